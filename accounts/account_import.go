@@ -0,0 +1,153 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// importableAccount returns a copy of the given account that only contains
+// the fields that are carried over when the account is imported: Its ID, its
+// balances and its settings. The invoices and payments of the copy are empty.
+func importableAccount(acct *OffChainBalanceAccount) *OffChainBalanceAccount {
+	return &OffChainBalanceAccount{
+		ID:                   acct.ID,
+		Type:                 acct.Type,
+		InitialBalance:       acct.InitialBalance,
+		CurrentBalance:       acct.CurrentBalance,
+		ExpirationDate:       acct.ExpirationDate,
+		Invoices:             make(AccountInvoices),
+		Payments:             make(AccountPayments),
+		Label:                acct.Label,
+		MaxHTLC:              acct.MaxHTLC,
+		SoftCap:              acct.SoftCap,
+		Sandbox:              acct.Sandbox,
+		KeysendBudget:        acct.KeysendBudget,
+		NodeID:               acct.NodeID,
+		AmountlessInvoiceMax: acct.AmountlessInvoiceMax,
+		TopUp:                acct.TopUp,
+		SpendRateLimit:       acct.SpendRateLimit,
+		ExpiryWarning:        acct.ExpiryWarning,
+		AllowedDestinations:  acct.AllowedDestinations,
+		MaxOpenInvoices:      acct.MaxOpenInvoices,
+		MaxInvoiceAmount:     acct.MaxInvoiceAmount,
+		MaxPayment:           acct.MaxPayment,
+	}
+}
+
+// validateImport checks that the given accounts can be imported together:
+// Every account needs an ID, a non-negative balance and valid policies, and
+// neither IDs nor labels may repeat. Unless overwrite is set, none of the
+// accounts may exist yet.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) validateImport(ctx context.Context,
+	accts []*OffChainBalanceAccount, overwrite bool) error {
+
+	ids := make(map[AccountID]struct{}, len(accts))
+	labels := make(map[string]struct{}, len(accts))
+	for _, acct := range accts {
+		if acct.ID == zeroID {
+			return fmt.Errorf("imported account has no ID")
+		}
+		if _, ok := ids[acct.ID]; ok {
+			return fmt.Errorf("account %x is imported more than "+
+				"once", acct.ID[:])
+		}
+		ids[acct.ID] = struct{}{}
+
+		if acct.Label != "" {
+			if _, ok := labels[acct.Label]; ok {
+				return fmt.Errorf("label '%s' is imported "+
+					"more than once: %w", acct.Label,
+					ErrLabelAlreadyExists)
+			}
+			labels[acct.Label] = struct{}{}
+		}
+
+		if acct.CurrentBalance < 0 {
+			return fmt.Errorf("account %x has a negative balance",
+				acct.ID[:])
+		}
+		if err := acct.TopUp.Validate(); err != nil {
+			return fmt.Errorf("account %x: %w", acct.ID[:], err)
+		}
+		if err := acct.SpendRateLimit.Validate(); err != nil {
+			return fmt.Errorf("account %x: %w", acct.ID[:], err)
+		}
+
+		if overwrite {
+			continue
+		}
+
+		_, err := s.store.Account(ctx, acct.ID)
+		switch {
+		case err == nil:
+			return fmt.Errorf("%w: %x", ErrAccountAlreadyExists,
+				acct.ID[:])
+
+		case !errors.Is(err, ErrAccNotFound):
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportAccounts recreates the given accounts with their original IDs, for
+// example to restore them from an export of another accounts database. Only
+// the balances and settings of the accounts are imported. All accounts are
+// validated before the first one is imported, so an import that is rejected
+// because an account already exists doesn't change anything. If overwrite is
+// set, existing accounts are updated to the imported state instead, but keep
+// their invoices and payments. Payments that are still in flight are debited
+// from the imported balance once they settle.
+func (s *InterceptorService) ImportAccounts(ctx context.Context,
+	accts []*OffChainBalanceAccount,
+	overwrite bool) ([]*OffChainBalanceAccount, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	// As this function sets account balances, we require that the service
+	// is running before we execute it.
+	if !s.isRunningUnsafe() {
+		return nil, ErrAccountServiceDisabled
+	}
+
+	if err := s.validateImport(ctx, accts, overwrite); err != nil {
+		return nil, err
+	}
+
+	imported := make([]*OffChainBalanceAccount, 0, len(accts))
+	for _, acct := range accts {
+		_, err := s.store.Account(ctx, acct.ID)
+		existed := err == nil
+
+		err = s.store.ImportAccount(ctx, acct, overwrite)
+		if err != nil {
+			return imported, fmt.Errorf("unable to import account "+
+				"%x: %w", acct.ID[:], err)
+		}
+
+		eventType := AccountLifecycleCreated
+		if existed {
+			eventType = AccountLifecycleUpdated
+		}
+
+		s.recordEvent(
+			ctx, acct.ID, AccountEventImported, "imported with "+
+				"balance %d mSAT", acct.CurrentBalance,
+		)
+		s.publishLifecycleEvent(ctx, eventType, acct.ID)
+
+		acct, err := s.store.Account(ctx, acct.ID)
+		if err != nil {
+			return imported, err
+		}
+		imported = append(imported, acct)
+	}
+	s.rescheduleExpiryWarnings()
+
+	return imported, nil
+}
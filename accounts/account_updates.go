@@ -0,0 +1,185 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// balanceOrExpiryChanged returns true if the balance or the expiration date of
+// the account differ between the two given states of it.
+func balanceOrExpiryChanged(prev, next *OffChainBalanceAccount) bool {
+	return prev.CurrentBalance != next.CurrentBalance ||
+		!prev.ExpirationDate.Equal(next.ExpirationDate)
+}
+
+// SubscribeAccountUpdates returns a channel on which an
+// AccountLifecycleUpdated event with the account with the given ID is
+// delivered whenever its balance or expiration date changes, together with a
+// function that must be called to end the subscription. The current state of
+// the account is always delivered first. Once the account reaches its expiry
+// warning lead time, an AccountLifecycleExpiringSoon event is delivered, which
+// happens right after the current state if the account is already within its
+// lead time when subscribing. The channel is closed once the account is
+// removed or the service is stopped.
+//
+// Unlike the lifecycle event subscription, a receiver that falls behind isn't
+// dropped. Instead, the subscription is renewed internally and the current
+// state of the account is delivered again if it changed in the meantime. Only
+// the latest state matters to the receiver, so no update is lost that way.
+func (s *InterceptorService) SubscribeAccountUpdates(ctx context.Context,
+	id AccountID) (<-chan *AccountLifecycleEvent, func(), error) {
+
+	// We subscribe to the lifecycle events before reading the account, so
+	// that no change that happens in between can be missed.
+	events, cancelEvents, err := s.lifecycleEvents.subscribe("")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	acct, err := s.Account(ctx, id)
+	if err != nil {
+		cancelEvents()
+		return nil, nil, err
+	}
+
+	var (
+		updates  = make(chan *AccountLifecycleEvent)
+		quit     = make(chan struct{})
+		quitOnce sync.Once
+	)
+	cancel := func() {
+		quitOnce.Do(func() { close(quit) })
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer close(updates)
+		defer func() { cancelEvents() }()
+
+		// warnedExpiry is the expiration date the receiver was last
+		// warned about, so that it isn't warned twice.
+		var warnedExpiry time.Time
+
+		send := func(eventType AccountLifecycleEventType,
+			acct *OffChainBalanceAccount) bool {
+
+			event := &AccountLifecycleEvent{
+				Type:      eventType,
+				AccountID: id,
+				Account:   acct,
+				Timestamp: time.Now(),
+			}
+
+			select {
+			case updates <- event:
+				return true
+
+			case <-quit:
+			case <-s.quit:
+			case <-ctx.Done():
+			}
+
+			return false
+		}
+
+		// sendWarning delivers an expiry warning for the account if it
+		// is due and the receiver wasn't warned about its current
+		// expiration date yet.
+		sendWarning := func(acct *OffChainBalanceAccount) bool {
+			if !acct.IsExpiringSoon() ||
+				warnedExpiry.Equal(acct.ExpirationDate) {
+
+				return true
+			}
+			warnedExpiry = acct.ExpirationDate
+
+			return send(AccountLifecycleExpiringSoon, acct)
+		}
+
+		last := acct
+		if !send(AccountLifecycleUpdated, last) || !sendWarning(last) {
+			return
+		}
+
+		for {
+			var event *AccountLifecycleEvent
+			select {
+			case event = <-events:
+			case <-quit:
+				return
+			case <-s.quit:
+				return
+			case <-ctx.Done():
+				return
+			}
+
+			// A nil event means that the broker dropped us because
+			// we didn't keep up. We subscribe again and read the
+			// account to catch up on what we missed.
+			if event == nil {
+				cancelEvents()
+				events, cancelEvents, err = s.lifecycleEvents.
+					subscribe("")
+				if err != nil {
+					log.Errorf("Unable to renew update "+
+						"subscription of account "+
+						"%x: %v", id[:], err)
+
+					return
+				}
+
+				event = &AccountLifecycleEvent{
+					Type:      AccountLifecycleUpdated,
+					AccountID: id,
+				}
+				event.Account, err = s.Account(ctx, id)
+				switch {
+				case errors.Is(err, ErrAccNotFound):
+					return
+
+				case err != nil:
+					log.Errorf("Unable to fetch "+
+						"account %x for update "+
+						"subscription: %v", id[:],
+						err)
+
+					return
+				}
+			}
+
+			if event.AccountID != id {
+				continue
+			}
+
+			switch event.Type {
+			case AccountLifecycleRemoved:
+				return
+
+			case AccountLifecycleUpdated:
+				acct := event.Account
+				if balanceOrExpiryChanged(last, acct) {
+					last = acct
+					if !send(AccountLifecycleUpdated, last) {
+						return
+					}
+				}
+
+				// This also catches up on a warning that
+				// was missed while we were behind.
+				if !sendWarning(acct) {
+					return
+				}
+
+			case AccountLifecycleExpiringSoon:
+				if !sendWarning(event.Account) {
+					return
+				}
+			}
+		}
+	}()
+
+	return updates, cancel, nil
+}
@@ -0,0 +1,94 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSubscribeAccountUpdates tests that an account update subscription starts
+// with the current state of the account, only delivers changes of its balance
+// and expiration date and ends when the account is removed.
+func TestSubscribeAccountUpdates(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	acct, err := service.NewAccount(ctx, 1000, time.Time{}, "watched")
+	require.NoError(t, err)
+	other, err := service.NewAccount(ctx, 1000, time.Time{}, "other")
+	require.NoError(t, err)
+
+	_, _, err = service.SubscribeAccountUpdates(ctx, AccountID{1})
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	updates, cancel, err := service.SubscribeAccountUpdates(ctx, acct.ID)
+	require.NoError(t, err)
+	defer cancel()
+
+	next := func() *OffChainBalanceAccount {
+		select {
+		case update := <-updates:
+			require.Equal(t, AccountLifecycleUpdated, update.Type)
+			return update.Account
+
+		case <-time.After(testTimeout):
+			t.Fatalf("no account update received")
+			return nil
+		}
+	}
+
+	require.EqualValues(t, 1000, next().CurrentBalance)
+
+	// Changes of other accounts and changes of the watched account that
+	// don't affect its balance or expiration date aren't delivered, so
+	// the next update is the credit.
+	_, err = service.CreditAccount(ctx, other.ID, 500)
+	require.NoError(t, err)
+	_, err = service.RenameAccountLabel(ctx, acct.ID, "renamed")
+	require.NoError(t, err)
+	_, err = service.CreditAccount(ctx, acct.ID, 500)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 1500, next().CurrentBalance)
+
+	expiry := time.Now().Add(time.Hour).Truncate(time.Second)
+	_, err = service.UpdateAccount(
+		ctx, acct.ID, &AccountUpdate{Expiry: fn.Some(expiry)},
+		fn.None[uint64](),
+	)
+	require.NoError(t, err)
+
+	update := next()
+	require.EqualValues(t, 1500, update.CurrentBalance)
+	require.True(t, expiry.Equal(update.ExpirationDate))
+
+	// Removing the account ends the subscription.
+	require.NoError(t, service.RemoveAccount(ctx, acct.ID))
+
+	select {
+	case update, ok := <-updates:
+		require.False(t, ok, "unexpected update %v", update)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("subscription not ended")
+	}
+}
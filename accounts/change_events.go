@@ -0,0 +1,112 @@
+package accounts
+
+import (
+	"context"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+)
+
+// WatchAccountChanges calls the given function for every account that is
+// created, credited, debited, otherwise updated, removed or about to expire,
+// until the context is canceled or the lifecycle events can no longer be
+// followed without missing any. Balance changes are classified by comparing the
+// balance of an account with the one it had in the previous event.
+func (s *RPCServer) WatchAccountChanges(ctx context.Context,
+	send func(*litrpc.AccountChangeEvent)) error {
+
+	// We subscribe before listing the accounts, so that no change that
+	// happens in between can be missed.
+	events, cancel, err := s.service.SubscribeAccountLifecycle("")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		cancel()
+	}()
+
+	accts, err := s.service.Accounts(ctx)
+	if err != nil {
+		return err
+	}
+
+	balances := make(map[AccountID]int64, len(accts))
+	for _, acct := range accts {
+		balances[acct.ID] = acct.CurrentBalance
+	}
+
+	var resumeToken string
+	for {
+		select {
+		case event, ok := <-events:
+			// If we didn't keep up, we resume after the last event
+			// we received.
+			if !ok {
+				cancel()
+
+				events, cancel, err = s.service.
+					SubscribeAccountLifecycle(resumeToken)
+				if err != nil {
+					return err
+				}
+
+				continue
+			}
+			resumeToken = event.ResumeToken
+
+			send(s.marshalAccountChange(event, balances))
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// marshalAccountChange converts an account lifecycle event into an account
+// change event and records the new balance of the account in the given map.
+func (s *RPCServer) marshalAccountChange(event *AccountLifecycleEvent,
+	balances map[AccountID]int64) *litrpc.AccountChangeEvent {
+
+	rpcEvent := &litrpc.AccountChangeEvent{
+		AccountId: event.AccountID.String(),
+	}
+	if event.Account != nil {
+		rpcEvent.Account = s.marshalAccount(event.Account)
+	}
+
+	switch event.Type {
+	case AccountLifecycleCreated:
+		rpcEvent.Type = litrpc.AccountChangeType_ACCOUNT_CHANGE_CREATED
+		rpcEvent.BalanceChangeMsat = event.Account.CurrentBalance
+		balances[event.AccountID] = event.Account.CurrentBalance
+
+	case AccountLifecycleUpdated:
+		change := event.Account.CurrentBalance -
+			balances[event.AccountID]
+		balances[event.AccountID] = event.Account.CurrentBalance
+
+		rpcEvent.BalanceChangeMsat = change
+		switch {
+		case change > 0:
+			rpcEvent.Type = litrpc.
+				AccountChangeType_ACCOUNT_CHANGE_CREDITED
+
+		case change < 0:
+			rpcEvent.Type = litrpc.
+				AccountChangeType_ACCOUNT_CHANGE_DEBITED
+
+		default:
+			rpcEvent.Type = litrpc.
+				AccountChangeType_ACCOUNT_CHANGE_UPDATED
+		}
+
+	case AccountLifecycleRemoved:
+		rpcEvent.Type = litrpc.AccountChangeType_ACCOUNT_CHANGE_REMOVED
+		delete(balances, event.AccountID)
+
+	case AccountLifecycleExpiringSoon:
+		rpcEvent.Type = litrpc.
+			AccountChangeType_ACCOUNT_CHANGE_EXPIRING_SOON
+	}
+
+	return rpcEvent
+}
@@ -0,0 +1,102 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestWatchAccountChanges tests that account lifecycle events are classified
+// by their balance change.
+func TestWatchAccountChanges(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	// An account that exists before watching starts is only reported once
+	// it changes, with the change relative to its existing balance.
+	existing, err := service.NewAccount(ctx, 1000, time.Time{}, "existing")
+	require.NoError(t, err)
+
+	server := NewRPCServer(service, nil, nil)
+
+	ctx, cancel := context.WithCancel(ctx)
+	changes := make(chan *litrpc.AccountChangeEvent, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- server.WatchAccountChanges(
+			ctx, func(event *litrpc.AccountChangeEvent) {
+				changes <- event
+			},
+		)
+	}()
+
+	// Wait for the watcher to subscribe before changing any accounts.
+	require.Eventually(t, func() bool {
+		service.lifecycleEvents.mu.Lock()
+		defer service.lifecycleEvents.mu.Unlock()
+
+		return len(service.lifecycleEvents.subscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	acct, err := service.NewAccount(ctx, 2000, time.Time{}, "new")
+	require.NoError(t, err)
+
+	event := <-changes
+	require.Equal(
+		t, litrpc.AccountChangeType_ACCOUNT_CHANGE_CREATED, event.Type,
+	)
+	require.EqualValues(t, 2000, event.BalanceChangeMsat)
+	require.EqualValues(t, 2, event.Account.CurrentBalance)
+
+	// Once the first event is reported, the watcher has listed the
+	// existing accounts.
+	_, err = service.DebitAccount(ctx, existing.ID, 400, "")
+	require.NoError(t, err)
+
+	event = <-changes
+	require.Equal(
+		t, litrpc.AccountChangeType_ACCOUNT_CHANGE_DEBITED, event.Type,
+	)
+	require.Equal(t, existing.ID.String(), event.AccountId)
+	require.EqualValues(t, -400, event.BalanceChangeMsat)
+
+	_, err = service.CreditAccount(ctx, acct.ID, 3000)
+	require.NoError(t, err)
+
+	event = <-changes
+	require.Equal(
+		t, litrpc.AccountChangeType_ACCOUNT_CHANGE_CREDITED, event.Type,
+	)
+	require.EqualValues(t, 3000, event.BalanceChangeMsat)
+
+	require.NoError(t, service.RemoveAccount(ctx, acct.ID))
+
+	event = <-changes
+	require.Equal(
+		t, litrpc.AccountChangeType_ACCOUNT_CHANGE_REMOVED, event.Type,
+	)
+	require.Equal(t, acct.ID.String(), event.AccountId)
+	require.Nil(t, event.Account)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btclog/v2"
 	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -75,12 +77,19 @@ type CheckerMap map[string]mid.RoundTripChecker
 // including invoices, payments and account balances.
 type AccountChecker struct {
 	checkers CheckerMap
+
+	// keysend enforces the keysend budgets of the accounts.
+	keysend *keysendLimiter
 }
 
 // NewAccountChecker creates a new account checker that can keep track of all
 // account related requests, including invoices, payments and account balances.
-func NewAccountChecker(service Service,
-	chainParams *chaincfg.Params) *AccountChecker {
+// Balances are reported to the account holder in satoshis rounded according
+// to the given rounding mode.
+func NewAccountChecker(service Service, chainParams *chaincfg.Params,
+	rounding RoundingMode) *AccountChecker {
+
+	keysend := newKeysendLimiter(clock.NewDefaultClock())
 
 	// sendResponseHandler is a response handler function that is used by
 	// multiple RPC checkers for checking an RPC response sent for a payment
@@ -112,9 +121,22 @@ func NewAccountChecker(service Service,
 
 	checkers := CheckerMap{
 		// Invoices:
-		"/lnrpc.Lightning/AddInvoice": mid.NewResponseRewriter(
+		"/lnrpc.Lightning/AddInvoice": mid.NewFullChecker(
 			&lnrpc.Invoice{},
 			&lnrpc.AddInvoiceResponse{},
+			func(ctx context.Context, r *lnrpc.Invoice) error {
+				acct, err := AccountFromContext(ctx)
+				if err != nil {
+					return err
+				}
+
+				err = checkInvoiceAmount(acct, invoiceAmount(r))
+				if err != nil {
+					return err
+				}
+
+				return service.CheckOpenInvoices(ctx, acct.ID)
+			},
 			func(ctx context.Context,
 				t *lnrpc.AddInvoiceResponse) (proto.Message,
 				error) {
@@ -181,10 +203,16 @@ func NewAccountChecker(service Service,
 			&lnrpc.SendRequest{},
 			&lnrpc.SendResponse{},
 			func(ctx context.Context, r *lnrpc.SendRequest) error {
+				dest, err := sendRequestDest(r)
+				if err != nil {
+					return err
+				}
+
 				return checkSend(
 					ctx, chainParams, service, r.Amt,
 					r.AmtMsat, r.PaymentRequest,
-					r.PaymentHash, r.FeeLimit,
+					r.PaymentHash, dest, r.FeeLimit, false,
+					r.DestCustomRecords, keysend,
 				)
 			}, sendResponseHandler, erroredPaymentHandler(service),
 		),
@@ -192,35 +220,52 @@ func NewAccountChecker(service Service,
 			&lnrpc.SendRequest{},
 			&lnrpc.SendResponse{},
 			func(ctx context.Context, r *lnrpc.SendRequest) error {
+				dest, err := sendRequestDest(r)
+				if err != nil {
+					return err
+				}
+
 				return checkSend(
 					ctx, chainParams, service, r.Amt,
 					r.AmtMsat, r.PaymentRequest,
-					r.PaymentHash, r.FeeLimit,
+					r.PaymentHash, dest, r.FeeLimit, false,
+					r.DestCustomRecords, keysend,
 				)
 			}, sendResponseHandler, erroredPaymentHandler(service),
 		),
 		// routerrpc.Router/SendPayment is deprecated.
-		"/routerrpc.Router/SendPaymentV2": mid.NewFullChecker(
+		"/routerrpc.Router/SendPaymentV2": mid.NewFullRewriter(
 			&routerrpc.SendPaymentRequest{},
 			&lnrpc.Payment{},
 			func(ctx context.Context,
-				r *routerrpc.SendPaymentRequest) error {
+				r *routerrpc.SendPaymentRequest) (proto.Message,
+				error) {
 
 				feeLimitMsat := r.FeeLimitMsat
 				if r.FeeLimitSat > 0 {
 					feeLimitMsat = r.FeeLimitSat * 1000
 				}
 
-				return checkSend(
+				// A payment that is allowed to be split can
+				// be bounded by lnd's max shard size, so we
+				// only need to check the full amount if the
+				// payment is restricted to a single part.
+				err := checkSend(
 					ctx, chainParams, service, r.Amt,
 					r.AmtMsat, r.PaymentRequest,
-					r.PaymentHash,
+					r.PaymentHash, r.Dest,
 					&lnrpc.FeeLimit{
 						Limit: &lnrpc.FeeLimit_FixedMsat{
 							FixedMsat: feeLimitMsat,
 						},
-					},
+					}, r.MaxParts != 1,
+					r.DestCustomRecords, keysend,
 				)
+				if err != nil {
+					return nil, err
+				}
+
+				return capMaxShardSize(ctx, r)
 			},
 			func(ctx context.Context,
 				r *lnrpc.Payment) (proto.Message, error) {
@@ -247,6 +292,7 @@ func NewAccountChecker(service Service,
 
 				return checkSendToRoute(
 					ctx, service, r.PaymentHash, r.Route,
+					keysend,
 				)
 			}, sendResponseHandler, erroredPaymentHandler(service),
 		),
@@ -258,6 +304,7 @@ func NewAccountChecker(service Service,
 
 				return checkSendToRoute(
 					ctx, service, r.PaymentHash, r.Route,
+					keysend,
 				)
 			}, sendResponseHandler, erroredPaymentHandler(service),
 		),
@@ -270,6 +317,7 @@ func NewAccountChecker(service Service,
 
 				return checkSendToRoute(
 					ctx, service, r.PaymentHash, r.Route,
+					keysend,
 				)
 			},
 			sendToRouteHTLCResponseHandler(service),
@@ -337,7 +385,9 @@ func NewAccountChecker(service Service,
 					return nil, err
 				}
 
-				balanceSat := acct.CurrentBalanceSats()
+				balanceSat := rounding.ToSatoshis(
+					acct.CurrentBalance,
+				)
 				emptyAmount := &lnrpc.Amount{}
 				return &lnrpc.ChannelBalanceResponse{
 					Balance: balanceSat,
@@ -395,6 +445,7 @@ func NewAccountChecker(service Service,
 
 	return &AccountChecker{
 		checkers: checkers,
+		keysend:  keysend,
 	}
 }
 
@@ -423,36 +474,28 @@ func (a *AccountChecker) handleErrorResponse(ctx context.Context,
 }
 
 // checkIncomingRequest makes sure the type of incoming call is supported and
-// if it is, that it is allowed with the current account balance.
+// if it is, that it is allowed with the current account balance. If the
+// request needs to be modified before it is passed on to lnd, the replacement
+// request is returned.
 func (a *AccountChecker) checkIncomingRequest(ctx context.Context,
-	fullUri string, req proto.Message) error {
+	fullUri string, req proto.Message) (proto.Message, error) {
 
 	// If we don't have a handler for the URI, it means we don't support
 	// that RPC.
 	checker, ok := a.checkers[fullUri]
 	if !ok {
-		return ErrNotSupportedWithAccounts
+		return nil, ErrNotSupportedWithAccounts
 	}
 
 	// This is just a sanity check to make sure the implementation for the
 	// checker actually matches the correct request type.
 	if !checker.HandlesRequest(req.ProtoReflect().Type()) {
-		return fmt.Errorf("invalid implementation, checker for URI "+
-			"%s does not accept request of type %v", fullUri,
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept request of type %v", fullUri,
 			req.ProtoReflect().Type())
 	}
 
-	req, err := checker.HandleRequest(ctx, req)
-	if err != nil {
-		return err
-	}
-
-	if req != nil {
-		return fmt.Errorf("request editing checkers not supported " +
-			"for accounts")
-	}
-
-	return nil
+	return checker.HandleRequest(ctx, req)
 }
 
 // replaceOutgoingResponse inspects the responses before sending them out to the
@@ -539,10 +582,18 @@ func filterPayments(ctx context.Context,
 }
 
 // checkSend checks if a payment can be initiated by making sure the account in
-// the context has enough balance to pay for it.
+// the context has enough balance to pay for it. The amount is checked against
+// the account's max payment amount first. If the payment cannot be split into
+// multiple HTLCs, the full amount is also checked against the account's max
+// HTLC amount. Keysend payments, recognized by their custom records, are
+// additionally charged to the account's keysend budget. Payments to invoices
+// without an amount are checked against the account's max amount for such
+// invoices. If the account has allowed destinations, the destination of the
+// invoice or, without an invoice, the given destination must be one of them.
 func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 	service Service, amt, amtMsat int64, invoice string,
-	paymentHash []byte, feeLimit *lnrpc.FeeLimit) error {
+	paymentHash, dest []byte, feeLimit *lnrpc.FeeLimit, splittable bool,
+	customRecords map[uint64][]byte, keysend *keysendLimiter) error {
 
 	log, acct, reqID, err := requestScopedValuesFromCtx(ctx)
 	if err != nil {
@@ -570,9 +621,28 @@ func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 			sendAmt = *payReq.MilliSat
 		}
 
+		if payReq.MilliSat == nil {
+			err := checkAmountlessInvoiceMax(acct, sendAmt)
+			if err != nil {
+				return err
+			}
+		}
+
 		if payReq.PaymentHash != nil {
 			pHash = *payReq.PaymentHash
 		}
+
+		// lnd pays the node of the invoice, so that is the
+		// destination we need to check, regardless of what the
+		// request states.
+		dest = nil
+		if payReq.Destination != nil {
+			dest = payReq.Destination.SerializeCompressed()
+		}
+	}
+
+	if err := checkDestination(acct, dest); err != nil {
+		return err
 	}
 
 	// If a payment hash was separately provided in the request, then glean
@@ -601,9 +671,23 @@ func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 		return fmt.Errorf("a payment hash is required")
 	}
 
+	if err := checkPaymentHash(ctx, pHash); err != nil {
+		return err
+	}
+
 	log.Tracef("Handling send request for payment with hash: %s and "+
 		"amount: %d", pHash, sendAmt)
 
+	if err := checkMaxPayment(acct, sendAmt); err != nil {
+		return err
+	}
+
+	if !splittable {
+		if err := checkMaxHTLC(acct, sendAmt); err != nil {
+			return err
+		}
+	}
+
 	// We also add the max fee to the amount to check. This might mean that
 	// not every single satoshi of an account can be used up. But it
 	// prevents an account from going into a negative balance if we only
@@ -620,6 +704,19 @@ func checkSend(ctx context.Context, chainParams *chaincfg.Params,
 		return fmt.Errorf("error validating account balance: %w", err)
 	}
 
+	err = service.CheckSpendRate(ctx, acct.ID, pHash, sendAmt)
+	if err != nil {
+		return err
+	}
+
+	if isKeysend(customRecords) {
+		if err := keysend.spend(acct, sendAmt-fee); err != nil {
+			return err
+		}
+	}
+
+	warnSoftCap(log, acct, pHash, sendAmt)
+
 	err = service.AssociatePayment(ctx, acct.ID, pHash, sendAmt)
 	if err != nil {
 		return fmt.Errorf("error associating payment: %w", err)
@@ -679,9 +776,12 @@ func checkSendResponse(ctx context.Context, service Service,
 }
 
 // checkSendToRoute checks if a payment can be sent to the route by making sure
-// the account in the context has enough balance to pay for it.
+// the account in the context has enough balance to pay for it. A route that
+// delivers a keysend preimage to its final hop is charged to the account's
+// keysend budget. If the account has allowed destinations, the final hop of
+// the route must be one of them.
 func checkSendToRoute(ctx context.Context, service Service, paymentHash []byte,
-	route *lnrpc.Route) error {
+	route *lnrpc.Route, keysend *keysendLimiter) error {
 
 	log, acct, reqID, err := requestScopedValuesFromCtx(ctx)
 	if err != nil {
@@ -697,6 +797,18 @@ func checkSendToRoute(ctx context.Context, service Service, paymentHash []byte,
 		return fmt.Errorf("invalid route")
 	}
 
+	dest, err := routeDest(route)
+	if err != nil {
+		return err
+	}
+	if err := checkDestination(acct, dest); err != nil {
+		return err
+	}
+
+	if err := checkPaymentHash(ctx, hash); err != nil {
+		return err
+	}
+
 	sendAmt := lnwire.NewMSatFromSatoshis(btcutil.Amount(route.TotalAmt)) // nolint
 	if lnwire.MilliSatoshi(route.TotalAmtMsat) > sendAmt {
 		sendAmt = lnwire.MilliSatoshi(route.TotalAmtMsat)
@@ -705,14 +817,26 @@ func checkSendToRoute(ctx context.Context, service Service, paymentHash []byte,
 	log.Tracef("Handling send request for payment with hash: %s and "+
 		"amount: %d", hash, sendAmt)
 
-	// We also add the max fee to the amount to check. This might mean that
-	// not every single satoshi of an account can be used up. But it
-	// prevents an account from going into a negative balance if we only
-	// check for the amount to send but then later debit the full amount.
+	// The route amount includes the routing fees, which aren't counted
+	// towards the max payment and HTLC amounts.
 	fee := lnwire.NewMSatFromSatoshis(btcutil.Amount(route.TotalFees)) // nolint
 	if lnwire.MilliSatoshi(route.TotalFeesMsat) > fee {
 		fee = lnwire.MilliSatoshi(route.TotalFeesMsat)
 	}
+	if sendAmt > fee {
+		if err := checkMaxPayment(acct, sendAmt-fee); err != nil {
+			return err
+		}
+
+		if err := checkMaxHTLC(acct, sendAmt-fee); err != nil {
+			return err
+		}
+	}
+
+	// We also add the max fee to the amount to check. This might mean that
+	// not every single satoshi of an account can be used up. But it
+	// prevents an account from going into a negative balance if we only
+	// check for the amount to send but then later debit the full amount.
 	sendAmt += fee
 
 	err = service.CheckBalance(ctx, acct.ID, sendAmt)
@@ -720,6 +844,20 @@ func checkSendToRoute(ctx context.Context, service Service, paymentHash []byte,
 		return fmt.Errorf("error validating account balance: %w", err)
 	}
 
+	err = service.CheckSpendRate(ctx, acct.ID, hash, sendAmt)
+	if err != nil {
+		return err
+	}
+
+	numHops := len(route.Hops)
+	if numHops > 0 && isKeysend(route.Hops[numHops-1].CustomRecords) {
+		if err := keysend.spend(acct, sendAmt-fee); err != nil {
+			return err
+		}
+	}
+
+	warnSoftCap(log, acct, hash, sendAmt)
+
 	err = service.AssociatePayment(ctx, acct.ID, hash, sendAmt)
 	if err != nil {
 		return fmt.Errorf("error associating payment with hash %s: %w",
@@ -732,6 +870,122 @@ func checkSendToRoute(ctx context.Context, service Service, paymentHash []byte,
 	})
 }
 
+// checkMaxHTLC makes sure that the given HTLC amount does not exceed the max
+// HTLC amount of the account, if one is set.
+func checkMaxHTLC(acct *OffChainBalanceAccount,
+	amt lnwire.MilliSatoshi) error {
+
+	if acct.MaxHTLC == 0 || amt <= acct.MaxHTLC {
+		return nil
+	}
+
+	return fmt.Errorf("%w: HTLC amount %v is larger than the account "+
+		"limit of %v", ErrMaxHTLCExceeded, amt, acct.MaxHTLC)
+}
+
+// checkMaxPayment makes sure that the given payment amount does not exceed the
+// max payment amount of the account, if one is set.
+func checkMaxPayment(acct *OffChainBalanceAccount,
+	amt lnwire.MilliSatoshi) error {
+
+	if acct.MaxPayment == 0 || amt <= acct.MaxPayment {
+		return nil
+	}
+
+	return fmt.Errorf("%w: payment amount %v is larger than the account "+
+		"limit of %v", ErrMaxPaymentExceeded, amt, acct.MaxPayment)
+}
+
+// checkAmountlessInvoiceMax makes sure that the given amount to pay to an
+// invoice without an amount was stated explicitly and doesn't exceed the
+// limit the account sets for such invoices. Without a limit, the amount is
+// only checked against the balance, like for any other payment.
+func checkAmountlessInvoiceMax(acct *OffChainBalanceAccount,
+	amt lnwire.MilliSatoshi) error {
+
+	if acct.AmountlessInvoiceMax == 0 {
+		return nil
+	}
+
+	if amt == 0 {
+		return fmt.Errorf("%w: an amount must be set to pay an invoice "+
+			"without an amount", ErrAmountlessInvoiceMaxExceeded)
+	}
+
+	if amt > acct.AmountlessInvoiceMax {
+		return fmt.Errorf("%w: amount %v is larger than the account "+
+			"limit of %v", ErrAmountlessInvoiceMaxExceeded, amt,
+			acct.AmountlessInvoiceMax)
+	}
+
+	return nil
+}
+
+// softCapSpend returns the total amount the account will have spent once the
+// payment with the given hash and amount goes through. Failed payments are not
+// counted, and neither is an earlier attempt of the same payment. The second
+// return value is true if the total exceeds the soft cap of the account.
+func softCapSpend(acct *OffChainBalanceAccount, hash lntypes.Hash,
+	amt lnwire.MilliSatoshi) (lnwire.MilliSatoshi, bool) {
+
+	if acct.SoftCap == 0 {
+		return 0, false
+	}
+
+	total := amt
+	for paymentHash, entry := range acct.Payments {
+		if paymentHash == hash ||
+			entry.Status == lnrpc.Payment_FAILED {
+
+			continue
+		}
+
+		total += entry.FullAmount
+	}
+
+	return total, total > acct.SoftCap
+}
+
+// warnSoftCap logs a warning if the given payment takes the account over its
+// soft cap. The payment is never rejected because of the soft cap.
+func warnSoftCap(log btclog.Logger, acct *OffChainBalanceAccount,
+	hash lntypes.Hash, amt lnwire.MilliSatoshi) {
+
+	total, exceeded := softCapSpend(acct, hash, amt)
+	if !exceeded {
+		return
+	}
+
+	log.Warnf("Payment with hash %s takes total account spend to %v, "+
+		"which exceeds the soft cap of %v", hash, total, acct.SoftCap)
+}
+
+// capMaxShardSize makes sure that lnd won't send any HTLC larger than the max
+// HTLC amount of the account in the context by lowering the max shard size of
+// the payment request if required. The modified request is returned if it
+// needs to be replaced, nil otherwise.
+func capMaxShardSize(ctx context.Context,
+	r *routerrpc.SendPaymentRequest) (proto.Message, error) {
+
+	acct, err := AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	maxHTLC := uint64(acct.MaxHTLC)
+	if maxHTLC == 0 {
+		return nil, nil
+	}
+
+	if r.MaxShardSizeMsat != 0 && r.MaxShardSizeMsat <= maxHTLC {
+		return nil, nil
+	}
+
+	r.MaxShardSizeMsat = maxHTLC
+
+	return r, nil
+}
+
 // erroredPaymentHandler does some trace logging about the errored payment and
 // clears up any state we may have had for the payment.
 func erroredPaymentHandler(service Service) mid.ErrorHandler {
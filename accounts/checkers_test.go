@@ -7,12 +7,21 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	invpkg "github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -70,7 +79,7 @@ func (m *mockService) CreditAccount(_ context.Context, _ AccountID,
 }
 
 func (m *mockService) DebitAccount(_ context.Context, _ AccountID,
-	_ lnwire.MilliSatoshi) (*OffChainBalanceAccount, error) {
+	_ lnwire.MilliSatoshi, _ string) (*OffChainBalanceAccount, error) {
 
 	return nil, nil
 }
@@ -94,6 +103,16 @@ func (m *mockService) CheckBalance(_ context.Context, _ AccountID,
 	return nil
 }
 
+func (m *mockService) CheckSpendRate(_ context.Context, _ AccountID,
+	_ lntypes.Hash, _ lnwire.MilliSatoshi) error {
+
+	return nil
+}
+
+func (m *mockService) CheckOpenInvoices(_ context.Context, _ AccountID) error {
+	return nil
+}
+
 func (m *mockService) AssociateInvoice(_ context.Context, id AccountID,
 	hash lntypes.Hash) error {
 
@@ -144,7 +163,7 @@ var _ Service = (*mockService)(nil)
 func TestAccountChecker(t *testing.T) {
 	t.Parallel()
 
-	checker := NewAccountChecker(nil, nil)
+	checker := NewAccountChecker(nil, nil, RoundDown)
 	for checkerName := range checker.checkers {
 		t.Logf("Checker registered: %v", checkerName)
 	}
@@ -456,7 +475,9 @@ func TestAccountCheckers(t *testing.T) {
 			tt.Parallel()
 
 			service := newMockService()
-			checkers := NewAccountChecker(service, chainParams)
+			checkers := NewAccountChecker(
+				service, chainParams, RoundDown,
+			)
 			acct := &OffChainBalanceAccount{
 				ID:       testID,
 				Type:     TypeInitialBalance,
@@ -472,7 +493,7 @@ func TestAccountCheckers(t *testing.T) {
 				tc.setup(service, acct)
 			}
 
-			err := checkers.checkIncomingRequest(
+			_, err := checkers.checkIncomingRequest(
 				ctx, tc.fullURI, tc.originalRequest,
 			)
 
@@ -553,7 +574,7 @@ func testSendPayment(t *testing.T, uri string) {
 	}
 
 	// This should error because there is no account in the context.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &lnrpc.SendRequest{},
 	)
 	require.ErrorContains(t, err, "no account found in context")
@@ -567,7 +588,7 @@ func testSendPayment(t *testing.T, uri string) {
 	ctxWithAcct := AddAccountToContext(ctx, acct)
 
 	// This should error because there is no request ID in the context.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctxWithAcct, uri, &lnrpc.SendRequest{},
 	)
 	require.ErrorContains(t, err, "no request ID found in context")
@@ -576,13 +597,13 @@ func testSendPayment(t *testing.T, uri string) {
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID1)
 
 	// This should error because no payment hash is provided.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &lnrpc.SendRequest{},
 	)
 	require.ErrorContains(t, err, "a payment hash is required")
 
 	// This should error because of an insufficient account balance.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &lnrpc.SendRequest{
 			Amt:         1000,
 			PaymentHash: testHash[:],
@@ -595,7 +616,7 @@ func testSendPayment(t *testing.T, uri string) {
 	assertBalance(acct.ID, 5000)
 
 	// This should work.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &lnrpc.SendRequest{
 			AmtMsat:     1000,
 			PaymentHash: testHash[:],
@@ -609,7 +630,7 @@ func testSendPayment(t *testing.T, uri string) {
 
 	// Try let the same request go through with the same payment hash. This
 	// should fail and the balance should remain unchanged.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &lnrpc.SendRequest{
 			AmtMsat:     1000,
 			PaymentHash: testHash[:],
@@ -648,7 +669,7 @@ func testSendPayment(t *testing.T, uri string) {
 
 	// Ok now we will test an errored request. First send through a valid
 	// send request and assert that the available balance is reduced.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &lnrpc.SendRequest{
 			AmtMsat:     1000,
 			PaymentHash: testHash2[:],
@@ -675,7 +696,7 @@ func testSendPayment(t *testing.T, uri string) {
 	reqID3 := nextRequestID()
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID3)
 
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &lnrpc.SendRequest{
 			AmtMsat:     2000,
 			PaymentHash: testHash3[:],
@@ -687,7 +708,7 @@ func testSendPayment(t *testing.T, uri string) {
 
 	reqID4 := nextRequestID()
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID4)
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &lnrpc.SendRequest{
 			AmtMsat:     2000,
 			PaymentHash: testHash4[:],
@@ -751,7 +772,7 @@ func TestSendPaymentV2(t *testing.T) {
 	}
 
 	// This should error because there is no account in the context.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendPaymentRequest{},
 	)
 	require.ErrorContains(t, err, "no account found in context")
@@ -765,7 +786,7 @@ func TestSendPaymentV2(t *testing.T) {
 	ctxWithAcct := AddAccountToContext(ctx, acct)
 
 	// This should error because there is no request ID in the context.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctxWithAcct, uri, &routerrpc.SendPaymentRequest{},
 	)
 	require.ErrorContains(t, err, "no request ID found in context")
@@ -774,13 +795,13 @@ func TestSendPaymentV2(t *testing.T) {
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID1)
 
 	// This should error because no payment hash is provided.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendPaymentRequest{},
 	)
 	require.ErrorContains(t, err, "a payment hash is required")
 
 	// This should error because of an insufficient account balance.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendPaymentRequest{
 			Amt:         1000,
 			PaymentHash: testHash[:],
@@ -793,7 +814,7 @@ func TestSendPaymentV2(t *testing.T) {
 	assertBalance(acct.ID, 5000)
 
 	// This should work.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendPaymentRequest{
 			AmtMsat:     1000,
 			PaymentHash: testHash[:],
@@ -806,7 +827,7 @@ func TestSendPaymentV2(t *testing.T) {
 
 	// Try let the same request go through with the same payment hash. This
 	// should fail and the balance should remain unchanged.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendPaymentRequest{
 			AmtMsat:     1000,
 			PaymentHash: testHash[:],
@@ -844,7 +865,7 @@ func TestSendPaymentV2(t *testing.T) {
 
 	// Ok now we will test an errored request. First send through a valid
 	// send request and assert that the available balance is reduced.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendPaymentRequest{
 			AmtMsat:     1000,
 			PaymentHash: testHash2[:],
@@ -868,7 +889,7 @@ func TestSendPaymentV2(t *testing.T) {
 	reqID3 := nextRequestID()
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID3)
 
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendPaymentRequest{
 			AmtMsat:     2000,
 			PaymentHash: testHash3[:],
@@ -879,7 +900,7 @@ func TestSendPaymentV2(t *testing.T) {
 
 	reqID4 := nextRequestID()
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID4)
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendPaymentRequest{
 			AmtMsat:     2000,
 			PaymentHash: testHash4[:],
@@ -940,7 +961,7 @@ func TestSendToRouteV2(t *testing.T) {
 	}
 
 	// This should error because there is no account in the context.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendToRouteRequest{},
 	)
 	require.ErrorContains(t, err, "no account found in context")
@@ -954,7 +975,7 @@ func TestSendToRouteV2(t *testing.T) {
 	ctxWithAcct := AddAccountToContext(ctx, acct)
 
 	// This should error because there is no request ID in the context.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctxWithAcct, uri, &routerrpc.SendToRouteRequest{},
 	)
 	require.ErrorContains(t, err, "no request ID found in context")
@@ -963,13 +984,13 @@ func TestSendToRouteV2(t *testing.T) {
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID1)
 
 	// This should error because no payment hash is provided.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendToRouteRequest{},
 	)
 	require.ErrorContains(t, err, "invalid hash length")
 
 	// This should error because of an insufficient account balance.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendToRouteRequest{
 			Route: &lnrpc.Route{
 				TotalAmt: 1000,
@@ -984,7 +1005,7 @@ func TestSendToRouteV2(t *testing.T) {
 	assertBalance(acct.ID, 5000)
 
 	// This should work.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendToRouteRequest{
 			Route: &lnrpc.Route{
 				TotalAmtMsat: 1000,
@@ -999,7 +1020,7 @@ func TestSendToRouteV2(t *testing.T) {
 
 	// Try let the same request go through with the same payment hash. This
 	// should fail and the balance should remain unchanged.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendToRouteRequest{
 			Route: &lnrpc.Route{
 				TotalAmtMsat: 1000,
@@ -1037,7 +1058,7 @@ func TestSendToRouteV2(t *testing.T) {
 
 	// Ok now we will test an errored request. First send through a valid
 	// send request and assert that the available balance is reduced.
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendToRouteRequest{
 			Route: &lnrpc.Route{
 				TotalAmtMsat: 1000,
@@ -1063,7 +1084,7 @@ func TestSendToRouteV2(t *testing.T) {
 	reqID3 := nextRequestID()
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID3)
 
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendToRouteRequest{
 			Route: &lnrpc.Route{
 				TotalAmtMsat: 2000,
@@ -1076,7 +1097,7 @@ func TestSendToRouteV2(t *testing.T) {
 
 	reqID4 := nextRequestID()
 	ctx = AddRequestIDToContext(ctxWithAcct, reqID4)
-	err = service.checkers.checkIncomingRequest(
+	_, err = service.checkers.checkIncomingRequest(
 		ctx, uri, &routerrpc.SendToRouteRequest{
 			Route: &lnrpc.Route{
 				TotalAmtMsat: 2000,
@@ -1101,6 +1122,744 @@ func TestSendToRouteV2(t *testing.T) {
 	assertBalance(acct.ID, 2000)
 }
 
+// TestAccountMaxHTLC makes sure that the max HTLC amount of an account is
+// enforced by the payment checkers.
+func TestAccountMaxHTLC(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		zeroFee = &lnrpc.FeeLimit{Limit: &lnrpc.FeeLimit_Fixed{
+			Fixed: 0,
+		}}
+		requestID uint64
+	)
+
+	nextRequestID := func() uint64 {
+		requestID++
+
+		return requestID
+	}
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	clock := clock.NewTestClock(time.Now())
+	store := NewTestDB(t, clock)
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+
+	acct, err := service.NewAccount(
+		ctx, 10000, clock.Now().Add(time.Hour), "test",
+		WithMaxHTLC(2000),
+	)
+	require.NoError(t, err)
+
+	ctxWithAcct := AddAccountToContext(ctx, acct)
+	ctx = AddRequestIDToContext(ctxWithAcct, nextRequestID())
+
+	// A payment that can't be split must not exceed the max HTLC amount.
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/lnrpc.Lightning/SendPaymentSync", &lnrpc.SendRequest{
+			AmtMsat:     3000,
+			PaymentHash: testHash[:],
+			FeeLimit:    zeroFee,
+		},
+	)
+	require.ErrorIs(t, err, ErrMaxHTLCExceeded)
+
+	// The same goes for a route that carries too much.
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendToRouteV2",
+		&routerrpc.SendToRouteRequest{
+			Route: &lnrpc.Route{
+				TotalAmtMsat: 3000,
+			},
+			PaymentHash: testHash[:],
+		},
+	)
+	require.ErrorIs(t, err, ErrMaxHTLCExceeded)
+
+	// The routing fees of a route don't count towards the limit.
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendToRouteV2",
+		&routerrpc.SendToRouteRequest{
+			Route: &lnrpc.Route{
+				TotalAmtMsat:  2500,
+				TotalFeesMsat: 500,
+			},
+			PaymentHash: testHash[:],
+		},
+	)
+	require.NoError(t, err)
+
+	// A payment that lnd is allowed to split should have its max shard
+	// size capped to the max HTLC amount.
+	ctx = AddRequestIDToContext(ctxWithAcct, nextRequestID())
+	replacement, err := service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendPaymentV2",
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:     3000,
+			PaymentHash: testHash2[:],
+		},
+	)
+	require.NoError(t, err)
+	assertMessagesEqual(t, &routerrpc.SendPaymentRequest{
+		AmtMsat:          3000,
+		PaymentHash:      testHash2[:],
+		MaxShardSizeMsat: 2000,
+	}, replacement)
+
+	// A smaller max shard size set by the caller is left untouched.
+	ctx = AddRequestIDToContext(ctxWithAcct, nextRequestID())
+	replacement, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendPaymentV2",
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:          3000,
+			PaymentHash:      testHash3[:],
+			MaxShardSizeMsat: 1000,
+		},
+	)
+	require.NoError(t, err)
+	require.Nil(t, replacement)
+
+	// But a payment that is restricted to a single part can't be split
+	// and must therefore be rejected.
+	ctx = AddRequestIDToContext(ctxWithAcct, nextRequestID())
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendPaymentV2",
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:     3000,
+			PaymentHash: testHash4[:],
+			MaxParts:    1,
+		},
+	)
+	require.ErrorIs(t, err, ErrMaxHTLCExceeded)
+}
+
+// TestAccountMaxPayment makes sure that the max payment amount of an account
+// is enforced for keysend and invoice payments before the balance is checked.
+func TestAccountMaxPayment(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		keysend = map[uint64][]byte{
+			record.KeySendType: make([]byte, 32),
+		}
+		requestID uint64
+	)
+
+	nextRequestID := func() uint64 {
+		requestID++
+
+		return requestID
+	}
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	clock := clock.NewTestClock(time.Now())
+	store := NewTestDB(t, clock)
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+
+	acct, err := service.NewAccount(
+		ctx, 10000, clock.Now().Add(time.Hour), "test",
+		WithMaxPayment(2000),
+	)
+	require.NoError(t, err)
+
+	send := func(req *routerrpc.SendPaymentRequest) error {
+		ctx := AddRequestIDToContext(
+			AddAccountToContext(ctx, acct), nextRequestID(),
+		)
+		_, err := service.checkers.checkIncomingRequest(
+			ctx, "/routerrpc.Router/SendPaymentV2", req,
+		)
+
+		return err
+	}
+
+	// A keysend payment above the limit is rejected even though the
+	// balance would be sufficient.
+	err = send(&routerrpc.SendPaymentRequest{
+		AmtMsat:           3000,
+		PaymentHash:       testHash[:],
+		DestCustomRecords: keysend,
+	})
+	require.ErrorIs(t, err, ErrMaxPaymentExceeded)
+
+	// The limit is checked before the balance.
+	err = send(&routerrpc.SendPaymentRequest{
+		AmtMsat:           20000,
+		PaymentHash:       testHash[:],
+		DestCustomRecords: keysend,
+	})
+	require.ErrorIs(t, err, ErrMaxPaymentExceeded)
+
+	// A keysend payment of exactly the limit is accepted.
+	err = send(&routerrpc.SendPaymentRequest{
+		AmtMsat:           2000,
+		PaymentHash:       testHash[:],
+		DestCustomRecords: keysend,
+	})
+	require.NoError(t, err)
+
+	// The same goes for invoices, whose amount is taken from the invoice.
+	err = send(&routerrpc.SendPaymentRequest{
+		PaymentRequest: newTestInvoice(t, testHash2, 3000),
+	})
+	require.ErrorIs(t, err, ErrMaxPaymentExceeded)
+
+	err = send(&routerrpc.SendPaymentRequest{
+		PaymentRequest: newTestInvoice(t, testHash3, 2000),
+	})
+	require.NoError(t, err)
+
+	// Once the limit is removed, larger payments are accepted again.
+	err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+		MaxPayment: fn.Some[lnwire.MilliSatoshi](0),
+	})
+	require.NoError(t, err)
+	acct, err = store.Account(ctx, acct.ID)
+	require.NoError(t, err)
+
+	err = send(&routerrpc.SendPaymentRequest{
+		PaymentRequest: newTestInvoice(t, testHash4, 3000),
+	})
+	require.NoError(t, err)
+}
+
+// TestAccountKeysendBudget makes sure that keysend payments are charged to
+// the keysend budget of an account while other payments are not.
+func TestAccountKeysendBudget(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		keysend = map[uint64][]byte{
+			record.KeySendType: make([]byte, 32),
+		}
+		requestID uint64
+	)
+
+	nextRequestID := func() uint64 {
+		requestID++
+
+		return requestID
+	}
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	clock := clock.NewTestClock(time.Now())
+	store := NewTestDB(t, clock)
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+
+	service.checkers.keysend.clock = clock
+
+	acct, err := service.NewAccount(
+		ctx, 10000, clock.Now().Add(time.Hour), "test",
+		WithKeysendBudget(2000),
+	)
+	require.NoError(t, err)
+
+	ctxWithAcct := AddAccountToContext(ctx, acct)
+
+	// The first keysend payment fits into the budget.
+	ctx = AddRequestIDToContext(ctxWithAcct, nextRequestID())
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendPaymentV2",
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:           1500,
+			PaymentHash:       testHash[:],
+			DestCustomRecords: keysend,
+		},
+	)
+	require.NoError(t, err)
+
+	// A second one that exceeds the rest of the budget is rejected.
+	ctx = AddRequestIDToContext(ctxWithAcct, nextRequestID())
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendPaymentV2",
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:           1000,
+			PaymentHash:       testHash2[:],
+			DestCustomRecords: keysend,
+		},
+	)
+	require.ErrorIs(t, err, ErrKeysendBudgetExceeded)
+
+	// Payments that aren't keysend payments are not limited.
+	ctx = AddRequestIDToContext(ctxWithAcct, nextRequestID())
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendPaymentV2",
+		&routerrpc.SendPaymentRequest{
+			AmtMsat:     1000,
+			PaymentHash: testHash2[:],
+		},
+	)
+	require.NoError(t, err)
+
+	// A route that delivers a keysend preimage to the final hop is
+	// charged to the budget as well.
+	keysendRoute := &lnrpc.Route{
+		TotalAmtMsat: 1000,
+		Hops: []*lnrpc.Hop{{
+			CustomRecords: keysend,
+		}},
+	}
+	ctx = AddRequestIDToContext(ctxWithAcct, nextRequestID())
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendToRouteV2",
+		&routerrpc.SendToRouteRequest{
+			Route:       keysendRoute,
+			PaymentHash: testHash3[:],
+		},
+	)
+	require.ErrorIs(t, err, ErrKeysendBudgetExceeded)
+
+	// Once the budget has been refilled, the route is accepted.
+	clock.SetTime(clock.Now().Add(time.Second))
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/routerrpc.Router/SendToRouteV2",
+		&routerrpc.SendToRouteRequest{
+			Route:       keysendRoute,
+			PaymentHash: testHash3[:],
+		},
+	)
+	require.NoError(t, err)
+}
+
+// TestAccountAllowedDestinations makes sure that an account with allowed
+// destinations can only pay those nodes, and that payments whose destination
+// can't be determined are rejected.
+func TestAccountAllowedDestinations(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		keysend = map[uint64][]byte{
+			record.KeySendType: make([]byte, 32),
+		}
+		requestID uint64
+	)
+
+	nextRequestID := func() uint64 {
+		requestID++
+
+		return requestID
+	}
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	clock := clock.NewTestClock(time.Now())
+	store := NewTestDB(t, clock)
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+
+	allowedKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	allowed := allowedKey.PubKey().SerializeCompressed()
+	other := otherKey.PubKey().SerializeCompressed()
+
+	acct, err := service.NewAccount(
+		ctx, 100000, clock.Now().Add(time.Hour), "test",
+		WithAllowedDestinations([]route.Vertex{
+			route.NewVertex(allowedKey.PubKey()),
+		}),
+	)
+	require.NoError(t, err)
+
+	check := func(uri string, req proto.Message) error {
+		ctx := AddRequestIDToContext(
+			AddAccountToContext(ctx, acct), nextRequestID(),
+		)
+		_, err := service.checkers.checkIncomingRequest(ctx, uri, req)
+
+		return err
+	}
+
+	sendV2 := func(req *routerrpc.SendPaymentRequest) error {
+		return check("/routerrpc.Router/SendPaymentV2", req)
+	}
+
+	// Invoices of the allowed node can be paid, invoices of other nodes
+	// can't, even if the request states the allowed node.
+	err = sendV2(&routerrpc.SendPaymentRequest{
+		PaymentRequest: newTestInvoiceFrom(
+			t, allowedKey, testHash, 1000,
+		),
+	})
+	require.NoError(t, err)
+
+	err = sendV2(&routerrpc.SendPaymentRequest{
+		PaymentRequest: newTestInvoiceFrom(
+			t, otherKey, testHash2, 1000,
+		),
+		Dest: allowed,
+	})
+	require.ErrorIs(t, err, ErrDestinationNotAllowed)
+
+	// A keysend payment without a destination can't be verified.
+	err = sendV2(&routerrpc.SendPaymentRequest{
+		AmtMsat:           1000,
+		PaymentHash:       testHash2[:],
+		DestCustomRecords: keysend,
+	})
+	require.ErrorIs(t, err, ErrDestinationNotAllowed)
+
+	err = sendV2(&routerrpc.SendPaymentRequest{
+		AmtMsat:           1000,
+		PaymentHash:       testHash2[:],
+		Dest:              other,
+		DestCustomRecords: keysend,
+	})
+	require.ErrorIs(t, err, ErrDestinationNotAllowed)
+
+	err = sendV2(&routerrpc.SendPaymentRequest{
+		AmtMsat:           1000,
+		PaymentHash:       testHash2[:],
+		Dest:              allowed,
+		DestCustomRecords: keysend,
+	})
+	require.NoError(t, err)
+
+	// The destination can also be set as a hex string in the deprecated
+	// SendPayment call.
+	err = check("/lnrpc.Lightning/SendPaymentSync", &lnrpc.SendRequest{
+		AmtMsat:     1000,
+		PaymentHash: testHash3[:],
+		DestString:  hex.EncodeToString(allowed), // nolint
+	})
+	require.NoError(t, err)
+
+	// Routes are checked by their final hop.
+	sendToRoute := func(dest []byte) error {
+		return check(
+			"/routerrpc.Router/SendToRouteV2",
+			&routerrpc.SendToRouteRequest{
+				PaymentHash: testHash4[:],
+				Route: &lnrpc.Route{
+					TotalAmtMsat: 1000,
+					Hops: []*lnrpc.Hop{{
+						PubKey: hex.EncodeToString(
+							dest,
+						),
+					}},
+				},
+			},
+		)
+	}
+	require.ErrorIs(t, sendToRoute(other), ErrDestinationNotAllowed)
+	require.ErrorIs(t, sendToRoute(nil), ErrDestinationNotAllowed)
+	require.NoError(t, sendToRoute(allowed))
+}
+
+// TestAccountInvoiceLimits makes sure that an account can't create invoices
+// that are larger than its maximum invoice amount and can't have more open
+// invoices than its maximum.
+func TestAccountInvoiceLimits(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		requestID uint64
+	)
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	clock := clock.NewTestClock(time.Now())
+	store := NewTestDB(t, clock)
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+
+	acct, err := service.NewAccount(
+		ctx, 100000, clock.Now().Add(time.Hour), "test",
+		WithMaxOpenInvoices(2), WithMaxInvoiceAmount(5000),
+	)
+	require.NoError(t, err)
+
+	addInvoice := func(req *lnrpc.Invoice) error {
+		requestID++
+		ctx := AddRequestIDToContext(
+			AddAccountToContext(ctx, acct), requestID,
+		)
+		_, err := service.checkers.checkIncomingRequest(
+			ctx, "/lnrpc.Lightning/AddInvoice", req,
+		)
+
+		return err
+	}
+
+	assertOpen := func(openInvoices uint32) {
+		dbAcct, err := service.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Equal(t, openInvoices, dbAcct.OpenInvoices)
+	}
+
+	// Invoices up to the maximum amount can be created, larger invoices
+	// and invoices without an amount can't.
+	require.NoError(t, addInvoice(&lnrpc.Invoice{Value: 5}))
+	require.NoError(t, addInvoice(&lnrpc.Invoice{ValueMsat: 5000}))
+
+	err = addInvoice(&lnrpc.Invoice{Value: 6})
+	require.ErrorIs(t, err, ErrMaxInvoiceAmountExceeded)
+
+	err = addInvoice(&lnrpc.Invoice{ValueMsat: 5001})
+	require.ErrorIs(t, err, ErrMaxInvoiceAmountExceeded)
+
+	err = addInvoice(&lnrpc.Invoice{})
+	require.ErrorIs(t, err, ErrMaxInvoiceAmountExceeded)
+
+	// Once the account has the maximum number of open invoices, no more
+	// invoices can be created.
+	require.NoError(t, service.AssociateInvoice(ctx, acct.ID, testHash))
+	require.NoError(t, service.AssociateInvoice(ctx, acct.ID, testHash2))
+	assertOpen(2)
+
+	lndMock.pendingInvoices = []lndclient.Invoice{
+		{Hash: testHash}, {Hash: testHash2}, {Hash: testHash3},
+	}
+	err = addInvoice(&lnrpc.Invoice{Value: 1})
+	require.ErrorIs(t, err, ErrMaxOpenInvoicesExceeded)
+	assertOpen(2)
+
+	// lnd doesn't notify about canceled invoices, so they are only found
+	// when the open invoices are counted again.
+	lndMock.pendingInvoices = []lndclient.Invoice{{Hash: testHash2}}
+	require.NoError(t, addInvoice(&lnrpc.Invoice{Value: 1}))
+	assertOpen(1)
+
+	// A settled invoice is no longer open.
+	lndMock.invoiceChan <- &lndclient.Invoice{
+		AddIndex:    1,
+		SettleIndex: 1,
+		Hash:        testHash2,
+		AmountPaid:  1000,
+		State:       invpkg.ContractSettled,
+	}
+	assertEventually(t, func() bool {
+		dbAcct, err := service.Account(ctx, acct.ID)
+		require.NoError(t, err)
+
+		return dbAcct.OpenInvoices == 0
+	})
+	lndMock.assertNoMainErr(t)
+}
+
+// newTestInvoice creates a signed invoice for the given payment hash. If the
+// amount is zero, the invoice doesn't specify an amount.
+func newTestInvoice(t *testing.T, hash lntypes.Hash,
+	amt lnwire.MilliSatoshi) string {
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return newTestInvoiceFrom(t, privKey, hash, amt)
+}
+
+// newTestInvoiceFrom creates an invoice for the given payment hash that is
+// signed by the node with the given private key. If the amount is zero, the
+// invoice doesn't specify an amount.
+func newTestInvoiceFrom(t *testing.T, privKey *btcec.PrivateKey,
+	hash lntypes.Hash, amt lnwire.MilliSatoshi) string {
+
+	opts := []func(*zpay32.Invoice){zpay32.Description("test")}
+	if amt != 0 {
+		opts = append(opts, zpay32.Amount(amt))
+	}
+
+	invoice, err := zpay32.NewInvoice(chainParams, hash, time.Now(), opts...)
+	require.NoError(t, err)
+
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(msg []byte) ([]byte, error) {
+			hash := chainhash.HashB(msg)
+
+			return ecdsa.SignCompact(privKey, hash, true), nil
+		},
+	})
+	require.NoError(t, err)
+
+	return payReq
+}
+
+// TestAccountAmountlessInvoiceMax makes sure that payments to invoices without
+// an amount must state an amount within the account's limit, while invoices
+// with an amount aren't affected by the limit.
+func TestAccountAmountlessInvoiceMax(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		requestID uint64
+	)
+
+	nextRequestID := func() uint64 {
+		requestID++
+
+		return requestID
+	}
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	clock := clock.NewTestClock(time.Now())
+	store := NewTestDB(t, clock)
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+
+	limited, err := service.NewAccount(
+		ctx, 10000, clock.Now().Add(time.Hour), "limited",
+		WithAmountlessInvoiceMax(2000),
+	)
+	require.NoError(t, err)
+
+	unlimited, err := service.NewAccount(
+		ctx, 10000, clock.Now().Add(time.Hour), "unlimited",
+	)
+	require.NoError(t, err)
+
+	send := func(acct *OffChainBalanceAccount, invoice string,
+		amtMsat int64) error {
+
+		ctx := AddRequestIDToContext(
+			AddAccountToContext(ctx, acct), nextRequestID(),
+		)
+		_, err := service.checkers.checkIncomingRequest(
+			ctx, "/routerrpc.Router/SendPaymentV2",
+			&routerrpc.SendPaymentRequest{
+				PaymentRequest: invoice,
+				AmtMsat:        amtMsat,
+			},
+		)
+
+		return err
+	}
+
+	amountless := newTestInvoice(t, testHash, 0)
+
+	// Without an explicit amount, the payment is rejected.
+	err = send(limited, amountless, 0)
+	require.ErrorIs(t, err, ErrAmountlessInvoiceMaxExceeded)
+
+	// An amount above the limit is rejected as well.
+	err = send(limited, amountless, 3000)
+	require.ErrorIs(t, err, ErrAmountlessInvoiceMaxExceeded)
+
+	// An amount within the limit is accepted.
+	err = send(limited, amountless, 2000)
+	require.NoError(t, err)
+
+	// Invoices that specify an amount are only limited by the balance.
+	err = send(limited, newTestInvoice(t, testHash2, 5000), 0)
+	require.NoError(t, err)
+
+	// Accounts without a limit may pay any amount up to their balance.
+	err = send(unlimited, amountless, 5000)
+	require.NoError(t, err)
+}
+
+// TestAccountSoftCap makes sure that exceeding the soft cap of an account
+// never causes a payment to be rejected, and that the total spend is computed
+// correctly.
+func TestAccountSoftCap(t *testing.T) {
+	var (
+		ctx     = context.Background()
+		zeroFee = &lnrpc.FeeLimit{Limit: &lnrpc.FeeLimit_Fixed{
+			Fixed: 0,
+		}}
+	)
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	clock := clock.NewTestClock(time.Now())
+	store := NewTestDB(t, clock)
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+
+	acct, err := service.NewAccount(
+		ctx, 10000, clock.Now().Add(time.Hour), "test",
+		WithSoftCap(2000),
+	)
+	require.NoError(t, err)
+
+	// Without any payments, only the new amount counts.
+	total, exceeded := softCapSpend(acct, testHash, 1500)
+	require.EqualValues(t, 1500, total)
+	require.False(t, exceeded)
+
+	// In-flight and settled payments count towards the soft cap, failed
+	// ones and earlier attempts of the same payment don't.
+	acct.Payments = AccountPayments{
+		testHash2: {
+			Status:     lnrpc.Payment_SUCCEEDED,
+			FullAmount: 1000,
+		},
+		testHash3: {
+			Status:     lnrpc.Payment_FAILED,
+			FullAmount: 5000,
+		},
+		testHash: {
+			Status:     lnrpc.Payment_IN_FLIGHT,
+			FullAmount: 1500,
+		},
+	}
+	total, exceeded = softCapSpend(acct, testHash, 1500)
+	require.EqualValues(t, 2500, total)
+	require.True(t, exceeded)
+
+	// A payment that exceeds the soft cap, but not the balance, must still
+	// go through.
+	ctx = AddAccountToContext(ctx, acct)
+	ctx = AddRequestIDToContext(ctx, 1)
+	_, err = service.checkers.checkIncomingRequest(
+		ctx, "/lnrpc.Lightning/SendPaymentSync", &lnrpc.SendRequest{
+			AmtMsat:     3000,
+			PaymentHash: testHash4[:],
+			FeeLimit:    zeroFee,
+		},
+	)
+	require.NoError(t, err)
+
+	// An account without a soft cap is never over it.
+	acct.SoftCap = 0
+	_, exceeded = softCapSpend(acct, testHash4, 1_000_000)
+	require.False(t, exceeded)
+}
+
 // assertMessagesEqual makes sure two proto messages are equal by JSON
 // serializing them.
 func assertMessagesEqual(t *testing.T, expected, actual proto.Message) {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btclog/v2"
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightningnetwork/lnd/lntypes"
 )
 
 // ContextKey is the type that we use to identify account specific values in the
@@ -24,6 +26,14 @@ var (
 	// KeyRequestID is the key under which we store the middleware request
 	// ID.
 	KeyRequestID = ContextKey{"request_id"}
+
+	// KeyActor is the key under which we store the actor that is recorded
+	// in the account history for changes made by a request.
+	KeyActor = ContextKey{"actor"}
+
+	// KeyPaymentHash is the key under which we store the payment hash the
+	// macaroon of a request is restricted to.
+	KeyPaymentHash = ContextKey{"payment_hash"}
 )
 
 // FromContext tries to extract a value from the given context.
@@ -75,10 +85,44 @@ func RequestIDFromContext(ctx context.Context) (uint64, error) {
 	return reqID, nil
 }
 
+// AddActorToContext adds the given actor to the context so that it is recorded
+// in the history of any account the request changes.
+func AddActorToContext(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, KeyActor, actor)
+}
+
+// ActorFromContext returns the actor stored in the given context or the
+// default actor if there is none.
+func ActorFromContext(ctx context.Context) string {
+	actor, ok := FromContext(ctx, KeyActor).(string)
+	if !ok || actor == "" {
+		return defaultActor
+	}
+
+	return actor
+}
+
+// AddPaymentHashToContext adds the payment hash the macaroon of the request is
+// restricted to to the context.
+func AddPaymentHashToContext(ctx context.Context,
+	hash lntypes.Hash) context.Context {
+
+	return context.WithValue(ctx, KeyPaymentHash, hash)
+}
+
+// PaymentHashFromContext returns the payment hash the macaroon of the request
+// is restricted to, if any.
+func PaymentHashFromContext(ctx context.Context) (lntypes.Hash, bool) {
+	hash, ok := FromContext(ctx, KeyPaymentHash).(lntypes.Hash)
+
+	return hash, ok
+}
+
 // requestScopedValuesFromCtx is a helper function that can be used to extract
 // an account and requestID from the given context. It also creates a new
 // prefixed logger that can be used by account request and response handlers.
-// Each log line will be prefixed by the account ID and the request ID.
+// Each log line will be prefixed by the account ID and the request ID. The
+// account ID is hashed if the middleware manager is configured to do so.
 func requestScopedValuesFromCtx(ctx context.Context) (btclog.Logger,
 	*OffChainBalanceAccount, uint64, error) {
 
@@ -92,7 +136,9 @@ func requestScopedValuesFromCtx(ctx context.Context) (btclog.Logger,
 		return nil, nil, 0, err
 	}
 
-	prefix := fmt.Sprintf("[account: %s, request: %d]", acc.ID, reqID)
+	prefix := fmt.Sprintf(
+		"[account: %s, request: %d]", mid.LogID(ctx, acc.ID[:]), reqID,
+	)
 
 	return log.WithPrefix(prefix), acc, reqID, nil
 }
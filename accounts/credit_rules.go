@@ -0,0 +1,135 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+)
+
+// CreditRule maps a memo prefix to an account. A settled invoice that isn't
+// associated with any account but has a memo that starts with the prefix
+// credits the account of the rule.
+type CreditRule struct {
+	// MemoPrefix is the prefix the memo of an invoice must start with to
+	// match the rule.
+	MemoPrefix string
+
+	// AccountID is the ID of the account that is credited.
+	AccountID AccountID
+
+	// CreatedAt is the time at which the rule was added. Invoices that were
+	// settled before are never credited.
+	CreatedAt time.Time
+}
+
+// AddCreditRule adds a rule that credits the account with the given ID for
+// every settled invoice with a memo that starts with the given prefix.
+func (s *InterceptorService) AddCreditRule(ctx context.Context,
+	memoPrefix string, accountID AccountID) (*CreditRule, error) {
+
+	if memoPrefix == "" {
+		return nil, errors.New("memo prefix must not be empty")
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	rule := &CreditRule{
+		MemoPrefix: memoPrefix,
+		AccountID:  accountID,
+		CreatedAt:  time.Now(),
+	}
+	if err := s.store.AddCreditRule(ctx, rule); err != nil {
+		return nil, fmt.Errorf("unable to add credit rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+// CreditRules returns all credit rules, ordered by their memo prefix.
+func (s *InterceptorService) CreditRules(ctx context.Context) ([]*CreditRule,
+	error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.store.CreditRules(ctx)
+}
+
+// RemoveCreditRule removes the credit rule for the given memo prefix.
+func (s *InterceptorService) RemoveCreditRule(ctx context.Context,
+	memoPrefix string) error {
+
+	s.Lock()
+	defer s.Unlock()
+
+	return s.store.RemoveCreditRule(ctx, memoPrefix)
+}
+
+// creditByRule credits the settled invoice to the account of the credit rule
+// that matches its memo, if there is one.
+//
+// NOTE: The store lock must be held when calling this method. Just like in
+// invoiceUpdate, any error must disable the service.
+func (s *InterceptorService) creditByRule(ctx context.Context,
+	invoice *lndclient.Invoice) error {
+
+	rules, err := s.store.CreditRules(ctx)
+	if err != nil {
+		return s.disableAndErrorfUnsafe("error fetching credit "+
+			"rules: %w", err)
+	}
+
+	rule := matchCreditRule(rules, invoice.Memo)
+	if rule == nil {
+		return nil
+	}
+
+	// lnd only reports the settle date with a precision of seconds.
+	createdAt := rule.CreatedAt.Truncate(time.Second)
+	if invoice.SettleDate.Before(createdAt) {
+		return nil
+	}
+
+	err = s.store.CreditAccount(
+		ctx, rule.AccountID, invoice.AmountPaid,
+		WithCreditInvoice(invoice.Hash),
+	)
+	if err != nil {
+		return s.disableAndErrorfUnsafe("error crediting invoice "+
+			"%v by credit rule '%s': %w", invoice.Hash,
+			rule.MemoPrefix, err)
+	}
+
+	s.recordEvent(
+		ctx, rule.AccountID, AccountEventBalanceUpdated, "credited %v "+
+			"for invoice %v matching credit rule '%s'",
+		invoice.AmountPaid, invoice.Hash, rule.MemoPrefix,
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, rule.AccountID)
+
+	return nil
+}
+
+// matchCreditRule returns the rule with the longest memo prefix that the given
+// memo starts with, or nil if no rule matches.
+func matchCreditRule(rules []*CreditRule, memo string) *CreditRule {
+	var match *CreditRule
+	for _, rule := range rules {
+		if !strings.HasPrefix(memo, rule.MemoPrefix) {
+			continue
+		}
+
+		if match == nil ||
+			len(rule.MemoPrefix) > len(match.MemoPrefix) {
+
+			match = rule
+		}
+	}
+
+	return match
+}
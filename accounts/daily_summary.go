@@ -0,0 +1,311 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+)
+
+const (
+	// DefaultDailySummaryTime is the default time of day in UTC at which
+	// the daily spend summary is sent.
+	DefaultDailySummaryTime = "00:00"
+
+	// dailySummaryTimeFormat is the format of the time of day at which the
+	// daily spend summary is sent.
+	dailySummaryTimeFormat = "15:04"
+)
+
+// AccountSummary summarizes the spend of a single account within a summary
+// period.
+type AccountSummary struct {
+	// AccountID is the hex encoded ID of the account.
+	AccountID string `json:"account_id"`
+
+	// Label is the label of the account, if it has one.
+	Label string `json:"label,omitempty"`
+
+	// SpentMsat is the total amount in millisatoshis the account was
+	// debited by within the period, including routing fees and manual
+	// debits.
+	SpentMsat uint64 `json:"spent_msat"`
+
+	// NumPayments is the number of payments the account made within the
+	// period. Manual debits are not counted.
+	NumPayments uint64 `json:"num_payments"`
+
+	// ClosingBalanceMsat is the balance of the account in millisatoshis at
+	// the end of the period.
+	ClosingBalanceMsat int64 `json:"closing_balance_msat"`
+}
+
+// dailySummaryPayload is the JSON payload that is sent to the daily summary
+// webhook.
+type dailySummaryPayload struct {
+	// PeriodStart is the unix timestamp of the start of the period.
+	PeriodStart int64 `json:"period_start"`
+
+	// PeriodEnd is the unix timestamp of the end of the period.
+	PeriodEnd int64 `json:"period_end"`
+
+	// Accounts are the summaries of the accounts. If the summaries aren't
+	// aggregated, every payload contains a single account.
+	Accounts []*AccountSummary `json:"accounts"`
+}
+
+// accountSpend is the spend of an account that was collected since the last
+// summary was sent.
+type accountSpend struct {
+	spent       uint64
+	numPayments uint64
+}
+
+// ParseDailySummaryTime parses a time of day in UTC formatted as HH:MM and
+// returns it as the offset from midnight.
+func ParseDailySummaryTime(value string) (time.Duration, error) {
+	t, err := time.Parse(dailySummaryTimeFormat, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid daily summary time %q, expected "+
+			"HH:MM: %w", value, err)
+	}
+
+	return time.Duration(t.Hour())*time.Hour +
+		time.Duration(t.Minute())*time.Minute, nil
+}
+
+// DailySummaryNotifier is a SpendNotifier that collects the debits of all
+// accounts and sends a summary of the spend, the number of payments and the
+// closing balance of every account to an HTTP endpoint once a day. Compared to
+// the spend webhook, this sends a single digest instead of an event for every
+// debit.
+type DailySummaryNotifier struct {
+	url       string
+	timeOfDay time.Duration
+	aggregate bool
+	accounts  func(context.Context) ([]*OffChainBalanceAccount, error)
+	client    *http.Client
+	clock     clock.Clock
+
+	mu          sync.Mutex
+	spend       map[string]*accountSpend
+	periodStart time.Time
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile-time check to ensure that DailySummaryNotifier implements the
+// SpendNotifier interface.
+var _ SpendNotifier = (*DailySummaryNotifier)(nil)
+
+// NewDailySummaryNotifier creates a new notifier that POSTs the daily summary
+// as JSON to the given URL at the given offset from midnight UTC. The accounts
+// function is used to list all accounts with their closing balances when the
+// summary is sent. If aggregate is set, the summaries of all accounts are sent
+// in a single request, otherwise one request is made per account.
+func NewDailySummaryNotifier(url string, timeOfDay time.Duration,
+	aggregate bool, accounts func(context.Context) (
+		[]*OffChainBalanceAccount, error)) *DailySummaryNotifier {
+
+	return &DailySummaryNotifier{
+		url:       url,
+		timeOfDay: timeOfDay,
+		aggregate: aggregate,
+		accounts:  accounts,
+		client: &http.Client{
+			Timeout: spendWebhookTimeout,
+		},
+		clock: clock.NewDefaultClock(),
+		spend: make(map[string]*accountSpend),
+		quit:  make(chan struct{}),
+	}
+}
+
+// Start starts sending the daily summary. The first period starts now.
+func (d *DailySummaryNotifier) Start() {
+	d.mu.Lock()
+	d.periodStart = d.clock.Now()
+	d.mu.Unlock()
+
+	d.wg.Add(1)
+	go d.run()
+}
+
+// Stop sends the summary of the current, incomplete period and stops the
+// notifier, so that the spend since the last summary isn't lost.
+func (d *DailySummaryNotifier) Stop() {
+	close(d.quit)
+	d.wg.Wait()
+
+	d.sendSummary()
+}
+
+// AccountDebited adds the debit to the spend of the account in the current
+// period.
+//
+// NOTE: This is part of the SpendNotifier interface.
+func (d *DailySummaryNotifier) AccountDebited(event *DebitEvent) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	spend, ok := d.spend[event.AccountID]
+	if !ok {
+		spend = &accountSpend{}
+		d.spend[event.AccountID] = spend
+	}
+
+	spend.spent += event.AmountMsat
+	if event.PaymentHash != "" {
+		spend.numPayments++
+	}
+}
+
+// run sends the summary every day at the configured time until the notifier
+// is stopped.
+func (d *DailySummaryNotifier) run() {
+	defer d.wg.Done()
+
+	for {
+		now := d.clock.Now()
+		next := nextDailySummary(now, d.timeOfDay)
+
+		select {
+		case <-d.clock.TickAfter(next.Sub(now)):
+			d.sendSummary()
+
+		case <-d.quit:
+			return
+		}
+	}
+}
+
+// nextDailySummary returns the first time after now at which the summary is
+// due, given its offset from midnight UTC.
+func nextDailySummary(now time.Time, timeOfDay time.Duration) time.Time {
+	now = now.UTC()
+	midnight := time.Date(
+		now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC,
+	)
+
+	next := midnight.Add(timeOfDay)
+	if !next.After(now) {
+		next = midnight.AddDate(0, 0, 1).Add(timeOfDay)
+	}
+
+	return next
+}
+
+// sendSummary sends the summary of the period that ends now and starts a new
+// period.
+func (d *DailySummaryNotifier) sendSummary() {
+	d.mu.Lock()
+	spend := d.spend
+	periodStart := d.periodStart
+	periodEnd := d.clock.Now()
+	d.spend = make(map[string]*accountSpend)
+	d.periodStart = periodEnd
+	d.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), spendWebhookTimeout,
+	)
+	defer cancel()
+
+	accounts, err := d.accounts(ctx)
+	if err != nil {
+		log.Errorf("Unable to list accounts for daily summary: %v", err)
+		return
+	}
+
+	summaries := summarizeSpend(accounts, spend)
+	if len(summaries) == 0 {
+		return
+	}
+
+	payloads := []*dailySummaryPayload{{
+		PeriodStart: periodStart.Unix(),
+		PeriodEnd:   periodEnd.Unix(),
+		Accounts:    summaries,
+	}}
+	if !d.aggregate {
+		payloads = payloads[:0]
+		for _, summary := range summaries {
+			payloads = append(payloads, &dailySummaryPayload{
+				PeriodStart: periodStart.Unix(),
+				PeriodEnd:   periodEnd.Unix(),
+				Accounts:    []*AccountSummary{summary},
+			})
+		}
+	}
+
+	for _, payload := range payloads {
+		if err := d.send(payload); err != nil {
+			log.Errorf("Unable to send daily summary of %d "+
+				"account(s): %v", len(payload.Accounts), err)
+		}
+	}
+}
+
+// summarizeSpend creates the summaries of the given accounts from the spend
+// that was collected for them. Accounts without any spend are included with
+// their closing balance so that external ledgers see every account. Spend of
+// accounts that have been removed since is dropped.
+func summarizeSpend(accounts []*OffChainBalanceAccount,
+	spend map[string]*accountSpend) []*AccountSummary {
+
+	summaries := make([]*AccountSummary, 0, len(accounts))
+	for _, acct := range accounts {
+		summary := &AccountSummary{
+			AccountID:          acct.ID.String(),
+			Label:              acct.Label,
+			ClosingBalanceMsat: acct.CurrentBalance,
+		}
+		if s, ok := spend[summary.AccountID]; ok {
+			summary.SpentMsat = s.spent
+			summary.NumPayments = s.numPayments
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries
+}
+
+// send POSTs the given payload to the webhook.
+func (d *DailySummaryNotifier) send(payload *dailySummaryPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), spendWebhookTimeout,
+	)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, d.url, bytes.NewReader(body),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package accounts
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNextDailySummary tests that the time of the next daily summary is the
+// next occurrence of the configured time of day.
+func TestNextDailySummary(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseDailySummaryTime("25:00")
+	require.Error(t, err)
+
+	timeOfDay, err := ParseDailySummaryTime("18:30")
+	require.NoError(t, err)
+	require.Equal(t, 18*time.Hour+30*time.Minute, timeOfDay)
+
+	day := time.Date(2024, 2, 28, 0, 0, 0, 0, time.UTC)
+
+	// Before the time of day, the summary is due on the same day.
+	next := nextDailySummary(day.Add(time.Hour), timeOfDay)
+	require.Equal(t, day.Add(timeOfDay), next)
+
+	// At or after the time of day, the summary is due on the next day.
+	next = nextDailySummary(day.Add(timeOfDay), timeOfDay)
+	require.Equal(t, day.AddDate(0, 0, 1).Add(timeOfDay), next)
+
+	// The time of day is always in UTC.
+	local := day.Add(20 * time.Hour).In(time.FixedZone("UTC+5", 5*3600))
+	next = nextDailySummary(local, timeOfDay)
+	require.Equal(t, day.AddDate(0, 0, 1).Add(timeOfDay), next)
+}
+
+// TestDailySummaryNotifier tests that the spend of all accounts is summarized
+// and sent to the webhook once a day.
+func TestDailySummaryNotifier(t *testing.T) {
+	t.Parallel()
+
+	payloads := make(chan *dailySummaryPayload, 10)
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+
+			var payload dailySummaryPayload
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			require.NoError(t, err)
+
+			payloads <- &payload
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	accounts := []*OffChainBalanceAccount{{
+		ID:             AccountID{1},
+		Label:          "first",
+		CurrentBalance: 5000,
+	}, {
+		ID:             AccountID{2},
+		CurrentBalance: 7000,
+	}}
+	listAccounts := func(context.Context) ([]*OffChainBalanceAccount,
+		error) {
+
+		return accounts, nil
+	}
+
+	receive := func() *dailySummaryPayload {
+		select {
+		case payload := <-payloads:
+			return payload
+
+		case <-time.After(testTimeout):
+			t.Fatalf("No payload received")
+			return nil
+		}
+	}
+
+	start := time.Date(2024, 2, 28, 12, 0, 0, 0, time.UTC)
+	tickSignal := make(chan time.Duration, 10)
+	testClock := clock.NewTestClockWithTickSignal(start, tickSignal)
+
+	notifier := NewDailySummaryNotifier(srv.URL, 0, true, listAccounts)
+	notifier.clock = testClock
+	notifier.Start()
+
+	first := AccountID{1}.String()
+	notifier.AccountDebited(&DebitEvent{
+		AccountID: first, AmountMsat: 1000, PaymentHash: "aa",
+	})
+	notifier.AccountDebited(&DebitEvent{
+		AccountID: first, AmountMsat: 2000, PaymentHash: "bb",
+	})
+	notifier.AccountDebited(&DebitEvent{
+		AccountID: first, AmountMsat: 500, Memo: "manual",
+	})
+
+	// The summary is sent at midnight and contains all accounts, including
+	// the ones without spend.
+	midnight := time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+	select {
+	case wait := <-tickSignal:
+		require.Equal(t, 12*time.Hour, wait)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("Summary not scheduled")
+	}
+	testClock.SetTime(midnight)
+
+	payload := receive()
+	require.Equal(t, start.Unix(), payload.PeriodStart)
+	require.Equal(t, midnight.Unix(), payload.PeriodEnd)
+	require.Equal(t, []*AccountSummary{{
+		AccountID:          first,
+		Label:              "first",
+		SpentMsat:          3500,
+		NumPayments:        2,
+		ClosingBalanceMsat: 5000,
+	}, {
+		AccountID:          AccountID{2}.String(),
+		ClosingBalanceMsat: 7000,
+	}}, payload.Accounts)
+
+	// The spend is reset for the next period, and the summary of the
+	// incomplete period is sent when the notifier is stopped.
+	notifier.AccountDebited(&DebitEvent{
+		AccountID: AccountID{2}.String(), AmountMsat: 100,
+		PaymentHash: "cc",
+	})
+	testClock.SetTime(midnight.Add(time.Hour))
+	notifier.Stop()
+
+	payload = receive()
+	require.Equal(t, midnight.Unix(), payload.PeriodStart)
+	require.Equal(t, midnight.Add(time.Hour).Unix(), payload.PeriodEnd)
+	require.Len(t, payload.Accounts, 2)
+	require.Zero(t, payload.Accounts[0].SpentMsat)
+	require.EqualValues(t, 100, payload.Accounts[1].SpentMsat)
+	require.EqualValues(t, 1, payload.Accounts[1].NumPayments)
+
+	// If the summaries aren't aggregated, every account is sent in its own
+	// request.
+	notifier = NewDailySummaryNotifier(srv.URL, 0, false, listAccounts)
+	notifier.Start()
+	notifier.Stop()
+
+	for _, acct := range accounts {
+		payload = receive()
+		require.Len(t, payload.Accounts, 1)
+		require.Equal(
+			t, acct.ID.String(), payload.Accounts[0].AccountID,
+		)
+	}
+}
@@ -0,0 +1,149 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/record"
+)
+
+const (
+	// DefaultDepositMemoPrefix is the default prefix of the memo of a
+	// deposit invoice, which is followed by the hex encoded ID of the
+	// account that is credited.
+	DefaultDepositMemoPrefix = "lit-deposit:"
+)
+
+// DepositTag describes how a settled invoice that isn't associated with any
+// account is tagged with the ID of the account it credits, which allows an
+// account holder to top up their own balance by paying an invoice to the node.
+type DepositTag struct {
+	// MemoPrefix is the prefix of the memo of a deposit invoice. It must
+	// be followed by the hex encoded ID of the account, which can be
+	// followed by a space and any other text.
+	MemoPrefix string
+
+	// RecordType is the type of the custom record that carries the ID of
+	// the account in the HTLCs that settle a deposit invoice. If it is
+	// zero, custom records are ignored.
+	RecordType uint64
+}
+
+// Validate checks that the tag has a memo prefix and that the custom record
+// type, if set, is in the custom record range.
+func (t DepositTag) Validate() error {
+	if t.MemoPrefix == "" {
+		return errors.New("deposit memo prefix must not be empty")
+	}
+
+	if t.RecordType != 0 && t.RecordType < record.CustomTypeStart {
+		return fmt.Errorf("deposit record type must be at least %d",
+			record.CustomTypeStart)
+	}
+
+	return nil
+}
+
+// accountID returns the ID of the account the given invoice is tagged with.
+// The custom record of the HTLCs takes precedence over the memo, as it is set
+// by the payer.
+func (t DepositTag) accountID(invoice *lndclient.Invoice) (AccountID, bool) {
+	if t.RecordType != 0 {
+		for _, htlc := range invoice.Htlcs {
+			if htlc.State != lnrpc.InvoiceHTLCState_SETTLED {
+				continue
+			}
+
+			value, ok := htlc.CustomRecords[t.RecordType]
+			if !ok || len(value) != AccountIDLen {
+				continue
+			}
+
+			var id AccountID
+			copy(id[:], value)
+
+			return id, true
+		}
+	}
+
+	if !strings.HasPrefix(invoice.Memo, t.MemoPrefix) {
+		return AccountID{}, false
+	}
+
+	fields := strings.Fields(strings.TrimPrefix(
+		invoice.Memo, t.MemoPrefix,
+	))
+	if len(fields) == 0 {
+		return AccountID{}, false
+	}
+
+	id, err := ParseAccountID(fields[0])
+	if err != nil {
+		return AccountID{}, false
+	}
+
+	return *id, true
+}
+
+// WithDepositTag is a functional option that can be passed to NewService to
+// credit settled invoices that are tagged with the ID of an account according
+// to the given tag to that account.
+func WithDepositTag(tag DepositTag) ServiceOption {
+	return func(s *InterceptorService) {
+		s.depositTag = &tag
+	}
+}
+
+// creditDeposit credits the settled invoice to the account it is tagged with,
+// if deposits are enabled and the invoice is tagged with the ID of an existing
+// account. It returns false if the invoice isn't a deposit.
+//
+// NOTE: The store lock must be held when calling this method. Just like in
+// invoiceUpdate, any error must disable the service.
+func (s *InterceptorService) creditDeposit(ctx context.Context,
+	invoice *lndclient.Invoice) (bool, error) {
+
+	if s.depositTag == nil {
+		return false, nil
+	}
+
+	id, ok := s.depositTag.accountID(invoice)
+	if !ok {
+		return false, nil
+	}
+
+	// Anyone can pay an invoice with a custom record, so the ID of an
+	// account that doesn't exist must not disable the service.
+	_, err := s.store.Account(ctx, id)
+	switch {
+	case errors.Is(err, ErrAccNotFound):
+		log.Warnf("Not crediting deposit invoice %v to unknown "+
+			"account %x", invoice.Hash, id[:])
+
+		return false, nil
+
+	case err != nil:
+		return true, s.disableAndErrorfUnsafe("error fetching "+
+			"account of deposit invoice %v: %w", invoice.Hash, err)
+	}
+
+	err = s.store.CreditAccount(
+		ctx, id, invoice.AmountPaid, WithCreditInvoice(invoice.Hash),
+	)
+	if err != nil {
+		return true, s.disableAndErrorfUnsafe("error crediting "+
+			"deposit invoice %v: %w", invoice.Hash, err)
+	}
+
+	s.recordEvent(
+		ctx, id, AccountEventBalanceUpdated, "credited %v for deposit "+
+			"invoice %v", invoice.AmountPaid, invoice.Hash,
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, id)
+
+	return true, nil
+}
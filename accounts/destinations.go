@@ -0,0 +1,147 @@
+package accounts
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// normalizeDestinations returns the given destinations sorted and without
+// duplicates, so that they are stored the same way by all store backends. It
+// returns nil if there are no destinations.
+func normalizeDestinations(dests []route.Vertex) []route.Vertex {
+	if len(dests) == 0 {
+		return nil
+	}
+
+	sorted := make([]route.Vertex, len(dests))
+	copy(sorted, dests)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i][:], sorted[j][:]) < 0
+	})
+
+	unique := sorted[:1]
+	for _, dest := range sorted[1:] {
+		if dest != unique[len(unique)-1] {
+			unique = append(unique, dest)
+		}
+	}
+
+	return unique
+}
+
+// serializeDestinations concatenates the given destinations for storage.
+func serializeDestinations(dests []route.Vertex) []byte {
+	result := make([]byte, 0, len(dests)*route.VertexSize)
+	for _, dest := range dests {
+		result = append(result, dest[:]...)
+	}
+
+	return result
+}
+
+// deserializeDestinations splits the concatenated destinations that were
+// written by serializeDestinations.
+func deserializeDestinations(raw []byte) ([]route.Vertex, error) {
+	if len(raw)%route.VertexSize != 0 {
+		return nil, fmt.Errorf("invalid allowed destinations length %d",
+			len(raw))
+	}
+
+	var dests []route.Vertex
+	for len(raw) > 0 {
+		var dest route.Vertex
+		copy(dest[:], raw[:route.VertexSize])
+		dests = append(dests, dest)
+
+		raw = raw[route.VertexSize:]
+	}
+
+	return dests, nil
+}
+
+// describeAllowedDestinations returns a description of the given allowed
+// destinations for the account history.
+func describeAllowedDestinations(dests []route.Vertex) string {
+	if len(dests) == 0 {
+		return "allowed destinations removed"
+	}
+
+	return fmt.Sprintf("allowed destinations set to %d nodes", len(dests))
+}
+
+// IsDestinationAllowed returns true if the account may send payments to the
+// node with the given public key.
+func (a *OffChainBalanceAccount) IsDestinationAllowed(dest route.Vertex) bool {
+	if len(a.AllowedDestinations) == 0 {
+		return true
+	}
+
+	for _, allowed := range a.AllowedDestinations {
+		if allowed == dest {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkDestination makes sure that the account may pay the given destination,
+// which is the serialized public key of the node. An empty destination means
+// that it can't be determined from the request, in which case the payment is
+// only allowed if the account may pay any node.
+func checkDestination(acct *OffChainBalanceAccount, dest []byte) error {
+	if len(acct.AllowedDestinations) == 0 {
+		return nil
+	}
+
+	if len(dest) == 0 {
+		return fmt.Errorf("%w: the destination of the payment is "+
+			"unknown", ErrDestinationNotAllowed)
+	}
+
+	vertex, err := route.NewVertexFromBytes(dest)
+	if err != nil {
+		return fmt.Errorf("invalid payment destination: %w", err)
+	}
+
+	if !acct.IsDestinationAllowed(vertex) {
+		return fmt.Errorf("%w: %v", ErrDestinationNotAllowed, vertex)
+	}
+
+	return nil
+}
+
+// sendRequestDest returns the destination that was set in the given send
+// request, either as raw bytes or, for older clients, as a hex string.
+func sendRequestDest(r *lnrpc.SendRequest) ([]byte, error) {
+	if len(r.Dest) != 0 || r.DestString == "" { // nolint
+		return r.Dest, nil
+	}
+
+	dest, err := hex.DecodeString(r.DestString) // nolint
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment destination: %w", err)
+	}
+
+	return dest, nil
+}
+
+// routeDest returns the public key of the final hop of the given route, or nil
+// if the route has no hops.
+func routeDest(r *lnrpc.Route) ([]byte, error) {
+	if len(r.Hops) == 0 {
+		return nil, nil
+	}
+
+	dest, err := hex.DecodeString(r.Hops[len(r.Hops)-1].PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid route destination: %w", err)
+	}
+
+	return dest, nil
+}
@@ -21,4 +21,66 @@ var (
 	ErrPaymentNotAssociated = errors.New(
 		"payment not associated with account",
 	)
+
+	// ErrAccountVersionConflict is returned by UpdateAccount if the
+	// account was changed since the version the caller expected.
+	ErrAccountVersionConflict = errors.New("account version conflict")
+
+	// ErrCreditRuleExists is returned when a credit rule is added for a
+	// memo prefix that is already mapped to an account.
+	ErrCreditRuleExists = errors.New(
+		"credit rule for memo prefix already exists",
+	)
+
+	// ErrCreditRuleNotFound is returned when a credit rule that doesn't
+	// exist is removed.
+	ErrCreditRuleNotFound = errors.New("credit rule not found")
+
+	// ErrPaymentHashNotAllowed is returned when a macaroon that is
+	// restricted to a single payment is used to pay a different one.
+	ErrPaymentHashNotAllowed = errors.New(
+		"payment hash not allowed by macaroon",
+	)
+
+	// ErrSweepToSelf is returned when the balance of an account that is
+	// removed should be swept to the account itself.
+	ErrSweepToSelf = errors.New("cannot sweep an account to itself")
+
+	// ErrTransferToSelf is returned when balance should be transferred
+	// from an account to the account itself.
+	ErrTransferToSelf = errors.New("cannot transfer balance from an " +
+		"account to itself")
+
+	// ErrAccountAlreadyExists is returned when an account is imported with
+	// the ID of an existing account and overwriting wasn't requested.
+	ErrAccountAlreadyExists = errors.New("account already exists")
+
+	// ErrRootKeyIDInUse is returned when the macaroon root key ID of an
+	// account is shared with another account or a session.
+	ErrRootKeyIDInUse = errors.New("macaroon root key ID already in use")
+
+	// ErrTopUpSourceInsufficient is returned when an account can't be
+	// topped up because the top-up source account doesn't have enough
+	// balance.
+	ErrTopUpSourceInsufficient = errors.New("insufficient balance in " +
+		"top-up source account")
+
+	// ErrResumeTokenExpired is returned when a subscription to the account
+	// lifecycle events can't be resumed because some of the events after
+	// the resume token are no longer known. The subscriber must list all
+	// accounts again instead.
+	ErrResumeTokenExpired = errors.New("account lifecycle resume token " +
+		"expired")
+
+	// ErrLifecycleSubscriberLagged is returned when a subscription to the
+	// account lifecycle events is ended because the subscriber didn't keep
+	// up with the events.
+	ErrLifecycleSubscriberLagged = errors.New("account lifecycle " +
+		"subscriber fell behind, resume with the last resume token")
+
+	// ErrExpirationExceedsMax is returned when an account is created or
+	// updated with an expiration date that is further in the future than
+	// the configured max expiration allows.
+	ErrExpirationExceedsMax = errors.New("account expiration exceeds " +
+		"the max expiration")
 )
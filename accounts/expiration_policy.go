@@ -0,0 +1,89 @@
+package accounts
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ExpirationPolicy restricts the expiration dates of the accounts that are
+// created or updated through the accounts RPCs.
+type ExpirationPolicy struct {
+	// Default is the time after creation at which an account expires if
+	// it is created without an expiration date. If it is zero, such
+	// accounts never expire.
+	Default time.Duration
+
+	// Max is the maximum time from now that the expiration date of an
+	// account can be set to. If it is set, accounts that never expire
+	// are rejected as well. If it is zero, there is no maximum.
+	Max time.Duration
+}
+
+// Validate checks that the durations of the policy aren't negative and that
+// the default doesn't exceed the maximum.
+func (p ExpirationPolicy) Validate() error {
+	if p.Default < 0 {
+		return errors.New("default expiration must not be negative")
+	}
+
+	if p.Max < 0 {
+		return errors.New("max expiration must not be negative")
+	}
+
+	if p.Max != 0 && p.Default > p.Max {
+		return fmt.Errorf("default expiration %v exceeds the max "+
+			"expiration %v", p.Default, p.Max)
+	}
+
+	return nil
+}
+
+// newAccountExpiration returns the expiration date of a new account that was
+// requested with the given expiration date, where the zero time means that
+// the account never expires. The default expiration is applied if no
+// expiration date was requested.
+func (p ExpirationPolicy) newAccountExpiration(now,
+	expiration time.Time) (time.Time, error) {
+
+	if expiration.IsZero() && p.Default != 0 {
+		expiration = now.Add(p.Default)
+	}
+
+	if err := p.check(now, expiration); err != nil {
+		return time.Time{}, err
+	}
+
+	return expiration, nil
+}
+
+// check returns ErrExpirationExceedsMax if the given expiration date, where the
+// zero time means that the account never expires, is further in the future
+// than the max expiration allows.
+func (p ExpirationPolicy) check(now, expiration time.Time) error {
+	if p.Max == 0 {
+		return nil
+	}
+
+	if expiration.IsZero() {
+		return fmt.Errorf("%w: accounts must expire within %v",
+			ErrExpirationExceedsMax, p.Max)
+	}
+
+	if expiration.After(now.Add(p.Max)) {
+		return fmt.Errorf("%w: expiration date %v is more than %v "+
+			"from now", ErrExpirationExceedsMax,
+			expiration.Format(time.RFC3339), p.Max)
+	}
+
+	return nil
+}
+
+// WithExpirationPolicy is a functional option that can be passed to NewService
+// to restrict the expiration dates of the accounts that are created or updated
+// through the accounts RPCs.
+func WithExpirationPolicy(policy ExpirationPolicy) ServiceOption {
+	return func(s *InterceptorService) {
+		s.expirationPolicy = policy
+	}
+}
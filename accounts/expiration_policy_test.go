@@ -0,0 +1,174 @@
+package accounts
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// TestExpirationPolicy tests that the default expiration is applied to new
+// accounts without an expiration date and that the max expiration is enforced.
+func TestExpirationPolicy(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now      = time.Unix(1_700_000_000, 0)
+		day      = 24 * time.Hour
+		inOneDay = now.Add(day)
+	)
+
+	tests := []struct {
+		name       string
+		policy     ExpirationPolicy
+		expiration time.Time
+		expected   time.Time
+		expectErr  bool
+	}{{
+		name:       "no policy",
+		expiration: time.Time{},
+		expected:   time.Time{},
+	}, {
+		name:       "default applied",
+		policy:     ExpirationPolicy{Default: day},
+		expiration: time.Time{},
+		expected:   inOneDay,
+	}, {
+		name:       "explicit expiration kept",
+		policy:     ExpirationPolicy{Default: 2 * day, Max: 3 * day},
+		expiration: inOneDay,
+		expected:   inOneDay,
+	}, {
+		name:       "at max",
+		policy:     ExpirationPolicy{Max: day},
+		expiration: inOneDay,
+		expected:   inOneDay,
+	}, {
+		name:       "beyond max",
+		policy:     ExpirationPolicy{Max: day},
+		expiration: inOneDay.Add(time.Second),
+		expectErr:  true,
+	}, {
+		name:       "never expires with max",
+		policy:     ExpirationPolicy{Max: day},
+		expiration: time.Time{},
+		expectErr:  true,
+	}, {
+		name:       "default within max",
+		policy:     ExpirationPolicy{Default: day, Max: day},
+		expiration: time.Time{},
+		expected:   inOneDay,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.NoError(t, test.policy.Validate())
+
+			expiration, err := test.policy.newAccountExpiration(
+				now, test.expiration,
+			)
+			if test.expectErr {
+				require.ErrorIs(t, err, ErrExpirationExceedsMax)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, test.expected.Equal(expiration))
+		})
+	}
+
+	// The default must not exceed the max.
+	err := ExpirationPolicy{Default: 2 * day, Max: day}.Validate()
+	require.Error(t, err)
+
+	err = ExpirationPolicy{Default: -day}.Validate()
+	require.Error(t, err)
+}
+
+// TestExpirationPolicyRPC tests that the RPC server applies the expiration
+// policy to created and updated accounts.
+func TestExpirationPolicyRPC(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := NewTestDB(t, clock.NewDefaultClock())
+	service, err := NewService(
+		store, func(error) {}, WithExpirationPolicy(ExpirationPolicy{
+			Default: time.Hour,
+			Max:     24 * time.Hour,
+		}),
+	)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, newMockLnd(), newMockRouter(), chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	baker := func(context.Context, uint64, []bakery.Op,
+		[]macaroon.Caveat) (string, error) {
+
+		mac, err := macaroon.New(
+			[]byte("root key"), []byte("id"), "lnd",
+			macaroon.LatestVersion,
+		)
+		if err != nil {
+			return "", err
+		}
+		macBytes, err := mac.MarshalBinary()
+		if err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(macBytes), nil
+	}
+	server := NewRPCServer(service, baker, nil)
+
+	// An account without an expiration date gets the default one, which
+	// is returned in the response.
+	start := time.Now()
+	resp, err := server.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: 1000,
+	})
+	require.NoError(t, err)
+	require.InDelta(
+		t, start.Add(time.Hour).Unix(), resp.Account.ExpirationDate, 5,
+	)
+
+	_, err = server.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: 1000,
+		ExpirationDate: time.Now().Add(48 * time.Hour).Unix(),
+	})
+	require.ErrorIs(t, err, ErrExpirationExceedsMax)
+
+	// Updates can neither remove the expiration date nor move it beyond
+	// the max, but other fields can still be updated without touching it.
+	_, err = server.UpdateAccount(ctx, &litrpc.UpdateAccountRequest{
+		Id:             resp.Account.Id,
+		ExpirationDate: 0,
+	})
+	require.ErrorIs(t, err, ErrExpirationExceedsMax)
+
+	_, err = server.UpdateAccount(ctx, &litrpc.UpdateAccountRequest{
+		Id:             resp.Account.Id,
+		ExpirationDate: time.Now().Add(48 * time.Hour).Unix(),
+	})
+	require.ErrorIs(t, err, ErrExpirationExceedsMax)
+
+	acct, err := server.UpdateAccount(ctx, &litrpc.UpdateAccountRequest{
+		Id:             resp.Account.Id,
+		ExpirationDate: -1,
+		MaxHtlcSat:     100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, resp.Account.ExpirationDate, acct.ExpirationDate)
+}
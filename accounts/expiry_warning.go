@@ -0,0 +1,159 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// describeExpiryWarning returns a description of the given expiry warning lead
+// time for the account history.
+func describeExpiryWarning(warning time.Duration) string {
+	if warning == 0 {
+		return "expiry warning removed"
+	}
+
+	return fmt.Sprintf("expiry warning set to %v before expiry", warning)
+}
+
+// expiryWarningTime returns the time at which the expiry warning of the
+// account is due. It returns false if the account has no expiry warning or
+// never expires.
+func (a *OffChainBalanceAccount) expiryWarningTime() (time.Time, bool) {
+	if a.ExpiryWarning <= 0 || a.ExpirationDate.IsZero() {
+		return time.Time{}, false
+	}
+
+	return a.ExpirationDate.Add(-a.ExpiryWarning), true
+}
+
+// IsExpiringSoon returns true if the account has reached its expiry warning
+// lead time but hasn't expired yet.
+func (a *OffChainBalanceAccount) IsExpiringSoon() bool {
+	warnAt, ok := a.expiryWarningTime()
+	if !ok || a.HasExpired() {
+		return false
+	}
+
+	return !time.Now().Before(warnAt)
+}
+
+// rescheduleExpiryWarnings lets the expiry warning loop know that the
+// expiration date or the expiry warning of an account might have changed, so
+// that it re-arms its timer. It never blocks.
+func (s *InterceptorService) rescheduleExpiryWarnings() {
+	select {
+	case s.expiryWarningReschedule <- struct{}{}:
+	default:
+	}
+}
+
+// expiryWarningLoop emits an AccountLifecycleExpiringSoon event once for every
+// account that reaches its expiry warning lead time. Instead of waiting on a
+// timer per account, a single timer is armed for the earliest warning that is
+// due across all accounts, and it is re-armed whenever an account changes.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *InterceptorService) expiryWarningLoop(ctx context.Context) {
+	defer s.wg.Done()
+
+	// warned holds the expiration date each account was last warned
+	// about, so that the warning is emitted again if the expiration date
+	// is moved.
+	warned := make(map[AccountID]time.Time)
+
+	// The timer fires right away, so that accounts which reached their
+	// lead time while litd was down are warned about on startup.
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+
+		case <-s.expiryWarningReschedule:
+			timer.Stop()
+
+		case <-ctx.Done():
+			return
+
+		case <-s.quit:
+			return
+		}
+
+		next, err := s.emitExpiryWarnings(ctx, warned)
+		if err != nil {
+			log.Errorf("Unable to check accounts for expiry "+
+				"warnings: %v", err)
+
+			next = time.Now().Add(time.Minute)
+		}
+
+		if !next.IsZero() {
+			timer.Reset(time.Until(next))
+		}
+	}
+}
+
+// emitExpiryWarnings emits an AccountLifecycleExpiringSoon event for every
+// account that reached its expiry warning lead time and wasn't warned about
+// its current expiration date yet. It returns the time at which the next
+// warning is due, or the zero time if no warning is pending.
+func (s *InterceptorService) emitExpiryWarnings(ctx context.Context,
+	warned map[AccountID]time.Time) (time.Time, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	if !s.isRunningUnsafe() {
+		return time.Time{}, nil
+	}
+
+	accts, err := s.store.Accounts(ctx)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var (
+		now  = time.Now()
+		next time.Time
+		seen = make(map[AccountID]struct{}, len(accts))
+	)
+	for _, acct := range accts {
+		seen[acct.ID] = struct{}{}
+
+		warnAt, ok := acct.expiryWarningTime()
+		if !ok || acct.HasExpired() {
+			continue
+		}
+
+		if now.Before(warnAt) {
+			if next.IsZero() || warnAt.Before(next) {
+				next = warnAt
+			}
+
+			continue
+		}
+
+		if warned[acct.ID].Equal(acct.ExpirationDate) {
+			continue
+		}
+		warned[acct.ID] = acct.ExpirationDate
+
+		log.Infof("Account %x expires soon, at %v", acct.ID[:],
+			acct.ExpirationDate)
+
+		s.publishLifecycleEvent(
+			ctx, AccountLifecycleExpiringSoon, acct.ID,
+		)
+	}
+
+	// Forget about accounts that were removed.
+	for id := range warned {
+		if _, ok := seen[id]; !ok {
+			delete(warned, id)
+		}
+	}
+
+	return next, nil
+}
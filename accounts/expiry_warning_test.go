@@ -0,0 +1,100 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// TestExpiryWarning tests that an expiring soon event is delivered once an
+// account reaches its expiry warning lead time, both when it is reached later
+// on and when the account is already within the lead time when subscribing.
+func TestExpiryWarning(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	// The account reaches its warning lead time in about two seconds.
+	expiry := time.Now().Add(time.Hour + 2*time.Second)
+	acct, err := service.NewAccount(ctx, 1000, expiry, "expiring")
+	require.NoError(t, err)
+
+	updateWarning := func(warning time.Duration) {
+		_, err := service.UpdateAccount(
+			ctx, acct.ID, &AccountUpdate{
+				ExpiryWarning: fn.Some(warning),
+			}, fn.None[uint64](),
+		)
+		require.NoError(t, err)
+	}
+	updateWarning(time.Hour)
+
+	dbAcct, err := store.Account(ctx, acct.ID)
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, dbAcct.ExpiryWarning)
+	require.False(t, dbAcct.IsExpiringSoon())
+
+	updates, cancel, err := service.SubscribeAccountUpdates(ctx, acct.ID)
+	require.NoError(t, err)
+	defer cancel()
+
+	next := func() *AccountLifecycleEvent {
+		select {
+		case update := <-updates:
+			return update
+
+		case <-time.After(testTimeout + 3*time.Second):
+			t.Fatalf("no account update received")
+			return nil
+		}
+	}
+
+	require.Equal(t, AccountLifecycleUpdated, next().Type)
+
+	warning := next()
+	require.Equal(t, AccountLifecycleExpiringSoon, warning.Type)
+	require.True(t, warning.Account.IsExpiringSoon())
+
+	// A new subscriber is warned right after the current state, as the
+	// account is already within its lead time.
+	updates2, cancel2, err := service.SubscribeAccountUpdates(ctx, acct.ID)
+	require.NoError(t, err)
+	defer cancel2()
+
+	for _, eventType := range []AccountLifecycleEventType{
+		AccountLifecycleUpdated, AccountLifecycleExpiringSoon,
+	} {
+		select {
+		case update := <-updates2:
+			require.Equal(t, eventType, update.Type)
+
+		case <-time.After(testTimeout):
+			t.Fatalf("no account update received")
+		}
+	}
+
+	// Removing the warning is recorded in the account.
+	updateWarning(0)
+	dbAcct, err = store.Account(ctx, acct.ID)
+	require.NoError(t, err)
+	require.Zero(t, dbAcct.ExpiryWarning)
+	require.False(t, dbAcct.IsExpiringSoon())
+}
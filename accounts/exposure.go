@@ -0,0 +1,55 @@
+package accounts
+
+import (
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// InFlightExposure summarizes the payments charged to accounts that are
+// currently in flight.
+type InFlightExposure struct {
+	// Total is the sum of the full amounts of all in-flight payments,
+	// including the maximum routing fees that were reserved for them.
+	Total lnwire.MilliSatoshi
+
+	// NumPayments is the number of in-flight payments.
+	NumPayments int
+
+	// NumAccounts is the number of accounts with at least one in-flight
+	// payment.
+	NumAccounts int
+}
+
+// InFlightExposure returns the total amount of all payments that are charged
+// to accounts and are currently in flight.
+func (s *InterceptorService) InFlightExposure() *InFlightExposure {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.inFlightExposureUnsafe()
+}
+
+// inFlightExposureUnsafe sums up the payments that are currently tracked.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) inFlightExposureUnsafe() *InFlightExposure {
+	var (
+		exposure = &InFlightExposure{}
+		accounts = make(map[AccountID]struct{})
+	)
+	for _, payment := range s.pendingPayments {
+		exposure.Total += payment.fullAmount
+		exposure.NumPayments++
+		accounts[payment.accountID] = struct{}{}
+	}
+	exposure.NumAccounts = len(accounts)
+
+	return exposure
+}
+
+// updateExposureMetrics must be called whenever a payment is added to or
+// removed from the tracked payments to keep the exposure metric up to date.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) updateExposureMetrics() {
+	inFlightExposure.Set(float64(s.inFlightExposureUnsafe().Total))
+}
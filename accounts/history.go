@@ -0,0 +1,162 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// defaultActor is the actor that is recorded for account events that aren't
+// caused by a request that identifies its origin.
+const defaultActor = "system"
+
+// AccountEventType denotes the kind of lifecycle event that happened to an
+// account. The values are persisted, so existing values must not be changed.
+type AccountEventType uint8
+
+const (
+	// AccountEventCreated is recorded when the account is created.
+	AccountEventCreated AccountEventType = 0
+
+	// AccountEventBalanceUpdated is recorded when the balance of the
+	// account is set, credited or debited manually. Balance changes caused
+	// by payments and invoices are not part of the history.
+	AccountEventBalanceUpdated AccountEventType = 1
+
+	// AccountEventExpiryUpdated is recorded when the expiration date of
+	// the account is changed.
+	AccountEventExpiryUpdated AccountEventType = 2
+
+	// AccountEventLimitsUpdated is recorded when the max HTLC amount, the
+	// soft cap or the top-up policy of the account is changed.
+	AccountEventLimitsUpdated AccountEventType = 3
+
+	// AccountEventLabelRenamed is recorded when the label of the account
+	// is changed.
+	AccountEventLabelRenamed AccountEventType = 4
+
+	// AccountEventRemoved is recorded when the account is removed.
+	AccountEventRemoved AccountEventType = 5
+
+	// AccountEventToppedUp is recorded when the account is topped up
+	// automatically according to its top-up policy.
+	AccountEventToppedUp AccountEventType = 6
+
+	// AccountEventImported is recorded when the account is created or
+	// overwritten by importing it from an export of an accounts database.
+	AccountEventImported AccountEventType = 7
+
+	// AccountEventMacaroonRotated is recorded when the root key of the
+	// account macaroon is replaced, which invalidates all macaroons that
+	// were issued for the account before.
+	AccountEventMacaroonRotated AccountEventType = 8
+)
+
+// String returns a human-readable representation of the event type.
+func (t AccountEventType) String() string {
+	switch t {
+	case AccountEventCreated:
+		return "created"
+
+	case AccountEventBalanceUpdated:
+		return "balance_updated"
+
+	case AccountEventExpiryUpdated:
+		return "expiry_updated"
+
+	case AccountEventLimitsUpdated:
+		return "limits_updated"
+
+	case AccountEventLabelRenamed:
+		return "label_renamed"
+
+	case AccountEventRemoved:
+		return "removed"
+
+	case AccountEventToppedUp:
+		return "topped_up"
+
+	case AccountEventImported:
+		return "imported"
+
+	case AccountEventMacaroonRotated:
+		return "macaroon_rotated"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// AccountEvent is a single entry in the lifecycle history of an account.
+type AccountEvent struct {
+	// Type is the kind of event.
+	Type AccountEventType
+
+	// Timestamp is the time at which the event happened.
+	Timestamp time.Time
+
+	// Actor describes who caused the event, for example the address of the
+	// RPC client that made the change.
+	Actor string
+
+	// Details is a human-readable description of the change.
+	Details string
+}
+
+// recordEvent appends an event to the history of the account with the given
+// ID. The actor is taken from the context. The change the event describes has
+// already happened at this point, so a failure to record it is only logged.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) recordEvent(ctx context.Context, id AccountID,
+	eventType AccountEventType, format string, args ...any) {
+
+	event := &AccountEvent{
+		Type:      eventType,
+		Timestamp: time.Now(),
+		Actor:     ActorFromContext(ctx),
+		Details:   fmt.Sprintf(format, args...),
+	}
+
+	err := s.store.AddAccountEvent(ctx, id, event)
+	if err != nil {
+		log.Errorf("Unable to record %v event of account %x: %v",
+			eventType, id[:], err)
+	}
+}
+
+// AccountHistory returns the lifecycle events of the account with the given
+// ID in the order they happened. The history of a removed account is still
+// available.
+func (s *InterceptorService) AccountHistory(ctx context.Context,
+	id AccountID) ([]*AccountEvent, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.store.AccountHistory(ctx, id)
+}
+
+// RecordMacaroonRotation records in the history of the account with the given
+// ID that the root key of its macaroon was replaced.
+func (s *InterceptorService) RecordMacaroonRotation(ctx context.Context,
+	id AccountID, rootKeyID uint64) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	s.recordEvent(
+		ctx, id, AccountEventMacaroonRotated, "macaroon root key %d "+
+			"replaced", rootKeyID,
+	)
+}
+
+// describeExpiry returns a description of the given expiration date for the
+// account history.
+func describeExpiry(expiry time.Time) string {
+	if expiry.IsZero() {
+		return "no expiration"
+	}
+
+	return fmt.Sprintf("expires at %v", expiry.UTC().Format(time.RFC3339))
+}
@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
 	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"google.golang.org/protobuf/proto"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
@@ -54,9 +56,21 @@ func (s *InterceptorService) CustomCaveatName() string {
 
 // Intercept processes an RPC middleware interception request and returns the
 // interception result which either accepts or rejects the intercepted message.
+// The time it takes to reach the decision is recorded as a metric.
 func (s *InterceptorService) Intercept(ctx context.Context,
 	req *lnrpc.RPCMiddlewareRequest) (*lnrpc.RPCMiddlewareResponse, error) {
 
+	start := time.Now()
+	resp, err := s.intercept(ctx, req)
+	observeInterceptLatency(resp, err, time.Since(start))
+
+	return resp, err
+}
+
+// intercept makes the decision for an RPC middleware interception request.
+func (s *InterceptorService) intercept(ctx context.Context,
+	req *lnrpc.RPCMiddlewareRequest) (*lnrpc.RPCMiddlewareResponse, error) {
+
 	// We only allow a single request or response to be handled at the same
 	// time. This should already be serialized by the RPC stream itself, but
 	// with the lock we prevent a new request to be handled before we finish
@@ -96,16 +110,23 @@ func (s *InterceptorService) Intercept(ctx context.Context,
 			"macaroon caveat")
 	}
 
+	// Tag all structured log lines written in the scope of this request
+	// with the account ID.
+	ctx = mid.AddLogID(ctx, "account_id", acctID[:])
+
 	acct, err := s.Account(ctx, *acctID)
+	if errors.Is(err, ErrAccNotFound) {
+		return s.handleUnknownAccount(ctx, req, *acctID)
+	}
 	if err != nil {
 		return mid.RPCErrString(
 			req, "error getting account %x: %v", acctID[:], err,
 		)
 	}
 
-	log.Debugf("Account auth intercepted, ID=%x, balance_sat=%d, "+
-		"expired=%v", acct.ID[:], acct.CurrentBalanceSats(),
-		acct.HasExpired())
+	log.DebugS(ctx, "Account auth intercepted",
+		"balance_sat", acct.CurrentBalanceSats(),
+		"expired", acct.HasExpired())
 
 	if acct.HasExpired() {
 		return mid.RPCErrString(
@@ -113,6 +134,58 @@ func (s *InterceptorService) Intercept(ctx context.Context,
 		)
 	}
 
+	// litd only spends the liquidity of the node it is connected to, so an
+	// account bound to another node can't be used here.
+	if !acct.IsBoundTo(s.NodeID()) {
+		return mid.RPCErrString(
+			req, "account %x is bound to node %v", acct.ID[:],
+			acct.NodeID,
+		)
+	}
+
+	// The account macaroon can have its own expiry that is independent of
+	// the account's expiry. lnd already validates the time caveat, but we
+	// enforce it here as well so that an expired account macaroon is
+	// never accepted by the account system.
+	macExpiry, err := expiryFromCaveats(mac.Caveats())
+	if err != nil {
+		return mid.RPCErrString(
+			req, "error parsing macaroon expiry: %v", err,
+		)
+	}
+
+	var macExpired bool
+	macExpiry.WhenSome(func(expiry time.Time) {
+		macExpired = !time.Now().Before(expiry)
+	})
+	if macExpired {
+		return mid.RPCErrString(
+			req, "macaroon for account %x has expired", acct.ID[:],
+		)
+	}
+
+	// A payment macaroon can only be used until the payment it is
+	// restricted to has succeeded. Responses are still let through so that
+	// the client learns the outcome of the payment it made.
+	paymentHash, err := paymentHashFromCaveats(mac.Caveats())
+	if err != nil {
+		return mid.RPCErrString(
+			req, "error parsing macaroon payment hash: %v", err,
+		)
+	}
+
+	var macUsed bool
+	paymentHash.WhenSome(func(hash lntypes.Hash) {
+		macUsed = paymentMacaroonUsed(acct, hash)
+		ctx = AddPaymentHashToContext(ctx, hash)
+	})
+	if macUsed && req.GetResponse() == nil {
+		return mid.RPCErrString(
+			req, "payment macaroon for account %x has already "+
+				"been used", acct.ID[:],
+		)
+	}
+
 	// We now add the account and request ID to the incoming context to give
 	// each checker access to them if required.
 	ctx = AddAccountToContext(ctx, acct)
@@ -132,9 +205,21 @@ func (s *InterceptorService) Intercept(ctx context.Context,
 			return mid.RPCErr(req, err)
 		}
 
-		return mid.RPCErr(req, s.checkers.checkIncomingRequest(
+		replacement, err := s.checkers.checkIncomingRequest(
 			ctx, r.Request.MethodFullUri, msg,
-		))
+		)
+		if err != nil {
+			return mid.RPCErr(req, err)
+		}
+
+		// No error occurred but the request should be replaced with
+		// the given custom request. Wrap it in the correct RPC
+		// response of the interceptor now.
+		if replacement != nil {
+			return mid.RPCReplacement(req, replacement)
+		}
+
+		return mid.RPCOk(req)
 
 	// Parse and possibly manipulate outgoing responses.
 	case *lnrpc.RPCMiddlewareRequest_Response:
@@ -190,6 +275,26 @@ func (s *InterceptorService) Intercept(ctx context.Context,
 	}
 }
 
+// handleUnknownAccount handles a request that was made with the macaroon of an
+// account that doesn't exist according to the configured policy.
+func (s *InterceptorService) handleUnknownAccount(ctx context.Context,
+	req *lnrpc.RPCMiddlewareRequest,
+	acctID AccountID) (*lnrpc.RPCMiddlewareResponse, error) {
+
+	if s.unknownAccountPolicy == UnknownAccountPassThrough {
+		log.WarnS(ctx, "Request for unknown account passed through "+
+			"without account checks", nil)
+
+		return mid.RPCOk(req)
+	}
+
+	log.WarnS(ctx, "Request for unknown account rejected", nil)
+
+	return mid.RPCErrString(
+		req, "account %x no longer exists", acctID[:],
+	)
+}
+
 // parseRPCMessage parses a raw RPC message into the original protobuf message
 // type.
 func parseRPCMessage(msg *lnrpc.RPCMessage) (proto.Message, error) {
@@ -255,6 +360,12 @@ func IDFromCaveats(caveats []macaroon.Caveat) (fn.Option[AccountID], error) {
 			continue
 		}
 
+		// Payment hash caveats share the name of the account caveat
+		// but don't contain an account ID.
+		if strings.HasPrefix(after, condPaymentHash+" ") {
+			continue
+		}
+
 		accountIDStr = after
 	}
 
@@ -272,3 +383,29 @@ func IDFromCaveats(caveats []macaroon.Caveat) (fn.Option[AccountID], error) {
 
 	return fn.Some(accountID), nil
 }
+
+// expiryFromCaveats returns the earliest expiry of all the time-before caveats
+// in the given set of caveats. If there is no such caveat, None is returned.
+func expiryFromCaveats(caveats []macaroon.Caveat) (fn.Option[time.Time],
+	error) {
+
+	var expiry fn.Option[time.Time]
+	for _, caveat := range caveats {
+		cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+		if err != nil || cond != checkers.CondTimeBefore {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, arg)
+		if err != nil {
+			return fn.None[time.Time](), fmt.Errorf("invalid time "+
+				"caveat %q: %w", arg, err)
+		}
+
+		if expiry.IsNone() || t.Before(expiry.UnwrapOr(t)) {
+			expiry = fn.Some(t)
+		}
+	}
+
+	return expiry, nil
+}
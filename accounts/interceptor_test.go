@@ -1,12 +1,21 @@
 package accounts
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
 	"gopkg.in/macaroon.v2"
 )
@@ -76,3 +85,374 @@ func TestAccountIDCaveatEmbedding(t *testing.T) {
 		})
 	}
 }
+
+// TestExpiryFromCaveats tests that the earliest time-before caveat is extracted
+// from a set of caveats.
+func TestExpiryFromCaveats(t *testing.T) {
+	t.Parallel()
+
+	early := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	late := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	timeCaveat := func(ts time.Time) macaroon.Caveat {
+		return macaroon.Caveat{
+			Id: []byte(checkers.TimeBeforeCaveat(ts).Condition),
+		}
+	}
+
+	tests := []struct {
+		name           string
+		caveats        []macaroon.Caveat
+		expectedErr    string
+		expectedExpiry fn.Option[time.Time]
+	}{
+		{
+			name: "no time caveat",
+			caveats: []macaroon.Caveat{
+				CaveatFromID(AccountID{1, 2, 3, 4, 5}),
+			},
+			expectedExpiry: fn.None[time.Time](),
+		},
+		{
+			name: "single time caveat",
+			caveats: []macaroon.Caveat{
+				CaveatFromID(AccountID{1, 2, 3, 4, 5}),
+				timeCaveat(late),
+			},
+			expectedExpiry: fn.Some(late),
+		},
+		{
+			name: "multiple time caveats",
+			caveats: []macaroon.Caveat{
+				timeCaveat(late),
+				timeCaveat(early),
+			},
+			expectedExpiry: fn.Some(early),
+		},
+		{
+			name: "invalid time caveat",
+			caveats: []macaroon.Caveat{
+				{Id: []byte(checkers.Condition(
+					checkers.CondTimeBefore, "tomorrow",
+				))},
+			},
+			expectedErr: "invalid time caveat",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			expiry, err := expiryFromCaveats(test.caveats)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(
+				t, test.expectedExpiry.IsSome(), expiry.IsSome(),
+			)
+			test.expectedExpiry.WhenSome(func(expected time.Time) {
+				require.True(t, expected.Equal(
+					expiry.UnwrapOr(time.Time{}),
+				))
+			})
+		})
+	}
+}
+
+// TestUnknownAccountPolicy tests that requests made with the macaroon of an
+// account that doesn't exist are handled according to the configured policy.
+func TestUnknownAccountPolicy(t *testing.T) {
+	t.Parallel()
+
+	mac, err := macaroon.New(
+		[]byte("root key"), []byte("id"), "lnd", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+	require.NoError(t, mac.AddFirstPartyCaveat(
+		CaveatFromID(AccountID{1, 2, 3, 4}).Id,
+	))
+	rawMac, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		policy      UnknownAccountPolicy
+		expectedErr string
+	}{
+		{
+			name:        "reject",
+			policy:      UnknownAccountReject,
+			expectedErr: "account 0102030400000000 no longer exists",
+		},
+		{
+			name:   "passthrough",
+			policy: UnknownAccountPassThrough,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			store := NewTestDB(t, clock.NewDefaultClock())
+
+			service, err := NewService(
+				store, func(error) {},
+				WithUnknownAccountPolicy(test.policy),
+			)
+			require.NoError(t, err)
+			service.isEnabled = true
+
+			streamAuth := &lnrpc.RPCMiddlewareRequest_StreamAuth{
+				StreamAuth: &lnrpc.StreamAuth{},
+			}
+			resp, err := service.Intercept(
+				ctx, &lnrpc.RPCMiddlewareRequest{
+					RawMacaroon:   rawMac,
+					InterceptType: streamAuth,
+				},
+			)
+			require.NoError(t, err)
+
+			feedback := resp.GetFeedback()
+			require.NotNil(t, feedback)
+			require.Equal(t, test.expectedErr, feedback.Error)
+		})
+	}
+}
+
+// TestPaymentHashFromCaveats tests that the payment hash a macaroon is
+// restricted to is extracted from its caveats and that payment hash caveats
+// aren't mistaken for the account ID.
+func TestPaymentHashFromCaveats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		caveats      []macaroon.Caveat
+		expectedErr  string
+		expectedHash fn.Option[lntypes.Hash]
+	}{
+		{
+			name: "no payment hash caveat",
+			caveats: []macaroon.Caveat{
+				CaveatFromID(AccountID{1, 2, 3, 4, 5}),
+			},
+			expectedHash: fn.None[lntypes.Hash](),
+		},
+		{
+			name: "single payment hash caveat",
+			caveats: []macaroon.Caveat{
+				CaveatFromID(AccountID{1, 2, 3, 4, 5}),
+				PaymentHashCaveat(testHash),
+			},
+			expectedHash: fn.Some(testHash),
+		},
+		{
+			name: "repeated payment hash caveat",
+			caveats: []macaroon.Caveat{
+				PaymentHashCaveat(testHash),
+				CaveatFromID(AccountID{1, 2, 3, 4, 5}),
+				PaymentHashCaveat(testHash),
+			},
+			expectedHash: fn.Some(testHash),
+		},
+		{
+			name: "conflicting payment hash caveats",
+			caveats: []macaroon.Caveat{
+				PaymentHashCaveat(testHash),
+				PaymentHashCaveat(testHash2),
+			},
+			expectedErr: "conflicting payment hash caveats",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			hash, err := paymentHashFromCaveats(test.caveats)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expectedHash, hash)
+
+			// The account ID must be found no matter where the
+			// payment hash caveats are.
+			acct, err := IDFromCaveats(test.caveats)
+			require.NoError(t, err)
+			require.Equal(t, fn.Some(AccountID{1, 2, 3, 4, 5}), acct)
+		})
+	}
+}
+
+// TestPaymentMacaroon tests that a macaroon that is restricted to a single
+// payment can only pay that payment and is rejected once it has succeeded.
+func TestPaymentMacaroon(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := NewTestDB(t, clock.NewDefaultClock())
+	service, err := NewService(store, func(error) {})
+	require.NoError(t, err)
+
+	err = service.Start(ctx, newMockLnd(), newMockRouter(), chainParams)
+	require.NoError(t, err)
+
+	acct, err := service.NewAccount(ctx, 10000, time.Time{}, "test")
+	require.NoError(t, err)
+
+	mac, err := macaroon.New(
+		[]byte("root key"), []byte("id"), "lnd", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+	require.NoError(t, mac.AddFirstPartyCaveat(CaveatFromID(acct.ID).Id))
+	require.NoError(t, mac.AddFirstPartyCaveat(
+		PaymentHashCaveat(testHash).Id,
+	))
+	rawMac, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	var requestID uint64
+	sendPayment := func(hash lntypes.Hash) string {
+		requestID++
+
+		req, err := proto.Marshal(&routerrpc.SendPaymentRequest{
+			AmtMsat:     1000,
+			PaymentHash: hash[:],
+		})
+		require.NoError(t, err)
+
+		request := &lnrpc.RPCMiddlewareRequest_Request{
+			Request: &lnrpc.RPCMessage{
+				MethodFullUri: "/routerrpc.Router/SendPaymentV2",
+				TypeName:      "routerrpc.SendPaymentRequest",
+				Serialized:    req,
+			},
+		}
+		resp, err := service.Intercept(
+			ctx, &lnrpc.RPCMiddlewareRequest{
+				RequestId:     requestID,
+				RawMacaroon:   rawMac,
+				InterceptType: request,
+			},
+		)
+		require.NoError(t, err)
+
+		return resp.GetFeedback().Error
+	}
+
+	// A different payment is rejected, the one the macaroon is restricted
+	// to is accepted.
+	require.Contains(
+		t, sendPayment(testHash2), ErrPaymentHashNotAllowed.Error(),
+	)
+	require.Empty(t, sendPayment(testHash))
+
+	// Once the payment has succeeded, the macaroon is used up.
+	_, err = store.UpsertAccountPayment(
+		ctx, acct.ID, testHash, 1000, lnrpc.Payment_SUCCEEDED,
+	)
+	require.NoError(t, err)
+
+	require.Contains(t, sendPayment(testHash), "has already been used")
+}
+
+// TestNodeBinding tests that new accounts are bound to the node the service is
+// connected to and that requests made with an account bound to another node
+// are rejected.
+func TestNodeBinding(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := NewTestDB(t, clock.NewDefaultClock())
+	service, err := NewService(store, func(error) {})
+	require.NoError(t, err)
+
+	err = service.Start(ctx, newMockLnd(), newMockRouter(), chainParams)
+	require.NoError(t, err)
+	require.Equal(t, testNodeID, service.NodeID())
+
+	bound, err := service.NewAccount(ctx, 10000, time.Time{}, "bound")
+	require.NoError(t, err)
+	require.Equal(t, testNodeID, bound.NodeID)
+
+	otherNode := route.Vertex{0x03, 0x01}
+	other, err := store.NewAccount(
+		ctx, 10000, time.Time{}, "other", WithNodeID(otherNode),
+	)
+	require.NoError(t, err)
+
+	// Accounts created before accounts were bound to a node aren't bound
+	// to any node.
+	legacy, err := store.NewAccount(ctx, 10000, time.Time{}, "legacy")
+	require.NoError(t, err)
+	require.Equal(t, route.Vertex{}, legacy.NodeID)
+
+	intercept := func(id AccountID) string {
+		mac, err := macaroon.New(
+			[]byte("root key"), []byte("id"), "lnd",
+			macaroon.LatestVersion,
+		)
+		require.NoError(t, err)
+		require.NoError(t, mac.AddFirstPartyCaveat(CaveatFromID(id).Id))
+		rawMac, err := mac.MarshalBinary()
+		require.NoError(t, err)
+
+		streamAuth := &lnrpc.RPCMiddlewareRequest_StreamAuth{
+			StreamAuth: &lnrpc.StreamAuth{},
+		}
+		resp, err := service.Intercept(
+			ctx, &lnrpc.RPCMiddlewareRequest{
+				RawMacaroon:   rawMac,
+				InterceptType: streamAuth,
+			},
+		)
+		require.NoError(t, err)
+
+		return resp.GetFeedback().GetError()
+	}
+
+	require.Empty(t, intercept(bound.ID))
+	require.Empty(t, intercept(legacy.ID))
+	require.Equal(
+		t, fmt.Sprintf("account %x is bound to node %v", other.ID[:],
+			otherNode), intercept(other.ID),
+	)
+
+	require.NoError(t, service.Stop())
+}
+
+// TestInterceptOutcome tests that the outcome of an interception is derived
+// correctly from its result for the latency metric.
+func TestInterceptOutcome(t *testing.T) {
+	t.Parallel()
+
+	req := &lnrpc.RPCMiddlewareRequest{MsgId: 1}
+	result := func(resp *lnrpc.RPCMiddlewareResponse,
+		err error) *lnrpc.RPCMiddlewareResponse {
+
+		require.NoError(t, err)
+		return resp
+	}
+
+	require.Equal(t, outcomeAccepted, interceptOutcome(
+		result(mid.RPCOk(req)), nil,
+	))
+	require.Equal(t, outcomeRejected, interceptOutcome(
+		result(mid.RPCErrString(req, "insufficient balance")), nil,
+	))
+	require.Equal(t, outcomeReplaced, interceptOutcome(
+		result(mid.RPCErrReplacement(req, testErr)), nil,
+	))
+	require.Equal(t, outcomeError, interceptOutcome(nil, testErr))
+}
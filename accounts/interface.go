@@ -13,6 +13,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
@@ -99,6 +100,14 @@ type PaymentEntry struct {
 	// set to the fee limit set when sending the payment and updated to the
 	// actual routing fee when the payment settles.
 	FullAmount lnwire.MilliSatoshi
+
+	// Fee is the routing fee that was actually paid for the payment. It
+	// is only known once the payment has succeeded.
+	Fee lnwire.MilliSatoshi
+
+	// Destination is the node the payment was sent to. It is only known
+	// once the payment has succeeded and is the zero vertex otherwise.
+	Destination route.Vertex
 }
 
 // AccountInvoices is the set of invoices that are associated with an account.
@@ -132,6 +141,11 @@ type OffChainBalanceAccount struct {
 	// was updated.
 	LastUpdate time.Time
 
+	// CreatedAt is the time at which the account was created. Accounts
+	// that were created before the creation time was recorded use the time
+	// of their last update before that instead.
+	CreatedAt time.Time
+
 	// ExpirationDate is a specific date in the future after which the
 	// account is marked as expired. Can be set to zero for accounts that
 	// never expire.
@@ -148,6 +162,97 @@ type OffChainBalanceAccount struct {
 	// Label is an optional label that can be set for the account. If it is
 	// not empty then it must be unique.
 	Label string
+
+	// MaxHTLC is the maximum amount in millisatoshis that a single HTLC
+	// sent by the account may carry, not including routing fees. A value
+	// of zero means that no limit is enforced.
+	MaxHTLC lnwire.MilliSatoshi
+
+	// SoftCap is the total amount in millisatoshis that the account may
+	// spend before a warning is emitted for every further payment. Unlike
+	// the balance, the soft cap never causes a payment to be rejected. A
+	// value of zero means that no soft cap is set.
+	SoftCap lnwire.MilliSatoshi
+
+	// Sandbox marks the account as one that is only used for testing.
+	// Sandbox accounts behave like any other account but are excluded
+	// from aggregate reports by default.
+	Sandbox bool
+
+	// KeysendBudget is the amount in millisatoshis that the account may
+	// spend on keysend payments per second, not including routing fees.
+	// Unused budget accumulates for at most one second. A value of zero
+	// means that keysend payments are not limited beyond the balance.
+	KeysendBudget lnwire.MilliSatoshi
+
+	// Version is incremented every time the account is changed in the
+	// store, starting at one for a new account. It can be used to detect
+	// whether an account was changed since it was read.
+	Version uint64
+
+	// NodeID is the identity public key of the lnd node whose liquidity
+	// the account spends. Requests made with the account are only
+	// accepted by litd if it is connected to that node. Accounts that
+	// were created before accounts were bound to a node have an empty
+	// NodeID and are accepted on any node.
+	NodeID route.Vertex
+
+	// AmountlessInvoiceMax is the maximum amount in millisatoshis the
+	// account may pay to an invoice that doesn't specify an amount. If it
+	// is set, payments to such invoices must explicitly state the amount
+	// to pay. A value of zero means that these payments are only limited
+	// by the balance.
+	AmountlessInvoiceMax lnwire.MilliSatoshi
+
+	// TopUp is the policy by which the account is topped up automatically
+	// from the configured top-up source account once a payment brings its
+	// balance below a minimum. The zero value disables automatic top-ups.
+	TopUp TopUpPolicy
+
+	// SpendRateLimit caps the amount the account may spend on payments
+	// within a rolling time window. The zero value means that payments
+	// are only limited by the balance.
+	SpendRateLimit SpendRateLimit
+
+	// ExpiryWarning is the lead time before the expiration date of the
+	// account at which an AccountLifecycleExpiringSoon event is emitted.
+	// Zero disables the warning.
+	ExpiryWarning time.Duration
+
+	// AllowedDestinations are the only nodes the account may send
+	// payments to, sorted by their public key. If it is empty, payments
+	// to any node are allowed.
+	AllowedDestinations []route.Vertex
+
+	// MaxOpenInvoices is the maximum number of invoices created with the
+	// account that may be open, meaning neither settled nor canceled, at
+	// the same time. Zero means that the number isn't limited.
+	MaxOpenInvoices uint32
+
+	// MaxInvoiceAmount is the maximum amount in millisatoshis of an
+	// invoice that may be created with the account. If it is set, invoices
+	// without an amount can't be created. Zero means that the amount isn't
+	// limited.
+	MaxInvoiceAmount lnwire.MilliSatoshi
+
+	// OpenInvoices is the number of invoices created with the account that
+	// are neither settled nor canceled yet. As lnd doesn't notify about
+	// canceled invoices, the number can be too high until the open
+	// invoices are counted again, which happens once the account reaches
+	// its MaxOpenInvoices.
+	OpenInvoices uint32
+
+	// MaxPayment is the maximum amount in millisatoshis that a single
+	// payment sent by the account may carry, not including routing fees.
+	// Unlike MaxHTLC, it also limits payments that are split into multiple
+	// HTLCs. A value of zero means that no limit is enforced.
+	MaxPayment lnwire.MilliSatoshi
+}
+
+// IsBoundTo returns true if the account may be used on the node with the given
+// identity public key.
+func (a *OffChainBalanceAccount) IsBoundTo(node route.Vertex) bool {
+	return a.NodeID == route.Vertex{} || a.NodeID == node
 }
 
 // HasExpired returns true if the account has an expiration date set and that
@@ -183,6 +288,50 @@ var (
 	// and that date is in the past.
 	ErrAccExpired = errors.New("account has expired")
 
+	// ErrMaxHTLCExceeded is returned if a payment would require an HTLC
+	// that is larger than the maximum HTLC amount set for the account.
+	ErrMaxHTLCExceeded = errors.New("account max HTLC amount exceeded")
+
+	// ErrMaxPaymentExceeded is returned if a payment is larger than the
+	// maximum payment amount set for the account.
+	ErrMaxPaymentExceeded = errors.New("account max payment amount " +
+		"exceeded")
+
+	// ErrKeysendBudgetExceeded is returned if a keysend payment would
+	// spend more than the account's keysend budget currently allows.
+	ErrKeysendBudgetExceeded = errors.New("account keysend budget " +
+		"exceeded")
+
+	// ErrAmountlessInvoiceMaxExceeded is returned if a payment to an
+	// invoice without an amount doesn't state the amount to pay or the
+	// amount is larger than the account allows for such invoices.
+	ErrAmountlessInvoiceMaxExceeded = errors.New("account max amount " +
+		"for amountless invoices exceeded")
+
+	// ErrSpendRateLimitExceeded is returned if a payment would take the
+	// spend of an account within the window of its spend rate limit over
+	// the limit. It is distinct from ErrAccBalanceInsufficient, as the
+	// payment can be retried once earlier payments left the window.
+	ErrSpendRateLimitExceeded = errors.New("account spend rate limit " +
+		"exceeded")
+
+	// ErrDestinationNotAllowed is returned if an account with allowed
+	// destinations tries to pay a node that isn't one of them, or a
+	// destination that can't be determined from the request.
+	ErrDestinationNotAllowed = errors.New("account payment destination " +
+		"not allowed")
+
+	// ErrMaxOpenInvoicesExceeded is returned if an account tries to create
+	// an invoice while it already has the maximum number of open invoices.
+	ErrMaxOpenInvoicesExceeded = errors.New("account max open invoices " +
+		"exceeded")
+
+	// ErrMaxInvoiceAmountExceeded is returned if an account tries to
+	// create an invoice with an amount that is larger than the account
+	// allows, or without an amount if the amount is limited.
+	ErrMaxInvoiceAmountExceeded = errors.New("account max invoice amount " +
+		"exceeded")
+
 	// ErrAccBalanceInsufficient is returned if the amount required to
 	// perform a certain action is larger than the current balance of the
 	// account
@@ -226,13 +375,59 @@ var (
 	}}
 )
 
+// AccountUpdate describes the changes to an existing account. Only the fields
+// that are set are changed.
+type AccountUpdate struct {
+	// Balance is the new balance of the account in millisatoshis.
+	Balance fn.Option[int64]
+
+	// Expiry is the new expiration date of the account. The zero time
+	// means that the account never expires.
+	Expiry fn.Option[time.Time]
+
+	// MaxHTLC is the new maximum HTLC amount. Zero removes the limit.
+	MaxHTLC fn.Option[lnwire.MilliSatoshi]
+
+	// SoftCap is the new soft cap. Zero removes the soft cap.
+	SoftCap fn.Option[lnwire.MilliSatoshi]
+
+	// TopUp is the new top-up policy. The zero policy disables automatic
+	// top-ups.
+	TopUp fn.Option[TopUpPolicy]
+
+	// SpendRateLimit is the new spend rate limit. The zero limit removes
+	// it.
+	SpendRateLimit fn.Option[SpendRateLimit]
+
+	// ExpiryWarning is the new expiry warning lead time. Zero disables the
+	// warning.
+	ExpiryWarning fn.Option[time.Duration]
+
+	// AllowedDestinations replaces the allowed destinations. An empty list
+	// allows payments to any node.
+	AllowedDestinations fn.Option[[]route.Vertex]
+
+	// MaxOpenInvoices is the new maximum number of open invoices. Zero
+	// removes the limit.
+	MaxOpenInvoices fn.Option[uint32]
+
+	// MaxInvoiceAmount is the new maximum invoice amount. Zero removes the
+	// limit.
+	MaxInvoiceAmount fn.Option[lnwire.MilliSatoshi]
+
+	// MaxPayment is the new maximum payment amount. Zero removes the
+	// limit.
+	MaxPayment fn.Option[lnwire.MilliSatoshi]
+}
+
 // Store is the main account store interface.
 type Store interface {
 	// NewAccount creates a new OffChainBalanceAccount with the given
-	// balance and a randomly chosen ID.
+	// balance and a randomly chosen ID, unless the WithAccountID option
+	// is used.
 	NewAccount(ctx context.Context, balance lnwire.MilliSatoshi,
-		expirationDate time.Time, label string) (
-		*OffChainBalanceAccount, error)
+		expirationDate time.Time, label string,
+		opts ...NewAccountOption) (*OffChainBalanceAccount, error)
 
 	// Account retrieves an account from the Store and un-marshals it. If
 	// the account cannot be found, then ErrAccNotFound is returned.
@@ -242,20 +437,68 @@ type Store interface {
 	// Accounts retrieves all accounts from the store and un-marshals them.
 	Accounts(ctx context.Context) ([]*OffChainBalanceAccount, error)
 
+	// AccountsPage returns up to limit accounts in the given sort order.
+	// The default order doesn't change as accounts are added or removed,
+	// and the page starts with the account with the given ID or, if there
+	// is none, with the account that follows it. For any other order the
+	// start account must exist, otherwise ErrAccNotFound is returned.
+	// Without a start, the page starts with the first account. The ID of
+	// the account that follows the page is returned as the start of the
+	// next page, or None if there are no more accounts.
+	AccountsPage(ctx context.Context, sort AccountSort,
+		start fn.Option[AccountID],
+		limit int) ([]*OffChainBalanceAccount, fn.Option[AccountID],
+		error)
+
 	// UpdateAccountBalanceAndExpiry updates the balance and/or expiry of an
 	// account.
 	UpdateAccountBalanceAndExpiry(ctx context.Context, id AccountID,
 		newBalance fn.Option[int64],
 		newExpiry fn.Option[time.Time]) error
 
-	// AddAccountInvoice adds an invoice hash to an account.
+	// UpdateAccount applies all changes of the given update to the
+	// account with the given ID in a single transaction, so either all of
+	// them or none are stored.
+	UpdateAccount(ctx context.Context, id AccountID,
+		update *AccountUpdate) error
+
+	// TopUpAccount atomically moves the top-up amount of the account with
+	// the given ID from the source account to it if the balance of the
+	// account is below the minimum of its top-up policy. The amount that
+	// was moved is returned, which is zero if the account didn't need a
+	// top-up. ErrTopUpSourceInsufficient is returned if the source doesn't
+	// have enough balance.
+	TopUpAccount(ctx context.Context, id,
+		source AccountID) (lnwire.MilliSatoshi, error)
+
+	// UpdateAccountLabel changes the label of an account. The new label
+	// must not be empty and must be unique; if it is not, then
+	// ErrLabelAlreadyExists is returned.
+	UpdateAccountLabel(ctx context.Context, id AccountID,
+		label string) error
+
+	// AddAccountInvoice adds an invoice hash to an account and counts it
+	// as an open invoice of the account if it wasn't added before.
 	AddAccountInvoice(ctx context.Context, id AccountID,
 		hash lntypes.Hash) error
 
+	// CloseAccountInvoice marks an invoice of an account as settled or
+	// canceled by decreasing the number of open invoices of the account.
+	// Nothing is changed if the invoice doesn't belong to the account or
+	// if the account doesn't have any open invoices.
+	CloseAccountInvoice(ctx context.Context, id AccountID,
+		hash lntypes.Hash) error
+
+	// UpdateAccountOpenInvoices sets the number of open invoices of an
+	// account after they were counted again.
+	UpdateAccountOpenInvoices(ctx context.Context, id AccountID,
+		openInvoices uint32) error
+
 	// CreditAccount increases the balance of the account with the
-	// given ID by the given amount.
+	// given ID by the given amount. Options can be passed to describe the
+	// credit in the ledger of the account.
 	CreditAccount(ctx context.Context, id AccountID,
-		amount lnwire.MilliSatoshi) error
+		amount lnwire.MilliSatoshi, opts ...CreditOption) error
 
 	// DebitAccount decreases the balance of the account with the
 	// given ID by the given amount.
@@ -280,9 +523,65 @@ type Store interface {
 		hash lntypes.Hash) error
 
 	// RemoveAccount finds an account by its ID and removes it from the¨
-	// store.
+	// store, together with its credit rules.
 	RemoveAccount(ctx context.Context, id AccountID) error
 
+	// SweepAndRemoveAccount atomically credits the remaining balance of
+	// the account with the given ID to the target account and removes the
+	// account like RemoveAccount does. The swept amount is returned. An
+	// account without a positive balance is removed without crediting the
+	// target, which must exist nonetheless.
+	SweepAndRemoveAccount(ctx context.Context, id,
+		target AccountID) (lnwire.MilliSatoshi, error)
+
+	// TransferAccountBalance atomically moves the given amount from the
+	// source account to the destination account. ErrAccBalanceInsufficient
+	// is returned and nothing is moved if the transfer would make the
+	// balance of the source negative.
+	TransferAccountBalance(ctx context.Context, source,
+		destination AccountID, amount lnwire.MilliSatoshi) error
+
+	// ImportAccount stores the given account under its own ID, for example
+	// to restore it from an export. Only the balance and the settings of
+	// the account are imported, not its invoices and payments. If an
+	// account with the ID already exists, ErrAccountAlreadyExists is
+	// returned unless overwrite is set. In that case, the balance and the
+	// settings of the existing account are replaced, while its invoices
+	// and payments are kept.
+	ImportAccount(ctx context.Context, account *OffChainBalanceAccount,
+		overwrite bool) error
+
+	// AddAccountEvent appends a lifecycle event to the history of the
+	// account with the given ID. The history is kept after the account
+	// itself is removed.
+	AddAccountEvent(ctx context.Context, id AccountID,
+		event *AccountEvent) error
+
+	// AccountHistory returns all lifecycle events of the account with the
+	// given ID in the order they were added. An account without any events
+	// has an empty history.
+	AccountHistory(ctx context.Context, id AccountID) ([]*AccountEvent,
+		error)
+
+	// AccountLedger returns all ledger entries of the account with the
+	// given ID in the order they were recorded. The ledger is kept after
+	// the account itself is removed.
+	AccountLedger(ctx context.Context, id AccountID) ([]*LedgerEntry,
+		error)
+
+	// AddCreditRule adds a rule that maps a memo prefix to an existing
+	// account. If a rule for the prefix already exists, then
+	// ErrCreditRuleExists is returned.
+	AddCreditRule(ctx context.Context, rule *CreditRule) error
+
+	// CreditRules returns all credit rules ordered by their memo prefix.
+	CreditRules(ctx context.Context) ([]*CreditRule, error)
+
+	// RemoveCreditRule removes the rule for the given memo prefix. If no
+	// such rule exists, then ErrCreditRuleNotFound is returned. The rules
+	// of an account are also removed together with the account.
+	RemoveCreditRule(ctx context.Context, memoPrefix string) error
+
 	// LastIndexes returns the last invoice add and settle index or
 	// ErrNoInvoiceIndexKnown if no indexes are known yet.
 	LastIndexes(ctx context.Context) (uint64, uint64, error)
@@ -302,6 +601,16 @@ type Service interface {
 	CheckBalance(ctx context.Context, id AccountID,
 		requiredBalance lnwire.MilliSatoshi) error
 
+	// CheckSpendRate makes sure that a payment with the given hash and
+	// amount doesn't exceed the spend rate limit of the account.
+	CheckSpendRate(ctx context.Context, id AccountID, hash lntypes.Hash,
+		amt lnwire.MilliSatoshi) error
+
+	// CheckOpenInvoices makes sure that the account with the given ID may
+	// create another invoice without exceeding its maximum number of open
+	// invoices.
+	CheckOpenInvoices(ctx context.Context, id AccountID) error
+
 	// AssociateInvoice associates a generated invoice with the given
 	// account, making it possible for the account to be credited in case
 	// the invoice is paid.
@@ -337,13 +646,54 @@ type Service interface {
 		amount lnwire.MilliSatoshi) (*OffChainBalanceAccount, error)
 
 	// DebitAccount decreases the balance of an existing account in the
-	// database.
+	// database. The optional memo is passed on to the spend notifier.
 	DebitAccount(ctx context.Context, accountID AccountID,
-		amount lnwire.MilliSatoshi) (*OffChainBalanceAccount, error)
+		amount lnwire.MilliSatoshi,
+		memo string) (*OffChainBalanceAccount, error)
 
 	RequestValuesStore
 }
 
+// LowBalanceHook is a hook that is invoked by the account service whenever the
+// balance of an account drops below the configured low balance watermark. This
+// allows an operator to plug in an automated funding action, for example a
+// Loop In or a channel rebalance, after which the account can be credited.
+type LowBalanceHook interface {
+	// AccountBalanceLow is called with the state of the account right
+	// after its balance dropped below the low balance watermark. The hook
+	// is called in its own goroutine and may call back into the account
+	// service, for example to credit the account.
+	AccountBalanceLow(ctx context.Context,
+		account *OffChainBalanceAccount) error
+}
+
+// LowBalanceHookFunc is a function type that implements the LowBalanceHook
+// interface.
+type LowBalanceHookFunc func(ctx context.Context,
+	account *OffChainBalanceAccount) error
+
+// AccountBalanceLow calls the underlying function.
+//
+// NOTE: This is part of the LowBalanceHook interface.
+func (f LowBalanceHookFunc) AccountBalanceLow(ctx context.Context,
+	account *OffChainBalanceAccount) error {
+
+	return f(ctx, account)
+}
+
+// LogLowBalanceHook is a LowBalanceHook that only logs a warning for accounts
+// that are running low on balance. It is used if a low balance watermark is
+// configured without providing a custom hook.
+var LogLowBalanceHook = LowBalanceHookFunc(func(_ context.Context,
+	account *OffChainBalanceAccount) error {
+
+	log.Warnf("Account %x (label=%q) is running low on balance: %d sats "+
+		"left", account.ID[:], account.Label,
+		account.CurrentBalanceSats())
+
+	return nil
+})
+
 // RequestValues holds various values associated with a specific request that
 // we may want access to when handling the response. At the moment this only
 // stores payment related data.
@@ -383,6 +733,8 @@ type upsertAcctPaymentOption struct {
 	usePendingAmount      bool
 	errIfAlreadySucceeded bool
 	errIfUnknown          bool
+	fee                   fn.Option[lnwire.MilliSatoshi]
+	destination           fn.Option[route.Vertex]
 }
 
 // newUpsertPaymentOption creates a new upsertAcctPaymentOption with default
@@ -442,3 +794,220 @@ func WithErrIfUnknown() UpsertPaymentOption {
 		o.errIfUnknown = true
 	}
 }
+
+// WithSettlementDetails is a functional option that can be passed to the
+// UpsertAccountPayment method to record the routing fee that was paid for the
+// payment and the node it was sent to. If the option is not set, any details
+// that were previously recorded for the payment are kept.
+func WithSettlementDetails(fee lnwire.MilliSatoshi,
+	destination route.Vertex) UpsertPaymentOption {
+
+	return func(o *upsertAcctPaymentOption) {
+		o.fee = fn.Some(fee)
+		o.destination = fn.Some(destination)
+	}
+}
+
+// CreditOption is a functional option that can be passed to the CreditAccount
+// method to describe the credit.
+type CreditOption func(*creditOptions)
+
+// creditOptions is a struct that holds optional parameters for the
+// CreditAccount method.
+type creditOptions struct {
+	entryType LedgerEntryType
+	reference lntypes.Hash
+}
+
+// newCreditOptions creates the options for the CreditAccount method from the
+// given functional options. Without any options, the credit is recorded as a
+// manual credit.
+func newCreditOptions(opts []CreditOption) *creditOptions {
+	options := &creditOptions{
+		entryType: LedgerEntryCredit,
+	}
+	for _, o := range opts {
+		o(options)
+	}
+
+	return options
+}
+
+// WithCreditInvoice is a functional option that can be passed to the
+// CreditAccount method to record that the account is credited for the paid
+// invoice with the given hash.
+func WithCreditInvoice(hash lntypes.Hash) CreditOption {
+	return func(o *creditOptions) {
+		o.entryType = LedgerEntryInvoice
+		o.reference = hash
+	}
+}
+
+// NewAccountOption is a functional option that can be passed to the NewAccount
+// method to set optional parameters of the new account.
+type NewAccountOption func(*newAccountOptions)
+
+// newAccountOptions is a struct that holds optional parameters for the
+// NewAccount method.
+type newAccountOptions struct {
+	maxHTLC lnwire.MilliSatoshi
+	softCap lnwire.MilliSatoshi
+	sandbox bool
+
+	keysendBudget lnwire.MilliSatoshi
+
+	nodeID route.Vertex
+
+	amountlessInvoiceMax lnwire.MilliSatoshi
+
+	topUp TopUpPolicy
+
+	spendRateLimit SpendRateLimit
+
+	allowedDestinations []route.Vertex
+
+	maxOpenInvoices uint32
+
+	maxInvoiceAmount lnwire.MilliSatoshi
+
+	maxPayment lnwire.MilliSatoshi
+
+	id AccountID
+}
+
+// newAccountOptionsFromOpts creates a new newAccountOptions struct with
+// default values and applies the given functional options.
+func newAccountOptionsFromOpts(opts []NewAccountOption) *newAccountOptions {
+	o := &newAccountOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return o
+}
+
+// WithMaxHTLC is a functional option that can be passed to the NewAccount
+// method to limit the amount of any single HTLC sent by the new account.
+func WithMaxHTLC(maxHTLC lnwire.MilliSatoshi) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.maxHTLC = maxHTLC
+	}
+}
+
+// WithSoftCap is a functional option that can be passed to the NewAccount
+// method to set a soft cap on the total amount spent by the new account.
+func WithSoftCap(softCap lnwire.MilliSatoshi) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.softCap = softCap
+	}
+}
+
+// WithSandbox is a functional option that can be passed to the NewAccount
+// method to mark the new account as a sandbox account.
+func WithSandbox() NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.sandbox = true
+	}
+}
+
+// WithKeysendBudget is a functional option that can be passed to the
+// NewAccount method to limit the amount the new account may spend on keysend
+// payments per second.
+func WithKeysendBudget(budget lnwire.MilliSatoshi) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.keysendBudget = budget
+	}
+}
+
+// WithNodeID is a functional option that can be passed to the NewAccount
+// method to bind the new account to the lnd node with the given identity
+// public key.
+func WithNodeID(nodeID route.Vertex) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.nodeID = nodeID
+	}
+}
+
+// WithAmountlessInvoiceMax is a functional option that can be passed to the
+// NewAccount method to limit the amount the new account may pay to an invoice
+// that doesn't specify an amount.
+func WithAmountlessInvoiceMax(max lnwire.MilliSatoshi) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.amountlessInvoiceMax = max
+	}
+}
+
+// WithTopUpPolicy is a functional option that can be passed to the NewAccount
+// method to top up the new account automatically according to the given
+// policy.
+func WithTopUpPolicy(policy TopUpPolicy) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.topUp = policy
+	}
+}
+
+// WithSpendRateLimit is a functional option that can be passed to the
+// NewAccount method to limit how much the new account may spend within a
+// rolling time window.
+func WithSpendRateLimit(limit SpendRateLimit) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.spendRateLimit = limit
+	}
+}
+
+// WithAllowedDestinations is a functional option that can be passed to the
+// NewAccount method to only allow the new account to pay the given nodes.
+func WithAllowedDestinations(dests []route.Vertex) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.allowedDestinations = normalizeDestinations(dests)
+	}
+}
+
+// WithMaxOpenInvoices is a functional option that can be passed to the
+// NewAccount method to limit the number of open invoices of the new account.
+func WithMaxOpenInvoices(maxOpen uint32) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.maxOpenInvoices = maxOpen
+	}
+}
+
+// WithMaxInvoiceAmount is a functional option that can be passed to the
+// NewAccount method to limit the amount of the invoices created with the new
+// account.
+func WithMaxInvoiceAmount(maxAmount lnwire.MilliSatoshi) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.maxInvoiceAmount = maxAmount
+	}
+}
+
+// WithAccountID is a functional option that can be passed to the NewAccount
+// method to create the new account with the given ID instead of a random one.
+// If an account with the ID already exists, ErrAccountAlreadyExists is
+// returned. The all-zero ID is reserved and can't be used.
+func WithAccountID(id AccountID) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.id = id
+	}
+}
+
+// WithMaxPayment is a functional option that can be passed to the NewAccount
+// method to limit the amount of any single payment sent by the new account.
+func WithMaxPayment(maxPayment lnwire.MilliSatoshi) NewAccountOption {
+	return func(o *newAccountOptions) {
+		o.maxPayment = maxPayment
+	}
+}
+
+// validateLabel makes sure that the given non-empty label can't be mistaken
+// for a hex encoded account ID to avoid confusion and make it easier for the
+// CLI to distinguish between the two.
+func validateLabel(label string) error {
+	if _, err := hex.DecodeString(label); err == nil &&
+		len(label) == hex.EncodedLen(AccountIDLen) {
+
+		return fmt.Errorf("the label '%s' is not allowed as it can be "+
+			"mistaken for an account ID", label)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// pendingInvoicesPageSize is the number of pending invoices that are queried
+// from lnd at once when the open invoices of an account are counted.
+const pendingInvoicesPageSize = 1000
+
+// describeMaxOpenInvoices returns a description of the given maximum number of
+// open invoices for the account history.
+func describeMaxOpenInvoices(maxOpen uint32) string {
+	if maxOpen == 0 {
+		return "max open invoices removed"
+	}
+
+	return fmt.Sprintf("max open invoices set to %d", maxOpen)
+}
+
+// describeMaxInvoiceAmount returns a description of the given maximum invoice
+// amount for the account history.
+func describeMaxInvoiceAmount(maxAmount lnwire.MilliSatoshi) string {
+	if maxAmount == 0 {
+		return "max invoice amount removed"
+	}
+
+	return fmt.Sprintf("max invoice amount set to %v", maxAmount)
+}
+
+// invoiceAmount returns the amount of the given invoice request, which is zero
+// if the invoice doesn't specify an amount.
+func invoiceAmount(invoice *lnrpc.Invoice) lnwire.MilliSatoshi {
+	if invoice.ValueMsat != 0 {
+		return lnwire.MilliSatoshi(invoice.ValueMsat)
+	}
+
+	return lnwire.NewMSatFromSatoshis(btcutil.Amount(invoice.Value))
+}
+
+// checkInvoiceAmount makes sure that the account may create an invoice with the
+// given amount. If the amount of the account's invoices is limited, invoices
+// without an amount are rejected as well, since they could be paid with any
+// amount.
+func checkInvoiceAmount(acct *OffChainBalanceAccount,
+	amt lnwire.MilliSatoshi) error {
+
+	if acct.MaxInvoiceAmount == 0 {
+		return nil
+	}
+
+	if amt == 0 {
+		return fmt.Errorf("%w: invoices without an amount can't be "+
+			"created", ErrMaxInvoiceAmountExceeded)
+	}
+
+	if amt > acct.MaxInvoiceAmount {
+		return fmt.Errorf("%w: invoice amount %v is larger than the "+
+			"limit of %v", ErrMaxInvoiceAmountExceeded, amt,
+			acct.MaxInvoiceAmount)
+	}
+
+	return nil
+}
+
+// CheckOpenInvoices makes sure that the account with the given ID has fewer
+// open invoices than its maximum, so that it may create another one. If the
+// account reached its maximum, its open invoices are counted again with lnd
+// first, as the stored number still includes invoices that were canceled or
+// expired. If the account still has too many open invoices,
+// ErrMaxOpenInvoicesExceeded is returned. Accounts without a maximum are never
+// limited.
+func (s *InterceptorService) CheckOpenInvoices(ctx context.Context,
+	id AccountID) error {
+
+	s.Lock()
+	defer s.Unlock()
+
+	if !s.isRunningUnsafe() {
+		return ErrAccountServiceDisabled
+	}
+
+	acct, err := s.store.Account(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if acct.MaxOpenInvoices == 0 ||
+		acct.OpenInvoices < acct.MaxOpenInvoices {
+
+		return nil
+	}
+
+	openInvoices, err := s.countOpenInvoices(ctx, acct)
+	if err != nil {
+		return fmt.Errorf("error counting open invoices: %w", err)
+	}
+
+	if openInvoices != acct.OpenInvoices {
+		log.Debugf("Account %x has %d instead of %d open invoices",
+			id[:], openInvoices, acct.OpenInvoices)
+
+		err = s.store.UpdateAccountOpenInvoices(ctx, id, openInvoices)
+		if err != nil {
+			return fmt.Errorf("error updating open invoices: %w",
+				err)
+		}
+	}
+
+	if openInvoices < acct.MaxOpenInvoices {
+		return nil
+	}
+
+	return fmt.Errorf("%w: account has %d open invoices (max %d)",
+		ErrMaxOpenInvoicesExceeded, openInvoices, acct.MaxOpenInvoices)
+}
+
+// countOpenInvoices counts the invoices of the given account that lnd reports
+// as pending, which are the invoices that are neither settled nor canceled.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) countOpenInvoices(ctx context.Context,
+	acct *OffChainBalanceAccount) (uint32, error) {
+
+	var (
+		openInvoices uint32
+		offset       uint64
+	)
+	for {
+		resp, err := s.lightningClient.ListInvoices(
+			ctx, lndclient.ListInvoicesRequest{
+				MaxInvoices: pendingInvoicesPageSize,
+				Offset:      offset,
+				PendingOnly: true,
+			},
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, invoice := range resp.Invoices {
+			if _, ok := acct.Invoices[invoice.Hash]; ok {
+				openInvoices++
+			}
+		}
+
+		if len(resp.Invoices) < pendingInvoicesPageSize {
+			return openInvoices, nil
+		}
+		offset = resp.LastIndexOffset
+	}
+}
@@ -0,0 +1,100 @@
+package accounts
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+)
+
+// keysendLimiter enforces the keysend budgets of accounts. Each account with a
+// budget gets a token bucket that holds at most one second worth of budget and
+// is refilled continuously at the budget rate. The buckets are only kept in
+// memory, so a restart grants every account its full budget again.
+type keysendLimiter struct {
+	mu      sync.Mutex
+	clock   clock.Clock
+	buckets map[AccountID]*keysendBucket
+}
+
+// keysendBucket is the state of the token bucket of a single account.
+type keysendBucket struct {
+	// available is the amount in millisatoshis that can currently be
+	// spent on keysend payments.
+	available float64
+
+	// lastUpdate is the time at which available was last updated.
+	lastUpdate time.Time
+}
+
+// newKeysendLimiter creates a new keysendLimiter that uses the given clock to
+// refill the buckets.
+func newKeysendLimiter(clock clock.Clock) *keysendLimiter {
+	return &keysendLimiter{
+		clock:   clock,
+		buckets: make(map[AccountID]*keysendBucket),
+	}
+}
+
+// spend deducts the given amount from the keysend budget of the account. An
+// error is returned and nothing is deducted if the budget doesn't currently
+// allow the amount to be spent. Accounts without a keysend budget are never
+// limited.
+func (l *keysendLimiter) spend(acct *OffChainBalanceAccount,
+	amt lnwire.MilliSatoshi) error {
+
+	if acct.KeysendBudget == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var (
+		now    = l.clock.Now()
+		budget = float64(acct.KeysendBudget)
+	)
+
+	// A new bucket starts out full.
+	bucket, ok := l.buckets[acct.ID]
+	if !ok {
+		bucket = &keysendBucket{
+			available:  budget,
+			lastUpdate: now,
+		}
+		l.buckets[acct.ID] = bucket
+	}
+
+	elapsed := now.Sub(bucket.lastUpdate).Seconds()
+	if elapsed > 0 {
+		bucket.available += elapsed * budget
+		bucket.lastUpdate = now
+	}
+	if bucket.available > budget {
+		bucket.available = budget
+	}
+
+	if float64(amt) > bucket.available {
+		available := lnwire.MilliSatoshi(bucket.available)
+
+		return fmt.Errorf("%w: keysend amount %v is larger than the "+
+			"available budget of %v (%v per second)",
+			ErrKeysendBudgetExceeded, amt, available,
+			acct.KeysendBudget)
+	}
+
+	bucket.available -= float64(amt)
+
+	return nil
+}
+
+// isKeysend returns true if the given custom records of a payment contain a
+// keysend preimage.
+func isKeysend(customRecords map[uint64][]byte) bool {
+	_, ok := customRecords[record.KeySendType]
+
+	return ok
+}
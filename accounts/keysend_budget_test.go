@@ -0,0 +1,53 @@
+package accounts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestKeysendLimiter makes sure that the keysend budget of an account is
+// refilled at the budget rate and never accumulates more than one second
+// worth of budget.
+func TestKeysendLimiter(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Unix(1000, 0))
+	limiter := newKeysendLimiter(testClock)
+
+	acct := &OffChainBalanceAccount{
+		ID:            AccountID{1},
+		KeysendBudget: 1000,
+	}
+
+	// A new account starts with its full budget, which can be spent in
+	// multiple payments.
+	require.NoError(t, limiter.spend(acct, 600))
+	require.NoError(t, limiter.spend(acct, 400))
+	require.ErrorIs(t, limiter.spend(acct, 1), ErrKeysendBudgetExceeded)
+
+	// After half a second, half the budget is available again.
+	testClock.SetTime(testClock.Now().Add(500 * time.Millisecond))
+	require.ErrorIs(t, limiter.spend(acct, 600), ErrKeysendBudgetExceeded)
+	require.NoError(t, limiter.spend(acct, 500))
+
+	// Waiting longer than a second doesn't grant more than the budget.
+	testClock.SetTime(testClock.Now().Add(time.Minute))
+	require.ErrorIs(t, limiter.spend(acct, 1001), ErrKeysendBudgetExceeded)
+	require.NoError(t, limiter.spend(acct, 1000))
+
+	// The budgets of accounts are independent of each other.
+	other := &OffChainBalanceAccount{
+		ID:            AccountID{2},
+		KeysendBudget: 1000,
+	}
+	require.NoError(t, limiter.spend(other, 1000))
+
+	// Accounts without a budget are never limited.
+	unlimited := &OffChainBalanceAccount{
+		ID: AccountID{3},
+	}
+	require.NoError(t, limiter.spend(unlimited, 1_000_000))
+}
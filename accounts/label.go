@@ -0,0 +1,112 @@
+package accounts
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// maxLabelAttempts is the number of labels the account service
+	// generates for a new account before it gives up finding one that
+	// isn't taken yet.
+	maxLabelAttempts = 10
+
+	// DefaultGroupSeparator is the separator that splits the group of an
+	// account from the rest of its label if no other separator is given,
+	// so the label "team/alice" belongs to the group "team".
+	DefaultGroupSeparator = "/"
+)
+
+// AccountGroup is a group of accounts whose labels share the same prefix.
+type AccountGroup struct {
+	// Name is the label prefix of the accounts in the group, without the
+	// separator.
+	Name string
+
+	// NumAccounts is the number of accounts in the group.
+	NumAccounts int
+}
+
+// groupAccounts groups the given accounts by the part of their label before
+// the first occurrence of the separator. The groups are sorted by name. The
+// number of accounts that don't belong to any group, because they don't have
+// a label or their label doesn't contain the separator, is returned as well.
+func groupAccounts(accounts []*OffChainBalanceAccount,
+	separator string) ([]*AccountGroup, int) {
+
+	var (
+		counts     = make(map[string]int)
+		numNoGroup int
+	)
+	for _, acct := range accounts {
+		name, _, found := strings.Cut(acct.Label, separator)
+		if !found || name == "" {
+			numNoGroup++
+			continue
+		}
+
+		counts[name]++
+	}
+
+	groups := make([]*AccountGroup, 0, len(counts))
+	for name, count := range counts {
+		groups = append(groups, &AccountGroup{
+			Name:        name,
+			NumAccounts: count,
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+
+	return groups, numNoGroup
+}
+
+var (
+	// labelAdjectives are the adjectives that ReadableLabel chooses from.
+	labelAdjectives = []string{
+		"able", "bold", "brave", "bright", "calm", "clever", "cool",
+		"crisp", "eager", "fair", "fancy", "fast", "fresh", "gentle",
+		"glad", "grand", "happy", "jolly", "keen", "kind", "lively",
+		"lucky", "mellow", "merry", "neat", "nimble", "proud", "quick",
+		"quiet", "sharp", "shiny", "smart", "snappy", "steady", "sunny",
+		"swift", "tidy", "vivid", "warm", "witty",
+	}
+
+	// labelNouns are the nouns that ReadableLabel chooses from.
+	labelNouns = []string{
+		"anchor", "badger", "beacon", "bison", "cedar", "comet",
+		"condor", "coral", "falcon", "fern", "finch", "fox", "glacier",
+		"harbor", "hawk", "heron", "island", "lagoon", "lynx", "maple",
+		"meadow", "meteor", "otter", "owl", "panda", "pebble", "pine",
+		"prairie", "raven", "reef", "river", "robin", "sparrow",
+		"summit", "tiger", "tundra", "valley", "willow", "wolf",
+		"zebra",
+	}
+)
+
+// LabelGenerator returns a new label for an account that was created without
+// one. The account service makes sure the label is unique by generating a new
+// one if it is already taken, so a generator should be able to return a
+// different label on every call.
+type LabelGenerator func() (string, error)
+
+// ReadableLabel is a LabelGenerator that returns labels of the form
+// adjective-noun-number, for example "swift-otter-42". There are more than a
+// million possible labels, so collisions are rare even with many accounts.
+func ReadableLabel() (string, error) {
+	var b [6]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("error generating random label: %w", err)
+	}
+
+	adjective := labelAdjectives[int(binary.BigEndian.Uint16(b[0:2]))%
+		len(labelAdjectives)]
+	noun := labelNouns[int(binary.BigEndian.Uint16(b[2:4]))%len(labelNouns)]
+	number := binary.BigEndian.Uint16(b[4:6]) % 1000
+
+	return fmt.Sprintf("%s-%s-%d", adjective, noun, number), nil
+}
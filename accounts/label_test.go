@@ -0,0 +1,96 @@
+package accounts
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReadableLabel makes sure that generated labels have the expected form
+// and are accepted as account labels.
+func TestReadableLabel(t *testing.T) {
+	t.Parallel()
+
+	labelRegex := regexp.MustCompile(`^[a-z]+-[a-z]+-[0-9]{1,3}$`)
+	for i := 0; i < 100; i++ {
+		label, err := ReadableLabel()
+		require.NoError(t, err)
+		require.Regexp(t, labelRegex, label)
+		require.NoError(t, validateLabel(label))
+	}
+}
+
+// TestGeneratedAccountLabel makes sure that the account service generates a
+// unique label for accounts that are created without one.
+func TestGeneratedAccountLabel(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var labels []string
+	generator := func() (string, error) {
+		if len(labels) == 0 {
+			return "taken", nil
+		}
+
+		label := labels[0]
+		labels = labels[1:]
+
+		return label, nil
+	}
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(
+		store, func(error) {}, WithLabelGenerator(generator),
+	)
+	require.NoError(t, err)
+
+	// An explicitly set label always takes precedence.
+	acct, err := service.NewAccount(ctx, 1000, time.Time{}, "taken")
+	require.NoError(t, err)
+	require.Equal(t, "taken", acct.Label)
+
+	// A generated label that is already taken is replaced by a new one.
+	labels = []string{"taken", "taken", "fresh-label-1"}
+	acct, err = service.NewAccount(ctx, 1000, time.Time{}, "")
+	require.NoError(t, err)
+	require.Equal(t, "fresh-label-1", acct.Label)
+
+	// If the generator only returns labels that are taken, we give up
+	// eventually.
+	_, err = service.NewAccount(ctx, 1000, time.Time{}, "")
+	require.ErrorContains(t, err, "unable to generate a unique account")
+}
+
+// TestGroupAccounts tests that accounts are grouped by the prefix of their
+// label.
+func TestGroupAccounts(t *testing.T) {
+	t.Parallel()
+
+	accounts := []*OffChainBalanceAccount{
+		{Label: "team/alice"},
+		{Label: "team/bob"},
+		{Label: "ops/carol/admin"},
+		{Label: "dave"},
+		{Label: "/eve"},
+		{},
+	}
+
+	groups, numUngrouped := groupAccounts(accounts, DefaultGroupSeparator)
+	require.Equal(t, []*AccountGroup{
+		{Name: "ops", NumAccounts: 1},
+		{Name: "team", NumAccounts: 2},
+	}, groups)
+	require.Equal(t, 3, numUngrouped)
+
+	// The separator can be longer than a single character.
+	groups, numUngrouped = groupAccounts(accounts, "/a")
+	require.Equal(t, []*AccountGroup{
+		{Name: "ops/carol", NumAccounts: 1},
+		{Name: "team", NumAccounts: 1},
+	}, groups)
+	require.Equal(t, 4, numUngrouped)
+}
@@ -0,0 +1,151 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// LedgerEntryType denotes the kind of balance change a ledger entry records.
+// The values are persisted, so existing values must not be changed.
+type LedgerEntryType uint8
+
+const (
+	// LedgerEntryOpening records the initial balance of a new account.
+	LedgerEntryOpening LedgerEntryType = 0
+
+	// LedgerEntryBalanceSet records that the balance of the account was
+	// set to a new value by updating the account.
+	LedgerEntryBalanceSet LedgerEntryType = 1
+
+	// LedgerEntryCredit records a manual credit of the account.
+	LedgerEntryCredit LedgerEntryType = 2
+
+	// LedgerEntryDebit records a manual debit of the account.
+	LedgerEntryDebit LedgerEntryType = 3
+
+	// LedgerEntryInvoice records that the account was credited for a paid
+	// invoice. The reference is the hash of the invoice.
+	LedgerEntryInvoice LedgerEntryType = 4
+
+	// LedgerEntryPayment records that the account was debited for a
+	// settled payment, including its routing fee. The reference is the
+	// hash of the payment.
+	LedgerEntryPayment LedgerEntryType = 5
+
+	// LedgerEntrySweep records that the balance of an account was swept
+	// into another account. It is recorded for both accounts: With a
+	// negative delta for the removed account and a positive one for the
+	// target.
+	LedgerEntrySweep LedgerEntryType = 6
+
+	// LedgerEntryTopUp records that an account was topped up automatically
+	// from the top-up source account. It is recorded for both accounts:
+	// With a positive delta for the topped up account and a negative one
+	// for the source.
+	LedgerEntryTopUp LedgerEntryType = 7
+
+	// LedgerEntryTransfer records that balance was transferred manually
+	// from one account to another. It is recorded for both accounts: With
+	// a negative delta for the source and a positive one for the
+	// destination.
+	LedgerEntryTransfer LedgerEntryType = 8
+
+	// LedgerEntryImport records the balance an account was given when it
+	// was imported. For an account that already existed, the delta is the
+	// difference to its previous balance.
+	LedgerEntryImport LedgerEntryType = 9
+)
+
+// String returns a human-readable representation of the entry type.
+func (t LedgerEntryType) String() string {
+	switch t {
+	case LedgerEntryOpening:
+		return "opening"
+
+	case LedgerEntryBalanceSet:
+		return "balance_set"
+
+	case LedgerEntryCredit:
+		return "credit"
+
+	case LedgerEntryDebit:
+		return "debit"
+
+	case LedgerEntryInvoice:
+		return "invoice"
+
+	case LedgerEntryPayment:
+		return "payment"
+
+	case LedgerEntrySweep:
+		return "sweep"
+
+	case LedgerEntryTopUp:
+		return "top_up"
+
+	case LedgerEntryTransfer:
+		return "transfer"
+
+	case LedgerEntryImport:
+		return "import"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// LedgerEntry is a single change of the balance of an account. Entries are
+// written in the same transaction as the balance change, so the ledger of an
+// account always adds up to its current balance.
+type LedgerEntry struct {
+	// Type is the kind of balance change.
+	Type LedgerEntryType
+
+	// Timestamp is the time at which the balance changed.
+	Timestamp time.Time
+
+	// Delta is the amount in millisatoshis by which the balance changed.
+	// It is negative if the balance went down.
+	Delta int64
+
+	// Balance is the balance of the account in millisatoshis after the
+	// change.
+	Balance int64
+
+	// Reference is the hash of the invoice or payment that caused the
+	// change. It is empty for changes that weren't caused by either.
+	Reference lntypes.Hash
+}
+
+// AccountLedger returns the ledger entries of the account with the given ID
+// that were recorded within the given time range, in the order they were
+// recorded. A zero start or end time leaves the range open on that side. The
+// ledger of a removed account is still available.
+func (s *InterceptorService) AccountLedger(ctx context.Context, id AccountID,
+	start, end time.Time) ([]*LedgerEntry, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	entries, err := s.store.AccountLedger(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered := make([]*LedgerEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !start.IsZero() && entry.Timestamp.Before(start) {
+			continue
+		}
+		if !end.IsZero() && entry.Timestamp.After(end) {
+			continue
+		}
+
+		filtered = append(filtered, entry)
+	}
+
+	return filtered, nil
+}
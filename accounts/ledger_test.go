@@ -0,0 +1,62 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountLedgerTimeRange tests that the account service only returns the
+// ledger entries within the requested time range.
+func TestAccountLedgerTimeRange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	start := time.Unix(1_700_000_000, 0)
+	testClock := clock.NewTestClock(start)
+	store := NewTestDB(t, testClock)
+
+	service, err := NewService(store, func(error) {})
+	require.NoError(t, err)
+
+	acct, err := store.NewAccount(ctx, 1000, time.Time{}, "ledger")
+	require.NoError(t, err)
+
+	testClock.SetTime(start.Add(time.Hour))
+	require.NoError(t, store.CreditAccount(ctx, acct.ID, 500))
+
+	testClock.SetTime(start.Add(2 * time.Hour))
+	require.NoError(t, store.DebitAccount(ctx, acct.ID, 200))
+
+	assertTypes := func(from, to time.Time, expected ...LedgerEntryType) {
+		t.Helper()
+
+		entries, err := service.AccountLedger(ctx, acct.ID, from, to)
+		require.NoError(t, err)
+		require.Len(t, entries, len(expected))
+
+		for i, entry := range entries {
+			require.Equal(t, expected[i], entry.Type)
+		}
+	}
+
+	assertTypes(
+		time.Time{}, time.Time{}, LedgerEntryOpening,
+		LedgerEntryCredit, LedgerEntryDebit,
+	)
+	assertTypes(
+		start.Add(time.Hour), time.Time{}, LedgerEntryCredit,
+		LedgerEntryDebit,
+	)
+	assertTypes(
+		time.Time{}, start.Add(time.Hour), LedgerEntryOpening,
+		LedgerEntryCredit,
+	)
+	assertTypes(
+		start.Add(time.Minute), start.Add(90*time.Minute),
+		LedgerEntryCredit,
+	)
+}
@@ -0,0 +1,284 @@
+package accounts
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// lifecycleEventBufferSize is the number of lifecycle events that are
+	// buffered for each subscriber on top of the events that are replayed
+	// when it resumes. A subscriber that doesn't keep up is dropped.
+	lifecycleEventBufferSize = 100
+
+	// lifecycleEventBacklogSize is the number of recent lifecycle events
+	// that are kept in memory so that a subscriber can resume after it
+	// was disconnected.
+	lifecycleEventBacklogSize = 1000
+)
+
+// AccountLifecycleEventType denotes the kind of change to an account that an
+// AccountLifecycleEvent describes.
+type AccountLifecycleEventType uint8
+
+const (
+	// AccountLifecycleCreated is emitted when an account is created.
+	AccountLifecycleCreated AccountLifecycleEventType = iota
+
+	// AccountLifecycleUpdated is emitted when the settings or the balance
+	// of an account change. Changes to the invoices and payments of an
+	// account that don't affect its balance are not emitted.
+	AccountLifecycleUpdated
+
+	// AccountLifecycleRemoved is emitted when an account is removed.
+	AccountLifecycleRemoved
+
+	// AccountLifecycleExpiringSoon is emitted once when an account reaches
+	// the expiry warning lead time before its expiration date. It is
+	// emitted again if the expiration date is changed afterwards.
+	AccountLifecycleExpiringSoon
+)
+
+// String returns a human-readable representation of the event type.
+func (t AccountLifecycleEventType) String() string {
+	switch t {
+	case AccountLifecycleCreated:
+		return "created"
+
+	case AccountLifecycleUpdated:
+		return "updated"
+
+	case AccountLifecycleRemoved:
+		return "removed"
+
+	case AccountLifecycleExpiringSoon:
+		return "expiring_soon"
+
+	default:
+		return "unknown"
+	}
+}
+
+// AccountLifecycleEvent describes the creation, change or removal of an
+// account. Together with a listing of all accounts, the events can be used to
+// maintain a mirror of the accounts outside of litd.
+type AccountLifecycleEvent struct {
+	// Type is the kind of change.
+	Type AccountLifecycleEventType
+
+	// AccountID is the ID of the account that changed.
+	AccountID AccountID
+
+	// Account is the full account after the change. It is nil for removed
+	// accounts.
+	Account *OffChainBalanceAccount
+
+	// Timestamp is the time at which the event was emitted.
+	Timestamp time.Time
+
+	// ResumeToken identifies the position of the event in the stream of
+	// lifecycle events. Subscribing with it replays all events that were
+	// emitted after this one.
+	ResumeToken string
+}
+
+// lifecycleSubscriber is a single subscriber of the lifecycle event broker.
+type lifecycleSubscriber struct {
+	events chan *AccountLifecycleEvent
+}
+
+// lifecycleEventBroker fans out account lifecycle events to all current
+// subscribers and keeps a backlog of recent events for subscribers that
+// resume. Events are numbered in the order they are published. The numbers
+// start over when litd restarts, so resume tokens also contain a random epoch
+// that identifies the broker they were issued by.
+type lifecycleEventBroker struct {
+	mu          sync.Mutex
+	epoch       uint64
+	seq         uint64
+	backlog     []*AccountLifecycleEvent
+	nextID      uint64
+	subscribers map[uint64]*lifecycleSubscriber
+}
+
+// newLifecycleEventBroker creates a new lifecycleEventBroker with a random
+// epoch and without any subscribers.
+func newLifecycleEventBroker() *lifecycleEventBroker {
+	var epoch [8]byte
+	_, _ = rand.Read(epoch[:])
+
+	return &lifecycleEventBroker{
+		epoch:       binary.BigEndian.Uint64(epoch[:]),
+		subscribers: make(map[uint64]*lifecycleSubscriber),
+	}
+}
+
+// resumeToken returns the resume token of the event with the given sequence
+// number.
+func (b *lifecycleEventBroker) resumeToken(seq uint64) string {
+	return fmt.Sprintf("%016x:%d", b.epoch, seq)
+}
+
+// parseResumeToken returns the sequence number of the event the given resume
+// token was issued for. ErrResumeTokenExpired is returned if the token was
+// issued before litd was restarted.
+func (b *lifecycleEventBroker) parseResumeToken(token string) (uint64,
+	error) {
+
+	epochStr, seqStr, ok := strings.Cut(token, ":")
+	if !ok {
+		return 0, fmt.Errorf("invalid resume token '%s'", token)
+	}
+
+	epoch, err := strconv.ParseUint(epochStr, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid resume token '%s': %w", token, err)
+	}
+
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid resume token '%s': %w", token, err)
+	}
+
+	if epoch != b.epoch {
+		return 0, fmt.Errorf("%w: token was issued before litd was "+
+			"restarted", ErrResumeTokenExpired)
+	}
+
+	return seq, nil
+}
+
+// subscribe registers a new subscriber. If a resume token is given, all events
+// that were published after the event with that token are delivered first.
+// ErrResumeTokenExpired is returned if those events are no longer all known.
+// The returned channel is closed if the subscriber doesn't keep up. The
+// returned function must be called to remove the subscription once the caller
+// is no longer interested in events.
+func (b *lifecycleEventBroker) subscribe(resumeToken string) (
+	<-chan *AccountLifecycleEvent, func(), error) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []*AccountLifecycleEvent
+	if resumeToken != "" {
+		seq, err := b.parseResumeToken(resumeToken)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// The backlog holds the events up to the current sequence
+		// number without gaps, so we can resume if the first event
+		// after the token is still in there.
+		oldest := b.seq - uint64(len(b.backlog)) + 1
+		switch {
+		case seq > b.seq:
+			return nil, nil, fmt.Errorf("unknown resume token '%s'",
+				resumeToken)
+
+		case seq+1 < oldest:
+			return nil, nil, fmt.Errorf("%w: %d events were "+
+				"missed", ErrResumeTokenExpired, b.seq-seq)
+		}
+
+		replay = b.backlog[len(b.backlog)-int(b.seq-seq):]
+	}
+
+	sub := &lifecycleSubscriber{
+		events: make(
+			chan *AccountLifecycleEvent,
+			len(replay)+lifecycleEventBufferSize,
+		),
+	}
+	for _, event := range replay {
+		sub.events <- event
+	}
+
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = sub
+
+	cancel := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subscribers, id)
+	}
+
+	return sub.events, cancel, nil
+}
+
+// publish numbers the given event, adds it to the backlog and sends it to all
+// subscribers. It never blocks, so it is safe to call while the account service
+// lock is held. Subscribers that don't keep up are dropped instead of skipping
+// the event, so that they can resume without missing any.
+func (b *lifecycleEventBroker) publish(event *AccountLifecycleEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event.ResumeToken = b.resumeToken(b.seq)
+
+	b.backlog = append(b.backlog, event)
+	if len(b.backlog) > lifecycleEventBacklogSize {
+		b.backlog = b.backlog[1:]
+	}
+
+	for id, sub := range b.subscribers {
+		select {
+		case sub.events <- event:
+		default:
+			log.Warnf("Dropping slow account lifecycle event "+
+				"subscriber %d", id)
+
+			close(sub.events)
+			delete(b.subscribers, id)
+		}
+	}
+}
+
+// publishLifecycleEvent publishes a lifecycle event of the given type for the
+// account with the given ID. For accounts that weren't removed, the account is
+// read from the store so that the event contains the full record.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) publishLifecycleEvent(ctx context.Context,
+	eventType AccountLifecycleEventType, id AccountID) {
+
+	event := &AccountLifecycleEvent{
+		Type:      eventType,
+		AccountID: id,
+		Timestamp: time.Now(),
+	}
+
+	if eventType != AccountLifecycleRemoved {
+		acct, err := s.store.Account(ctx, id)
+		if err != nil {
+			log.Errorf("Unable to fetch account %x for %v "+
+				"lifecycle event: %v", id[:], eventType, err)
+
+			return
+		}
+		event.Account = acct
+	}
+
+	s.lifecycleEvents.publish(event)
+}
+
+// SubscribeAccountLifecycle returns a channel on which the lifecycle events of
+// all accounts are delivered, together with a function that must be called to
+// end the subscription. If a resume token is given, the events that were
+// emitted after the event with that token are delivered first. The channel is
+// closed if the receiver doesn't keep up, in which case it can subscribe again
+// with the token of the last event it received.
+func (s *InterceptorService) SubscribeAccountLifecycle(resumeToken string) (
+	<-chan *AccountLifecycleEvent, func(), error) {
+
+	return s.lifecycleEvents.subscribe(resumeToken)
+}
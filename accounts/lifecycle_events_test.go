@@ -0,0 +1,130 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLifecycleEventBroker tests that lifecycle events are delivered to all
+// current subscribers, that subscriptions can be resumed from the backlog and
+// that slow subscribers are dropped.
+func TestLifecycleEventBroker(t *testing.T) {
+	t.Parallel()
+
+	broker := newLifecycleEventBroker()
+
+	events, cancel, err := broker.subscribe("")
+	require.NoError(t, err)
+
+	publish := func(n int) []*AccountLifecycleEvent {
+		published := make([]*AccountLifecycleEvent, 0, n)
+		for i := 0; i < n; i++ {
+			event := &AccountLifecycleEvent{
+				Type:      AccountLifecycleUpdated,
+				AccountID: AccountID{byte(i)},
+			}
+			broker.publish(event)
+			published = append(published, event)
+		}
+
+		return published
+	}
+
+	published := publish(3)
+	for _, event := range published {
+		require.Equal(t, event, <-events)
+	}
+	cancel()
+
+	// Resuming after the first event replays the other two.
+	events, cancel, err = broker.subscribe(published[0].ResumeToken)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, published[1], <-events)
+	require.Equal(t, published[2], <-events)
+	cancel()
+
+	// Resuming after the last event doesn't replay anything.
+	events, cancel, err = broker.subscribe(published[2].ResumeToken)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	// A subscriber that doesn't read its events is dropped, which closes
+	// its channel.
+	publish(lifecycleEventBufferSize + 1)
+	for i := 0; i < lifecycleEventBufferSize; i++ {
+		<-events
+	}
+	_, ok := <-events
+	require.False(t, ok)
+	cancel()
+
+	// Once the event after a token is no longer in the backlog, the
+	// subscription can't be resumed.
+	publish(lifecycleEventBacklogSize)
+	_, _, err = broker.subscribe(published[2].ResumeToken)
+	require.ErrorIs(t, err, ErrResumeTokenExpired)
+
+	// Neither can tokens of another broker, for example from before a
+	// restart.
+	_, _, err = newLifecycleEventBroker().subscribe(
+		published[2].ResumeToken,
+	)
+	require.ErrorIs(t, err, ErrResumeTokenExpired)
+
+	_, _, err = broker.subscribe("invalid")
+	require.Error(t, err)
+}
+
+// TestAccountLifecycleEvents tests that the account service emits lifecycle
+// events with the full account when accounts are created and changed and with
+// the account ID when they are removed.
+func TestAccountLifecycleEvents(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	events, cancel, err := service.SubscribeAccountLifecycle("")
+	require.NoError(t, err)
+	defer cancel()
+
+	acct, err := service.NewAccount(ctx, 1000, time.Time{}, "mirror")
+	require.NoError(t, err)
+
+	event := <-events
+	require.Equal(t, AccountLifecycleCreated, event.Type)
+	require.Equal(t, acct.ID, event.AccountID)
+	require.EqualValues(t, 1000, event.Account.CurrentBalance)
+
+	_, err = service.CreditAccount(ctx, acct.ID, 500)
+	require.NoError(t, err)
+
+	event = <-events
+	require.Equal(t, AccountLifecycleUpdated, event.Type)
+	require.EqualValues(t, 1500, event.Account.CurrentBalance)
+
+	require.NoError(t, service.RemoveAccount(ctx, acct.ID))
+
+	event = <-events
+	require.Equal(t, AccountLifecycleRemoved, event.Type)
+	require.Equal(t, acct.ID, event.AccountID)
+	require.Nil(t, event.Account)
+}
@@ -0,0 +1,209 @@
+package accounts
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/fn"
+)
+
+// listAccountsBatchSize is the number of accounts that are read from the store
+// at once while accounts are listed.
+const listAccountsBatchSize = 500
+
+// AccountSortField is the account property that accounts are sorted by when
+// they are listed.
+type AccountSortField uint8
+
+const (
+	// AccountSortDefault sorts the accounts in the order of the store,
+	// which doesn't change as accounts are added or removed.
+	AccountSortDefault AccountSortField = iota
+
+	// AccountSortBalance sorts the accounts by their current balance.
+	AccountSortBalance
+
+	// AccountSortExpiration sorts the accounts by their expiration date.
+	// Accounts that never expire expire after all others.
+	AccountSortExpiration
+
+	// AccountSortLabel sorts the accounts by their label. Accounts without
+	// a label come before all others.
+	AccountSortLabel
+
+	// AccountSortCreated sorts the accounts by the time they were created.
+	AccountSortCreated
+)
+
+// String returns a human-readable representation of the sort field.
+func (f AccountSortField) String() string {
+	switch f {
+	case AccountSortDefault:
+		return "default"
+
+	case AccountSortBalance:
+		return "balance"
+
+	case AccountSortExpiration:
+		return "expiration"
+
+	case AccountSortLabel:
+		return "label"
+
+	case AccountSortCreated:
+		return "created"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(f))
+	}
+}
+
+// AccountSort is the order in which accounts are listed. Accounts with the same
+// value of the sort field are ordered by their alias, so the order is always
+// total and stays the same across pages.
+type AccountSort struct {
+	// Field is the account property to sort by.
+	Field AccountSortField
+
+	// Descending reverses the order.
+	Descending bool
+}
+
+// String returns a human-readable representation of the sort order.
+func (s AccountSort) String() string {
+	if s.Descending {
+		return s.Field.String() + " desc"
+	}
+
+	return s.Field.String()
+}
+
+// compare returns a negative number if account a comes before account b in the
+// sort order, a positive number if it comes after it and zero if they are the
+// same account. Accounts are only ordered by their alias for the default sort.
+func (s AccountSort) compare(a, b *OffChainBalanceAccount) int {
+	var result int
+	switch s.Field {
+	case AccountSortBalance:
+		result = cmp.Compare(a.CurrentBalance, b.CurrentBalance)
+
+	case AccountSortExpiration:
+		// Accounts that never expire have a zero expiration date but
+		// must come last.
+		aNever := a.ExpirationDate.IsZero()
+		bNever := b.ExpirationDate.IsZero()
+		switch {
+		case aNever && !bNever:
+			result = 1
+
+		case !aNever && bNever:
+			result = -1
+
+		default:
+			result = a.ExpirationDate.Compare(b.ExpirationDate)
+		}
+
+	case AccountSortLabel:
+		result = strings.Compare(a.Label, b.Label)
+
+	case AccountSortCreated:
+		result = a.CreatedAt.Compare(b.CreatedAt)
+	}
+
+	// The alias of an account is its ID read as a signed integer, which is
+	// what the SQL store orders by.
+	if result == 0 {
+		result = cmp.Compare(
+			int64(byteOrder.Uint64(a.ID[:])),
+			int64(byteOrder.Uint64(b.ID[:])),
+		)
+	}
+
+	if s.Descending {
+		result = -result
+	}
+
+	return result
+}
+
+// AccountFilter selects the accounts that are returned when listing accounts.
+// The zero value selects all accounts that haven't expired.
+type AccountFilter struct {
+	// LabelPrefix, if set, only selects accounts with a label that starts
+	// with it.
+	LabelPrefix string
+
+	// MinBalance only selects accounts with a current balance in
+	// millisatoshis of at least this amount.
+	MinBalance int64
+
+	// IncludeExpired also selects accounts that have expired.
+	IncludeExpired bool
+
+	// Sandbox, if set, only selects sandbox accounts if it is true and
+	// only other accounts if it is false.
+	Sandbox fn.Option[bool]
+}
+
+// matches returns true if the filter selects the given account.
+func (f AccountFilter) matches(acct *OffChainBalanceAccount) bool {
+	if !strings.HasPrefix(acct.Label, f.LabelPrefix) {
+		return false
+	}
+
+	if acct.CurrentBalance < f.MinBalance {
+		return false
+	}
+
+	if !f.IncludeExpired && acct.HasExpired() {
+		return false
+	}
+
+	return f.Sandbox.UnwrapOr(acct.Sandbox) == acct.Sandbox
+}
+
+// ListAccounts returns up to limit accounts that are selected by the given
+// filter in the given sort order, starting at the given account. A limit of
+// zero returns all selected accounts. The order of the accounts is stable, so
+// the returned start of the next page always continues right after the
+// returned accounts, even if accounts are created or removed in the meantime.
+// It is None if there are no more selected accounts. For any but the default
+// sort order, the account a page starts with must not be removed before the
+// page is listed.
+func (s *InterceptorService) ListAccounts(ctx context.Context,
+	filter AccountFilter, sort AccountSort, start fn.Option[AccountID],
+	limit int) ([]*OffChainBalanceAccount, fn.Option[AccountID], error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	var selected []*OffChainBalanceAccount
+	for {
+		batch, next, err := s.store.AccountsPage(
+			ctx, sort, start, listAccountsBatchSize,
+		)
+		if err != nil {
+			return nil, fn.None[AccountID](), err
+		}
+
+		for _, acct := range batch {
+			if !filter.matches(acct) {
+				continue
+			}
+
+			// The next page starts with the first selected account
+			// that doesn't fit into this one.
+			if limit > 0 && len(selected) == limit {
+				return selected, fn.Some(acct.ID), nil
+			}
+			selected = append(selected, acct)
+		}
+
+		if next.IsNone() {
+			return selected, fn.None[AccountID](), nil
+		}
+		start = next
+	}
+}
@@ -0,0 +1,126 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestListAccounts tests that accounts are filtered before they are paginated,
+// so that every page except the last one is full.
+func TestListAccounts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	newAccount := func(label string, balance int64, expired,
+		sandbox bool) {
+
+		var expiry time.Time
+		if expired {
+			expiry = time.Now().Add(-time.Hour)
+		}
+
+		var opts []NewAccountOption
+		if sandbox {
+			opts = append(opts, WithSandbox())
+		}
+
+		_, err := store.NewAccount(
+			ctx, lnwire.MilliSatoshi(balance), expiry, label,
+			opts...,
+		)
+		require.NoError(t, err)
+	}
+
+	for i := 0; i < 5; i++ {
+		newAccount(fmt.Sprintf("team/%d", i), 1000, false, false)
+		newAccount(fmt.Sprintf("other/%d", i), 1000, false, false)
+	}
+	newAccount("team/poor", 10, false, false)
+	newAccount("team/expired", 1000, true, false)
+	newAccount("team/sandbox", 1000, false, true)
+
+	listAll := func(filter AccountFilter, sort AccountSort,
+		limit int) []*OffChainBalanceAccount {
+
+		var (
+			all   []*OffChainBalanceAccount
+			start = fn.None[AccountID]()
+		)
+		for {
+			page, next, err := service.ListAccounts(
+				ctx, filter, sort, start, limit,
+			)
+			require.NoError(t, err)
+
+			if next.IsNone() {
+				return append(all, page...)
+			}
+			require.Len(t, page, limit)
+
+			all = append(all, page...)
+			start = next
+		}
+	}
+
+	labels := func(accts []*OffChainBalanceAccount) []string {
+		result := make([]string, 0, len(accts))
+		for _, acct := range accts {
+			result = append(result, acct.Label)
+		}
+
+		return result
+	}
+
+	filter := AccountFilter{
+		LabelPrefix: "team/",
+		MinBalance:  100,
+		Sandbox:     fn.Some(false),
+	}
+	require.ElementsMatch(t, []string{
+		"team/0", "team/1", "team/2", "team/3", "team/4",
+	}, labels(listAll(filter, AccountSort{}, 2)))
+
+	// Sorted accounts are filtered before they are paginated as well.
+	sort := AccountSort{Field: AccountSortLabel, Descending: true}
+	require.Equal(t, []string{
+		"team/4", "team/3", "team/2", "team/1", "team/0",
+	}, labels(listAll(filter, sort, 2)))
+
+	filter.IncludeExpired = true
+	filter.Sandbox = fn.None[bool]()
+	require.ElementsMatch(t, []string{
+		"team/0", "team/1", "team/2", "team/3", "team/4",
+		"team/expired", "team/sandbox",
+	}, labels(listAll(filter, AccountSort{}, 3)))
+
+	// Without a limit, all accounts that aren't expired are returned at
+	// once.
+	accts, next, err := service.ListAccounts(
+		ctx, AccountFilter{}, AccountSort{}, fn.None[AccountID](), 0,
+	)
+	require.NoError(t, err)
+	require.Len(t, accts, 12)
+	require.True(t, next.IsNone())
+}
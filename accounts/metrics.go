@@ -0,0 +1,126 @@
+package accounts
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// outcomeAccepted is the outcome of an intercepted message that was
+	// passed on unchanged.
+	outcomeAccepted = "accepted"
+
+	// outcomeReplaced is the outcome of an intercepted message that was
+	// replaced with a different message or error.
+	outcomeReplaced = "replaced"
+
+	// outcomeRejected is the outcome of an intercepted message that was
+	// rejected, for example because of an insufficient balance.
+	outcomeRejected = "rejected"
+
+	// outcomeError is the outcome of an intercepted message that couldn't
+	// be handled at all.
+	outcomeError = "error"
+)
+
+var (
+	// commitLatency records the time it takes to commit a write
+	// transaction of the kvdb account store, labeled by commit policy.
+	// The metric is registered with the default prometheus registry, which
+	// lnd exposes if it is built with monitoring support and litd runs in
+	// integrated mode.
+	commitLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "litd",
+		Subsystem: "accounts",
+		Name:      "store_commit_latency_seconds",
+		Help: "Time it takes to commit a write transaction of the " +
+			"accounts store.",
+		Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+	}, []string{"policy"})
+
+	// inFlightExposure is the total amount in millisatoshis of all
+	// payments charged to accounts that are currently in flight.
+	inFlightExposure = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "litd",
+		Subsystem: "accounts",
+		Name:      "inflight_exposure_msat",
+		Help: "Total amount of all in-flight payments charged to " +
+			"accounts, including reserved routing fees.",
+	})
+
+	// interceptLatency records the time it takes the accounts interceptor
+	// to decide on a request or response, labeled by the decision. It
+	// includes reading the account from the store and all balance, in-flight
+	// and rate limit checks.
+	interceptLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "litd",
+		Subsystem: "accounts",
+		Name:      "intercept_latency_seconds",
+		Help: "Time it takes the accounts interceptor to decide on an " +
+			"intercepted message.",
+		Buckets: prometheus.ExponentialBuckets(0.0001, 2, 16),
+	}, []string{"outcome"})
+
+	metricsOnce sync.Once
+)
+
+// registerMetrics registers the metrics of the accounts package with the
+// default prometheus registry. It is safe to call multiple times.
+func registerMetrics() {
+	metricsOnce.Do(func() {
+		err := prometheus.Register(commitLatency)
+		if err != nil {
+			log.Warnf("Unable to register accounts store metrics: "+
+				"%v", err)
+		}
+
+		err = prometheus.Register(inFlightExposure)
+		if err != nil {
+			log.Warnf("Unable to register accounts exposure "+
+				"metrics: %v", err)
+		}
+
+		err = prometheus.Register(interceptLatency)
+		if err != nil {
+			log.Warnf("Unable to register accounts interceptor "+
+				"metrics: %v", err)
+		}
+	})
+}
+
+// observeCommitLatency records the duration of a single commit.
+func observeCommitLatency(policy CommitPolicy, d time.Duration) {
+	commitLatency.WithLabelValues(string(policy)).Observe(d.Seconds())
+}
+
+// observeInterceptLatency records the duration of a single interception with
+// the outcome derived from its result.
+func observeInterceptLatency(resp *lnrpc.RPCMiddlewareResponse, err error,
+	d time.Duration) {
+
+	outcome := interceptOutcome(resp, err)
+	interceptLatency.WithLabelValues(outcome).Observe(d.Seconds())
+}
+
+// interceptOutcome returns the decision the interceptor made for a message,
+// given its result.
+func interceptOutcome(resp *lnrpc.RPCMiddlewareResponse, err error) string {
+	feedback := resp.GetFeedback()
+
+	switch {
+	case err != nil:
+		return outcomeError
+
+	case feedback.GetReplaceResponse():
+		return outcomeReplaced
+
+	case feedback.GetError() != "":
+		return outcomeRejected
+
+	default:
+		return outcomeAccepted
+	}
+}
@@ -0,0 +1,136 @@
+package accounts
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// paymentEventBufferSize is the number of payment events that are buffered
+// for each subscriber. Events for a subscriber that doesn't keep up are
+// dropped.
+const paymentEventBufferSize = 100
+
+// PaymentEventType denotes the stage in the lifecycle of a payment that a
+// PaymentEvent describes.
+type PaymentEventType uint8
+
+const (
+	// PaymentEventInitiated is emitted once the account service starts
+	// tracking a payment that is charged to an account.
+	PaymentEventInitiated PaymentEventType = iota
+
+	// PaymentEventInFlight is emitted for every update lnd reports while
+	// the payment is still in flight.
+	PaymentEventInFlight
+
+	// PaymentEventSettled is emitted once the payment succeeded and the
+	// account was debited.
+	PaymentEventSettled
+
+	// PaymentEventFailed is emitted once the payment failed.
+	PaymentEventFailed
+)
+
+// String returns a human-readable representation of the event type.
+func (t PaymentEventType) String() string {
+	switch t {
+	case PaymentEventInitiated:
+		return "initiated"
+
+	case PaymentEventInFlight:
+		return "in_flight"
+
+	case PaymentEventSettled:
+		return "settled"
+
+	case PaymentEventFailed:
+		return "failed"
+
+	default:
+		return "unknown"
+	}
+}
+
+// PaymentEvent describes a change in the lifecycle of a payment that is
+// charged to an account.
+type PaymentEvent struct {
+	// Type is the lifecycle stage of the payment.
+	Type PaymentEventType
+
+	// AccountID is the ID of the account the payment is charged to.
+	AccountID AccountID
+
+	// Hash is the payment hash of the payment.
+	Hash lntypes.Hash
+
+	// Amount is the amount of the payment excluding fees as reported by
+	// lnd. For initiated payments, lnd hasn't reported anything yet, so
+	// this is the full amount reserved for the payment, including the
+	// maximum routing fee.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the routing fee that was paid. It is only set for settled
+	// payments.
+	Fee lnwire.MilliSatoshi
+
+	// Timestamp is the time at which the event was emitted.
+	Timestamp time.Time
+}
+
+// paymentEventBroker fans out payment events to all current subscribers.
+type paymentEventBroker struct {
+	mu          sync.Mutex
+	nextID      uint64
+	subscribers map[uint64]chan *PaymentEvent
+}
+
+// newPaymentEventBroker creates a new paymentEventBroker without any
+// subscribers.
+func newPaymentEventBroker() *paymentEventBroker {
+	return &paymentEventBroker{
+		subscribers: make(map[uint64]chan *PaymentEvent),
+	}
+}
+
+// subscribe registers a new subscriber. The returned function must be called
+// to remove the subscription once the caller is no longer interested in
+// events.
+func (b *paymentEventBroker) subscribe() (<-chan *PaymentEvent, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+
+	events := make(chan *PaymentEvent, paymentEventBufferSize)
+	b.subscribers[id] = events
+
+	cancel := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subscribers, id)
+	}
+
+	return events, cancel
+}
+
+// publish sends the given event to all subscribers. It never blocks, so it is
+// safe to call while the account service lock is held.
+func (b *paymentEventBroker) publish(event *PaymentEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for id, events := range b.subscribers {
+		select {
+		case events <- event:
+		default:
+			log.Warnf("Dropping %v event of payment %v for slow "+
+				"payment event subscriber %d", event.Type,
+				event.Hash, id)
+		}
+	}
+}
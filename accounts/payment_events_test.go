@@ -0,0 +1,46 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPaymentEventBroker tests that payment events are delivered to all
+// current subscribers and that cancelled or slow subscribers don't block the
+// publisher.
+func TestPaymentEventBroker(t *testing.T) {
+	t.Parallel()
+
+	broker := newPaymentEventBroker()
+
+	events1, cancel1 := broker.subscribe()
+	events2, cancel2 := broker.subscribe()
+	defer cancel2()
+
+	event := &PaymentEvent{
+		Type:      PaymentEventSettled,
+		AccountID: AccountID{1, 2, 3},
+		Hash:      lntypes.Hash{4, 5, 6},
+		Amount:    1000,
+		Fee:       10,
+	}
+	broker.publish(event)
+
+	require.Equal(t, event, <-events1)
+	require.Equal(t, event, <-events2)
+
+	// A cancelled subscriber no longer receives events.
+	cancel1()
+	broker.publish(event)
+	require.Equal(t, event, <-events2)
+	require.Empty(t, events1)
+
+	// A subscriber that doesn't read its events doesn't block the
+	// publisher, the surplus events are dropped instead.
+	for i := 0; i < paymentEventBufferSize+10; i++ {
+		broker.publish(event)
+	}
+	require.Len(t, events2, paymentEventBufferSize)
+}
@@ -0,0 +1,115 @@
+package accounts
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	"gopkg.in/macaroon.v2"
+)
+
+// condPaymentHash is the keyword that follows the account caveat name in a
+// caveat that restricts an account macaroon to a single payment.
+const condPaymentHash = "payment_hash"
+
+var (
+	// paymentHashCaveatPrefix is the prefix of the custom caveat that
+	// restricts an account macaroon to a single payment. The condition
+	// after the prefix is the hex encoded payment hash.
+	paymentHashCaveatPrefix = fmt.Sprintf(
+		"%s%s ", caveatPrefix, condPaymentHash,
+	)
+
+	// PaymentMacaroonPermissions are the permissions of an account
+	// macaroon that is restricted to a single payment. They allow sending
+	// and tracking the payment but not creating invoices.
+	PaymentMacaroonPermissions = []bakery.Op{{
+		Entity: "info",
+		Action: "read",
+	}, {
+		Entity: "offchain",
+		Action: "read",
+	}, {
+		Entity: "offchain",
+		Action: "write",
+	}}
+)
+
+// PaymentHashCaveat creates a custom caveat that restricts an account macaroon
+// to paying the invoice with the given payment hash. The caveat uses the name
+// of the account caveat, so lnd hands requests made with the macaroon to the
+// account interceptor, which enforces it.
+func PaymentHashCaveat(hash lntypes.Hash) macaroon.Caveat {
+	condition := checkers.Condition(macaroons.CondLndCustom, fmt.Sprintf(
+		"%s %s %x", CondAccount, condPaymentHash, hash[:],
+	))
+
+	return macaroon.Caveat{Id: []byte(condition)}
+}
+
+// paymentHashFromCaveats returns the payment hash a macaroon is restricted to
+// by its payment hash caveats. Since a macaroon can only be attenuated, an
+// error is returned if the caveats name different hashes.
+func paymentHashFromCaveats(caveats []macaroon.Caveat) (
+	fn.Option[lntypes.Hash], error) {
+
+	var paymentHash fn.Option[lntypes.Hash]
+	for _, caveat := range caveats {
+		_, after, found := strings.Cut(
+			string(caveat.Id), paymentHashCaveatPrefix,
+		)
+		if !found {
+			continue
+		}
+
+		hashBytes, err := hex.DecodeString(after)
+		if err != nil {
+			return fn.None[lntypes.Hash](), err
+		}
+
+		hash, err := lntypes.MakeHash(hashBytes)
+		if err != nil {
+			return fn.None[lntypes.Hash](), err
+		}
+
+		if hash != paymentHash.UnwrapOr(hash) {
+			return fn.None[lntypes.Hash](), fmt.Errorf("conflicting "+
+				"payment hash caveats %v and %v",
+				paymentHash.UnwrapOr(hash), hash)
+		}
+
+		paymentHash = fn.Some(hash)
+	}
+
+	return paymentHash, nil
+}
+
+// checkPaymentHash makes sure that a payment with the given hash may be sent
+// with the macaroon of the request in the context.
+func checkPaymentHash(ctx context.Context, hash lntypes.Hash) error {
+	allowed, ok := PaymentHashFromContext(ctx)
+	if !ok || allowed == hash {
+		return nil
+	}
+
+	return fmt.Errorf("%w: macaroon may only be used to pay %v",
+		ErrPaymentHashNotAllowed, allowed)
+}
+
+// paymentMacaroonUsed returns true if the payment with the given hash has
+// already succeeded for the account, which means that a macaroon restricted to
+// that payment has been used up.
+func paymentMacaroonUsed(acct *OffChainBalanceAccount,
+	hash lntypes.Hash) bool {
+
+	entry, ok := acct.Payments[hash]
+
+	return ok && entry.Status == lnrpc.Payment_SUCCEEDED
+}
@@ -0,0 +1,81 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+)
+
+// RootKeyIDInUse is a function that reports whether a macaroon root key ID
+// with the given suffix is used outside of the accounts service, for example
+// by a session.
+type RootKeyIDInUse func(ctx context.Context, suffix [4]byte) (bool, error)
+
+// WithRootKeyIDInUse is a functional option that can be passed to NewService
+// to let the service check that the macaroon root key of an account isn't
+// shared with anything other than accounts, such as sessions.
+func WithRootKeyIDInUse(inUse RootKeyIDInUse) ServiceOption {
+	return func(s *InterceptorService) {
+		s.rootKeyIDInUse = inUse
+	}
+}
+
+// rootKeyIDSuffix returns the suffix of the macaroon root key ID that the
+// macaroons of the account with the given ID are baked under.
+func rootKeyIDSuffix(id AccountID) [4]byte {
+	var suffix [4]byte
+	copy(suffix[:], id[0:4])
+
+	return suffix
+}
+
+// checkRootKeyID returns ErrRootKeyIDInUse if the macaroon root key of the
+// account with the given ID is shared with another account or with anything
+// else that uses super macaroon root keys.
+func (s *InterceptorService) checkRootKeyID(ctx context.Context,
+	id AccountID) error {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.checkRootKeyIDUnsafe(ctx, id)
+}
+
+// checkRootKeyIDUnsafe is like checkRootKeyID, but doesn't acquire the service
+// lock.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) checkRootKeyIDUnsafe(ctx context.Context,
+	id AccountID) error {
+
+	suffix := rootKeyIDSuffix(id)
+
+	accts, err := s.store.Accounts(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list accounts: %w", err)
+	}
+
+	for _, acct := range accts {
+		if acct.ID == id || rootKeyIDSuffix(acct.ID) != suffix {
+			continue
+		}
+
+		return fmt.Errorf("%w: account %v uses the macaroon root key "+
+			"%d", ErrRootKeyIDInUse, acct.ID,
+			accountMacaroonRootKeyID(id))
+	}
+
+	if s.rootKeyIDInUse == nil {
+		return nil
+	}
+
+	inUse, err := s.rootKeyIDInUse(ctx, suffix)
+	if err != nil {
+		return fmt.Errorf("unable to check macaroon root key: %w", err)
+	}
+	if inUse {
+		return fmt.Errorf("%w: a session uses the macaroon root key %d",
+			ErrRootKeyIDInUse, accountMacaroonRootKeyID(id))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package accounts
+
+import (
+	"fmt"
+)
+
+// RoundingMode determines how millisatoshi amounts are converted to whole
+// satoshis when balances are reported.
+type RoundingMode string
+
+const (
+	// RoundDown drops any fractional satoshi. This favors the node
+	// operator, since an account is never shown more than it can spend.
+	// The zero value of RoundingMode behaves like RoundDown.
+	RoundDown RoundingMode = "down"
+
+	// RoundUp rounds any fractional satoshi up to the next full satoshi.
+	// This favors the account holder.
+	RoundUp RoundingMode = "up"
+
+	// RoundNearest rounds to the nearest full satoshi, with half a satoshi
+	// being rounded away from zero.
+	RoundNearest RoundingMode = "nearest"
+)
+
+// Validate returns an error if the rounding mode is unknown.
+func (r RoundingMode) Validate() error {
+	switch r {
+	case "", RoundDown, RoundUp, RoundNearest:
+		return nil
+
+	default:
+		return fmt.Errorf("unknown rounding mode '%s'", r)
+	}
+}
+
+// ToSatoshis converts the given amount in millisatoshis to satoshis according
+// to the rounding mode. Negative amounts are rounded symmetrically to positive
+// ones.
+func (r RoundingMode) ToSatoshis(msat int64) int64 {
+	if msat < 0 {
+		return -r.ToSatoshis(-msat)
+	}
+
+	sats, remainder := msat/1000, msat%1000
+	switch {
+	case r == RoundUp && remainder > 0:
+		sats++
+
+	case r == RoundNearest && remainder >= 500:
+		sats++
+	}
+
+	return sats
+}
@@ -0,0 +1,46 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestRoundingModeToSatoshis makes sure millisatoshi amounts are converted to
+// satoshis according to the rounding mode.
+func TestRoundingModeToSatoshis(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msat    int64
+		down    int64
+		up      int64
+		nearest int64
+	}{
+		{msat: 0, down: 0, up: 0, nearest: 0},
+		{msat: 1, down: 0, up: 1, nearest: 0},
+		{msat: 499, down: 0, up: 1, nearest: 0},
+		{msat: 500, down: 0, up: 1, nearest: 1},
+		{msat: 1000, down: 1, up: 1, nearest: 1},
+		{msat: 1999, down: 1, up: 2, nearest: 2},
+		{msat: -1, down: 0, up: -1, nearest: 0},
+		{msat: -500, down: 0, up: -1, nearest: -1},
+		{msat: -1499, down: -1, up: -2, nearest: -1},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.down, RoundDown.ToSatoshis(test.msat))
+		require.Equal(t, test.up, RoundUp.ToSatoshis(test.msat))
+		require.Equal(
+			t, test.nearest, RoundNearest.ToSatoshis(test.msat),
+		)
+
+		// The zero value must behave like rounding down.
+		require.Equal(
+			t, test.down, RoundingMode("").ToSatoshis(test.msat),
+		)
+	}
+
+	require.NoError(t, RoundNearest.Validate())
+	require.Error(t, RoundingMode("sideways").Validate())
+}
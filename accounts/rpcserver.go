@@ -4,14 +4,19 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
 	litmac "github.com/lightninglabs/lightning-terminal/macaroons"
+	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
-	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc/peer"
+	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
 	"gopkg.in/macaroon.v2"
 )
@@ -23,15 +28,18 @@ type RPCServer struct {
 	service *InterceptorService
 
 	superMacBaker litmac.Baker
+
+	superMacDeleter litmac.RootKeyDeleter
 }
 
 // NewRPCServer returns a new RPC server for the given service.
-func NewRPCServer(service *InterceptorService,
-	superMacBaker litmac.Baker) *RPCServer {
+func NewRPCServer(service *InterceptorService, superMacBaker litmac.Baker,
+	superMacDeleter litmac.RootKeyDeleter) *RPCServer {
 
 	return &RPCServer{
-		service:       service,
-		superMacBaker: superMacBaker,
+		service:         service,
+		superMacBaker:   superMacBaker,
+		superMacDeleter: superMacDeleter,
 	}
 }
 
@@ -50,8 +58,22 @@ func (s *RPCServer) CreateAccount(ctx context.Context,
 	req *litrpc.CreateAccountRequest) (*litrpc.CreateAccountResponse,
 	error) {
 
-	log.Infof("[createaccount] label=%v, balance=%d, expiration=%d",
-		req.Label, req.AccountBalance, req.ExpirationDate)
+	log.Infof("[createaccount] label=%v, balance=%d, expiration=%d, "+
+		"max_htlc=%d, macaroon_expiration=%d, soft_cap=%d, "+
+		"sandbox=%v, keysend_budget=%d, node_id=%v, "+
+		"amountless_invoice_max=%d, min_balance=%d, top_up_amount=%d, "+
+		"max_sat_per_window=%d, window_seconds=%d, "+
+		"allowed_destinations=%v, max_open_invoices=%d, "+
+		"max_invoice_amount=%d, max_payment=%d", req.Label,
+		req.AccountBalance, req.ExpirationDate, req.MaxHtlcSat,
+		req.MacaroonExpirationDate, req.SoftCapSat, req.Sandbox,
+		req.KeysendBudgetSatPerSec, req.NodeId,
+		req.AmountlessInvoiceMaxSat, req.MinBalanceSat,
+		req.TopUpAmountSat, req.MaxSatPerWindow, req.WindowSeconds,
+		req.AllowedDestinations, req.MaxOpenInvoices,
+		req.MaxInvoiceAmountSat, req.MaxPaymentSat)
+
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
 
 	var (
 		balanceMsat    lnwire.MilliSatoshi
@@ -65,69 +87,404 @@ func (s *RPCServer) CreateAccount(ctx context.Context,
 		expirationDate = time.Unix(req.ExpirationDate, 0)
 	}
 
+	// The expiration policy is enforced here rather than by the clients,
+	// so that it covers all of them. The account in the response carries
+	// the resulting expiration date.
+	policy := s.service.ExpirationPolicy()
+	expirationDate, err := policy.newAccountExpiration(
+		time.Now(), expirationDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	macExpiry, err := parseMacaroonExpiry(
+		req.MacaroonExpirationDate, expirationDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert from satoshis to millisatoshis for storage.
 	balance := btcutil.Amount(req.AccountBalance)
 	balanceMsat = lnwire.NewMSatFromSatoshis(balance)
 
-	// Create the actual account in the macaroon account store.
+	var opts []NewAccountOption
+	if req.Id != "" {
+		id, err := ParseAccountID(req.Id)
+		if err != nil {
+			return nil, err
+		}
+		if *id == (AccountID{}) {
+			return nil, fmt.Errorf("the all-zero account ID is " +
+				"reserved")
+		}
+		opts = append(opts, WithAccountID(*id))
+	}
+	if req.MaxHtlcSat > 0 {
+		opts = append(opts, WithMaxHTLC(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MaxHtlcSat),
+		)))
+	}
+	if req.SoftCapSat > 0 {
+		opts = append(opts, WithSoftCap(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.SoftCapSat),
+		)))
+	}
+	if req.Sandbox {
+		opts = append(opts, WithSandbox())
+	}
+	if req.KeysendBudgetSatPerSec > 0 {
+		budget := lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.KeysendBudgetSatPerSec),
+		)
+		opts = append(opts, WithKeysendBudget(budget))
+	}
+	if req.NodeId != "" {
+		nodeID, err := route.NewVertexFromStr(req.NodeId)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing node ID: %w", err)
+		}
+
+		// litd only fronts a single lnd node for now, so that is the
+		// only node an account can be bound to.
+		if nodeID != s.service.NodeID() {
+			return nil, fmt.Errorf("litd is not connected to node "+
+				"%v, accounts can only be bound to node %v",
+				nodeID, s.service.NodeID())
+		}
+		opts = append(opts, WithNodeID(nodeID))
+	}
+	if req.AmountlessInvoiceMaxSat > 0 {
+		amountlessMax := lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.AmountlessInvoiceMaxSat),
+		)
+		opts = append(opts, WithAmountlessInvoiceMax(amountlessMax))
+	}
+	topUp := TopUpPolicy{
+		MinBalance: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MinBalanceSat),
+		),
+		Amount: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.TopUpAmountSat),
+		),
+	}
+	if err := topUp.Validate(); err != nil {
+		return nil, err
+	}
+	if topUp.IsEnabled() {
+		opts = append(opts, WithTopUpPolicy(topUp))
+	}
+	rateLimit := SpendRateLimit{
+		MaxAmount: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MaxSatPerWindow),
+		),
+		Window: time.Duration(req.WindowSeconds) * time.Second,
+	}
+	if err := rateLimit.Validate(); err != nil {
+		return nil, err
+	}
+	if rateLimit.IsEnabled() {
+		opts = append(opts, WithSpendRateLimit(rateLimit))
+	}
+	if len(req.AllowedDestinations) > 0 {
+		dests, err := parseDestinations(req.AllowedDestinations)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithAllowedDestinations(dests))
+	}
+	if req.MaxOpenInvoices > 0 {
+		opts = append(opts, WithMaxOpenInvoices(req.MaxOpenInvoices))
+	}
+	if req.MaxInvoiceAmountSat > 0 {
+		maxInvoiceAmount := lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MaxInvoiceAmountSat),
+		)
+		opts = append(opts, WithMaxInvoiceAmount(maxInvoiceAmount))
+	}
+	if req.MaxPaymentSat > 0 {
+		opts = append(opts, WithMaxPayment(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MaxPaymentSat),
+		)))
+	}
+
+	// Create the actual account in the macaroon account store. Labels are
+	// normalized the same way as when an account is renamed.
 	account, err := s.service.NewAccount(
-		ctx, balanceMsat, expirationDate, req.Label,
+		ctx, balanceMsat, expirationDate, strings.TrimSpace(req.Label),
+		opts...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create account: %w", err)
 	}
 
-	var rootKeyIdSuffix [4]byte
-	copy(rootKeyIdSuffix[:], account.ID[0:4])
-	macRootKey := litmac.NewSuperMacaroonRootKeyID(rootKeyIdSuffix)
-
-	accountCaveat := checkers.Condition(
-		macaroons.CondLndCustom,
-		fmt.Sprintf("%s %x", CondAccount, account.ID[:]),
-	)
+	// Read the account back so that the response reflects exactly what
+	// was stored, including any generated label.
+	account, err = s.service.Account(ctx, account.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving new account: %w", err)
+	}
 
-	macHex, err := s.superMacBaker(
-		ctx, macRootKey, MacaroonPermissions,
-		[]macaroon.Caveat{{Id: []byte(accountCaveat)}},
+	macBytes, err := s.bakeAccountMacaroon(
+		ctx, account.ID, MacaroonPermissions,
+		accountMacaroonCaveats(account.ID, macExpiry),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error baking account macaroon: %w", err)
+		return nil, err
 	}
 
-	macBytes, err := hex.DecodeString(macHex)
-	if err != nil {
-		return nil, fmt.Errorf("error decoding account macaroon: %w",
-			err)
+	// Without an explicit expiry, the macaroon is valid for as long as
+	// the account is.
+	resolvedMacExpiry := macExpiry
+	if resolvedMacExpiry.IsZero() {
+		resolvedMacExpiry = expirationDate
 	}
 
-	return &litrpc.CreateAccountResponse{
-		Account:  marshalAccount(account),
+	resp := &litrpc.CreateAccountResponse{
+		Account:  s.marshalAccount(account),
 		Macaroon: macBytes,
-	}, nil
+	}
+	if !resolvedMacExpiry.IsZero() {
+		resp.MacaroonExpirationDate = resolvedMacExpiry.Unix()
+	}
+
+	return resp, nil
 }
 
 // UpdateAccount updates an existing account in the account database.
 func (s *RPCServer) UpdateAccount(ctx context.Context,
 	req *litrpc.UpdateAccountRequest) (*litrpc.Account, error) {
 
-	log.Infof("[updateaccount] id=%s, label=%v, balance=%d, expiration=%d",
-		req.Id, req.Label, req.AccountBalance, req.ExpirationDate)
+	log.Infof("[updateaccount] id=%s, label=%v, balance=%d, "+
+		"expiration=%d, max_htlc=%d, soft_cap=%d, version=%d, "+
+		"min_balance=%d, top_up_amount=%d, max_sat_per_window=%d, "+
+		"window_seconds=%d, expiry_warning=%d, "+
+		"allowed_destinations=%v, clear_allowed_destinations=%v, "+
+		"max_open_invoices=%d, max_invoice_amount=%d, "+
+		"max_payment=%d", req.Id, req.Label, req.AccountBalance,
+		req.ExpirationDate, req.MaxHtlcSat, req.SoftCapSat, req.Version,
+		req.MinBalanceSat, req.TopUpAmountSat, req.MaxSatPerWindow,
+		req.WindowSeconds, req.ExpiryWarningSeconds,
+		req.AllowedDestinations, req.ClearAllowedDestinations,
+		req.MaxOpenInvoices, req.MaxInvoiceAmountSat, req.MaxPaymentSat)
+
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
 
 	accountID, err := s.findAccount(ctx, req.Id, req.Label)
 	if err != nil {
 		return nil, err
 	}
 
+	// A balance of -1 signals "don't update the balance".
+	update := &AccountUpdate{}
+	if req.AccountBalance >= 0 {
+		// Convert from satoshis to millisatoshis for storage.
+		update.Balance = fn.Some(req.AccountBalance * 1000)
+	}
+
+	// A value of zero signals "don't update the max HTLC amount" while -1
+	// removes the limit.
+	switch {
+	case req.MaxHtlcSat > 0:
+		update.MaxHTLC = fn.Some(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MaxHtlcSat),
+		))
+
+	case req.MaxHtlcSat == -1:
+		update.MaxHTLC = fn.Some(lnwire.MilliSatoshi(0))
+
+	case req.MaxHtlcSat < -1:
+		return nil, fmt.Errorf("invalid max HTLC amount %d",
+			req.MaxHtlcSat)
+	}
+
+	// The soft cap follows the same convention as the max HTLC amount.
+	switch {
+	case req.SoftCapSat > 0:
+		update.SoftCap = fn.Some(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.SoftCapSat),
+		))
+
+	case req.SoftCapSat == -1:
+		update.SoftCap = fn.Some(lnwire.MilliSatoshi(0))
+
+	case req.SoftCapSat < -1:
+		return nil, fmt.Errorf("invalid soft cap %d", req.SoftCapSat)
+	}
+
+	// A top-up amount of zero signals "don't update the top-up policy"
+	// while -1 removes it. A new policy needs a minimum balance as well.
+	switch {
+	case req.TopUpAmountSat > 0:
+		if req.MinBalanceSat <= 0 {
+			return nil, fmt.Errorf("a top-up policy requires a " +
+				"minimum balance")
+		}
+
+		update.TopUp = fn.Some(TopUpPolicy{
+			MinBalance: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(req.MinBalanceSat),
+			),
+			Amount: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(req.TopUpAmountSat),
+			),
+		})
+
+	case req.TopUpAmountSat == -1:
+		update.TopUp = fn.Some(TopUpPolicy{})
+
+	case req.TopUpAmountSat < -1:
+		return nil, fmt.Errorf("invalid top-up amount %d",
+			req.TopUpAmountSat)
+
+	case req.MinBalanceSat != 0:
+		return nil, fmt.Errorf("a minimum balance can only be set " +
+			"together with a top-up amount")
+	}
+
+	// A maximum of zero signals "don't update the spend rate limit" while
+	// -1 removes it. A new limit needs a window as well.
+	switch {
+	case req.MaxSatPerWindow > 0:
+		limit := SpendRateLimit{
+			MaxAmount: lnwire.NewMSatFromSatoshis(
+				btcutil.Amount(req.MaxSatPerWindow),
+			),
+			Window: time.Duration(req.WindowSeconds) * time.Second,
+		}
+		if err := limit.Validate(); err != nil {
+			return nil, err
+		}
+
+		update.SpendRateLimit = fn.Some(limit)
+
+	case req.MaxSatPerWindow == -1:
+		update.SpendRateLimit = fn.Some(SpendRateLimit{})
+
+	case req.MaxSatPerWindow < -1:
+		return nil, fmt.Errorf("invalid spend rate limit %d",
+			req.MaxSatPerWindow)
+
+	case req.WindowSeconds != 0:
+		return nil, fmt.Errorf("a spend rate limit window can only " +
+			"be set together with a maximum amount")
+	}
+
+	// An expiry warning of zero signals "don't update the expiry warning"
+	// while -1 removes it.
+	switch {
+	case req.ExpiryWarningSeconds > 0:
+		update.ExpiryWarning = fn.Some(
+			time.Duration(req.ExpiryWarningSeconds) * time.Second,
+		)
+
+	case req.ExpiryWarningSeconds == -1:
+		update.ExpiryWarning = fn.Some(time.Duration(0))
+
+	case req.ExpiryWarningSeconds < -1:
+		return nil, fmt.Errorf("invalid expiry warning %d",
+			req.ExpiryWarningSeconds)
+	}
+
+	// An empty list of allowed destinations signals "don't update the
+	// allowed destinations" unless they are explicitly cleared.
+	switch {
+	case len(req.AllowedDestinations) > 0 && req.ClearAllowedDestinations:
+		return nil, fmt.Errorf("allowed destinations can't be set " +
+			"and cleared at the same time")
+
+	case len(req.AllowedDestinations) > 0:
+		dests, err := parseDestinations(req.AllowedDestinations)
+		if err != nil {
+			return nil, err
+		}
+		update.AllowedDestinations = fn.Some(dests)
+
+	case req.ClearAllowedDestinations:
+		update.AllowedDestinations = fn.Some[[]route.Vertex](nil)
+	}
+
+	// The invoice limits follow the same convention as the max HTLC
+	// amount.
+	switch {
+	case req.MaxOpenInvoices > math.MaxUint32:
+		return nil, fmt.Errorf("max open invoices %d exceeds the "+
+			"maximum of %d", req.MaxOpenInvoices,
+			uint32(math.MaxUint32))
+
+	case req.MaxOpenInvoices > 0:
+		update.MaxOpenInvoices = fn.Some(uint32(req.MaxOpenInvoices))
+
+	case req.MaxOpenInvoices == -1:
+		update.MaxOpenInvoices = fn.Some(uint32(0))
+
+	case req.MaxOpenInvoices < -1:
+		return nil, fmt.Errorf("invalid max open invoices %d",
+			req.MaxOpenInvoices)
+	}
+
+	switch {
+	case req.MaxInvoiceAmountSat > 0:
+		update.MaxInvoiceAmount = fn.Some(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MaxInvoiceAmountSat),
+		))
+
+	case req.MaxInvoiceAmountSat == -1:
+		update.MaxInvoiceAmount = fn.Some(lnwire.MilliSatoshi(0))
+
+	case req.MaxInvoiceAmountSat < -1:
+		return nil, fmt.Errorf("invalid max invoice amount %d",
+			req.MaxInvoiceAmountSat)
+	}
+
+	switch {
+	case req.MaxPaymentSat > 0:
+		update.MaxPayment = fn.Some(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MaxPaymentSat),
+		))
+
+	case req.MaxPaymentSat == -1:
+		update.MaxPayment = fn.Some(lnwire.MilliSatoshi(0))
+
+	case req.MaxPaymentSat < -1:
+		return nil, fmt.Errorf("invalid max payment %d",
+			req.MaxPaymentSat)
+	}
+
+	// A version of zero signals "don't check the version".
+	var version fn.Option[uint64]
+	if req.Version != 0 {
+		version = fn.Some(req.Version)
+	}
+
+	// An expiration date of -1 signals "don't update the expiration date",
+	// any other date, including 0 for "never expire", must be within the
+	// max expiration. An account that never expires has a zero time (zero
+	// unix time would still be 1970, so that doesn't work for us).
+	if req.ExpirationDate >= 0 {
+		var expiration time.Time
+		if req.ExpirationDate > 0 {
+			expiration = time.Unix(req.ExpirationDate, 0)
+		}
+
+		err := s.service.ExpirationPolicy().check(
+			time.Now(), expiration,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		update.Expiry = fn.Some(expiration)
+	}
+
 	// Ask the service to update the account.
-	account, err := s.service.UpdateAccount(
-		ctx, accountID, btcutil.Amount(req.AccountBalance),
-		req.ExpirationDate,
-	)
+	account, err := s.service.UpdateAccount(ctx, accountID, update, version)
 	if err != nil {
 		return nil, err
 	}
 
-	return marshalAccount(account), nil
+	return s.marshalAccount(account), nil
 }
 
 // CreditAccount increases the balance of an existing account in the account
@@ -152,6 +509,8 @@ func (s *RPCServer) CreditAccount(ctx context.Context,
 	log.Infof("[creditaccount] id=%s, label=%v, amount=%d", id, label,
 		req.Amount)
 
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
+
 	amount := lnwire.MilliSatoshi(req.Amount * 1000)
 
 	accountID, err := s.findAccount(ctx, id, label)
@@ -165,10 +524,51 @@ func (s *RPCServer) CreditAccount(ctx context.Context,
 	}
 
 	return &litrpc.CreditAccountResponse{
-		Account: marshalAccount(account),
+		Account: s.marshalAccount(account),
 	}, nil
 }
 
+// RenameAccountLabel changes the label of an existing account in the account
+// database.
+func (s *RPCServer) RenameAccountLabel(ctx context.Context,
+	req *litrpc.RenameAccountLabelRequest) (*litrpc.Account, error) {
+
+	if req.GetAccount() == nil {
+		return nil, fmt.Errorf("account param must be specified")
+	}
+
+	var id, label string
+
+	switch idType := req.Account.Identifier.(type) {
+	case *litrpc.AccountIdentifier_Id:
+		id = idType.Id
+	case *litrpc.AccountIdentifier_Label:
+		label = idType.Label
+	}
+
+	log.Infof("[renameaccountlabel] id=%s, label=%v, new_label=%v", id,
+		label, req.NewLabel)
+
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
+
+	newLabel := strings.TrimSpace(req.NewLabel)
+	if newLabel == "" {
+		return nil, fmt.Errorf("new label must be specified")
+	}
+
+	accountID, err := s.findAccount(ctx, id, label)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.service.RenameAccountLabel(ctx, accountID, newLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.marshalAccount(account), nil
+}
+
 // DebitAccount decreases the balance of an existing account in the account
 // database, by the given amount.
 func (s *RPCServer) DebitAccount(ctx context.Context,
@@ -190,6 +590,8 @@ func (s *RPCServer) DebitAccount(ctx context.Context,
 	log.Infof("[debitaccount] id=%s, label=%v, amount=%d", id, label,
 		req.Amount)
 
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
+
 	amount := lnwire.MilliSatoshi(req.Amount * 1000)
 
 	accountID, err := s.findAccount(ctx, id, label)
@@ -197,40 +599,244 @@ func (s *RPCServer) DebitAccount(ctx context.Context,
 		return nil, err
 	}
 
-	account, err := s.service.DebitAccount(ctx, accountID, amount)
+	account, err := s.service.DebitAccount(ctx, accountID, amount, req.Memo)
 	if err != nil {
 		return nil, err
 	}
 
 	return &litrpc.DebitAccountResponse{
-		Account: marshalAccount(account),
+		Account: s.marshalAccount(account),
+	}, nil
+}
+
+// TransferAccountBalance moves the given amount from the source account to the
+// destination account in a single database transaction.
+func (s *RPCServer) TransferAccountBalance(ctx context.Context,
+	req *litrpc.TransferAccountBalanceRequest) (
+	*litrpc.TransferAccountBalanceResponse, error) {
+
+	if req.GetSource() == nil || req.GetDestination() == nil {
+		return nil, fmt.Errorf("source and destination params must be " +
+			"specified")
+	}
+
+	sourceID, sourceLabel := identifierParts(req.Source)
+	destID, destLabel := identifierParts(req.Destination)
+
+	log.Infof("[transferaccountbalance] source_id=%s, source_label=%v, "+
+		"destination_id=%s, destination_label=%v, amount=%d", sourceID,
+		sourceLabel, destID, destLabel, req.Amount)
+
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
+
+	amount := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.Amount))
+
+	source, err := s.findAccount(ctx, sourceID, sourceLabel)
+	if err != nil {
+		return nil, fmt.Errorf("error finding source account: %w", err)
+	}
+
+	destination, err := s.findAccount(ctx, destID, destLabel)
+	if err != nil {
+		return nil, fmt.Errorf("error finding destination account: %w",
+			err)
+	}
+
+	sourceAcct, destAcct, err := s.service.TransferAccountBalance(
+		ctx, source, destination, amount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.TransferAccountBalanceResponse{
+		Source:      s.marshalAccount(sourceAcct),
+		Destination: s.marshalAccount(destAcct),
 	}, nil
 }
 
+// parseDestinations parses the given hex encoded node public keys.
+func parseDestinations(dests []string) ([]route.Vertex, error) {
+	result := make([]route.Vertex, 0, len(dests))
+	for _, dest := range dests {
+		vertex, err := route.NewVertexFromStr(dest)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing allowed "+
+				"destination %s: %w", dest, err)
+		}
+		result = append(result, vertex)
+	}
+
+	return result, nil
+}
+
+// marshalDestinations returns the hex encoded public keys of the allowed
+// destinations of the given account.
+func marshalDestinations(acct *OffChainBalanceAccount) []string {
+	if len(acct.AllowedDestinations) == 0 {
+		return nil
+	}
+
+	dests := make([]string, 0, len(acct.AllowedDestinations))
+	for _, dest := range acct.AllowedDestinations {
+		dests = append(dests, dest.String())
+	}
+
+	return dests
+}
+
+// identifierParts returns the ID and the label set in the given account
+// identifier, of which at most one is non-empty.
+func identifierParts(ident *litrpc.AccountIdentifier) (string, string) {
+	switch idType := ident.Identifier.(type) {
+	case *litrpc.AccountIdentifier_Id:
+		return idType.Id, ""
+
+	case *litrpc.AccountIdentifier_Label:
+		return "", idType.Label
+
+	default:
+		return "", ""
+	}
+}
+
 // ListAccounts returns all accounts that are currently stored in the account
 // database.
 func (s *RPCServer) ListAccounts(ctx context.Context,
-	_ *litrpc.ListAccountsRequest) (*litrpc.ListAccountsResponse, error) {
+	req *litrpc.ListAccountsRequest) (*litrpc.ListAccountsResponse, error) {
 
-	log.Info("[listaccounts]")
+	log.Infof("[listaccounts] sandbox_filter=%v, label_prefix=%v, "+
+		"min_balance=%d, include_expired=%v, page_size=%d, "+
+		"page_token=%v, sort_by=%v, sort_dir=%v", req.SandboxFilter,
+		req.LabelPrefix, req.MinBalance, req.IncludeExpired,
+		req.PageSize, req.PageToken, req.SortBy, req.SortDir)
 
-	// Retrieve all accounts from the macaroon account store.
-	accts, err := s.service.Accounts(ctx)
+	sort, err := unmarshalAccountSort(req.SortBy, req.SortDir)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := AccountFilter{
+		LabelPrefix: req.LabelPrefix,
+		MinBalance: int64(lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(req.MinBalance),
+		)),
+		IncludeExpired: req.IncludeExpired,
+	}
+	switch req.SandboxFilter {
+	case litrpc.SandboxFilter_SANDBOX_EXCLUDE:
+		filter.Sandbox = fn.Some(false)
+
+	case litrpc.SandboxFilter_SANDBOX_ONLY:
+		filter.Sandbox = fn.Some(true)
+	}
+
+	// The page token is the ID of the first account of the page.
+	start := fn.None[AccountID]()
+	if req.PageToken != "" {
+		id, err := ParseAccountID(req.PageToken)
+		if err != nil {
+			return nil, fmt.Errorf("invalid page token: %w", err)
+		}
+		start = fn.Some(*id)
+	}
+
+	accts, next, err := s.service.ListAccounts(
+		ctx, filter, sort, start, int(req.PageSize),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to list accounts: %w", err)
 	}
 
 	// Map the response into the proper response type and return it.
-	rpcAccounts := make([]*litrpc.Account, len(accts))
-	for i, acct := range accts {
-		acct := acct
+	resp := &litrpc.ListAccountsResponse{
+		Accounts: make([]*litrpc.Account, 0, len(accts)),
+	}
+	for _, acct := range accts {
+		resp.Accounts = append(resp.Accounts, s.marshalAccount(acct))
+	}
+	next.WhenSome(func(id AccountID) {
+		resp.NextPageToken = id.String()
+	})
+
+	return resp, nil
+}
+
+// unmarshalAccountSort converts the sort field and direction of an RPC request
+// to the sort order of the service.
+func unmarshalAccountSort(field litrpc.AccountSortField,
+	dir litrpc.SortDirection) (AccountSort, error) {
 
-		rpcAccounts[i] = marshalAccount(acct)
+	var sort AccountSort
+	switch field {
+	case litrpc.AccountSortField_ACCOUNT_SORT_DEFAULT:
+		sort.Field = AccountSortDefault
+
+	case litrpc.AccountSortField_ACCOUNT_SORT_BALANCE:
+		sort.Field = AccountSortBalance
+
+	case litrpc.AccountSortField_ACCOUNT_SORT_EXPIRATION:
+		sort.Field = AccountSortExpiration
+
+	case litrpc.AccountSortField_ACCOUNT_SORT_LABEL:
+		sort.Field = AccountSortLabel
+
+	case litrpc.AccountSortField_ACCOUNT_SORT_CREATED:
+		sort.Field = AccountSortCreated
+
+	default:
+		return sort, fmt.Errorf("unknown sort field %v", field)
 	}
 
-	return &litrpc.ListAccountsResponse{
-		Accounts: rpcAccounts,
-	}, nil
+	switch dir {
+	case litrpc.SortDirection_SORT_ASCENDING:
+
+	case litrpc.SortDirection_SORT_DESCENDING:
+		if sort.Field == AccountSortDefault {
+			return sort, fmt.Errorf("a sort direction can only be " +
+				"set together with a sort field")
+		}
+		sort.Descending = true
+
+	default:
+		return sort, fmt.Errorf("unknown sort direction %v", dir)
+	}
+
+	return sort, nil
+}
+
+// ListAccountGroups returns the distinct label prefixes of all accounts with
+// the number of accounts that share each of them.
+func (s *RPCServer) ListAccountGroups(ctx context.Context,
+	req *litrpc.ListAccountGroupsRequest) (
+	*litrpc.ListAccountGroupsResponse, error) {
+
+	log.Infof("[listaccountgroups] separator=%v", req.Separator)
+
+	separator := req.Separator
+	if separator == "" {
+		separator = DefaultGroupSeparator
+	}
+
+	accts, err := s.service.Accounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list accounts: %w", err)
+	}
+
+	groups, numUngrouped := groupAccounts(accts, separator)
+
+	resp := &litrpc.ListAccountGroupsResponse{
+		Groups:       make([]*litrpc.AccountGroup, 0, len(groups)),
+		NumUngrouped: uint32(numUngrouped),
+	}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, &litrpc.AccountGroup{
+			Name:        group.Name,
+			NumAccounts: uint32(group.NumAccounts),
+		})
+	}
+
+	return resp, nil
 }
 
 // AccountInfo returns the account with the given ID or label.
@@ -249,7 +855,19 @@ func (s *RPCServer) AccountInfo(ctx context.Context,
 		return nil, fmt.Errorf("error retrieving account: %w", err)
 	}
 
-	return marshalAccount(dbAccount), nil
+	rpcAccount := s.marshalAccount(dbAccount)
+
+	remaining, limited, err := s.service.SpendRateAllowance(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("error calculating spend rate "+
+			"allowance: %w", err)
+	}
+	if limited {
+		toSats := s.service.RoundingMode().ToSatoshis
+		rpcAccount.WindowRemainingSat = uint64(toSats(int64(remaining)))
+	}
+
+	return rpcAccount, nil
 }
 
 // RemoveAccount removes the given account from the account database.
@@ -257,20 +875,71 @@ func (s *RPCServer) RemoveAccount(ctx context.Context,
 	req *litrpc.RemoveAccountRequest) (*litrpc.RemoveAccountResponse,
 	error) {
 
-	log.Infof("[removeaccount] id=%v, label=%v", req.Id, req.Label)
+	log.Infof("[removeaccount] id=%v, label=%v, sweep_to_id=%v, "+
+		"sweep_to_label=%v", req.Id, req.Label, req.SweepToId,
+		req.SweepToLabel)
+
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
 
 	accountID, err := s.findAccount(ctx, req.Id, req.Label)
 	if err != nil {
 		return nil, err
 	}
 
-	// Now remove the account.
-	err = s.service.RemoveAccount(ctx, accountID)
+	// Without a sweep target, we just remove the account.
+	if req.SweepToId == "" && req.SweepToLabel == "" {
+		err = s.service.RemoveAccount(ctx, accountID)
+		if err != nil {
+			return nil, fmt.Errorf("error removing account: %w",
+				err)
+		}
+
+		return &litrpc.RemoveAccountResponse{}, nil
+	}
+
+	targetID, err := s.findAccount(ctx, req.SweepToId, req.SweepToLabel)
 	if err != nil {
-		return nil, fmt.Errorf("error removing account: %w", err)
+		return nil, fmt.Errorf("invalid sweep target: %w", err)
 	}
 
-	return &litrpc.RemoveAccountResponse{}, nil
+	swept, err := s.service.SweepAndRemoveAccount(ctx, accountID, targetID)
+	if err != nil {
+		return nil, fmt.Errorf("error sweeping and removing account: %w",
+			err)
+	}
+
+	return &litrpc.RemoveAccountResponse{
+		SweptBalanceSat: uint64(
+			s.service.RoundingMode().ToSatoshis(int64(swept)),
+		),
+		SweptBalanceMsat: uint64(swept),
+	}, nil
+}
+
+// PurgeExpiredAccounts removes all expired accounts from the account database.
+// If preview is set, the accounts are only returned and not removed.
+func (s *RPCServer) PurgeExpiredAccounts(ctx context.Context,
+	req *litrpc.PurgeExpiredAccountsRequest) (
+	*litrpc.PurgeExpiredAccountsResponse, error) {
+
+	log.Infof("[purgeexpiredaccounts] preview=%v", req.Preview)
+
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
+
+	accts, err := s.service.PurgeExpiredAccounts(ctx, req.Preview)
+	if err != nil {
+		return nil, fmt.Errorf("error purging expired accounts: %w",
+			err)
+	}
+
+	rpcAccounts := make([]*litrpc.Account, len(accts))
+	for i, acct := range accts {
+		rpcAccounts[i] = s.marshalAccount(acct)
+	}
+
+	return &litrpc.PurgeExpiredAccountsResponse{
+		Accounts: rpcAccounts,
+	}, nil
 }
 
 // findAccount finds an account by its ID or label.
@@ -318,13 +987,995 @@ func (s *RPCServer) findAccount(ctx context.Context, id string, label string) (
 	}
 }
 
-// marshalAccount converts an account into its RPC counterpart.
-func marshalAccount(acct *OffChainBalanceAccount) *litrpc.Account {
+// SubscribePaymentEvents streams the lifecycle events of all payments that are
+// charged to an account, optionally limited to a single account.
+func (s *RPCServer) SubscribePaymentEvents(
+	req *litrpc.SubscribePaymentEventsRequest,
+	stream litrpc.Accounts_SubscribePaymentEventsServer) error {
+
+	ctx := stream.Context()
+
+	var (
+		id, label string
+		filter    *AccountID
+	)
+	if req.GetAccount() != nil {
+		switch idType := req.Account.Identifier.(type) {
+		case *litrpc.AccountIdentifier_Id:
+			id = idType.Id
+		case *litrpc.AccountIdentifier_Label:
+			label = idType.Label
+		}
+
+		accountID, err := s.findAccount(ctx, id, label)
+		if err != nil {
+			return err
+		}
+		filter = &accountID
+	}
+
+	log.Infof("[subscribepaymentevents] id=%s, label=%v", id, label)
+
+	events, cancel := s.service.SubscribePaymentEvents()
+	defer cancel()
+
+	for {
+		select {
+		case event := <-events:
+			if filter != nil && event.AccountID != *filter {
+				continue
+			}
+
+			err := stream.Send(marshalPaymentEvent(event))
+			if err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// SubscribeAccountLifecycle streams the creation, changes and removal of all
+// accounts, optionally resuming after the event with the given resume token.
+func (s *RPCServer) SubscribeAccountLifecycle(
+	req *litrpc.SubscribeAccountLifecycleRequest,
+	stream litrpc.Accounts_SubscribeAccountLifecycleServer) error {
+
+	ctx := stream.Context()
+
+	log.Infof("[subscribeaccountlifecycle] resume_token=%s",
+		req.ResumeToken)
+
+	events, cancel, err := s.service.SubscribeAccountLifecycle(
+		req.ResumeToken,
+	)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return ErrLifecycleSubscriberLagged
+			}
+
+			err := stream.Send(s.marshalLifecycleEvent(event))
+			if err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// SubscribeAccountUpdates streams the state of a single account, first when
+// subscribing and then whenever its balance or expiration date changes, as
+// well as a warning once the account reaches its expiry warning lead time. The
+// stream ends when the account is removed.
+func (s *RPCServer) SubscribeAccountUpdates(
+	req *litrpc.SubscribeAccountUpdatesRequest,
+	stream litrpc.Accounts_SubscribeAccountUpdatesServer) error {
+
+	ctx := stream.Context()
+
+	if req.GetAccount() == nil {
+		return fmt.Errorf("an account ID or label must be specified")
+	}
+	id, label := identifierParts(req.Account)
+
+	log.Infof("[subscribeaccountupdates] id=%s, label=%v", id, label)
+
+	accountID, err := s.findAccount(ctx, id, label)
+	if err != nil {
+		return err
+	}
+
+	updates, cancel, err := s.service.SubscribeAccountUpdates(
+		ctx, accountID,
+	)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	for {
+		select {
+		case event, ok := <-updates:
+			// The updates also end when the service is stopped, in
+			// which case the client should subscribe again later.
+			if !ok && !s.service.IsRunning() {
+				return ErrAccountServiceDisabled
+			}
+			if !ok {
+				return nil
+			}
+
+			updateType := litrpc.AccountUpdateType_UPDATE_STATE
+			if event.Type == AccountLifecycleExpiringSoon {
+				updateType = litrpc.
+					AccountUpdateType_UPDATE_EXPIRING_SOON
+			}
+
+			err := stream.Send(&litrpc.AccountUpdate{
+				Type:      updateType,
+				Account:   s.marshalAccount(event.Account),
+				Timestamp: event.Timestamp.Unix(),
+			})
+			if err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// VerifyAccountsStore checks all accounts in the account database for
+// inconsistencies.
+func (s *RPCServer) VerifyAccountsStore(ctx context.Context,
+	_ *litrpc.VerifyAccountsStoreRequest) (
+	*litrpc.VerifyAccountsStoreResponse, error) {
+
+	log.Info("[verifyaccountsstore]")
+
+	report, err := s.service.VerifyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &litrpc.VerifyAccountsStoreResponse{
+		NumAccounts: uint32(report.NumAccounts),
+		Issues: make(
+			[]*litrpc.AccountStoreIssue, 0, len(report.Issues),
+		),
+	}
+	for _, issue := range report.Issues {
+		resp.Issues = append(resp.Issues, &litrpc.AccountStoreIssue{
+			Type:        string(issue.Type),
+			AccountId:   hex.EncodeToString(issue.AccountID[:]),
+			Description: issue.Description,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetTotalInFlightExposure returns the total amount of all in-flight payments
+// that are charged to accounts.
+func (s *RPCServer) GetTotalInFlightExposure(_ context.Context,
+	_ *litrpc.GetTotalInFlightExposureRequest) (
+	*litrpc.GetTotalInFlightExposureResponse, error) {
+
+	log.Info("[gettotalinflightexposure]")
+
+	exposure := s.service.InFlightExposure()
+	toSats := s.service.RoundingMode().ToSatoshis
+
+	return &litrpc.GetTotalInFlightExposureResponse{
+		TotalInFlightSat:  uint64(toSats(int64(exposure.Total))),
+		TotalInFlightMsat: uint64(exposure.Total),
+		NumPayments:       uint32(exposure.NumPayments),
+		NumAccounts:       uint32(exposure.NumAccounts),
+	}, nil
+}
+
+// GetAccountHistory returns the lifecycle events of the account with the given
+// ID or label.
+func (s *RPCServer) GetAccountHistory(ctx context.Context,
+	req *litrpc.GetAccountHistoryRequest) (
+	*litrpc.GetAccountHistoryResponse, error) {
+
+	log.Infof("[getaccounthistory] id=%v, label=%v", req.Id, req.Label)
+
+	accountID, err := s.findAccount(ctx, req.Id, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.service.AccountHistory(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving account history: %w",
+			err)
+	}
+
+	resp := &litrpc.GetAccountHistoryResponse{
+		Events: make([]*litrpc.AccountEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, marshalAccountEvent(event))
+	}
+
+	return resp, nil
+}
+
+// GetAccountLedger returns the balance changes of the account with the given ID
+// or label within the requested time range.
+func (s *RPCServer) GetAccountLedger(ctx context.Context,
+	req *litrpc.GetAccountLedgerRequest) (*litrpc.GetAccountLedgerResponse,
+	error) {
+
+	log.Infof("[getaccountledger] id=%v, label=%v, start_time=%d, "+
+		"end_time=%d", req.Id, req.Label, req.StartTime, req.EndTime)
+
+	accountID, err := s.findAccount(ctx, req.Id, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	var start, end time.Time
+	if req.StartTime > 0 {
+		start = time.Unix(int64(req.StartTime), 0)
+	}
+	if req.EndTime > 0 {
+		// The end is given in seconds, so we include the entries of
+		// the whole second.
+		end = time.Unix(int64(req.EndTime), 0).Add(
+			time.Second - time.Nanosecond,
+		)
+	}
+
+	entries, err := s.service.AccountLedger(ctx, accountID, start, end)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving account ledger: %w",
+			err)
+	}
+
+	resp := &litrpc.GetAccountLedgerResponse{
+		Entries: make([]*litrpc.LedgerEntry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, marshalLedgerEntry(entry))
+	}
+
+	return resp, nil
+}
+
+// EstimateAccountRunway estimates when the balance of an account reaches zero
+// based on its recent spend rate.
+func (s *RPCServer) EstimateAccountRunway(ctx context.Context,
+	req *litrpc.EstimateAccountRunwayRequest) (
+	*litrpc.EstimateAccountRunwayResponse, error) {
+
+	log.Infof("[estimateaccountrunway] id=%v, label=%v, window=%d",
+		req.Id, req.Label, req.WindowSeconds)
+
+	accountID, err := s.findAccount(ctx, req.Id, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	window := DefaultRunwayWindow
+	if req.WindowSeconds > 0 {
+		window = time.Duration(req.WindowSeconds) * time.Second
+	}
+
+	runway, err := s.service.EstimateRunway(ctx, accountID, window)
+	if err != nil {
+		return nil, fmt.Errorf("error estimating account runway: %w",
+			err)
+	}
+
+	toSats := s.service.RoundingMode().ToSatoshis
+	resp := &litrpc.EstimateAccountRunwayResponse{
+		WindowSeconds:      uint64(runway.Window.Seconds()),
+		SpentSat:           uint64(toSats(int64(runway.Spent))),
+		NumPayments:        uint32(runway.NumPayments),
+		CurrentBalance:     toSats(runway.Balance),
+		SpendRateSatPerDay: runway.DailyRate / 1000,
+	}
+	runway.Depletion.WhenSome(func(depletion time.Time) {
+		resp.DepletionDate = depletion.Unix()
+		if runway.Balance > 0 {
+			resp.DaysRemaining = float64(runway.Balance) /
+				runway.DailyRate
+		}
+	})
+
+	return resp, nil
+}
+
+// AddCreditRule adds a rule that credits an account for settled invoices with
+// a memo that starts with the given prefix.
+func (s *RPCServer) AddCreditRule(ctx context.Context,
+	req *litrpc.AddCreditRuleRequest) (*litrpc.CreditRule, error) {
+
+	log.Infof("[addcreditrule] memo_prefix=%v, id=%v, label=%v",
+		req.MemoPrefix, req.Id, req.Label)
+
+	accountID, err := s.findAccount(ctx, req.Id, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := s.service.AddCreditRule(ctx, req.MemoPrefix, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalCreditRule(rule), nil
+}
+
+// ListCreditRules returns all credit rules.
+func (s *RPCServer) ListCreditRules(ctx context.Context,
+	_ *litrpc.ListCreditRulesRequest) (*litrpc.ListCreditRulesResponse,
+	error) {
+
+	log.Info("[listcreditrules]")
+
+	rules, err := s.service.CreditRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing credit rules: %w", err)
+	}
+
+	resp := &litrpc.ListCreditRulesResponse{
+		Rules: make([]*litrpc.CreditRule, 0, len(rules)),
+	}
+	for _, rule := range rules {
+		resp.Rules = append(resp.Rules, marshalCreditRule(rule))
+	}
+
+	return resp, nil
+}
+
+// RemoveCreditRule removes the credit rule for the given memo prefix.
+func (s *RPCServer) RemoveCreditRule(ctx context.Context,
+	req *litrpc.RemoveCreditRuleRequest) (*litrpc.RemoveCreditRuleResponse,
+	error) {
+
+	log.Infof("[removecreditrule] memo_prefix=%v", req.MemoPrefix)
+
+	err := s.service.RemoveCreditRule(ctx, req.MemoPrefix)
+	if err != nil {
+		return nil, fmt.Errorf("error removing credit rule: %w", err)
+	}
+
+	return &litrpc.RemoveCreditRuleResponse{}, nil
+}
+
+// MintPaymentMacaroon bakes a macaroon for an existing account that can only be
+// used to pay the invoice with the given payment hash.
+func (s *RPCServer) MintPaymentMacaroon(ctx context.Context,
+	req *litrpc.MintPaymentMacaroonRequest) (
+	*litrpc.MintPaymentMacaroonResponse, error) {
+
+	log.Infof("[mintpaymentmacaroon] id=%v, label=%v, payment_hash=%x",
+		req.Id, req.Label, req.PaymentHash)
+
+	hash, err := lntypes.MakeHash(req.PaymentHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment hash: %w", err)
+	}
+
+	accountID, err := s.findAccount(ctx, req.Id, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.service.Account(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving account: %w", err)
+	}
+
+	if account.HasExpired() {
+		return nil, fmt.Errorf("account %v has expired", account.ID)
+	}
+
+	if paymentMacaroonUsed(account, hash) {
+		return nil, fmt.Errorf("payment %v has already been paid by "+
+			"account %v", hash, account.ID)
+	}
+
+	caveats := []macaroon.Caveat{
+		CaveatFromID(account.ID), PaymentHashCaveat(hash),
+	}
+	macBytes, err := s.bakeAccountMacaroon(
+		ctx, account.ID, PaymentMacaroonPermissions, caveats,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.MintPaymentMacaroonResponse{
+		Macaroon: macBytes,
+	}, nil
+}
+
+// ExportAccountMacaroon bakes a new macaroon with the permissions of the one
+// returned by CreateAccount for an existing account. Macaroons that were baked
+// for the account before are not invalidated.
+func (s *RPCServer) ExportAccountMacaroon(ctx context.Context,
+	req *litrpc.ExportAccountMacaroonRequest) (
+	*litrpc.ExportAccountMacaroonResponse, error) {
+
+	log.Infof("[exportaccountmacaroon] id=%v, label=%v, "+
+		"macaroon_expiration=%d", req.Id, req.Label,
+		req.MacaroonExpirationDate)
+
+	accountID, err := s.findAccount(ctx, req.Id, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.service.Account(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving account: %w", err)
+	}
+
+	if account.HasExpired() {
+		return nil, fmt.Errorf("account %v has expired", account.ID)
+	}
+
+	macExpiry, err := parseMacaroonExpiry(
+		req.MacaroonExpirationDate, account.ExpirationDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	macBytes, err := s.bakeAccountMacaroon(
+		ctx, account.ID, MacaroonPermissions,
+		accountMacaroonCaveats(account.ID, macExpiry),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without an explicit expiry, the macaroon is valid for as long as
+	// the account is.
+	if macExpiry.IsZero() {
+		macExpiry = account.ExpirationDate
+	}
+
+	resp := &litrpc.ExportAccountMacaroonResponse{
+		Macaroon: macBytes,
+	}
+	if !macExpiry.IsZero() {
+		resp.MacaroonExpirationDate = macExpiry.Unix()
+	}
+
+	return resp, nil
+}
+
+// RotateAccountMacaroon replaces the root key of the macaroons of an existing
+// account and bakes a new macaroon with the permissions of the one returned by
+// CreateAccount. All macaroons that were baked for the account before stop
+// working, while the balance and settings of the account stay the same.
+func (s *RPCServer) RotateAccountMacaroon(ctx context.Context,
+	req *litrpc.RotateAccountMacaroonRequest) (
+	*litrpc.RotateAccountMacaroonResponse, error) {
+
+	log.Infof("[rotateaccountmacaroon] id=%v, label=%v, "+
+		"macaroon_expiration=%d", req.Id, req.Label,
+		req.MacaroonExpirationDate)
+
+	accountID, err := s.findAccount(ctx, req.Id, req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.service.Account(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving account: %w", err)
+	}
+
+	if account.HasExpired() {
+		return nil, fmt.Errorf("account %v has expired", account.ID)
+	}
+
+	macExpiry, err := parseMacaroonExpiry(
+		req.MacaroonExpirationDate, account.ExpirationDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting a root key that is shared with another account or a session
+	// would invalidate their macaroons as well, so the rotation is refused
+	// in that case.
+	if err := s.service.checkRootKeyID(ctx, account.ID); err != nil {
+		return nil, fmt.Errorf("unable to rotate the macaroon of "+
+			"account %v: %w", account.ID, err)
+	}
+
+	// Deleting the root key invalidates the old macaroons right away. lnd
+	// creates a new root key under the same ID when the new macaroon is
+	// baked. If baking fails, the rotation can simply be retried.
+	rootKeyID := accountMacaroonRootKeyID(account.ID)
+	if err := s.superMacDeleter(ctx, rootKeyID); err != nil {
+		return nil, fmt.Errorf("error deleting account macaroon root "+
+			"key: %w", err)
+	}
+
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
+	s.service.RecordMacaroonRotation(ctx, account.ID, rootKeyID)
+
+	log.Infof("Rotated macaroon root key %d of account %v", rootKeyID,
+		account.ID)
+
+	macBytes, err := s.bakeAccountMacaroon(
+		ctx, account.ID, MacaroonPermissions,
+		accountMacaroonCaveats(account.ID, macExpiry),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without an explicit expiry, the macaroon is valid for as long as
+	// the account is.
+	if macExpiry.IsZero() {
+		macExpiry = account.ExpirationDate
+	}
+
+	resp := &litrpc.RotateAccountMacaroonResponse{
+		Macaroon: macBytes,
+	}
+	if !macExpiry.IsZero() {
+		resp.MacaroonExpirationDate = macExpiry.Unix()
+	}
+
+	return resp, nil
+}
+
+// ExportAccounts returns the balances and settings of all accounts in
+// millisatoshis, so that they can be imported again with ImportAccounts.
+func (s *RPCServer) ExportAccounts(ctx context.Context,
+	_ *litrpc.ExportAccountsRequest) (*litrpc.ExportAccountsResponse,
+	error) {
+
+	log.Info("[exportaccounts]")
+
+	accts, err := s.service.Accounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list accounts: %w", err)
+	}
+
+	resp := &litrpc.ExportAccountsResponse{
+		Accounts: make([]*litrpc.ExportedAccount, 0, len(accts)),
+	}
+	for _, acct := range accts {
+		resp.Accounts = append(
+			resp.Accounts, marshalExportedAccount(acct),
+		)
+	}
+
+	return resp, nil
+}
+
+// ImportAccounts recreates the given exported accounts with their original
+// IDs, optionally overwriting accounts that already exist.
+func (s *RPCServer) ImportAccounts(ctx context.Context,
+	req *litrpc.ImportAccountsRequest) (*litrpc.ImportAccountsResponse,
+	error) {
+
+	log.Infof("[importaccounts] num_accounts=%d, overwrite=%v",
+		len(req.Accounts), req.Overwrite)
+
+	ctx = AddActorToContext(ctx, rpcActor(ctx))
+
+	accts := make([]*OffChainBalanceAccount, 0, len(req.Accounts))
+	for _, rpcAcct := range req.Accounts {
+		acct, err := s.unmarshalExportedAccount(rpcAcct)
+		if err != nil {
+			return nil, err
+		}
+		accts = append(accts, acct)
+	}
+
+	imported, err := s.service.ImportAccounts(ctx, accts, req.Overwrite)
+	if err != nil {
+		return nil, fmt.Errorf("unable to import accounts: %w", err)
+	}
+
+	resp := &litrpc.ImportAccountsResponse{
+		Accounts: make([]*litrpc.Account, 0, len(imported)),
+	}
+	for _, acct := range imported {
+		resp.Accounts = append(resp.Accounts, s.marshalAccount(acct))
+	}
+
+	return resp, nil
+}
+
+// marshalExportedAccount converts an account into its exported RPC
+// representation.
+func marshalExportedAccount(
+	acct *OffChainBalanceAccount) *litrpc.ExportedAccount {
+
+	exported := &litrpc.ExportedAccount{
+		Id:                      hex.EncodeToString(acct.ID[:]),
+		Label:                   acct.Label,
+		InitialBalanceMsat:      uint64(acct.InitialBalance),
+		CurrentBalanceMsat:      acct.CurrentBalance,
+		MaxHtlcMsat:             uint64(acct.MaxHTLC),
+		SoftCapMsat:             uint64(acct.SoftCap),
+		Sandbox:                 acct.Sandbox,
+		KeysendBudgetMsatPerSec: uint64(acct.KeysendBudget),
+		AmountlessInvoiceMaxMsat: uint64(
+			acct.AmountlessInvoiceMax,
+		),
+		TopUpMinBalanceMsat: uint64(acct.TopUp.MinBalance),
+		TopUpAmountMsat:     uint64(acct.TopUp.Amount),
+		MaxMsatPerWindow:    uint64(acct.SpendRateLimit.MaxAmount),
+		WindowSeconds: uint64(
+			acct.SpendRateLimit.Window / time.Second,
+		),
+		ExpiryWarningSeconds: uint64(acct.ExpiryWarning / time.Second),
+		AllowedDestinations:  marshalDestinations(acct),
+		MaxOpenInvoices:      acct.MaxOpenInvoices,
+		MaxInvoiceAmountMsat: uint64(acct.MaxInvoiceAmount),
+		MaxPaymentMsat:       uint64(acct.MaxPayment),
+	}
+	if !acct.ExpirationDate.IsZero() {
+		exported.ExpirationDate = acct.ExpirationDate.Unix()
+	}
+	if acct.NodeID != (route.Vertex{}) {
+		exported.NodeId = acct.NodeID.String()
+	}
+
+	return exported
+}
+
+// unmarshalExportedAccount converts an exported account back into an account
+// that can be imported. Accounts that are bound to a node can only be imported
+// if litd is connected to that node.
+func (s *RPCServer) unmarshalExportedAccount(
+	exported *litrpc.ExportedAccount) (*OffChainBalanceAccount, error) {
+
+	id, err := ParseAccountID(exported.Id)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing account ID '%s': %w",
+			exported.Id, err)
+	}
+
+	acct := &OffChainBalanceAccount{
+		ID:             *id,
+		Type:           TypeInitialBalance,
+		InitialBalance: lnwire.MilliSatoshi(exported.InitialBalanceMsat),
+		CurrentBalance: exported.CurrentBalanceMsat,
+		Label:          exported.Label,
+		MaxHTLC:        lnwire.MilliSatoshi(exported.MaxHtlcMsat),
+		SoftCap:        lnwire.MilliSatoshi(exported.SoftCapMsat),
+		Sandbox:        exported.Sandbox,
+		KeysendBudget: lnwire.MilliSatoshi(
+			exported.KeysendBudgetMsatPerSec,
+		),
+		AmountlessInvoiceMax: lnwire.MilliSatoshi(
+			exported.AmountlessInvoiceMaxMsat,
+		),
+		TopUp: TopUpPolicy{
+			MinBalance: lnwire.MilliSatoshi(
+				exported.TopUpMinBalanceMsat,
+			),
+			Amount: lnwire.MilliSatoshi(exported.TopUpAmountMsat),
+		},
+		SpendRateLimit: SpendRateLimit{
+			MaxAmount: lnwire.MilliSatoshi(
+				exported.MaxMsatPerWindow,
+			),
+			Window: time.Duration(exported.WindowSeconds) *
+				time.Second,
+		},
+		ExpiryWarning: time.Duration(exported.ExpiryWarningSeconds) *
+			time.Second,
+		MaxOpenInvoices: exported.MaxOpenInvoices,
+		MaxInvoiceAmount: lnwire.MilliSatoshi(
+			exported.MaxInvoiceAmountMsat,
+		),
+		MaxPayment: lnwire.MilliSatoshi(exported.MaxPaymentMsat),
+	}
+	if exported.ExpirationDate > 0 {
+		acct.ExpirationDate = time.Unix(exported.ExpirationDate, 0)
+	}
+
+	if exported.NodeId != "" {
+		nodeID, err := route.NewVertexFromStr(exported.NodeId)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing node ID of "+
+				"account %v: %w", exported.Id, err)
+		}
+
+		if nodeID != s.service.NodeID() {
+			return nil, fmt.Errorf("account %v is bound to node "+
+				"%v, but litd is connected to node %v",
+				exported.Id, nodeID, s.service.NodeID())
+		}
+		acct.NodeID = nodeID
+	}
+
+	dests, err := parseDestinations(exported.AllowedDestinations)
+	if err != nil {
+		return nil, fmt.Errorf("account %v: %w", exported.Id, err)
+	}
+	acct.AllowedDestinations = normalizeDestinations(dests)
+
+	return acct, nil
+}
+
+// parseMacaroonExpiry parses the requested expiration date of an account
+// macaroon. The macaroon expiry is independent of the account expiry, but it
+// doesn't make sense for the macaroon to outlive the account. A zero time is
+// returned if the macaroon only expires with the account.
+func parseMacaroonExpiry(timestamp int64,
+	accountExpiry time.Time) (time.Time, error) {
+
+	if timestamp < 0 {
+		return time.Time{}, fmt.Errorf("invalid macaroon expiration "+
+			"date %d", timestamp)
+	}
+	if timestamp == 0 {
+		return time.Time{}, nil
+	}
+
+	macExpiry := time.Unix(timestamp, 0)
+	if !macExpiry.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("macaroon expiration date " +
+			"must be in the future")
+	}
+
+	if !accountExpiry.IsZero() && macExpiry.After(accountExpiry) {
+		return time.Time{}, fmt.Errorf("macaroon expiration date " +
+			"must not be after the account expiration date")
+	}
+
+	return macExpiry, nil
+}
+
+// accountMacaroonCaveats returns the caveats of a macaroon that is bound to
+// the account with the given ID and expires at the given time, unless it is
+// zero.
+func accountMacaroonCaveats(id AccountID,
+	macExpiry time.Time) []macaroon.Caveat {
+
+	caveats := []macaroon.Caveat{CaveatFromID(id)}
+	if !macExpiry.IsZero() {
+		timeCaveat := checkers.TimeBeforeCaveat(macExpiry)
+		caveats = append(caveats, macaroon.Caveat{
+			Id: []byte(timeCaveat.Condition),
+		})
+	}
+
+	return caveats
+}
+
+// accountMacaroonRootKeyID returns the ID of the root key that the macaroons of
+// the account with the given ID are baked under.
+func accountMacaroonRootKeyID(id AccountID) uint64 {
+	return litmac.NewSuperMacaroonRootKeyID(rootKeyIDSuffix(id))
+}
+
+// bakeAccountMacaroon bakes a macaroon with the given permissions and caveats
+// under the root key of the account with the given ID.
+func (s *RPCServer) bakeAccountMacaroon(ctx context.Context, id AccountID,
+	perms []bakery.Op, caveats []macaroon.Caveat) ([]byte, error) {
+
+	macRootKey := accountMacaroonRootKeyID(id)
+	macHex, err := s.superMacBaker(ctx, macRootKey, perms, caveats)
+	if err != nil {
+		return nil, fmt.Errorf("error baking account macaroon: %w", err)
+	}
+
+	macBytes, err := hex.DecodeString(macHex)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding account macaroon: %w",
+			err)
+	}
+
+	return macBytes, nil
+}
+
+// marshalCreditRule converts a credit rule into its RPC counterpart.
+func marshalCreditRule(rule *CreditRule) *litrpc.CreditRule {
+	return &litrpc.CreditRule{
+		MemoPrefix: rule.MemoPrefix,
+		AccountId:  rule.AccountID.String(),
+		CreatedAt:  rule.CreatedAt.Unix(),
+	}
+}
+
+// marshalAccountEvent converts an account lifecycle event into its RPC
+// counterpart.
+func marshalAccountEvent(event *AccountEvent) *litrpc.AccountEvent {
+	var eventType litrpc.AccountEventType
+	switch event.Type {
+	case AccountEventCreated:
+		eventType = litrpc.AccountEventType_ACCOUNT_CREATED
+
+	case AccountEventBalanceUpdated:
+		eventType = litrpc.AccountEventType_ACCOUNT_BALANCE_UPDATED
+
+	case AccountEventExpiryUpdated:
+		eventType = litrpc.AccountEventType_ACCOUNT_EXPIRY_UPDATED
+
+	case AccountEventLimitsUpdated:
+		eventType = litrpc.AccountEventType_ACCOUNT_LIMITS_UPDATED
+
+	case AccountEventLabelRenamed:
+		eventType = litrpc.AccountEventType_ACCOUNT_LABEL_RENAMED
+
+	case AccountEventRemoved:
+		eventType = litrpc.AccountEventType_ACCOUNT_REMOVED
+
+	case AccountEventToppedUp:
+		eventType = litrpc.AccountEventType_ACCOUNT_TOPPED_UP
+
+	case AccountEventImported:
+		eventType = litrpc.AccountEventType_ACCOUNT_IMPORTED
+
+	case AccountEventMacaroonRotated:
+		eventType = litrpc.AccountEventType_ACCOUNT_MACAROON_ROTATED
+	}
+
+	return &litrpc.AccountEvent{
+		Type:      eventType,
+		Timestamp: event.Timestamp.Unix(),
+		Actor:     event.Actor,
+		Details:   event.Details,
+	}
+}
+
+// marshalLedgerEntry converts a ledger entry into its RPC representation.
+func marshalLedgerEntry(entry *LedgerEntry) *litrpc.LedgerEntry {
+	var entryType litrpc.LedgerEntryType
+	switch entry.Type {
+	case LedgerEntryOpening:
+		entryType = litrpc.LedgerEntryType_LEDGER_OPENING
+
+	case LedgerEntryBalanceSet:
+		entryType = litrpc.LedgerEntryType_LEDGER_BALANCE_SET
+
+	case LedgerEntryCredit:
+		entryType = litrpc.LedgerEntryType_LEDGER_CREDIT
+
+	case LedgerEntryDebit:
+		entryType = litrpc.LedgerEntryType_LEDGER_DEBIT
+
+	case LedgerEntryInvoice:
+		entryType = litrpc.LedgerEntryType_LEDGER_INVOICE
+
+	case LedgerEntryPayment:
+		entryType = litrpc.LedgerEntryType_LEDGER_PAYMENT
+
+	case LedgerEntrySweep:
+		entryType = litrpc.LedgerEntryType_LEDGER_SWEEP
+
+	case LedgerEntryTopUp:
+		entryType = litrpc.LedgerEntryType_LEDGER_TOP_UP
+
+	case LedgerEntryTransfer:
+		entryType = litrpc.LedgerEntryType_LEDGER_TRANSFER
+
+	case LedgerEntryImport:
+		entryType = litrpc.LedgerEntryType_LEDGER_IMPORT
+	}
+
+	var reference string
+	if entry.Reference != (lntypes.Hash{}) {
+		reference = entry.Reference.String()
+	}
+
+	return &litrpc.LedgerEntry{
+		Type:        entryType,
+		Timestamp:   entry.Timestamp.Unix(),
+		DeltaMsat:   entry.Delta,
+		BalanceMsat: entry.Balance,
+		Reference:   reference,
+	}
+}
+
+// rpcActor returns the actor that is recorded in the account history for
+// changes made through the accounts RPCs, which is the address of the client
+// if it is known.
+func rpcActor(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "rpc"
+	}
+
+	return fmt.Sprintf("rpc:%v", p.Addr)
+}
+
+// marshalPaymentEvent converts a payment event into its RPC counterpart.
+func marshalPaymentEvent(event *PaymentEvent) *litrpc.PaymentEvent {
+	var eventType litrpc.PaymentEventType
+	switch event.Type {
+	case PaymentEventInitiated:
+		eventType = litrpc.PaymentEventType_PAYMENT_INITIATED
+
+	case PaymentEventInFlight:
+		eventType = litrpc.PaymentEventType_PAYMENT_IN_FLIGHT
+
+	case PaymentEventSettled:
+		eventType = litrpc.PaymentEventType_PAYMENT_SETTLED
+
+	case PaymentEventFailed:
+		eventType = litrpc.PaymentEventType_PAYMENT_FAILED
+	}
+
+	return &litrpc.PaymentEvent{
+		Type:        eventType,
+		AccountId:   hex.EncodeToString(event.AccountID[:]),
+		PaymentHash: event.Hash[:],
+		AmountMsat:  uint64(event.Amount),
+		FeeMsat:     uint64(event.Fee),
+		Timestamp:   event.Timestamp.Unix(),
+	}
+}
+
+// marshalLifecycleEvent converts an account lifecycle event into its RPC
+// counterpart.
+func (s *RPCServer) marshalLifecycleEvent(
+	event *AccountLifecycleEvent) *litrpc.AccountLifecycleEvent {
+
+	var eventType litrpc.AccountLifecycleEventType
+	switch event.Type {
+	case AccountLifecycleCreated:
+		eventType = litrpc.AccountLifecycleEventType_LIFECYCLE_CREATED
+
+	case AccountLifecycleUpdated:
+		eventType = litrpc.AccountLifecycleEventType_LIFECYCLE_UPDATED
+
+	case AccountLifecycleRemoved:
+		eventType = litrpc.AccountLifecycleEventType_LIFECYCLE_REMOVED
+
+	case AccountLifecycleExpiringSoon:
+		eventType = litrpc.
+			AccountLifecycleEventType_LIFECYCLE_EXPIRING_SOON
+	}
+
+	rpcEvent := &litrpc.AccountLifecycleEvent{
+		Type:        eventType,
+		AccountId:   event.AccountID.String(),
+		Timestamp:   event.Timestamp.Unix(),
+		ResumeToken: event.ResumeToken,
+	}
+	if event.Account != nil {
+		rpcEvent.Account = s.marshalAccount(event.Account)
+	}
+
+	return rpcEvent
+}
+
+// marshalAccount converts an account into its RPC counterpart. Millisatoshi
+// amounts are rounded to satoshis according to the rounding mode of the
+// service.
+func (s *RPCServer) marshalAccount(
+	acct *OffChainBalanceAccount) *litrpc.Account {
+
+	toSats := s.service.RoundingMode().ToSatoshis
+
 	rpcAccount := &litrpc.Account{
 		Id:             hex.EncodeToString(acct.ID[:]),
-		InitialBalance: uint64(acct.InitialBalance.ToSatoshis()),
-		CurrentBalance: acct.CurrentBalanceSats(),
+		InitialBalance: uint64(toSats(int64(acct.InitialBalance))),
+		CurrentBalance: toSats(acct.CurrentBalance),
 		LastUpdate:     acct.LastUpdate.Unix(),
+		CreatedAt:      acct.CreatedAt.Unix(),
 		ExpirationDate: int64(0),
 		Invoices: make(
 			[]*litrpc.AccountInvoice, 0, len(acct.Invoices),
@@ -332,8 +1983,37 @@ func marshalAccount(acct *OffChainBalanceAccount) *litrpc.Account {
 		Payments: make(
 			[]*litrpc.AccountPayment, 0, len(acct.Payments),
 		),
-		Label: acct.Label,
+		Label:      acct.Label,
+		MaxHtlcSat: uint64(toSats(int64(acct.MaxHTLC))),
+		SoftCapSat: uint64(toSats(int64(acct.SoftCap))),
+		Sandbox:    acct.Sandbox,
+		Version:    acct.Version,
 	}
+	rpcAccount.KeysendBudgetSatPerSec = uint64(
+		toSats(int64(acct.KeysendBudget)),
+	)
+	if acct.NodeID != (route.Vertex{}) {
+		rpcAccount.NodeId = acct.NodeID.String()
+	}
+	rpcAccount.AmountlessInvoiceMaxSat = uint64(
+		toSats(int64(acct.AmountlessInvoiceMax)),
+	)
+	rpcAccount.MinBalanceSat = uint64(toSats(int64(acct.TopUp.MinBalance)))
+	rpcAccount.TopUpAmountSat = uint64(toSats(int64(acct.TopUp.Amount)))
+	rpcAccount.MaxSatPerWindow = uint64(
+		toSats(int64(acct.SpendRateLimit.MaxAmount)),
+	)
+	rpcAccount.WindowSeconds = uint64(acct.SpendRateLimit.Window.Seconds())
+	rpcAccount.ExpiryWarningSeconds = uint64(acct.ExpiryWarning.Seconds())
+	rpcAccount.AllowedDestinations = marshalDestinations(acct)
+	rpcAccount.MaxOpenInvoices = acct.MaxOpenInvoices
+	rpcAccount.MaxInvoiceAmountSat = uint64(
+		toSats(int64(acct.MaxInvoiceAmount)),
+	)
+	rpcAccount.OpenInvoices = acct.OpenInvoices
+	rpcAccount.MaxPaymentSat = uint64(toSats(int64(acct.MaxPayment)))
+	rpcAccount.AvailableBalance = toSats(calcAvailableAccountBalance(acct))
+	rpcAccount.PendingPayments = numPendingPayments(acct)
 
 	for hash := range acct.Invoices {
 		i := &litrpc.AccountInvoice{
@@ -344,9 +2024,13 @@ func marshalAccount(acct *OffChainBalanceAccount) *litrpc.Account {
 	}
 	for hash, paymentEntry := range acct.Payments {
 		p := &litrpc.AccountPayment{
-			Hash:       make([]byte, lntypes.HashSize),
-			State:      paymentEntry.Status.String(),
-			FullAmount: int64(paymentEntry.FullAmount.ToSatoshis()),
+			Hash:           make([]byte, lntypes.HashSize),
+			State:          paymentEntry.Status.String(),
+			FullAmount:     toSats(int64(paymentEntry.FullAmount)),
+			RoutingFeeMsat: uint64(paymentEntry.Fee),
+		}
+		if paymentEntry.Destination != (route.Vertex{}) {
+			p.Destination = paymentEntry.Destination.String()
 		}
 		copy(p.Hash, hash[:])
 		rpcAccount.Payments = append(rpcAccount.Payments, p)
@@ -0,0 +1,383 @@
+package accounts
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// TestExportAccountMacaroon tests that an exported account macaroon is baked
+// under the same root key and with the same permissions and caveats as the
+// macaroon returned when the account was created.
+func TestExportAccountMacaroon(t *testing.T) {
+	t.Parallel()
+
+	type bakeCall struct {
+		rootKeyID uint64
+		perms     []bakery.Op
+		caveats   []macaroon.Caveat
+	}
+
+	var calls []bakeCall
+	baker := func(_ context.Context, rootKeyID uint64, perms []bakery.Op,
+		caveats []macaroon.Caveat) (string, error) {
+
+		calls = append(calls, bakeCall{rootKeyID, perms, caveats})
+
+		mac, err := macaroon.New(
+			[]byte("root key"), []byte("id"), "lnd",
+			macaroon.LatestVersion,
+		)
+		if err != nil {
+			return "", err
+		}
+		macBytes, err := mac.MarshalBinary()
+		if err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(macBytes), nil
+	}
+
+	ctx := context.Background()
+	store := NewTestDB(t, clock.NewDefaultClock())
+	service, err := NewService(store, func(error) {})
+	require.NoError(t, err)
+
+	err = service.Start(ctx, newMockLnd(), newMockRouter(), chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	server := NewRPCServer(service, baker, nil)
+
+	acctExpiry := time.Now().Add(time.Hour).Unix()
+	created, err := server.CreateAccount(
+		ctx, &litrpc.CreateAccountRequest{
+			AccountBalance: 1000,
+			ExpirationDate: acctExpiry,
+			Label:          "export",
+		},
+	)
+	require.NoError(t, err)
+
+	exported, err := server.ExportAccountMacaroon(
+		ctx, &litrpc.ExportAccountMacaroonRequest{Label: "export"},
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, exported.Macaroon)
+	require.Equal(
+		t, created.MacaroonExpirationDate,
+		exported.MacaroonExpirationDate,
+	)
+
+	require.Len(t, calls, 2)
+	require.Equal(t, calls[0], calls[1])
+
+	// The macaroon may not outlive the account.
+	_, err = server.ExportAccountMacaroon(
+		ctx, &litrpc.ExportAccountMacaroonRequest{
+			Id:                     created.Account.Id,
+			MacaroonExpirationDate: acctExpiry + 1,
+		},
+	)
+	require.ErrorContains(t, err, "must not be after the account")
+
+	// A shorter macaroon expiry adds a time caveat.
+	macExpiry := acctExpiry - 60
+	exported, err = server.ExportAccountMacaroon(
+		ctx, &litrpc.ExportAccountMacaroonRequest{
+			Id:                     created.Account.Id,
+			MacaroonExpirationDate: macExpiry,
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, macExpiry, exported.MacaroonExpirationDate)
+	require.Len(t, calls, 3)
+	require.Equal(t, calls[0].rootKeyID, calls[2].rootKeyID)
+	require.Len(t, calls[2].caveats, 2)
+
+	expiry, err := expiryFromCaveats(calls[2].caveats)
+	require.NoError(t, err)
+	require.Equal(t, macExpiry, expiry.UnwrapOr(time.Time{}).Unix())
+}
+
+// TestRotateAccountMacaroon tests that rotating the macaroon of an account
+// deletes the root key of the account before a new macaroon is baked under the
+// same root key ID, and that the rotation is recorded in the account history.
+func TestRotateAccountMacaroon(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+	baker := func(_ context.Context, rootKeyID uint64, _ []bakery.Op,
+		_ []macaroon.Caveat) (string, error) {
+
+		calls = append(calls, fmt.Sprintf("bake %d", rootKeyID))
+
+		mac, err := macaroon.New(
+			[]byte("root key"), []byte("id"), "lnd",
+			macaroon.LatestVersion,
+		)
+		if err != nil {
+			return "", err
+		}
+		macBytes, err := mac.MarshalBinary()
+		if err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(macBytes), nil
+	}
+	deleter := func(_ context.Context, rootKeyID uint64) error {
+		calls = append(calls, fmt.Sprintf("delete %d", rootKeyID))
+
+		return nil
+	}
+
+	ctx := context.Background()
+	store := NewTestDB(t, clock.NewDefaultClock())
+	service, err := NewService(store, func(error) {})
+	require.NoError(t, err)
+
+	err = service.Start(ctx, newMockLnd(), newMockRouter(), chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	server := NewRPCServer(service, baker, deleter)
+
+	created, err := server.CreateAccount(
+		ctx, &litrpc.CreateAccountRequest{
+			AccountBalance: 1000,
+			Label:          "rotate",
+		},
+	)
+	require.NoError(t, err)
+
+	rotated, err := server.RotateAccountMacaroon(
+		ctx, &litrpc.RotateAccountMacaroonRequest{Label: "rotate"},
+	)
+	require.NoError(t, err)
+	require.NotEmpty(t, rotated.Macaroon)
+	require.Zero(t, rotated.MacaroonExpirationDate)
+
+	id, err := ParseAccountID(created.Account.Id)
+	require.NoError(t, err)
+	rootKeyID := accountMacaroonRootKeyID(*id)
+	require.Equal(t, []string{
+		fmt.Sprintf("bake %d", rootKeyID),
+		fmt.Sprintf("delete %d", rootKeyID),
+		fmt.Sprintf("bake %d", rootKeyID),
+	}, calls)
+
+	// The balance of the account is not touched.
+	acct, err := service.Account(ctx, *id)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000_000, acct.CurrentBalance)
+
+	history, err := service.AccountHistory(ctx, *id)
+	require.NoError(t, err)
+	rotation := history[len(history)-1]
+	require.Equal(t, AccountEventMacaroonRotated, rotation.Type)
+	require.Contains(t, rotation.Details, fmt.Sprint(rootKeyID))
+	require.NotEmpty(t, rotation.Actor)
+
+	// An account that shares the root key with the rotated one can only be
+	// created directly in the store. Rotating either of them is refused,
+	// since it would invalidate the macaroons of the other one as well.
+	sharedID := *id
+	sharedID[7] ^= 0xff
+	_, err = store.NewAccount(
+		ctx, 0, time.Time{}, "shared", WithAccountID(sharedID),
+	)
+	require.NoError(t, err)
+
+	_, err = server.RotateAccountMacaroon(
+		ctx, &litrpc.RotateAccountMacaroonRequest{Label: "rotate"},
+	)
+	require.ErrorIs(t, err, ErrRootKeyIDInUse)
+	require.Len(t, calls, 3)
+
+	// The refused rotation isn't recorded in the account history.
+	refusedHistory, err := service.AccountHistory(ctx, *id)
+	require.NoError(t, err)
+	require.Equal(t, history, refusedHistory)
+}
+
+// TestCreateAccountRootKeyID tests that an account can't be created with an ID
+// whose macaroon root key is already used by another account or a session.
+func TestCreateAccountRootKeyID(t *testing.T) {
+	t.Parallel()
+
+	baker := func(context.Context, uint64, []bakery.Op,
+		[]macaroon.Caveat) (string, error) {
+
+		mac, err := macaroon.New(
+			[]byte("root key"), []byte("id"), "lnd",
+			macaroon.LatestVersion,
+		)
+		if err != nil {
+			return "", err
+		}
+		macBytes, err := mac.MarshalBinary()
+		if err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(macBytes), nil
+	}
+
+	sessionSuffix := [4]byte{0xaa, 0xbb, 0xcc, 0xdd}
+	sessionInUse := func(_ context.Context, suffix [4]byte) (bool, error) {
+		return suffix == sessionSuffix, nil
+	}
+
+	ctx := context.Background()
+	store := NewTestDB(t, clock.NewDefaultClock())
+	service, err := NewService(
+		store, func(error) {}, WithRootKeyIDInUse(sessionInUse),
+	)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, newMockLnd(), newMockRouter(), chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	server := NewRPCServer(service, baker, nil)
+
+	_, err = server.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: 1000,
+		Id:             "0000000000000001",
+	})
+	require.NoError(t, err)
+
+	// Sequential external IDs share the first 4 bytes and with that the
+	// macaroon root key.
+	_, err = server.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: 1000,
+		Id:             "0000000000000002",
+	})
+	require.ErrorIs(t, err, ErrRootKeyIDInUse)
+
+	// The same goes for an ID that matches the root key of a session.
+	_, err = server.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: 1000,
+		Id:             "aabbccdd00000001",
+	})
+	require.ErrorIs(t, err, ErrRootKeyIDInUse)
+
+	// Reusing the full ID of an existing account is still reported as
+	// such.
+	_, err = server.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: 1000,
+		Id:             "0000000000000001",
+	})
+	require.ErrorIs(t, err, ErrAccountAlreadyExists)
+
+	// IDs with different first 4 bytes and random IDs are still accepted.
+	_, err = server.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: 1000,
+		Id:             "0000000100000001",
+	})
+	require.NoError(t, err)
+
+	_, err = server.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: 1000,
+	})
+	require.NoError(t, err)
+
+	accts, err := service.Accounts(ctx)
+	require.NoError(t, err)
+	require.Len(t, accts, 3)
+}
+
+// TestExportImportAccounts tests that accounts that are exported from one
+// accounts database and imported into another one end up with the same ID,
+// balances and settings.
+func TestExportImportAccounts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	newServer := func() (*RPCServer, *InterceptorService) {
+		store := NewTestDB(t, clock.NewDefaultClock())
+		service, err := NewService(store, func(error) {})
+		require.NoError(t, err)
+
+		err = service.Start(
+			ctx, newMockLnd(), newMockRouter(), chainParams,
+		)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, service.Stop())
+		})
+
+		return NewRPCServer(service, nil, nil), service
+	}
+
+	source, sourceService := newServer()
+	_, err := sourceService.NewAccount(
+		ctx, 1_500, time.Unix(2_000_000_000, 0), "first",
+		WithMaxHTLC(700), WithSpendRateLimit(SpendRateLimit{
+			MaxAmount: 1_000,
+			Window:    time.Minute,
+		}),
+	)
+	require.NoError(t, err)
+	_, err = sourceService.NewAccount(ctx, 2_001, time.Time{}, "")
+	require.NoError(t, err)
+
+	export, err := source.ExportAccounts(
+		ctx, &litrpc.ExportAccountsRequest{},
+	)
+	require.NoError(t, err)
+	require.Len(t, export.Accounts, 2)
+
+	target, _ := newServer()
+	resp, err := target.ImportAccounts(ctx, &litrpc.ImportAccountsRequest{
+		Accounts: export.Accounts,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Accounts, 2)
+
+	reexport, err := target.ExportAccounts(
+		ctx, &litrpc.ExportAccountsRequest{},
+	)
+	require.NoError(t, err)
+	require.ElementsMatch(t, accountIDs(export), accountIDs(reexport))
+	for _, acct := range reexport.Accounts {
+		for _, orig := range export.Accounts {
+			if orig.Id == acct.Id {
+				require.True(t, proto.Equal(orig, acct))
+			}
+		}
+	}
+
+	// Importing the same accounts again is refused without overwrite.
+	_, err = target.ImportAccounts(ctx, &litrpc.ImportAccountsRequest{
+		Accounts: export.Accounts,
+	})
+	require.ErrorIs(t, err, ErrAccountAlreadyExists)
+}
+
+// accountIDs returns the IDs of the accounts of the given export.
+func accountIDs(export *litrpc.ExportAccountsResponse) []string {
+	ids := make([]string, 0, len(export.Accounts))
+	for _, acct := range export.Accounts {
+		ids = append(ids, acct.Id)
+	}
+
+	return ids
+}
@@ -0,0 +1,219 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultRunwayWindow is the window over which the spend rate of an
+	// account is averaged if no window is given.
+	DefaultRunwayWindow = 30 * 24 * time.Hour
+
+	// runwayPageSize is the number of payments that are requested from lnd
+	// at once when estimating the runway of an account.
+	runwayPageSize = 500
+)
+
+// AccountRunway is an estimate of how long the balance of an account lasts if
+// it keeps being spent at its recent rate.
+type AccountRunway struct {
+	// Window is the duration over which the spend rate was averaged.
+	Window time.Duration
+
+	// Spent is the amount, including routing fees, of all payments of the
+	// account that succeeded within the window.
+	Spent lnwire.MilliSatoshi
+
+	// NumPayments is the number of payments that make up Spent.
+	NumPayments int
+
+	// Balance is the current balance of the account in millisatoshis.
+	Balance int64
+
+	// DailyRate is the average amount in millisatoshis that was spent per
+	// day within the window.
+	DailyRate float64
+
+	// Depletion is the time at which the balance is projected to reach
+	// zero. It is None if nothing was spent within the window, since the
+	// balance isn't going down then.
+	Depletion fn.Option[time.Time]
+}
+
+// EstimateRunway estimates how long the balance of the account with the given
+// ID lasts, based on the payments the account made within the given window
+// before now. The payments are read from lnd, as the accounts store doesn't
+// know when a payment was made.
+func (s *InterceptorService) EstimateRunway(ctx context.Context, id AccountID,
+	window time.Duration) (*AccountRunway, error) {
+
+	if window <= 0 {
+		return nil, fmt.Errorf("invalid runway window %v", window)
+	}
+
+	s.RLock()
+	running := s.isRunningUnsafe()
+	lightningClient := s.lightningClient
+	s.RUnlock()
+
+	if !running {
+		return nil, ErrAccountServiceDisabled
+	}
+
+	acct, err := s.Account(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	payments, err := listPaymentsSince(
+		ctx, lightningClient, now.Add(-window),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing payments: %w", err)
+	}
+
+	return estimateRunway(acct, payments, now, window), nil
+}
+
+// listPaymentsSince returns at least all payments that were started at or after
+// the given time. Payments are listed from the newest to the oldest, so we can
+// stop as soon as we see a payment that was started before.
+func listPaymentsSince(ctx context.Context,
+	client lndclient.LightningClient,
+	since time.Time) ([]lndclient.Payment, error) {
+
+	var (
+		payments []lndclient.Payment
+		offset   uint64
+	)
+	for {
+		resp, err := client.ListPayments(
+			ctx, lndclient.ListPaymentsRequest{
+				MaxPayments: runwayPageSize,
+				Offset:      offset,
+				Reversed:    true,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, payment := range resp.Payments {
+			started, ok := paymentStartTime(payment)
+			if ok && started.Before(since) {
+				return payments, nil
+			}
+
+			payments = append(payments, payment)
+		}
+
+		// A reversed query returns the payments before the offset, so
+		// we are done once we reached the first payment.
+		if len(resp.Payments) < runwayPageSize ||
+			resp.FirstIndexOffset <= 1 {
+
+			return payments, nil
+		}
+		offset = resp.FirstIndexOffset
+	}
+}
+
+// estimateRunway estimates how long the balance of the given account lasts,
+// based on the payments of the account among the given ones that succeeded
+// within the window before now.
+func estimateRunway(acct *OffChainBalanceAccount,
+	payments []lndclient.Payment, now time.Time,
+	window time.Duration) *AccountRunway {
+
+	runway := &AccountRunway{
+		Window:  window,
+		Balance: acct.CurrentBalance,
+	}
+
+	since := now.Add(-window)
+	for _, payment := range payments {
+		if _, ok := acct.Payments[payment.Hash]; !ok {
+			continue
+		}
+
+		settled, ok := paymentSettleTime(payment)
+		if !ok || settled.Before(since) || settled.After(now) {
+			continue
+		}
+
+		runway.Spent += payment.Amount + payment.Fee
+		runway.NumPayments++
+	}
+
+	days := window.Hours() / 24
+	runway.DailyRate = float64(runway.Spent) / days
+
+	if runway.DailyRate > 0 {
+		var remaining time.Duration
+		if runway.Balance > 0 {
+			remainingDays := float64(runway.Balance) /
+				runway.DailyRate
+			remaining = time.Duration(
+				remainingDays * float64(24*time.Hour),
+			)
+		}
+
+		runway.Depletion = fn.Some(now.Add(remaining))
+	}
+
+	return runway
+}
+
+// paymentStartTime returns the time at which the first HTLC of the payment was
+// attempted. False is returned if no HTLC was attempted.
+func paymentStartTime(payment lndclient.Payment) (time.Time, bool) {
+	var (
+		started time.Time
+		found   bool
+	)
+	for _, htlc := range payment.Htlcs {
+		attempt := time.Unix(0, htlc.AttemptTimeNs)
+		if !found || attempt.Before(started) {
+			started = attempt
+			found = true
+		}
+	}
+
+	return started, found
+}
+
+// paymentSettleTime returns the time at which the last successful HTLC of the
+// payment was resolved. False is returned if the payment didn't succeed.
+func paymentSettleTime(payment lndclient.Payment) (time.Time, bool) {
+	if payment.Status == nil ||
+		payment.Status.State != lnrpc.Payment_SUCCEEDED {
+
+		return time.Time{}, false
+	}
+
+	var (
+		settled time.Time
+		found   bool
+	)
+	for _, htlc := range payment.Htlcs {
+		if htlc.Status != lnrpc.HTLCAttempt_SUCCEEDED {
+			continue
+		}
+
+		resolved := time.Unix(0, htlc.ResolveTimeNs)
+		if !found || resolved.After(settled) {
+			settled = resolved
+			found = true
+		}
+	}
+
+	return settled, found
+}
@@ -0,0 +1,145 @@
+package accounts
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestEstimateRunway tests that the runway of an account is estimated from
+// the payments of the account that succeeded within the window.
+func TestEstimateRunway(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000, 0)
+	window := 10 * 24 * time.Hour
+
+	payment := func(hash lntypes.Hash, amt lnwire.MilliSatoshi,
+		state lnrpc.Payment_PaymentStatus,
+		settled time.Time) lndclient.Payment {
+
+		attempted := settled.Add(-time.Second)
+		htlcStatus := lnrpc.HTLCAttempt_SUCCEEDED
+		if state != lnrpc.Payment_SUCCEEDED {
+			htlcStatus = lnrpc.HTLCAttempt_FAILED
+		}
+
+		return lndclient.Payment{
+			Hash:   hash,
+			Amount: amt,
+			Fee:    amt / 100,
+			Status: &lndclient.PaymentStatus{State: state},
+			Htlcs: []*lnrpc.HTLCAttempt{{
+				Status:        htlcStatus,
+				AttemptTimeNs: attempted.UnixNano(),
+				ResolveTimeNs: settled.UnixNano(),
+			}},
+		}
+	}
+
+	var otherHash lntypes.Hash
+	otherHash[0] = 0xff
+
+	acct := &OffChainBalanceAccount{
+		CurrentBalance: 40_000_000,
+		Payments: map[lntypes.Hash]*PaymentEntry{
+			testHash:  {Status: lnrpc.Payment_SUCCEEDED},
+			testHash2: {Status: lnrpc.Payment_SUCCEEDED},
+			testHash3: {Status: lnrpc.Payment_FAILED},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		payments    []lndclient.Payment
+		spent       lnwire.MilliSatoshi
+		numPayments int
+		days        float64
+	}{{
+		name: "no activity",
+	}, {
+		name: "payments within window",
+		payments: []lndclient.Payment{
+			payment(
+				testHash, 5_000_000, lnrpc.Payment_SUCCEEDED,
+				now.Add(-time.Hour),
+			),
+			payment(
+				testHash2, 5_000_000, lnrpc.Payment_SUCCEEDED,
+				now.Add(-9*24*time.Hour),
+			),
+		},
+		spent:       10_100_000,
+		numPayments: 2,
+		days:        40_000_000 / (10_100_000 / 10.0),
+	}, {
+		name: "ignored payments",
+		payments: []lndclient.Payment{
+			payment(
+				testHash, 10_000_000, lnrpc.Payment_SUCCEEDED,
+				now.Add(-time.Hour),
+			),
+			payment(
+				testHash2, 5_000_000, lnrpc.Payment_SUCCEEDED,
+				now.Add(-11*24*time.Hour),
+			),
+			payment(
+				testHash3, 5_000_000, lnrpc.Payment_FAILED,
+				now.Add(-time.Hour),
+			),
+			payment(
+				otherHash, 5_000_000, lnrpc.Payment_SUCCEEDED,
+				now.Add(-time.Hour),
+			),
+		},
+		spent:       10_100_000,
+		numPayments: 1,
+		days:        40_000_000 / (10_100_000 / 10.0),
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			runway := estimateRunway(acct, tc.payments, now, window)
+			require.Equal(t, window, runway.Window)
+			require.Equal(t, tc.spent, runway.Spent)
+			require.Equal(t, tc.numPayments, runway.NumPayments)
+			require.EqualValues(t, 40_000_000, runway.Balance)
+
+			if tc.spent == 0 {
+				require.Zero(t, runway.DailyRate)
+				require.True(t, runway.Depletion.IsNone())
+
+				return
+			}
+
+			require.InDelta(
+				t, float64(tc.spent)/10, runway.DailyRate, 1e-6,
+			)
+
+			depletion := runway.Depletion.UnwrapOr(time.Time{})
+			remaining := depletion.Sub(now).Hours() / 24
+			require.InDelta(t, tc.days, remaining, 1e-6)
+		})
+	}
+
+	// An account without a balance left is depleted right away.
+	empty := &OffChainBalanceAccount{
+		Payments: map[lntypes.Hash]*PaymentEntry{
+			testHash: {Status: lnrpc.Payment_SUCCEEDED},
+		},
+	}
+	runway := estimateRunway(empty, []lndclient.Payment{
+		payment(
+			testHash, 1_000, lnrpc.Payment_SUCCEEDED,
+			now.Add(-time.Hour),
+		),
+	}, now, window)
+	require.Equal(t, now, runway.Depletion.UnwrapOr(time.Time{}))
+}
@@ -7,7 +7,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -16,14 +15,131 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 )
 
 // Config holds the configuration options for the accounts service.
 type Config struct {
 	// Disable will disable the accounts service if set.
 	Disable bool `long:"disable" description:"disable the accounts service"`
+
+	// LowBalanceWatermark is the balance in satoshis below which the
+	// LowBalanceHook is invoked for an account.
+	LowBalanceWatermark uint64 `long:"lowbalancewatermark" description:"The account balance in satoshis below which the low balance hook is invoked for an account, for example to top it up automatically. Set to 0 to disable."`
+
+	// LowBalanceHook is the hook that is invoked if the balance of an
+	// account drops below the LowBalanceWatermark. It can't be set through
+	// the command line or config file and must be provided by a caller that
+	// embeds litd. If it is not set, a hook that only logs a warning is
+	// used.
+	LowBalanceHook LowBalanceHook
+
+	// SpendWebhook is the URL that debit events are sent to.
+	SpendWebhook string `long:"spendwebhook" description:"An optional URL that a JSON encoded event is POSTed to for every debit of an account, including the amount, the resulting balance and a sequence number. Events that occur in rapid succession are sent in a single batch."`
+
+	// SpendWebhookBatchInterval is the interval in which debit events are
+	// collected before being sent to the SpendWebhook.
+	SpendWebhookBatchInterval time.Duration `long:"spendwebhookbatchinterval" description:"The interval in which debit events are collected before they are sent to the spend webhook in a single request."`
+
+	// DailySummaryWebhook is the URL that the daily spend summary is sent
+	// to.
+	DailySummaryWebhook string `long:"dailysummarywebhook" description:"An optional URL that a JSON encoded summary of the day's spend, number of payments and closing balance of every account is POSTed to once a day. The summary of an incomplete day is sent when litd shuts down."`
+
+	// DailySummaryTime is the time of day in UTC at which the daily spend
+	// summary is sent.
+	DailySummaryTime string `long:"dailysummarytime" description:"The time of day in UTC, formatted as HH:MM, at which the daily summary is sent to the daily summary webhook."`
+
+	// DailySummaryAggregate sends the summaries of all accounts in a
+	// single request.
+	DailySummaryAggregate bool `long:"dailysummaryaggregate" description:"Send the daily summaries of all accounts to the daily summary webhook in a single request instead of one request per account."`
+
+	// StoreCommitPolicy is the policy used to commit write transactions
+	// of the bbolt account store.
+	StoreCommitPolicy string `long:"storecommitpolicy" description:"How write transactions of the bbolt accounts store are committed. 'sync' commits and syncs every write on its own. 'batch' coalesces concurrent writes into a single commit, which raises the throughput under heavy credit/debit traffic but adds latency to each write and causes all writes of a batch to be rolled back and retried if one of them fails. Does not apply to SQL backends." choice:"sync" choice:"batch"`
+
+	// StoreNoFreelistSync disables syncing the freelist of the bbolt
+	// account store on every commit.
+	StoreNoFreelistSync bool `long:"storenofreelistsync" description:"Don't sync the freelist of the bbolt accounts store to disk on every commit. Commits become faster, but the freelist has to be rebuilt by scanning the whole database file on every startup, which slows down the startup of large databases. Does not apply to SQL backends."`
+
+	// UnknownAccountPolicy determines how requests are handled that are
+	// made with a macaroon of an account that doesn't exist.
+	UnknownAccountPolicy string `long:"unknownaccountpolicy" description:"How requests made with the macaroon of an account that no longer exists are handled. 'reject' rejects them with an error stating that the account no longer exists. 'passthrough' lets them through without any account checks, so the request is only restricted by the permissions of the macaroon itself. Only use 'passthrough' if account macaroons are never handed out to untrusted parties." choice:"reject" choice:"passthrough"`
+
+	// SatRounding is the rounding mode used when millisatoshi balances are
+	// reported in satoshis.
+	SatRounding string `long:"satrounding" description:"How account balances and amounts that are tracked in millisatoshis are rounded when they are reported in satoshis, both in the accounts RPCs and in the channel balance shown to account holders. 'down' never shows an account more than it can spend, 'up' rounds in favor of the account holder and 'nearest' rounds to the closest satoshi. Balance checks and debits always use exact millisatoshi amounts." choice:"down" choice:"up" choice:"nearest"`
+
+	// AutoLabel enables generating a label for accounts that are created
+	// without one.
+	AutoLabel bool `long:"autolabel" description:"Generate a readable, unique label of the form adjective-noun-number for every account that is created without a label, so that it is easier to reference. An explicitly set label always takes precedence."`
+
+	// TopUpSource is the hex encoded ID of the account that funds the
+	// automatic top-ups of accounts with a top-up policy.
+	TopUpSource string `long:"topupsource" description:"The hex encoded ID of the master account that funds the automatic top-ups of accounts with a top-up policy. Whenever a payment brings the balance of such an account below its minimum balance, the top-up amount is moved from the master account to it. If not set, top-up policies have no effect."`
+
+	// DepositInvoices enables crediting settled invoices that are tagged
+	// with the ID of an account to that account.
+	DepositInvoices bool `long:"depositinvoices" description:"Let account holders top up their own balance by paying an invoice to this node. A settled invoice that isn't associated with an account but is tagged with the ID of an existing account is credited to that account. An invoice is tagged either by its memo or by a custom record of its HTLCs, see depositmemoprefix and depositrecordtype."`
+
+	// DepositMemoPrefix is the prefix of the memo of a deposit invoice.
+	DepositMemoPrefix string `long:"depositmemoprefix" description:"The prefix of the memo of a deposit invoice. It must be followed by the hex encoded ID of the account that is credited, optionally followed by a space and any other text, for example 'lit-deposit:0123456789abcdef top-up'."`
+
+	// DepositRecordType is the type of the custom record that carries the
+	// ID of the account in the HTLCs of a deposit invoice.
+	DepositRecordType uint64 `long:"depositrecordtype" description:"The type of a custom record in the HTLCs that settle a deposit invoice that carries the 8 byte ID of the account that is credited. It takes precedence over the memo of the invoice. Must be in the custom record range starting at 65536. If not set, only the memo is used."`
+
+	// DefaultExpiration is the time after which accounts expire that are
+	// created without an expiration date.
+	DefaultExpiration time.Duration `long:"defaultexpiration" description:"The time after creation at which an account expires if it is created without an expiration date, for example 720h. Applies to all clients of the accounts RPCs. If not set, such accounts never expire."`
+
+	// MaxExpiration is the maximum time from now that the expiration date
+	// of an account can be set to.
+	MaxExpiration time.Duration `long:"maxexpiration" description:"The maximum time from now that the expiration date of an account can be set to when it is created or updated, for example 2160h. Expiration dates further in the future and accounts that never expire are rejected, unless a defaultexpiration is set, which is then applied to new accounts without an expiration date. Imported accounts keep their expiration date. If not set, there is no maximum."`
+}
+
+// DefaultConfig returns the default configuration of the accounts service.
+func DefaultConfig() *Config {
+	return &Config{
+		SpendWebhookBatchInterval: DefaultSpendWebhookBatchInterval,
+		DailySummaryTime:          DefaultDailySummaryTime,
+		StoreCommitPolicy:         string(CommitPolicySync),
+		UnknownAccountPolicy:      string(UnknownAccountReject),
+		SatRounding:               string(RoundDown),
+		DepositMemoPrefix:         DefaultDepositMemoPrefix,
+	}
+}
+
+// BoltStoreOptions returns the options that should be used to open the bbolt
+// account store according to the configuration.
+func (c *Config) BoltStoreOptions() []BoltStoreOption {
+	var opts []BoltStoreOption
+	if c.StoreCommitPolicy != "" {
+		opts = append(opts, WithCommitPolicy(
+			CommitPolicy(c.StoreCommitPolicy),
+		))
+	}
+	if c.StoreNoFreelistSync {
+		opts = append(opts, WithNoFreelistSync())
+	}
+
+	return opts
 }
 
+// UnknownAccountPolicy determines how the interceptor handles requests that are
+// made with a macaroon of an account that doesn't exist, for example because
+// it was removed while its macaroon was still in use.
+type UnknownAccountPolicy string
+
+const (
+	// UnknownAccountReject rejects the request with an error stating that
+	// the account no longer exists.
+	UnknownAccountReject UnknownAccountPolicy = "reject"
+
+	// UnknownAccountPassThrough accepts the request without applying any
+	// account restrictions to it.
+	UnknownAccountPassThrough UnknownAccountPolicy = "passthrough"
+)
+
 // trackedPayment is a struct that holds all information that identifies a
 // payment that we are tracking in the service.
 type trackedPayment struct {
@@ -54,7 +170,12 @@ type InterceptorService struct {
 
 	store Store
 
-	routerClient lndclient.RouterClient
+	lightningClient lndclient.LightningClient
+	routerClient    lndclient.RouterClient
+
+	// nodeID is the identity public key of the lnd node the service is
+	// connected to. It is only known once the service was started.
+	nodeID route.Vertex
 
 	mainCtx       context.Context
 	contextCancel fn.Option[context.CancelFunc]
@@ -70,6 +191,62 @@ type InterceptorService struct {
 
 	*requestValuesStore
 
+	// lowBalanceWatermark is the balance in millisatoshis below which the
+	// lowBalanceHook is invoked for an account.
+	lowBalanceWatermark lnwire.MilliSatoshi
+
+	// lowBalanceHook is an optional hook that is invoked when the balance
+	// of an account drops below the lowBalanceWatermark.
+	lowBalanceHook LowBalanceHook
+
+	// spendNotifiers are optional notifiers that are informed about every
+	// debit of an account.
+	spendNotifiers []SpendNotifier
+
+	// debitSeq is the sequence number of the last debit event that was
+	// sent to the spendNotifiers.
+	debitSeq uint64
+
+	// paymentEvents distributes the lifecycle events of account payments
+	// to subscribers.
+	paymentEvents *paymentEventBroker
+
+	// lifecycleEvents distributes the creation, changes and removal of
+	// accounts to subscribers.
+	lifecycleEvents *lifecycleEventBroker
+
+	// expiryWarningReschedule signals the expiry warning loop that the
+	// expiration date or the expiry warning of an account changed.
+	expiryWarningReschedule chan struct{}
+
+	// unknownAccountPolicy determines how requests for accounts that
+	// don't exist are handled.
+	unknownAccountPolicy UnknownAccountPolicy
+
+	// rounding is the rounding mode used when balances are reported in
+	// satoshis.
+	rounding RoundingMode
+
+	// labelGenerator is an optional generator for the labels of accounts
+	// that are created without one.
+	labelGenerator LabelGenerator
+
+	// topUpSource is the ID of the account that funds the automatic
+	// top-ups of accounts. Top-ups are disabled if it is the zero ID.
+	topUpSource AccountID
+
+	// depositTag describes how deposit invoices are tagged with the ID of
+	// the account they credit. Deposits are disabled if it is nil.
+	depositTag *DepositTag
+
+	// expirationPolicy restricts the expiration dates of the accounts that
+	// are created or updated through the RPC server.
+	expirationPolicy ExpirationPolicy
+
+	// rootKeyIDInUse reports whether a macaroon root key ID is used by
+	// something other than an account. It is optional.
+	rootKeyIDInUse RootKeyIDInUse
+
 	mainErrCallback func(error)
 	wg              sync.WaitGroup
 	quit            chan struct{}
@@ -77,20 +254,82 @@ type InterceptorService struct {
 	isEnabled bool
 }
 
+// ServiceOption is a functional option that can be passed to NewService to
+// modify the behavior of the account service.
+type ServiceOption func(*InterceptorService)
+
+// WithLowBalanceHook is a functional option that can be passed to NewService to
+// let the service invoke the given hook whenever the balance of an account
+// drops below the given watermark.
+func WithLowBalanceHook(watermark lnwire.MilliSatoshi,
+	hook LowBalanceHook) ServiceOption {
+
+	return func(s *InterceptorService) {
+		s.lowBalanceWatermark = watermark
+		s.lowBalanceHook = hook
+	}
+}
+
+// WithSpendNotifier is a functional option that can be passed to NewService to
+// let the service inform the given notifier about every debit of an account.
+// It can be passed multiple times to inform several notifiers.
+func WithSpendNotifier(notifier SpendNotifier) ServiceOption {
+	return func(s *InterceptorService) {
+		s.spendNotifiers = append(s.spendNotifiers, notifier)
+	}
+}
+
+// WithUnknownAccountPolicy is a functional option that can be passed to
+// NewService to set how requests for accounts that don't exist are handled.
+func WithUnknownAccountPolicy(policy UnknownAccountPolicy) ServiceOption {
+	return func(s *InterceptorService) {
+		s.unknownAccountPolicy = policy
+	}
+}
+
+// WithRoundingMode is a functional option that can be passed to NewService to
+// set how millisatoshi balances are rounded when reported in satoshis.
+func WithRoundingMode(rounding RoundingMode) ServiceOption {
+	return func(s *InterceptorService) {
+		s.rounding = rounding
+	}
+}
+
+// WithLabelGenerator is a functional option that can be passed to NewService
+// to generate a label for every account that is created without one.
+func WithLabelGenerator(generator LabelGenerator) ServiceOption {
+	return func(s *InterceptorService) {
+		s.labelGenerator = generator
+	}
+}
+
 // NewService returns a service backed by the macaroon Bolt DB stored in the
 // passed-in directory.
-func NewService(store Store, errCallback func(error)) (*InterceptorService,
-	error) {
+func NewService(store Store, errCallback func(error),
+	opts ...ServiceOption) (*InterceptorService, error) {
 
-	return &InterceptorService{
+	s := &InterceptorService{
 		store:              store,
 		invoiceToAccount:   make(map[lntypes.Hash]AccountID),
 		pendingPayments:    make(map[lntypes.Hash]*trackedPayment),
 		requestValuesStore: newRequestValuesStore(),
+		paymentEvents:      newPaymentEventBroker(),
+		lifecycleEvents:    newLifecycleEventBroker(),
 		mainErrCallback:    errCallback,
 		quit:               make(chan struct{}),
 		isEnabled:          false,
-	}, nil
+
+		unknownAccountPolicy:    UnknownAccountReject,
+		rounding:                RoundDown,
+		expiryWarningReschedule: make(chan struct{}, 1),
+	}
+	for _, o := range opts {
+		o(s)
+	}
+
+	registerMetrics()
+
+	return s, nil
 }
 
 // Start starts the account service and its interceptor capability.
@@ -102,8 +341,17 @@ func (s *InterceptorService) Start(ctx context.Context,
 	s.mainCtx = mainCtx
 	s.contextCancel = fn.Some(contextCancel)
 
+	s.lightningClient = lightningClient
 	s.routerClient = routerClient
-	s.checkers = NewAccountChecker(s, params)
+	s.checkers = NewAccountChecker(s, params, s.rounding)
+
+	// New accounts are bound to the node we're connected to, and accounts
+	// bound to a different node are rejected by the interceptor.
+	info, err := lightningClient.GetInfo(ctx)
+	if err != nil {
+		return s.disableAndErrorf("error querying node info: %w", err)
+	}
+	s.nodeID = info.IdentityPubkey
 
 	s.isEnabled = true
 
@@ -123,10 +371,13 @@ func (s *InterceptorService) Start(ctx context.Context,
 		}
 
 		// Let's also resume tracking payments that have a last recorded
-		// state of being in-flight.
+		// state of being in-flight. Their full amount stays reserved
+		// until lnd reports a final state for them. Failed payments
+		// don't reserve anything, so we don't need to track them again
+		// unless they are retried.
 		for hash, entry := range acct.Payments {
 			entry := entry
-			if !successState(entry.Status) {
+			if inflightState(entry.Status) {
 				err := s.TrackPayment(
 					ctx, acct.ID, hash, entry.FullAmount,
 				)
@@ -217,6 +468,9 @@ func (s *InterceptorService) Start(ctx context.Context,
 		}
 	}()
 
+	s.wg.Add(1)
+	go s.expiryWarningLoop(s.mainCtx)
+
 	return nil
 }
 
@@ -285,23 +539,99 @@ func (s *InterceptorService) disableAndErrorfUnsafe(format string,
 }
 
 // NewAccount creates a new OffChainBalanceAccount with the given balance and a
-// randomly chosen ID.
+// randomly chosen ID. If no label is given and a label generator is
+// configured, the account gets a generated label that isn't used by any other
+// account yet.
 func (s *InterceptorService) NewAccount(ctx context.Context,
 	balance lnwire.MilliSatoshi,
-	expirationDate time.Time, label string) (*OffChainBalanceAccount,
-	error) {
+	expirationDate time.Time, label string,
+	opts ...NewAccountOption) (*OffChainBalanceAccount, error) {
 
 	s.Lock()
 	defer s.Unlock()
 
-	return s.store.NewAccount(ctx, balance, expirationDate, label)
+	// The root key of an account's macaroons is derived from the first
+	// bytes of its ID. Random IDs are unlikely to collide, but a caller
+	// that chooses the ID could otherwise get the macaroon root key of
+	// another account or session.
+	id := newAccountOptionsFromOpts(opts).id
+	if id != (AccountID{}) {
+		if err := s.checkRootKeyIDUnsafe(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	// Bind the account to the node we're connected to unless the caller
+	// chose a node explicitly.
+	if s.nodeID != (route.Vertex{}) {
+		opts = append([]NewAccountOption{WithNodeID(s.nodeID)}, opts...)
+	}
+
+	var (
+		acct *OffChainBalanceAccount
+		err  error
+	)
+	if label == "" && s.labelGenerator != nil {
+		acct, err = s.newAccountWithGeneratedLabel(
+			ctx, balance, expirationDate, opts...,
+		)
+	} else {
+		acct, err = s.store.NewAccount(
+			ctx, balance, expirationDate, label, opts...,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.recordEvent(
+		ctx, acct.ID, AccountEventCreated, "initial balance %v, %s",
+		balance, describeExpiry(expirationDate),
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleCreated, acct.ID)
+
+	return acct, nil
 }
 
-// UpdateAccount writes an account to the database, overwriting the existing one
-// if it exists.
+// newAccountWithGeneratedLabel creates a new account with a label from the
+// label generator. If the generated label is already taken, a new one is
+// generated up to maxLabelAttempts times.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) newAccountWithGeneratedLabel(ctx context.Context,
+	balance lnwire.MilliSatoshi, expirationDate time.Time,
+	opts ...NewAccountOption) (*OffChainBalanceAccount, error) {
+
+	for i := 0; i < maxLabelAttempts; i++ {
+		label, err := s.labelGenerator()
+		if err != nil {
+			return nil, err
+		}
+
+		acct, err := s.store.NewAccount(
+			ctx, balance, expirationDate, label, opts...,
+		)
+		if errors.Is(err, ErrLabelAlreadyExists) {
+			log.Debugf("Generated account label '%s' is already "+
+				"taken, trying another one", label)
+
+			continue
+		}
+
+		return acct, err
+	}
+
+	return nil, fmt.Errorf("unable to generate a unique account label "+
+		"after %d attempts", maxLabelAttempts)
+}
+
+// UpdateAccount applies the given update to an existing account. Only the
+// fields that are set in the update are changed. If a version is given, the
+// update is only applied if the account still has that version, otherwise
+// ErrAccountVersionConflict is returned.
 func (s *InterceptorService) UpdateAccount(ctx context.Context,
-	accountID AccountID, accountBalance btcutil.Amount,
-	expirationDate int64) (*OffChainBalanceAccount, error) {
+	accountID AccountID, update *AccountUpdate,
+	version fn.Option[uint64]) (*OffChainBalanceAccount, error) {
 
 	s.Lock()
 	defer s.Unlock()
@@ -314,34 +644,143 @@ func (s *InterceptorService) UpdateAccount(ctx context.Context,
 		return nil, ErrAccountServiceDisabled
 	}
 
-	// If the expiration date was set, parse it as a unix time stamp. A
-	// value of -1 signals "don't update the expiration date".
-	var expiry fn.Option[time.Time]
-	if expirationDate > 0 {
-		expiry = fn.Some(time.Unix(expirationDate, 0))
-	} else if expirationDate == 0 {
-		// Setting the expiration to 0 means don't expire in which case
-		// we use a zero time (zero unix time would still be 1970, so
-		// that doesn't work for us).
-		expiry = fn.Some(time.Time{})
-	}
+	// The store lock is held until the update is complete, so the account
+	// can't change between this check and the update.
+	var versionErr error
+	version.WhenSome(func(expected uint64) {
+		acct, err := s.store.Account(ctx, accountID)
+		if err != nil {
+			versionErr = err
+			return
+		}
 
-	// If the new account balance was set, parse it as millisatoshis. A
-	// value of -1 signals "don't update the balance".
-	var balance fn.Option[int64]
-	if accountBalance >= 0 {
-		// Convert from satoshis to millisatoshis for storage.
-		balance = fn.Some(int64(accountBalance) * 1000)
+		if acct.Version != expected {
+			versionErr = fmt.Errorf("%w: expected version %d but "+
+				"account is at version %d",
+				ErrAccountVersionConflict, expected,
+				acct.Version)
+		}
+	})
+	if versionErr != nil {
+		return nil, versionErr
 	}
 
-	// Create the actual account in the macaroon account store.
-	err := s.store.UpdateAccountBalanceAndExpiry(
-		ctx, accountID, balance, expiry,
+	// We normalize a copy of the update, so that the caller's one is left
+	// untouched.
+	normalized := *update
+	normalized.AllowedDestinations = fn.MapOption(normalizeDestinations)(
+		update.AllowedDestinations,
 	)
+	update = &normalized
+
+	// All changes are written in a single transaction, so a failure can't
+	// leave the account partially updated.
+	err := s.store.UpdateAccount(ctx, accountID, update)
 	if err != nil {
 		return nil, fmt.Errorf("unable to update account: %w", err)
 	}
 
+	update.Balance.WhenSome(func(amt int64) {
+		s.recordEvent(
+			ctx, accountID, AccountEventBalanceUpdated,
+			"balance set to %v", lnwire.MilliSatoshi(amt),
+		)
+	})
+	update.Expiry.WhenSome(func(t time.Time) {
+		s.recordEvent(
+			ctx, accountID, AccountEventExpiryUpdated, "%s",
+			describeExpiry(t),
+		)
+	})
+	update.MaxHTLC.WhenSome(func(amt lnwire.MilliSatoshi) {
+		s.recordEvent(
+			ctx, accountID, AccountEventLimitsUpdated,
+			"max HTLC set to %v", amt,
+		)
+	})
+	update.SoftCap.WhenSome(func(amt lnwire.MilliSatoshi) {
+		s.recordEvent(
+			ctx, accountID, AccountEventLimitsUpdated,
+			"soft cap set to %v", amt,
+		)
+	})
+	update.TopUp.WhenSome(func(policy TopUpPolicy) {
+		s.recordEvent(
+			ctx, accountID, AccountEventLimitsUpdated, "%s",
+			describeTopUpPolicy(policy),
+		)
+	})
+	update.SpendRateLimit.WhenSome(func(limit SpendRateLimit) {
+		s.recordEvent(
+			ctx, accountID, AccountEventLimitsUpdated, "%s",
+			describeSpendRateLimit(limit),
+		)
+	})
+	update.ExpiryWarning.WhenSome(func(warning time.Duration) {
+		s.recordEvent(
+			ctx, accountID, AccountEventExpiryUpdated, "%s",
+			describeExpiryWarning(warning),
+		)
+	})
+	update.AllowedDestinations.WhenSome(func(dests []route.Vertex) {
+		s.recordEvent(
+			ctx, accountID, AccountEventLimitsUpdated, "%s",
+			describeAllowedDestinations(dests),
+		)
+	})
+	update.MaxOpenInvoices.WhenSome(func(maxOpen uint32) {
+		s.recordEvent(
+			ctx, accountID, AccountEventLimitsUpdated, "%s",
+			describeMaxOpenInvoices(maxOpen),
+		)
+	})
+	update.MaxInvoiceAmount.WhenSome(func(maxAmount lnwire.MilliSatoshi) {
+		s.recordEvent(
+			ctx, accountID, AccountEventLimitsUpdated, "%s",
+			describeMaxInvoiceAmount(maxAmount),
+		)
+	})
+	update.MaxPayment.WhenSome(func(amt lnwire.MilliSatoshi) {
+		s.recordEvent(
+			ctx, accountID, AccountEventLimitsUpdated,
+			"max payment set to %v", amt,
+		)
+	})
+
+	if update.Expiry.IsSome() || update.ExpiryWarning.IsSome() {
+		s.rescheduleExpiryWarnings()
+	}
+
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, accountID)
+
+	return s.store.Account(ctx, accountID)
+}
+
+// RenameAccountLabel changes the label of an existing account in the database
+// without touching any of its other fields.
+func (s *InterceptorService) RenameAccountLabel(ctx context.Context,
+	accountID AccountID, label string) (*OffChainBalanceAccount, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	acct, err := s.store.Account(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	oldLabel := acct.Label
+
+	err = s.store.UpdateAccountLabel(ctx, accountID, label)
+	if err != nil {
+		return nil, fmt.Errorf("unable to rename account: %w", err)
+	}
+
+	s.recordEvent(
+		ctx, accountID, AccountEventLabelRenamed, "label changed from "+
+			"'%s' to '%s'", oldLabel, label,
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, accountID)
+
 	return s.store.Account(ctx, accountID)
 }
 
@@ -367,13 +806,20 @@ func (s *InterceptorService) CreditAccount(ctx context.Context,
 		return nil, fmt.Errorf("unable to credit account: %w", err)
 	}
 
+	s.recordEvent(
+		ctx, accountID, AccountEventBalanceUpdated, "credited %v",
+		amount,
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, accountID)
+
 	return s.store.Account(ctx, accountID)
 }
 
 // DebitAccount decreases the balance of an existing account in the database.
+// The optional memo is passed on to the spend notifiers, if any are configured.
 func (s *InterceptorService) DebitAccount(ctx context.Context,
-	accountID AccountID,
-	amount lnwire.MilliSatoshi) (*OffChainBalanceAccount, error) {
+	accountID AccountID, amount lnwire.MilliSatoshi,
+	memo string) (*OffChainBalanceAccount, error) {
 
 	s.Lock()
 	defer s.Unlock()
@@ -392,7 +838,90 @@ func (s *InterceptorService) DebitAccount(ctx context.Context,
 		return nil, fmt.Errorf("unable to debit account: %w", err)
 	}
 
-	return s.store.Account(ctx, accountID)
+	s.recordEvent(
+		ctx, accountID, AccountEventBalanceUpdated, "debited %v",
+		amount,
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, accountID)
+
+	acct, err := s.store.Account(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.accountDebited(acct, amount, fn.None[lntypes.Hash](), memo)
+
+	return acct, nil
+}
+
+// accountDebited must be called after the given account was debited by the
+// given amount. It invokes the low balance hook if the debit made the balance
+// drop below the watermark and informs the spend notifiers about the debit.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) accountDebited(acct *OffChainBalanceAccount,
+	amount lnwire.MilliSatoshi, paymentHash fn.Option[lntypes.Hash],
+	memo string) {
+
+	s.checkLowBalance(acct, acct.CurrentBalance+int64(amount))
+
+	if len(s.spendNotifiers) == 0 {
+		return
+	}
+
+	s.debitSeq++
+	event := &DebitEvent{
+		Sequence:    s.debitSeq,
+		AccountID:   acct.ID.String(),
+		AmountMsat:  uint64(amount),
+		BalanceMsat: acct.CurrentBalance,
+		Memo:        memo,
+		Timestamp:   time.Now().Unix(),
+	}
+	paymentHash.WhenSome(func(hash lntypes.Hash) {
+		event.PaymentHash = hash.String()
+	})
+
+	for _, notifier := range s.spendNotifiers {
+		notifier.AccountDebited(event)
+	}
+}
+
+// checkLowBalance invokes the low balance hook in a separate goroutine if the
+// balance of the given account just dropped below the low balance watermark.
+// The hook is only invoked once when the watermark is crossed and not again
+// for further debits while the balance stays below it.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) checkLowBalance(acct *OffChainBalanceAccount,
+	prevBalance int64) {
+
+	if s.lowBalanceHook == nil || s.lowBalanceWatermark == 0 {
+		return
+	}
+
+	watermark := int64(s.lowBalanceWatermark)
+	if prevBalance < watermark || acct.CurrentBalance >= watermark {
+		return
+	}
+
+	log.Debugf("Balance of account %x dropped below the low balance "+
+		"watermark of %v, invoking hook", acct.ID[:],
+		s.lowBalanceWatermark)
+
+	// We call the hook in a goroutine since it is allowed to call back into
+	// the service, for example to credit the account, which would deadlock
+	// while we're still holding the lock.
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		err := s.lowBalanceHook.AccountBalanceLow(s.mainCtx, acct)
+		if err != nil {
+			log.Errorf("Error invoking low balance hook for "+
+				"account %x: %v", acct.ID[:], err)
+		}
+	}()
 }
 
 // Account retrieves an account from the bolt DB and un-marshals it. If the
@@ -423,6 +952,191 @@ func (s *InterceptorService) RemoveAccount(ctx context.Context,
 	s.Lock()
 	defer s.Unlock()
 
+	err := s.removeAccountUnsafe(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	s.recordEvent(ctx, id, AccountEventRemoved, "account removed")
+	s.publishLifecycleEvent(ctx, AccountLifecycleRemoved, id)
+
+	return nil
+}
+
+// SweepAndRemoveAccount credits the remaining balance of the account with the
+// given ID to the target account and removes the account in one step, so that
+// no balance is stranded. Accounts with in-flight payments can't be swept, as
+// the payments might still be debited. The swept amount is returned.
+func (s *InterceptorService) SweepAndRemoveAccount(ctx context.Context, id,
+	target AccountID) (lnwire.MilliSatoshi, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	// As this function updates account balances, we require that the
+	// service is running before we execute it.
+	if !s.isRunningUnsafe() {
+		return 0, ErrAccountServiceDisabled
+	}
+
+	if id == target {
+		return 0, ErrSweepToSelf
+	}
+
+	for hash, payment := range s.pendingPayments {
+		if payment.accountID == id {
+			return 0, fmt.Errorf("cannot sweep account %v with "+
+				"in-flight payment %v", id, hash)
+		}
+	}
+
+	swept, err := s.store.SweepAndRemoveAccount(ctx, id, target)
+	if err != nil {
+		return 0, err
+	}
+
+	s.recordEvent(
+		ctx, id, AccountEventRemoved, "account removed, swept %v to "+
+			"account %v", swept, target,
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleRemoved, id)
+	if swept > 0 {
+		s.recordEvent(
+			ctx, target, AccountEventBalanceUpdated, "credited %v "+
+				"swept from removed account %v", swept, id,
+		)
+		s.publishLifecycleEvent(
+			ctx, AccountLifecycleUpdated, target,
+		)
+	}
+
+	return swept, nil
+}
+
+// TransferAccountBalance moves the given amount from the source account to the
+// destination account in a single store transaction, so that no balance is
+// lost if litd stops halfway. The amount must be covered by the balance of the
+// source that isn't reserved for in-flight payments. The updated source and
+// destination accounts are returned.
+func (s *InterceptorService) TransferAccountBalance(ctx context.Context,
+	source, destination AccountID,
+	amount lnwire.MilliSatoshi) (*OffChainBalanceAccount,
+	*OffChainBalanceAccount, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	// As this function updates account balances, we require that the
+	// service is running before we execute it.
+	if !s.isRunningUnsafe() {
+		return nil, nil, ErrAccountServiceDisabled
+	}
+
+	if source == destination {
+		return nil, nil, ErrTransferToSelf
+	}
+
+	if amount == 0 {
+		return nil, nil, fmt.Errorf("transfer amount must be positive")
+	}
+
+	// The store only makes sure that the balance doesn't go negative, but
+	// in-flight payments might still be debited from the source, so we
+	// don't allow their amount to be transferred away.
+	sourceAcct, err := s.store.Account(ctx, source)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error fetching transfer source: "+
+			"%w", err)
+	}
+	available := calcAvailableAccountBalance(sourceAcct)
+	if available < int64(amount) {
+		return nil, nil, fmt.Errorf("%w: cannot transfer %v, only %d "+
+			"mSAT are not reserved for in-flight payments",
+			ErrAccBalanceInsufficient, amount, available)
+	}
+
+	err = s.store.TransferAccountBalance(ctx, source, destination, amount)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to transfer balance: %w",
+			err)
+	}
+
+	s.recordEvent(
+		ctx, source, AccountEventBalanceUpdated, "transferred %v to "+
+			"account %v", amount, destination,
+	)
+	s.recordEvent(
+		ctx, destination, AccountEventBalanceUpdated, "received %v "+
+			"transferred from account %v", amount, source,
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, source)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, destination)
+
+	sourceAcct, err = s.store.Account(ctx, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	s.checkLowBalance(sourceAcct, sourceAcct.CurrentBalance+int64(amount))
+
+	destAcct, err := s.store.Account(ctx, destination)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sourceAcct, destAcct, nil
+}
+
+// PurgeExpiredAccounts removes all accounts that have expired from the DB and
+// returns them. If preview is true, the accounts that would be removed are
+// returned without actually removing them.
+func (s *InterceptorService) PurgeExpiredAccounts(ctx context.Context,
+	preview bool) ([]*OffChainBalanceAccount, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	accts, err := s.store.Accounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []*OffChainBalanceAccount
+	for _, acct := range accts {
+		if !acct.HasExpired() {
+			continue
+		}
+
+		expired = append(expired, acct)
+	}
+
+	if preview {
+		return expired, nil
+	}
+
+	for _, acct := range expired {
+		err := s.removeAccountUnsafe(ctx, acct.ID)
+		if err != nil {
+			return nil, fmt.Errorf("error removing account %x: %w",
+				acct.ID[:], err)
+		}
+
+		s.recordEvent(
+			ctx, acct.ID, AccountEventRemoved, "expired account "+
+				"purged",
+		)
+		s.publishLifecycleEvent(ctx, AccountLifecycleRemoved, acct.ID)
+	}
+
+	return expired, nil
+}
+
+// removeAccountUnsafe stops tracking all payments of the account with the
+// given ID and then removes it from the DB.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) removeAccountUnsafe(ctx context.Context,
+	id AccountID) error {
+
 	// Are we currently tracking any payments?
 	for hash, payment := range s.pendingPayments {
 		if payment.accountID != id {
@@ -480,6 +1194,19 @@ func calcAvailableAccountBalance(account *OffChainBalanceAccount) int64 {
 	return account.CurrentBalance - inFlightAmt
 }
 
+// numPendingPayments returns the number of payments of the account that are
+// still in-flight and therefore reserve part of its balance.
+func numPendingPayments(account *OffChainBalanceAccount) uint32 {
+	var numPending uint32
+	for _, payment := range account.Payments {
+		if inflightState(payment.Status) {
+			numPending++
+		}
+	}
+
+	return numPending
+}
+
 // AssociateInvoice associates a generated invoice with the given account,
 // making it possible for the account to be credited in case the invoice is
 // paid.
@@ -603,22 +1330,38 @@ func (s *InterceptorService) invoiceUpdate(ctx context.Context,
 		return nil
 	}
 
-	// The invoice was settled, let's now credit the account. But only if
-	// the invoice actually belongs to an account that we track.
+	// The invoice was settled, let's now credit the account. If the
+	// invoice doesn't belong to an account that we track, it might be a
+	// deposit to an account or a credit rule might still map it to one.
 	acctID, ok := s.invoiceToAccount[invoice.Hash]
 	if !ok {
-		return nil
+		credited, err := s.creditDeposit(ctx, invoice)
+		if err != nil || credited {
+			return err
+		}
+
+		return s.creditByRule(ctx, invoice)
 	}
 
 	// If we get here, the current account has the invoice associated with
 	// it that was just paid. Credit the amount to the account and update it
 	// in the DB.
-	err := s.store.CreditAccount(ctx, acctID, invoice.AmountPaid)
+	err := s.store.CreditAccount(
+		ctx, acctID, invoice.AmountPaid,
+		WithCreditInvoice(invoice.Hash),
+	)
 	if err != nil {
 		return s.disableAndErrorfUnsafe("error increasing account "+
 			"balance account: %w", err)
 	}
 
+	err = s.store.CloseAccountInvoice(ctx, acctID, invoice.Hash)
+	if err != nil {
+		return s.disableAndErrorfUnsafe("error closing account "+
+			"invoice: %w", err)
+	}
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, acctID)
+
 	// We've now fully processed the invoice and don't need to keep it
 	// mapped in memory anymore.
 	delete(s.invoiceToAccount, invoice.Hash)
@@ -712,6 +1455,15 @@ func (s *InterceptorService) TrackPayment(ctx context.Context, id AccountID,
 		fullAmount: fullAmt,
 		cancel:     cancel,
 	}
+	s.updateExposureMetrics()
+
+	s.paymentEvents.publish(&PaymentEvent{
+		Type:      PaymentEventInitiated,
+		AccountID: id,
+		Hash:      hash,
+		Amount:    fullAmt,
+		Timestamp: time.Now(),
+	})
 
 	s.wg.Add(1)
 	go func() {
@@ -800,6 +1552,20 @@ func (s *InterceptorService) paymentUpdate(ctx context.Context,
 	// The unknown state should never happen in practice but if it ever did
 	// we couldn't handle it anyway, so let's also ignore it.
 	if inflightState(status.State) {
+		s.RLock()
+		pendingPayment, ok := s.pendingPayments[hash]
+		s.RUnlock()
+
+		if ok {
+			s.paymentEvents.publish(&PaymentEvent{
+				Type:      PaymentEventInFlight,
+				AccountID: pendingPayment.accountID,
+				Hash:      hash,
+				Amount:    status.Value,
+				Timestamp: time.Now(),
+			})
+		}
+
 		return false, nil
 	}
 
@@ -832,19 +1598,31 @@ func (s *InterceptorService) paymentUpdate(ctx context.Context,
 		if err != nil {
 			err = s.disableAndErrorfUnsafe("error removing "+
 				"payment: %w", err)
+
+			return terminalState, err
 		}
 
-		return terminalState, err
+		s.paymentEvents.publish(&PaymentEvent{
+			Type:      PaymentEventFailed,
+			AccountID: pendingPayment.accountID,
+			Hash:      hash,
+			Amount:    status.Value,
+			Timestamp: time.Now(),
+		})
+
+		return terminalState, nil
 	}
 
 	// The payment went through! We now need to debit the full amount from
 	// the account.
 	fullAmount := status.Value + status.Fee
 
-	// Update the persisted account.
+	// Update the persisted account. We also record the fee and destination
+	// of the payment so the debit can later be audited.
 	_, err := s.store.UpsertAccountPayment(
 		ctx, pendingPayment.accountID, hash, fullAmount,
 		lnrpc.Payment_SUCCEEDED, WithDebitAccount(),
+		WithSettlementDetails(status.Fee, paymentDestination(status)),
 	)
 	if err != nil {
 		err = s.disableAndErrorfUnsafe("error updating account: %w",
@@ -853,6 +1631,35 @@ func (s *InterceptorService) paymentUpdate(ctx context.Context,
 		return terminalState, err
 	}
 
+	// If a low balance hook or spend notifier is configured, we need to
+	// inform them about the debit. A failure to look up the account only
+	// affects those, so we don't treat it as fatal.
+	if s.lowBalanceHook != nil || len(s.spendNotifiers) > 0 {
+		acct, err := s.store.Account(ctx, pendingPayment.accountID)
+		if err != nil {
+			log.Errorf("Error fetching account %x after debit: %v",
+				pendingPayment.accountID[:], err)
+		} else {
+			s.accountDebited(acct, fullAmount, fn.Some(hash), "")
+		}
+	}
+	s.publishLifecycleEvent(
+		ctx, AccountLifecycleUpdated, pendingPayment.accountID,
+	)
+
+	// If the payment brought the balance below the minimum of the
+	// account's top-up policy, it is topped up right away.
+	s.topUpAccount(ctx, pendingPayment.accountID)
+
+	s.paymentEvents.publish(&PaymentEvent{
+		Type:      PaymentEventSettled,
+		AccountID: pendingPayment.accountID,
+		Hash:      hash,
+		Amount:    status.Value,
+		Fee:       status.Fee,
+		Timestamp: time.Now(),
+	})
+
 	// We've now fully processed the payment and don't need to keep it
 	// mapped or tracked anymore.
 	err = s.removePayment(ctx, hash, lnrpc.Payment_SUCCEEDED)
@@ -864,6 +1671,63 @@ func (s *InterceptorService) paymentUpdate(ctx context.Context,
 	return terminalState, err
 }
 
+// paymentDestination returns the node that the given payment was sent to, as
+// revealed by the final hop of its first succeeded HTLC. The zero vertex is
+// returned if the destination can't be determined.
+func paymentDestination(status lndclient.PaymentStatus) route.Vertex {
+	for _, htlc := range status.Htlcs {
+		if htlc.Status != lnrpc.HTLCAttempt_SUCCEEDED ||
+			htlc.Route == nil || len(htlc.Route.Hops) == 0 {
+
+			continue
+		}
+
+		lastHop := htlc.Route.Hops[len(htlc.Route.Hops)-1]
+		dest, err := route.NewVertexFromStr(lastHop.PubKey)
+		if err != nil {
+			log.Warnf("Invalid destination %v in route of "+
+				"payment: %v", lastHop.PubKey, err)
+
+			return route.Vertex{}
+		}
+
+		return dest
+	}
+
+	return route.Vertex{}
+}
+
+// NodeID returns the identity public key of the lnd node the service is
+// connected to. It is empty if the service hasn't been started.
+func (s *InterceptorService) NodeID() route.Vertex {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.nodeID
+}
+
+// RoundingMode returns the rounding mode that is used when balances are
+// reported in satoshis.
+func (s *InterceptorService) RoundingMode() RoundingMode {
+	return s.rounding
+}
+
+// ExpirationPolicy returns the policy that restricts the expiration dates of
+// the accounts that are created or updated through the RPC server.
+func (s *InterceptorService) ExpirationPolicy() ExpirationPolicy {
+	return s.expirationPolicy
+}
+
+// SubscribePaymentEvents returns a channel on which the lifecycle events of all
+// payments charged to accounts are delivered, together with a function that
+// must be called to end the subscription. Events are dropped if the receiver
+// doesn't keep up.
+func (s *InterceptorService) SubscribePaymentEvents() (<-chan *PaymentEvent,
+	func()) {
+
+	return s.paymentEvents.subscribe()
+}
+
 // RemovePayment removes a failed payment from the service because it no longer
 // needs to be tracked. The payment is certain to never succeed, so we never
 // need to debit the amount from the account.
@@ -906,6 +1770,7 @@ func (s *InterceptorService) removePayment(ctx context.Context,
 
 	pendingPayment.cancel()
 	delete(s.pendingPayments, hash)
+	s.updateExposureMetrics()
 
 	return nil
 }
@@ -2,17 +2,24 @@ package accounts
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
 	invpkg "github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,6 +29,10 @@ var (
 	testExpiration = time.Now().Add(24 * time.Hour)
 	testTimeout    = time.Millisecond * 500
 	testInterval   = time.Millisecond * 20
+
+	// testNodeID is the identity public key of the node mockLnd pretends
+	// to be.
+	testNodeID = route.Vertex{0x02, 0x01, 0x02, 0x03}
 )
 
 type mockLnd struct {
@@ -35,6 +46,8 @@ type mockLnd struct {
 	invoiceSubscriptionErr error
 	invoiceErrChan         chan error
 	invoiceChan            chan *lndclient.Invoice
+
+	pendingInvoices []lndclient.Invoice
 }
 
 func newMockLnd() *mockLnd {
@@ -56,6 +69,12 @@ func (m *mockLnd) RawClientWithMacAuth(ctx context.Context) (context.Context,
 	return ctx, 0, nil
 }
 
+// GetInfo returns the info of the mocked node, of which only the identity
+// public key is set.
+func (m *mockLnd) GetInfo(context.Context) (*lndclient.Info, error) {
+	return &lndclient.Info{IdentityPubkey: testNodeID}, nil
+}
+
 func (m *mockLnd) assertNoMainErr(t *testing.T) {
 	select {
 	case err := <-m.mainErrChan:
@@ -114,6 +133,21 @@ func (m *mockLnd) SubscribeInvoices(_ context.Context,
 	return m.invoiceChan, m.invoiceErrChan, nil
 }
 
+// ListInvoices returns the pending invoices of the mock. Pagination is not
+// supported.
+func (m *mockLnd) ListInvoices(_ context.Context,
+	req lndclient.ListInvoicesRequest) (*lndclient.ListInvoicesResponse,
+	error) {
+
+	if !req.PendingOnly {
+		return nil, fmt.Errorf("only pending invoices can be listed")
+	}
+
+	return &lndclient.ListInvoicesResponse{
+		Invoices: m.pendingInvoices,
+	}, nil
+}
+
 type mockRouter struct {
 	lndclient.RouterClient
 
@@ -349,6 +383,60 @@ func TestAccountService(t *testing.T) {
 			lnd.assertNoMainErr(t)
 			require.True(t, s.IsRunning())
 		},
+	}, {
+		name: "startup do not reserve failed payments",
+		setup: func(t *testing.T, lnd *mockLnd, r *mockRouter,
+			s *InterceptorService) []AccountID {
+
+			acct, err := s.store.NewAccount(
+				ctx, 5000, testExpiration, "",
+			)
+			require.NoError(t, err)
+
+			_, err = s.store.UpsertAccountPayment(
+				ctx, acct.ID, testHash, 2000,
+				lnrpc.Payment_FAILED,
+			)
+			require.NoError(t, err)
+
+			_, err = s.store.UpsertAccountPayment(
+				ctx, acct.ID, testHash2, 1000,
+				lnrpc.Payment_IN_FLIGHT,
+			)
+			require.NoError(t, err)
+
+			return []AccountID{acct.ID}
+		},
+		validate: func(t *testing.T, lnd *mockLnd, r *mockRouter,
+			ids []AccountID, s *InterceptorService) {
+
+			// Only the in-flight payment is tracked again and
+			// reserves part of the balance.
+			r.assertPaymentRequests(t, map[lntypes.Hash]struct{}{
+				testHash2: {},
+			})
+			lnd.assertNoMainErr(t)
+
+			acct, err := s.store.Account(ctx, ids[0])
+			require.NoError(t, err)
+			require.EqualValues(
+				t, 4000, calcAvailableAccountBalance(acct),
+			)
+			require.EqualValues(t, 1, numPendingPayments(acct))
+
+			// Once the payment fails, its amount is released.
+			r.paymentChans[testHash2] <- lndclient.PaymentStatus{
+				State: lnrpc.Payment_FAILED,
+			}
+
+			assertEventually(t, func() bool {
+				acct, err := s.store.Account(ctx, ids[0])
+				require.NoError(t, err)
+
+				return numPendingPayments(acct) == 0 &&
+					calcAvailableAccountBalance(acct) == 5000
+			})
+		},
 	}, {
 		name: "startup err on payment tracking",
 		setup: func(t *testing.T, lnd *mockLnd, r *mockRouter,
@@ -781,6 +869,13 @@ func TestAccountService(t *testing.T) {
 			testID := ids[0]
 			testID2 := ids[1]
 
+			// All three payments count towards the exposure.
+			require.Equal(t, &InFlightExposure{
+				Total:       7000,
+				NumPayments: 3,
+				NumAccounts: 2,
+			}, s.InFlightExposure())
+
 			// The first should be able to initiate another payment
 			// with an amount smaller or equal to 2k msats. This
 			// also asserts that the second accounts in-flight
@@ -804,6 +899,14 @@ func TestAccountService(t *testing.T) {
 				return err == nil
 			})
 
+			// The failed payment no longer counts towards the
+			// exposure.
+			require.Equal(t, &InFlightExposure{
+				Total:       5000,
+				NumPayments: 2,
+				NumAccounts: 2,
+			}, s.InFlightExposure())
+
 			// The second account should be able to initiate a
 			// payment of 1k msats.
 			err = s.CheckBalance(ctx, testID2, 1000)
@@ -872,7 +975,664 @@ func TestAccountService(t *testing.T) {
 	}
 }
 
+// TestLowBalanceHook makes sure that the low balance hook is invoked exactly
+// once when the balance of an account drops below the watermark.
+func TestLowBalanceHook(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lowAccounts := make(chan *OffChainBalanceAccount, 10)
+	hook := LowBalanceHookFunc(func(_ context.Context,
+		acct *OffChainBalanceAccount) error {
+
+		lowAccounts <- acct
+
+		return nil
+	})
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(
+		store, errFunc, WithLowBalanceHook(3000, hook),
+	)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	acct, err := service.NewAccount(ctx, 5000, testExpiration, "")
+	require.NoError(t, err)
+
+	assertNoHookCall := func() {
+		select {
+		case a := <-lowAccounts:
+			t.Fatalf("Unexpected low balance hook call for %x",
+				a.ID[:])
+
+		case <-time.After(testInterval):
+		}
+	}
+
+	// Staying above the watermark shouldn't invoke the hook.
+	_, err = service.DebitAccount(ctx, acct.ID, 1000, "")
+	require.NoError(t, err)
+	assertNoHookCall()
+
+	// Dropping below the watermark should.
+	_, err = service.DebitAccount(ctx, acct.ID, 2000, "")
+	require.NoError(t, err)
+
+	select {
+	case a := <-lowAccounts:
+		require.Equal(t, acct.ID, a.ID)
+		require.EqualValues(t, 2000, a.CurrentBalance)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("Low balance hook wasn't invoked")
+	}
+
+	// Further debits below the watermark don't invoke the hook again.
+	_, err = service.DebitAccount(ctx, acct.ID, 1000, "")
+	require.NoError(t, err)
+	assertNoHookCall()
+}
+
+// mockSpendNotifier is a SpendNotifier that forwards all events to a channel.
+type mockSpendNotifier struct {
+	events chan *DebitEvent
+}
+
+func (m *mockSpendNotifier) AccountDebited(event *DebitEvent) {
+	m.events <- event
+}
+
+// TestSpendNotifier tests that the spend notifier is informed about every
+// debit with increasing sequence numbers.
+func TestSpendNotifier(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	notifier := &mockSpendNotifier{events: make(chan *DebitEvent, 10)}
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc, WithSpendNotifier(notifier))
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	acct, err := service.NewAccount(ctx, 5000, testExpiration, "")
+	require.NoError(t, err)
+
+	_, err = service.DebitAccount(ctx, acct.ID, 1000, "first")
+	require.NoError(t, err)
+
+	_, err = service.DebitAccount(ctx, acct.ID, 1500, "")
+	require.NoError(t, err)
+
+	event := <-notifier.events
+	require.EqualValues(t, 1, event.Sequence)
+	require.Equal(t, acct.ID.String(), event.AccountID)
+	require.EqualValues(t, 1000, event.AmountMsat)
+	require.EqualValues(t, 4000, event.BalanceMsat)
+	require.Equal(t, "first", event.Memo)
+	require.Empty(t, event.PaymentHash)
+
+	event = <-notifier.events
+	require.EqualValues(t, 2, event.Sequence)
+	require.EqualValues(t, 1500, event.AmountMsat)
+	require.EqualValues(t, 2500, event.BalanceMsat)
+	require.Empty(t, event.Memo)
+}
+
+// TestPurgeExpiredAccounts tests that only expired accounts are purged and
+// that a preview doesn't remove any accounts.
+func TestPurgeExpiredAccounts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	active, err := service.NewAccount(ctx, 1000, testExpiration, "active")
+	require.NoError(t, err)
+
+	noExpiry, err := service.NewAccount(ctx, 2000, time.Time{}, "forever")
+	require.NoError(t, err)
+
+	expired, err := service.NewAccount(
+		ctx, 3000, time.Now().Add(-time.Hour), "expired",
+	)
+	require.NoError(t, err)
+
+	// A preview should return the expired account with its balance but
+	// leave it in the DB.
+	purged, err := service.PurgeExpiredAccounts(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+	require.Equal(t, expired.ID, purged[0].ID)
+	require.EqualValues(t, 3000, purged[0].CurrentBalance)
+
+	accts, err := service.Accounts(ctx)
+	require.NoError(t, err)
+	require.Len(t, accts, 3)
+
+	// Now actually purge the expired account.
+	purged, err = service.PurgeExpiredAccounts(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, purged, 1)
+	require.Equal(t, expired.ID, purged[0].ID)
+
+	_, err = service.Account(ctx, expired.ID)
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	_, err = service.Account(ctx, active.ID)
+	require.NoError(t, err)
+
+	_, err = service.Account(ctx, noExpiry.ID)
+	require.NoError(t, err)
+
+	// Purging again shouldn't remove anything.
+	purged, err = service.PurgeExpiredAccounts(ctx, false)
+	require.NoError(t, err)
+	require.Empty(t, purged)
+}
+
 // assertEventually asserts that the given predicate is eventually satisfied.
 func assertEventually(t *testing.T, predicate func() bool) {
 	require.Eventually(t, predicate, testTimeout, testInterval)
 }
+
+// TestAccountServiceHistory makes sure that the account service records the
+// lifecycle events of an account together with the actor from the context.
+func TestAccountServiceHistory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	acct, err := service.NewAccount(ctx, 1000, time.Time{}, "")
+	require.NoError(t, err)
+
+	rpcCtx := AddActorToContext(ctx, "rpc:127.0.0.1:1234")
+	_, err = service.UpdateAccount(
+		rpcCtx, acct.ID, &AccountUpdate{
+			Balance: fn.Some(int64(2000)),
+			MaxHTLC: fn.Some(lnwire.MilliSatoshi(500)),
+		}, fn.None[uint64](),
+	)
+	require.NoError(t, err)
+
+	_, err = service.RenameAccountLabel(rpcCtx, acct.ID, "renamed")
+	require.NoError(t, err)
+
+	require.NoError(t, service.RemoveAccount(rpcCtx, acct.ID))
+
+	events, err := service.AccountHistory(ctx, acct.ID)
+	require.NoError(t, err)
+
+	types := make([]AccountEventType, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+	require.Equal(t, []AccountEventType{
+		AccountEventCreated, AccountEventBalanceUpdated,
+		AccountEventLimitsUpdated, AccountEventLabelRenamed,
+		AccountEventRemoved,
+	}, types)
+
+	// Only the creation happened without an actor in the context.
+	require.Equal(t, defaultActor, events[0].Actor)
+	for _, event := range events[1:] {
+		require.Equal(t, "rpc:127.0.0.1:1234", event.Actor)
+	}
+	require.Equal(
+		t, "label changed from '' to 'renamed'", events[3].Details,
+	)
+}
+
+// TestCreditRuleSettlement tests that settled invoices that aren't associated
+// with an account credit the account of the matching credit rule.
+func TestCreditRuleSettlement(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	acct, err := service.NewAccount(ctx, 0, time.Time{}, "regular")
+	require.NoError(t, err)
+	vip, err := service.NewAccount(ctx, 0, time.Time{}, "vip")
+	require.NoError(t, err)
+
+	_, err = service.AddCreditRule(ctx, "", acct.ID)
+	require.ErrorContains(t, err, "must not be empty")
+
+	_, err = service.AddCreditRule(ctx, "topup-", acct.ID)
+	require.NoError(t, err)
+	_, err = service.AddCreditRule(ctx, "topup-vip-", vip.ID)
+	require.NoError(t, err)
+
+	assertBalance := func(id AccountID, balance int64) {
+		t.Helper()
+
+		assertEventually(t, func() bool {
+			acct, err := service.Account(ctx, id)
+			require.NoError(t, err)
+
+			return acct.CurrentBalance == balance
+		})
+	}
+
+	lndMock.assertInvoiceRequest(t, 0, 0)
+	settle := func(index uint64, memo string, amt lnwire.MilliSatoshi,
+		settleDate time.Time) {
+
+		lndMock.invoiceChan <- &lndclient.Invoice{
+			AddIndex:    index,
+			SettleIndex: index,
+			Hash:        lntypes.Hash{byte(index)},
+			Memo:        memo,
+			AmountPaid:  amt,
+			State:       invpkg.ContractSettled,
+			SettleDate:  settleDate,
+		}
+	}
+
+	// Invoices that don't match a rule or were settled before the rule was
+	// added aren't credited. The longest matching prefix wins.
+	settle(1, "unrelated", 100, time.Now())
+	settle(2, "topup-old", 200, time.Now().Add(-time.Hour))
+	settle(3, "topup-vip-1", 300, time.Now())
+	assertBalance(vip.ID, 300)
+	assertBalance(acct.ID, 0)
+
+	settle(4, "topup-2", 400, time.Now())
+	assertBalance(acct.ID, 400)
+
+	events, err := service.AccountHistory(ctx, acct.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, AccountEventBalanceUpdated, events[1].Type)
+	require.Contains(t, events[1].Details, "credit rule 'topup-'")
+}
+
+// TestDepositInvoiceSettlement tests that settled invoices that are tagged with
+// the ID of an account are credited to that account.
+func TestDepositInvoiceSettlement(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const recordType = record.CustomTypeStart + 1
+
+	require.ErrorContains(
+		t, DepositTag{}.Validate(), "must not be empty",
+	)
+	require.ErrorContains(
+		t, DepositTag{MemoPrefix: "x", RecordType: 1}.Validate(),
+		"must be at least",
+	)
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc, WithDepositTag(DepositTag{
+		MemoPrefix: DefaultDepositMemoPrefix,
+		RecordType: recordType,
+	}))
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	acct, err := service.NewAccount(ctx, 0, time.Time{}, "")
+	require.NoError(t, err)
+	other, err := service.NewAccount(ctx, 0, time.Time{}, "")
+	require.NoError(t, err)
+
+	assertBalance := func(id AccountID, balance int64) {
+		t.Helper()
+
+		assertEventually(t, func() bool {
+			acct, err := service.Account(ctx, id)
+			require.NoError(t, err)
+
+			return acct.CurrentBalance == balance
+		})
+	}
+
+	lndMock.assertInvoiceRequest(t, 0, 0)
+	settle := func(index uint64, memo string, amt lnwire.MilliSatoshi,
+		records map[uint64][]byte) {
+
+		lndMock.invoiceChan <- &lndclient.Invoice{
+			AddIndex:    index,
+			SettleIndex: index,
+			Hash:        lntypes.Hash{byte(index)},
+			Memo:        memo,
+			AmountPaid:  amt,
+			State:       invpkg.ContractSettled,
+			Htlcs: []lndclient.InvoiceHtlc{{
+				Amount:        amt,
+				State:         lnrpc.InvoiceHTLCState_SETTLED,
+				CustomRecords: records,
+			}},
+		}
+	}
+	memo := func(id AccountID, suffix string) string {
+		return DefaultDepositMemoPrefix + hex.EncodeToString(id[:]) +
+			suffix
+	}
+
+	// Invoices without a valid tag or tagged with an unknown account
+	// aren't credited and don't disable the service.
+	settle(1, DefaultDepositMemoPrefix+"invalid", 100, nil)
+	settle(2, memo(AccountID{1, 2, 3}, ""), 200, nil)
+	settle(3, memo(acct.ID, " thanks"), 300, nil)
+	assertBalance(acct.ID, 300)
+	require.True(t, service.IsRunning())
+
+	// The custom record takes precedence over the memo.
+	settle(4, memo(acct.ID, ""), 400, map[uint64][]byte{
+		recordType: other.ID[:],
+	})
+	assertBalance(other.ID, 400)
+	assertBalance(acct.ID, 300)
+
+	events, err := service.AccountHistory(ctx, acct.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, AccountEventBalanceUpdated, events[1].Type)
+	require.Contains(t, events[1].Details, "deposit invoice")
+}
+
+// TestUpdateAccountVersion tests that updates based on a stale version of an
+// account are rejected.
+func TestUpdateAccountVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	acct, err := service.NewAccount(ctx, 1000, time.Time{}, "")
+	require.NoError(t, err)
+
+	update := func(balance btcutil.Amount,
+		version fn.Option[uint64]) (*OffChainBalanceAccount, error) {
+
+		return service.UpdateAccount(
+			ctx, acct.ID, &AccountUpdate{
+				Balance: fn.Some(int64(balance) * 1000),
+			}, version,
+		)
+	}
+
+	// Two clients read the same version, only the first update succeeds.
+	updated, err := update(2, fn.Some(acct.Version))
+	require.NoError(t, err)
+	require.Greater(t, updated.Version, acct.Version)
+
+	_, err = update(3, fn.Some(acct.Version))
+	require.ErrorIs(t, err, ErrAccountVersionConflict)
+
+	// The rejected update must not have changed the account.
+	current, err := service.Account(ctx, acct.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 2000, current.CurrentBalance)
+	require.Equal(t, updated.Version, current.Version)
+
+	// Updates based on the current version or without a version succeed.
+	_, err = update(3, fn.Some(current.Version))
+	require.NoError(t, err)
+
+	_, err = update(4, fn.None[uint64]())
+	require.NoError(t, err)
+}
+
+// TestServiceSweepAndRemoveAccount tests that sweeping an account records the
+// sweep in the history of both accounts and that accounts with in-flight
+// payments can't be swept.
+func TestServiceSweepAndRemoveAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	source, err := service.NewAccount(ctx, 1500, time.Time{}, "source")
+	require.NoError(t, err)
+	target, err := service.NewAccount(ctx, 500, time.Time{}, "target")
+	require.NoError(t, err)
+
+	// An in-flight payment might still be debited from the account, so
+	// the account can't be swept while it's tracked.
+	service.pendingPayments[testHash] = &trackedPayment{
+		accountID: source.ID,
+		hash:      testHash,
+	}
+	_, err = service.SweepAndRemoveAccount(ctx, source.ID, target.ID)
+	require.ErrorContains(t, err, "in-flight payment")
+	delete(service.pendingPayments, testHash)
+
+	swept, err := service.SweepAndRemoveAccount(ctx, source.ID, target.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1500, swept)
+
+	sourceHistory, err := service.AccountHistory(ctx, source.ID)
+	require.NoError(t, err)
+	last := sourceHistory[len(sourceHistory)-1]
+	require.Equal(t, AccountEventRemoved, last.Type)
+	require.Contains(t, last.Details, target.ID.String())
+
+	targetHistory, err := service.AccountHistory(ctx, target.ID)
+	require.NoError(t, err)
+	last = targetHistory[len(targetHistory)-1]
+	require.Equal(t, AccountEventBalanceUpdated, last.Type)
+	require.Contains(t, last.Details, source.ID.String())
+}
+
+// TestServiceTransferAccountBalance tests that a transfer is recorded in the
+// history of both accounts and that the balance reserved for in-flight
+// payments can't be transferred.
+func TestServiceTransferAccountBalance(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	source, err := service.NewAccount(ctx, 1500, time.Time{}, "source")
+	require.NoError(t, err)
+	dest, err := service.NewAccount(ctx, 500, time.Time{}, "dest")
+	require.NoError(t, err)
+
+	_, _, err = service.TransferAccountBalance(ctx, source.ID, dest.ID, 0)
+	require.Error(t, err)
+
+	// An in-flight payment might still be debited from the source, so its
+	// amount can't be transferred away.
+	err = service.AssociatePayment(ctx, source.ID, testHash, 1000)
+	require.NoError(t, err)
+
+	_, _, err = service.TransferAccountBalance(
+		ctx, source.ID, dest.ID, 501,
+	)
+	require.ErrorIs(t, err, ErrAccBalanceInsufficient)
+
+	dbSource, dbDest, err := service.TransferAccountBalance(
+		ctx, source.ID, dest.ID, 500,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, dbSource.CurrentBalance)
+	require.EqualValues(t, 1000, dbDest.CurrentBalance)
+
+	sourceHistory, err := service.AccountHistory(ctx, source.ID)
+	require.NoError(t, err)
+	last := sourceHistory[len(sourceHistory)-1]
+	require.Equal(t, AccountEventBalanceUpdated, last.Type)
+	require.Contains(t, last.Details, dest.ID.String())
+
+	destHistory, err := service.AccountHistory(ctx, dest.ID)
+	require.NoError(t, err)
+	last = destHistory[len(destHistory)-1]
+	require.Equal(t, AccountEventBalanceUpdated, last.Type)
+	require.Contains(t, last.Details, source.ID.String())
+}
+
+// TestServiceImportAccounts tests that an import is validated as a whole
+// before any account is imported, and that imported accounts are recorded in
+// their history.
+func TestServiceImportAccounts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+	service, err := NewService(store, errFunc)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	existing, err := service.NewAccount(ctx, 1000, time.Time{}, "existing")
+	require.NoError(t, err)
+
+	fresh := &OffChainBalanceAccount{
+		ID:             AccountID{9, 8, 7, 6, 5, 4, 3, 2},
+		Type:           TypeInitialBalance,
+		InitialBalance: 2000,
+		CurrentBalance: 2000,
+		Label:          "fresh",
+	}
+	overwrite := importableAccount(existing)
+	overwrite.CurrentBalance = 4000
+
+	// The existing account makes the whole import fail, so the new
+	// account isn't imported either.
+	_, err = service.ImportAccounts(
+		ctx, []*OffChainBalanceAccount{fresh, overwrite}, false,
+	)
+	require.ErrorIs(t, err, ErrAccountAlreadyExists)
+	_, err = service.Account(ctx, fresh.ID)
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	// Accounts and labels may not repeat within an import.
+	_, err = service.ImportAccounts(
+		ctx, []*OffChainBalanceAccount{fresh, fresh}, true,
+	)
+	require.Error(t, err)
+
+	imported, err := service.ImportAccounts(
+		ctx, []*OffChainBalanceAccount{fresh, overwrite}, true,
+	)
+	require.NoError(t, err)
+	require.Len(t, imported, 2)
+	require.EqualValues(t, 2000, imported[0].CurrentBalance)
+	require.EqualValues(t, 4000, imported[1].CurrentBalance)
+
+	history, err := service.AccountHistory(ctx, existing.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountEventImported, history[len(history)-1].Type)
+}
@@ -0,0 +1,161 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// SpendRateLimit caps the amount an account may spend on payments within a
+// rolling time window.
+type SpendRateLimit struct {
+	// MaxAmount is the amount in millisatoshis, including routing fees,
+	// that the account may spend on payments within any window.
+	MaxAmount lnwire.MilliSatoshi
+
+	// Window is the length of the rolling window.
+	Window time.Duration
+}
+
+// IsEnabled returns true if the limit restricts payments of the account.
+func (l SpendRateLimit) IsEnabled() bool {
+	return l.MaxAmount > 0
+}
+
+// Validate checks that the limit is either disabled or sets both a maximum
+// amount and a window of at least one second.
+func (l SpendRateLimit) Validate() error {
+	switch {
+	case l.MaxAmount == 0 && l.Window == 0:
+		return nil
+
+	case l.MaxAmount == 0:
+		return fmt.Errorf("a spend rate limit requires a maximum " +
+			"amount")
+
+	case l.Window < time.Second:
+		return fmt.Errorf("a spend rate limit requires a window of " +
+			"at least one second")
+	}
+
+	return nil
+}
+
+// describeSpendRateLimit returns a description of the given spend rate limit
+// for the account history.
+func describeSpendRateLimit(limit SpendRateLimit) string {
+	if !limit.IsEnabled() {
+		return "spend rate limit removed"
+	}
+
+	return fmt.Sprintf("spend rate limit set to %v per %v", limit.MaxAmount,
+		limit.Window)
+}
+
+// windowSpend returns the amount the account spent on payments within the
+// rolling window of its spend rate limit that ends at the given time. Settled
+// payments are taken from the ledger of the account, and in-flight payments are
+// counted with their full amount, as they might still settle. The payment with
+// the given hash is skipped, so that a retried payment isn't counted twice.
+func windowSpend(acct *OffChainBalanceAccount, ledger []*LedgerEntry,
+	now time.Time, skip lntypes.Hash) lnwire.MilliSatoshi {
+
+	start := now.Add(-acct.SpendRateLimit.Window)
+
+	var spent lnwire.MilliSatoshi
+	for _, entry := range ledger {
+		if entry.Type != LedgerEntryPayment ||
+			!entry.Timestamp.After(start) ||
+			entry.Reference == skip {
+
+			continue
+		}
+
+		spent += lnwire.MilliSatoshi(-entry.Delta)
+	}
+
+	for hash, payment := range acct.Payments {
+		if hash == skip || !inflightState(payment.Status) {
+			continue
+		}
+
+		spent += payment.FullAmount
+	}
+
+	return spent
+}
+
+// CheckSpendRate makes sure that a payment with the given hash and amount,
+// including the maximum routing fee, doesn't take the spend of the account
+// within the window of its spend rate limit over the limit. If it does,
+// ErrSpendRateLimitExceeded is returned. Accounts without a spend rate limit
+// are never limited.
+func (s *InterceptorService) CheckSpendRate(ctx context.Context, id AccountID,
+	hash lntypes.Hash, amt lnwire.MilliSatoshi) error {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	acct, err := s.store.Account(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if !acct.SpendRateLimit.IsEnabled() {
+		return nil
+	}
+
+	ledger, err := s.store.AccountLedger(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	spent := windowSpend(acct, ledger, time.Now(), hash)
+	if spent+amt <= acct.SpendRateLimit.MaxAmount {
+		return nil
+	}
+
+	var remaining lnwire.MilliSatoshi
+	if spent < acct.SpendRateLimit.MaxAmount {
+		remaining = acct.SpendRateLimit.MaxAmount - spent
+	}
+
+	return fmt.Errorf("%w: payment amount %v is larger than the remaining "+
+		"allowance of %v (%v per %v)", ErrSpendRateLimitExceeded, amt,
+		remaining, acct.SpendRateLimit.MaxAmount,
+		acct.SpendRateLimit.Window)
+}
+
+// SpendRateAllowance returns the amount the account with the given ID can
+// currently still spend within the window of its spend rate limit. The second
+// return value is false if the account has no spend rate limit.
+func (s *InterceptorService) SpendRateAllowance(ctx context.Context,
+	id AccountID) (lnwire.MilliSatoshi, bool, error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	acct, err := s.store.Account(ctx, id)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if !acct.SpendRateLimit.IsEnabled() {
+		return 0, false, nil
+	}
+
+	ledger, err := s.store.AccountLedger(ctx, id)
+	if err != nil {
+		return 0, false, err
+	}
+
+	spent := windowSpend(acct, ledger, time.Now(), lntypes.Hash{})
+	if spent >= acct.SpendRateLimit.MaxAmount {
+		return 0, true, nil
+	}
+
+	return acct.SpendRateLimit.MaxAmount - spent, true, nil
+}
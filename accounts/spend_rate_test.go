@@ -0,0 +1,104 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpendRateLimitValidate tests that a spend rate limit must set both a
+// maximum amount and a window of at least one second or neither.
+func TestSpendRateLimitValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, SpendRateLimit{}.Validate())
+	require.NoError(t, SpendRateLimit{
+		MaxAmount: 1000, Window: time.Second,
+	}.Validate())
+	require.Error(t, SpendRateLimit{MaxAmount: 1000}.Validate())
+	require.Error(t, SpendRateLimit{
+		MaxAmount: 1000, Window: time.Millisecond,
+	}.Validate())
+	require.Error(t, SpendRateLimit{Window: time.Hour}.Validate())
+}
+
+// TestCheckSpendRate tests that payments are rejected once the settled and
+// in-flight payments of an account within the rolling window of its spend rate
+// limit reach the limit, and that settled payments stop counting once they
+// left the window.
+func TestCheckSpendRate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Now()
+	testClock := clock.NewTestClock(now.Add(-2 * time.Hour))
+	store := NewTestDB(t, testClock)
+
+	service, err := NewService(store, func(error) {})
+	require.NoError(t, err)
+
+	acct, err := service.NewAccount(
+		ctx, 100_000, time.Time{}, "rate", WithSpendRateLimit(
+			SpendRateLimit{MaxAmount: 5000, Window: time.Hour},
+		),
+	)
+	require.NoError(t, err)
+
+	assertAllowance := func(expected int64) {
+		t.Helper()
+
+		remaining, limited, err := service.SpendRateAllowance(
+			ctx, acct.ID,
+		)
+		require.NoError(t, err)
+		require.True(t, limited)
+		require.EqualValues(t, expected, remaining)
+	}
+
+	// A payment that settled before the window doesn't count.
+	_, err = store.UpsertAccountPayment(
+		ctx, acct.ID, testHash, 4000, lnrpc.Payment_SUCCEEDED,
+		WithDebitAccount(),
+	)
+	require.NoError(t, err)
+	assertAllowance(5000)
+
+	// A payment that settled within the window and one that is still
+	// in flight both count.
+	testClock.SetTime(now.Add(-10 * time.Minute))
+	_, err = store.UpsertAccountPayment(
+		ctx, acct.ID, testHash2, 2000, lnrpc.Payment_SUCCEEDED,
+		WithDebitAccount(),
+	)
+	require.NoError(t, err)
+	err = service.AssociatePayment(ctx, acct.ID, testHash3, 1000)
+	require.NoError(t, err)
+	assertAllowance(2000)
+
+	err = service.CheckSpendRate(ctx, acct.ID, lntypes.Hash{4}, 2000)
+	require.NoError(t, err)
+
+	err = service.CheckSpendRate(ctx, acct.ID, lntypes.Hash{4}, 2001)
+	require.ErrorIs(t, err, ErrSpendRateLimitExceeded)
+	require.NotErrorIs(t, err, ErrAccBalanceInsufficient)
+
+	// Retrying the in-flight payment doesn't count it twice.
+	err = service.CheckSpendRate(ctx, acct.ID, testHash3, 3000)
+	require.NoError(t, err)
+
+	// Accounts without a limit are never limited.
+	unlimited, err := service.NewAccount(ctx, 100_000, time.Time{}, "")
+	require.NoError(t, err)
+
+	err = service.CheckSpendRate(ctx, unlimited.ID, testHash, 50_000)
+	require.NoError(t, err)
+
+	_, limited, err := service.SpendRateAllowance(ctx, unlimited.ID)
+	require.NoError(t, err)
+	require.False(t, limited)
+}
@@ -5,10 +5,11 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/binary"
-	"encoding/hex"
+	"errors"
 	"fmt"
 	"math"
 	"os"
+	"slices"
 	"time"
 
 	"github.com/btcsuite/btcwallet/walletdb"
@@ -18,6 +19,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"go.etcd.io/bbolt"
 )
 
@@ -43,6 +45,21 @@ var (
 	// based balances are stored.
 	accountBucketName = []byte("accounts")
 
+	// accountEventsBucketName is the name of the bucket that holds a
+	// sub-bucket with the lifecycle events of each account, keyed by the
+	// account ID. It is separate from the accounts bucket so that the
+	// history of an account outlives the account itself.
+	accountEventsBucketName = []byte("account-events")
+
+	// accountLedgerBucketName is the name of the bucket that holds a
+	// sub-bucket with the ledger entries of each account, keyed by the
+	// account ID. Like the events, the ledger outlives the account.
+	accountLedgerBucketName = []byte("account-ledger")
+
+	// creditRulesBucketName is the name of the bucket that holds the
+	// credit rules, keyed by their memo prefix.
+	creditRulesBucketName = []byte("account-credit-rules")
+
 	// lastAddIndexKey is the name of the key under which we store the last
 	// known invoice add index.
 	lastAddIndexKey = []byte("last-add-index")
@@ -58,15 +75,80 @@ var (
 	zeroID = AccountID{}
 )
 
+// CommitPolicy determines how the write transactions of a BoltStore are
+// committed to disk.
+type CommitPolicy string
+
+const (
+	// CommitPolicySync commits every write transaction on its own and
+	// waits for the data to be synced to disk before returning. This is
+	// the default.
+	CommitPolicySync CommitPolicy = "sync"
+
+	// CommitPolicyBatch coalesces write transactions that happen
+	// concurrently into a single commit, so that they only need a single
+	// sync to disk. This increases the write throughput under heavy,
+	// concurrent credit and debit traffic at the cost of a higher latency
+	// for each individual write, since a write may wait for other writes
+	// to join its batch. A write still only returns after its batch was
+	// synced to disk. If any write in a batch fails, the whole batch is
+	// rolled back and its writes are retried one by one.
+	CommitPolicyBatch CommitPolicy = "batch"
+)
+
+// boltStoreOptions holds the optional parameters of a BoltStore.
+type boltStoreOptions struct {
+	commitPolicy   CommitPolicy
+	noFreelistSync bool
+}
+
+// BoltStoreOption is a functional option that can be passed to NewBoltStore.
+type BoltStoreOption func(*boltStoreOptions)
+
+// WithCommitPolicy sets the policy that is used to commit write transactions
+// to disk.
+func WithCommitPolicy(policy CommitPolicy) BoltStoreOption {
+	return func(o *boltStoreOptions) {
+		o.commitPolicy = policy
+	}
+}
+
+// WithNoFreelistSync prevents the database from syncing its freelist to disk
+// on every commit. This makes commits faster, but the freelist then needs to be
+// rebuilt by scanning the whole database file when it is opened, which
+// increases the startup time for large databases.
+func WithNoFreelistSync() BoltStoreOption {
+	return func(o *boltStoreOptions) {
+		o.noFreelistSync = true
+	}
+}
+
 // BoltStore wraps the bolt DB that stores all accounts and their balances.
 type BoltStore struct {
-	db    kvdb.Backend
-	clock clock.Clock
+	db           kvdb.Backend
+	clock        clock.Clock
+	commitPolicy CommitPolicy
 }
 
 // NewBoltStore creates a BoltStore instance and the corresponding bucket in the
 // bolt DB if it does not exist yet.
-func NewBoltStore(dir, fileName string, clock clock.Clock) (*BoltStore, error) {
+func NewBoltStore(dir, fileName string, clock clock.Clock,
+	opts ...BoltStoreOption) (*BoltStore, error) {
+
+	options := &boltStoreOptions{
+		commitPolicy: CommitPolicySync,
+	}
+	for _, o := range opts {
+		o(options)
+	}
+
+	switch options.commitPolicy {
+	case CommitPolicySync, CommitPolicyBatch:
+	default:
+		return nil, fmt.Errorf("unknown commit policy %q",
+			options.commitPolicy)
+	}
+
 	// Ensure that the path to the directory exists.
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
 		if err := os.MkdirAll(dir, dbPathPermission); err != nil {
@@ -77,9 +159,10 @@ func NewBoltStore(dir, fileName string, clock clock.Clock) (*BoltStore, error) {
 	// Open the database that we'll use to store the primary macaroon key,
 	// and all generated macaroons+caveats.
 	db, err := kvdb.GetBoltBackend(&kvdb.BoltBackendConfig{
-		DBPath:     dir,
-		DBFileName: fileName,
-		DBTimeout:  DefaultAccountDBTimeout,
+		DBPath:         dir,
+		DBFileName:     fileName,
+		DBTimeout:      DefaultAccountDBTimeout,
+		NoFreelistSync: options.noFreelistSync,
 	})
 	if err == bbolt.ErrTimeout {
 		return nil, fmt.Errorf("error while trying to open %s/%s: "+
@@ -90,22 +173,63 @@ func NewBoltStore(dir, fileName string, clock clock.Clock) (*BoltStore, error) {
 		return nil, err
 	}
 
-	// If the store's bucket doesn't exist, create it.
+	// If the store's buckets don't exist, create them.
 	err = db.Update(func(tx kvdb.RwTx) error {
 		_, err := tx.CreateTopLevelBucket(accountBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(accountEventsBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(accountLedgerBucketName)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateTopLevelBucket(creditRulesBucketName)
 		return err
 	}, func() {})
 	if err != nil {
 		return nil, err
 	}
 
+	registerMetrics()
+
 	// Return the DB wrapped in a BoltStore object.
 	return &BoltStore{
-		db:    db,
-		clock: clock,
+		db:           db,
+		clock:        clock,
+		commitPolicy: options.commitPolicy,
 	}, nil
 }
 
+// update runs the given function in a write transaction that is committed
+// according to the commit policy of the store. The time it takes to commit
+// the transaction is recorded in the commit latency metric.
+func (s *BoltStore) update(f func(tx kvdb.RwTx) error, reset func()) error {
+	start := time.Now()
+	defer func() {
+		observeCommitLatency(s.commitPolicy, time.Since(start))
+	}()
+
+	if s.commitPolicy == CommitPolicyBatch {
+		// A batched transaction may be retried on its own if another
+		// transaction of the same batch fails, so we need to reset any
+		// external state before every attempt.
+		return kvdb.Batch(s.db, func(tx kvdb.RwTx) error {
+			reset()
+
+			return f(tx)
+		})
+	}
+
+	return s.db.Update(f, reset)
+}
+
 // Close closes the underlying bolt DB.
 //
 // NOTE: This is part of the Store interface.
@@ -114,37 +238,27 @@ func (s *BoltStore) Close() error {
 }
 
 // NewAccount creates a new OffChainBalanceAccount with the given balance and a
-// randomly chosen ID.
+// randomly chosen ID, unless the WithAccountID option is used.
 //
 // NOTE: This is part of the Store interface.
 func (s *BoltStore) NewAccount(ctx context.Context, balance lnwire.MilliSatoshi,
-	expirationDate time.Time, label string) (*OffChainBalanceAccount,
-	error) {
+	expirationDate time.Time, label string,
+	opts ...NewAccountOption) (*OffChainBalanceAccount, error) {
+
+	options := newAccountOptionsFromOpts(opts)
 
 	// If a label is set, it must be unique, as we use it to identify the
 	// account in some of the RPCs. It also can't be mistaken for a hex
 	// encoded account ID to avoid confusion and make it easier for the CLI
 	// to distinguish between the two.
 	if len(label) > 0 {
-		if _, err := hex.DecodeString(label); err == nil &&
-			len(label) == hex.EncodedLen(AccountIDLen) {
-
-			return nil, fmt.Errorf("the label '%s' is not allowed "+
-				"as it can be mistaken for an account ID",
-				label)
+		if err := validateLabel(label); err != nil {
+			return nil, err
 		}
 
-		accounts, err := s.Accounts(ctx)
+		err := s.checkLabelUnique(ctx, label, nil)
 		if err != nil {
-			return nil, fmt.Errorf("error checking label "+
-				"uniqueness: %w", err)
-		}
-		for _, account := range accounts {
-			if account.Label == label {
-				return nil, fmt.Errorf("an account with the "+
-					"label '%s' already exists: %w", label,
-					ErrLabelAlreadyExists)
-			}
+			return nil, err
 		}
 	}
 
@@ -158,24 +272,55 @@ func (s *BoltStore) NewAccount(ctx context.Context, balance lnwire.MilliSatoshi,
 		Invoices:       make(AccountInvoices),
 		Payments:       make(AccountPayments),
 		Label:          label,
+		MaxHTLC:        options.maxHTLC,
+		SoftCap:        options.softCap,
+		Sandbox:        options.sandbox,
+		KeysendBudget:  options.keysendBudget,
+		NodeID:         options.nodeID,
+
+		AmountlessInvoiceMax: options.amountlessInvoiceMax,
+		TopUp:                options.topUp,
+		SpendRateLimit:       options.spendRateLimit,
+		AllowedDestinations:  options.allowedDestinations,
+		MaxOpenInvoices:      options.maxOpenInvoices,
+		MaxInvoiceAmount:     options.maxInvoiceAmount,
+		MaxPayment:           options.maxPayment,
+		CreatedAt:            s.clock.Now(),
 	}
 
 	// Try storing the account in the account database, so we can keep track
 	// of its balance.
-	err := s.db.Update(func(tx walletdb.ReadWriteTx) error {
+	err := s.update(func(tx walletdb.ReadWriteTx) error {
 		bucket := tx.ReadWriteBucket(accountBucketName)
 		if bucket == nil {
 			return ErrAccountBucketNotFound
 		}
 
-		id, err := uniqueRandomAccountID(bucket)
-		if err != nil {
-			return fmt.Errorf("error creating random account ID: "+
-				"%w", err)
+		id := options.id
+		switch {
+		case id == zeroID:
+			var err error
+			id, err = uniqueRandomAccountID(bucket)
+			if err != nil {
+				return fmt.Errorf("error creating random "+
+					"account ID: %w", err)
+			}
+
+		case bucket.Get(id[:]) != nil:
+			return ErrAccountAlreadyExists
 		}
 
 		account.ID = id
-		return s.storeAccount(bucket, account)
+		if err := s.storeAccount(bucket, account); err != nil {
+			return err
+		}
+
+		return addLedgerEntry(tx, id, &LedgerEntry{
+			Type:      LedgerEntryOpening,
+			Timestamp: account.LastUpdate,
+			Delta:     account.CurrentBalance,
+			Balance:   account.CurrentBalance,
+		})
 	}, func() {
 		account.ID = zeroID
 	})
@@ -190,17 +335,86 @@ func (s *BoltStore) NewAccount(ctx context.Context, balance lnwire.MilliSatoshi,
 // account.
 //
 // NOTE: This is part of the Store interface.
-func (s *BoltStore) UpdateAccountBalanceAndExpiry(_ context.Context,
+func (s *BoltStore) UpdateAccountBalanceAndExpiry(ctx context.Context,
 	id AccountID, newBalance fn.Option[int64],
 	newExpiry fn.Option[time.Time]) error {
 
-	update := func(account *OffChainBalanceAccount) error {
-		newBalance.WhenSome(func(balance int64) {
+	return s.UpdateAccount(ctx, id, &AccountUpdate{
+		Balance: newBalance,
+		Expiry:  newExpiry,
+	})
+}
+
+// UpdateAccount applies all changes of the given update to the account with
+// the given ID in a single transaction.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) UpdateAccount(_ context.Context, id AccountID,
+	update *AccountUpdate) error {
+
+	updateFn := func(account *OffChainBalanceAccount) error {
+		update.Balance.WhenSome(func(balance int64) {
 			account.CurrentBalance = balance
 		})
-		newExpiry.WhenSome(func(expiry time.Time) {
+		update.Expiry.WhenSome(func(expiry time.Time) {
 			account.ExpirationDate = expiry
 		})
+		update.MaxHTLC.WhenSome(func(amt lnwire.MilliSatoshi) {
+			account.MaxHTLC = amt
+		})
+		update.SoftCap.WhenSome(func(amt lnwire.MilliSatoshi) {
+			account.SoftCap = amt
+		})
+		update.TopUp.WhenSome(func(policy TopUpPolicy) {
+			account.TopUp = policy
+		})
+		update.SpendRateLimit.WhenSome(func(limit SpendRateLimit) {
+			account.SpendRateLimit = limit
+		})
+		update.ExpiryWarning.WhenSome(func(warning time.Duration) {
+			account.ExpiryWarning = warning
+		})
+		update.AllowedDestinations.WhenSome(func(dests []route.Vertex) {
+			account.AllowedDestinations = normalizeDestinations(
+				dests,
+			)
+		})
+		update.MaxOpenInvoices.WhenSome(func(maxOpen uint32) {
+			account.MaxOpenInvoices = maxOpen
+		})
+		update.MaxInvoiceAmount.WhenSome(func(amt lnwire.MilliSatoshi) {
+			account.MaxInvoiceAmount = amt
+		})
+		update.MaxPayment.WhenSome(func(amt lnwire.MilliSatoshi) {
+			account.MaxPayment = amt
+		})
+
+		return nil
+	}
+
+	return s.updateAccountBalance(
+		id, &LedgerEntry{Type: LedgerEntryBalanceSet}, updateFn,
+	)
+}
+
+// UpdateAccountLabel changes the label of the account with the given ID.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) UpdateAccountLabel(ctx context.Context, id AccountID,
+	label string) error {
+
+	if len(label) == 0 {
+		return fmt.Errorf("label must not be empty")
+	}
+	if err := validateLabel(label); err != nil {
+		return err
+	}
+	if err := s.checkLabelUnique(ctx, label, &id); err != nil {
+		return err
+	}
+
+	update := func(account *OffChainBalanceAccount) error {
+		account.Label = label
 
 		return nil
 	}
@@ -208,6 +422,30 @@ func (s *BoltStore) UpdateAccountBalanceAndExpiry(_ context.Context,
 	return s.updateAccount(id, update)
 }
 
+// checkLabelUnique returns ErrLabelAlreadyExists if an account other than the
+// one with the given ID already uses the given label.
+func (s *BoltStore) checkLabelUnique(ctx context.Context, label string,
+	exclude *AccountID) error {
+
+	accounts, err := s.Accounts(ctx)
+	if err != nil {
+		return fmt.Errorf("error checking label uniqueness: %w", err)
+	}
+	for _, account := range accounts {
+		if exclude != nil && account.ID == *exclude {
+			continue
+		}
+
+		if account.Label == label {
+			return fmt.Errorf("an account with the label '%s' "+
+				"already exists: %w", label,
+				ErrLabelAlreadyExists)
+		}
+	}
+
+	return nil
+}
+
 // AddAccountInvoice adds an invoice hash to the account with the given ID.
 //
 // NOTE: This is part of the Store interface.
@@ -215,7 +453,34 @@ func (s *BoltStore) AddAccountInvoice(_ context.Context, id AccountID,
 	hash lntypes.Hash) error {
 
 	update := func(account *OffChainBalanceAccount) error {
+		if _, ok := account.Invoices[hash]; ok {
+			return nil
+		}
+
 		account.Invoices[hash] = struct{}{}
+		account.OpenInvoices++
+
+		return nil
+	}
+
+	return s.updateAccount(id, update)
+}
+
+// CloseAccountInvoice decreases the number of open invoices of the account
+// with the given ID if the invoice belongs to it.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) CloseAccountInvoice(_ context.Context, id AccountID,
+	hash lntypes.Hash) error {
+
+	update := func(account *OffChainBalanceAccount) error {
+		if _, ok := account.Invoices[hash]; !ok {
+			return nil
+		}
+
+		if account.OpenInvoices > 0 {
+			account.OpenInvoices--
+		}
 
 		return nil
 	}
@@ -228,7 +493,9 @@ func (s *BoltStore) AddAccountInvoice(_ context.Context, id AccountID,
 //
 // NOTE: This is part of the Store interface.
 func (s *BoltStore) CreditAccount(_ context.Context, id AccountID,
-	amount lnwire.MilliSatoshi) error {
+	amount lnwire.MilliSatoshi, opts ...CreditOption) error {
+
+	options := newCreditOptions(opts)
 
 	update := func(account *OffChainBalanceAccount) error {
 		if amount > math.MaxInt64 {
@@ -241,7 +508,10 @@ func (s *BoltStore) CreditAccount(_ context.Context, id AccountID,
 		return nil
 	}
 
-	return s.updateAccount(id, update)
+	return s.updateAccountBalance(id, &LedgerEntry{
+		Type:      options.entryType,
+		Reference: options.reference,
+	}, update)
 }
 
 // DebitAccount decreases the balance of the account with the given ID
@@ -268,7 +538,9 @@ func (s *BoltStore) DebitAccount(_ context.Context, id AccountID,
 		return nil
 	}
 
-	return s.updateAccount(id, update)
+	return s.updateAccountBalance(
+		id, &LedgerEntry{Type: LedgerEntryDebit}, update,
+	)
 }
 
 // UpsertAccountPayment updates or inserts a payment entry for the given
@@ -319,10 +591,19 @@ func (s *BoltStore) UpsertAccountPayment(_ context.Context, id AccountID,
 			return ErrPaymentNotAssociated
 		}
 
-		account.Payments[paymentHash] = &PaymentEntry{
+		newEntry := &PaymentEntry{
 			Status:     status,
 			FullAmount: fullAmount,
 		}
+		if known {
+			newEntry.Fee = entry.Fee
+			newEntry.Destination = entry.Destination
+		}
+		newEntry.Fee = opts.fee.UnwrapOr(newEntry.Fee)
+		newEntry.Destination = opts.destination.UnwrapOr(
+			newEntry.Destination,
+		)
+		account.Payments[paymentHash] = newEntry
 
 		if opts.debitAccount {
 			account.CurrentBalance -= int64(fullAmount)
@@ -331,7 +612,12 @@ func (s *BoltStore) UpsertAccountPayment(_ context.Context, id AccountID,
 		return nil
 	}
 
-	return known, s.updateAccount(id, update)
+	entry := &LedgerEntry{
+		Type:      LedgerEntryPayment,
+		Reference: paymentHash,
+	}
+
+	return known, s.updateAccountBalance(id, entry, update)
 }
 
 // DeleteAccountPayment removes a payment entry from the account with the given
@@ -364,7 +650,17 @@ func (s *BoltStore) DeleteAccountPayment(_ context.Context, id AccountID,
 func (s *BoltStore) updateAccount(id AccountID,
 	updateFn func(*OffChainBalanceAccount) error) error {
 
-	return s.db.Update(func(tx kvdb.RwTx) error {
+	return s.updateAccountBalance(id, nil, updateFn)
+}
+
+// updateAccountBalance updates the account like updateAccount does. If the
+// update changes the balance of the account and a ledger entry is given, the
+// entry is completed with the change and the resulting balance and appended to
+// the ledger of the account in the same transaction.
+func (s *BoltStore) updateAccountBalance(id AccountID, entry *LedgerEntry,
+	updateFn func(*OffChainBalanceAccount) error) error {
+
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(accountBucketName)
 		if bucket == nil {
 			return ErrAccountBucketNotFound
@@ -375,6 +671,7 @@ func (s *BoltStore) updateAccount(id AccountID,
 			return fmt.Errorf("error fetching account, %w", err)
 		}
 
+		oldBalance := account.CurrentBalance
 		err = updateFn(account)
 		if err != nil {
 			return fmt.Errorf("error updating account, %w", err)
@@ -385,16 +682,56 @@ func (s *BoltStore) updateAccount(id AccountID,
 			return fmt.Errorf("error storing account, %w", err)
 		}
 
-		return nil
+		if entry == nil || account.CurrentBalance == oldBalance {
+			return nil
+		}
+
+		entry.Timestamp = account.LastUpdate
+		entry.Delta = account.CurrentBalance - oldBalance
+		entry.Balance = account.CurrentBalance
+
+		return addLedgerEntry(tx, id, entry)
 	}, func() {})
 }
 
+// addLedgerEntry appends the given entry to the ledger of the account with the
+// given ID.
+func addLedgerEntry(tx kvdb.RwTx, id AccountID, entry *LedgerEntry) error {
+	entryBytes, err := serializeLedgerEntry(entry)
+	if err != nil {
+		return err
+	}
+
+	bucket := tx.ReadWriteBucket(accountLedgerBucketName)
+	if bucket == nil {
+		return ErrAccountBucketNotFound
+	}
+
+	ledgerBucket, err := bucket.CreateBucketIfNotExists(id[:])
+	if err != nil {
+		return err
+	}
+
+	// Like for the events, the sequence number of the bucket gives us keys
+	// that sort in the order the entries were added.
+	seq, err := ledgerBucket.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	var key [8]byte
+	byteOrder.PutUint64(key[:], seq)
+
+	return ledgerBucket.Put(key[:], entryBytes)
+}
+
 // storeAccount serializes and writes the given account to the given account
-// bucket.
+// bucket. The last update time and the version of the account are updated.
 func (s *BoltStore) storeAccount(accountBucket kvdb.RwBucket,
 	account *OffChainBalanceAccount) error {
 
 	account.LastUpdate = s.clock.Now()
+	account.Version++
 
 	accountBinary, err := serializeAccount(account)
 	if err != nil {
@@ -527,11 +864,116 @@ func (s *BoltStore) Accounts(_ context.Context) ([]*OffChainBalanceAccount,
 	return accounts, nil
 }
 
+// AccountsPage returns up to limit accounts in the given sort order, starting
+// with the given ID. The default order is the order of their ID.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) AccountsPage(ctx context.Context, sort AccountSort,
+	start fn.Option[AccountID], limit int) ([]*OffChainBalanceAccount,
+	fn.Option[AccountID], error) {
+
+	switch sort.Field {
+	case AccountSortDefault:
+		return s.accountsPageByID(start, limit)
+
+	case AccountSortBalance, AccountSortExpiration, AccountSortLabel,
+		AccountSortCreated:
+
+	default:
+		return nil, fn.None[AccountID](), fmt.Errorf("unknown account "+
+			"sort field %v", sort.Field)
+	}
+
+	// The bucket is only ordered by account ID, so we need to sort all
+	// accounts to find the page.
+	accounts, err := s.Accounts(ctx)
+	if err != nil {
+		return nil, fn.None[AccountID](), err
+	}
+	slices.SortFunc(accounts, sort.compare)
+
+	first := 0
+	if start.IsSome() {
+		id := start.UnsafeFromSome()
+		first = slices.IndexFunc(
+			accounts, func(acct *OffChainBalanceAccount) bool {
+				return acct.ID == id
+			},
+		)
+		if first < 0 {
+			return nil, fn.None[AccountID](), fmt.Errorf("start "+
+				"account %v: %w", id, ErrAccNotFound)
+		}
+	}
+	accounts = accounts[first:]
+
+	if len(accounts) > limit {
+		return accounts[:limit], fn.Some(accounts[limit].ID), nil
+	}
+
+	return accounts, fn.None[AccountID](), nil
+}
+
+// accountsPageByID returns up to limit accounts ordered by their ID, starting
+// with the given ID.
+func (s *BoltStore) accountsPageByID(start fn.Option[AccountID],
+	limit int) ([]*OffChainBalanceAccount, fn.Option[AccountID], error) {
+
+	var (
+		accounts []*OffChainBalanceAccount
+		next     fn.Option[AccountID]
+	)
+	err := s.db.View(func(tx kvdb.RTx) error {
+		cursor := tx.ReadBucket(accountBucketName).ReadCursor()
+
+		// The keys of the bucket are the account IDs, so iterating
+		// over them returns the accounts ordered by their ID.
+		k, v := cursor.First()
+		start.WhenSome(func(id AccountID) {
+			k, v = cursor.Seek(id[:])
+		})
+		for ; k != nil; k, v = cursor.Next() {
+			// Skip the two special purpose keys.
+			if bytes.Equal(k, lastAddIndexKey) ||
+				bytes.Equal(k, lastSettleIndexKey) {
+
+				continue
+			}
+
+			// There should be no sub-buckets.
+			if v == nil {
+				return fmt.Errorf("invalid bucket structure")
+			}
+
+			account, err := deserializeAccount(v)
+			if err != nil {
+				return err
+			}
+
+			if len(accounts) == limit {
+				next = fn.Some(account.ID)
+				return nil
+			}
+			accounts = append(accounts, account)
+		}
+
+		return nil
+	}, func() {
+		accounts = nil
+		next = fn.None[AccountID]()
+	})
+	if err != nil {
+		return nil, fn.None[AccountID](), err
+	}
+
+	return accounts, next, nil
+}
+
 // RemoveAccount finds an account by its ID and removes it from the DB.
 //
 // NOTE: This is part of the Store interface.
 func (s *BoltStore) RemoveAccount(_ context.Context, id AccountID) error {
-	return s.db.Update(func(tx kvdb.RwTx) error {
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(accountBucketName)
 		if bucket == nil {
 			return ErrAccountBucketNotFound
@@ -542,7 +984,556 @@ func (s *BoltStore) RemoveAccount(_ context.Context, id AccountID) error {
 			return ErrAccNotFound
 		}
 
+		if err := removeAccountCreditRules(tx, id); err != nil {
+			return err
+		}
+
+		return bucket.Delete(id[:])
+	}, func() {})
+}
+
+// SweepAndRemoveAccount atomically credits the remaining balance of the account
+// with the given ID to the target account and removes the account.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) SweepAndRemoveAccount(_ context.Context, id,
+	target AccountID) (lnwire.MilliSatoshi, error) {
+
+	if id == target {
+		return 0, ErrSweepToSelf
+	}
+
+	var swept lnwire.MilliSatoshi
+	err := s.update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		account, err := getAccount(bucket, id)
+		if err != nil {
+			return err
+		}
+
+		targetAccount, err := getAccount(bucket, target)
+		if err != nil {
+			return fmt.Errorf("error fetching sweep target: %w", err)
+		}
+
+		if account.CurrentBalance > 0 {
+			swept = lnwire.MilliSatoshi(account.CurrentBalance)
+			targetAccount.CurrentBalance += account.CurrentBalance
+
+			err = s.storeAccount(bucket, targetAccount)
+			if err != nil {
+				return fmt.Errorf("error storing sweep "+
+					"target: %w", err)
+			}
+
+			err = addLedgerEntry(tx, id, &LedgerEntry{
+				Type:      LedgerEntrySweep,
+				Timestamp: targetAccount.LastUpdate,
+				Delta:     -account.CurrentBalance,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = addLedgerEntry(tx, target, &LedgerEntry{
+				Type:      LedgerEntrySweep,
+				Timestamp: targetAccount.LastUpdate,
+				Delta:     account.CurrentBalance,
+				Balance:   targetAccount.CurrentBalance,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := removeAccountCreditRules(tx, id); err != nil {
+			return err
+		}
+
 		return bucket.Delete(id[:])
+	}, func() {
+		swept = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return swept, nil
+}
+
+// TransferAccountBalance atomically moves the given amount from the source
+// account to the destination account.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) TransferAccountBalance(_ context.Context, source,
+	destination AccountID, amount lnwire.MilliSatoshi) error {
+
+	if source == destination {
+		return ErrTransferToSelf
+	}
+
+	return s.update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		sourceAccount, err := getAccount(bucket, source)
+		if err != nil {
+			return fmt.Errorf("error fetching transfer source: %w",
+				err)
+		}
+
+		destAccount, err := getAccount(bucket, destination)
+		if err != nil {
+			return fmt.Errorf("error fetching transfer "+
+				"destination: %w", err)
+		}
+
+		if sourceAccount.CurrentBalance < int64(amount) {
+			return fmt.Errorf("%w: cannot transfer %v, source "+
+				"balance is %d mSAT", ErrAccBalanceInsufficient,
+				amount, sourceAccount.CurrentBalance)
+		}
+
+		sourceAccount.CurrentBalance -= int64(amount)
+		destAccount.CurrentBalance += int64(amount)
+
+		if err := s.storeAccount(bucket, sourceAccount); err != nil {
+			return err
+		}
+		if err := s.storeAccount(bucket, destAccount); err != nil {
+			return err
+		}
+
+		err = addLedgerEntry(tx, source, &LedgerEntry{
+			Type:      LedgerEntryTransfer,
+			Timestamp: sourceAccount.LastUpdate,
+			Delta:     -int64(amount),
+			Balance:   sourceAccount.CurrentBalance,
+		})
+		if err != nil {
+			return err
+		}
+
+		return addLedgerEntry(tx, destination, &LedgerEntry{
+			Type:      LedgerEntryTransfer,
+			Timestamp: destAccount.LastUpdate,
+			Delta:     int64(amount),
+			Balance:   destAccount.CurrentBalance,
+		})
+	}, func() {})
+}
+
+// ImportAccount stores the given account under its own ID. If an account with
+// the ID already exists, ErrAccountAlreadyExists is returned unless overwrite
+// is set, in which case its balance and settings are replaced.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) ImportAccount(ctx context.Context,
+	account *OffChainBalanceAccount, overwrite bool) error {
+
+	if len(account.Label) > 0 {
+		if err := validateLabel(account.Label); err != nil {
+			return err
+		}
+
+		err := s.checkLabelUnique(ctx, account.Label, &account.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		imported := importableAccount(account)
+		imported.CreatedAt = s.clock.Now()
+
+		var oldBalance int64
+		existing, err := getAccount(bucket, account.ID)
+		switch {
+		case err == nil && !overwrite:
+			return fmt.Errorf("%w: %x", ErrAccountAlreadyExists,
+				account.ID[:])
+
+		case err == nil:
+			imported.Invoices = existing.Invoices
+			imported.Payments = existing.Payments
+			imported.Version = existing.Version
+			imported.OpenInvoices = existing.OpenInvoices
+			imported.CreatedAt = existing.CreatedAt
+			oldBalance = existing.CurrentBalance
+
+		case !errors.Is(err, ErrAccNotFound):
+			return fmt.Errorf("error fetching account, %w", err)
+		}
+
+		if err := s.storeAccount(bucket, imported); err != nil {
+			return err
+		}
+
+		if existing != nil && imported.CurrentBalance == oldBalance {
+			return nil
+		}
+
+		return addLedgerEntry(tx, account.ID, &LedgerEntry{
+			Type:      LedgerEntryImport,
+			Timestamp: imported.LastUpdate,
+			Delta:     imported.CurrentBalance - oldBalance,
+			Balance:   imported.CurrentBalance,
+		})
+	}, func() {})
+}
+
+// UpdateAccountOpenInvoices sets the number of open invoices of the account
+// with the given ID.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) UpdateAccountOpenInvoices(_ context.Context, id AccountID,
+	openInvoices uint32) error {
+
+	update := func(account *OffChainBalanceAccount) error {
+		account.OpenInvoices = openInvoices
+
+		return nil
+	}
+
+	return s.updateAccount(id, update)
+}
+
+// TopUpAccount atomically moves the top-up amount of the account with the
+// given ID from the source account to it if the balance of the account is below
+// the minimum of its top-up policy.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) TopUpAccount(_ context.Context, id,
+	source AccountID) (lnwire.MilliSatoshi, error) {
+
+	if id == source {
+		return 0, fmt.Errorf("cannot top up an account from itself")
+	}
+
+	var amount lnwire.MilliSatoshi
+	err := s.update(func(tx kvdb.RwTx) error {
+		amount = 0
+
+		bucket := tx.ReadWriteBucket(accountBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		account, err := getAccount(bucket, id)
+		if err != nil {
+			return err
+		}
+
+		if !needsTopUp(account) {
+			return nil
+		}
+
+		sourceAccount, err := getAccount(bucket, source)
+		if err != nil {
+			return fmt.Errorf("error fetching top-up source: %w",
+				err)
+		}
+
+		topUp := int64(account.TopUp.Amount)
+		if sourceAccount.CurrentBalance < topUp {
+			available := lnwire.MilliSatoshi(
+				sourceAccount.CurrentBalance,
+			)
+
+			return fmt.Errorf("%w: %v needed but only %v available",
+				ErrTopUpSourceInsufficient, account.TopUp.Amount,
+				available)
+		}
+
+		sourceAccount.CurrentBalance -= topUp
+		account.CurrentBalance += topUp
+
+		if err := s.storeAccount(bucket, sourceAccount); err != nil {
+			return err
+		}
+		if err := s.storeAccount(bucket, account); err != nil {
+			return err
+		}
+
+		err = addLedgerEntry(tx, source, &LedgerEntry{
+			Type:      LedgerEntryTopUp,
+			Timestamp: sourceAccount.LastUpdate,
+			Delta:     -topUp,
+			Balance:   sourceAccount.CurrentBalance,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = addLedgerEntry(tx, id, &LedgerEntry{
+			Type:      LedgerEntryTopUp,
+			Timestamp: account.LastUpdate,
+			Delta:     topUp,
+			Balance:   account.CurrentBalance,
+		})
+		if err != nil {
+			return err
+		}
+
+		amount = account.TopUp.Amount
+
+		return nil
+	}, func() {
+		amount = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return amount, nil
+}
+
+// removeAccountCreditRules removes all credit rules of the account with the
+// given ID.
+func removeAccountCreditRules(tx kvdb.RwTx, id AccountID) error {
+	bucket := tx.ReadWriteBucket(creditRulesBucketName)
+	if bucket == nil {
+		return ErrAccountBucketNotFound
+	}
+
+	// Keys must not be deleted while iterating over the bucket, so we
+	// collect them first.
+	var prefixes [][]byte
+	err := bucket.ForEach(func(k, v []byte) error {
+		rule, err := deserializeCreditRule(k, v)
+		if err != nil {
+			return err
+		}
+
+		if rule.AccountID == id {
+			prefixes = append(prefixes, k)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, prefix := range prefixes {
+		if err := bucket.Delete(prefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddAccountEvent appends a lifecycle event to the history of the account
+// with the given ID.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) AddAccountEvent(_ context.Context, id AccountID,
+	event *AccountEvent) error {
+
+	eventBytes, err := serializeAccountEvent(event)
+	if err != nil {
+		return err
+	}
+
+	return s.update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(accountEventsBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		eventsBucket, err := bucket.CreateBucketIfNotExists(id[:])
+		if err != nil {
+			return err
+		}
+
+		// The sequence number of the bucket gives us keys that sort in
+		// the order the events were added.
+		seq, err := eventsBucket.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		var key [8]byte
+		byteOrder.PutUint64(key[:], seq)
+
+		return eventsBucket.Put(key[:], eventBytes)
+	}, func() {})
+}
+
+// AccountHistory returns all lifecycle events of the account with the given ID
+// in the order they were added.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) AccountHistory(_ context.Context, id AccountID) (
+	[]*AccountEvent, error) {
+
+	var events []*AccountEvent
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(accountEventsBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		eventsBucket := bucket.NestedReadBucket(id[:])
+		if eventsBucket == nil {
+			return nil
+		}
+
+		return eventsBucket.ForEach(func(_, v []byte) error {
+			event, err := deserializeAccountEvent(v)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, event)
+
+			return nil
+		})
+	}, func() {
+		events = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// AccountLedger returns all ledger entries of the account with the given ID in
+// the order they were recorded.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) AccountLedger(_ context.Context, id AccountID) (
+	[]*LedgerEntry, error) {
+
+	var entries []*LedgerEntry
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(accountLedgerBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		ledgerBucket := bucket.NestedReadBucket(id[:])
+		if ledgerBucket == nil {
+			return nil
+		}
+
+		return ledgerBucket.ForEach(func(_, v []byte) error {
+			entry, err := deserializeLedgerEntry(v)
+			if err != nil {
+				return err
+			}
+
+			entries = append(entries, entry)
+
+			return nil
+		})
+	}, func() {
+		entries = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// AddCreditRule adds a rule that maps a memo prefix to an existing account.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) AddCreditRule(_ context.Context, rule *CreditRule) error {
+	ruleBytes, err := serializeCreditRule(rule)
+	if err != nil {
+		return err
+	}
+
+	return s.update(func(tx kvdb.RwTx) error {
+		accounts := tx.ReadBucket(accountBucketName)
+		if accounts == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		if len(accounts.Get(rule.AccountID[:])) == 0 {
+			return ErrAccNotFound
+		}
+
+		bucket := tx.ReadWriteBucket(creditRulesBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		key := []byte(rule.MemoPrefix)
+		if len(bucket.Get(key)) != 0 {
+			return ErrCreditRuleExists
+		}
+
+		return bucket.Put(key, ruleBytes)
+	}, func() {})
+}
+
+// CreditRules returns all credit rules ordered by their memo prefix.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) CreditRules(_ context.Context) ([]*CreditRule, error) {
+	var rules []*CreditRule
+	err := s.db.View(func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(creditRulesBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		// The keys are the memo prefixes, so the cursor returns the
+		// rules in the right order.
+		return bucket.ForEach(func(k, v []byte) error {
+			rule, err := deserializeCreditRule(k, v)
+			if err != nil {
+				return err
+			}
+
+			rules = append(rules, rule)
+
+			return nil
+		})
+	}, func() {
+		rules = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// RemoveCreditRule removes the rule for the given memo prefix.
+//
+// NOTE: This is part of the Store interface.
+func (s *BoltStore) RemoveCreditRule(_ context.Context,
+	memoPrefix string) error {
+
+	return s.update(func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(creditRulesBucketName)
+		if bucket == nil {
+			return ErrAccountBucketNotFound
+		}
+
+		key := []byte(memoPrefix)
+		if len(bucket.Get(key)) == 0 {
+			return ErrCreditRuleNotFound
+		}
+
+		return bucket.Delete(key)
 	}, func() {})
 }
 
@@ -592,7 +1583,7 @@ func (s *BoltStore) StoreLastIndexes(_ context.Context, addIndex,
 	byteOrder.PutUint64(addValue, addIndex)
 	byteOrder.PutUint64(settleValue, settleIndex)
 
-	return s.db.Update(func(tx kvdb.RwTx) error {
+	return s.update(func(tx kvdb.RwTx) error {
 		bucket := tx.ReadWriteBucket(accountBucketName)
 		if bucket == nil {
 			return ErrAccountBucketNotFound
@@ -0,0 +1,81 @@
+//go:build !test_db_sqlite && !test_db_postgres
+
+package accounts
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBoltStoreCommitPolicy makes sure that writes are persisted correctly
+// with every commit policy, even if they happen concurrently.
+func TestBoltStoreCommitPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	// An unknown commit policy is rejected.
+	_, err := NewBoltStore(
+		t.TempDir(), DBFilename, clock.NewDefaultClock(),
+		WithCommitPolicy("unknown"),
+	)
+	require.ErrorContains(t, err, "unknown commit policy")
+
+	policies := []CommitPolicy{CommitPolicySync, CommitPolicyBatch}
+	for _, policy := range policies {
+		t.Run(string(policy), func(t *testing.T) {
+			t.Parallel()
+
+			store, err := NewBoltStore(
+				t.TempDir(), DBFilename,
+				clock.NewTestClock(time.Now()),
+				WithCommitPolicy(policy), WithNoFreelistSync(),
+			)
+			require.NoError(t, err)
+			t.Cleanup(func() {
+				require.NoError(t, store.Close())
+			})
+
+			const numAccounts = 20
+			ids := make([]AccountID, numAccounts)
+
+			var wg sync.WaitGroup
+			for i := 0; i < numAccounts; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+
+					acct, err := store.NewAccount(
+						ctx, 1000, time.Time{}, "",
+					)
+					require.NoError(t, err)
+
+					err = store.UpdateAccountBalanceAndExpiry(
+						ctx, acct.ID, fn.Some(int64(i)),
+						fn.None[time.Time](),
+					)
+					require.NoError(t, err)
+
+					ids[i] = acct.ID
+				}(i)
+			}
+			wg.Wait()
+
+			accts, err := store.Accounts(ctx)
+			require.NoError(t, err)
+			require.Len(t, accts, numAccounts)
+
+			for i, id := range ids {
+				acct, err := store.Account(ctx, id)
+				require.NoError(t, err)
+				require.EqualValues(t, i, acct.CurrentBalance)
+			}
+		})
+	}
+}
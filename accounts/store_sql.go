@@ -4,9 +4,9 @@ import (
 	"context"
 	"crypto/rand"
 	"database/sql"
-	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/lightninglabs/lightning-terminal/db"
@@ -16,6 +16,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 )
 
 const (
@@ -35,20 +36,51 @@ const (
 type SQLQueries interface {
 	AddAccountInvoice(ctx context.Context, arg sqlc.AddAccountInvoiceParams) error
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteAccountCreditRule(ctx context.Context, memoPrefix string) error
 	DeleteAccountPayment(ctx context.Context, arg sqlc.DeleteAccountPaymentParams) error
 	GetAccount(ctx context.Context, id int64) (sqlc.Account, error)
 	GetAccountByLabel(ctx context.Context, label sql.NullString) (sqlc.Account, error)
+	GetAccountCreditRule(ctx context.Context, memoPrefix string) (sqlc.AccountCreditRule, error)
 	GetAccountIDByAlias(ctx context.Context, alias int64) (int64, error)
 	GetAccountIndex(ctx context.Context, name string) (int64, error)
 	GetAccountPayment(ctx context.Context, arg sqlc.GetAccountPaymentParams) (sqlc.AccountPayment, error)
 	InsertAccount(ctx context.Context, arg sqlc.InsertAccountParams) (int64, error)
+	InsertAccountCreditRule(ctx context.Context, arg sqlc.InsertAccountCreditRuleParams) error
+	InsertAccountEvent(ctx context.Context, arg sqlc.InsertAccountEventParams) error
+	InsertAccountLedgerEntry(ctx context.Context, arg sqlc.InsertAccountLedgerEntryParams) error
+	ListAccountCreditRules(ctx context.Context) ([]sqlc.ListAccountCreditRulesRow, error)
+	ListAccountEvents(ctx context.Context, accountAlias int64) ([]sqlc.AccountEvent, error)
 	ListAccountInvoices(ctx context.Context, id int64) ([]sqlc.AccountInvoice, error)
+	ListAccountLedgerEntries(ctx context.Context, accountAlias int64) ([]sqlc.AccountLedger, error)
 	ListAccountPayments(ctx context.Context, id int64) ([]sqlc.AccountPayment, error)
 	ListAllAccounts(ctx context.Context) ([]sqlc.Account, error)
+	ListAccountsPage(ctx context.Context, arg sqlc.ListAccountsPageParams) ([]sqlc.Account, error)
+	ListAccountsByBalanceAsc(ctx context.Context, arg sqlc.ListAccountsByBalanceAscParams) ([]sqlc.Account, error)
+	ListAccountsByBalanceDesc(ctx context.Context, arg sqlc.ListAccountsByBalanceDescParams) ([]sqlc.Account, error)
+	ListAccountsByExpirationAsc(ctx context.Context, arg sqlc.ListAccountsByExpirationAscParams) ([]sqlc.Account, error)
+	ListAccountsByExpirationDesc(ctx context.Context, arg sqlc.ListAccountsByExpirationDescParams) ([]sqlc.Account, error)
+	ListAccountsByLabelAsc(ctx context.Context, arg sqlc.ListAccountsByLabelAscParams) ([]sqlc.Account, error)
+	ListAccountsByLabelDesc(ctx context.Context, arg sqlc.ListAccountsByLabelDescParams) ([]sqlc.Account, error)
+	ListAccountsByCreatedAsc(ctx context.Context, arg sqlc.ListAccountsByCreatedAscParams) ([]sqlc.Account, error)
+	ListAccountsByCreatedDesc(ctx context.Context, arg sqlc.ListAccountsByCreatedDescParams) ([]sqlc.Account, error)
+	OverwriteAccount(ctx context.Context, arg sqlc.OverwriteAccountParams) (int64, error)
 	SetAccountIndex(ctx context.Context, arg sqlc.SetAccountIndexParams) error
 	UpdateAccountBalance(ctx context.Context, arg sqlc.UpdateAccountBalanceParams) (int64, error)
 	UpdateAccountExpiry(ctx context.Context, arg sqlc.UpdateAccountExpiryParams) (int64, error)
 	UpdateAccountLastUpdate(ctx context.Context, arg sqlc.UpdateAccountLastUpdateParams) (int64, error)
+	UpdateAccountMaxHTLC(ctx context.Context, arg sqlc.UpdateAccountMaxHTLCParams) (int64, error)
+	UpdateAccountSoftCap(ctx context.Context, arg sqlc.UpdateAccountSoftCapParams) (int64, error)
+	UpdateAccountLabel(ctx context.Context, arg sqlc.UpdateAccountLabelParams) (int64, error)
+	UpdateAccountTopUpPolicy(ctx context.Context, arg sqlc.UpdateAccountTopUpPolicyParams) (int64, error)
+	UpdateAccountSpendRateLimit(ctx context.Context, arg sqlc.UpdateAccountSpendRateLimitParams) (int64, error)
+	UpdateAccountExpiryWarning(ctx context.Context, arg sqlc.UpdateAccountExpiryWarningParams) (int64, error)
+	UpdateAccountMaxOpenInvoices(ctx context.Context, arg sqlc.UpdateAccountMaxOpenInvoicesParams) (int64, error)
+	UpdateAccountMaxInvoiceAmount(ctx context.Context, arg sqlc.UpdateAccountMaxInvoiceAmountParams) (int64, error)
+	UpdateAccountMaxPayment(ctx context.Context, arg sqlc.UpdateAccountMaxPaymentParams) (int64, error)
+	UpdateAccountOpenInvoices(ctx context.Context, arg sqlc.UpdateAccountOpenInvoicesParams) (int64, error)
+	InsertAccountAllowedDestination(ctx context.Context, arg sqlc.InsertAccountAllowedDestinationParams) error
+	DeleteAccountAllowedDestinations(ctx context.Context, accountID int64) error
+	ListAccountAllowedDestinations(ctx context.Context, accountID int64) ([][]byte, error)
 	UpsertAccountPayment(ctx context.Context, arg sqlc.UpsertAccountPaymentParams) error
 	GetAccountInvoice(ctx context.Context, arg sqlc.GetAccountInvoiceParams) (sqlc.AccountInvoice, error)
 }
@@ -90,25 +122,25 @@ func NewSQLStore(sqlDB *db.BaseDB, clock clock.Clock) *SQLStore {
 }
 
 // NewAccount creates and persists a new OffChainBalanceAccount with the given
-// balance and a randomly chosen ID. If the given label is not empty, then it
-// must be unique; if it is not, then ErrLabelAlreadyExists is returned.
+// balance and a randomly chosen ID, unless the WithAccountID option is used.
+// If the given label is not empty, then it must be unique; if it is not, then
+// ErrLabelAlreadyExists is returned. A given ID must be unique as well,
+// otherwise ErrAccountAlreadyExists is returned.
 //
 // NOTE: This is part of the Store interface.
 func (s *SQLStore) NewAccount(ctx context.Context, balance lnwire.MilliSatoshi,
-	expirationDate time.Time, label string) (*OffChainBalanceAccount,
-	error) {
+	expirationDate time.Time, label string,
+	opts ...NewAccountOption) (*OffChainBalanceAccount, error) {
+
+	options := newAccountOptionsFromOpts(opts)
 
 	// Ensure that if a label is set, it can't be mistaken for a hex
 	// encoded account ID to avoid confusion and make it easier for the CLI
 	// to distinguish between the two.
 	var labelVal sql.NullString
 	if len(label) > 0 {
-		if _, err := hex.DecodeString(label); err == nil &&
-			len(label) == hex.EncodedLen(AccountIDLen) {
-
-			return nil, fmt.Errorf("the label '%s' is not allowed "+
-				"as it can be mistaken for an account ID",
-				label)
+		if err := validateLabel(label); err != nil {
+			return nil, err
 		}
 
 		labelVal = sql.NullString{
@@ -117,14 +149,20 @@ func (s *SQLStore) NewAccount(ctx context.Context, balance lnwire.MilliSatoshi,
 		}
 	}
 
+	// Accounts that aren't bound to a node don't have a node ID.
+	var nodeID []byte
+	if options.nodeID != (route.Vertex{}) {
+		nodeID = options.nodeID[:]
+	}
+
 	var (
 		writeTxOpts db.QueriesTxOptions
 		account     *OffChainBalanceAccount
 	)
 	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
 		// First, find a unique alias (this is what the ID was in the
-		// kvdb implementation of the DB).
-		alias, err := uniqueRandomAccountAlias(ctx, db)
+		// kvdb implementation of the DB), unless one was given.
+		alias, err := newAccountAlias(ctx, db, options.id)
 		if err != nil {
 			return err
 		}
@@ -146,11 +184,49 @@ func (s *SQLStore) NewAccount(ctx context.Context, balance lnwire.MilliSatoshi,
 			LastUpdated:        s.clock.Now().UTC(),
 			Label:              labelVal,
 			Alias:              alias,
+			MaxHtlcMsat:        int64(options.maxHTLC),
+			SoftCapMsat:        int64(options.softCap),
+			Sandbox:            options.sandbox,
+			KeysendBudgetMsat:  int64(options.keysendBudget),
+			NodeID:             nodeID,
+			AmountlessMaxMsat: int64(
+				options.amountlessInvoiceMax,
+			),
+			TopUpMinBalanceMsat: int64(options.topUp.MinBalance),
+			TopUpAmountMsat:     int64(options.topUp.Amount),
+			RateLimitMaxMsat: int64(
+				options.spendRateLimit.MaxAmount,
+			),
+			RateLimitWindowSeconds: int64(
+				options.spendRateLimit.Window.Seconds(),
+			),
+			MaxOpenInvoices: int64(options.maxOpenInvoices),
+			MaxInvoiceAmountMsat: int64(
+				options.maxInvoiceAmount,
+			),
+			CreatedAt:      s.clock.Now().UTC(),
+			MaxPaymentMsat: int64(options.maxPayment),
 		})
 		if err != nil {
 			return fmt.Errorf("inserting account: %w", err)
 		}
 
+		err = setAllowedDestinations(
+			ctx, db, id, options.allowedDestinations,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = s.insertLedgerEntry(ctx, db, alias, &LedgerEntry{
+			Type:    LedgerEntryOpening,
+			Delta:   int64(balance),
+			Balance: int64(balance),
+		})
+		if err != nil {
+			return fmt.Errorf("inserting ledger entry: %w", err)
+		}
+
 		account, err = getAndMarshalAccount(ctx, db, id)
 		if err != nil {
 			return fmt.Errorf("fetching account: %w", err)
@@ -194,10 +270,52 @@ func marshalDBAccount(ctx context.Context, db SQLQueries,
 		InitialBalance: lnwire.MilliSatoshi(dbAcct.InitialBalanceMsat),
 		CurrentBalance: dbAcct.CurrentBalanceMsat,
 		LastUpdate:     dbAcct.LastUpdated.UTC(),
+		CreatedAt:      dbAcct.CreatedAt.UTC(),
 		ExpirationDate: dbAcct.Expiration.UTC(),
 		Invoices:       make(AccountInvoices),
 		Payments:       make(AccountPayments),
 		Label:          dbAcct.Label.String,
+		MaxHTLC:        lnwire.MilliSatoshi(dbAcct.MaxHtlcMsat),
+		SoftCap:        lnwire.MilliSatoshi(dbAcct.SoftCapMsat),
+		Sandbox:        dbAcct.Sandbox,
+		KeysendBudget:  lnwire.MilliSatoshi(dbAcct.KeysendBudgetMsat),
+		Version:        uint64(dbAcct.Version),
+
+		AmountlessInvoiceMax: lnwire.MilliSatoshi(
+			dbAcct.AmountlessMaxMsat,
+		),
+		TopUp: TopUpPolicy{
+			MinBalance: lnwire.MilliSatoshi(
+				dbAcct.TopUpMinBalanceMsat,
+			),
+			Amount: lnwire.MilliSatoshi(dbAcct.TopUpAmountMsat),
+		},
+		SpendRateLimit: SpendRateLimit{
+			MaxAmount: lnwire.MilliSatoshi(dbAcct.RateLimitMaxMsat),
+			Window: time.Duration(dbAcct.RateLimitWindowSeconds) *
+				time.Second,
+		},
+		ExpiryWarning: time.Duration(dbAcct.ExpiryWarningSeconds) *
+			time.Second,
+		MaxOpenInvoices: uint32(dbAcct.MaxOpenInvoices),
+		MaxInvoiceAmount: lnwire.MilliSatoshi(
+			dbAcct.MaxInvoiceAmountMsat,
+		),
+		OpenInvoices: uint32(dbAcct.OpenInvoices),
+		MaxPayment:   lnwire.MilliSatoshi(dbAcct.MaxPaymentMsat),
+	}
+	copy(account.NodeID[:], dbAcct.NodeID)
+
+	dests, err := db.ListAccountAllowedDestinations(ctx, dbAcct.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, dest := range dests {
+		var vertex route.Vertex
+		copy(vertex[:], dest)
+		account.AllowedDestinations = append(
+			account.AllowedDestinations, vertex,
+		)
 	}
 
 	invoices, err := db.ListAccountInvoices(ctx, dbAcct.ID)
@@ -218,15 +336,45 @@ func marshalDBAccount(ctx context.Context, db SQLQueries,
 	for _, payment := range payments {
 		var hash lntypes.Hash
 		copy(hash[:], payment.Hash)
-		account.Payments[hash] = &PaymentEntry{
+		entry := &PaymentEntry{
 			Status:     lnrpc.Payment_PaymentStatus(payment.Status),
 			FullAmount: lnwire.MilliSatoshi(payment.FullAmountMsat),
+			Fee:        lnwire.MilliSatoshi(payment.FeeMsat),
 		}
+		copy(entry.Destination[:], payment.Destination)
+		account.Payments[hash] = entry
 	}
 
 	return account, nil
 }
 
+// newAccountAlias returns the alias of the given account ID, or a unique
+// random alias if the ID is the all-zero ID. ErrAccountAlreadyExists is
+// returned if an account with the given ID already exists.
+func newAccountAlias(ctx context.Context, db SQLQueries, id AccountID) (int64,
+	error) {
+
+	if id == (AccountID{}) {
+		return uniqueRandomAccountAlias(ctx, db)
+	}
+
+	alias, err := id.ToInt64()
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = db.GetAccountIDByAlias(ctx, alias)
+	switch {
+	case err == nil:
+		return 0, ErrAccountAlreadyExists
+
+	case !errors.Is(err, sql.ErrNoRows):
+		return 0, err
+	}
+
+	return alias, nil
+}
+
 // uniqueRandomAccountAlias generates a random account alias that is not already
 // in use. An account "alias" is a unique 8 byte identifier (which corresponds
 // to the AccountID type) that is used to identify accounts in the database. The
@@ -298,10 +446,102 @@ func (s *SQLStore) AddAccountInvoice(ctx context.Context, alias AccountID,
 			return err
 		}
 
+		acct, err := db.GetAccount(ctx, acctID)
+		if err != nil {
+			return err
+		}
+
+		err = setOpenInvoices(ctx, db, acctID, acct.OpenInvoices+1)
+		if err != nil {
+			return err
+		}
+
+		return s.markAccountUpdated(ctx, db, acctID)
+	})
+}
+
+// CloseAccountInvoice decreases the number of open invoices of the account
+// with the given alias if the invoice belongs to it.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) CloseAccountInvoice(ctx context.Context, alias AccountID,
+	hash lntypes.Hash) error {
+
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		acctID, err := getAccountIDByAlias(ctx, db, alias)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.GetAccountInvoice(ctx, sqlc.GetAccountInvoiceParams{
+			AccountID: acctID,
+			Hash:      hash[:],
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		acct, err := db.GetAccount(ctx, acctID)
+		if err != nil {
+			return err
+		}
+		if acct.OpenInvoices == 0 {
+			return nil
+		}
+
+		err = setOpenInvoices(ctx, db, acctID, acct.OpenInvoices-1)
+		if err != nil {
+			return err
+		}
+
 		return s.markAccountUpdated(ctx, db, acctID)
 	})
 }
 
+// UpdateAccountOpenInvoices sets the number of open invoices of the account
+// with the given alias.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) UpdateAccountOpenInvoices(ctx context.Context,
+	alias AccountID, openInvoices uint32) error {
+
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		id, err := getAccountIDByAlias(ctx, db, alias)
+		if err != nil {
+			return err
+		}
+
+		err = setOpenInvoices(ctx, db, id, int64(openInvoices))
+		if err != nil {
+			return err
+		}
+
+		return s.markAccountUpdated(ctx, db, id)
+	})
+}
+
+// setOpenInvoices sets the number of open invoices of the account with the
+// given ID.
+func setOpenInvoices(ctx context.Context, db SQLQueries, id int64,
+	openInvoices int64) error {
+
+	_, err := db.UpdateAccountOpenInvoices(
+		ctx, sqlc.UpdateAccountOpenInvoicesParams{
+			ID:           id,
+			OpenInvoices: openInvoices,
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("updating open invoices: %w", err)
+	}
+
+	return nil
+}
+
 func getAccountIDByAlias(ctx context.Context, db SQLQueries, alias AccountID) (
 	int64, error) {
 
@@ -320,7 +560,7 @@ func getAccountIDByAlias(ctx context.Context, db SQLQueries, alias AccountID) (
 }
 
 // markAccountUpdated is a helper that updates the last updated timestamp of
-// the account with the given ID.
+// the account with the given ID and increments its version.
 func (s *SQLStore) markAccountUpdated(ctx context.Context,
 	db SQLQueries, id int64) error {
 
@@ -337,6 +577,57 @@ func (s *SQLStore) markAccountUpdated(ctx context.Context,
 	return err
 }
 
+// setAccountBalance sets the balance of the given account to the new balance
+// and appends the given ledger entry, completed with the change and the
+// resulting balance, to the ledger of the account. Nothing is recorded if the
+// balance doesn't change.
+func (s *SQLStore) setAccountBalance(ctx context.Context, db SQLQueries,
+	acct sqlc.Account, newBalance int64, entry *LedgerEntry) error {
+
+	_, err := db.UpdateAccountBalance(
+		ctx, sqlc.UpdateAccountBalanceParams{
+			ID:                 acct.ID,
+			CurrentBalanceMsat: newBalance,
+		},
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrAccNotFound
+	} else if err != nil {
+		return err
+	}
+
+	if newBalance == acct.CurrentBalanceMsat {
+		return nil
+	}
+
+	entry.Delta = newBalance - acct.CurrentBalanceMsat
+	entry.Balance = newBalance
+
+	return s.insertLedgerEntry(ctx, db, acct.Alias, entry)
+}
+
+// insertLedgerEntry appends the given entry to the ledger of the account with
+// the given alias. The entry is timestamped with the current time.
+func (s *SQLStore) insertLedgerEntry(ctx context.Context, db SQLQueries,
+	alias int64, entry *LedgerEntry) error {
+
+	var reference []byte
+	if entry.Reference != (lntypes.Hash{}) {
+		reference = entry.Reference[:]
+	}
+
+	return db.InsertAccountLedgerEntry(
+		ctx, sqlc.InsertAccountLedgerEntryParams{
+			AccountAlias: alias,
+			Type:         int16(entry.Type),
+			AmountMsat:   entry.Delta,
+			BalanceMsat:  entry.Balance,
+			Reference:    reference,
+			CreatedAt:    s.clock.Now().UTC(),
+		},
+	)
+}
+
 // UpdateAccountBalanceAndExpiry updates the balance and/or expiry of an
 // account.
 //
@@ -345,6 +636,19 @@ func (s *SQLStore) UpdateAccountBalanceAndExpiry(ctx context.Context,
 	alias AccountID, newBalance fn.Option[int64],
 	newExpiry fn.Option[time.Time]) error {
 
+	return s.UpdateAccount(ctx, alias, &AccountUpdate{
+		Balance: newBalance,
+		Expiry:  newExpiry,
+	})
+}
+
+// UpdateAccount applies all changes of the given update to the account with
+// the given alias in a single transaction.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) UpdateAccount(ctx context.Context, alias AccountID,
+	update *AccountUpdate) error {
+
 	var writeTxOpts db.QueriesTxOptions
 	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
 		id, err := getAccountIDByAlias(ctx, db, alias)
@@ -352,19 +656,23 @@ func (s *SQLStore) UpdateAccountBalanceAndExpiry(ctx context.Context,
 			return err
 		}
 
-		newBalance.WhenSome(func(i int64) {
-			_, err = db.UpdateAccountBalance(
-				ctx, sqlc.UpdateAccountBalanceParams{
-					ID:                 id,
-					CurrentBalanceMsat: i,
-				},
+		if update.Balance.IsSome() {
+			acct, err := db.GetAccount(ctx, id)
+			if err != nil {
+				return err
+			}
+
+			err = s.setAccountBalance(
+				ctx, db, acct,
+				update.Balance.UnwrapOr(acct.CurrentBalanceMsat),
+				&LedgerEntry{Type: LedgerEntryBalanceSet},
 			)
-		})
-		if err != nil {
-			return err
+			if err != nil {
+				return err
+			}
 		}
 
-		newExpiry.WhenSome(func(t time.Time) {
+		update.Expiry.WhenSome(func(t time.Time) {
 			_, err = db.UpdateAccountExpiry(
 				ctx, sqlc.UpdateAccountExpiryParams{
 					ID:         id,
@@ -376,6 +684,193 @@ func (s *SQLStore) UpdateAccountBalanceAndExpiry(ctx context.Context,
 			return err
 		}
 
+		update.MaxHTLC.WhenSome(func(amt lnwire.MilliSatoshi) {
+			_, err = db.UpdateAccountMaxHTLC(
+				ctx, sqlc.UpdateAccountMaxHTLCParams{
+					ID:          id,
+					MaxHtlcMsat: int64(amt),
+				},
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		update.SoftCap.WhenSome(func(amt lnwire.MilliSatoshi) {
+			_, err = db.UpdateAccountSoftCap(
+				ctx, sqlc.UpdateAccountSoftCapParams{
+					ID:          id,
+					SoftCapMsat: int64(amt),
+				},
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		update.TopUp.WhenSome(func(policy TopUpPolicy) {
+			_, err = db.UpdateAccountTopUpPolicy(
+				ctx, sqlc.UpdateAccountTopUpPolicyParams{
+					ID: id,
+					TopUpMinBalanceMsat: int64(
+						policy.MinBalance,
+					),
+					TopUpAmountMsat: int64(policy.Amount),
+				},
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		update.SpendRateLimit.WhenSome(func(limit SpendRateLimit) {
+			_, err = db.UpdateAccountSpendRateLimit(
+				ctx, sqlc.UpdateAccountSpendRateLimitParams{
+					ID: id,
+					RateLimitMaxMsat: int64(
+						limit.MaxAmount,
+					),
+					RateLimitWindowSeconds: int64(
+						limit.Window.Seconds(),
+					),
+				},
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		update.ExpiryWarning.WhenSome(func(warning time.Duration) {
+			_, err = db.UpdateAccountExpiryWarning(
+				ctx, sqlc.UpdateAccountExpiryWarningParams{
+					ID: id,
+					ExpiryWarningSeconds: int64(
+						warning.Seconds(),
+					),
+				},
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		update.AllowedDestinations.WhenSome(func(dests []route.Vertex) {
+			err = setAllowedDestinations(
+				ctx, db, id, normalizeDestinations(dests),
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		update.MaxOpenInvoices.WhenSome(func(maxOpen uint32) {
+			_, err = db.UpdateAccountMaxOpenInvoices(
+				ctx, sqlc.UpdateAccountMaxOpenInvoicesParams{
+					ID:              id,
+					MaxOpenInvoices: int64(maxOpen),
+				},
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		update.MaxInvoiceAmount.WhenSome(func(amt lnwire.MilliSatoshi) {
+			_, err = db.UpdateAccountMaxInvoiceAmount(
+				ctx, sqlc.UpdateAccountMaxInvoiceAmountParams{
+					ID:                   id,
+					MaxInvoiceAmountMsat: int64(amt),
+				},
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		update.MaxPayment.WhenSome(func(amt lnwire.MilliSatoshi) {
+			_, err = db.UpdateAccountMaxPayment(
+				ctx, sqlc.UpdateAccountMaxPaymentParams{
+					ID:             id,
+					MaxPaymentMsat: int64(amt),
+				},
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		return s.markAccountUpdated(ctx, db, id)
+	})
+}
+
+// setAllowedDestinations replaces the allowed destinations of the account with
+// the given ID.
+func setAllowedDestinations(ctx context.Context, db SQLQueries, id int64,
+	dests []route.Vertex) error {
+
+	err := db.DeleteAccountAllowedDestinations(ctx, id)
+	if err != nil {
+		return fmt.Errorf("deleting allowed destinations: %w", err)
+	}
+
+	for _, dest := range dests {
+		err := db.InsertAccountAllowedDestination(
+			ctx, sqlc.InsertAccountAllowedDestinationParams{
+				AccountID: id,
+				NodeID:    dest[:],
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("inserting allowed destination: %w",
+				err)
+		}
+	}
+
+	return nil
+}
+
+// UpdateAccountLabel changes the label of the account with the given alias.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) UpdateAccountLabel(ctx context.Context, alias AccountID,
+	label string) error {
+
+	if len(label) == 0 {
+		return fmt.Errorf("label must not be empty")
+	}
+	if err := validateLabel(label); err != nil {
+		return err
+	}
+
+	labelVal := sql.NullString{
+		String: label,
+		Valid:  true,
+	}
+
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		id, err := getAccountIDByAlias(ctx, db, alias)
+		if err != nil {
+			return err
+		}
+
+		existing, err := db.GetAccountByLabel(ctx, labelVal)
+		if err == nil && existing.ID != id {
+			return ErrLabelAlreadyExists
+		} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		_, err = db.UpdateAccountLabel(
+			ctx, sqlc.UpdateAccountLabelParams{
+				ID:    id,
+				Label: labelVal,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
 		return s.markAccountUpdated(ctx, db, id)
 	})
 }
@@ -385,7 +880,9 @@ func (s *SQLStore) UpdateAccountBalanceAndExpiry(ctx context.Context,
 //
 // NOTE: This is part of the Store interface.
 func (s *SQLStore) CreditAccount(ctx context.Context, alias AccountID,
-	amount lnwire.MilliSatoshi) error {
+	amount lnwire.MilliSatoshi, opts ...CreditOption) error {
+
+	options := newCreditOptions(opts)
 
 	var writeTxOpts db.QueriesTxOptions
 	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
@@ -401,10 +898,10 @@ func (s *SQLStore) CreditAccount(ctx context.Context, alias AccountID,
 
 		newBalance := acct.CurrentBalanceMsat + int64(amount)
 
-		_, err = db.UpdateAccountBalance(
-			ctx, sqlc.UpdateAccountBalanceParams{
-				ID:                 id,
-				CurrentBalanceMsat: newBalance,
+		err = s.setAccountBalance(
+			ctx, db, acct, newBalance, &LedgerEntry{
+				Type:      options.entryType,
+				Reference: options.reference,
 			},
 		)
 		if err != nil {
@@ -442,11 +939,9 @@ func (s *SQLStore) DebitAccount(ctx context.Context, alias AccountID,
 
 		newBalance := acct.CurrentBalanceMsat - int64(amount)
 
-		_, err = db.UpdateAccountBalance(
-			ctx, sqlc.UpdateAccountBalanceParams{
-				ID:                 id,
-				CurrentBalanceMsat: newBalance,
-			},
+		err = s.setAccountBalance(
+			ctx, db, acct, newBalance,
+			&LedgerEntry{Type: LedgerEntryDebit},
 		)
 		if err != nil {
 			return err
@@ -512,19 +1007,604 @@ func (s *SQLStore) Accounts(ctx context.Context) ([]*OffChainBalanceAccount,
 	return accounts, err
 }
 
-// RemoveAccount finds an account by its ID and removes it from the DB.
+// AccountsPage returns up to limit accounts in the given sort order, starting
+// with the given alias. The default order is the order of their alias.
 //
 // NOTE: This is part of the Store interface.
-func (s *SQLStore) RemoveAccount(ctx context.Context, alias AccountID) error {
-	var writeTxOpts db.QueriesTxOptions
-	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
-		id, err := getAccountIDByAlias(ctx, db, alias)
-		if err != nil {
-			return err
+func (s *SQLStore) AccountsPage(ctx context.Context, sort AccountSort,
+	start fn.Option[AccountID], limit int) ([]*OffChainBalanceAccount,
+	fn.Option[AccountID], error) {
+
+	var (
+		readTxOpts = db.NewQueryReadTx()
+		accounts   []*OffChainBalanceAccount
+		next       fn.Option[AccountID]
+	)
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLQueries) error {
+		// We fetch one account more than requested to find out where
+		// the next page starts.
+		dbAccounts, err := listAccountsPage(
+			ctx, db, sort, start, int32(limit+1),
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(dbAccounts) > limit {
+			nextID, err := AccountIDFromInt64(
+				dbAccounts[limit].Alias,
+			)
+			if err != nil {
+				return err
+			}
+			next = fn.Some(nextID)
+			dbAccounts = dbAccounts[:limit]
+		}
+
+		accounts = make([]*OffChainBalanceAccount, len(dbAccounts))
+		for i, dbAccount := range dbAccounts {
+			account, err := marshalDBAccount(ctx, db, dbAccount)
+			if err != nil {
+				return err
+			}
+
+			accounts[i] = account
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fn.None[AccountID](), err
+	}
+
+	return accounts, next, nil
+}
+
+// listAccountsPage returns up to limit accounts in the given sort order,
+// starting with the given alias.
+func listAccountsPage(ctx context.Context, db SQLQueries, sort AccountSort,
+	start fn.Option[AccountID], limit int32) ([]sqlc.Account, error) {
+
+	if sort.Field == AccountSortDefault {
+		// Aliases are signed, so the first page starts at the smallest
+		// one.
+		var (
+			startAlias int64 = math.MinInt64
+			err        error
+		)
+		start.WhenSome(func(id AccountID) {
+			startAlias, err = id.ToInt64()
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return db.ListAccountsPage(ctx, sqlc.ListAccountsPageParams{
+			Alias: startAlias,
+			Limit: limit,
+		})
+	}
+
+	// Any other order continues at the sort key of the start account, so
+	// it must still exist.
+	var startAcct sqlc.Account
+	if start.IsSome() {
+		alias := start.UnsafeFromSome()
+		id, err := getAccountIDByAlias(ctx, db, alias)
+		if err != nil {
+			return nil, fmt.Errorf("start account %v: %w", alias,
+				err)
+		}
+
+		startAcct, err = db.GetAccount(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	hasStart := start.IsSome()
+
+	switch sort.Field {
+	case AccountSortBalance:
+		params := sqlc.ListAccountsByBalanceAscParams{
+			HasStart:     hasStart,
+			StartBalance: startAcct.CurrentBalanceMsat,
+			StartAlias:   startAcct.Alias,
+			Limit:        limit,
+		}
+		if sort.Descending {
+			return db.ListAccountsByBalanceDesc(
+				ctx, sqlc.ListAccountsByBalanceDescParams(
+					params,
+				),
+			)
+		}
+
+		return db.ListAccountsByBalanceAsc(ctx, params)
+
+	case AccountSortExpiration:
+		// Accounts that never expire are stored with a zero expiration
+		// date but come last.
+		params := sqlc.ListAccountsByExpirationAscParams{
+			HasStart:        hasStart,
+			Never:           time.Time{},
+			StartNever:      startAcct.Expiration.IsZero(),
+			StartExpiration: startAcct.Expiration,
+			StartAlias:      startAcct.Alias,
+			Limit:           limit,
+		}
+		if sort.Descending {
+			return db.ListAccountsByExpirationDesc(
+				ctx, sqlc.ListAccountsByExpirationDescParams(
+					params,
+				),
+			)
+		}
+
+		return db.ListAccountsByExpirationAsc(ctx, params)
+
+	case AccountSortLabel:
+		// Accounts without a label are stored with a NULL label, which
+		// the queries sort as an empty one.
+		params := sqlc.ListAccountsByLabelAscParams{
+			HasStart: hasStart,
+			StartLabel: sql.NullString{
+				String: startAcct.Label.String,
+				Valid:  true,
+			},
+			StartAlias: startAcct.Alias,
+			Limit:      limit,
+		}
+		if sort.Descending {
+			return db.ListAccountsByLabelDesc(
+				ctx, sqlc.ListAccountsByLabelDescParams(params),
+			)
+		}
+
+		return db.ListAccountsByLabelAsc(ctx, params)
+
+	case AccountSortCreated:
+		params := sqlc.ListAccountsByCreatedAscParams{
+			HasStart:       hasStart,
+			StartCreatedAt: startAcct.CreatedAt,
+			StartAlias:     startAcct.Alias,
+			Limit:          limit,
+		}
+		if sort.Descending {
+			return db.ListAccountsByCreatedDesc(
+				ctx, sqlc.ListAccountsByCreatedDescParams(
+					params,
+				),
+			)
+		}
+
+		return db.ListAccountsByCreatedAsc(ctx, params)
+
+	default:
+		return nil, fmt.Errorf("unknown account sort field %v",
+			sort.Field)
+	}
+}
+
+// RemoveAccount finds an account by its ID and removes it from the DB.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) RemoveAccount(ctx context.Context, alias AccountID) error {
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		id, err := getAccountIDByAlias(ctx, db, alias)
+		if err != nil {
+			return err
+		}
+
+		return db.DeleteAccount(ctx, id)
+	})
+}
+
+// SweepAndRemoveAccount atomically credits the remaining balance of the account
+// with the given alias to the target account and removes the account.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) SweepAndRemoveAccount(ctx context.Context, alias,
+	target AccountID) (lnwire.MilliSatoshi, error) {
+
+	if alias == target {
+		return 0, ErrSweepToSelf
+	}
+
+	var (
+		writeTxOpts db.QueriesTxOptions
+		swept       lnwire.MilliSatoshi
+	)
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		swept = 0
+
+		id, err := getAccountIDByAlias(ctx, db, alias)
+		if err != nil {
+			return err
+		}
+
+		targetID, err := getAccountIDByAlias(ctx, db, target)
+		if err != nil {
+			return fmt.Errorf("error fetching sweep target: %w", err)
+		}
+
+		acct, err := db.GetAccount(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if acct.CurrentBalanceMsat > 0 {
+			targetAcct, err := db.GetAccount(ctx, targetID)
+			if err != nil {
+				return err
+			}
+
+			newBalance := targetAcct.CurrentBalanceMsat +
+				acct.CurrentBalanceMsat
+
+			err = s.setAccountBalance(
+				ctx, db, targetAcct, newBalance,
+				&LedgerEntry{Type: LedgerEntrySweep},
+			)
+			if err != nil {
+				return err
+			}
+
+			err = s.insertLedgerEntry(
+				ctx, db, acct.Alias, &LedgerEntry{
+					Type:  LedgerEntrySweep,
+					Delta: -acct.CurrentBalanceMsat,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			err = s.markAccountUpdated(ctx, db, targetID)
+			if err != nil {
+				return err
+			}
+
+			swept = lnwire.MilliSatoshi(acct.CurrentBalanceMsat)
 		}
 
 		return db.DeleteAccount(ctx, id)
 	})
+	if err != nil {
+		return 0, err
+	}
+
+	return swept, nil
+}
+
+// TransferAccountBalance atomically moves the given amount from the source
+// account to the destination account.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) TransferAccountBalance(ctx context.Context, source,
+	destination AccountID, amount lnwire.MilliSatoshi) error {
+
+	if source == destination {
+		return ErrTransferToSelf
+	}
+
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		sourceID, err := getAccountIDByAlias(ctx, db, source)
+		if err != nil {
+			return fmt.Errorf("error fetching transfer source: %w",
+				err)
+		}
+
+		destID, err := getAccountIDByAlias(ctx, db, destination)
+		if err != nil {
+			return fmt.Errorf("error fetching transfer "+
+				"destination: %w", err)
+		}
+
+		sourceAcct, err := db.GetAccount(ctx, sourceID)
+		if err != nil {
+			return err
+		}
+
+		if sourceAcct.CurrentBalanceMsat < int64(amount) {
+			return fmt.Errorf("%w: cannot transfer %v, source "+
+				"balance is %d mSAT", ErrAccBalanceInsufficient,
+				amount, sourceAcct.CurrentBalanceMsat)
+		}
+
+		destAcct, err := db.GetAccount(ctx, destID)
+		if err != nil {
+			return err
+		}
+
+		err = s.setAccountBalance(
+			ctx, db, sourceAcct,
+			sourceAcct.CurrentBalanceMsat-int64(amount),
+			&LedgerEntry{Type: LedgerEntryTransfer},
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.markAccountUpdated(ctx, db, sourceID); err != nil {
+			return err
+		}
+
+		err = s.setAccountBalance(
+			ctx, db, destAcct,
+			destAcct.CurrentBalanceMsat+int64(amount),
+			&LedgerEntry{Type: LedgerEntryTransfer},
+		)
+		if err != nil {
+			return err
+		}
+
+		return s.markAccountUpdated(ctx, db, destID)
+	})
+}
+
+// ImportAccount stores the given account under its own ID as the alias. If an
+// account with the alias already exists, ErrAccountAlreadyExists is returned
+// unless overwrite is set, in which case its balance and settings are
+// replaced.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) ImportAccount(ctx context.Context,
+	account *OffChainBalanceAccount, overwrite bool) error {
+
+	var labelVal sql.NullString
+	if len(account.Label) > 0 {
+		if err := validateLabel(account.Label); err != nil {
+			return err
+		}
+
+		labelVal = sql.NullString{
+			String: account.Label,
+			Valid:  true,
+		}
+	}
+
+	var nodeID []byte
+	if account.NodeID != (route.Vertex{}) {
+		nodeID = account.NodeID[:]
+	}
+
+	alias, err := account.ID.ToInt64()
+	if err != nil {
+		return fmt.Errorf("error converting account alias into "+
+			"int64: %w", err)
+	}
+
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		if labelVal.Valid {
+			other, err := db.GetAccountByLabel(ctx, labelVal)
+			if err == nil && other.Alias != alias {
+				return ErrLabelAlreadyExists
+			} else if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+		}
+
+		id, err := getAccountIDByAlias(ctx, db, account.ID)
+		switch {
+		case errors.Is(err, ErrAccNotFound):
+			return s.insertImportedAccount(
+				ctx, db, account, alias, labelVal, nodeID,
+			)
+
+		case err != nil:
+			return err
+
+		case !overwrite:
+			return fmt.Errorf("%w: %x", ErrAccountAlreadyExists,
+				account.ID[:])
+		}
+
+		existing, err := db.GetAccount(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.OverwriteAccount(ctx, sqlc.OverwriteAccountParams{
+			ID:                 id,
+			Type:               int16(account.Type),
+			InitialBalanceMsat: int64(account.InitialBalance),
+			Expiration:         account.ExpirationDate.UTC(),
+			Label:              labelVal,
+			MaxHtlcMsat:        int64(account.MaxHTLC),
+			SoftCapMsat:        int64(account.SoftCap),
+			Sandbox:            account.Sandbox,
+			KeysendBudgetMsat:  int64(account.KeysendBudget),
+			NodeID:             nodeID,
+			AmountlessMaxMsat: int64(
+				account.AmountlessInvoiceMax,
+			),
+			TopUpMinBalanceMsat: int64(account.TopUp.MinBalance),
+			TopUpAmountMsat:     int64(account.TopUp.Amount),
+			RateLimitMaxMsat: int64(
+				account.SpendRateLimit.MaxAmount,
+			),
+			RateLimitWindowSeconds: int64(
+				account.SpendRateLimit.Window.Seconds(),
+			),
+			ExpiryWarningSeconds: int64(
+				account.ExpiryWarning.Seconds(),
+			),
+			MaxOpenInvoices: int64(account.MaxOpenInvoices),
+			MaxInvoiceAmountMsat: int64(
+				account.MaxInvoiceAmount,
+			),
+			MaxPaymentMsat: int64(account.MaxPayment),
+		})
+		if err != nil {
+			return fmt.Errorf("overwriting account: %w", err)
+		}
+
+		err = setAllowedDestinations(
+			ctx, db, id, account.AllowedDestinations,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = s.setAccountBalance(
+			ctx, db, existing, account.CurrentBalance,
+			&LedgerEntry{Type: LedgerEntryImport},
+		)
+		if err != nil {
+			return err
+		}
+
+		return s.markAccountUpdated(ctx, db, id)
+	})
+}
+
+// insertImportedAccount inserts the given imported account with the given
+// alias and records its balance in its ledger.
+func (s *SQLStore) insertImportedAccount(ctx context.Context, db SQLQueries,
+	account *OffChainBalanceAccount, alias int64, label sql.NullString,
+	nodeID []byte) error {
+
+	id, err := db.InsertAccount(ctx, sqlc.InsertAccountParams{
+		Type:               int16(account.Type),
+		InitialBalanceMsat: int64(account.InitialBalance),
+		CurrentBalanceMsat: account.CurrentBalance,
+		Expiration:         account.ExpirationDate.UTC(),
+		LastUpdated:        s.clock.Now().UTC(),
+		Label:              label,
+		Alias:              alias,
+		MaxHtlcMsat:        int64(account.MaxHTLC),
+		SoftCapMsat:        int64(account.SoftCap),
+		Sandbox:            account.Sandbox,
+		KeysendBudgetMsat:  int64(account.KeysendBudget),
+		NodeID:             nodeID,
+		AmountlessMaxMsat:  int64(account.AmountlessInvoiceMax),
+		TopUpMinBalanceMsat: int64(
+			account.TopUp.MinBalance,
+		),
+		TopUpAmountMsat: int64(account.TopUp.Amount),
+		RateLimitMaxMsat: int64(
+			account.SpendRateLimit.MaxAmount,
+		),
+		RateLimitWindowSeconds: int64(
+			account.SpendRateLimit.Window.Seconds(),
+		),
+		ExpiryWarningSeconds: int64(account.ExpiryWarning.Seconds()),
+		MaxOpenInvoices:      int64(account.MaxOpenInvoices),
+		MaxInvoiceAmountMsat: int64(account.MaxInvoiceAmount),
+		CreatedAt:            s.clock.Now().UTC(),
+		MaxPaymentMsat:       int64(account.MaxPayment),
+	})
+	if err != nil {
+		return fmt.Errorf("inserting account: %w", err)
+	}
+
+	err = setAllowedDestinations(ctx, db, id, account.AllowedDestinations)
+	if err != nil {
+		return err
+	}
+
+	err = s.insertLedgerEntry(ctx, db, alias, &LedgerEntry{
+		Type:    LedgerEntryImport,
+		Delta:   account.CurrentBalance,
+		Balance: account.CurrentBalance,
+	})
+	if err != nil {
+		return fmt.Errorf("inserting ledger entry: %w", err)
+	}
+
+	return nil
+}
+
+// TopUpAccount atomically moves the top-up amount of the account with the
+// given alias from the source account to it if the balance of the account is
+// below the minimum of its top-up policy.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) TopUpAccount(ctx context.Context, alias,
+	source AccountID) (lnwire.MilliSatoshi, error) {
+
+	if alias == source {
+		return 0, fmt.Errorf("cannot top up an account from itself")
+	}
+
+	var (
+		writeTxOpts db.QueriesTxOptions
+		amount      lnwire.MilliSatoshi
+	)
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		amount = 0
+
+		id, err := getAccountIDByAlias(ctx, db, alias)
+		if err != nil {
+			return err
+		}
+
+		acct, err := db.GetAccount(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		topUp := acct.TopUpAmountMsat
+		if topUp == 0 || acct.CurrentBalanceMsat >=
+			acct.TopUpMinBalanceMsat {
+
+			return nil
+		}
+
+		sourceID, err := getAccountIDByAlias(ctx, db, source)
+		if err != nil {
+			return fmt.Errorf("error fetching top-up source: %w",
+				err)
+		}
+
+		sourceAcct, err := db.GetAccount(ctx, sourceID)
+		if err != nil {
+			return err
+		}
+
+		if sourceAcct.CurrentBalanceMsat < topUp {
+			available := lnwire.MilliSatoshi(
+				sourceAcct.CurrentBalanceMsat,
+			)
+
+			return fmt.Errorf("%w: %v needed but only %v available",
+				ErrTopUpSourceInsufficient,
+				lnwire.MilliSatoshi(topUp), available)
+		}
+
+		err = s.setAccountBalance(
+			ctx, db, sourceAcct, sourceAcct.CurrentBalanceMsat-topUp,
+			&LedgerEntry{Type: LedgerEntryTopUp},
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.markAccountUpdated(ctx, db, sourceID); err != nil {
+			return err
+		}
+
+		err = s.setAccountBalance(
+			ctx, db, acct, acct.CurrentBalanceMsat+topUp,
+			&LedgerEntry{Type: LedgerEntryTopUp},
+		)
+		if err != nil {
+			return err
+		}
+		if err := s.markAccountUpdated(ctx, db, id); err != nil {
+			return err
+		}
+
+		amount = lnwire.MilliSatoshi(topUp)
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return amount, nil
 }
 
 // UpsertAccountPayment updates or inserts a payment entry for the given
@@ -567,6 +1647,17 @@ func (s *SQLStore) UpsertAccountPayment(ctx context.Context, alias AccountID,
 
 		known = err == nil
 
+		var (
+			fee         lnwire.MilliSatoshi
+			destination route.Vertex
+		)
+		if known {
+			fee = lnwire.MilliSatoshi(payment.FeeMsat)
+			copy(destination[:], payment.Destination)
+		}
+		fee = opts.fee.UnwrapOr(fee)
+		destination = opts.destination.UnwrapOr(destination)
+
 		if known {
 			currStatus := lnrpc.Payment_PaymentStatus(
 				payment.Status,
@@ -603,6 +1694,8 @@ func (s *SQLStore) UpsertAccountPayment(ctx context.Context, alias AccountID,
 				Hash:           hash[:],
 				Status:         int16(status),
 				FullAmountMsat: int64(fullAmount),
+				FeeMsat:        int64(fee),
+				Destination:    destinationBytes(destination),
 			},
 		)
 		if err != nil {
@@ -620,15 +1713,13 @@ func (s *SQLStore) UpsertAccountPayment(ctx context.Context, alias AccountID,
 			newBalance := acct.CurrentBalanceMsat -
 				int64(fullAmount)
 
-			_, err = db.UpdateAccountBalance(
-				ctx, sqlc.UpdateAccountBalanceParams{
-					ID:                 id,
-					CurrentBalanceMsat: newBalance,
+			err = s.setAccountBalance(
+				ctx, db, acct, newBalance, &LedgerEntry{
+					Type:      LedgerEntryPayment,
+					Reference: hash,
 				},
 			)
-			if errors.Is(err, sql.ErrNoRows) {
-				return ErrAccNotFound
-			} else if err != nil {
+			if err != nil {
 				return err
 			}
 		}
@@ -680,6 +1771,191 @@ func (s *SQLStore) DeleteAccountPayment(ctx context.Context, alias AccountID,
 	})
 }
 
+// AddAccountEvent appends a lifecycle event to the history of the account
+// with the given ID.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) AddAccountEvent(ctx context.Context, alias AccountID,
+	event *AccountEvent) error {
+
+	aliasInt, err := alias.ToInt64()
+	if err != nil {
+		return fmt.Errorf("error converting account alias into "+
+			"int64: %w", err)
+	}
+
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		return db.InsertAccountEvent(ctx, sqlc.InsertAccountEventParams{
+			AccountAlias: aliasInt,
+			Type:         int16(event.Type),
+			Actor:        event.Actor,
+			Details:      event.Details,
+			CreatedAt:    event.Timestamp.UTC(),
+		})
+	})
+}
+
+// AccountHistory returns all lifecycle events of the account with the given ID
+// in the order they were added.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) AccountHistory(ctx context.Context, alias AccountID) (
+	[]*AccountEvent, error) {
+
+	aliasInt, err := alias.ToInt64()
+	if err != nil {
+		return nil, fmt.Errorf("error converting account alias into "+
+			"int64: %w", err)
+	}
+
+	var (
+		readTxOpts = db.NewQueryReadTx()
+		events     []*AccountEvent
+	)
+	err = s.db.ExecTx(ctx, &readTxOpts, func(db SQLQueries) error {
+		dbEvents, err := db.ListAccountEvents(ctx, aliasInt)
+		if err != nil {
+			return err
+		}
+
+		events = make([]*AccountEvent, len(dbEvents))
+		for i, dbEvent := range dbEvents {
+			events[i] = &AccountEvent{
+				Type:      AccountEventType(dbEvent.Type),
+				Timestamp: dbEvent.CreatedAt.UTC(),
+				Actor:     dbEvent.Actor,
+				Details:   dbEvent.Details,
+			}
+		}
+
+		return nil
+	})
+
+	return events, err
+}
+
+// AccountLedger returns all ledger entries of the account with the given ID in
+// the order they were recorded.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) AccountLedger(ctx context.Context, alias AccountID) (
+	[]*LedgerEntry, error) {
+
+	aliasInt, err := alias.ToInt64()
+	if err != nil {
+		return nil, fmt.Errorf("error converting account alias into "+
+			"int64: %w", err)
+	}
+
+	var (
+		readTxOpts = db.NewQueryReadTx()
+		entries    []*LedgerEntry
+	)
+	err = s.db.ExecTx(ctx, &readTxOpts, func(db SQLQueries) error {
+		dbEntries, err := db.ListAccountLedgerEntries(ctx, aliasInt)
+		if err != nil {
+			return err
+		}
+
+		entries = make([]*LedgerEntry, len(dbEntries))
+		for i, dbEntry := range dbEntries {
+			entries[i] = &LedgerEntry{
+				Type:      LedgerEntryType(dbEntry.Type),
+				Timestamp: dbEntry.CreatedAt.UTC(),
+				Delta:     dbEntry.AmountMsat,
+				Balance:   dbEntry.BalanceMsat,
+			}
+			copy(entries[i].Reference[:], dbEntry.Reference)
+		}
+
+		return nil
+	})
+
+	return entries, err
+}
+
+// AddCreditRule adds a rule that maps a memo prefix to an existing account.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) AddCreditRule(ctx context.Context, rule *CreditRule) error {
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		id, err := getAccountIDByAlias(ctx, db, rule.AccountID)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.GetAccountCreditRule(ctx, rule.MemoPrefix)
+		if err == nil {
+			return ErrCreditRuleExists
+		} else if !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		return db.InsertAccountCreditRule(
+			ctx, sqlc.InsertAccountCreditRuleParams{
+				MemoPrefix: rule.MemoPrefix,
+				AccountID:  id,
+				CreatedAt:  rule.CreatedAt.UTC(),
+			},
+		)
+	})
+}
+
+// CreditRules returns all credit rules ordered by their memo prefix.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) CreditRules(ctx context.Context) ([]*CreditRule, error) {
+	var (
+		readTxOpts = db.NewQueryReadTx()
+		rules      []*CreditRule
+	)
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLQueries) error {
+		dbRules, err := db.ListAccountCreditRules(ctx)
+		if err != nil {
+			return err
+		}
+
+		rules = make([]*CreditRule, len(dbRules))
+		for i, dbRule := range dbRules {
+			alias, err := AccountIDFromInt64(dbRule.Alias)
+			if err != nil {
+				return err
+			}
+
+			rules[i] = &CreditRule{
+				MemoPrefix: dbRule.MemoPrefix,
+				AccountID:  alias,
+				CreatedAt:  dbRule.CreatedAt.UTC(),
+			}
+		}
+
+		return nil
+	})
+
+	return rules, err
+}
+
+// RemoveCreditRule removes the rule for the given memo prefix.
+//
+// NOTE: This is part of the Store interface.
+func (s *SQLStore) RemoveCreditRule(ctx context.Context,
+	memoPrefix string) error {
+
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		_, err := db.GetAccountCreditRule(ctx, memoPrefix)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCreditRuleNotFound
+		} else if err != nil {
+			return err
+		}
+
+		return db.DeleteAccountCreditRule(ctx, memoPrefix)
+	})
+}
+
 // LastIndexes returns the last invoice add and settle index or
 // ErrNoInvoiceIndexKnown if no indexes are known yet.
 //
@@ -741,3 +2017,13 @@ func (s *SQLStore) Close() error {
 
 // A compile-time check to ensure that SQLStore implements the Store interface.
 var _ Store = (*SQLStore)(nil)
+
+// destinationBytes returns the serialized form of the given payment
+// destination, or nil if the destination is unknown.
+func destinationBytes(destination route.Vertex) []byte {
+	if destination == (route.Vertex{}) {
+		return nil
+	}
+
+	return destination[:]
+}
@@ -3,6 +3,8 @@ package accounts
 import (
 	"context"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"math"
+	"slices"
 	"testing"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/stretchr/testify/require"
 )
 
@@ -94,6 +97,7 @@ func TestAccountStore(t *testing.T) {
 	}
 	acct1.Invoices[lntypes.Hash{12, 34, 56, 78}] = struct{}{}
 	acct1.Invoices[lntypes.Hash{34, 56, 78, 90}] = struct{}{}
+	acct1.OpenInvoices = 2
 	acct1.CurrentBalance += 10000
 	acct1.CurrentBalance -= 5000
 
@@ -151,21 +155,37 @@ func assertEqualAccounts(t *testing.T, expected,
 	actualExpiry := actual.ExpirationDate
 	expectedUpdate := expected.LastUpdate
 	actualUpdate := actual.LastUpdate
+	expectedCreated := expected.CreatedAt
+	actualCreated := actual.CreatedAt
+
+	// The version depends on the number of updates made to the account,
+	// which TestAccountVersion covers, so we don't compare it here.
+	expectedVersion := expected.Version
+	actualVersion := actual.Version
 
 	expected.ExpirationDate = time.Time{}
 	expected.LastUpdate = time.Time{}
+	expected.CreatedAt = time.Time{}
+	expected.Version = 0
 	actual.ExpirationDate = time.Time{}
 	actual.LastUpdate = time.Time{}
+	actual.CreatedAt = time.Time{}
+	actual.Version = 0
 
 	require.Equal(t, expected, actual)
 	require.Equal(t, expectedExpiry.Unix(), actualExpiry.Unix())
 	require.Equal(t, expectedUpdate.Unix(), actualUpdate.Unix())
+	require.Equal(t, expectedCreated.Unix(), actualCreated.Unix())
 
 	// Restore the old values to not influence the tests.
 	expected.ExpirationDate = expectedExpiry
 	expected.LastUpdate = expectedUpdate
+	expected.CreatedAt = expectedCreated
+	expected.Version = expectedVersion
 	actual.ExpirationDate = actualExpiry
 	actual.LastUpdate = actualUpdate
+	actual.CreatedAt = actualCreated
+	actual.Version = actualVersion
 }
 
 // TestAccountUpdateMethods tests that all the Store methods that update an
@@ -238,6 +258,499 @@ func TestAccountUpdateMethods(t *testing.T) {
 		assertBalanceAndExpiry(newBalance, newExpiry)
 	})
 
+	t.Run("MaxHTLC", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		// Updating an account that doesn't exist should error out.
+		err := store.UpdateAccount(ctx, AccountID{}, &AccountUpdate{
+			MaxHTLC: fn.Some[lnwire.MilliSatoshi](1000),
+		})
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "foo", WithMaxHTLC(5000),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 5000, acct.MaxHTLC)
+
+		assertMaxHTLC := func(maxHTLC lnwire.MilliSatoshi) {
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, maxHTLC, dbAcct.MaxHTLC)
+		}
+
+		// The limit set on creation should have been persisted.
+		assertMaxHTLC(5000)
+
+		// Lower the limit.
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			MaxHTLC: fn.Some[lnwire.MilliSatoshi](1000),
+		})
+		require.NoError(t, err)
+		assertMaxHTLC(1000)
+
+		// Finally, remove the limit again.
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			MaxHTLC: fn.Some[lnwire.MilliSatoshi](0),
+		})
+		require.NoError(t, err)
+		assertMaxHTLC(0)
+	})
+
+	t.Run("SoftCap", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		// Updating an account that doesn't exist should error out.
+		err := store.UpdateAccount(ctx, AccountID{}, &AccountUpdate{
+			SoftCap: fn.Some[lnwire.MilliSatoshi](1000),
+		})
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "foo", WithSoftCap(5000),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 5000, acct.SoftCap)
+
+		assertSoftCap := func(softCap lnwire.MilliSatoshi) {
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, softCap, dbAcct.SoftCap)
+		}
+
+		// The soft cap set on creation should have been persisted.
+		assertSoftCap(5000)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			SoftCap: fn.Some[lnwire.MilliSatoshi](8000),
+		})
+		require.NoError(t, err)
+		assertSoftCap(8000)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			SoftCap: fn.Some[lnwire.MilliSatoshi](0),
+		})
+		require.NoError(t, err)
+		assertSoftCap(0)
+	})
+
+	t.Run("UpdateAccountLabel", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		// Updating an account that doesn't exist should error out.
+		err := store.UpdateAccountLabel(ctx, AccountID{}, "foo")
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		acct, err := store.NewAccount(ctx, 1000, time.Time{}, "foo")
+		require.NoError(t, err)
+		_, err = store.NewAccount(ctx, 0, time.Time{}, "bar")
+		require.NoError(t, err)
+
+		// The label of another account can't be taken.
+		err = store.UpdateAccountLabel(ctx, acct.ID, "bar")
+		require.ErrorIs(t, err, ErrLabelAlreadyExists)
+
+		// Neither can an empty label or one that looks like an ID.
+		err = store.UpdateAccountLabel(ctx, acct.ID, "")
+		require.Error(t, err)
+		err = store.UpdateAccountLabel(ctx, acct.ID, "0102030405060708")
+		require.ErrorContains(t, err, "mistaken for an account ID")
+
+		// Keeping the current label is fine.
+		err = store.UpdateAccountLabel(ctx, acct.ID, "foo")
+		require.NoError(t, err)
+
+		// Renaming only changes the label.
+		err = store.UpdateAccountLabel(ctx, acct.ID, "baz")
+		require.NoError(t, err)
+
+		dbAcct, err := store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Equal(t, "baz", dbAcct.Label)
+		require.EqualValues(t, 1000, dbAcct.CurrentBalance)
+		require.True(t, dbAcct.ExpirationDate.IsZero())
+	})
+
+	t.Run("Sandbox", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "test", WithSandbox(),
+		)
+		require.NoError(t, err)
+		require.True(t, acct.Sandbox)
+
+		prodAcct, err := store.NewAccount(ctx, 0, time.Time{}, "prod")
+		require.NoError(t, err)
+		require.False(t, prodAcct.Sandbox)
+
+		// The flag must survive a round trip through the store and
+		// other updates of the account.
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			SoftCap: fn.Some[lnwire.MilliSatoshi](1000),
+		})
+		require.NoError(t, err)
+
+		dbAcct, err := store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.True(t, dbAcct.Sandbox)
+
+		dbAcct, err = store.Account(ctx, prodAcct.ID)
+		require.NoError(t, err)
+		require.False(t, dbAcct.Sandbox)
+	})
+
+	t.Run("KeysendBudget", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "stream", WithKeysendBudget(5000),
+		)
+		require.NoError(t, err)
+		require.Equal(t, lnwire.MilliSatoshi(5000), acct.KeysendBudget)
+
+		dbAcct, err := store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Equal(
+			t, lnwire.MilliSatoshi(5000), dbAcct.KeysendBudget,
+		)
+
+		acct, err = store.NewAccount(ctx, 0, time.Time{}, "")
+		require.NoError(t, err)
+
+		dbAcct, err = store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Zero(t, dbAcct.KeysendBudget)
+	})
+
+	t.Run("NodeID", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		nodeID := route.Vertex{0x02, 0xaa, 0xbb}
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "node", WithNodeID(nodeID),
+		)
+		require.NoError(t, err)
+		require.Equal(t, nodeID, acct.NodeID)
+
+		dbAcct, err := store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Equal(t, nodeID, dbAcct.NodeID)
+
+		acct, err = store.NewAccount(ctx, 0, time.Time{}, "")
+		require.NoError(t, err)
+
+		dbAcct, err = store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Equal(t, route.Vertex{}, dbAcct.NodeID)
+	})
+
+	t.Run("AmountlessInvoiceMax", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "amountless",
+			WithAmountlessInvoiceMax(1234),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 1234, acct.AmountlessInvoiceMax)
+
+		dbAcct, err := store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 1234, dbAcct.AmountlessInvoiceMax)
+	})
+
+	t.Run("SpendRateLimit", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		// Updating an account that doesn't exist should error out.
+		err := store.UpdateAccount(ctx, AccountID{}, &AccountUpdate{
+			SpendRateLimit: fn.Some(SpendRateLimit{}),
+		})
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		limit := SpendRateLimit{MaxAmount: 5000, Window: time.Hour}
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "rate", WithSpendRateLimit(limit),
+		)
+		require.NoError(t, err)
+		require.Equal(t, limit, acct.SpendRateLimit)
+
+		assertLimit := func(limit SpendRateLimit) {
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, limit, dbAcct.SpendRateLimit)
+		}
+
+		// The limit set on creation should have been persisted.
+		assertLimit(limit)
+
+		limit = SpendRateLimit{MaxAmount: 8000, Window: time.Minute}
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			SpendRateLimit: fn.Some(limit),
+		})
+		require.NoError(t, err)
+		assertLimit(limit)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			SpendRateLimit: fn.Some(SpendRateLimit{}),
+		})
+		require.NoError(t, err)
+		assertLimit(SpendRateLimit{})
+	})
+
+	t.Run("ExpiryWarning", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		// Updating an account that doesn't exist should error out.
+		err := store.UpdateAccount(ctx, AccountID{}, &AccountUpdate{
+			ExpiryWarning: fn.Some[time.Duration](0),
+		})
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		acct, err := store.NewAccount(ctx, 0, time.Time{}, "warn")
+		require.NoError(t, err)
+		require.Zero(t, acct.ExpiryWarning)
+
+		assertWarning := func(warning time.Duration) {
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, warning, dbAcct.ExpiryWarning)
+		}
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			ExpiryWarning: fn.Some(24 * time.Hour),
+		})
+		require.NoError(t, err)
+		assertWarning(24 * time.Hour)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			ExpiryWarning: fn.Some[time.Duration](0),
+		})
+		require.NoError(t, err)
+		assertWarning(0)
+	})
+
+	t.Run("AllowedDestinations", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		// Updating an account that doesn't exist should error out.
+		err := store.UpdateAccount(ctx, AccountID{}, &AccountUpdate{
+			AllowedDestinations: fn.Some[[]route.Vertex](nil),
+		})
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		dest1 := route.Vertex{2, 1}
+		dest2 := route.Vertex{3, 1}
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "dests", WithAllowedDestinations(
+				[]route.Vertex{dest2, dest1, dest2},
+			),
+		)
+		require.NoError(t, err)
+
+		assertDests := func(dests []route.Vertex) {
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, dests, dbAcct.AllowedDestinations)
+		}
+
+		// The destinations are stored sorted and without duplicates.
+		assertDests([]route.Vertex{dest1, dest2})
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			AllowedDestinations: fn.Some([]route.Vertex{dest2}),
+		})
+		require.NoError(t, err)
+		assertDests([]route.Vertex{dest2})
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			AllowedDestinations: fn.Some[[]route.Vertex](nil),
+		})
+		require.NoError(t, err)
+		assertDests(nil)
+	})
+
+	t.Run("InvoiceLimits", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		// Updating an account that doesn't exist should error out.
+		err := store.UpdateAccount(ctx, AccountID{}, &AccountUpdate{
+			MaxOpenInvoices: fn.Some[uint32](0),
+		})
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		err = store.UpdateAccount(ctx, AccountID{}, &AccountUpdate{
+			MaxInvoiceAmount: fn.Some[lnwire.MilliSatoshi](0),
+		})
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "invoices", WithMaxOpenInvoices(3),
+			WithMaxInvoiceAmount(5000),
+		)
+		require.NoError(t, err)
+
+		assertLimits := func(maxOpen uint32,
+			maxAmount lnwire.MilliSatoshi) {
+
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, maxOpen, dbAcct.MaxOpenInvoices)
+			require.Equal(t, maxAmount, dbAcct.MaxInvoiceAmount)
+		}
+		assertLimits(3, 5000)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			MaxOpenInvoices: fn.Some[uint32](10),
+		})
+		require.NoError(t, err)
+		assertLimits(10, 5000)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			MaxInvoiceAmount: fn.Some[lnwire.MilliSatoshi](0),
+		})
+		require.NoError(t, err)
+		assertLimits(10, 0)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			MaxOpenInvoices: fn.Some[uint32](0),
+		})
+		require.NoError(t, err)
+		assertLimits(0, 0)
+	})
+
+	t.Run("MaxPayment", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		// Updating an account that doesn't exist should error out.
+		err := store.UpdateAccount(ctx, AccountID{}, &AccountUpdate{
+			MaxPayment: fn.Some[lnwire.MilliSatoshi](0),
+		})
+		require.ErrorIs(t, err, ErrAccNotFound)
+
+		acct, err := store.NewAccount(
+			ctx, 0, time.Time{}, "payments", WithMaxPayment(2000),
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 2000, acct.MaxPayment)
+
+		assertMaxPayment := func(maxPayment lnwire.MilliSatoshi) {
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, maxPayment, dbAcct.MaxPayment)
+		}
+		assertMaxPayment(2000)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			MaxPayment: fn.Some[lnwire.MilliSatoshi](3000),
+		})
+		require.NoError(t, err)
+		assertMaxPayment(3000)
+
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			MaxPayment: fn.Some[lnwire.MilliSatoshi](0),
+		})
+		require.NoError(t, err)
+		assertMaxPayment(0)
+	})
+
+	t.Run("UpdateAccount", func(t *testing.T) {
+		clock := clock.NewTestClock(time.Now())
+		store := NewTestDB(t, clock)
+
+		acct, err := store.NewAccount(ctx, 1000, time.Time{}, "all")
+		require.NoError(t, err)
+
+		// All fields are changed by a single update, which only
+		// increments the version of the account once.
+		var dest route.Vertex
+		dest[0] = 0x02
+		expiry := clock.Now().Add(time.Hour)
+		limit := SpendRateLimit{MaxAmount: 100, Window: time.Hour}
+		topUp := TopUpPolicy{MinBalance: 10, Amount: 20}
+		err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+			Balance:             fn.Some(int64(2000)),
+			Expiry:              fn.Some(expiry),
+			MaxHTLC:             fn.Some[lnwire.MilliSatoshi](1),
+			SoftCap:             fn.Some[lnwire.MilliSatoshi](2),
+			TopUp:               fn.Some(topUp),
+			SpendRateLimit:      fn.Some(limit),
+			ExpiryWarning:       fn.Some(time.Minute),
+			AllowedDestinations: fn.Some([]route.Vertex{dest}),
+			MaxOpenInvoices:     fn.Some[uint32](3),
+			MaxInvoiceAmount:    fn.Some[lnwire.MilliSatoshi](4),
+			MaxPayment:          fn.Some[lnwire.MilliSatoshi](5),
+		})
+		require.NoError(t, err)
+
+		dbAcct, err := store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.EqualValues(t, 2000, dbAcct.CurrentBalance)
+		require.WithinDuration(
+			t, expiry, dbAcct.ExpirationDate, time.Second,
+		)
+		require.EqualValues(t, 1, dbAcct.MaxHTLC)
+		require.EqualValues(t, 2, dbAcct.SoftCap)
+		require.Equal(t, topUp, dbAcct.TopUp)
+		require.Equal(t, limit, dbAcct.SpendRateLimit)
+		require.Equal(t, time.Minute, dbAcct.ExpiryWarning)
+		require.Equal(
+			t, []route.Vertex{dest}, dbAcct.AllowedDestinations,
+		)
+		require.EqualValues(t, 3, dbAcct.MaxOpenInvoices)
+		require.EqualValues(t, 4, dbAcct.MaxInvoiceAmount)
+		require.EqualValues(t, 5, dbAcct.MaxPayment)
+		require.Equal(t, acct.Version+1, dbAcct.Version)
+
+		// The balance change is recorded in the ledger.
+		entries, err := store.AccountLedger(ctx, acct.ID)
+		require.NoError(t, err)
+		last := entries[len(entries)-1]
+		require.Equal(t, LedgerEntryBalanceSet, last.Type)
+		require.EqualValues(t, 1000, last.Delta)
+	})
+
+	t.Run("OpenInvoices", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+		acct, err := store.NewAccount(ctx, 0, time.Time{}, "open")
+		require.NoError(t, err)
+		require.Zero(t, acct.OpenInvoices)
+
+		assertOpen := func(openInvoices uint32) {
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, openInvoices, dbAcct.OpenInvoices)
+		}
+
+		// Every new invoice is counted once.
+		hash1 := lntypes.Hash{1, 2, 3, 4}
+		hash2 := lntypes.Hash{5, 6, 7, 8}
+		require.NoError(t, store.AddAccountInvoice(ctx, acct.ID, hash1))
+		require.NoError(t, store.AddAccountInvoice(ctx, acct.ID, hash1))
+		require.NoError(t, store.AddAccountInvoice(ctx, acct.ID, hash2))
+		assertOpen(2)
+
+		// Closing an invoice of another account doesn't change the
+		// count, and the count never drops below zero.
+		err = store.CloseAccountInvoice(ctx, acct.ID, lntypes.Hash{9})
+		require.NoError(t, err)
+		assertOpen(2)
+
+		err = store.CloseAccountInvoice(ctx, acct.ID, hash1)
+		require.NoError(t, err)
+		assertOpen(1)
+
+		err = store.UpdateAccountOpenInvoices(ctx, acct.ID, 0)
+		require.NoError(t, err)
+		assertOpen(0)
+
+		err = store.CloseAccountInvoice(ctx, acct.ID, hash2)
+		require.NoError(t, err)
+		assertOpen(0)
+	})
+
 	t.Run("AddAccountInvoice", func(t *testing.T) {
 		store := NewTestDB(t, clock.NewTestClock(time.Now()))
 
@@ -559,23 +1072,887 @@ func TestAccountUpdateMethods(t *testing.T) {
 			},
 		})
 	})
-}
 
-// TestLastInvoiceIndexes makes sure the last known invoice indexes can be
-// stored and retrieved correctly.
-func TestLastInvoiceIndexes(t *testing.T) {
-	t.Parallel()
-	ctx := context.Background()
+	t.Run("AccountPayment settlement details", func(t *testing.T) {
+		store := NewTestDB(t, clock.NewTestClock(time.Now()))
 
-	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+		acct, err := store.NewAccount(ctx, 1000, time.Time{}, "foo")
+		require.NoError(t, err)
 
-	_, _, err := store.LastIndexes(ctx)
-	require.ErrorIs(t, err, ErrNoInvoiceIndexKnown)
+		assertPayment := func(hash lntypes.Hash,
+			expected *PaymentEntry) {
 
-	require.NoError(t, store.StoreLastIndexes(ctx, 7, 99))
+			dbAcct, err := store.Account(ctx, acct.ID)
+			require.NoError(t, err)
+			require.Equal(t, expected, dbAcct.Payments[hash])
+		}
+
+		hash := lntypes.Hash{1, 2, 3, 4}
+		dest := route.Vertex{2, 5, 6, 7}
+
+		// A payment that is still in flight has no details yet.
+		_, err = store.UpsertAccountPayment(
+			ctx, acct.ID, hash, 600, lnrpc.Payment_IN_FLIGHT,
+		)
+		require.NoError(t, err)
+		assertPayment(hash, &PaymentEntry{
+			Status:     lnrpc.Payment_IN_FLIGHT,
+			FullAmount: 600,
+		})
+
+		// Settling the payment records the fee and destination.
+		_, err = store.UpsertAccountPayment(
+			ctx, acct.ID, hash, 510, lnrpc.Payment_SUCCEEDED,
+			WithDebitAccount(), WithSettlementDetails(10_500, dest),
+		)
+		require.NoError(t, err)
+		assertPayment(hash, &PaymentEntry{
+			Status:      lnrpc.Payment_SUCCEEDED,
+			FullAmount:  510,
+			Fee:         10_500,
+			Destination: dest,
+		})
+
+		// A later update without details keeps the recorded ones.
+		_, err = store.UpsertAccountPayment(
+			ctx, acct.ID, hash, 0, lnrpc.Payment_SUCCEEDED,
+			WithPendingAmount(),
+		)
+		require.NoError(t, err)
+		assertPayment(hash, &PaymentEntry{
+			Status:      lnrpc.Payment_SUCCEEDED,
+			FullAmount:  510,
+			Fee:         10_500,
+			Destination: dest,
+		})
+	})
+}
+
+// TestLastInvoiceIndexes makes sure the last known invoice indexes can be
+// stored and retrieved correctly.
+func TestLastInvoiceIndexes(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	_, _, err := store.LastIndexes(ctx)
+	require.ErrorIs(t, err, ErrNoInvoiceIndexKnown)
+
+	require.NoError(t, store.StoreLastIndexes(ctx, 7, 99))
 
 	add, settle, err := store.LastIndexes(ctx)
 	require.NoError(t, err)
 	require.EqualValues(t, 7, add)
 	require.EqualValues(t, 99, settle)
 }
+
+// TestAccountHistory tests that the lifecycle events of an account are stored
+// in order and outlive the account itself.
+func TestAccountHistory(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	acct, err := store.NewAccount(ctx, 1000, time.Time{}, "history")
+	require.NoError(t, err)
+
+	// An account without any events has an empty history.
+	events, err := store.AccountHistory(ctx, acct.ID)
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	now := time.Unix(time.Now().Unix(), 0)
+	created := &AccountEvent{
+		Type:      AccountEventCreated,
+		Timestamp: now,
+		Actor:     "rpc:127.0.0.1:1234",
+		Details:   "initial balance 1000 mSAT",
+	}
+	renamed := &AccountEvent{
+		Type:      AccountEventLabelRenamed,
+		Timestamp: now.Add(time.Minute),
+		Actor:     defaultActor,
+		Details:   "label changed",
+	}
+	require.NoError(t, store.AddAccountEvent(ctx, acct.ID, created))
+	require.NoError(t, store.AddAccountEvent(ctx, acct.ID, renamed))
+
+	// Events of other accounts must not show up in the history.
+	other, err := store.NewAccount(ctx, 2000, time.Time{}, "other")
+	require.NoError(t, err)
+	require.NoError(t, store.AddAccountEvent(ctx, other.ID, created))
+
+	assertHistory := func(expected ...*AccountEvent) {
+		t.Helper()
+
+		events, err := store.AccountHistory(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Len(t, events, len(expected))
+
+		for i, event := range events {
+			require.Equal(t, expected[i].Type, event.Type)
+			require.Equal(t, expected[i].Actor, event.Actor)
+			require.Equal(t, expected[i].Details, event.Details)
+			require.True(
+				t, expected[i].Timestamp.Equal(event.Timestamp),
+			)
+		}
+	}
+	assertHistory(created, renamed)
+
+	// The history is kept after the account was removed.
+	require.NoError(t, store.RemoveAccount(ctx, acct.ID))
+
+	removed := &AccountEvent{
+		Type:      AccountEventRemoved,
+		Timestamp: now.Add(time.Hour),
+		Actor:     defaultActor,
+		Details:   "account removed",
+	}
+	require.NoError(t, store.AddAccountEvent(ctx, acct.ID, removed))
+	assertHistory(created, renamed, removed)
+}
+
+// TestCreditRules tests that credit rules can be added, listed and removed and
+// that they are removed together with their account.
+func TestCreditRules(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	acct, err := store.NewAccount(ctx, 1000, time.Time{}, "rules")
+	require.NoError(t, err)
+	other, err := store.NewAccount(ctx, 2000, time.Time{}, "other")
+	require.NoError(t, err)
+
+	rules, err := store.CreditRules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, rules)
+
+	now := time.Unix(time.Now().Unix(), 0)
+	topUp := &CreditRule{
+		MemoPrefix: "topup-",
+		AccountID:  acct.ID,
+		CreatedAt:  now,
+	}
+	donation := &CreditRule{
+		MemoPrefix: "donation",
+		AccountID:  other.ID,
+		CreatedAt:  now.Add(time.Minute),
+	}
+	require.NoError(t, store.AddCreditRule(ctx, topUp))
+	require.NoError(t, store.AddCreditRule(ctx, donation))
+
+	// A prefix can only be mapped to a single account.
+	err = store.AddCreditRule(ctx, &CreditRule{
+		MemoPrefix: "topup-",
+		AccountID:  other.ID,
+		CreatedAt:  now,
+	})
+	require.ErrorIs(t, err, ErrCreditRuleExists)
+
+	// Rules can only be added for existing accounts.
+	err = store.AddCreditRule(ctx, &CreditRule{
+		MemoPrefix: "unknown",
+		AccountID:  AccountID{1, 2, 3},
+		CreatedAt:  now,
+	})
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	assertRules := func(expected ...*CreditRule) {
+		t.Helper()
+
+		rules, err := store.CreditRules(ctx)
+		require.NoError(t, err)
+		require.Len(t, rules, len(expected))
+
+		for i, rule := range rules {
+			require.Equal(
+				t, expected[i].MemoPrefix, rule.MemoPrefix,
+			)
+			require.Equal(t, expected[i].AccountID, rule.AccountID)
+			require.True(
+				t, expected[i].CreatedAt.Equal(rule.CreatedAt),
+			)
+		}
+	}
+	assertRules(donation, topUp)
+
+	require.NoError(t, store.RemoveCreditRule(ctx, "donation"))
+	assertRules(topUp)
+
+	err = store.RemoveCreditRule(ctx, "donation")
+	require.ErrorIs(t, err, ErrCreditRuleNotFound)
+
+	// Removing the account also removes its rules.
+	require.NoError(t, store.RemoveAccount(ctx, acct.ID))
+	assertRules()
+}
+
+// TestAccountVersion tests that the version of an account is incremented by
+// every change to the account.
+func TestAccountVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	acct, err := store.NewAccount(ctx, 1000, time.Time{}, "version")
+	require.NoError(t, err)
+	require.EqualValues(t, 1, acct.Version)
+
+	assertVersion := func(version uint64) {
+		t.Helper()
+
+		acct, err := store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Equal(t, version, acct.Version)
+	}
+	assertVersion(1)
+
+	require.NoError(t, store.CreditAccount(ctx, acct.ID, 500))
+	assertVersion(2)
+
+	require.NoError(t, store.UpdateAccountLabel(ctx, acct.ID, "renamed"))
+	assertVersion(3)
+
+	err = store.UpdateAccountBalanceAndExpiry(
+		ctx, acct.ID, fn.Some(int64(2000)), fn.Some(time.Time{}),
+	)
+	require.NoError(t, err)
+	assertVersion(4)
+
+	// Failed updates don't change the version.
+	err = store.DebitAccount(ctx, acct.ID, 1_000_000)
+	require.Error(t, err)
+	assertVersion(4)
+}
+
+// TestSweepAndRemoveAccount tests that the balance of a removed account is
+// credited to the target account in the same step.
+func TestSweepAndRemoveAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	source, err := store.NewAccount(ctx, 1500, time.Time{}, "source")
+	require.NoError(t, err)
+	target, err := store.NewAccount(ctx, 500, time.Time{}, "target")
+	require.NoError(t, err)
+	empty, err := store.NewAccount(ctx, 0, time.Time{}, "empty")
+	require.NoError(t, err)
+
+	// An account can't be swept to itself or to an unknown account. The
+	// failed attempts must not remove the account.
+	_, err = store.SweepAndRemoveAccount(ctx, source.ID, source.ID)
+	require.ErrorIs(t, err, ErrSweepToSelf)
+
+	_, err = store.SweepAndRemoveAccount(ctx, source.ID, AccountID{1, 2})
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	_, err = store.Account(ctx, source.ID)
+	require.NoError(t, err)
+
+	swept, err := store.SweepAndRemoveAccount(ctx, source.ID, target.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 1500, swept)
+
+	_, err = store.Account(ctx, source.ID)
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	dbTarget, err := store.Account(ctx, target.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 2000, dbTarget.CurrentBalance)
+
+	// An account without a balance is removed without changing the
+	// target.
+	swept, err = store.SweepAndRemoveAccount(ctx, empty.ID, target.ID)
+	require.NoError(t, err)
+	require.Zero(t, swept)
+
+	_, err = store.Account(ctx, empty.ID)
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	dbTarget2, err := store.Account(ctx, target.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 2000, dbTarget2.CurrentBalance)
+	require.Equal(t, dbTarget.Version, dbTarget2.Version)
+}
+
+// TestTransferAccountBalance tests that balance is moved between two accounts
+// atomically and that transfers that would make the source balance negative
+// are rejected without changing either account.
+func TestTransferAccountBalance(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	source, err := store.NewAccount(ctx, 1500, time.Time{}, "source")
+	require.NoError(t, err)
+	dest, err := store.NewAccount(ctx, 500, time.Time{}, "dest")
+	require.NoError(t, err)
+
+	assertBalances := func(sourceBalance, destBalance int64) {
+		t.Helper()
+
+		dbSource, err := store.Account(ctx, source.ID)
+		require.NoError(t, err)
+		require.Equal(t, sourceBalance, dbSource.CurrentBalance)
+
+		dbDest, err := store.Account(ctx, dest.ID)
+		require.NoError(t, err)
+		require.Equal(t, destBalance, dbDest.CurrentBalance)
+	}
+
+	err = store.TransferAccountBalance(ctx, source.ID, source.ID, 100)
+	require.ErrorIs(t, err, ErrTransferToSelf)
+
+	err = store.TransferAccountBalance(ctx, source.ID, AccountID{1, 2}, 100)
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	err = store.TransferAccountBalance(ctx, source.ID, dest.ID, 1501)
+	require.ErrorIs(t, err, ErrAccBalanceInsufficient)
+	assertBalances(1500, 500)
+
+	err = store.TransferAccountBalance(ctx, source.ID, dest.ID, 1000)
+	require.NoError(t, err)
+	assertBalances(500, 1500)
+
+	// The whole remaining balance can be transferred.
+	err = store.TransferAccountBalance(ctx, source.ID, dest.ID, 500)
+	require.NoError(t, err)
+	assertBalances(0, 2000)
+
+	entries, err := store.AccountLedger(ctx, source.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, LedgerEntryTransfer, entries[1].Type)
+	require.EqualValues(t, -1000, entries[1].Delta)
+	require.EqualValues(t, 500, entries[1].Balance)
+
+	entries, err = store.AccountLedger(ctx, dest.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	require.Equal(t, LedgerEntryTransfer, entries[2].Type)
+	require.EqualValues(t, 500, entries[2].Delta)
+	require.EqualValues(t, 2000, entries[2].Balance)
+}
+
+// TestAccountsPage tests that paging through the accounts returns every
+// account exactly once, even if accounts are removed while paging.
+func TestAccountsPage(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	all := make(map[AccountID]struct{})
+	for i := 0; i < 7; i++ {
+		acct, err := store.NewAccount(ctx, 100, time.Time{}, "")
+		require.NoError(t, err)
+		all[acct.ID] = struct{}{}
+	}
+
+	page, next, err := store.AccountsPage(
+		ctx, AccountSort{}, fn.None[AccountID](), 10,
+	)
+	require.NoError(t, err)
+	require.Len(t, page, 7)
+	require.True(t, next.IsNone())
+
+	var (
+		seen  = make(map[AccountID]struct{})
+		start = fn.None[AccountID]()
+	)
+	for {
+		page, next, err := store.AccountsPage(
+			ctx, AccountSort{}, start, 3,
+		)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page), 3)
+
+		for _, acct := range page {
+			require.NotContains(t, seen, acct.ID)
+			seen[acct.ID] = struct{}{}
+		}
+
+		if next.IsNone() {
+			break
+		}
+
+		// Removing the account the next page starts with must not
+		// make the next page skip any other account.
+		if len(seen) == 3 {
+			nextID := next.UnsafeFromSome()
+			require.NoError(t, store.RemoveAccount(ctx, nextID))
+			delete(all, nextID)
+		}
+		start = next
+	}
+	require.Equal(t, all, seen)
+}
+
+// TestAccountsPageSorted tests that paging through the accounts in any sort
+// order returns every account exactly once, with accounts that have the same
+// sort key ordered by their alias.
+func TestAccountsPageSorted(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	now := time.Now()
+	testClock := clock.NewTestClock(now)
+	store := NewTestDB(t, testClock)
+
+	newAccount := func(label string, balance lnwire.MilliSatoshi,
+		expiry time.Duration, created time.Duration) {
+
+		var expirationDate time.Time
+		if expiry != 0 {
+			expirationDate = now.Add(expiry)
+		}
+
+		testClock.SetTime(now.Add(created))
+		_, err := store.NewAccount(ctx, balance, expirationDate, label)
+		require.NoError(t, err)
+	}
+	newAccount("a", 300, 2*time.Hour, 0)
+	newAccount("b", 100, 0, 0)
+	newAccount("c", 200, time.Hour, time.Minute)
+	newAccount("", 100, 3*time.Hour, time.Minute)
+	newAccount("d", 200, 0, 2*time.Minute)
+
+	alias := func(acct *OffChainBalanceAccount) int64 {
+		alias, err := acct.ID.ToInt64()
+		require.NoError(t, err)
+
+		return alias
+	}
+
+	tests := []struct {
+		field AccountSortField
+
+		// key returns the value of the sort field of an account.
+		key func(acct *OffChainBalanceAccount) int64
+
+		// keys are the values of the sort field in ascending order.
+		keys []int64
+	}{{
+		field: AccountSortBalance,
+		key: func(acct *OffChainBalanceAccount) int64 {
+			return acct.CurrentBalance
+		},
+		keys: []int64{100, 100, 200, 200, 300},
+	}, {
+		field: AccountSortExpiration,
+		key: func(acct *OffChainBalanceAccount) int64 {
+			if acct.ExpirationDate.IsZero() {
+				return math.MaxInt64
+			}
+
+			return acct.ExpirationDate.Unix() - now.Unix()
+		},
+		keys: []int64{3600, 7200, 10800, math.MaxInt64, math.MaxInt64},
+	}, {
+		field: AccountSortLabel,
+		key: func(acct *OffChainBalanceAccount) int64 {
+			if acct.Label == "" {
+				return 0
+			}
+
+			return int64(acct.Label[0])
+		},
+		keys: []int64{0, 'a', 'b', 'c', 'd'},
+	}, {
+		field: AccountSortCreated,
+		key: func(acct *OffChainBalanceAccount) int64 {
+			return acct.CreatedAt.Unix() - now.Unix()
+		},
+		keys: []int64{0, 0, 60, 60, 120},
+	}}
+
+	for _, test := range tests {
+		for _, descending := range []bool{false, true} {
+			sort := AccountSort{
+				Field:      test.field,
+				Descending: descending,
+			}
+
+			var (
+				all   []*OffChainBalanceAccount
+				start = fn.None[AccountID]()
+			)
+			for {
+				page, next, err := store.AccountsPage(
+					ctx, sort, start, 2,
+				)
+				require.NoError(t, err, sort)
+
+				all = append(all, page...)
+				if next.IsNone() {
+					break
+				}
+				require.Len(t, page, 2, sort)
+				start = next
+			}
+
+			expectedKeys := slices.Clone(test.keys)
+			if descending {
+				slices.Reverse(expectedKeys)
+			}
+
+			keys := make([]int64, 0, len(all))
+			for i, acct := range all {
+				keys = append(keys, test.key(acct))
+
+				if i == 0 || keys[i] != keys[i-1] {
+					continue
+				}
+
+				// Accounts with the same key are ordered by
+				// their alias in the same direction.
+				if descending {
+					require.Less(
+						t, alias(acct), alias(all[i-1]),
+						sort,
+					)
+				} else {
+					require.Greater(
+						t, alias(acct), alias(all[i-1]),
+						sort,
+					)
+				}
+			}
+			require.Equal(t, expectedKeys, keys, sort)
+		}
+	}
+
+	// A sorted page can't start at an account that doesn't exist.
+	sort := AccountSort{Field: AccountSortBalance}
+	page, next, err := store.AccountsPage(ctx, sort, fn.None[AccountID](), 1)
+	require.NoError(t, err)
+	require.NoError(t, store.RemoveAccount(ctx, page[0].ID))
+
+	_, _, err = store.AccountsPage(ctx, sort, fn.Some(page[0].ID), 1)
+	require.ErrorIs(t, err, ErrAccNotFound)
+
+	page, _, err = store.AccountsPage(ctx, sort, next, 1)
+	require.NoError(t, err)
+	require.Equal(t, next.UnsafeFromSome(), page[0].ID)
+}
+
+// TestImportAccount tests that accounts are imported with their own ID and
+// settings, that existing accounts are only overwritten on request and that
+// overwriting keeps their invoices and payments.
+func TestImportAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	existing, err := store.NewAccount(ctx, 1000, time.Time{}, "existing")
+	require.NoError(t, err)
+	err = store.AddAccountInvoice(ctx, existing.ID, lntypes.Hash{1})
+	require.NoError(t, err)
+
+	imported := &OffChainBalanceAccount{
+		ID:             AccountID{1, 2, 3, 4, 5, 6, 7, 8},
+		Type:           TypeInitialBalance,
+		InitialBalance: 5000,
+		CurrentBalance: 3000,
+		ExpirationDate: time.Unix(2_000_000_000, 0),
+		Label:          "imported",
+		MaxHTLC:        400,
+		SoftCap:        100,
+		Sandbox:        true,
+		KeysendBudget:  10,
+		TopUp: TopUpPolicy{
+			MinBalance: 500,
+			Amount:     1000,
+		},
+		SpendRateLimit: SpendRateLimit{
+			MaxAmount: 2000,
+			Window:    time.Hour,
+		},
+	}
+	require.NoError(t, store.ImportAccount(ctx, imported, false))
+
+	dbAcct, err := store.Account(ctx, imported.ID)
+	require.NoError(t, err)
+	require.Equal(t, imported.Label, dbAcct.Label)
+	require.Equal(t, imported.InitialBalance, dbAcct.InitialBalance)
+	require.Equal(t, imported.CurrentBalance, dbAcct.CurrentBalance)
+	require.True(t, imported.ExpirationDate.Equal(dbAcct.ExpirationDate))
+	require.Equal(t, imported.MaxHTLC, dbAcct.MaxHTLC)
+	require.Equal(t, imported.SoftCap, dbAcct.SoftCap)
+	require.Equal(t, imported.Sandbox, dbAcct.Sandbox)
+	require.Equal(t, imported.KeysendBudget, dbAcct.KeysendBudget)
+	require.Equal(t, imported.TopUp, dbAcct.TopUp)
+	require.Equal(t, imported.SpendRateLimit, dbAcct.SpendRateLimit)
+
+	entries, err := store.AccountLedger(ctx, imported.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, LedgerEntryImport, entries[0].Type)
+	require.EqualValues(t, 3000, entries[0].Delta)
+
+	// Importing an account that already exists is refused unless it
+	// should be overwritten.
+	overwrite := importableAccount(existing)
+	overwrite.CurrentBalance = 2500
+	overwrite.MaxHTLC = 300
+	err = store.ImportAccount(ctx, overwrite, false)
+	require.ErrorIs(t, err, ErrAccountAlreadyExists)
+
+	// Neither can an account take over the label of another account.
+	overwrite.Label = "imported"
+	err = store.ImportAccount(ctx, overwrite, true)
+	require.ErrorIs(t, err, ErrLabelAlreadyExists)
+
+	overwrite.Label = "existing"
+	require.NoError(t, store.ImportAccount(ctx, overwrite, true))
+
+	dbAcct, err = store.Account(ctx, existing.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 2500, dbAcct.CurrentBalance)
+	require.EqualValues(t, 300, dbAcct.MaxHTLC)
+	require.Contains(t, dbAcct.Invoices, lntypes.Hash{1})
+
+	entries, err = store.AccountLedger(ctx, existing.ID)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, LedgerEntryImport, entries[1].Type)
+	require.EqualValues(t, 1500, entries[1].Delta)
+	require.EqualValues(t, 2500, entries[1].Balance)
+}
+
+// TestNewAccountWithID tests that an account can be created with a given ID
+// instead of a random one, as long as the ID isn't used yet.
+func TestNewAccountWithID(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	id := AccountID{0xaa, 0xbb, 0xcc, 0xdd, 0, 0, 0, 1}
+	acct, err := store.NewAccount(
+		ctx, 1000, time.Time{}, "custom", WithAccountID(id),
+	)
+	require.NoError(t, err)
+	require.Equal(t, id, acct.ID)
+
+	dbAcct, err := store.Account(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, "custom", dbAcct.Label)
+	require.EqualValues(t, 1000, dbAcct.CurrentBalance)
+
+	// The ID can't be used for a second account.
+	_, err = store.NewAccount(
+		ctx, 1000, time.Time{}, "other", WithAccountID(id),
+	)
+	require.ErrorIs(t, err, ErrAccountAlreadyExists)
+
+	// The failed attempt didn't take the label.
+	other, err := store.NewAccount(ctx, 1000, time.Time{}, "other")
+	require.NoError(t, err)
+	require.NotEqual(t, id, other.ID)
+
+	accts, err := store.Accounts(ctx)
+	require.NoError(t, err)
+	require.Len(t, accts, 2)
+}
+
+// TestAccountLedger tests that every balance change of an account is recorded
+// in its ledger together with the resulting balance, and that changes that
+// don't affect the balance aren't.
+func TestAccountLedger(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	acct, err := store.NewAccount(ctx, 1000, time.Time{}, "ledger")
+	require.NoError(t, err)
+	other, err := store.NewAccount(ctx, 200, time.Time{}, "other")
+	require.NoError(t, err)
+
+	require.NoError(t, store.CreditAccount(ctx, acct.ID, 500))
+	require.NoError(t, store.CreditAccount(
+		ctx, acct.ID, 300, WithCreditInvoice(testHash),
+	))
+	require.NoError(t, store.DebitAccount(ctx, acct.ID, 100))
+
+	// A failed debit must not leave an entry behind.
+	require.Error(t, store.DebitAccount(ctx, acct.ID, 1_000_000))
+
+	_, err = store.UpsertAccountPayment(
+		ctx, acct.ID, testHash2, 400, lnrpc.Payment_SUCCEEDED,
+		WithDebitAccount(),
+	)
+	require.NoError(t, err)
+
+	// Neither an expiry update nor a payment that doesn't debit the
+	// account change the balance.
+	expiry := time.Now().Add(time.Hour)
+	err = store.UpdateAccountBalanceAndExpiry(
+		ctx, acct.ID, fn.None[int64](), fn.Some(expiry),
+	)
+	require.NoError(t, err)
+	_, err = store.UpsertAccountPayment(
+		ctx, acct.ID, testHash3, 50, lnrpc.Payment_IN_FLIGHT,
+	)
+	require.NoError(t, err)
+
+	err = store.UpdateAccountBalanceAndExpiry(
+		ctx, acct.ID, fn.Some(int64(2000)), fn.None[time.Time](),
+	)
+	require.NoError(t, err)
+
+	swept, err := store.SweepAndRemoveAccount(ctx, acct.ID, other.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 2000, swept)
+
+	assertLedger := func(id AccountID, expected ...*LedgerEntry) {
+		t.Helper()
+
+		entries, err := store.AccountLedger(ctx, id)
+		require.NoError(t, err)
+		require.Len(t, entries, len(expected))
+
+		for i, entry := range entries {
+			require.Equal(t, expected[i].Type, entry.Type)
+			require.Equal(t, expected[i].Delta, entry.Delta)
+			require.Equal(t, expected[i].Balance, entry.Balance)
+			require.Equal(t, expected[i].Reference, entry.Reference)
+			require.False(t, entry.Timestamp.IsZero())
+		}
+	}
+
+	// The ledger is kept after the account was removed.
+	assertLedger(acct.ID, &LedgerEntry{
+		Type:    LedgerEntryOpening,
+		Delta:   1000,
+		Balance: 1000,
+	}, &LedgerEntry{
+		Type:    LedgerEntryCredit,
+		Delta:   500,
+		Balance: 1500,
+	}, &LedgerEntry{
+		Type:      LedgerEntryInvoice,
+		Delta:     300,
+		Balance:   1800,
+		Reference: testHash,
+	}, &LedgerEntry{
+		Type:    LedgerEntryDebit,
+		Delta:   -100,
+		Balance: 1700,
+	}, &LedgerEntry{
+		Type:      LedgerEntryPayment,
+		Delta:     -400,
+		Balance:   1300,
+		Reference: testHash2,
+	}, &LedgerEntry{
+		Type:    LedgerEntryBalanceSet,
+		Delta:   700,
+		Balance: 2000,
+	}, &LedgerEntry{
+		Type:  LedgerEntrySweep,
+		Delta: -2000,
+	})
+
+	assertLedger(other.ID, &LedgerEntry{
+		Type:    LedgerEntryOpening,
+		Delta:   200,
+		Balance: 200,
+	}, &LedgerEntry{
+		Type:    LedgerEntrySweep,
+		Delta:   2000,
+		Balance: 2200,
+	})
+
+	// An unknown account has an empty ledger.
+	entries, err := store.AccountLedger(ctx, AccountID{1, 2, 3})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+// TestTopUpAccount tests that an account is only topped up if its balance is
+// below the minimum of its top-up policy and the source can fund the top-up,
+// and that the persisted policy can be updated.
+func TestTopUpAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	policy := TopUpPolicy{MinBalance: 1000, Amount: 500}
+	acct, err := store.NewAccount(
+		ctx, 2000, time.Time{}, "topped", WithTopUpPolicy(policy),
+	)
+	require.NoError(t, err)
+	require.Equal(t, policy, acct.TopUp)
+
+	source, err := store.NewAccount(ctx, 800, time.Time{}, "source")
+	require.NoError(t, err)
+
+	assertBalances := func(acctBalance, sourceBalance int64) {
+		t.Helper()
+
+		dbAcct, err := store.Account(ctx, acct.ID)
+		require.NoError(t, err)
+		require.Equal(t, acctBalance, dbAcct.CurrentBalance)
+
+		dbSource, err := store.Account(ctx, source.ID)
+		require.NoError(t, err)
+		require.Equal(t, sourceBalance, dbSource.CurrentBalance)
+	}
+
+	// Above the minimum balance, nothing is moved.
+	amount, err := store.TopUpAccount(ctx, acct.ID, source.ID)
+	require.NoError(t, err)
+	require.Zero(t, amount)
+	assertBalances(2000, 800)
+
+	// Below the minimum, the policy amount is moved from the source.
+	require.NoError(t, store.DebitAccount(ctx, acct.ID, 1500))
+	amount, err = store.TopUpAccount(ctx, acct.ID, source.ID)
+	require.NoError(t, err)
+	require.EqualValues(t, 500, amount)
+	assertBalances(1000, 300)
+
+	entries, err := store.AccountLedger(ctx, acct.ID)
+	require.NoError(t, err)
+	require.Equal(t, LedgerEntryTopUp, entries[len(entries)-1].Type)
+	require.EqualValues(t, 500, entries[len(entries)-1].Delta)
+
+	entries, err = store.AccountLedger(ctx, source.ID)
+	require.NoError(t, err)
+	require.Equal(t, LedgerEntryTopUp, entries[len(entries)-1].Type)
+	require.EqualValues(t, -500, entries[len(entries)-1].Delta)
+
+	// A source that can't fund the top-up leaves both balances unchanged.
+	require.NoError(t, store.DebitAccount(ctx, acct.ID, 100))
+	_, err = store.TopUpAccount(ctx, acct.ID, source.ID)
+	require.ErrorIs(t, err, ErrTopUpSourceInsufficient)
+	assertBalances(900, 300)
+
+	// Without a policy, the account isn't topped up anymore.
+	err = store.UpdateAccount(ctx, acct.ID, &AccountUpdate{
+		TopUp: fn.Some(TopUpPolicy{}),
+	})
+	require.NoError(t, err)
+
+	dbAcct, err := store.Account(ctx, acct.ID)
+	require.NoError(t, err)
+	require.False(t, dbAcct.TopUp.IsEnabled())
+
+	require.NoError(t, store.CreditAccount(ctx, source.ID, 1000))
+	amount, err = store.TopUpAccount(ctx, acct.ID, source.ID)
+	require.NoError(t, err)
+	require.Zero(t, amount)
+	assertBalances(900, 1300)
+}
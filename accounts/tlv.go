@@ -9,6 +9,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/tlv"
 )
 
@@ -22,6 +23,45 @@ const (
 	typeInvoices       tlv.Type = 7
 	typePayments       tlv.Type = 8
 	typeLabel          tlv.Type = 9
+	typeMaxHTLC        tlv.Type = 10
+	typeSoftCap        tlv.Type = 11
+	typeSandbox        tlv.Type = 12
+	typePaymentDetails tlv.Type = 13
+	typeKeysendBudget  tlv.Type = 14
+	typeVersion        tlv.Type = 15
+	typeNodeID         tlv.Type = 16
+	typeAmountlessMax  tlv.Type = 17
+	typeTopUpMin       tlv.Type = 18
+	typeTopUpAmount    tlv.Type = 19
+	typeRateLimitMax   tlv.Type = 20
+	typeRateLimitWin   tlv.Type = 21
+	typeExpiryWarning  tlv.Type = 22
+	typeAllowedDests   tlv.Type = 23
+	typeMaxOpenInvs    tlv.Type = 24
+	typeMaxInvoiceAmt  tlv.Type = 25
+	typeOpenInvoices   tlv.Type = 26
+	typeCreatedAt      tlv.Type = 27
+	typeMaxPayment     tlv.Type = 28
+)
+
+const (
+	typeEventType      tlv.Type = 1
+	typeEventTimestamp tlv.Type = 2
+	typeEventActor     tlv.Type = 3
+	typeEventDetails   tlv.Type = 4
+)
+
+const (
+	typeLedgerType      tlv.Type = 1
+	typeLedgerTimestamp tlv.Type = 2
+	typeLedgerDelta     tlv.Type = 3
+	typeLedgerBalance   tlv.Type = 4
+	typeLedgerReference tlv.Type = 5
+)
+
+const (
+	typeCreditRuleAccountID tlv.Type = 1
+	typeCreditRuleCreatedAt tlv.Type = 2
 )
 
 func serializeAccount(account *OffChainBalanceAccount) ([]byte, error) {
@@ -36,6 +76,7 @@ func serializeAccount(account *OffChainBalanceAccount) ([]byte, error) {
 		currentBalance = uint64(account.CurrentBalance)
 		lastUpdate     = uint64(account.LastUpdate.UnixNano())
 		label          = []byte(account.Label)
+		version        = account.Version
 	)
 
 	tlvRecords := []tlv.Record{
@@ -60,6 +101,132 @@ func serializeAccount(account *OffChainBalanceAccount) ([]byte, error) {
 		tlv.MakePrimitiveRecord(typeLabel, &label),
 	)
 
+	if account.MaxHTLC != 0 {
+		maxHTLC := uint64(account.MaxHTLC)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeMaxHTLC, &maxHTLC,
+		))
+	}
+
+	if account.SoftCap != 0 {
+		softCap := uint64(account.SoftCap)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeSoftCap, &softCap,
+		))
+	}
+
+	if account.Sandbox {
+		sandbox := uint8(1)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeSandbox, &sandbox,
+		))
+	}
+
+	// The settlement details of the payments are stored in a separate
+	// record so that accounts written by older versions can still be read.
+	details := paymentDetails(account.Payments)
+	if len(details) > 0 {
+		tlvRecords = append(tlvRecords, newPaymentDetailsMapRecord(
+			typePaymentDetails, &details,
+		))
+	}
+
+	if account.KeysendBudget != 0 {
+		keysendBudget := uint64(account.KeysendBudget)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeKeysendBudget, &keysendBudget,
+		))
+	}
+
+	tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+		typeVersion, &version,
+	))
+
+	if account.NodeID != (route.Vertex{}) {
+		nodeID := [33]byte(account.NodeID)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeNodeID, &nodeID,
+		))
+	}
+
+	if account.AmountlessInvoiceMax != 0 {
+		amountlessMax := uint64(account.AmountlessInvoiceMax)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeAmountlessMax, &amountlessMax,
+		))
+	}
+
+	if account.TopUp.IsEnabled() {
+		topUpMin := uint64(account.TopUp.MinBalance)
+		topUpAmount := uint64(account.TopUp.Amount)
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(typeTopUpMin, &topUpMin),
+			tlv.MakePrimitiveRecord(typeTopUpAmount, &topUpAmount),
+		)
+	}
+
+	if account.SpendRateLimit.IsEnabled() {
+		rateLimitMax := uint64(account.SpendRateLimit.MaxAmount)
+		rateLimitWin := uint64(account.SpendRateLimit.Window.Seconds())
+		tlvRecords = append(
+			tlvRecords,
+			tlv.MakePrimitiveRecord(typeRateLimitMax, &rateLimitMax),
+			tlv.MakePrimitiveRecord(typeRateLimitWin, &rateLimitWin),
+		)
+	}
+
+	if account.ExpiryWarning > 0 {
+		expiryWarning := uint64(account.ExpiryWarning.Seconds())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeExpiryWarning, &expiryWarning,
+		))
+	}
+
+	if len(account.AllowedDestinations) > 0 {
+		allowedDests := serializeDestinations(
+			account.AllowedDestinations,
+		)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeAllowedDests, &allowedDests,
+		))
+	}
+
+	if account.MaxOpenInvoices != 0 {
+		maxOpenInvs := account.MaxOpenInvoices
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeMaxOpenInvs, &maxOpenInvs,
+		))
+	}
+
+	if account.MaxInvoiceAmount != 0 {
+		maxInvoiceAmt := uint64(account.MaxInvoiceAmount)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeMaxInvoiceAmt, &maxInvoiceAmt,
+		))
+	}
+
+	if account.OpenInvoices != 0 {
+		openInvoices := account.OpenInvoices
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeOpenInvoices, &openInvoices,
+		))
+	}
+
+	if !account.CreatedAt.IsZero() {
+		createdAt := uint64(account.CreatedAt.UnixNano())
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeCreatedAt, &createdAt,
+		))
+	}
+
+	if account.MaxPayment != 0 {
+		maxPayment := uint64(account.MaxPayment)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeMaxPayment, &maxPayment,
+		))
+	}
+
 	tlvStream, err := tlv.NewStream(tlvRecords...)
 	if err != nil {
 		return nil, err
@@ -84,6 +251,25 @@ func deserializeAccount(content []byte) (*OffChainBalanceAccount, error) {
 		invoices       AccountInvoices
 		payments       AccountPayments
 		label          []byte
+		maxHTLC        uint64
+		softCap        uint64
+		sandbox        uint8
+		details        AccountPayments
+		keysendBudget  uint64
+		version        uint64
+		nodeID         [33]byte
+		amountlessMax  uint64
+		topUpMin       uint64
+		topUpAmount    uint64
+		rateLimitMax   uint64
+		rateLimitWin   uint64
+		expiryWarning  uint64
+		allowedDests   []byte
+		maxOpenInvs    uint32
+		maxInvoiceAmt  uint64
+		openInvoices   uint32
+		createdAt      uint64
+		maxPayment     uint64
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -96,6 +282,25 @@ func deserializeAccount(content []byte) (*OffChainBalanceAccount, error) {
 		newInvoiceEntryMapRecord(typeInvoices, &invoices),
 		newPaymentEntryMapRecord(typePayments, &payments),
 		tlv.MakePrimitiveRecord(typeLabel, &label),
+		tlv.MakePrimitiveRecord(typeMaxHTLC, &maxHTLC),
+		tlv.MakePrimitiveRecord(typeSoftCap, &softCap),
+		tlv.MakePrimitiveRecord(typeSandbox, &sandbox),
+		newPaymentDetailsMapRecord(typePaymentDetails, &details),
+		tlv.MakePrimitiveRecord(typeKeysendBudget, &keysendBudget),
+		tlv.MakePrimitiveRecord(typeVersion, &version),
+		tlv.MakePrimitiveRecord(typeNodeID, &nodeID),
+		tlv.MakePrimitiveRecord(typeAmountlessMax, &amountlessMax),
+		tlv.MakePrimitiveRecord(typeTopUpMin, &topUpMin),
+		tlv.MakePrimitiveRecord(typeTopUpAmount, &topUpAmount),
+		tlv.MakePrimitiveRecord(typeRateLimitMax, &rateLimitMax),
+		tlv.MakePrimitiveRecord(typeRateLimitWin, &rateLimitWin),
+		tlv.MakePrimitiveRecord(typeExpiryWarning, &expiryWarning),
+		tlv.MakePrimitiveRecord(typeAllowedDests, &allowedDests),
+		tlv.MakePrimitiveRecord(typeMaxOpenInvs, &maxOpenInvs),
+		tlv.MakePrimitiveRecord(typeMaxInvoiceAmt, &maxInvoiceAmt),
+		tlv.MakePrimitiveRecord(typeOpenInvoices, &openInvoices),
+		tlv.MakePrimitiveRecord(typeCreatedAt, &createdAt),
+		tlv.MakePrimitiveRecord(typeMaxPayment, &maxPayment),
 	)
 	if err != nil {
 		return nil, err
@@ -114,16 +319,231 @@ func deserializeAccount(content []byte) (*OffChainBalanceAccount, error) {
 		Invoices:       invoices,
 		Payments:       payments,
 		Label:          string(label),
+		MaxHTLC:        lnwire.MilliSatoshi(maxHTLC),
+		SoftCap:        lnwire.MilliSatoshi(softCap),
+		Sandbox:        sandbox == 1,
+		KeysendBudget:  lnwire.MilliSatoshi(keysendBudget),
+		Version:        version,
+		NodeID:         route.Vertex(nodeID),
+
+		AmountlessInvoiceMax: lnwire.MilliSatoshi(amountlessMax),
+		TopUp: TopUpPolicy{
+			MinBalance: lnwire.MilliSatoshi(topUpMin),
+			Amount:     lnwire.MilliSatoshi(topUpAmount),
+		},
+		SpendRateLimit: SpendRateLimit{
+			MaxAmount: lnwire.MilliSatoshi(rateLimitMax),
+			Window:    time.Duration(rateLimitWin) * time.Second,
+		},
+		ExpiryWarning:    time.Duration(expiryWarning) * time.Second,
+		MaxOpenInvoices:  maxOpenInvs,
+		MaxInvoiceAmount: lnwire.MilliSatoshi(maxInvoiceAmt),
+		OpenInvoices:     openInvoices,
+		MaxPayment:       lnwire.MilliSatoshi(maxPayment),
 	}
 	copy(account.ID[:], id)
 
+	account.AllowedDestinations, err = deserializeDestinations(allowedDests)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accounts that were stored before versions were introduced have
+	// never been changed as far as we know.
+	if _, ok := parsedTypes[typeVersion]; !ok {
+		account.Version = 1
+	}
+
+	for hash, detail := range details {
+		entry, ok := account.Payments[hash]
+		if !ok {
+			continue
+		}
+
+		entry.Fee = detail.Fee
+		entry.Destination = detail.Destination
+	}
+
 	if t, ok := parsedTypes[typeExpirationDate]; ok && t == nil {
 		account.ExpirationDate = time.Unix(0, int64(expirationDate))
 	}
 
+	// Accounts that were stored before creation times were recorded use
+	// their last update instead, which is kept once they are stored again.
+	account.CreatedAt = account.LastUpdate
+	if t, ok := parsedTypes[typeCreatedAt]; ok && t == nil {
+		account.CreatedAt = time.Unix(0, int64(createdAt))
+	}
+
 	return account, nil
 }
 
+func serializeAccountEvent(event *AccountEvent) ([]byte, error) {
+	var (
+		buf       bytes.Buffer
+		eventType = uint8(event.Type)
+		timestamp = uint64(event.Timestamp.UnixNano())
+		actor     = []byte(event.Actor)
+		details   = []byte(event.Details)
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeEventType, &eventType),
+		tlv.MakePrimitiveRecord(typeEventTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeEventActor, &actor),
+		tlv.MakePrimitiveRecord(typeEventDetails, &details),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func deserializeAccountEvent(content []byte) (*AccountEvent, error) {
+	var (
+		eventType uint8
+		timestamp uint64
+		actor     []byte
+		details   []byte
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeEventType, &eventType),
+		tlv.MakePrimitiveRecord(typeEventTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeEventActor, &actor),
+		tlv.MakePrimitiveRecord(typeEventDetails, &details),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(content)); err != nil {
+		return nil, err
+	}
+
+	return &AccountEvent{
+		Type:      AccountEventType(eventType),
+		Timestamp: time.Unix(0, int64(timestamp)),
+		Actor:     string(actor),
+		Details:   string(details),
+	}, nil
+}
+
+func serializeLedgerEntry(entry *LedgerEntry) ([]byte, error) {
+	var (
+		buf       bytes.Buffer
+		entryType = uint8(entry.Type)
+		timestamp = uint64(entry.Timestamp.UnixNano())
+		delta     = uint64(entry.Delta)
+		balance   = uint64(entry.Balance)
+		reference = [32]byte(entry.Reference)
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeLedgerType, &entryType),
+		tlv.MakePrimitiveRecord(typeLedgerTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeLedgerDelta, &delta),
+		tlv.MakePrimitiveRecord(typeLedgerBalance, &balance),
+		tlv.MakePrimitiveRecord(typeLedgerReference, &reference),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func deserializeLedgerEntry(content []byte) (*LedgerEntry, error) {
+	var (
+		entryType uint8
+		timestamp uint64
+		delta     uint64
+		balance   uint64
+		reference [32]byte
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeLedgerType, &entryType),
+		tlv.MakePrimitiveRecord(typeLedgerTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeLedgerDelta, &delta),
+		tlv.MakePrimitiveRecord(typeLedgerBalance, &balance),
+		tlv.MakePrimitiveRecord(typeLedgerReference, &reference),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(content)); err != nil {
+		return nil, err
+	}
+
+	return &LedgerEntry{
+		Type:      LedgerEntryType(entryType),
+		Timestamp: time.Unix(0, int64(timestamp)),
+		Delta:     int64(delta),
+		Balance:   int64(balance),
+		Reference: reference,
+	}, nil
+}
+
+func serializeCreditRule(rule *CreditRule) ([]byte, error) {
+	var (
+		buf       bytes.Buffer
+		accountID = rule.AccountID[:]
+		createdAt = uint64(rule.CreatedAt.UnixNano())
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeCreditRuleAccountID, &accountID),
+		tlv.MakePrimitiveRecord(typeCreditRuleCreatedAt, &createdAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func deserializeCreditRule(memoPrefix, content []byte) (*CreditRule, error) {
+	var (
+		accountID []byte
+		createdAt uint64
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeCreditRuleAccountID, &accountID),
+		tlv.MakePrimitiveRecord(typeCreditRuleCreatedAt, &createdAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(bytes.NewReader(content)); err != nil {
+		return nil, err
+	}
+
+	rule := &CreditRule{
+		MemoPrefix: string(memoPrefix),
+		CreatedAt:  time.Unix(0, int64(createdAt)),
+	}
+	copy(rule.AccountID[:], accountID)
+
+	return rule, nil
+}
+
 // newInvoiceEntryMapRecord returns a new TLV record for encoding the given map
 // of invoice hashes.
 func newInvoiceEntryMapRecord(tlvType tlv.Type,
@@ -263,3 +683,102 @@ func PaymentEntryMapDecoder(r io.Reader, val any, buf *[8]byte, _ uint64) error
 	}
 	return tlv.NewTypeForEncodingErr(val, "*AccountPayments")
 }
+
+// paymentDetails returns the subset of the given payments for which settlement
+// details are known.
+func paymentDetails(payments AccountPayments) AccountPayments {
+	details := make(AccountPayments)
+	for hash, entry := range payments {
+		if entry.Fee == 0 && entry.Destination == (route.Vertex{}) {
+			continue
+		}
+
+		details[hash] = entry
+	}
+
+	return details
+}
+
+// newPaymentDetailsMapRecord returns a new TLV record for encoding the
+// settlement details of the given map of payment entries.
+func newPaymentDetailsMapRecord(tlvType tlv.Type,
+	hashMap *AccountPayments) tlv.Record {
+
+	recordSize := func() uint64 {
+		// We have a 32-byte hash, 8 bytes for the fee and a 33-byte
+		// destination public key for each entry.
+		return uint64(len(*hashMap) * (lntypes.HashSize + 8 + 33))
+	}
+	return tlv.MakeDynamicRecord(
+		tlvType, hashMap, recordSize, PaymentDetailsMapEncoder,
+		PaymentDetailsMapDecoder,
+	)
+}
+
+// PaymentDetailsMapEncoder encodes the fee and destination of a map of payment
+// entries.
+func PaymentDetailsMapEncoder(w io.Writer, val any, buf *[8]byte) error {
+	if t, ok := val.(*AccountPayments); ok {
+		if err := tlv.WriteVarInt(w, uint64(len(*t)), buf); err != nil {
+			return err
+		}
+		for hash, entry := range *t {
+			hash := [32]byte(hash)
+
+			if err := tlv.EBytes32(w, &hash, buf); err != nil {
+				return err
+			}
+
+			err := tlv.EUint64T(w, uint64(entry.Fee), buf)
+			if err != nil {
+				return err
+			}
+
+			dest := [33]byte(entry.Destination)
+			if err := tlv.EBytes33(w, &dest, buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "*AccountPayments")
+}
+
+// PaymentDetailsMapDecoder decodes the fee and destination of a map of payment
+// entries. Only the Fee and Destination fields of the decoded entries are set.
+func PaymentDetailsMapDecoder(r io.Reader, val any, buf *[8]byte,
+	_ uint64) error {
+
+	if typ, ok := val.(*AccountPayments); ok {
+		numItems, err := tlv.ReadVarInt(r, buf)
+		if err != nil {
+			return err
+		}
+
+		entries := make(AccountPayments, numItems)
+		for i := uint64(0); i < numItems; i++ {
+			var item [32]byte
+			if err := tlv.DBytes32(r, &item, buf, 32); err != nil {
+				return err
+			}
+
+			var fee uint64
+			if err := tlv.DUint64(r, &fee, buf, 8); err != nil {
+				return err
+			}
+
+			var dest [33]byte
+			if err := tlv.DBytes33(r, &dest, buf, 33); err != nil {
+				return err
+			}
+
+			entries[item] = &PaymentEntry{
+				Fee:         lnwire.MilliSatoshi(fee),
+				Destination: dest,
+			}
+		}
+		*typ = entries
+		return nil
+	}
+	return tlv.NewTypeForEncodingErr(val, "*AccountPayments")
+}
@@ -0,0 +1,111 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TopUpPolicy describes when and by how much an account is topped up
+// automatically from the top-up source account.
+type TopUpPolicy struct {
+	// MinBalance is the balance in millisatoshis below which the account
+	// is topped up.
+	MinBalance lnwire.MilliSatoshi
+
+	// Amount is the amount in millisatoshis the account is credited with
+	// on every top-up.
+	Amount lnwire.MilliSatoshi
+}
+
+// IsEnabled returns true if the policy tops up the account.
+func (p TopUpPolicy) IsEnabled() bool {
+	return p.Amount > 0
+}
+
+// Validate checks that the policy is either disabled or sets both a minimum
+// balance and a top-up amount.
+func (p TopUpPolicy) Validate() error {
+	switch {
+	case p.MinBalance == 0 && p.Amount == 0:
+		return nil
+
+	case p.MinBalance == 0:
+		return fmt.Errorf("a top-up policy requires a minimum balance")
+
+	case p.Amount == 0:
+		return fmt.Errorf("a top-up policy requires a top-up amount")
+	}
+
+	return nil
+}
+
+// describeTopUpPolicy returns a description of the given top-up policy for the
+// account history.
+func describeTopUpPolicy(policy TopUpPolicy) string {
+	if !policy.IsEnabled() {
+		return "top-up policy removed"
+	}
+
+	return fmt.Sprintf("top-up policy set to %v below %v", policy.Amount,
+		policy.MinBalance)
+}
+
+// needsTopUp returns true if the given account has a top-up policy and its
+// balance is below the minimum of the policy.
+func needsTopUp(acct *OffChainBalanceAccount) bool {
+	return acct.TopUp.IsEnabled() &&
+		acct.CurrentBalance < int64(acct.TopUp.MinBalance)
+}
+
+// WithTopUpSource is a functional option that can be passed to NewService to
+// fund the automatic top-ups of accounts from the account with the given ID.
+// Without a source, top-up policies have no effect.
+func WithTopUpSource(source AccountID) ServiceOption {
+	return func(s *InterceptorService) {
+		s.topUpSource = source
+	}
+}
+
+// topUpAccount tops up the account with the given ID from the top-up source if
+// its balance dropped below the minimum of its top-up policy. The check and
+// the transfer happen in a single store transaction, so concurrent debits
+// can't top up the account twice for the same shortfall. The debit that
+// triggered the top-up already happened, so a failed top-up is only logged.
+//
+// NOTE: The store lock must be held when calling this method.
+func (s *InterceptorService) topUpAccount(ctx context.Context, id AccountID) {
+	if s.topUpSource == zeroID || s.topUpSource == id {
+		return
+	}
+
+	amount, err := s.store.TopUpAccount(ctx, id, s.topUpSource)
+	switch {
+	case errors.Is(err, ErrTopUpSourceInsufficient):
+		log.Warnf("Unable to top up account %x: %v", id[:], err)
+		return
+
+	case err != nil:
+		log.Errorf("Unable to top up account %x: %v", id[:], err)
+		return
+
+	case amount == 0:
+		return
+	}
+
+	log.Infof("Topped up account %x with %v from account %x", id[:],
+		amount, s.topUpSource[:])
+
+	s.recordEvent(
+		ctx, id, AccountEventToppedUp, "topped up %v from account %x",
+		amount, s.topUpSource[:],
+	)
+	s.recordEvent(
+		ctx, s.topUpSource, AccountEventBalanceUpdated,
+		"debited %v to top up account %x", amount, id[:],
+	)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, id)
+	s.publishLifecycleEvent(ctx, AccountLifecycleUpdated, s.topUpSource)
+}
@@ -0,0 +1,109 @@
+package accounts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTopUpPolicyValidate tests that a top-up policy must set both a minimum
+// balance and an amount or neither.
+func TestTopUpPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, TopUpPolicy{}.Validate())
+	require.NoError(t, TopUpPolicy{MinBalance: 1, Amount: 2}.Validate())
+	require.Error(t, TopUpPolicy{MinBalance: 1}.Validate())
+	require.Error(t, TopUpPolicy{Amount: 2}.Validate())
+}
+
+// TestAccountTopUp tests that an account is topped up from the top-up source
+// once a settled payment brings its balance below the minimum balance of its
+// policy, and that a top-up is skipped if the source can't fund it.
+func TestAccountTopUp(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lndMock := newMockLnd()
+	routerMock := newMockRouter()
+	errFunc := func(err error) {
+		lndMock.mainErrChan <- err
+	}
+	store := NewTestDB(t, clock.NewTestClock(time.Now()))
+
+	master, err := store.NewAccount(ctx, 3000, time.Time{}, "master")
+	require.NoError(t, err)
+
+	service, err := NewService(
+		store, errFunc, WithTopUpSource(master.ID),
+	)
+	require.NoError(t, err)
+
+	err = service.Start(ctx, lndMock, routerMock, chainParams)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, service.Stop())
+	})
+
+	acct, err := service.NewAccount(
+		ctx, 5000, time.Time{}, "customer", WithTopUpPolicy(TopUpPolicy{
+			MinBalance: 3000,
+			Amount:     2000,
+		}),
+	)
+	require.NoError(t, err)
+
+	pay := func(hash lntypes.Hash, amount int64) {
+		t.Helper()
+
+		err := service.TrackPayment(ctx, acct.ID, hash, 0)
+		require.NoError(t, err)
+		require.Equal(t, hash, <-routerMock.paymentReq)
+
+		routerMock.paymentChans[hash] <- lndclient.PaymentStatus{
+			State: lnrpc.Payment_SUCCEEDED,
+			Value: lnwire.MilliSatoshi(amount),
+		}
+	}
+
+	assertBalances := func(acctBalance, masterBalance int64) {
+		t.Helper()
+
+		assertEventually(t, func() bool {
+			dbAcct, err := service.Account(ctx, acct.ID)
+			require.NoError(t, err)
+
+			dbMaster, err := service.Account(ctx, master.ID)
+			require.NoError(t, err)
+
+			return dbAcct.CurrentBalance == acctBalance &&
+				dbMaster.CurrentBalance == masterBalance
+		})
+	}
+
+	// A payment that keeps the balance above the minimum doesn't top up
+	// the account.
+	pay(testHash, 1000)
+	assertBalances(4000, 3000)
+
+	// Dropping below the minimum tops the account up by the policy amount.
+	pay(testHash2, 1500)
+	assertBalances(4500, 1000)
+
+	history, err := service.AccountHistory(ctx, acct.ID)
+	require.NoError(t, err)
+	require.Equal(t, AccountEventToppedUp, history[len(history)-1].Type)
+
+	// Once the source can't fund the top-up anymore, the account stays
+	// below the minimum, but the service keeps running.
+	pay(testHash3, 2000)
+	assertBalances(2500, 1000)
+	require.True(t, service.IsRunning())
+}
@@ -0,0 +1,177 @@
+package accounts
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+// StoreIssueType denotes the kind of inconsistency that was found in the
+// accounts store.
+type StoreIssueType string
+
+const (
+	// IssueDuplicateLabel is reported for every account that shares its
+	// label with another account.
+	IssueDuplicateLabel StoreIssueType = "duplicate_label"
+
+	// IssueInvalidLabel is reported for an account with a label that can
+	// be mistaken for an account ID.
+	IssueInvalidLabel StoreIssueType = "invalid_label"
+
+	// IssueNegativeBalance is reported for an account with a balance below
+	// zero.
+	IssueNegativeBalance StoreIssueType = "negative_balance"
+
+	// IssueSharedInvoice is reported for an invoice that is associated
+	// with more than one account and would therefore credit all of them.
+	IssueSharedInvoice StoreIssueType = "shared_invoice"
+
+	// IssueSharedPayment is reported for a payment that is associated with
+	// more than one account and would therefore debit all of them.
+	IssueSharedPayment StoreIssueType = "shared_payment"
+
+	// IssueUntrackedPayment is reported for a payment that is still in
+	// flight according to the store but isn't tracked by the running
+	// account service. Such a payment is never debited if it succeeds. A
+	// payment that is being sent while the store is verified can be
+	// reported briefly, until lnd has accepted it and tracking starts.
+	IssueUntrackedPayment StoreIssueType = "untracked_payment"
+)
+
+// StoreIssue is a single inconsistency that was found in the accounts store.
+type StoreIssue struct {
+	// Type is the kind of inconsistency.
+	Type StoreIssueType
+
+	// AccountID is the account the issue was found on.
+	AccountID AccountID
+
+	// Description is a human-readable description of the issue.
+	Description string
+}
+
+// StoreReport is the result of verifying the accounts store.
+type StoreReport struct {
+	// NumAccounts is the number of accounts that were checked.
+	NumAccounts int
+
+	// Issues are all inconsistencies that were found.
+	Issues []*StoreIssue
+}
+
+// VerifyStore checks all accounts in the store for inconsistencies and
+// returns a report of all issues found. The store is only read, so this is
+// safe to call while the service is running.
+func (s *InterceptorService) VerifyStore(ctx context.Context) (*StoreReport,
+	error) {
+
+	s.RLock()
+	defer s.RUnlock()
+
+	accounts, err := s.store.Accounts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching accounts: %w", err)
+	}
+
+	// Sort the accounts so that the report is deterministic.
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].ID.String() < accounts[j].ID.String()
+	})
+
+	// Pending payments are only tracked while the service is running, so
+	// we can't say anything about them otherwise.
+	var pending map[lntypes.Hash]*trackedPayment
+	if s.isRunningUnsafe() {
+		pending = s.pendingPayments
+	}
+
+	return verifyAccounts(accounts, pending), nil
+}
+
+// verifyAccounts checks the given accounts for inconsistencies. If pending is
+// non-nil, it must contain all payments that are currently tracked by the
+// service.
+func verifyAccounts(accounts []*OffChainBalanceAccount,
+	pending map[lntypes.Hash]*trackedPayment) *StoreReport {
+
+	report := &StoreReport{
+		NumAccounts: len(accounts),
+	}
+	addIssue := func(acct *OffChainBalanceAccount, issueType StoreIssueType,
+		format string, args ...any) {
+
+		report.Issues = append(report.Issues, &StoreIssue{
+			Type:        issueType,
+			AccountID:   acct.ID,
+			Description: fmt.Sprintf(format, args...),
+		})
+	}
+
+	var (
+		labels   = make(map[string]int)
+		invoices = make(map[lntypes.Hash]int)
+		payments = make(map[lntypes.Hash]int)
+	)
+	for _, acct := range accounts {
+		if acct.Label != "" {
+			labels[acct.Label]++
+		}
+		for hash := range acct.Invoices {
+			invoices[hash]++
+		}
+		for hash := range acct.Payments {
+			payments[hash]++
+		}
+	}
+
+	for _, acct := range accounts {
+		if acct.Label != "" {
+			if labels[acct.Label] > 1 {
+				addIssue(acct, IssueDuplicateLabel, "label "+
+					"'%s' is used by %d accounts",
+					acct.Label, labels[acct.Label])
+			}
+
+			if err := validateLabel(acct.Label); err != nil {
+				addIssue(acct, IssueInvalidLabel, "%v", err)
+			}
+		}
+
+		if acct.CurrentBalance < 0 {
+			addIssue(acct, IssueNegativeBalance, "balance is %d "+
+				"msat", acct.CurrentBalance)
+		}
+
+		for hash := range acct.Invoices {
+			if invoices[hash] > 1 {
+				addIssue(acct, IssueSharedInvoice, "invoice "+
+					"%v is associated with %d accounts",
+					hash, invoices[hash])
+			}
+		}
+
+		for hash, entry := range acct.Payments {
+			if payments[hash] > 1 {
+				addIssue(acct, IssueSharedPayment, "payment "+
+					"%v is associated with %d accounts",
+					hash, payments[hash])
+			}
+
+			if pending == nil || !inflightState(entry.Status) {
+				continue
+			}
+
+			tracked, ok := pending[hash]
+			if !ok || tracked.accountID != acct.ID {
+				addIssue(acct, IssueUntrackedPayment,
+					"payment %v is %v but not tracked",
+					hash, entry.Status)
+			}
+		}
+	}
+
+	return report
+}
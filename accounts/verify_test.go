@@ -0,0 +1,97 @@
+package accounts
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/require"
+)
+
+// TestVerifyAccounts tests that all kinds of inconsistencies are detected in a
+// set of accounts.
+func TestVerifyAccounts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		invoice = lntypes.Hash{1}
+		shared  = lntypes.Hash{2}
+		tracked = lntypes.Hash{3}
+		stale   = lntypes.Hash{4}
+		settled = lntypes.Hash{5}
+	)
+
+	healthy := &OffChainBalanceAccount{
+		ID:             AccountID{1},
+		CurrentBalance: 1000,
+		Label:          "healthy",
+		Invoices:       AccountInvoices{invoice: {}},
+		Payments: AccountPayments{
+			tracked: {Status: lnrpc.Payment_IN_FLIGHT},
+			settled: {Status: lnrpc.Payment_SUCCEEDED},
+		},
+	}
+	broken1 := &OffChainBalanceAccount{
+		ID:             AccountID{2},
+		CurrentBalance: -5,
+		Label:          "dup",
+		Invoices:       AccountInvoices{},
+		Payments: AccountPayments{
+			shared: {Status: lnrpc.Payment_SUCCEEDED},
+			stale:  {Status: lnrpc.Payment_UNKNOWN},
+		},
+	}
+	broken2 := &OffChainBalanceAccount{
+		ID:             AccountID{3},
+		CurrentBalance: 0,
+		Label:          "dup",
+		Invoices:       AccountInvoices{},
+		Payments: AccountPayments{
+			shared: {Status: lnrpc.Payment_SUCCEEDED},
+		},
+	}
+	accounts := []*OffChainBalanceAccount{healthy, broken1, broken2}
+
+	pending := map[lntypes.Hash]*trackedPayment{
+		tracked: {accountID: healthy.ID, hash: tracked},
+	}
+
+	issueTypes := func(report *StoreReport) map[AccountID][]StoreIssueType {
+		types := make(map[AccountID][]StoreIssueType)
+		for _, issue := range report.Issues {
+			types[issue.AccountID] = append(
+				types[issue.AccountID], issue.Type,
+			)
+		}
+
+		return types
+	}
+
+	report := verifyAccounts(accounts, pending)
+	require.Equal(t, 3, report.NumAccounts)
+
+	types := issueTypes(report)
+	require.Empty(t, types[healthy.ID])
+	require.ElementsMatch(t, []StoreIssueType{
+		IssueDuplicateLabel, IssueNegativeBalance, IssueSharedPayment,
+		IssueUntrackedPayment,
+	}, types[broken1.ID])
+	require.ElementsMatch(t, []StoreIssueType{
+		IssueDuplicateLabel, IssueSharedPayment,
+	}, types[broken2.ID])
+
+	// Without the pending payments of a running service, tracking can't
+	// be verified.
+	types = issueTypes(verifyAccounts(accounts, nil))
+	require.NotContains(t, types[broken1.ID], IssueUntrackedPayment)
+
+	// A shared invoice and a label that looks like an ID are detected as
+	// well.
+	broken2.Invoices[invoice] = struct{}{}
+	broken2.Label = "0102030405060708"
+	types = issueTypes(verifyAccounts(accounts, pending))
+	require.Contains(t, types[healthy.ID], IssueSharedInvoice)
+	require.ElementsMatch(t, []StoreIssueType{
+		IssueInvalidLabel, IssueSharedInvoice, IssueSharedPayment,
+	}, types[broken2.ID])
+}
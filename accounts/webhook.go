@@ -0,0 +1,197 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultSpendWebhookBatchInterval is the default interval in which
+	// debit events are collected before they are sent to the spend webhook
+	// in a single request.
+	DefaultSpendWebhookBatchInterval = time.Second
+
+	// spendWebhookTimeout is the maximum time a single request to the
+	// spend webhook is allowed to take.
+	spendWebhookTimeout = 10 * time.Second
+)
+
+// DebitEvent describes a single debit of an account.
+type DebitEvent struct {
+	// Sequence is the sequence number of the event. It is strictly
+	// increasing for all debit events emitted by the account service and
+	// can be used to order events that are delivered out of order. The
+	// numbering starts at 1 again whenever litd is restarted.
+	Sequence uint64 `json:"sequence"`
+
+	// AccountID is the hex encoded ID of the debited account.
+	AccountID string `json:"account_id"`
+
+	// AmountMsat is the amount in millisatoshis the account was debited
+	// by, including any routing fees.
+	AmountMsat uint64 `json:"amount_msat"`
+
+	// BalanceMsat is the balance of the account in millisatoshis after the
+	// debit.
+	BalanceMsat int64 `json:"balance_msat"`
+
+	// PaymentHash is the hex encoded hash of the payment that caused the
+	// debit. It is empty for manual debits.
+	PaymentHash string `json:"payment_hash,omitempty"`
+
+	// Memo is the optional memo that was provided for a manual debit.
+	Memo string `json:"memo,omitempty"`
+
+	// Timestamp is the unix timestamp of the debit.
+	Timestamp int64 `json:"timestamp"`
+}
+
+// SpendNotifier is notified by the account service about every debit of an
+// account.
+type SpendNotifier interface {
+	// AccountDebited is called after an account was debited. The call
+	// must not block, since it is made while the account service lock is
+	// held.
+	AccountDebited(event *DebitEvent)
+}
+
+// spendWebhookPayload is the JSON payload that is sent to the spend webhook.
+type spendWebhookPayload struct {
+	Events []*DebitEvent `json:"events"`
+}
+
+// WebhookSpendNotifier is a SpendNotifier that sends debit events to an HTTP
+// endpoint. To avoid flooding the endpoint, events that occur in rapid
+// succession are collected and sent as a single batch.
+type WebhookSpendNotifier struct {
+	url           string
+	batchInterval time.Duration
+	client        *http.Client
+
+	mu      sync.Mutex
+	batch   []*DebitEvent
+	timer   *time.Timer
+	stopped bool
+
+	wg sync.WaitGroup
+}
+
+// A compile-time check to ensure that WebhookSpendNotifier implements the
+// SpendNotifier interface.
+var _ SpendNotifier = (*WebhookSpendNotifier)(nil)
+
+// NewWebhookSpendNotifier creates a new notifier that POSTs batches of debit
+// events as JSON to the given URL. All events that occur within the given
+// batch interval after the first event of a batch are sent together.
+func NewWebhookSpendNotifier(url string,
+	batchInterval time.Duration) *WebhookSpendNotifier {
+
+	if batchInterval <= 0 {
+		batchInterval = DefaultSpendWebhookBatchInterval
+	}
+
+	return &WebhookSpendNotifier{
+		url:           url,
+		batchInterval: batchInterval,
+		client: &http.Client{
+			Timeout: spendWebhookTimeout,
+		},
+	}
+}
+
+// AccountDebited adds the event to the current batch and schedules the batch
+// to be sent if it is the first event in it.
+//
+// NOTE: This is part of the SpendNotifier interface.
+func (w *WebhookSpendNotifier) AccountDebited(event *DebitEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.stopped {
+		return
+	}
+
+	w.batch = append(w.batch, event)
+	if w.timer == nil {
+		w.wg.Add(1)
+		w.timer = time.AfterFunc(w.batchInterval, func() {
+			defer w.wg.Done()
+
+			w.flush()
+		})
+	}
+}
+
+// Stop sends any pending events and stops the notifier. Events that are
+// received after Stop was called are dropped.
+func (w *WebhookSpendNotifier) Stop() {
+	w.mu.Lock()
+	w.stopped = true
+
+	// If we manage to stop the timer before it fired, we need to flush
+	// the batch ourselves.
+	if w.timer != nil && w.timer.Stop() {
+		w.wg.Done()
+		w.timer = nil
+	}
+	w.mu.Unlock()
+
+	w.wg.Wait()
+	w.flush()
+}
+
+// flush sends all events of the current batch to the webhook.
+func (w *WebhookSpendNotifier) flush() {
+	w.mu.Lock()
+	batch := w.batch
+	w.batch = nil
+	w.timer = nil
+	w.mu.Unlock()
+
+	if len(batch) == 0 {
+		return
+	}
+
+	if err := w.send(batch); err != nil {
+		log.Errorf("Unable to send %d debit event(s) to spend "+
+			"webhook: %v", len(batch), err)
+	}
+}
+
+// send POSTs the given events to the webhook.
+func (w *WebhookSpendNotifier) send(events []*DebitEvent) error {
+	payload, err := json.Marshal(&spendWebhookPayload{Events: events})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(), spendWebhookTimeout,
+	)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodPost, w.url, bytes.NewReader(payload),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
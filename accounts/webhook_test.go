@@ -0,0 +1,73 @@
+package accounts
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestWebhookSpendNotifier tests that debit events are sent to the webhook in
+// batches.
+func TestWebhookSpendNotifier(t *testing.T) {
+	t.Parallel()
+
+	payloads := make(chan *spendWebhookPayload, 10)
+	srv := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, http.MethodPost, r.Method)
+			require.Equal(
+				t, "application/json",
+				r.Header.Get("Content-Type"),
+			)
+
+			var payload spendWebhookPayload
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			require.NoError(t, err)
+
+			payloads <- &payload
+		},
+	))
+	t.Cleanup(srv.Close)
+
+	notifier := NewWebhookSpendNotifier(srv.URL, 50*time.Millisecond)
+
+	// Events that are sent in rapid succession should end up in a single
+	// batch.
+	notifier.AccountDebited(&DebitEvent{Sequence: 1, AmountMsat: 1000})
+	notifier.AccountDebited(&DebitEvent{Sequence: 2, AmountMsat: 2000})
+
+	select {
+	case payload := <-payloads:
+		require.Len(t, payload.Events, 2)
+		require.EqualValues(t, 1, payload.Events[0].Sequence)
+		require.EqualValues(t, 2, payload.Events[1].Sequence)
+		require.EqualValues(t, 2000, payload.Events[1].AmountMsat)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("No payload received")
+	}
+
+	// Pending events should be sent when the notifier is stopped, even if
+	// the batch interval hasn't passed yet.
+	notifier.batchInterval = time.Hour
+	notifier.AccountDebited(&DebitEvent{Sequence: 3, Memo: "coffee"})
+	notifier.Stop()
+
+	select {
+	case payload := <-payloads:
+		require.Len(t, payload.Events, 1)
+		require.EqualValues(t, 3, payload.Events[0].Sequence)
+		require.Equal(t, "coffee", payload.Events[0].Memo)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("No payload received")
+	}
+
+	// Events received after the notifier was stopped are dropped.
+	notifier.AccountDebited(&DebitEvent{Sequence: 4})
+	require.Empty(t, notifier.batch)
+}
@@ -0,0 +1,123 @@
+package autopilotserver
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ValidateConfig checks that the given JSON-serialized configuration can be
+// used for the feature before a session is registered with it. The Autopilot
+// server doesn't provide a separate schema for the configuration of a
+// feature, so the default configuration of the feature is used as one: the
+// configuration must be a JSON object, it may only contain fields that the
+// default configuration contains and each field must be of the same JSON type
+// as in the default configuration. Objects and arrays are checked
+// recursively. Empty objects, empty arrays and null values in the default
+// configuration accept any value. An empty configuration means that the
+// default configuration is used, so it is always valid.
+func (f *Feature) ValidateConfig(config []byte) error {
+	if len(config) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(config, &value); err != nil {
+		return fmt.Errorf("config is not valid JSON: %w", err)
+	}
+
+	if _, ok := value.(map[string]interface{}); !ok {
+		return fmt.Errorf("config must be a JSON object")
+	}
+
+	// Without a default configuration there is nothing we could check the
+	// configuration against, so we leave the validation to the Autopilot
+	// server.
+	if len(f.DefaultConfig) == 0 {
+		return nil
+	}
+
+	var schema interface{}
+	if err := json.Unmarshal(f.DefaultConfig, &schema); err != nil {
+		return fmt.Errorf("default config of feature %s is not valid "+
+			"JSON: %w", f.Name, err)
+	}
+
+	return validateConfigValue("config", schema, value)
+}
+
+// validateConfigValue checks that the given value at the given path of a
+// configuration is of the same JSON type as the schema value.
+func validateConfigValue(path string, schema, value interface{}) error {
+	if schema == nil || value == nil {
+		return nil
+	}
+
+	if jsonType(schema) != jsonType(value) {
+		return fmt.Errorf("%s must be of type %s but is of type %s",
+			path, jsonType(schema), jsonType(value))
+	}
+
+	switch s := schema.(type) {
+	case map[string]interface{}:
+		if len(s) == 0 {
+			return nil
+		}
+
+		for key, v := range value.(map[string]interface{}) {
+			fieldPath := fmt.Sprintf("%s.%s", path, key)
+
+			fieldSchema, ok := s[key]
+			if !ok {
+				return fmt.Errorf("%s is not a known field",
+					fieldPath)
+			}
+
+			err := validateConfigValue(fieldPath, fieldSchema, v)
+			if err != nil {
+				return err
+			}
+		}
+
+	case []interface{}:
+		if len(s) == 0 {
+			return nil
+		}
+
+		// All elements of an array are expected to be of the same
+		// type as its first element in the default configuration.
+		for i, v := range value.([]interface{}) {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+
+			err := validateConfigValue(elemPath, s[0], v)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// jsonType returns the name of the JSON type of the given value as decoded by
+// json.Unmarshal.
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+
+	case []interface{}:
+		return "array"
+
+	case string:
+		return "string"
+
+	case float64:
+		return "number"
+
+	case bool:
+		return "boolean"
+
+	default:
+		return "null"
+	}
+}
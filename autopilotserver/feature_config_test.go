@@ -0,0 +1,118 @@
+package autopilotserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestFeatureValidateConfig tests that a feature configuration is validated
+// against the default configuration of the feature.
+func TestFeatureValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	defaultConfig := `{
+		"target": 0.5,
+		"enabled": true,
+		"node": "abc",
+		"peers": ["abc"],
+		"limits": {"max": 10},
+		"extra": {},
+		"anything": null
+	}`
+
+	tests := []struct {
+		name          string
+		defaultConfig string
+		config        string
+		expectedErr   string
+	}{
+		{
+			name:          "empty config",
+			defaultConfig: defaultConfig,
+		},
+		{
+			name:          "no default config",
+			defaultConfig: "",
+			config:        `{"unknown": 1}`,
+		},
+		{
+			name:          "valid config",
+			defaultConfig: defaultConfig,
+			config: `{"target": 0.2, "peers": ["a", "b"],
+				"limits": {"max": 5}, "extra": {"x": [1]},
+				"anything": "value"}`,
+		},
+		{
+			name:          "invalid json",
+			defaultConfig: defaultConfig,
+			config:        `{"target": `,
+			expectedErr:   "config is not valid JSON",
+		},
+		{
+			name:          "not an object",
+			defaultConfig: defaultConfig,
+			config:        `[1, 2]`,
+			expectedErr:   "config must be a JSON object",
+		},
+		{
+			name:          "unknown field",
+			defaultConfig: defaultConfig,
+			config:        `{"targt": 0.2}`,
+			expectedErr:   "config.targt is not a known field",
+		},
+		{
+			name:          "wrong type",
+			defaultConfig: defaultConfig,
+			config:        `{"enabled": "yes"}`,
+			expectedErr: "config.enabled must be of type boolean " +
+				"but is of type string",
+		},
+		{
+			name:          "wrong nested type",
+			defaultConfig: defaultConfig,
+			config:        `{"limits": {"max": "10"}}`,
+			expectedErr: "config.limits.max must be of type " +
+				"number but is of type string",
+		},
+		{
+			name:          "unknown nested field",
+			defaultConfig: defaultConfig,
+			config:        `{"limits": {"min": 1}}`,
+			expectedErr:   "config.limits.min is not a known field",
+		},
+		{
+			name:          "wrong array element type",
+			defaultConfig: defaultConfig,
+			config:        `{"peers": ["a", 1]}`,
+			expectedErr: "config.peers[1] must be of type string " +
+				"but is of type number",
+		},
+		{
+			name:          "invalid default config",
+			defaultConfig: `{`,
+			config:        `{"target": 0.2}`,
+			expectedErr:   "default config of feature test is not",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := &Feature{
+				Name:          "test",
+				DefaultConfig: []byte(test.defaultConfig),
+			}
+
+			err := f.ValidateConfig([]byte(test.config))
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
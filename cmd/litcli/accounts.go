@@ -1,21 +1,40 @@
 package main
 
 import (
+	"context"
+	"encoding/csv"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/lightninglabs/lightning-terminal/accounts"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	idName    = "id"
 	labelName = "label"
+
+	// listAccountsPageSize is the number of accounts that are requested
+	// at once when listing accounts.
+	listAccountsPageSize = 100
+
+	// watchRetryDelay is the time to wait before subscribing to the
+	// updates of a watched account again after the subscription was lost.
+	watchRetryDelay = 2 * time.Second
 )
 
 var accountsCommands = []cli.Command{
@@ -26,10 +45,29 @@ var accountsCommands = []cli.Command{
 		Category:  "Accounts",
 		Subcommands: []cli.Command{
 			createAccountCommand,
+			importAccountsCommand,
+			exportAccountsCommand,
+			importAccountsDBCommand,
+			mintPaymentMacaroonCommand,
+			exportAccountMacaroonCommand,
+			rotateAccountMacaroonCommand,
 			updateAccountCommand,
+			transferBalanceCommand,
+			renameAccountLabelCommand,
 			listAccountsCommand,
+			listAccountGroupsCommand,
 			accountInfoCommand,
+			accountTransactionsCommand,
+			accountEventsCommand,
+			accountLifecycleCommand,
+			verifyAccountsCommand,
+			inFlightExposureCommand,
+			accountHistoryCommand,
+			accountLedgerCommand,
+			accountRunwayCommand,
+			creditRuleCommand,
 			removeAccountCommand,
+			purgeAccountsCommand,
 		},
 		Description: "Manage accounts.",
 	},
@@ -39,7 +77,15 @@ var createAccountCommand = cli.Command{
 	Name:      "create",
 	ShortName: "c",
 	Usage:     "Create a new off-chain account with a balance.",
-	ArgsUsage: "balance [expiration_date] [--label=LABEL] [--save_to=FILE]",
+	ArgsUsage: "balance [expiration_date] [--label=LABEL] [--save_to=FILE] " +
+		"[--max_htlc_sat=SAT] [--macaroon_expiry=TIMESTAMP] " +
+		"[--soft_cap_sat=SAT] [--sandbox] " +
+		"[--keysend_budget_sat_per_sec=SAT] [--node_id=PUBKEY] " +
+		"[--amountless_invoice_max_sat=SAT] [--min_balance=SAT " +
+		"--top_up_amount=SAT] [--max_sat_per_window=SAT " +
+		"--window_seconds=SECONDS] [--allow_dest=PUBKEY...] " +
+		"[--max_invoices=N] [--max_invoice_amount=SAT] " +
+		"[--max_payment=SAT] [--id=ID]",
 	Description: `Adds an entry to the account database.
 This entry represents an amount of satoshis (account balance) that can be spent
 using off-chain transactions (e.g. paying invoices).
@@ -50,12 +96,23 @@ the daemon that has issued the macaroon.
 
 Accounts only assert a maximum amount spendable. Having a certain account
 balance does not guarantee that the node has the channel liquidity to actually
-spend that amount.`,
+spend that amount.
+
+The account gets a random ID unless one is given with --id, for example to map
+it to the ID of an external system. A custom ID is 8 bytes encoded as 16 hex
+characters like a random one, so it is still told apart from labels, and can
+only be given with the flag.`,
 	Flags: []cli.Flag{
 		cli.Uint64Flag{
 			Name:  "balance",
 			Usage: "The initial balance of the account.",
 		},
+		cli.StringFlag{
+			Name: idName,
+			Usage: "(optional) The hex encoded 8 byte ID of the " +
+				"account. It must not be used by another " +
+				"account. If not set, a random ID is chosen.",
+		},
 		cli.Int64Flag{
 			Name: "expiration_date",
 			Usage: "The expiration date of the account expressed " +
@@ -71,6 +128,113 @@ spend that amount.`,
 			Name:  labelName,
 			Usage: "(optional) The unique label of the account.",
 		},
+		cli.Uint64Flag{
+			Name: "max_htlc_sat",
+			Usage: "(optional) The maximum amount in satoshis " +
+				"that a single HTLC sent by the account may " +
+				"carry; 0 means there is no limit.",
+		},
+		cli.Uint64Flag{
+			Name: "soft_cap_sat",
+			Usage: "(optional) The total amount in satoshis the " +
+				"account may spend before a warning is " +
+				"logged for each payment; payments are never " +
+				"rejected because of it. 0 means there is no " +
+				"soft cap.",
+		},
+		cli.BoolFlag{
+			Name: "sandbox",
+			Usage: "(optional) Mark the account as a sandbox " +
+				"account that is only used for testing and " +
+				"excluded from aggregate reports.",
+		},
+		cli.Uint64Flag{
+			Name: "keysend_budget_sat_per_sec",
+			Usage: "(optional) The amount in satoshis the account " +
+				"may spend on keysend payments per second, " +
+				"for example for streaming payments. 0 means " +
+				"keysend payments are only limited by the " +
+				"balance.",
+		},
+		cli.StringFlag{
+			Name: "node_id",
+			Usage: "(optional) The identity public key of the lnd " +
+				"node the account is bound to. Defaults to " +
+				"the node litd is connected to, which is " +
+				"currently the only supported node.",
+		},
+		cli.Uint64Flag{
+			Name: "amountless_invoice_max_sat",
+			Usage: "(optional) The maximum amount in satoshis the " +
+				"account may pay to an invoice that doesn't " +
+				"specify an amount. If set, payments to such " +
+				"invoices must state the amount explicitly. " +
+				"0 means they are only limited by the balance.",
+		},
+		cli.Uint64Flag{
+			Name: "min_balance",
+			Usage: "(optional) The balance in satoshis below " +
+				"which the account is topped up " +
+				"automatically from the top-up source " +
+				"account litd is configured with; must be " +
+				"set together with --top_up_amount.",
+		},
+		cli.Uint64Flag{
+			Name: "top_up_amount",
+			Usage: "(optional) The amount in satoshis the " +
+				"account is credited with whenever a " +
+				"payment brings its balance below " +
+				"--min_balance.",
+		},
+		cli.Uint64Flag{
+			Name: "max_sat_per_window",
+			Usage: "(optional) The maximum amount in satoshis, " +
+				"including routing fees, the account may " +
+				"spend on payments within any rolling " +
+				"window of --window_seconds.",
+		},
+		cli.Uint64Flag{
+			Name: "window_seconds",
+			Usage: "(optional) The length in seconds of the " +
+				"rolling window of the spend rate limit; " +
+				"must be set together with " +
+				"--max_sat_per_window.",
+		},
+		cli.StringSliceFlag{
+			Name: "allow_dest",
+			Usage: "(optional) The hex encoded public key of a " +
+				"node the account may send payments to; can " +
+				"be repeated. If set, payments to all other " +
+				"nodes and payments whose destination can't " +
+				"be verified are rejected.",
+		},
+		cli.UintFlag{
+			Name: "max_invoices",
+			Usage: "(optional) The maximum number of invoices " +
+				"created with the account that may be open " +
+				"at the same time.",
+		},
+		cli.Uint64Flag{
+			Name: "max_invoice_amount",
+			Usage: "(optional) The maximum amount in satoshis of " +
+				"an invoice created with the account. If " +
+				"set, invoices without an amount are " +
+				"rejected as well.",
+		},
+		cli.Uint64Flag{
+			Name: "max_payment",
+			Usage: "(optional) The maximum amount in satoshis a " +
+				"single payment of the account may send, " +
+				"not including routing fees.",
+		},
+		cli.Int64Flag{
+			Name: "macaroon_expiry",
+			Usage: "(optional) The expiration date of the account " +
+				"macaroon expressed in seconds since the " +
+				"unix epoch. Must not be after the account's " +
+				"expiration date. 0 means the macaroon only " +
+				"expires with the account.",
+		},
 	},
 	Action: createAccount,
 }
@@ -115,9 +279,30 @@ func createAccount(cli *cli.Context) error {
 	}
 
 	req := &litrpc.CreateAccountRequest{
-		AccountBalance: initialBalance,
-		ExpirationDate: expirationDate,
-		Label:          cli.String(labelName),
+		AccountBalance:         initialBalance,
+		ExpirationDate:         expirationDate,
+		Label:                  cli.String(labelName),
+		MaxHtlcSat:             cli.Uint64("max_htlc_sat"),
+		MacaroonExpirationDate: cli.Int64("macaroon_expiry"),
+		SoftCapSat:             cli.Uint64("soft_cap_sat"),
+		Sandbox:                cli.Bool("sandbox"),
+		KeysendBudgetSatPerSec: cli.Uint64(
+			"keysend_budget_sat_per_sec",
+		),
+		NodeId: cli.String("node_id"),
+		AmountlessInvoiceMaxSat: cli.Uint64(
+			"amountless_invoice_max_sat",
+		),
+		MinBalanceSat:   cli.Uint64("min_balance"),
+		TopUpAmountSat:  cli.Uint64("top_up_amount"),
+		MaxSatPerWindow: cli.Uint64("max_sat_per_window"),
+		WindowSeconds:   cli.Uint64("window_seconds"),
+
+		AllowedDestinations: cli.StringSlice("allow_dest"),
+		MaxOpenInvoices:     uint32(cli.Uint("max_invoices")),
+		MaxInvoiceAmountSat: cli.Uint64("max_invoice_amount"),
+		MaxPaymentSat:       cli.Uint64("max_payment"),
+		Id:                  cli.String(idName),
 	}
 	resp, err := client.CreateAccount(ctx, req)
 	if err != nil {
@@ -125,6 +310,7 @@ func createAccount(cli *cli.Context) error {
 	}
 
 	printRespJSON(resp)
+	printAccountConfirmation(resp)
 
 	// User requested to store the newly baked account macaroon to a file
 	// in addition to printing it to the console.
@@ -142,46 +328,2032 @@ func createAccount(cli *cli.Context) error {
 	return nil
 }
 
-var updateAccountCommand = cli.Command{
-	Name:      "update",
-	ShortName: "u",
-	Usage:     "Update an existing off-chain account.",
-	ArgsUsage: "[id | label] new_balance [new_expiration_date] [--save_to=]",
-	Description: "Updates an existing off-chain account and sets " +
-		"either a new balance or new expiration date or both.",
+// printAccountConfirmation prints a short summary of the account that was
+// actually stored, so that any defaults or normalization that were applied
+// are easy to spot. It is printed to stderr, so that stdout only contains the
+// JSON response and can be piped to other tools.
+func printAccountConfirmation(resp *litrpc.CreateAccountResponse) {
+	acct := resp.Account
+
+	label := "no label"
+	if acct.Label != "" {
+		label = fmt.Sprintf("label '%s'", acct.Label)
+	}
+
+	fmt.Fprintf(os.Stderr, "Created account %s with %s and a balance "+
+		"of %d sats, account %s, macaroon %s\n", acct.Id, label,
+		acct.CurrentBalance, formatExpiry(acct.ExpirationDate),
+		formatExpiry(resp.MacaroonExpirationDate))
+}
+
+// formatExpiry formats a unix timestamp in seconds as an expiration date,
+// where zero means that there is no expiration.
+func formatExpiry(timestamp int64) string {
+	if timestamp == 0 {
+		return "never expires"
+	}
+
+	return "expires " + time.Unix(timestamp, 0).Format(time.RFC3339)
+}
+
+var importAccountsCommand = cli.Command{
+	Name:      "import",
+	Usage:     "Create off-chain accounts from a CSV file.",
+	ArgsUsage: "--file=FILE [--save_dir=DIR]",
+	Description: `Creates one account for every row of a CSV file.
+Each row has the columns label,balance,expiration_date where the balance is in
+satoshis and the optional expiration date is expressed in seconds since the
+unix epoch. A first row that starts with the column name "label" is skipped as
+a header.
+
+All rows are processed even if some of them fail. A table that maps the label of
+every created account to its ID is printed, followed by the rows that failed.
+The command exits with an error if any row failed.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "file",
+			Usage: "The CSV file to read the accounts from.",
+		},
+		cli.StringFlag{
+			Name: "save_dir",
+			Usage: "(optional) Store the macaroon of each created " +
+				"account as <label>.macaroon in the given " +
+				"directory. Accounts without a label are " +
+				"stored as <id>.macaroon.",
+		},
+	},
+	Action: importAccounts,
+}
+
+// importRow is a single account to create that was read from an import file.
+// If the row contains invalid values, err is set instead.
+type importRow struct {
+	line           int
+	label          string
+	balance        uint64
+	expirationDate int64
+	err            error
+}
+
+// importResult is the outcome of creating the account of a single import row.
+type importResult struct {
+	row          *importRow
+	accountID    string
+	macaroonPath string
+	err          error
+}
+
+func importAccounts(cli *cli.Context) error {
+	ctx := getContext()
+
+	if !cli.IsSet("file") {
+		return errors.New("the file to import must be set")
+	}
+
+	fileName := lncfg.CleanAndExpandPath(cli.String("file"))
+	file, err := os.Open(fileName)
+	if err != nil {
+		return fmt.Errorf("unable to open import file: %w", err)
+	}
+	defer file.Close()
+
+	rows, err := readImportRows(file)
+	if err != nil {
+		return fmt.Errorf("unable to read import file %s: %w", fileName,
+			err)
+	}
+
+	var saveDir string
+	if cli.IsSet("save_dir") {
+		saveDir = lncfg.CleanAndExpandPath(cli.String("save_dir"))
+		if err := os.MkdirAll(saveDir, 0700); err != nil {
+			return fmt.Errorf("unable to create %s: %w", saveDir,
+				err)
+		}
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	results := make([]*importResult, 0, len(rows))
+	for _, row := range rows {
+		results = append(
+			results, importAccount(ctx, client, row, saveDir),
+		)
+	}
+
+	return printImportResults(results)
+}
+
+// readImportRows reads the accounts to create from the given CSV data. Rows
+// that contain invalid values are returned with their error set, so that they
+// are reported like rows that failed to be created. An error is only returned
+// if the data isn't valid CSV.
+func readImportRows(r io.Reader) ([]*importRow, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []*importRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if len(rows) == 0 && strings.EqualFold(record[0], "label") {
+			continue
+		}
+
+		rows = append(rows, parseImportRow(line, record))
+	}
+}
+
+// parseImportRow parses a single CSV record of an import file that was read
+// from the given line.
+func parseImportRow(line int, record []string) *importRow {
+	row := &importRow{
+		line:  line,
+		label: strings.TrimSpace(record[0]),
+	}
+
+	if len(record) < 2 || len(record) > 3 {
+		row.err = fmt.Errorf("expected the columns label,balance,"+
+			"expiration_date but found %d columns", len(record))
+
+		return row
+	}
+
+	var err error
+	row.balance, err = strconv.ParseUint(
+		strings.TrimSpace(record[1]), 10, 64,
+	)
+	if err != nil {
+		row.err = fmt.Errorf("invalid balance: %w", err)
+		return row
+	}
+
+	if len(record) == 3 && strings.TrimSpace(record[2]) != "" {
+		row.expirationDate, err = strconv.ParseInt(
+			strings.TrimSpace(record[2]), 10, 64,
+		)
+		if err != nil {
+			row.err = fmt.Errorf("invalid expiration_date: %w", err)
+		}
+	}
+
+	return row
+}
+
+// importAccount creates the account of the given import row and stores its
+// macaroon in the save directory, if one is given.
+func importAccount(ctx context.Context, client litrpc.AccountsClient,
+	row *importRow, saveDir string) *importResult {
+
+	result := &importResult{row: row}
+	if row.err != nil {
+		result.err = row.err
+		return result
+	}
+
+	// Make sure the macaroon can't be written outside of the save
+	// directory before we create the account.
+	if saveDir != "" && strings.ContainsAny(row.label, `/\`) {
+		result.err = errors.New("label must not contain path " +
+			"separators when saving macaroons")
+
+		return result
+	}
+
+	resp, err := client.CreateAccount(ctx, &litrpc.CreateAccountRequest{
+		AccountBalance: row.balance,
+		ExpirationDate: row.expirationDate,
+		Label:          row.label,
+	})
+	if err != nil {
+		result.err = err
+		return result
+	}
+	result.accountID = resp.Account.Id
+
+	if saveDir == "" {
+		return result
+	}
+
+	name := resp.Account.Label
+	if name == "" {
+		name = resp.Account.Id
+	}
+	macaroonPath := filepath.Join(saveDir, name+".macaroon")
+
+	err = os.WriteFile(macaroonPath, resp.Macaroon, 0644)
+	if err != nil {
+		result.err = fmt.Errorf("account %s was created but its "+
+			"macaroon could not be saved: %w", resp.Account.Id, err)
+
+		return result
+	}
+	result.macaroonPath = macaroonPath
+
+	return result
+}
+
+// printImportResults prints a table of the accounts that were created,
+// followed by a summary of the rows that failed. An error is returned if any
+// row failed.
+func printImportResults(results []*importResult) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "LABEL\tACCOUNT ID\tMACAROON")
+
+	var failed []*importResult
+	for _, result := range results {
+		if result.err != nil {
+			failed = append(failed, result)
+		}
+		if result.accountID == "" {
+			continue
+		}
+
+		macaroonPath := result.macaroonPath
+		if macaroonPath == "" {
+			macaroonPath = "-"
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", result.row.label,
+			result.accountID, macaroonPath)
+	}
+	_ = w.Flush()
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	fmt.Printf("\n%d of %d rows failed:\n", len(failed), len(results))
+	for _, result := range failed {
+		fmt.Printf("line %d (label '%s'): %v\n", result.row.line,
+			result.row.label, result.err)
+	}
+
+	return fmt.Errorf("%d of %d accounts could not be imported",
+		len(failed), len(results))
+}
+
+var exportAccountsCommand = cli.Command{
+	Name:      "export",
+	Usage:     "Export all off-chain accounts to a JSON file.",
+	ArgsUsage: "[--out=FILE]",
+	Description: `Exports the ID, label, balances, expiration date and
+settings of every account, for example as a backup or to move the accounts to
+another litd instance with the import-db command. Amounts are exported in
+millisatoshis. The invoices and payments of the accounts are not exported, and
+neither are any macaroon secrets.
+
+Without --out, the export is printed to stdout.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "out",
+			Usage: "(optional) The file to write the export to.",
+		},
+	},
+	Action: exportAccounts,
+}
+
+func exportAccounts(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	resp, err := client.ExportAccounts(ctx, &litrpc.ExportAccountsRequest{})
+	if err != nil {
+		return err
+	}
+
+	if !cli.IsSet("out") {
+		printRespJSON(resp)
+		return nil
+	}
+
+	jsonBytes, err := lnrpc.ProtoJSONMarshalOpts.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("unable to encode export: %w", err)
+	}
+
+	// The export contains the balances of all accounts, so we only make
+	// it readable for the current user.
+	fileName := lncfg.CleanAndExpandPath(cli.String("out"))
+	if err := os.WriteFile(fileName, jsonBytes, 0600); err != nil {
+		return fmt.Errorf("unable to write export to %s: %w", fileName,
+			err)
+	}
+
+	fmt.Printf("Exported %d accounts to %s\n", len(resp.Accounts),
+		fileName)
+
+	return nil
+}
+
+var importAccountsDBCommand = cli.Command{
+	Name:      "import-db",
+	Usage:     "Recreate off-chain accounts from a JSON export.",
+	ArgsUsage: "--file=FILE [--overwrite]",
+	Description: `Recreates the accounts of a file that was written by the
+export command with their original IDs, balances and settings.
+
+If any of the accounts already exists, nothing is imported unless --overwrite
+is set. Existing accounts are then updated to the exported state, but keep
+their invoices and payments.
+
+Macaroons that were issued for the accounts stay valid if the accounts are
+imported into the litd instance that issued them. Otherwise, new macaroons can
+be created with the export-macaroon command.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "file",
+			Usage: "The export file to read the accounts from.",
+		},
+		cli.BoolFlag{
+			Name: "overwrite",
+			Usage: "Overwrite accounts that already exist instead " +
+				"of refusing the import.",
+		},
+	},
+	Action: importAccountsDB,
+}
+
+func importAccountsDB(cli *cli.Context) error {
+	ctx := getContext()
+
+	if !cli.IsSet("file") {
+		return errors.New("the file to import must be set")
+	}
+
+	fileName := lncfg.CleanAndExpandPath(cli.String("file"))
+	jsonBytes, err := os.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("unable to read import file: %w", err)
+	}
+
+	export := &litrpc.ExportAccountsResponse{}
+	err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(jsonBytes, export)
+	if err != nil {
+		return fmt.Errorf("unable to parse import file %s: %w",
+			fileName, err)
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	resp, err := client.ImportAccounts(ctx, &litrpc.ImportAccountsRequest{
+		Accounts:  export.Accounts,
+		Overwrite: cli.Bool("overwrite"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var mintPaymentMacaroonCommand = cli.Command{
+	Name:      "mint-payment-macaroon",
+	Usage:     "Bake an account macaroon that can pay a single invoice.",
+	ArgsUsage: "[id | label] --payment_hash=",
+	Description: "Bakes a macaroon for an existing account that can only " +
+		"be used to pay the invoice with the given payment hash. " +
+		"Once that payment has succeeded, the macaroon can't be " +
+		"used anymore.",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  idName,
-			Usage: "The ID of the account to update.",
+			Usage: "The ID of the account.",
 		},
 		cli.StringFlag{
 			Name:  labelName,
 			Usage: "(optional) The unique label of the account.",
 		},
-		cli.Int64Flag{
-			Name: "new_balance",
-			Usage: "(deprecated) The new balance of the account; " +
-				"-1 means do not update the balance.",
-			Value:  -1,
-			Hidden: true,
+		cli.StringFlag{
+			Name: "payment_hash",
+			Usage: "The hex encoded payment hash of the invoice " +
+				"the macaroon can pay.",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "Store the payment macaroon created for the " +
+				"account to the given file.",
+		},
+	},
+	Action: mintPaymentMacaroon,
+}
+
+func mintPaymentMacaroon(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	id, label, _, err := parseIDOrLabel(cli)
+	if err != nil {
+		return err
+	}
+
+	paymentHash, err := hex.DecodeString(cli.String("payment_hash"))
+	if err != nil {
+		return fmt.Errorf("unable to decode payment hash: %w", err)
+	}
+
+	resp, err := client.MintPaymentMacaroon(
+		ctx, &litrpc.MintPaymentMacaroonRequest{
+			Id:          id,
+			Label:       label,
+			PaymentHash: paymentHash,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	if cli.IsSet("save_to") {
+		fileName := lncfg.CleanAndExpandPath(cli.String("save_to"))
+		err := os.WriteFile(fileName, resp.Macaroon, 0644)
+		if err != nil {
+			return fmt.Errorf("error writing payment macaroon "+
+				"to %s: %v", fileName, err)
+		}
+
+		fmt.Printf("Payment macaroon saved to %s\n", fileName)
+	}
+
+	return nil
+}
+
+var exportAccountMacaroonCommand = cli.Command{
+	Name:      "export-macaroon",
+	Usage:     "Bake a new macaroon for an existing account.",
+	ArgsUsage: "[id | label] [--save_to=FILE] [--macaroon_expiry=TIMESTAMP]",
+	Description: "Bakes a new macaroon with the same permissions as the " +
+		"one returned when the account was created, for example to " +
+		"replace a lost macaroon file. Macaroons that were issued " +
+		"for the account before are not invalidated. The macaroon " +
+		"can spend the balance of the account, so handle it with " +
+		"care.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "Store the account macaroon to the given " +
+				"file.",
+		},
+		cli.Int64Flag{
+			Name: "macaroon_expiry",
+			Usage: "(optional) The expiration date of the " +
+				"macaroon expressed in seconds since the " +
+				"unix epoch. Must not be after the account's " +
+				"expiration date. 0 means the macaroon only " +
+				"expires with the account.",
+		},
+	},
+	Action: exportAccountMacaroon,
+}
+
+func exportAccountMacaroon(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	id, label, _, err := parseIDOrLabel(cli)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ExportAccountMacaroon(
+		ctx, &litrpc.ExportAccountMacaroonRequest{
+			Id:                     id,
+			Label:                  label,
+			MacaroonExpirationDate: cli.Int64("macaroon_expiry"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	if cli.IsSet("save_to") {
+		fileName := lncfg.CleanAndExpandPath(cli.String("save_to"))
+		err := os.WriteFile(fileName, resp.Macaroon, 0644)
+		if err != nil {
+			return fmt.Errorf("error writing account macaroon "+
+				"to %s: %v", fileName, err)
+		}
+
+		fmt.Printf("Account macaroon saved to %s\n", fileName)
+	}
+
+	return nil
+}
+
+var rotateAccountMacaroonCommand = cli.Command{
+	Name:      "rotate-macaroon",
+	Usage:     "Replace the macaroon of an existing account.",
+	ArgsUsage: "[id | label] [--save_to=FILE] [--macaroon_expiry=TIMESTAMP]",
+	Description: "Replaces the root key of the account's macaroons and " +
+		"bakes a new macaroon with the same permissions as the one " +
+		"returned when the account was created, for example if the " +
+		"old macaroon was leaked. All macaroons that were issued for " +
+		"the account before stop working immediately. The balance " +
+		"and settings of the account are not changed. The rotation " +
+		"is refused if another account or a session shares the " +
+		"root key, since their macaroons would stop working too.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.StringFlag{
+			Name: "save_to",
+			Usage: "Store the new account macaroon to the given " +
+				"file.",
+		},
+		cli.Int64Flag{
+			Name: "macaroon_expiry",
+			Usage: "(optional) The expiration date of the " +
+				"macaroon expressed in seconds since the " +
+				"unix epoch. Must not be after the account's " +
+				"expiration date. 0 means the macaroon only " +
+				"expires with the account.",
+		},
+	},
+	Action: rotateAccountMacaroon,
+}
+
+func rotateAccountMacaroon(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	id, label, _, err := parseIDOrLabel(cli)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.RotateAccountMacaroon(
+		ctx, &litrpc.RotateAccountMacaroonRequest{
+			Id:                     id,
+			Label:                  label,
+			MacaroonExpirationDate: cli.Int64("macaroon_expiry"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	if cli.IsSet("save_to") {
+		fileName := lncfg.CleanAndExpandPath(cli.String("save_to"))
+		err := os.WriteFile(fileName, resp.Macaroon, 0644)
+		if err != nil {
+			return fmt.Errorf("error writing account macaroon "+
+				"to %s: %v", fileName, err)
+		}
+
+		fmt.Printf("Account macaroon saved to %s\n", fileName)
+	}
+
+	return nil
+}
+
+var updateAccountCommand = cli.Command{
+	Name:      "update",
+	ShortName: "u",
+	Usage:     "Update an existing off-chain account.",
+	ArgsUsage: "[id | label] new_balance [new_expiration_date] [--save_to=]",
+	Description: "Updates an existing off-chain account and sets " +
+		"either a new balance or new expiration date or both.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account to update.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.Int64Flag{
+			Name: "new_balance",
+			Usage: "(deprecated) The new balance of the account; " +
+				"-1 means do not update the balance.",
+			Value:  -1,
+			Hidden: true,
+		},
+		cli.Int64Flag{
+			Name: "new_expiration_date",
+			Usage: "The new expiration date of the account " +
+				"expressed in seconds since the unix epoch; " +
+				"-1 means do not update the expiration date; " +
+				"0 means it does not expire.",
+			Value: -1,
+		},
+		cli.Int64Flag{
+			Name: "max_htlc_sat",
+			Usage: "The new maximum amount in satoshis that a " +
+				"single HTLC sent by the account may carry; " +
+				"0 means do not update the limit; -1 removes " +
+				"the limit.",
+		},
+		cli.Int64Flag{
+			Name: "soft_cap_sat",
+			Usage: "The new soft cap in satoshis on the total " +
+				"amount spent by the account; 0 means do not " +
+				"update the soft cap; -1 removes it.",
+		},
+		cli.Int64Flag{
+			Name: "min_balance",
+			Usage: "The new balance in satoshis below which the " +
+				"account is topped up automatically; must " +
+				"be set together with --top_up_amount.",
+		},
+		cli.Int64Flag{
+			Name: "top_up_amount",
+			Usage: "The new amount in satoshis the account is " +
+				"credited with on every automatic top-up; " +
+				"0 means do not update the top-up policy; " +
+				"-1 removes it.",
+		},
+		cli.Int64Flag{
+			Name: "max_sat_per_window",
+			Usage: "The new maximum amount in satoshis the " +
+				"account may spend on payments within any " +
+				"rolling window of --window_seconds; 0 " +
+				"means do not update the spend rate limit; " +
+				"-1 removes it.",
+		},
+		cli.Int64Flag{
+			Name: "window_seconds",
+			Usage: "The new length in seconds of the rolling " +
+				"window of the spend rate limit; must be " +
+				"set together with --max_sat_per_window.",
+		},
+		cli.DurationFlag{
+			Name: "expiry_warning",
+			Usage: "The new lead time before the expiration " +
+				"date at which subscribers of the account " +
+				"updates are warned that the account " +
+				"expires soon, for example 24h; 0 removes " +
+				"the warning.",
+		},
+		cli.StringSliceFlag{
+			Name: "allow_dest",
+			Usage: "The hex encoded public key of a node the " +
+				"account may send payments to; can be " +
+				"repeated. Replaces the current allowed " +
+				"destinations.",
+		},
+		cli.BoolFlag{
+			Name: "clear_allow_dest",
+			Usage: "Remove the allowed destinations, so that " +
+				"the account may pay any node.",
+		},
+		cli.Int64Flag{
+			Name: "max_invoices",
+			Usage: "The new maximum number of invoices created " +
+				"with the account that may be open at the " +
+				"same time; 0 means do not update the " +
+				"limit; -1 removes the limit.",
+		},
+		cli.Int64Flag{
+			Name: "max_invoice_amount",
+			Usage: "The new maximum amount in satoshis of an " +
+				"invoice created with the account; 0 means " +
+				"do not update the limit; -1 removes the " +
+				"limit.",
+		},
+		cli.Int64Flag{
+			Name: "max_payment",
+			Usage: "The new maximum amount in satoshis a single " +
+				"payment of the account may send; 0 means " +
+				"do not update the limit; -1 removes the " +
+				"limit.",
+		},
+		cli.Uint64Flag{
+			Name: "version",
+			Usage: "(optional) The version of the account the " +
+				"update is based on; the update is rejected " +
+				"if the account was changed since.",
+		},
+	},
+	Action: updateAccount,
+	Subcommands: []cli.Command{
+		creditCommand,
+		debitCommand,
+	},
+}
+
+func updateAccount(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	id, label, args, err := parseIDOrLabel(cli)
+	if err != nil {
+		return err
+	}
+
+	var (
+		newBalance     int64
+		expirationDate int64
+	)
+	switch {
+	case cli.IsSet("new_balance"):
+		newBalance = cli.Int64("new_balance")
+	case args.Present():
+		newBalance, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode balance %v", err)
+		}
+		args = args.Tail()
+	}
+
+	// Unless a new expiration date is given, the default value of the
+	// flag keeps the current expiration date.
+	expirationDate = cli.Int64("new_expiration_date")
+	if !cli.IsSet("new_expiration_date") && args.Present() {
+		expirationDate, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf(
+				"unable to decode expiration_date: %v", err,
+			)
+		}
+		args = args.Tail()
+	}
+
+	req := &litrpc.UpdateAccountRequest{
+		Id:              id,
+		Label:           label,
+		AccountBalance:  newBalance,
+		ExpirationDate:  expirationDate,
+		MaxHtlcSat:      cli.Int64("max_htlc_sat"),
+		SoftCapSat:      cli.Int64("soft_cap_sat"),
+		Version:         cli.Uint64("version"),
+		MinBalanceSat:   cli.Int64("min_balance"),
+		TopUpAmountSat:  cli.Int64("top_up_amount"),
+		MaxSatPerWindow: cli.Int64("max_sat_per_window"),
+		WindowSeconds:   cli.Int64("window_seconds"),
+
+		AllowedDestinations:      cli.StringSlice("allow_dest"),
+		ClearAllowedDestinations: cli.Bool("clear_allow_dest"),
+		MaxOpenInvoices:          cli.Int64("max_invoices"),
+		MaxInvoiceAmountSat:      cli.Int64("max_invoice_amount"),
+		MaxPaymentSat:            cli.Int64("max_payment"),
+	}
+
+	// The RPC uses -1 to remove the expiry warning, as zero leaves it
+	// unchanged.
+	if cli.IsSet("expiry_warning") {
+		warning := cli.Duration("expiry_warning")
+		switch {
+		case warning < 0:
+			return fmt.Errorf("expiry warning must not be negative")
+
+		case warning == 0:
+			req.ExpiryWarningSeconds = -1
+
+		case warning < time.Second:
+			return fmt.Errorf("expiry warning must be at least " +
+				"one second")
+
+		default:
+			req.ExpiryWarningSeconds = int64(warning.Seconds())
+		}
+	}
+
+	resp, err := client.UpdateAccount(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var creditCommand = cli.Command{
+	Name:      "credit",
+	ShortName: "c",
+	Usage:     "Increase an account's balance by the given amount.",
+	ArgsUsage: "[id | label] amount",
+	Description: "Increases an existing off-chain account's balance by " +
+		"the given amount.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account to credit.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.Uint64Flag{
+			Name:  "amount",
+			Usage: "The amount to credit the account.",
+		},
+	},
+	Action: creditBalance,
+}
+
+func creditBalance(cli *cli.Context) error {
+	return updateBalance(cli, true)
+}
+
+var debitCommand = cli.Command{
+	Name:      "debit",
+	ShortName: "d",
+	Usage:     "Decrease an account's balance by the given amount.",
+	ArgsUsage: "[id | label] amount",
+	Description: "Decreases an existing off-chain account's balance by " +
+		"the given amount.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account to debit.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.Uint64Flag{
+			Name:  "amount",
+			Usage: "The amount to debit the account.",
+		},
+		cli.StringFlag{
+			Name: "memo",
+			Usage: "(optional) A memo that is included in the " +
+				"debit event sent to the spend webhook.",
+		},
+	},
+	Action: debitBalance,
+}
+
+func debitBalance(cli *cli.Context) error {
+	return updateBalance(cli, false)
+}
+
+func updateBalance(cli *cli.Context, add bool) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	account, args, err := parseAccountIdentifier(cli)
+	if err != nil {
+		return err
+	}
+
+	if (!cli.IsSet("amount") && len(args) != 1) ||
+		(cli.IsSet("amount") && len(args) != 0) {
+
+		return errors.New("invalid number of arguments")
+	}
+
+	var amount uint64
+	switch {
+	case cli.IsSet("amount"):
+		amount = cli.Uint64("amount")
+	case args.Present():
+		amount, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amount %v", err)
+		}
+		args = args.Tail()
+	default:
+		return errors.New("must set a value for amount")
+	}
+
+	if add {
+		req := &litrpc.CreditAccountRequest{
+			Account: account,
+			Amount:  amount,
+		}
+
+		resp, err := client.CreditAccount(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+	} else {
+		req := &litrpc.DebitAccountRequest{
+			Account: account,
+			Amount:  amount,
+			Memo:    cli.String("memo"),
+		}
+
+		resp, err := client.DebitAccount(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+	}
+
+	return nil
+}
+
+var renameAccountLabelCommand = cli.Command{
+	Name:      "rename-label",
+	ShortName: "rl",
+	Usage:     "Change the label of an existing off-chain account.",
+	ArgsUsage: "[id | label] new_label",
+	Description: "Changes only the label of an existing off-chain " +
+		"account. The new label must not be used by any other " +
+		"account.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account to rename.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The current label of the account.",
+		},
+		cli.StringFlag{
+			Name:  "new_label",
+			Usage: "The new label of the account.",
+		},
+	},
+	Action: renameAccountLabel,
+}
+
+func renameAccountLabel(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	account, args, err := parseAccountIdentifier(cli)
+	if err != nil {
+		return err
+	}
+
+	var newLabel string
+	switch {
+	case cli.IsSet("new_label") && len(args) == 0:
+		newLabel = cli.String("new_label")
+	case !cli.IsSet("new_label") && len(args) == 1:
+		newLabel = args.First()
+	default:
+		return errors.New("invalid number of arguments")
+	}
+
+	resp, err := client.RenameAccountLabel(
+		ctx, &litrpc.RenameAccountLabelRequest{
+			Account:  account,
+			NewLabel: newLabel,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listAccountsCommand = cli.Command{
+	Name:      "list",
+	ShortName: "l",
+	Usage:     "List all off-chain accounts.",
+	Description: "Returns the accounts that are currently stored in " +
+		"the account database and match the given filters. " +
+		"Expired accounts are only listed with --expired. The " +
+		"accounts are fetched page by page, so that even large " +
+		"account databases can be listed.\n\n" +
+		"With --fiat, the fiat equivalent of each balance is shown " +
+		"as well, together with the rate and the time it was " +
+		"fetched at. The rate is fetched by litcli from the price " +
+		"source that is configured in litd.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "sandbox",
+			Usage: "Whether to list sandbox accounts. Options " +
+				"include 'include', 'exclude' and 'only'.",
+			Value: "include",
+		},
+		cli.StringFlag{
+			Name: "label_prefix",
+			Usage: "(optional) Only list accounts with a label " +
+				"that starts with this prefix.",
+		},
+		cli.Uint64Flag{
+			Name: "min_balance",
+			Usage: "(optional) Only list accounts with a " +
+				"balance of at least this many satoshis.",
+		},
+		cli.BoolFlag{
+			Name:  "expired",
+			Usage: "Also list accounts that have expired.",
+		},
+		cli.Uint64Flag{
+			Name: "max",
+			Usage: "(optional) The maximum number of accounts to " +
+				"list. All matching accounts are listed if " +
+				"not set.",
+		},
+		cli.StringFlag{
+			Name: "sort_by",
+			Usage: "(optional) The account property to sort the " +
+				"accounts by. Options include 'balance', " +
+				"'expiration', 'label' and 'created'.",
+		},
+		cli.BoolFlag{
+			Name: "desc",
+			Usage: "Sort the accounts in descending order. Can " +
+				"only be set together with --sort_by.",
+		},
+		fiatFlag,
+		outputFlag,
+	},
+	Action: listAccounts,
+}
+
+func listAccounts(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	format, err := parseOutputFormat(cli)
+	if err != nil {
+		return err
+	}
+
+	fiat, err := newFiatRateSource(ctx, cli, clientConn)
+	if err != nil {
+		return err
+	}
+
+	var filter litrpc.SandboxFilter
+	switch cli.String("sandbox") {
+	case "include":
+		filter = litrpc.SandboxFilter_SANDBOX_INCLUDE
+
+	case "exclude":
+		filter = litrpc.SandboxFilter_SANDBOX_EXCLUDE
+
+	case "only":
+		filter = litrpc.SandboxFilter_SANDBOX_ONLY
+
+	default:
+		return fmt.Errorf("unknown sandbox filter %s. Valid options "+
+			"include 'include', 'exclude' and 'only'",
+			cli.String("sandbox"))
+	}
+
+	var sortBy litrpc.AccountSortField
+	switch cli.String("sort_by") {
+	case "":
+		sortBy = litrpc.AccountSortField_ACCOUNT_SORT_DEFAULT
+
+	case "balance":
+		sortBy = litrpc.AccountSortField_ACCOUNT_SORT_BALANCE
+
+	case "expiration":
+		sortBy = litrpc.AccountSortField_ACCOUNT_SORT_EXPIRATION
+
+	case "label":
+		sortBy = litrpc.AccountSortField_ACCOUNT_SORT_LABEL
+
+	case "created":
+		sortBy = litrpc.AccountSortField_ACCOUNT_SORT_CREATED
+
+	default:
+		return fmt.Errorf("unknown sort field %s. Valid options "+
+			"include 'balance', 'expiration', 'label' and "+
+			"'created'", cli.String("sort_by"))
+	}
+
+	sortDir := litrpc.SortDirection_SORT_ASCENDING
+	if cli.Bool("desc") {
+		sortDir = litrpc.SortDirection_SORT_DESCENDING
+	}
+
+	req := &litrpc.ListAccountsRequest{
+		SandboxFilter:  filter,
+		LabelPrefix:    cli.String("label_prefix"),
+		MinBalance:     cli.Uint64("min_balance"),
+		IncludeExpired: cli.Bool("expired"),
+		SortBy:         sortBy,
+		SortDir:        sortDir,
+	}
+	maxAccounts := cli.Uint64("max")
+
+	result := &litrpc.ListAccountsResponse{}
+	for {
+		req.PageSize = listAccountsPageSize
+		remaining := maxAccounts - uint64(len(result.Accounts))
+		if maxAccounts > 0 && remaining < listAccountsPageSize {
+			req.PageSize = uint32(remaining)
+		}
+
+		resp, err := client.ListAccounts(ctx, req)
+		if err != nil {
+			return err
+		}
+		result.Accounts = append(result.Accounts, resp.Accounts...)
+
+		if resp.NextPageToken == "" ||
+			(maxAccounts > 0 &&
+				uint64(len(result.Accounts)) >= maxAccounts) {
+
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	var rate *fiatRate
+	if fiat != nil {
+		rate, err = fiat.rate(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if format == outputTable {
+		return printAccountsTable(result.Accounts, rate)
+	}
+
+	if rate != nil {
+		return printRespJSONWithFiat(result, result.Accounts, rate)
+	}
+
+	printRespJSON(result)
+	return nil
+}
+
+// printAccountsTable prints a table with a row for each of the given accounts.
+// If a fiat rate is given, the fiat equivalent of the balances is shown as
+// well, followed by the rate.
+func printAccountsTable(accts []*litrpc.Account, rate *fiatRate) error {
+	columns := []string{"ID", "LABEL", "BALANCE (SAT)"}
+	if rate != nil {
+		columns = append(columns, fmt.Sprintf("BALANCE (%s)",
+			rate.Currency))
+	}
+	columns = append(
+		columns, "AVAILABLE (SAT)", "PENDING", "EXPIRES", "STATUS",
+	)
+
+	t := newTable(columns...)
+	for _, acct := range accts {
+		cells := []string{
+			shortAccountID(acct.Id), acct.Label,
+			strconv.FormatInt(acct.CurrentBalance, 10),
+		}
+		if rate != nil {
+			cells = append(cells, rate.toFiat(acct.CurrentBalance))
+		}
+		cells = append(
+			cells, strconv.FormatInt(acct.AvailableBalance, 10),
+			strconv.FormatUint(uint64(acct.PendingPayments), 10),
+			formatExpirationDate(acct.ExpirationDate),
+			accountStatus(acct),
+		)
+
+		t.addRow(cells...)
+	}
+
+	if err := t.print(); err != nil {
+		return err
+	}
+
+	if rate != nil {
+		fmt.Println()
+		fmt.Println(rate)
+	}
+
+	return nil
+}
+
+// shortAccountID returns the first half of the given hex encoded account ID,
+// which is usually enough to tell accounts apart in a table.
+func shortAccountID(id string) string {
+	if len(id) <= accounts.AccountIDLen {
+		return id
+	}
+
+	return id[:accounts.AccountIDLen]
+}
+
+// formatExpirationDate formats the given expiration date of an account, which
+// is a unix timestamp in seconds, for humans.
+func formatExpirationDate(expirationDate int64) string {
+	if expirationDate <= 0 {
+		return "never"
+	}
+
+	return time.Unix(expirationDate, 0).Format(time.DateTime)
+}
+
+// accountStatus returns a short description of the state of the given
+// account.
+func accountStatus(acct *litrpc.Account) string {
+	switch {
+	case acct.ExpirationDate > 0 &&
+		time.Unix(acct.ExpirationDate, 0).Before(time.Now()):
+
+		return "expired"
+
+	case acct.CurrentBalance <= 0:
+		return "empty"
+
+	case acct.Sandbox:
+		return "sandbox"
+
+	default:
+		return "active"
+	}
+}
+
+var listAccountGroupsCommand = cli.Command{
+	Name:  "groups",
+	Usage: "List the groups of accounts.",
+	Description: "Returns the distinct groups of accounts with the " +
+		"number of accounts in each. The group of an account is " +
+		"the part of its label before the first separator, so the " +
+		"label 'team/alice' belongs to the group 'team'.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "separator",
+			Usage: "The separator that splits the group from the " +
+				"rest of a label.",
+			Value: accounts.DefaultGroupSeparator,
+		},
+	},
+	Action: listAccountGroups,
+}
+
+func listAccountGroups(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	resp, err := client.ListAccountGroups(
+		ctx, &litrpc.ListAccountGroupsRequest{
+			Separator: cli.String("separator"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var accountInfoCommand = cli.Command{
+	Name:      "info",
+	ShortName: "i",
+	Usage:     "Show information about a single off-chain account.",
+	ArgsUsage: "[id | label]",
+	Description: "Returns a single account entry from the account " +
+		"database.\n\n" +
+		"Accounts only assert a maximum amount spendable. With " +
+		"--check_liquidity, the total local balance of the node's " +
+		"channels is queried from lnd as well, and a warning is " +
+		"shown if the account balance exceeds it, as payments may " +
+		"then fail despite the balance. The account itself is not " +
+		"changed.\n\n" +
+		"With --fiat, the fiat equivalent of the balance is shown " +
+		"as well, together with the rate and the time it was " +
+		"fetched at. The rate is fetched by litcli from the price " +
+		"source that is configured in litd. With --watch, the rate " +
+		"is fetched again at most once a minute.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.BoolFlag{
+			Name: "check_liquidity",
+			Usage: "Warn if the account balance exceeds the " +
+				"outbound liquidity of the node's channels.",
+		},
+		cli.BoolFlag{
+			Name: "watch",
+			Usage: "Keep running and print a line with the " +
+				"balance whenever the balance or the " +
+				"expiration date of the account changes, " +
+				"and a warning once the account reaches " +
+				"its expiry warning lead time.",
+		},
+		fiatFlag,
+		outputFlag,
+	},
+	Action: accountInfo,
+}
+
+func accountInfo(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	id, label, _, err := parseIDOrLabel(cli)
+	if err != nil {
+		return err
+	}
+
+	fiat, err := newFiatRateSource(ctx, cli, clientConn)
+	if err != nil {
+		return err
+	}
+
+	if cli.Bool("watch") {
+		if cli.Bool("check_liquidity") {
+			return fmt.Errorf("--check_liquidity can't be used " +
+				"with --watch")
+		}
+
+		return watchAccount(ctx, client, id, label, fiat)
+	}
+
+	format, err := parseOutputFormat(cli)
+	if err != nil {
+		return err
+	}
+
+	req := &litrpc.AccountInfoRequest{
+		Id:    id,
+		Label: label,
+	}
+	resp, err := client.AccountInfo(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if cli.Bool("check_liquidity") {
+		err := checkAccountLiquidity(ctx, cli, resp.CurrentBalance)
+		if err != nil {
+			return err
+		}
+	}
+
+	var rate *fiatRate
+	if fiat != nil {
+		rate, err = fiat.rate(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if format == outputTable {
+		return printAccountsTable([]*litrpc.Account{resp}, rate)
+	}
+
+	if rate != nil {
+		return printRespJSONWithFiat(resp, []*litrpc.Account{resp}, rate)
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// checkAccountLiquidity queries lnd for the total local balance of the node's
+// channels and prints a warning to stderr if the given account balance exceeds
+// it. The warning is only informational, the account isn't changed.
+func checkAccountLiquidity(ctx context.Context, cli *cli.Context,
+	balanceSat int64) error {
+
+	superMacConn, cleanup, err := connectSuperMacClient(ctx, cli)
+	if err != nil {
+		return fmt.Errorf("unable to make rpc con: %w", err)
+	}
+	defer cleanup()
+
+	lndClient := lnrpc.NewLightningClient(superMacConn)
+	chanBalance, err := lndClient.ChannelBalance(
+		ctx, &lnrpc.ChannelBalanceRequest{},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to query channel balance: %w", err)
+	}
+
+	outboundSat := int64(chanBalance.LocalBalance.GetSat())
+	if balanceSat > outboundSat {
+		fmt.Fprintf(os.Stderr, "warning: the account balance of %d "+
+			"sat exceeds the outbound liquidity of %d sat of the "+
+			"node's channels, payments may fail despite the "+
+			"account balance\n", balanceSat, outboundSat)
+	}
+
+	return nil
+}
+
+// watchAccount prints a line for the account with the given ID or label
+// whenever its balance or expiration date changes, until the account is
+// removed or the command is interrupted. If the connection to litd is lost or
+// the account service is restarted, the subscription is renewed once litd is
+// available again. The current state of the account is sent on every new
+// subscription, so no change is missed. If a fiat rate source is given, the
+// fiat equivalent of the balance is shown as well.
+func watchAccount(ctx context.Context, client litrpc.AccountsClient, id,
+	label string, fiat *fiatRateSource) error {
+
+	ident := &litrpc.AccountIdentifier{
+		Identifier: &litrpc.AccountIdentifier_Label{Label: label},
+	}
+	if id != "" {
+		ident.Identifier = &litrpc.AccountIdentifier_Id{Id: id}
+	}
+
+	var last *litrpc.Account
+	for {
+		err := streamAccountUpdates(ctx, client, ident, &last, fiat)
+		switch {
+		case err == nil:
+			fmt.Println("account was removed")
+			return nil
+
+		case ctx.Err() != nil:
+			return nil
+
+		case status.Code(err) != codes.Unavailable &&
+			!strings.Contains(
+				err.Error(),
+				accounts.ErrAccountServiceDisabled.Error(),
+			):
+
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "subscription lost (%v), "+
+			"resubscribing\n", err)
+
+		select {
+		case <-time.After(watchRetryDelay):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// streamAccountUpdates subscribes to the updates of the given account and
+// prints a line for every update and expiry warning until the stream ends.
+// The last account state that was printed is kept in last, so that the balance
+// change can be shown across subscriptions. A nil error is returned if the
+// account was removed.
+func streamAccountUpdates(ctx context.Context, client litrpc.AccountsClient,
+	ident *litrpc.AccountIdentifier, last **litrpc.Account,
+	fiat *fiatRateSource) error {
+
+	stream, err := client.SubscribeAccountUpdates(
+		ctx, &litrpc.SubscribeAccountUpdatesRequest{
+			Account: ident,
+		}, grpc.WaitForReady(true),
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		acct := update.Account
+		if update.Type == litrpc.AccountUpdateType_UPDATE_EXPIRING_SOON {
+			fmt.Printf("%s  account expires soon, at %s\n",
+				time.Unix(update.Timestamp, 0).Format(
+					time.DateTime,
+				), formatExpirationDate(acct.ExpirationDate))
+
+			continue
+		}
+
+		// A new subscription always starts with the current state,
+		// which we don't print again if nothing changed while we were
+		// disconnected.
+		prev := *last
+		if prev != nil && prev.CurrentBalance == acct.CurrentBalance &&
+			prev.ExpirationDate == acct.ExpirationDate {
+
+			continue
+		}
+		*last = acct
+
+		line := formatAccountUpdate(prev, acct)
+
+		// A price source that is temporarily unavailable shouldn't
+		// stop the account from being watched.
+		if fiat != nil {
+			rate, err := fiat.rate(ctx)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "unable to fetch fiat "+
+					"rate: %v\n", err)
+			} else {
+				line += fmt.Sprintf("  (%s %s)",
+					rate.toFiat(acct.CurrentBalance),
+					rate.Currency)
+			}
+		}
+
+		fmt.Println(line)
+	}
+}
+
+// formatAccountUpdate returns a single line describing the given account
+// state and the change of its balance compared to the previous state, if
+// there is one.
+func formatAccountUpdate(prev, acct *litrpc.Account) string {
+	delta := ""
+	if prev != nil {
+		delta = fmt.Sprintf(" (%+d)",
+			acct.CurrentBalance-prev.CurrentBalance)
+	}
+
+	return fmt.Sprintf("%s  balance %d sat%s  expires %s",
+		time.Unix(acct.LastUpdate, 0).Format(time.DateTime),
+		acct.CurrentBalance, delta,
+		formatExpirationDate(acct.ExpirationDate))
+}
+
+var accountTransactionsCommand = cli.Command{
+	Name:      "transactions",
+	ShortName: "t",
+	Usage:     "Show the payments debited from an off-chain account.",
+	ArgsUsage: "[id | label]",
+	Description: "Lists all succeeded payments of an account together " +
+		"with the routing fee that was paid and the node the " +
+		"payment was sent to.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+	},
+	Action: accountTransactions,
+}
+
+// accountDebit is a single payment debit as displayed by the transactions
+// command.
+type accountDebit struct {
+	PaymentHash    string `json:"payment_hash"`
+	AmountSat      int64  `json:"amount_sat"`
+	RoutingFeeMsat uint64 `json:"routing_fee_msat"`
+	Destination    string `json:"destination"`
+}
+
+func accountTransactions(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	id, label, _, err := parseIDOrLabel(cli)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.AccountInfo(ctx, &litrpc.AccountInfoRequest{
+		Id:    id,
+		Label: label,
+	})
+	if err != nil {
+		return err
+	}
+
+	debits := make([]*accountDebit, 0, len(resp.Payments))
+	for _, payment := range resp.Payments {
+		if payment.State != lnrpc.Payment_SUCCEEDED.String() {
+			continue
+		}
+
+		debits = append(debits, &accountDebit{
+			PaymentHash:    hex.EncodeToString(payment.Hash),
+			AmountSat:      payment.FullAmount,
+			RoutingFeeMsat: payment.RoutingFeeMsat,
+			Destination:    payment.Destination,
+		})
+	}
+
+	printJSON(debits)
+	return nil
+}
+
+var accountEventsCommand = cli.Command{
+	Name:      "events",
+	ShortName: "e",
+	Usage:     "Stream the payment events of off-chain accounts.",
+	ArgsUsage: "[id | label]",
+	Description: "Streams the lifecycle events (initiated, in flight, " +
+		"settled, failed) of all payments charged to an account as " +
+		"they happen. If no account is given, the events of all " +
+		"accounts are shown.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "(optional) The ID of the account.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+	},
+	Action: accountEvents,
+}
+
+func accountEvents(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	req := &litrpc.SubscribePaymentEventsRequest{}
+	if cli.IsSet(idName) || cli.IsSet(labelName) || cli.Args().Present() {
+		req.Account, _, err = parseAccountIdentifier(cli)
+		if err != nil {
+			return err
+		}
+	}
+
+	stream, err := client.SubscribePaymentEvents(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
+
+var accountLifecycleCommand = cli.Command{
+	Name:  "lifecycle",
+	Usage: "Stream the creation, changes and removal of accounts.",
+	Description: "Streams an event with the full account whenever an " +
+		"account is created or updated and with the account ID " +
+		"whenever one is removed. Pass the resume token of the last " +
+		"event that was received to also show the events that were " +
+		"missed since.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "resume_token",
+			Usage: "(optional) The resume token of the last event " +
+				"that was received.",
+		},
+	},
+	Action: accountLifecycle,
+}
+
+func accountLifecycle(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	stream, err := client.SubscribeAccountLifecycle(
+		ctx, &litrpc.SubscribeAccountLifecycleRequest{
+			ResumeToken: cli.String("resume_token"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
+
+var verifyAccountsCommand = cli.Command{
+	Name:  "verify",
+	Usage: "Check the accounts database for inconsistencies.",
+	Description: "Checks all accounts for inconsistencies such as " +
+		"duplicate labels, negative balances, invoices or payments " +
+		"that are associated with more than one account and " +
+		"in-flight payments that aren't tracked. The database is " +
+		"only read, so this is safe to run on a live node.",
+	Action: verifyAccounts,
+}
+
+func verifyAccounts(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	resp, err := client.VerifyAccountsStore(
+		ctx, &litrpc.VerifyAccountsStoreRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var inFlightExposureCommand = cli.Command{
+	Name:  "exposure",
+	Usage: "Show the total amount of in-flight account payments.",
+	Description: "Shows the total amount of all payments charged to " +
+		"accounts that are currently in flight, including the " +
+		"maximum routing fees reserved for them.",
+	Action: inFlightExposure,
+}
+
+func inFlightExposure(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	resp, err := client.GetTotalInFlightExposure(
+		ctx, &litrpc.GetTotalInFlightExposureRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var accountHistoryCommand = cli.Command{
+	Name:      "history",
+	Usage:     "Show the lifecycle history of an off-chain account.",
+	ArgsUsage: "[id | label]",
+	Description: "Prints a timeline of the lifecycle events of an " +
+		"account, such as its creation, manual changes of its " +
+		"balance, expiry, limits or label and its removal, " +
+		"together with who made each change. The history of a " +
+		"removed account can still be shown by its ID.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.BoolFlag{
+			Name: "json",
+			Usage: "Print the events as JSON instead of a " +
+				"timeline.",
+		},
+	},
+	Action: accountHistory,
+}
+
+func accountHistory(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	id, label, _, err := parseIDOrLabel(cli)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAccountHistory(
+		ctx, &litrpc.GetAccountHistoryRequest{
+			Id:    id,
+			Label: label,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if cli.Bool("json") {
+		printRespJSON(resp)
+		return nil
+	}
+
+	for _, event := range resp.Events {
+		fmt.Printf("%s  %-23s  %s (by %s)\n",
+			time.Unix(event.Timestamp, 0).Format(time.RFC3339),
+			event.Type, event.Details, event.Actor)
+	}
+
+	return nil
+}
+
+var accountLedgerCommand = cli.Command{
+	Name:      "ledger",
+	Usage:     "Show the balance changes of an off-chain account.",
+	ArgsUsage: "[id | label]",
+	Description: "Prints every change of the balance of an account, " +
+		"such as manual credits and debits, paid invoices and " +
+		"settled payments, together with the resulting balance. " +
+		"The ledger of a removed account can still be shown by " +
+		"its ID.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account.",
+		},
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.Uint64Flag{
+			Name: "start_time",
+			Usage: "Only entries recorded at or after this unix " +
+				"timestamp will be shown.",
+		},
+		cli.Uint64Flag{
+			Name: "end_time",
+			Usage: "Only entries recorded at or before this unix " +
+				"timestamp will be shown.",
+		},
+		cli.BoolFlag{
+			Name:  "json",
+			Usage: "Print the entries as JSON instead of a table.",
+		},
+	},
+	Action: accountLedger,
+}
+
+func accountLedger(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	id, label, _, err := parseIDOrLabel(cli)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetAccountLedger(
+		ctx, &litrpc.GetAccountLedgerRequest{
+			Id:        id,
+			Label:     label,
+			StartTime: cli.Uint64("start_time"),
+			EndTime:   cli.Uint64("end_time"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if cli.Bool("json") {
+		printRespJSON(resp)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "TIME\tTYPE\tDELTA (mSAT)\tBALANCE (mSAT)\t"+
+		"REFERENCE")
+	for _, entry := range resp.Entries {
+		reference := entry.Reference
+		if reference == "" {
+			reference = "-"
+		}
+
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%+d\t%d\t%s\n",
+			time.Unix(entry.Timestamp, 0).Format(time.RFC3339),
+			entry.Type, entry.DeltaMsat, entry.BalanceMsat,
+			reference)
+	}
+
+	return w.Flush()
+}
+
+var accountRunwayCommand = cli.Command{
+	Name:      "runway",
+	Usage:     "Estimate how long the balance of an account lasts.",
+	ArgsUsage: "[id | label]",
+	Description: "Averages the amount the account spent on payments " +
+		"over a recent window and estimates when its balance " +
+		"reaches zero if it keeps spending at that rate.",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  idName,
+			Usage: "The ID of the account.",
 		},
-		cli.Int64Flag{
-			Name: "new_expiration_date",
-			Usage: "The new expiration date of the account " +
-				"expressed in seconds since the unix epoch; " +
-				"-1 means do not update the expiration date; " +
-				"0 means it does not expire.",
-			Value: -1,
+		cli.StringFlag{
+			Name:  labelName,
+			Usage: "(optional) The unique label of the account.",
+		},
+		cli.DurationFlag{
+			Name:  "window",
+			Usage: "The window to average the spend rate over.",
+			Value: accounts.DefaultRunwayWindow,
 		},
 	},
-	Action: updateAccount,
-	Subcommands: []cli.Command{
-		creditCommand,
-		debitCommand,
-	},
+	Action: accountRunway,
 }
 
-func updateAccount(cli *cli.Context) error {
+func accountRunway(cli *cli.Context) error {
 	ctx := getContext()
 	clientConn, cleanup, err := connectClient(cli, false)
 	if err != nil {
@@ -190,111 +2362,87 @@ func updateAccount(cli *cli.Context) error {
 	defer cleanup()
 	client := litrpc.NewAccountsClient(clientConn)
 
-	id, label, args, err := parseIDOrLabel(cli)
+	id, label, _, err := parseIDOrLabel(cli)
 	if err != nil {
 		return err
 	}
 
-	var (
-		newBalance     int64
-		expirationDate int64
-	)
-	switch {
-	case cli.IsSet("new_balance"):
-		newBalance = cli.Int64("new_balance")
-	case args.Present():
-		newBalance, err = strconv.ParseInt(args.First(), 10, 64)
-		if err != nil {
-			return fmt.Errorf("unable to decode balance %v", err)
-		}
-		args = args.Tail()
-	}
-
-	switch {
-	case cli.IsSet("new_expiration_date"):
-		expirationDate = cli.Int64("new_expiration_date")
-	case args.Present():
-		expirationDate, err = strconv.ParseInt(args.First(), 10, 64)
-		if err != nil {
-			return fmt.Errorf(
-				"unable to decode expiration_date: %v", err,
-			)
-		}
-		args = args.Tail()
+	window := cli.Duration("window")
+	if window < time.Second {
+		return fmt.Errorf("window must be at least one second")
 	}
 
-	req := &litrpc.UpdateAccountRequest{
-		Id:             id,
-		Label:          label,
-		AccountBalance: newBalance,
-		ExpirationDate: expirationDate,
-	}
-	resp, err := client.UpdateAccount(ctx, req)
+	resp, err := client.EstimateAccountRunway(
+		ctx, &litrpc.EstimateAccountRunwayRequest{
+			Id:            id,
+			Label:         label,
+			WindowSeconds: uint64(window.Seconds()),
+		},
+	)
 	if err != nil {
 		return err
 	}
 
 	printRespJSON(resp)
+
+	if resp.DepletionDate == 0 {
+		fmt.Printf("No payments in the last %v, the balance of %d "+
+			"sats is not being spent\n", window,
+			resp.CurrentBalance)
+
+		return nil
+	}
+
+	fmt.Printf("Spent %d sats in %d payments in the last %v (%.2f sats "+
+		"per day), the balance of %d sats lasts %.1f more days "+
+		"until %s\n", resp.SpentSat, resp.NumPayments, window,
+		resp.SpendRateSatPerDay, resp.CurrentBalance,
+		resp.DaysRemaining, time.Unix(resp.DepletionDate, 0).Format(
+			time.RFC3339,
+		))
+
 	return nil
 }
 
-var creditCommand = cli.Command{
-	Name:      "credit",
-	ShortName: "c",
-	Usage:     "Increase an account's balance by the given amount.",
-	ArgsUsage: "[id | label] amount",
-	Description: "Increases an existing off-chain account's balance by " +
-		"the given amount.",
-	Flags: []cli.Flag{
-		cli.StringFlag{
-			Name:  idName,
-			Usage: "The ID of the account to credit.",
-		},
-		cli.StringFlag{
-			Name:  labelName,
-			Usage: "(optional) The unique label of the account.",
-		},
-		cli.Uint64Flag{
-			Name:  "amount",
-			Usage: "The amount to credit the account.",
-		},
+var creditRuleCommand = cli.Command{
+	Name:  "credit-rule",
+	Usage: "Manage rules that credit accounts for settled invoices.",
+	Description: "Credit rules map invoice memo prefixes to accounts. " +
+		"Once an invoice that isn't associated with any account " +
+		"is settled, the account of the rule with the longest " +
+		"matching memo prefix is credited with the paid amount.",
+	Subcommands: []cli.Command{
+		addCreditRuleCommand,
+		listCreditRulesCommand,
+		removeCreditRuleCommand,
 	},
-	Action: creditBalance,
-}
-
-func creditBalance(cli *cli.Context) error {
-	return updateBalance(cli, true)
 }
 
-var debitCommand = cli.Command{
-	Name:      "debit",
-	ShortName: "d",
-	Usage:     "Decrease an account's balance by the given amount.",
-	ArgsUsage: "[id | label] amount",
-	Description: "Decreases an existing off-chain account's balance by " +
-		"the given amount.",
+var addCreditRuleCommand = cli.Command{
+	Name:      "add",
+	Usage:     "Credit an account for invoices with a memo prefix.",
+	ArgsUsage: "[id | label] memo_prefix",
+	Description: "Adds a rule that credits the account for every " +
+		"invoice that is settled from now on and has a memo " +
+		"starting with the given prefix.",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  idName,
-			Usage: "The ID of the account to debit.",
+			Usage: "The ID of the account to credit.",
 		},
 		cli.StringFlag{
 			Name:  labelName,
 			Usage: "(optional) The unique label of the account.",
 		},
-		cli.Uint64Flag{
-			Name:  "amount",
-			Usage: "The amount to debit the account.",
+		cli.StringFlag{
+			Name:  "memo_prefix",
+			Usage: "The memo prefix of the invoices to credit.",
 		},
 	},
-	Action: debitBalance,
-}
-
-func debitBalance(cli *cli.Context) error {
-	return updateBalance(cli, false)
+	Action: addCreditRule,
 }
 
-func updateBalance(cli *cli.Context, add bool) error {
+func addCreditRule(cli *cli.Context) error {
 	ctx := getContext()
 	clientConn, cleanup, err := connectClient(cli, false)
 	if err != nil {
@@ -303,70 +2451,42 @@ func updateBalance(cli *cli.Context, add bool) error {
 	defer cleanup()
 	client := litrpc.NewAccountsClient(clientConn)
 
-	account, args, err := parseAccountIdentifier(cli)
+	id, label, args, err := parseIDOrLabel(cli)
 	if err != nil {
 		return err
 	}
 
-	if (!cli.IsSet("amount") && len(args) != 1) ||
-		(cli.IsSet("amount") && len(args) != 0) {
-
-		return errors.New("invalid number of arguments")
-	}
-
-	var amount uint64
+	var memoPrefix string
 	switch {
-	case cli.IsSet("amount"):
-		amount = cli.Uint64("amount")
+	case cli.IsSet("memo_prefix"):
+		memoPrefix = cli.String("memo_prefix")
 	case args.Present():
-		amount, err = strconv.ParseUint(args.First(), 10, 64)
-		if err != nil {
-			return fmt.Errorf("unable to decode amount %v", err)
-		}
-		args = args.Tail()
+		memoPrefix = args.First()
 	default:
-		return errors.New("must set a value for amount")
+		return fmt.Errorf("memo prefix argument missing")
 	}
 
-	if add {
-		req := &litrpc.CreditAccountRequest{
-			Account: account,
-			Amount:  amount,
-		}
-
-		resp, err := client.CreditAccount(ctx, req)
-		if err != nil {
-			return err
-		}
-
-		printRespJSON(resp)
-	} else {
-		req := &litrpc.DebitAccountRequest{
-			Account: account,
-			Amount:  amount,
-		}
-
-		resp, err := client.DebitAccount(ctx, req)
-		if err != nil {
-			return err
-		}
-
-		printRespJSON(resp)
+	resp, err := client.AddCreditRule(ctx, &litrpc.AddCreditRuleRequest{
+		MemoPrefix: memoPrefix,
+		Id:         id,
+		Label:      label,
+	})
+	if err != nil {
+		return err
 	}
 
+	printRespJSON(resp)
 	return nil
 }
 
-var listAccountsCommand = cli.Command{
-	Name:      "list",
-	ShortName: "l",
-	Usage:     "List all off-chain accounts.",
-	Description: "Returns all accounts that are currently stored in " +
-		"the account database.",
-	Action: listAccounts,
+var listCreditRulesCommand = cli.Command{
+	Name:        "list",
+	Usage:       "List all credit rules.",
+	Description: "Lists all credit rules, ordered by their memo prefix.",
+	Action:      listCreditRules,
 }
 
-func listAccounts(cli *cli.Context) error {
+func listCreditRules(cli *cli.Context) error {
 	ctx := getContext()
 	clientConn, cleanup, err := connectClient(cli, false)
 	if err != nil {
@@ -375,8 +2495,9 @@ func listAccounts(cli *cli.Context) error {
 	defer cleanup()
 	client := litrpc.NewAccountsClient(clientConn)
 
-	req := &litrpc.ListAccountsRequest{}
-	resp, err := client.ListAccounts(ctx, req)
+	resp, err := client.ListCreditRules(
+		ctx, &litrpc.ListCreditRulesRequest{},
+	)
 	if err != nil {
 		return err
 	}
@@ -385,27 +2506,21 @@ func listAccounts(cli *cli.Context) error {
 	return nil
 }
 
-var accountInfoCommand = cli.Command{
-	Name:      "info",
-	ShortName: "i",
-	Usage:     "Show information about a single off-chain account.",
-	ArgsUsage: "[id | label]",
-	Description: "Returns a single account entry from the account " +
-		"database.",
+var removeCreditRuleCommand = cli.Command{
+	Name:        "remove",
+	Usage:       "Remove a credit rule.",
+	ArgsUsage:   "memo_prefix",
+	Description: "Removes the credit rule for the given memo prefix.",
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:  idName,
-			Usage: "The ID of the account.",
-		},
-		cli.StringFlag{
-			Name:  labelName,
-			Usage: "(optional) The unique label of the account.",
+			Name:  "memo_prefix",
+			Usage: "The memo prefix of the rule to remove.",
 		},
 	},
-	Action: accountInfo,
+	Action: removeCreditRule,
 }
 
-func accountInfo(cli *cli.Context) error {
+func removeCreditRule(cli *cli.Context) error {
 	ctx := getContext()
 	clientConn, cleanup, err := connectClient(cli, false)
 	if err != nil {
@@ -414,16 +2529,68 @@ func accountInfo(cli *cli.Context) error {
 	defer cleanup()
 	client := litrpc.NewAccountsClient(clientConn)
 
-	id, label, _, err := parseIDOrLabel(cli)
+	var memoPrefix string
+	switch {
+	case cli.IsSet("memo_prefix"):
+		memoPrefix = cli.String("memo_prefix")
+	case cli.Args().Present():
+		memoPrefix = cli.Args().First()
+	default:
+		return fmt.Errorf("memo prefix argument missing")
+	}
+
+	resp, err := client.RemoveCreditRule(
+		ctx, &litrpc.RemoveCreditRuleRequest{
+			MemoPrefix: memoPrefix,
+		},
+	)
 	if err != nil {
 		return err
 	}
 
-	req := &litrpc.AccountInfoRequest{
-		Id:    id,
-		Label: label,
+	printRespJSON(resp)
+	return nil
+}
+
+var transferBalanceCommand = cli.Command{
+	Name:      "transfer",
+	Usage:     "Move balance from one off-chain account to another.",
+	ArgsUsage: "from to amount",
+	Description: "Moves the given amount in satoshis from the account " +
+		"identified by the ID or label 'from' to the account " +
+		"identified by 'to'. Both balances are updated in a single " +
+		"database transaction. The transfer is rejected if the " +
+		"source account doesn't have enough balance that isn't " +
+		"reserved for in-flight payments.",
+	Action: transferBalance,
+}
+
+func transferBalance(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
 	}
-	resp, err := client.AccountInfo(ctx, req)
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	args := cli.Args()
+	if len(args) != 3 {
+		return errors.New("invalid number of arguments, expected " +
+			"from, to and amount")
+	}
+
+	amount, err := strconv.ParseUint(args.Get(2), 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to decode amount %v", err)
+	}
+
+	req := &litrpc.TransferAccountBalanceRequest{
+		Source:      identifierFromValue(args.Get(0)),
+		Destination: identifierFromValue(args.Get(1)),
+		Amount:      amount,
+	}
+	resp, err := client.TransferAccountBalance(ctx, req)
 	if err != nil {
 		return err
 	}
@@ -433,11 +2600,16 @@ func accountInfo(cli *cli.Context) error {
 }
 
 var removeAccountCommand = cli.Command{
-	Name:        "remove",
-	ShortName:   "r",
-	Usage:       "Remove an off-chain account from the database.",
-	ArgsUsage:   "[id | label]",
-	Description: "Removes an account entry from the account database.",
+	Name:      "remove",
+	ShortName: "r",
+	Usage:     "Remove an off-chain account from the database.",
+	ArgsUsage: "[id | label] [--sweep_to=ID|LABEL] [--force]",
+	Description: "Removes an account entry from the account database. " +
+		"Use --sweep_to to credit the remaining balance of the " +
+		"account to another account in the same step. An account " +
+		"that still has a balance can only be removed without " +
+		"--sweep_to if --force is set, as its balance is dropped " +
+		"with it.",
 	Flags: []cli.Flag{
 		cli.StringFlag{
 			Name:  idName,
@@ -447,6 +2619,17 @@ var removeAccountCommand = cli.Command{
 			Name:  labelName,
 			Usage: "(optional) The unique label of the account.",
 		},
+		cli.StringFlag{
+			Name: "sweep_to, refund_to",
+			Usage: "(optional) The ID or label of the account to " +
+				"credit the remaining balance to.",
+		},
+		cli.BoolFlag{
+			Name: "force",
+			Usage: "Remove the account even if it still has a " +
+				"balance and no account to credit it to is " +
+				"set.",
+		},
 	},
 	Action: removeAccount,
 }
@@ -469,8 +2652,78 @@ func removeAccount(cli *cli.Context) error {
 		Id:    id,
 		Label: label,
 	}
-	_, err = client.RemoveAccount(ctx, req)
-	return err
+	if cli.IsSet("sweep_to") {
+		req.SweepToId, req.SweepToLabel = splitIDOrLabel(
+			cli.String("sweep_to"),
+		)
+	}
+
+	// Make sure the balance of the account isn't dropped by accident.
+	if !cli.IsSet("sweep_to") && !cli.Bool("force") {
+		acct, err := client.AccountInfo(ctx, &litrpc.AccountInfoRequest{
+			Id:    id,
+			Label: label,
+		})
+		if err != nil {
+			return err
+		}
+
+		if acct.CurrentBalance != 0 {
+			return fmt.Errorf("account still has a balance of %d "+
+				"sats, use --sweep_to to credit it to another "+
+				"account or --force to drop it",
+				acct.CurrentBalance)
+		}
+	}
+
+	resp, err := client.RemoveAccount(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if cli.IsSet("sweep_to") {
+		printRespJSON(resp)
+	}
+
+	return nil
+}
+
+var purgeAccountsCommand = cli.Command{
+	Name:  "gc",
+	Usage: "Remove all expired off-chain accounts from the database.",
+	Description: "Removes all expired account entries from the account " +
+		"database and prints the removed accounts. Use --preview to " +
+		"only print the accounts that would be removed, including " +
+		"their balances, without removing them.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "preview",
+			Usage: "Only list the expired accounts that would be " +
+				"removed without removing them.",
+		},
+	},
+	Action: purgeAccounts,
+}
+
+func purgeAccounts(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewAccountsClient(clientConn)
+
+	req := &litrpc.PurgeExpiredAccountsRequest{
+		Preview: cli.Bool("preview"),
+	}
+	resp, err := client.PurgeExpiredAccounts(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
 }
 
 // parseAccountIdentifier parses either the id or label from the command line,
@@ -506,7 +2759,10 @@ func parseAccountIdentifier(ctx *cli.Context) (
 	}
 }
 
-// parseIDOrLabel parses either the id or label from the command line.
+// parseIDOrLabel parses either the id or label from the command line. A
+// positional argument is told apart by its form, which also works for accounts
+// that were created with a custom ID, as those have the same length as random
+// ones and labels can't look like an ID.
 func parseIDOrLabel(ctx *cli.Context) (string, string, cli.Args, error) {
 	var (
 		accountID string
@@ -526,26 +2782,47 @@ func parseIDOrLabel(ctx *cli.Context) (string, string, cli.Args, error) {
 		label = ctx.String(labelName)
 
 	case args.Present():
-		accountID = args.First()
+		accountID, label = splitIDOrLabel(args.First())
 		args = args.Tail()
 
-		// Since we have a positional argument, we cannot be sure it's
-		// an ID. So we check if it's an ID by trying to hex decode it
-		// and by checking the length. This will break if the user
-		// chooses labels that are also valid hex encoded IDs. But since
-		// the label is supposed to be human-readable, this should be
-		// unlikely.
-		_, err := hex.DecodeString(accountID)
-		if len(accountID) != hex.EncodedLen(accounts.AccountIDLen) ||
-			err != nil {
-
-			label = accountID
-			accountID = ""
-		}
-
 	default:
 		return "", "", nil, fmt.Errorf("id argument missing")
 	}
 
 	return accountID, label, args, nil
 }
+
+// identifierFromValue returns an account identifier for the given value, which
+// is treated as an account ID if it looks like one and as a label otherwise.
+func identifierFromValue(value string) *litrpc.AccountIdentifier {
+	id, label := splitIDOrLabel(value)
+	if id != "" {
+		return &litrpc.AccountIdentifier{
+			Identifier: &litrpc.AccountIdentifier_Id{
+				Id: id,
+			},
+		}
+	}
+
+	return &litrpc.AccountIdentifier{
+		Identifier: &litrpc.AccountIdentifier_Label{
+			Label: label,
+		},
+	}
+}
+
+// splitIDOrLabel returns the given value as the account ID if it looks like
+// one and as the label otherwise.
+func splitIDOrLabel(value string) (string, string) {
+	// We cannot be sure the value is an ID. So we check if it's an ID by
+	// trying to hex decode it and by checking the length. This will break
+	// if the user chooses labels that are also valid hex encoded IDs. But
+	// since the label is supposed to be human-readable, this should be
+	// unlikely.
+	_, err := hex.DecodeString(value)
+	if len(value) != hex.EncodedLen(accounts.AccountIDLen) || err != nil {
+		return "", value
+	}
+
+	return value, ""
+}
@@ -3,8 +3,12 @@ package main
 import (
 	"encoding/hex"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/urfave/cli"
 )
 
@@ -152,6 +156,203 @@ func listActions(cli *cli.Context) error {
 	return nil
 }
 
+var firewallCommands = cli.Command{
+	Name:     "firewall",
+	Usage:    "Inspect and tune the firewall rules",
+	Category: "Firewall",
+	Subcommands: []cli.Command{
+		replayActionsCommand,
+		auditLogCommand,
+	},
+}
+
+var auditLogCommand = cli.Command{
+	Name:      "audit",
+	ShortName: "a",
+	Usage:     "List the decisions the firewall made for session requests",
+	Description: `
+	Lists the entries of the firewall's audit log. An entry is recorded for
+	every request of a session that is subject to firewall rules, stating
+	whether the request was allowed or denied and which rules denied it.
+	`,
+	Action: auditLog,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "session",
+			Usage: "The hex encoded ID of the session to list " +
+				"the entries of. If not set, the entries of " +
+				"all sessions are listed.",
+		},
+		cli.DurationFlag{
+			Name: "since",
+			Usage: "Only list the entries of this duration up " +
+				"to now, for example '24h'.",
+		},
+		cli.Uint64Flag{
+			Name: "start_timestamp",
+			Usage: "Only list the entries recorded at or after " +
+				"this unix timestamp. Can't be combined with " +
+				"'since'.",
+		},
+		cli.Uint64Flag{
+			Name: "end_timestamp",
+			Usage: "Only list the entries recorded at or before " +
+				"this unix timestamp.",
+		},
+		cli.Uint64Flag{
+			Name: "max_num_entries",
+			Usage: "The maximum number of entries to list. If " +
+				"not set, all matching entries are listed.",
+		},
+	},
+}
+
+func auditLog(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewFirewallClient(clientConn)
+
+	var sessionID []byte
+	if cli.IsSet("session") {
+		sessionID, err = hex.DecodeString(cli.String("session"))
+		if err != nil {
+			return err
+		}
+	}
+
+	startTimestamp := cli.Uint64("start_timestamp")
+	if cli.IsSet("since") {
+		if cli.IsSet("start_timestamp") {
+			return fmt.Errorf("only one of since and " +
+				"start_timestamp can be set")
+		}
+
+		since := time.Now().Add(-cli.Duration("since"))
+		startTimestamp = uint64(since.Unix())
+	}
+
+	resp, err := client.FirewallAuditLog(
+		ctx, &litrpc.FirewallAuditLogRequest{
+			SessionId:      sessionID,
+			StartTimestamp: startTimestamp,
+			EndTimestamp:   cli.Uint64("end_timestamp"),
+			MaxNumEntries:  cli.Uint64("max_num_entries"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var replayActionsCommand = cli.Command{
+	Name:      "replay",
+	ShortName: "r",
+	Usage: "Replay the past actions of a session against a proposed " +
+		"rule set",
+	ArgsUsage: "session_id --rules=FILE",
+	Description: `
+	Re-evaluates the past actions of a session against a proposed set of
+	rules in dry-run mode and reports which previously allowed actions
+	would now be denied and vice versa. Replaying has no effect on the
+	rules that are enforced for the session.
+
+	The rules file must contain a JSON object that maps feature names to
+	their rules in the same format as the RulesMap of the autopilot
+	session RPCs, for example:
+
+	{"AutoFees": {"rules": {"rate-limit": {"rate_limit": {
+	    "read_limit": {"iterations": 10, "num_hours": 1},
+	    "write_limit": {"iterations": 1, "num_hours": 1}}}}}}
+	`,
+	Action: replayActions,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "session_id",
+			Usage: "The hex encoded ID of the session to replay.",
+		},
+		cli.StringFlag{
+			Name: "rules",
+			Usage: "The path to a JSON file containing the " +
+				"proposed rules.",
+		},
+		cli.Uint64Flag{
+			Name: "start_timestamp",
+			Usage: "Only actions executed after this unix " +
+				"timestamp will be replayed.",
+		},
+		cli.Uint64Flag{
+			Name: "end_timestamp",
+			Usage: "Only actions executed before this unix " +
+				"timestamp will be replayed.",
+		},
+	},
+}
+
+func replayActions(cli *cli.Context) error {
+	ctx := getContext()
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewFirewallClient(clientConn)
+
+	sessionIDStr := cli.String("session_id")
+	if cli.Args().Present() {
+		sessionIDStr = cli.Args().First()
+	}
+	if sessionIDStr == "" {
+		return fmt.Errorf("a session ID must be specified")
+	}
+
+	sessionID, err := hex.DecodeString(sessionIDStr)
+	if err != nil {
+		return err
+	}
+
+	if cli.String("rules") == "" {
+		return fmt.Errorf("a rules file must be specified")
+	}
+
+	rulesJSON, err := os.ReadFile(lncfg.CleanAndExpandPath(
+		cli.String("rules"),
+	))
+	if err != nil {
+		return fmt.Errorf("unable to read rules file: %w", err)
+	}
+
+	// The rules file only contains the feature rules map, so we wrap it
+	// into a request to be able to decode it.
+	req := &litrpc.ReplayActionsRequest{}
+	err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(
+		[]byte(fmt.Sprintf(`{"feature_rules": %s}`, rulesJSON)), req,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to parse rules file: %w", err)
+	}
+
+	req.SessionId = sessionID
+	req.StartTimestamp = cli.Uint64("start_timestamp")
+	req.EndTimestamp = cli.Uint64("end_timestamp")
+
+	resp, err := client.ReplayActions(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 func parseActionState(actionStr string) (litrpc.ActionState, error) {
 	switch actionStr {
 	case "":
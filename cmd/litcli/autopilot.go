@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -87,6 +88,7 @@ var addAutopilotSessionCmd = cli.Command{
 		labelFlag,
 		expiryFlag,
 		mailboxServerAddrFlag,
+		mailboxVerifyFlag,
 		devserver,
 		cli.StringSliceFlag{
 			Name:     "feature",
@@ -152,6 +154,28 @@ var addAutopilotSessionCmd = cli.Command{
 				"flags of the previous session. Example: " +
 				"\"ClearPubkeys|ClearAmounts\"",
 		},
+		cli.Uint64Flag{
+			Name: "amount-variation-ppm",
+			Usage: "The maximum relative variation, in parts per " +
+				"million, by which the privacy mapper " +
+				"randomizes amounts. If not set, the " +
+				"default variation of 50000 ppm is used. " +
+				"Can't be combined with --amount-bucket-size.",
+		},
+		cli.Uint64Flag{
+			Name: "amount-bucket-size",
+			Usage: "If set, the privacy mapper rounds amounts " +
+				"down to a multiple of the given bucket " +
+				"size in satoshis instead of randomizing " +
+				"them.",
+		},
+		cli.StringSliceFlag{
+			Name: "clear-amount-field",
+			Usage: "The name of an amount field, like " +
+				"local_balance or fee_msat, that the " +
+				"privacy mapper should not obfuscate. Can " +
+				"be specified multiple times.",
+		},
 	},
 }
 
@@ -439,16 +463,40 @@ func initAutopilotSession(cli *cli.Context) error {
 		privacyFlags = flags.Serialize()
 	}
 
+	var amountCfg *litrpc.AmountObfuscation
+	if cli.IsSet("amount-variation-ppm") ||
+		cli.IsSet("amount-bucket-size") ||
+		cli.IsSet("clear-amount-field") {
+
+		variation := cli.Uint64("amount-variation-ppm")
+		if variation > math.MaxUint32 {
+			return fmt.Errorf("amount variation of %d ppm is too "+
+				"large", variation)
+		}
+
+		amountCfg = &litrpc.AmountObfuscation{
+			VariationPpm:  uint32(variation),
+			BucketSizeSat: cli.Uint64("amount-bucket-size"),
+			ClearFields:   cli.StringSlice("clear-amount-field"),
+		}
+		if amountCfg.BucketSizeSat != 0 {
+			amountCfg.Strategy = litrpc.
+				AmountObfuscationStrategy_AMOUNT_OBFUSCATION_BUCKET
+		}
+	}
+
 	resp, err := client.AddAutopilotSession(
 		ctx, &litrpc.AddAutopilotSessionRequest{
 			Label:                  cli.String("label"),
 			ExpiryTimestampSeconds: uint64(sessionExpiry),
 			MailboxServerAddr:      cli.String("mailboxserveraddr"),
 			DevServer:              cli.Bool("devserver"),
+			VerifyMailboxServer:    cli.Bool("mailbox_verify"),
 			Features:               featureMap,
 			LinkedGroupId:          groupID,
 			PrivacyFlags:           privacyFlags,
 			PrivacyFlagsSet:        privacyFlagsSet,
+			AmountObfuscation:      amountCfg,
 		},
 	)
 	if err != nil {
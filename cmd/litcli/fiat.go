@@ -0,0 +1,278 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// fiatRateCacheDuration is the time for which a fetched fiat rate is
+	// reused before it is fetched again.
+	fiatRateCacheDuration = time.Minute
+
+	// fiatRateTimeout is the maximum time a request to the price source
+	// may take.
+	fiatRateTimeout = 10 * time.Second
+
+	// fiatCurrencyPlaceholder is replaced with the currency code in the
+	// URL of the price source.
+	fiatCurrencyPlaceholder = "{currency}"
+
+	// maxFiatRateResponseSize is the maximum size of a response of the
+	// price source that is read.
+	maxFiatRateResponseSize = 1 << 20
+)
+
+var fiatFlag = cli.StringFlag{
+	Name: "fiat",
+	Usage: "(optional) Also show the balance in this fiat currency, " +
+		"for example USD, using the price source that is " +
+		"configured with fiat.pricesource in litd. If set to an " +
+		"empty value, the currency configured with fiat.currency " +
+		"is used.",
+}
+
+// fiatRate is the price of one bitcoin in a fiat currency at a point in time.
+type fiatRate struct {
+	Currency  string    `json:"currency"`
+	BTCPrice  float64   `json:"btc_price"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// toFiat converts the given amount in satoshis to the fiat currency.
+func (r *fiatRate) toFiat(sats int64) string {
+	return strconv.FormatFloat(
+		float64(sats)/btcutil.SatoshiPerBitcoin*r.BTCPrice, 'f', 2,
+		64,
+	)
+}
+
+// String returns the rate and the time it was fetched at for humans.
+func (r *fiatRate) String() string {
+	return fmt.Sprintf("1 BTC = %s %s, fetched at %s",
+		strconv.FormatFloat(r.BTCPrice, 'f', 2, 64), r.Currency,
+		r.FetchedAt.Format(time.DateTime))
+}
+
+// fiatRateSource fetches the fiat rate from the price source that is
+// configured in litd. The conversion is done in litcli, so that litd doesn't
+// depend on the price source. Fetched rates are cached briefly.
+type fiatRateSource struct {
+	url      string
+	currency string
+
+	cached *fiatRate
+}
+
+// newFiatRateSource returns the price source that litd is configured with for
+// the currency given with the --fiat flag, or nil if the flag isn't set.
+func newFiatRateSource(ctx context.Context, cli *cli.Context,
+	conn grpc.ClientConnInterface) (*fiatRateSource, error) {
+
+	if !cli.IsSet(fiatFlag.Name) {
+		return nil, nil
+	}
+
+	info, err := litrpc.NewProxyClient(conn).GetInfo(
+		ctx, &litrpc.GetInfoRequest{},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch price source: %w", err)
+	}
+
+	if info.FiatPriceSource == "" {
+		return nil, fmt.Errorf("litd has no price source configured, " +
+			"set fiat.pricesource to show fiat balances")
+	}
+
+	currency := cli.String(fiatFlag.Name)
+	if currency == "" {
+		currency = info.FiatCurrency
+	}
+	if currency == "" {
+		return nil, fmt.Errorf("no currency given and litd has no " +
+			"fiat.currency configured")
+	}
+
+	return &fiatRateSource{
+		url:      info.FiatPriceSource,
+		currency: strings.ToUpper(currency),
+	}, nil
+}
+
+// rate returns the current fiat rate, which is only fetched again once the
+// cached rate is older than fiatRateCacheDuration.
+func (s *fiatRateSource) rate(ctx context.Context) (*fiatRate, error) {
+	if s.cached != nil &&
+		time.Since(s.cached.FetchedAt) < fiatRateCacheDuration {
+
+		return s.cached, nil
+	}
+
+	price, err := fetchBTCPrice(ctx, s.url, s.currency)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cached = &fiatRate{
+		Currency:  s.currency,
+		BTCPrice:  price,
+		FetchedAt: time.Now(),
+	}
+
+	return s.cached, nil
+}
+
+// fetchBTCPrice fetches the price of one bitcoin in the given currency from
+// the price source with the given URL.
+func fetchBTCPrice(ctx context.Context, sourceURL,
+	currency string) (float64, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, fiatRateTimeout)
+	defer cancel()
+
+	reqURL := strings.ReplaceAll(
+		sourceURL, fiatCurrencyPlaceholder,
+		url.QueryEscape(strings.ToLower(currency)),
+	)
+	req, err := http.NewRequestWithContext(
+		ctx, http.MethodGet, reqURL, nil,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("invalid price source URL: %w", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("unable to fetch price: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("price source returned status %s",
+			resp.Status)
+	}
+
+	body, err := io.ReadAll(
+		io.LimitReader(resp.Body, maxFiatRateResponseSize),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("unable to read price: %w", err)
+	}
+
+	var doc any
+	if err := json.Unmarshal(body, &doc); err != nil {
+		return 0, fmt.Errorf("unable to decode price: %w", err)
+	}
+
+	price, ok := findPrice(doc, currency)
+	if !ok || price <= 0 {
+		return 0, fmt.Errorf("price source response contains no %s "+
+			"price", currency)
+	}
+
+	return price, nil
+}
+
+// findPrice returns the number or numeric string under the first key that
+// matches the currency code in the given JSON document. Keys on a level are
+// checked before the objects nested below them, in alphabetical order.
+func findPrice(doc any, currency string) (float64, bool) {
+	obj, ok := doc.(map[string]any)
+	if !ok {
+		return 0, false
+	}
+
+	keys := make([]string, 0, len(obj))
+	for key := range obj {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !strings.EqualFold(key, currency) {
+			continue
+		}
+
+		switch value := obj[key].(type) {
+		case float64:
+			return value, true
+
+		case string:
+			price, err := strconv.ParseFloat(value, 64)
+			if err == nil {
+				return price, true
+			}
+		}
+	}
+
+	for _, key := range keys {
+		if price, ok := findPrice(obj[key], currency); ok {
+			return price, true
+		}
+	}
+
+	return 0, false
+}
+
+// printRespJSONWithFiat prints the given response as JSON like printRespJSON,
+// with the fiat equivalent of the balance added to the object of each of the
+// given accounts and the rate that was used added to the response. The
+// response must either be a single account or contain the accounts in its
+// accounts list.
+func printRespJSONWithFiat(resp proto.Message, accts []*litrpc.Account,
+	rate *fiatRate) error {
+
+	if rawOutput {
+		return fmt.Errorf("--fiat can't be used with --raw")
+	}
+
+	jsonBytes, err := lnrpc.ProtoJSONMarshalOpts.Marshal(resp)
+	if err != nil {
+		return fmt.Errorf("unable to decode response: %w", err)
+	}
+
+	var doc map[string]any
+	if err := json.Unmarshal(jsonBytes, &doc); err != nil {
+		return fmt.Errorf("unable to decode response: %w", err)
+	}
+
+	objs := []any{doc}
+	if list, ok := doc["accounts"].([]any); ok {
+		objs = list
+	}
+	if len(objs) != len(accts) {
+		return fmt.Errorf("unexpected number of accounts in response")
+	}
+
+	for i, obj := range objs {
+		acctObj, ok := obj.(map[string]any)
+		if !ok {
+			return fmt.Errorf("unexpected account in response")
+		}
+
+		acctObj["current_balance_fiat"] = rate.toFiat(
+			accts[i].CurrentBalance,
+		)
+	}
+	doc["fiat_rate"] = rate
+
+	printJSON(doc)
+
+	return nil
+}
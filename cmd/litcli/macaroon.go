@@ -0,0 +1,380 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/lightninglabs/lightning-terminal/accounts"
+	"github.com/lightninglabs/lightning-terminal/firewall"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	litmac "github.com/lightninglabs/lightning-terminal/macaroons"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/urfave/cli"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	"gopkg.in/macaroon.v2"
+)
+
+// macaroonCommands are commands that work with macaroons. Apart from the
+// check command, they work offline and don't need a connection to LiTd.
+var macaroonCommands = cli.Command{
+	Name:  "macaroon",
+	Usage: "Work with macaroons",
+	Description: "Commands that work with macaroons. Only the check " +
+		"command needs a connection to LiTd.",
+	Category: "LiT",
+	Subcommands: []cli.Command{
+		inspectMacaroonCmd,
+		checkMacaroonCmd,
+	},
+}
+
+// inspectMacaroonCmd is a command that decodes a macaroon and prints its
+// permissions and caveats.
+var inspectMacaroonCmd = cli.Command{
+	Name:      "inspect",
+	Usage:     "Decode a macaroon and print its permissions and caveats.",
+	ArgsUsage: "[--mac_file=PATH | --mac=HEX]",
+	Description: `Decodes the given macaroon and prints its root key ID,
+permissions, allowed URIs and caveats. The caveats that LiT and lnd
+use, such as the account, payment, firewall, expiry and IP caveats, are
+interpreted. Caveats that can't be interpreted are printed hex encoded.
+
+The macaroon is only decoded, not verified, so this works offline and
+doesn't need the root key. A macaroon that is printed by this command
+isn't necessarily valid.`,
+	Action: inspectMacaroon,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "mac_file",
+			Usage: "The path to the macaroon file.",
+		},
+		cli.StringFlag{
+			Name:  "mac",
+			Usage: "The hex-encoded macaroon.",
+		},
+	},
+}
+
+// checkMacaroonCmd is a command that checks whether a macaroon would be
+// authorized to call an RPC.
+var checkMacaroonCmd = cli.Command{
+	Name:      "check",
+	Usage:     "Check whether a macaroon may call an RPC.",
+	ArgsUsage: "--uri=URI [--mac_file=PATH | --mac=HEX]",
+	Description: `Asks LiTd whether the given macaroon would be authorized to
+call the RPC with the given URI, for example /lnrpc.Lightning/GetInfo,
+without making the call. LiTd validates the macaroon the same way it
+validates the call itself, including its caveats. If the call would be
+denied, the caveat or the permissions that block it are printed.
+
+If no macaroon is given, the macaroon that litcli connects with is
+checked. The custom caveats that are enforced on the content of a
+request, such as the balance of an account or the firewall rules of a
+session, aren't part of the check.`,
+	Action: checkMacaroon,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:     "uri",
+			Usage:    "The full URI of the RPC to check.",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  "mac_file",
+			Usage: "(optional) The path to the macaroon file.",
+		},
+		cli.StringFlag{
+			Name:  "mac",
+			Usage: "(optional) The hex-encoded macaroon.",
+		},
+	},
+}
+
+func checkMacaroon(ctx *cli.Context) error {
+	req := &litrpc.CheckPermissionRequest{
+		Uri: ctx.String("uri"),
+	}
+
+	var err error
+	switch {
+	case ctx.IsSet("mac_file") && ctx.IsSet("mac"):
+		return fmt.Errorf("only one of --mac_file and --mac can be set")
+
+	case ctx.IsSet("mac_file"):
+		macPath := lncfg.CleanAndExpandPath(ctx.String("mac_file"))
+		req.Macaroon, err = os.ReadFile(macPath)
+		if err != nil {
+			return fmt.Errorf("unable to read macaroon file %v: %w",
+				macPath, err)
+		}
+
+	case ctx.IsSet("mac"):
+		req.Macaroon, err = hex.DecodeString(ctx.String("mac"))
+		if err != nil {
+			return fmt.Errorf("unable to hex decode macaroon: %w",
+				err)
+		}
+	}
+
+	clientConn, cleanup, err := connectClient(ctx, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewProxyClient(clientConn)
+
+	resp, err := client.CheckPermission(getContext(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// inspectedCaveat is the decoded form of a single macaroon caveat.
+type inspectedCaveat struct {
+	Type  string `json:"type"`
+	Value any    `json:"value,omitempty"`
+	Hex   string `json:"hex,omitempty"`
+}
+
+// inspectedMacaroon is the decoded form of a macaroon.
+type inspectedMacaroon struct {
+	Version       uint16             `json:"version"`
+	Location      string             `json:"location"`
+	RootKeyID     string             `json:"root_key_id"`
+	SuperMacaroon bool               `json:"super_macaroon"`
+	SessionID     string             `json:"session_id,omitempty"`
+	Permissions   []string           `json:"permissions"`
+	AllowedURIs   []string           `json:"allowed_uris,omitempty"`
+	Expiry        string             `json:"expiry,omitempty"`
+	Caveats       []*inspectedCaveat `json:"caveats"`
+}
+
+func inspectMacaroon(ctx *cli.Context) error {
+	var (
+		macBytes []byte
+		err      error
+	)
+	switch {
+	case ctx.IsSet("mac_file") && ctx.IsSet("mac"):
+		return fmt.Errorf("only one of --mac_file and --mac can be set")
+
+	case ctx.IsSet("mac_file"):
+		macPath := lncfg.CleanAndExpandPath(ctx.String("mac_file"))
+		macBytes, err = os.ReadFile(macPath)
+		if err != nil {
+			return fmt.Errorf("unable to read macaroon file %v: %w",
+				macPath, err)
+		}
+
+	case ctx.IsSet("mac"):
+		macBytes, err = hex.DecodeString(ctx.String("mac"))
+		if err != nil {
+			return fmt.Errorf("unable to hex decode macaroon: %w",
+				err)
+		}
+
+	default:
+		return cli.ShowCommandHelp(ctx, "inspect")
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return fmt.Errorf("unable to decode macaroon: %w", err)
+	}
+
+	inspected, err := decodeMacaroon(mac)
+	if err != nil {
+		return err
+	}
+
+	printJSON(inspected)
+
+	return nil
+}
+
+// decodeMacaroon decodes the identifier and the caveats of the given macaroon.
+func decodeMacaroon(mac *macaroon.Macaroon) (*inspectedMacaroon, error) {
+	rawID := mac.Id()
+	if len(rawID) == 0 || rawID[0] != byte(bakery.LatestVersion) {
+		return nil, fmt.Errorf("invalid macaroon version: %x", rawID)
+	}
+
+	decodedID := &lnrpc.MacaroonId{}
+	if err := proto.Unmarshal(rawID[1:], decodedID); err != nil {
+		return nil, fmt.Errorf("unable to decode macaroon ID: %w", err)
+	}
+
+	inspected := &inspectedMacaroon{
+		Version:     uint16(mac.Version()),
+		Location:    mac.Location(),
+		RootKeyID:   string(decodedID.StorageId),
+		Permissions: []string{},
+		Caveats:     []*inspectedCaveat{},
+	}
+
+	// Super macaroons that are baked for a session carry the session ID
+	// in the last 4 bytes of their root key ID.
+	rootKeyID, err := litmac.RootKeyIDFromMacaroon(mac)
+	if err == nil {
+		var rootKeyBytes [8]byte
+		binary.BigEndian.PutUint64(rootKeyBytes[:], rootKeyID)
+
+		prefix := litmac.SuperMacaroonRootKeyPrefix
+		if bytes.HasPrefix(rootKeyBytes[:], prefix[:]) {
+			sessionID := session.IDFromMacRootKeyID(rootKeyID)
+			inspected.SuperMacaroon = true
+			inspected.SessionID = hex.EncodeToString(sessionID[:])
+		}
+	}
+
+	for _, op := range decodedID.Ops {
+		for _, action := range op.Actions {
+			if op.Entity == macaroons.PermissionEntityCustomURI {
+				inspected.AllowedURIs = append(
+					inspected.AllowedURIs, action,
+				)
+
+				continue
+			}
+
+			inspected.Permissions = append(
+				inspected.Permissions,
+				fmt.Sprintf("%s:%s", op.Entity, action),
+			)
+		}
+	}
+
+	var expiry time.Time
+	for _, caveat := range mac.Caveats() {
+		decoded := decodeCaveat(caveat)
+		inspected.Caveats = append(inspected.Caveats, decoded)
+
+		t, ok := decoded.Value.(time.Time)
+		if ok && (expiry.IsZero() || t.Before(expiry)) {
+			expiry = t
+		}
+	}
+	if !expiry.IsZero() {
+		inspected.Expiry = expiry.Format(time.RFC3339)
+	}
+
+	return inspected, nil
+}
+
+// decodeCaveat interprets the given caveat. Caveats that LiT and lnd don't use
+// are printed as their condition, or hex encoded if they aren't a readable
+// condition.
+func decodeCaveat(caveat macaroon.Caveat) *inspectedCaveat {
+	rawHex := &inspectedCaveat{
+		Type: "unknown",
+		Hex:  hex.EncodeToString(caveat.Id),
+	}
+
+	if len(caveat.VerificationId) > 0 {
+		rawHex.Type = "third-party"
+		rawHex.Value = caveat.Location
+
+		return rawHex
+	}
+
+	if !utf8.Valid(caveat.Id) {
+		return rawHex
+	}
+
+	cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+	if err != nil {
+		return rawHex
+	}
+
+	switch cond {
+	case checkers.CondTimeBefore:
+		t, err := time.Parse(time.RFC3339Nano, arg)
+		if err != nil {
+			return rawHex
+		}
+
+		return &inspectedCaveat{Type: "expiry", Value: t}
+
+	case "ipaddr":
+		return &inspectedCaveat{Type: "ip-address", Value: arg}
+
+	case macaroons.CondIPRange:
+		return &inspectedCaveat{Type: "ip-range", Value: arg}
+
+	case macaroons.CondLndCustom:
+		return decodeCustomCaveat(caveat, arg)
+
+	default:
+		return &inspectedCaveat{Type: cond, Value: arg}
+	}
+}
+
+// decodeCustomCaveat interprets the given lnd custom caveat with the given
+// argument, which consists of the custom caveat name followed by its
+// condition.
+func decodeCustomCaveat(caveat macaroon.Caveat,
+	arg string) *inspectedCaveat {
+
+	name, condition, _ := strings.Cut(arg, " ")
+
+	switch name {
+	case accounts.CondAccount:
+		hash, isPayment := strings.CutPrefix(condition, "payment_hash ")
+		if isPayment {
+			return &inspectedCaveat{
+				Type:  "account-payment-hash",
+				Value: hash,
+			}
+		}
+
+		id, err := accounts.ParseAccountID(condition)
+		if err != nil {
+			break
+		}
+
+		return &inspectedCaveat{
+			Type:  "account",
+			Value: hex.EncodeToString(id[:]),
+		}
+
+	case firewall.RuleEnforcerCaveat:
+		metaInfo, err := firewall.ParseMetaInfoCaveat(string(caveat.Id))
+		if err == nil {
+			return &inspectedCaveat{
+				Type:  "firewall-meta-info",
+				Value: metaInfo,
+			}
+		}
+
+		rules, err := firewall.ParseRuleCaveat(string(caveat.Id))
+		if err == nil {
+			return &inspectedCaveat{
+				Type:  "firewall-rules",
+				Value: rules,
+			}
+		}
+
+	case firewall.CondPrivacy:
+		return &inspectedCaveat{Type: "privacy-mapper"}
+	}
+
+	return &inspectedCaveat{
+		Type:  fmt.Sprintf("custom:%s", name),
+		Value: condition,
+		Hex:   hex.EncodeToString(caveat.Id),
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/urfave/cli"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 	"gopkg.in/macaroon.v2"
 )
@@ -36,6 +37,7 @@ const (
 	envVarMacaroonPath    = "LITCLI_MACAROONPATH"
 	envVarLNDDir          = "LITCLI_LNDDIR"
 	envVarMacaroonTimeout = "LITCLI_MACAROONTIMEOUT"
+	envVarRawOutput       = "LITCLI_RAW"
 )
 
 var (
@@ -68,6 +70,16 @@ var (
 		Value:  terminal.DefaultMacaroonPath,
 		EnvVar: envVarMacaroonPath,
 	}
+	rawOutputFlag = cli.BoolFlag{
+		Name: "raw",
+		Usage: "Print RPC responses in the protobuf text format " +
+			"instead of reformatting them as JSON",
+		EnvVar: envVarRawOutput,
+	}
+
+	// rawOutput is set if the global raw output flag was specified. It
+	// is read by the response print helpers.
+	rawOutput bool
 )
 
 func main() {
@@ -87,6 +99,7 @@ func main() {
 		baseDirFlag,
 		tlsCertFlag,
 		macaroonPathFlag,
+		rawOutputFlag,
 		// The following two flags are only required for the 'litcli ln'
 		// sub commands, because they call into lnd's commands package
 		// that requires them. They only need to be _defined_, but
@@ -107,13 +120,19 @@ func main() {
 			EnvVar: envVarMacaroonTimeout,
 		},
 	}
+	app.Before = func(ctx *cli.Context) error {
+		rawOutput = ctx.GlobalBool(rawOutputFlag.Name)
+		return nil
+	}
 	app.Commands = append(app.Commands, sessionCommands...)
 	app.Commands = append(app.Commands, accountsCommands...)
 	app.Commands = append(app.Commands, listActionsCommand)
+	app.Commands = append(app.Commands, firewallCommands)
 	app.Commands = append(app.Commands, privacyMapCommands)
 	app.Commands = append(app.Commands, autopilotCommands)
 	app.Commands = append(app.Commands, litCommands...)
 	app.Commands = append(app.Commands, helperCommands)
+	app.Commands = append(app.Commands, macaroonCommands)
 	app.Commands = append(app.Commands, statusCommands...)
 	app.Commands = append(app.Commands, lnCommands...)
 
@@ -307,6 +326,11 @@ func macFromBytes(macBytes []byte) (grpc.DialOption, error) {
 }
 
 func printRespJSON(resp proto.Message) { // nolint
+	if rawOutput {
+		printRespRaw(resp)
+		return
+	}
+
 	jsonBytes, err := lnrpc.ProtoJSONMarshalOpts.Marshal(resp)
 	if err != nil {
 		fmt.Println("unable to decode response: ", err)
@@ -316,6 +340,22 @@ func printRespJSON(resp proto.Message) { // nolint
 	fmt.Println(string(jsonBytes))
 }
 
+// printRespRaw prints the given response in the protobuf text format. Unlike
+// the JSON output, this shows exactly which fields are present on the wire,
+// including any unknown fields.
+func printRespRaw(resp proto.Message) {
+	textBytes, err := prototext.MarshalOptions{
+		Multiline:   true,
+		EmitUnknown: true,
+	}.Marshal(resp)
+	if err != nil {
+		fmt.Println("unable to decode response: ", err)
+		return
+	}
+
+	fmt.Println(string(textBytes))
+}
+
 func connectSuperMacClient(ctx context.Context, cli *cli.Context) (
 	grpc.ClientConnInterface, func(), error) {
 
@@ -2,10 +2,14 @@ package main
 
 import (
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"os"
 
 	"github.com/lightninglabs/lightning-terminal/firewalldb"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/urfave/cli"
 )
 
@@ -38,6 +42,8 @@ var privacyMapCommands = cli.Command{
 	Subcommands: []cli.Command{
 		privacyMapConvertStrCommand,
 		privacyMapConvertUint64Command,
+		privacyMapExportCommand,
+		privacyMapImportCommand,
 	},
 	Description: "Access the real-pseudo string pairs of the " +
 		"privacy mapper. To improve privacy around data " +
@@ -163,3 +169,158 @@ func privacyMapConvertUint64(cli *cli.Context) error {
 	})
 	return nil
 }
+
+var privacyMapExportCommand = cli.Command{
+	Name:  "export",
+	Usage: "Export the real-pseudo pairs of a privacy map.",
+	Description: `
+Export all real-pseudo pairs of the privacy map of a session group, so that
+they can be restored with the import command, for example after moving to
+another litd instance. The group is selected either with the --session flag
+or with the global --group_id flag.
+
+The export reveals the real channel IDs, channel points and node pubkeys that
+were hidden from the autopilot server. It is therefore only available if litd
+was started with --firewall.allow-privacy-export and every export is logged.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "session",
+			Usage: "The ID of a session of the group whose " +
+				"privacy map should be exported.",
+		},
+	},
+	Action: privacyMapExport,
+}
+
+func privacyMapExport(cli *cli.Context) error {
+	ctx := getContext()
+
+	sessionID, groupID, err := parsePrivacyMapIDs(cli)
+	if err != nil {
+		return err
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewFirewallClient(clientConn)
+
+	resp, err := client.ExportPrivacyMapping(
+		ctx, &litrpc.ExportPrivacyMappingRequest{
+			SessionId: sessionID,
+			GroupId:   groupID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var privacyMapImportCommand = cli.Command{
+	Name:  "import",
+	Usage: "Import real-pseudo pairs into a privacy map.",
+	Description: `
+Import the real-pseudo pairs of an export created with the export command into
+the privacy map of a session group. The group is selected either with the
+--session flag or with the global --group_id flag. Pairs that already exist
+are skipped. If any real or pseudo value is already mapped to a different
+value, nothing is imported.
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "session",
+			Usage: "The ID of a session of the group whose " +
+				"privacy map the pairs should be imported " +
+				"into.",
+		},
+		cli.StringFlag{
+			Name:  "file",
+			Usage: "The export file to read the pairs from.",
+		},
+	},
+	Action: privacyMapImport,
+}
+
+func privacyMapImport(cli *cli.Context) error {
+	ctx := getContext()
+
+	if !cli.IsSet("file") {
+		return errors.New("the file to import must be set")
+	}
+
+	sessionID, groupID, err := parsePrivacyMapIDs(cli)
+	if err != nil {
+		return err
+	}
+
+	fileName := lncfg.CleanAndExpandPath(cli.String("file"))
+	jsonBytes, err := os.ReadFile(fileName)
+	if err != nil {
+		return fmt.Errorf("unable to read import file: %w", err)
+	}
+
+	export := &litrpc.ExportPrivacyMappingResponse{}
+	err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(jsonBytes, export)
+	if err != nil {
+		return fmt.Errorf("unable to parse import file %s: %w",
+			fileName, err)
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewFirewallClient(clientConn)
+
+	resp, err := client.ImportPrivacyMapping(
+		ctx, &litrpc.ImportPrivacyMappingRequest{
+			SessionId: sessionID,
+			GroupId:   groupID,
+			Pairs:     export.Pairs,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// parsePrivacyMapIDs parses the session ID set with the --session flag or the
+// group ID set with the global --group_id flag.
+func parsePrivacyMapIDs(cli *cli.Context) ([]byte, []byte, error) {
+	switch {
+	case cli.IsSet("session") && cli.GlobalIsSet("group_id"):
+		return nil, nil, fmt.Errorf("only one of session and group_id " +
+			"can be set")
+
+	case cli.IsSet("session"):
+		sessionID, err := hex.DecodeString(cli.String("session"))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return sessionID, nil, nil
+
+	case cli.GlobalIsSet("group_id"):
+		groupID, err := hex.DecodeString(cli.GlobalString("group_id"))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return nil, groupID, nil
+
+	default:
+		return nil, nil, fmt.Errorf("must set session or group_id")
+	}
+}
@@ -43,6 +43,39 @@ var litCommands = []cli.Command{
 			},
 		},
 	},
+	{
+		Name:  "events",
+		Usage: "Stream the events of litd",
+		Description: "Streams the changes of accounts, sessions and " +
+			"sub-servers and the requests denied by the firewall " +
+			"as they happen. Every event carries a sequence " +
+			"number that increases by one for each event of any " +
+			"category and the ID of the stream it belongs to. To " +
+			"resume after a disconnect without missing any event, " +
+			"pass the stream ID and the sequence number of the " +
+			"last event that was received.",
+		Category: "LiT",
+		Action:   subscribeEvents,
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name: "category",
+				Usage: "(optional) Only show the events of " +
+					"this category, one of account, " +
+					"session, sub_server or firewall. Can " +
+					"be specified multiple times.",
+			},
+			cli.StringFlag{
+				Name: "stream_id",
+				Usage: "(optional) The stream ID of the last " +
+					"event that was received.",
+			},
+			cli.Uint64Flag{
+				Name: "after_sequence",
+				Usage: "(optional) The sequence number of the " +
+					"last event that was received.",
+			},
+		},
+	},
 	{
 		Name: "getinfo",
 		Usage: "Returns basic information related to the active " +
@@ -52,6 +85,33 @@ var litCommands = []cli.Command{
 		Category: "LiT",
 		Action:   getInfo,
 	},
+	{
+		Name:  "rotaterootkey",
+		Usage: "Rotate the root key of LiT's own macaroons",
+		Description: "Create a new root key for LiT's own macaroons " +
+			"and bake a new LiT macaroon under it, which replaces " +
+			"the one on disk. Macaroons that were baked under " +
+			"the previous root key remain valid for the grace " +
+			"period that is configured with " +
+			"--macaroonrootkeygraceperiod, after which the " +
+			"previous root key is invalidated.\n\n" +
+			"Only the macaroons of LiT's own macaroon service, " +
+			"such as lit.macaroon, are affected. Account and " +
+			"session macaroons are baked by lnd under lnd's root " +
+			"keys and stay valid. Use `litcli accounts " +
+			"rotate-macaroon` to replace the macaroons of an " +
+			"account and revoke a session to invalidate its " +
+			"macaroon.",
+		Category: "LiT",
+		Action:   rotateRootKey,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name: "save_to",
+				Usage: "Save the returned macaroon to this " +
+					"file.",
+			},
+		},
+	},
 	{
 		Name:        "stop",
 		Usage:       "Shutdown the LiT daemon",
@@ -59,6 +119,69 @@ var litCommands = []cli.Command{
 		Category:    "LiT",
 		Action:      shutdownLit,
 	},
+	{
+		Name: "whoami",
+		Usage: "Describe the privileges of the macaroon the call is " +
+			"made with",
+		Description: "Describe the privileges of the macaroon that " +
+			"the call is made with: the URIs it may call and, if " +
+			"it belongs to one, the session it was baked for, " +
+			"the account it is linked to including its balance " +
+			"and the firewall rules that are enforced for it.",
+		Category: "LiT",
+		Action:   whoAmI,
+	},
+}
+
+// eventCategories maps the names of the event categories that can be passed
+// to the events command to their RPC counterpart.
+var eventCategories = map[string]litrpc.EventCategory{
+	"account":    litrpc.EventCategory_EVENT_CATEGORY_ACCOUNT,
+	"session":    litrpc.EventCategory_EVENT_CATEGORY_SESSION,
+	"sub_server": litrpc.EventCategory_EVENT_CATEGORY_SUB_SERVER,
+	"firewall":   litrpc.EventCategory_EVENT_CATEGORY_FIREWALL,
+}
+
+func subscribeEvents(cli *cli.Context) error {
+	req := &litrpc.SubscribeEventsRequest{
+		StreamId:      cli.String("stream_id"),
+		AfterSequence: cli.Uint64("after_sequence"),
+	}
+	if cli.IsSet("after_sequence") && req.StreamId == "" {
+		return fmt.Errorf("--after_sequence requires --stream_id")
+	}
+
+	for _, name := range cli.StringSlice("category") {
+		category, ok := eventCategories[name]
+		if !ok {
+			return fmt.Errorf("unknown event category '%s', must "+
+				"be one of account, session, sub_server or "+
+				"firewall", name)
+		}
+
+		req.Categories = append(req.Categories, category)
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewProxyClient(clientConn)
+
+	stream, err := client.SubscribeEvents(getContext(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
 }
 
 func getInfo(cli *cli.Context) error {
@@ -80,6 +203,25 @@ func getInfo(cli *cli.Context) error {
 	return nil
 }
 
+func whoAmI(cli *cli.Context) error {
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewProxyClient(clientConn)
+
+	ctx := getContext()
+	resp, err := client.WhoAmI(ctx, &litrpc.WhoAmIRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 func shutdownLit(cli *cli.Context) error {
 	clientConn, cleanup, err := connectClient(cli, false)
 	if err != nil {
@@ -99,6 +241,42 @@ func shutdownLit(cli *cli.Context) error {
 	return nil
 }
 
+func rotateRootKey(cli *cli.Context) error {
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewProxyClient(clientConn)
+
+	ctx := getContext()
+	resp, err := client.RotateRootKey(ctx, &litrpc.RotateRootKeyRequest{})
+	if err != nil {
+		return err
+	}
+
+	// If the user specified the optional --save_to parameter, we'll save
+	// the macaroon to that file.
+	if cli.IsSet("save_to") {
+		macSavePath := lncfg.CleanAndExpandPath(cli.String("save_to"))
+		macBytes, err := hex.DecodeString(resp.Macaroon)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(macSavePath, macBytes, 0644)
+		if err != nil {
+			_ = os.Remove(macSavePath)
+			return err
+		}
+		fmt.Printf("Macaroon saved to %s\n", macSavePath)
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 func bakeSuperMacaroon(cli *cli.Context) error {
 	var suffixBytes [4]byte
 	if cli.IsSet("root_key_suffix") {
@@ -0,0 +1,525 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/lightninglabs/lightning-terminal/accounts"
+	"github.com/lightninglabs/lightning-terminal/firewall"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/rules"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/urfave/cli"
+)
+
+const (
+	// legacyTemplatesFilename is the name of the file in the lit directory
+	// that earlier versions of litcli stored the session templates in.
+	legacyTemplatesFilename = "session_templates.json"
+
+	// importedTemplatesSuffix is appended to the name of the legacy
+	// templates file once its templates were imported into the session
+	// database.
+	importedTemplatesSuffix = ".imported"
+)
+
+// legacySessionTemplate is a session template as it was stored in the legacy
+// templates file.
+type legacySessionTemplate struct {
+	Type              string   `json:"type"`
+	URIs              []string `json:"uris,omitempty"`
+	AccountID         string   `json:"account_id,omitempty"`
+	ErrorVerbosity    string   `json:"error_verbosity"`
+	MailboxServerAddr string   `json:"mailbox_server_addr"`
+	DevServer         bool     `json:"dev_server"`
+	ExpirySeconds     uint64   `json:"expiry_seconds"`
+}
+
+var sessionTemplateCommands = cli.Command{
+	Name:  "template",
+	Usage: "Manage session templates.",
+	Description: "Manage named sets of session parameters that are " +
+		"stored in the session database. New sessions can be " +
+		"created from a template with " +
+		"`litcli sessions add --template=name`, where the flags " +
+		"that are set explicitly override the values of the " +
+		"template.\n\n" +
+		"   Templates that earlier versions of litcli saved to " +
+		legacyTemplatesFilename + " in the lit directory are " +
+		"imported into the session database the first time a " +
+		"template is saved, listed or used. Templates with a name " +
+		"that already exists in the database are skipped. The file " +
+		"is then renamed to " + legacyTemplatesFilename +
+		importedTemplatesSuffix + ".",
+	Subcommands: []cli.Command{
+		saveSessionTemplateCommand,
+		listSessionTemplatesCommand,
+		deleteSessionTemplateCommand,
+	},
+}
+
+var saveSessionTemplateCommand = cli.Command{
+	Name:      "save",
+	Usage:     "Save a session template.",
+	ArgsUsage: "name",
+	Description: "Saves the type, permissions, caveats, rules, account, " +
+		"error verbosity, mailbox server and lifetime given by the " +
+		"flags as a template with the given name. If --localpubkey " +
+		"is set, the configuration of the existing session is saved " +
+		"instead, where the flags that are set explicitly override " +
+		"its values. The pairing secret and keys of the session are " +
+		"never saved.",
+	Action: saveSessionTemplate,
+	Flags: append([]cli.Flag{
+		cli.StringFlag{
+			Name: "localpubkey",
+			Usage: "(optional) The local pubkey of an existing " +
+				"session to save the configuration of.",
+		},
+		cli.BoolFlag{
+			Name:  "force",
+			Usage: "Overwrite an existing template with the name.",
+		},
+	}, sessionParamFlags...),
+}
+
+func saveSessionTemplate(cli *cli.Context) error {
+	name := cli.Args().First()
+	if name == "" {
+		return errors.New("template name missing")
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	if err := importLegacySessionTemplates(cli, client); err != nil {
+		return err
+	}
+
+	tmpl, err := sessionParamsFromFlags(cli)
+	if err != nil {
+		return err
+	}
+
+	ctx := getContext()
+	if cli.IsSet("localpubkey") {
+		pubkey, err := hex.DecodeString(cli.String("localpubkey"))
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.ListSessions(
+			ctx, &litrpc.ListSessionsRequest{},
+		)
+		if err != nil {
+			return err
+		}
+
+		var session *litrpc.Session
+		for _, s := range resp.Sessions {
+			if bytes.Equal(s.LocalPublicKey, pubkey) {
+				session = s
+				break
+			}
+		}
+		if session == nil {
+			return fmt.Errorf("no session with local pubkey %x "+
+				"found", pubkey)
+		}
+
+		sessionTmpl, err := newSessionTemplate(session)
+		if err != nil {
+			return err
+		}
+
+		applySessionTemplate(cli, tmpl, sessionTmpl)
+	}
+	tmpl.Name = name
+
+	resp, err := client.SaveSessionTemplate(
+		ctx, &litrpc.SaveSessionTemplateRequest{
+			Template:  tmpl,
+			Overwrite: cli.Bool("force"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var listSessionTemplatesCommand = cli.Command{
+	Name:   "list",
+	Usage:  "List all session templates.",
+	Action: listSessionTemplates,
+}
+
+func listSessionTemplates(cli *cli.Context) error {
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	if err := importLegacySessionTemplates(cli, client); err != nil {
+		return err
+	}
+
+	resp, err := client.ListSessionTemplates(
+		getContext(), &litrpc.ListSessionTemplatesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var deleteSessionTemplateCommand = cli.Command{
+	Name:      "delete",
+	Usage:     "Delete a session template.",
+	ArgsUsage: "name",
+	Description: "Deletes the session template with the given name. " +
+		"Sessions that were created from it are not affected.",
+	Action: deleteSessionTemplate,
+}
+
+func deleteSessionTemplate(cli *cli.Context) error {
+	name := cli.Args().First()
+	if name == "" {
+		return errors.New("template name missing")
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	resp, err := client.DeleteSessionTemplate(
+		getContext(), &litrpc.DeleteSessionTemplateRequest{
+			Name: name,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// newSessionTemplate creates a template from the configuration of the given
+// session.
+func newSessionTemplate(session *litrpc.Session) (*litrpc.SessionTemplate,
+	error) {
+
+	switch session.SessionType {
+	case litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		litrpc.SessionType_TYPE_MACAROON_READONLY,
+		litrpc.SessionType_TYPE_MACAROON_ACCOUNT,
+		litrpc.SessionType_TYPE_MACAROON_CUSTOM:
+
+	default:
+		return nil, fmt.Errorf("sessions of type %v can't be saved as "+
+			"a template", session.SessionType)
+	}
+
+	tmpl := &litrpc.SessionTemplate{
+		SessionType:       session.SessionType,
+		ErrorVerbosity:    session.ErrorVerbosity,
+		MailboxServerAddr: session.MailboxServerAddr,
+		DevServer:         session.DevServer,
+		AccountId:         session.AccountId,
+		SpendBudgetSat:    session.SpendBudgetSat,
+	}
+	if session.ExpiryTimestampSeconds > session.CreatedAt {
+		tmpl.ExpirySeconds = session.ExpiryTimestampSeconds -
+			session.CreatedAt
+	}
+
+	// The spend budget is saved on its own, so it must not be part of the
+	// session rules as well.
+	for name, value := range session.SessionRules.GetRules() {
+		if name == rules.SessionBudgetName {
+			continue
+		}
+
+		if tmpl.SessionRules == nil {
+			tmpl.SessionRules = &litrpc.RulesMap{
+				Rules: make(map[string]*litrpc.RuleValue),
+			}
+		}
+		tmpl.SessionRules.Rules[name] = value
+	}
+
+	if session.MacaroonRecipe == nil {
+		return tmpl, nil
+	}
+
+	// The permissions of account sessions are derived from the type, the
+	// permissions of all other sessions are saved. Admin and readonly
+	// sessions only have URIs in their recipe if they were restricted to
+	// them.
+	for _, perm := range session.MacaroonRecipe.Permissions {
+		switch session.SessionType {
+		case litrpc.SessionType_TYPE_MACAROON_CUSTOM:
+			tmpl.MacaroonCustomPermissions = append(
+				tmpl.MacaroonCustomPermissions, perm,
+			)
+
+		case litrpc.SessionType_TYPE_MACAROON_ADMIN,
+			litrpc.SessionType_TYPE_MACAROON_READONLY:
+
+			if perm.Entity == macaroons.PermissionEntityCustomURI {
+				tmpl.AllowedUris = append(
+					tmpl.AllowedUris, perm.Action,
+				)
+			}
+		}
+	}
+
+	// The account caveat of account sessions and the firewall caveat of
+	// the session rules are added again when the session is created, so
+	// only the custom caveats are saved.
+	var accountCaveat string
+	if session.SessionType == litrpc.SessionType_TYPE_MACAROON_ACCOUNT {
+		id, err := accounts.ParseAccountID(session.AccountId)
+		if err != nil {
+			return nil, err
+		}
+		accountCaveat = string(accounts.CaveatFromID(*id).Id)
+	}
+	for _, caveat := range session.MacaroonRecipe.Caveats {
+		if caveat == accountCaveat {
+			continue
+		}
+
+		_, err := firewall.ParseRuleCaveat(caveat)
+		if !errors.Is(err, firewall.ErrNoRulesCaveat) {
+			continue
+		}
+
+		tmpl.MacaroonCustomCaveats = append(
+			tmpl.MacaroonCustomCaveats, caveat,
+		)
+	}
+
+	return tmpl, nil
+}
+
+// getSessionTemplate fetches the session template with the given name.
+func getSessionTemplate(client litrpc.SessionsClient,
+	name string) (*litrpc.SessionTemplate, error) {
+
+	resp, err := client.ListSessionTemplates(
+		getContext(), &litrpc.ListSessionTemplatesRequest{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(resp.Templates))
+	for _, tmpl := range resp.Templates {
+		if tmpl.Name == name {
+			return tmpl, nil
+		}
+
+		names = append(names, tmpl.Name)
+	}
+
+	return nil, fmt.Errorf("unknown session template '%s', available "+
+		"templates: [%s]", name, strings.Join(names, ", "))
+}
+
+// applySessionTemplate sets all session parameters whose flags weren't set
+// explicitly to the values of the given template. The session rules of the
+// template are only replaced by the rules that were set with flags.
+func applySessionTemplate(cli *cli.Context, params,
+	tmpl *litrpc.SessionTemplate) {
+
+	if !cli.IsSet("type") {
+		params.SessionType = tmpl.SessionType
+	}
+	if !cli.IsSet(expiryFlag.Name) && tmpl.ExpirySeconds > 0 {
+		params.ExpirySeconds = tmpl.ExpirySeconds
+	}
+	if !cli.IsSet("mailboxserveraddr") && tmpl.MailboxServerAddr != "" {
+		params.MailboxServerAddr = tmpl.MailboxServerAddr
+	}
+	if !cli.IsSet(devserver.Name) {
+		params.DevServer = tmpl.DevServer
+	}
+	if !cli.IsSet("uri") {
+		params.MacaroonCustomPermissions = tmpl.MacaroonCustomPermissions
+	}
+	if !cli.IsSet("allow_uri") {
+		params.AllowedUris = tmpl.AllowedUris
+	}
+	if !cli.IsSet("caveat") {
+		params.MacaroonCustomCaveats = tmpl.MacaroonCustomCaveats
+	}
+	if !cli.IsSet("account_id") {
+		params.AccountId = tmpl.AccountId
+	}
+	if !cli.IsSet("error_verbosity") {
+		params.ErrorVerbosity = tmpl.ErrorVerbosity
+	}
+	if !cli.IsSet("spend_budget") {
+		params.SpendBudgetSat = tmpl.SpendBudgetSat
+	}
+
+	sessionRules := make(map[string]*litrpc.RuleValue)
+	for name, value := range tmpl.SessionRules.GetRules() {
+		sessionRules[name] = value
+	}
+	for name, value := range params.SessionRules.GetRules() {
+		sessionRules[name] = value
+	}
+
+	params.SessionRules = nil
+	if len(sessionRules) > 0 {
+		params.SessionRules = &litrpc.RulesMap{Rules: sessionRules}
+	}
+}
+
+// importLegacySessionTemplates imports the templates of the legacy templates
+// file in the lit directory into the session database, if the file exists.
+// Templates with a name that already exists in the database are skipped, as
+// are templates that can't be converted. Once all templates were handled, the
+// file is renamed so that it is only imported once.
+func importLegacySessionTemplates(cli *cli.Context,
+	client litrpc.SessionsClient) error {
+
+	baseDir := lncfg.CleanAndExpandPath(cli.GlobalString(baseDirFlag.Name))
+	path := filepath.Join(baseDir, legacyTemplatesFilename)
+
+	content, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("unable to read legacy session templates: %w",
+			err)
+	}
+
+	var legacyTemplates map[string]*legacySessionTemplate
+	if err := json.Unmarshal(content, &legacyTemplates); err != nil {
+		return fmt.Errorf("unable to decode legacy session templates: "+
+			"%w", err)
+	}
+
+	ctx := getContext()
+	resp, err := client.ListSessionTemplates(
+		ctx, &litrpc.ListSessionTemplatesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]bool, len(resp.Templates))
+	for _, tmpl := range resp.Templates {
+		existing[tmpl.Name] = true
+	}
+
+	names := make([]string, 0, len(legacyTemplates))
+	for name := range legacyTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if existing[name] {
+			fmt.Fprintf(os.Stderr, "Not importing session template "+
+				"'%s' from %s, a template with the name "+
+				"already exists\n", name, path)
+
+			continue
+		}
+
+		tmpl, err := legacyTemplates[name].toRPC()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Not importing session template "+
+				"'%s' from %s: %v\n", name, path, err)
+
+			continue
+		}
+		tmpl.Name = name
+
+		_, err = client.SaveSessionTemplate(
+			ctx, &litrpc.SaveSessionTemplateRequest{
+				Template: tmpl,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to import session template "+
+				"'%s': %w", name, err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Imported session template '%s' from "+
+			"%s\n", name, path)
+	}
+
+	importedPath := path + importedTemplatesSuffix
+	if err := os.Rename(path, importedPath); err != nil {
+		return fmt.Errorf("unable to rename legacy session templates "+
+			"file: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Session templates are now stored in the "+
+		"session database, %s was renamed to %s\n", path, importedPath)
+
+	return nil
+}
+
+// toRPC converts the legacy template to a template of the sessions RPCs.
+func (t *legacySessionTemplate) toRPC() (*litrpc.SessionTemplate, error) {
+	sessType, err := parseSessionType(t.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	errorVerbosity, err := parseErrorVerbosity(t.ErrorVerbosity)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl := &litrpc.SessionTemplate{
+		SessionType:       sessType,
+		ExpirySeconds:     t.ExpirySeconds,
+		MailboxServerAddr: t.MailboxServerAddr,
+		DevServer:         t.DevServer,
+		AccountId:         t.AccountID,
+		ErrorVerbosity:    errorVerbosity,
+	}
+	for _, uri := range t.URIs {
+		tmpl.MacaroonCustomPermissions = append(
+			tmpl.MacaroonCustomPermissions,
+			&litrpc.MacaroonPermission{
+				Entity: macaroons.PermissionEntityCustomURI,
+				Action: uri,
+			},
+		)
+	}
+
+	return tmpl, nil
+}
@@ -1,13 +1,34 @@
 package main
 
 import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lightning-node-connect/mailbox"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/rules"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/skip2/go-qrcode"
 	"github.com/urfave/cli"
+	"golang.org/x/term"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -15,6 +36,14 @@ var (
 	// The current value evaluates to 90 days.
 	defaultSessionExpiry = time.Hour * 24 * 90
 
+	// defaultConnectTimeout is the default time we wait for an LNC
+	// connection to be established when testing a session.
+	defaultConnectTimeout = time.Minute
+
+	// defaultConnectURL is the default URL of Terminal on the web that
+	// the connection string of a session points to.
+	defaultConnectURL = "https://terminal.lightning.engineering"
+
 	labelFlag = cli.StringFlag{
 		Name:     "label",
 		Usage:    "The session label.",
@@ -27,9 +56,14 @@ var (
 		Value: uint64(defaultSessionExpiry.Seconds()),
 	}
 	mailboxServerAddrFlag = cli.StringFlag{
-		Name:  "mailboxserveraddr",
+		Name:  "mailboxserveraddr, mailbox_addr",
 		Usage: "The host:port of the mailbox server to be used.",
-		Value: "mailbox.terminal.lightning.today:443",
+		Value: session.DefaultMailboxServerAddr,
+	}
+	mailboxVerifyFlag = cli.BoolFlag{
+		Name: "mailbox_verify",
+		Usage: "Only add the session if a TLS connection to the " +
+			"mailbox server can be established.",
 	}
 	devserver = cli.BoolFlag{
 		Name: "devserver",
@@ -48,51 +82,227 @@ var sessionCommands = []cli.Command{
 			addSessionCommand,
 			listSessionCommand,
 			revokeSessionCommand,
+			extendSessionCommand,
+			connStatusCommand,
+			connectionStringCommand,
+			connectSessionCommand,
+			sessionTemplateCommands,
 		},
 		Description: "Manage Lightning Node Connect sessions.",
 	},
 }
 
+// sessionTypeAutopilot is the value of the 'type' flag of the add command that
+// registers an Autopilot session instead of a regular one.
+const sessionTypeAutopilot = "autopilot"
+
+// sessionParamFlags are the flags of the session parameters that can be saved
+// as a session template.
+var sessionParamFlags = []cli.Flag{
+	expiryFlag,
+	mailboxServerAddrFlag,
+	devserver,
+	cli.StringFlag{
+		Name: "type",
+		Usage: "The session type to be created which will " +
+			"determine the permissions a user has when " +
+			"connecting with the session; options " +
+			"include readonly|admin|account|custom|" +
+			"autopilot.",
+		Value: "readonly",
+	},
+	cli.StringSliceFlag{
+		Name: "uri",
+		Usage: "The URI that should be included in the " +
+			"macaroon of a custom session. Note that " +
+			"this flag will only be used if the 'type' " +
+			"flag is set to 'custom'. This flag can be " +
+			"specified multiple times if multiple URIs " +
+			"should be included. Note that a regex can " +
+			"also be specified which will then result in " +
+			"all URIs matching the regex to be included. " +
+			"For example, '/lnrpc\\..*' will result in " +
+			"all `lnrpc` permissions being included.",
+	},
+	cli.StringSliceFlag{
+		Name: "allow_uri",
+		Usage: "The full URI of an RPC, for example " +
+			"'/lnrpc.Lightning/GetInfo', that the " +
+			"session should be restricted to. Note that " +
+			"this flag can only be used if the 'type' " +
+			"flag is set to 'admin' or 'readonly'. This " +
+			"flag can be specified multiple times if the " +
+			"session should be allowed to call multiple " +
+			"URIs.",
+	},
+	cli.Uint64Flag{
+		Name: "spend_budget",
+		Usage: "The total amount in satoshis, including " +
+			"routing fees, that the session can spend in " +
+			"off-chain payments over its lifetime. " +
+			"Further payments are rejected once the " +
+			"budget is used up. This requires the " +
+			"autopilot to be enabled, as the budget is " +
+			"enforced by the firewall.",
+	},
+	cli.StringSliceFlag{
+		Name: "rate_limit",
+		Usage: "A limit on the number of calls the session " +
+			"can make, in the form " +
+			"'PATTERN:CALLS/WINDOW', for example " +
+			"'/lnrpc\\..*:60/1m' to allow 60 calls to " +
+			"all `lnrpc` methods per minute. The pattern " +
+			"is a regex that is matched against the full " +
+			"URI of each call and the window is a " +
+			"duration in whole seconds. This flag can be " +
+			"specified multiple times, in which case a " +
+			"call has to be within all matching limits. " +
+			"This requires the autopilot to be enabled, " +
+			"as the limits are enforced by the firewall.",
+	},
+	cli.StringFlag{
+		Name: "memo_pattern",
+		Usage: "A regex that the memo of every invoice the " +
+			"session creates and the description of every " +
+			"invoice it pays must match, for example " +
+			"'^order-[0-9]+$'. Payments without an invoice " +
+			"are rejected. If set to an empty string, any " +
+			"memo that is set is accepted. This requires " +
+			"the autopilot to be enabled, as the memo is " +
+			"checked by the firewall.",
+	},
+	cli.Int64SliceFlag{
+		Name: "channel_allow",
+		Usage: "The ID of a channel that the session may act " +
+			"on, for example to update its policy, to " +
+			"close it or to send payments over it. If " +
+			"set, actions on any other channel are " +
+			"rejected and payments must specify their " +
+			"outgoing channel. This flag can be " +
+			"specified multiple times. This requires " +
+			"the autopilot to be enabled, as the list is " +
+			"enforced by the firewall.",
+	},
+	cli.Int64SliceFlag{
+		Name: "channel_deny",
+		Usage: "The ID of a channel that the session may not " +
+			"act on. If set, payments must specify their " +
+			"outgoing channel. This flag can be " +
+			"specified multiple times and can't be " +
+			"combined with 'channel_allow'. This " +
+			"requires the autopilot to be enabled, as " +
+			"the list is enforced by the firewall.",
+	},
+	cli.StringSliceFlag{
+		Name: "peer_allow",
+		Usage: "The hex encoded pubkey of a peer that the " +
+			"session may act on, for example to open " +
+			"or close channels with it or to connect to " +
+			"it. If set, actions on any other peer are " +
+			"rejected. This flag can be specified " +
+			"multiple times. This requires the autopilot " +
+			"to be enabled, as the list is enforced by " +
+			"the firewall.",
+	},
+	cli.StringSliceFlag{
+		Name: "peer_deny",
+		Usage: "The hex encoded pubkey of a peer that the " +
+			"session may not act on. This flag can be " +
+			"specified multiple times and can't be " +
+			"combined with 'peer_allow'. This requires " +
+			"the autopilot to be enabled, as the list is " +
+			"enforced by the firewall.",
+	},
+	cli.StringSliceFlag{
+		Name: "caveat",
+		Usage: "A custom first-party caveat, given as its " +
+			"condition, that should be added to the " +
+			"session's macaroon, for example " +
+			"'ipaddr 127.0.0.1'. Only the time-before, " +
+			"ipaddr, iprange and lnd-custom conditions " +
+			"are supported. This flag can be specified " +
+			"multiple times if multiple caveats should be " +
+			"added.",
+	},
+	cli.StringFlag{
+		Name: "account_id",
+		Usage: "The account id that should be used for " +
+			"the account session. Note that this flag " +
+			"will only be used if the 'type' flag is " +
+			"set to 'account'.",
+	},
+	cli.StringFlag{
+		Name: "error_verbosity",
+		Usage: "The verbosity of the errors returned to the " +
+			"client connected through the session; " +
+			"options include verbose|terse. Terse errors " +
+			"only contain the gRPC status code and no " +
+			"details about the node's internals. If not " +
+			"set, admin sessions get verbose errors and " +
+			"all other session types get terse ones.",
+	},
+}
+
 var addSessionCommand = cli.Command{
-	Name:        "add",
-	ShortName:   "a",
-	Usage:       "Create a new Lightning Node Connect session.",
-	Description: "Add a new active session.",
-	Action:      addSession,
-	Flags: []cli.Flag{
+	Name:      "add",
+	ShortName: "a",
+	Usage:     "Create a new Lightning Node Connect session.",
+	Description: "Add a new active session.\n\n" +
+		"With --link_to, the new session is added to the group of an " +
+		"existing session, so that it can be recognized as the same " +
+		"client over time, for example when a session is rotated. " +
+		"All other sessions in the group must be revoked or expired " +
+		"first. The spend budget and the rate limits of a group are " +
+		"shared, so the new session continues with the amount " +
+		"spent and the calls made by the other sessions of its " +
+		"group. The sessions of a group can be listed with " +
+		"`litcli sessions list --group`.",
+	Action: addSession,
+	Flags: append([]cli.Flag{
 		labelFlag,
-		expiryFlag,
-		mailboxServerAddrFlag,
-		devserver,
-		cli.StringFlag{
-			Name: "type",
-			Usage: "The session type to be created which will " +
-				"determine the permissions a user has when " +
-				"connecting with the session; options " +
-				"include readonly|admin|account|custom.",
-			Value: "readonly",
+		mailboxVerifyFlag,
+		cli.StringSliceFlag{
+			Name: "feature",
+			Usage: "The name of an Autopilot feature that the " +
+				"session may use. The session is only " +
+				"registered for the given features and any " +
+				"attempt of the Autopilot to use another " +
+				"feature is rejected. The default rules " +
+				"and configuration of the Autopilot are " +
+				"used for each feature. Note that this flag " +
+				"can only be used if the 'type' flag is set " +
+				"to 'autopilot' and that it must be " +
+				"specified at least once in that case. Use " +
+				"`litcli autopilot add` for more options.",
 		},
 		cli.StringSliceFlag{
-			Name: "uri",
-			Usage: "The URI that should be included in the " +
-				"macaroon of a custom session. Note that " +
-				"this flag will only be used if the 'type' " +
-				"flag is set to 'custom'. This flag can be " +
-				"specified multiple times if multiple URIs " +
-				"should be included. Note that a regex can " +
-				"also be specified which will then result in " +
-				"all URIs matching the regex to be included. " +
-				"For example, '/lnrpc\\..*' will result in " +
-				"all `lnrpc` permissions being included.",
+			Name: "feature_config",
+			Usage: "The JSON configuration of a feature, given " +
+				"in the same order as the 'feature' flags. " +
+				"If set, a configuration must be given for " +
+				"each feature, where '{}' means that the " +
+				"default configuration is used. The " +
+				"configuration is validated against the " +
+				"default configuration of the feature " +
+				"before the session is created.",
 		},
 		cli.StringFlag{
-			Name: "account_id",
-			Usage: "The account id that should be used for " +
-				"the account session. Note that this flag " +
-				"will only be used if the 'type' flag is " +
-				"set to 'account'.",
+			Name: "template",
+			Usage: "The name of a template saved with " +
+				"`litcli sessions template save` to create " +
+				"the session from. Flags that are set " +
+				"explicitly override the values of the " +
+				"template.",
 		},
-	},
+		cli.StringFlag{
+			Name: "link_to",
+			Usage: "(optional) The hex encoded ID of an existing " +
+				"session to add the new session to the " +
+				"group of. Autopilot sessions must be " +
+				"linked with `litcli autopilot add " +
+				"--group_id` instead.",
+		},
+	}, sessionParamFlags...),
 }
 
 func addSession(cli *cli.Context) error {
@@ -103,12 +313,117 @@ func addSession(cli *cli.Context) error {
 	defer cleanup()
 	client := litrpc.NewSessionsClient(clientConn)
 
-	sessTypeStr := cli.String("type")
-	sessType, err := parseSessionType(sessTypeStr)
+	features := cli.StringSlice("feature")
+	if cli.String("type") == sessionTypeAutopilot {
+		if len(features) == 0 {
+			return fmt.Errorf("at least one feature must be set " +
+				"for an autopilot session")
+		}
+		if cli.IsSet("template") {
+			return fmt.Errorf("autopilot sessions can't be " +
+				"created from a template")
+		}
+		if cli.IsSet("link_to") {
+			return fmt.Errorf("autopilot sessions must be linked " +
+				"with `litcli autopilot add --group_id`")
+		}
+
+		sessionRules, err := parseSessionRules(cli)
+		if err != nil {
+			return err
+		}
+
+		sessionLength := time.Second *
+			time.Duration(cli.Uint64(expiryFlag.Name))
+
+		return addAutopilotFeatureSession(
+			cli, litrpc.NewAutopilotClient(clientConn), features,
+			cli.StringSlice("feature_config"), sessionRules,
+			time.Now().Add(sessionLength).Unix(),
+		)
+	}
+
+	if len(features) > 0 || cli.IsSet("feature_config") {
+		return fmt.Errorf("features can only be set for an " +
+			"autopilot session")
+	}
+
+	params, err := sessionParamsFromFlags(cli)
+	if err != nil {
+		return err
+	}
+
+	if cli.IsSet("template") {
+		err := importLegacySessionTemplates(cli, client)
+		if err != nil {
+			return err
+		}
+
+		tmpl, err := getSessionTemplate(client, cli.String("template"))
+		if err != nil {
+			return err
+		}
+
+		applySessionTemplate(cli, params, tmpl)
+	}
+
+	var linkedID []byte
+	if cli.IsSet("link_to") {
+		linkedID, err = hex.DecodeString(cli.String("link_to"))
+		if err != nil {
+			return fmt.Errorf("invalid session ID to link to: %w",
+				err)
+		}
+	}
+
+	sessionLength := time.Second * time.Duration(params.ExpirySeconds)
+	sessionExpiry := time.Now().Add(sessionLength).Unix()
+	macPerms := params.MacaroonCustomPermissions
+
+	resp, err := client.AddSession(
+		getContext(), &litrpc.AddSessionRequest{
+			Label:                     cli.String("label"),
+			SessionType:               params.SessionType,
+			ExpiryTimestampSeconds:    uint64(sessionExpiry),
+			MailboxServerAddr:         params.MailboxServerAddr,
+			DevServer:                 params.DevServer,
+			VerifyMailboxServer:       cli.Bool("mailbox_verify"),
+			MacaroonCustomPermissions: macPerms,
+			AccountId:                 params.AccountId,
+			ErrorVerbosity:            params.ErrorVerbosity,
+			MacaroonCustomCaveats:     params.MacaroonCustomCaveats,
+			AllowedUris:               params.AllowedUris,
+			SpendBudgetSat:            params.SpendBudgetSat,
+			SessionRules:              params.SessionRules,
+			LinkedSessionId:           linkedID,
+		},
+	)
 	if err != nil {
 		return err
 	}
 
+	printRespJSON(resp)
+
+	return nil
+}
+
+// sessionParamsFromFlags parses the session parameters that can be saved as a
+// session template from the command line flags.
+func sessionParamsFromFlags(cli *cli.Context) (*litrpc.SessionTemplate,
+	error) {
+
+	sessType, err := parseSessionType(cli.String("type"))
+	if err != nil {
+		return nil, err
+	}
+
+	errorVerbosity, err := parseErrorVerbosity(
+		cli.String("error_verbosity"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	var macPerms []*litrpc.MacaroonPermission
 	for _, uri := range cli.StringSlice("uri") {
 		macPerms = append(macPerms, &litrpc.MacaroonPermission{
@@ -117,19 +432,120 @@ func addSession(cli *cli.Context) error {
 		})
 	}
 
-	sessionLength := time.Second * time.Duration(cli.Uint64("expiry"))
-	sessionExpiry := time.Now().Add(sessionLength).Unix()
+	sessionRules, err := parseSessionRules(cli)
+	if err != nil {
+		return nil, err
+	}
 
-	ctx := getContext()
-	resp, err := client.AddSession(
-		ctx, &litrpc.AddSessionRequest{
-			Label:                     cli.String("label"),
-			SessionType:               sessType,
-			ExpiryTimestampSeconds:    uint64(sessionExpiry),
-			MailboxServerAddr:         cli.String("mailboxserveraddr"),
-			DevServer:                 cli.Bool("devserver"),
-			MacaroonCustomPermissions: macPerms,
-			AccountId:                 cli.String("account_id"),
+	return &litrpc.SessionTemplate{
+		SessionType:               sessType,
+		ExpirySeconds:             cli.Uint64(expiryFlag.Name),
+		MailboxServerAddr:         cli.String("mailboxserveraddr"),
+		DevServer:                 cli.Bool(devserver.Name),
+		MacaroonCustomPermissions: macPerms,
+		AccountId:                 cli.String("account_id"),
+		ErrorVerbosity:            errorVerbosity,
+		MacaroonCustomCaveats:     cli.StringSlice("caveat"),
+		AllowedUris:               cli.StringSlice("allow_uri"),
+		SpendBudgetSat:            cli.Uint64("spend_budget"),
+		SessionRules:              sessionRules,
+	}, nil
+}
+
+// parseSessionRules parses the session wide rules that are set with the rate
+// limit, memo, channel and peer flags. Nil is returned if none of them are
+// set.
+func parseSessionRules(cli *cli.Context) (*litrpc.RulesMap, error) {
+	ruleValues := make(map[string]*litrpc.RuleValue)
+	if rateLimits := cli.StringSlice("rate_limit"); len(rateLimits) > 0 {
+		rateLimit, err := parseRateLimits(rateLimits)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleValues[rules.SessionRateLimitName] = rateLimit
+	}
+
+	if cli.IsSet("memo_pattern") {
+		ruleValues[rules.MemoRequiredName] = &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_MemoRequired{
+				MemoRequired: &litrpc.MemoRequired{
+					Pattern: cli.String("memo_pattern"),
+				},
+			},
+		}
+	}
+
+	allowList := toChanIDs(cli.Int64Slice("channel_allow"))
+	denyList := toChanIDs(cli.Int64Slice("channel_deny"))
+	if len(allowList) > 0 || len(denyList) > 0 {
+		ruleValues[rules.ChannelRestrictName] = &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_ChannelRestrict{
+				ChannelRestrict: &litrpc.ChannelRestrict{
+					ChannelIds:        denyList,
+					AllowedChannelIds: allowList,
+				},
+			},
+		}
+	}
+
+	peerAllowList := cli.StringSlice("peer_allow")
+	peerDenyList := cli.StringSlice("peer_deny")
+	if len(peerAllowList) > 0 || len(peerDenyList) > 0 {
+		ruleValues[rules.PeersRestrictName] = &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_PeerRestrict{
+				PeerRestrict: &litrpc.PeerRestrict{
+					PeerIds:        peerDenyList,
+					AllowedPeerIds: peerAllowList,
+				},
+			},
+		}
+	}
+
+	if len(ruleValues) == 0 {
+		return nil, nil
+	}
+
+	return &litrpc.RulesMap{Rules: ruleValues}, nil
+}
+
+// addAutopilotFeatureSession registers an Autopilot session that may only use
+// the given features, each with the default rules of the Autopilot and either
+// the matching configuration or the default one if no configurations are
+// given.
+func addAutopilotFeatureSession(cli *cli.Context,
+	client litrpc.AutopilotClient, features, configs []string,
+	sessionRules *litrpc.RulesMap, sessionExpiry int64) error {
+
+	if len(configs) > 0 && len(configs) != len(features) {
+		return fmt.Errorf("number of features (%v) and configurations "+
+			"(%v) must match", len(features), len(configs))
+	}
+
+	featureMap := make(map[string]*litrpc.FeatureConfig, len(features))
+	for i, feature := range features {
+		if _, ok := featureMap[feature]; ok {
+			return fmt.Errorf("feature %v is set multiple times",
+				feature)
+		}
+
+		featureConfig := &litrpc.FeatureConfig{}
+		if len(configs) > 0 && configs[i] != "{}" {
+			featureConfig.Config = []byte(configs[i])
+		}
+
+		featureMap[feature] = featureConfig
+	}
+
+	resp, err := client.AddAutopilotSession(
+		getContext(), &litrpc.AddAutopilotSessionRequest{
+			Label:                  cli.String("label"),
+			ExpiryTimestampSeconds: uint64(sessionExpiry),
+			MailboxServerAddr:      cli.String("mailboxserveraddr"),
+			DevServer:              cli.Bool("devserver"),
+			VerifyMailboxServer:    cli.Bool("mailbox_verify"),
+			Features:               featureMap,
+			SessionRules:           sessionRules,
 		},
 	)
 	if err != nil {
@@ -141,6 +557,61 @@ func addSession(cli *cli.Context) error {
 	return nil
 }
 
+// toChanIDs converts the given channel IDs parsed from the command line to
+// their unsigned form.
+func toChanIDs(ids []int64) []uint64 {
+	chanIDs := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		chanIDs = append(chanIDs, uint64(id))
+	}
+
+	return chanIDs
+}
+
+// parseRateLimits parses the given rate limits of the form
+// PATTERN:CALLS/WINDOW into the value of a session rate limit rule.
+func parseRateLimits(rateLimits []string) (*litrpc.RuleValue, error) {
+	limits := make([]*litrpc.MethodRateLimit, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		idx := strings.LastIndex(rateLimit, ":")
+		calls, window, ok := strings.Cut(rateLimit[idx+1:], "/")
+		if idx <= 0 || !ok {
+			return nil, fmt.Errorf("invalid rate limit %s, "+
+				"expected PATTERN:CALLS/WINDOW", rateLimit)
+		}
+
+		numCalls, err := strconv.ParseUint(calls, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number of calls in "+
+				"rate limit %s: %w", rateLimit, err)
+		}
+
+		windowLength, err := time.ParseDuration(window)
+		if err != nil {
+			return nil, fmt.Errorf("invalid window in rate limit "+
+				"%s: %w", rateLimit, err)
+		}
+		if windowLength%time.Second != 0 {
+			return nil, fmt.Errorf("the window of rate limit %s "+
+				"must be in whole seconds", rateLimit)
+		}
+
+		limits = append(limits, &litrpc.MethodRateLimit{
+			MethodPattern: rateLimit[:idx],
+			Calls:         uint32(numCalls),
+			WindowSeconds: uint32(windowLength / time.Second),
+		})
+	}
+
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_SessionRateLimit{
+			SessionRateLimit: &litrpc.SessionRateLimit{
+				Limits: limits,
+			},
+		},
+	}, nil
+}
+
 func parseSessionType(sessionType string) (litrpc.SessionType, error) {
 	switch sessionType {
 	case "admin":
@@ -156,11 +627,73 @@ func parseSessionType(sessionType string) (litrpc.SessionType, error) {
 	}
 }
 
+func parseErrorVerbosity(verbosity string) (litrpc.ErrorVerbosity, error) {
+	switch verbosity {
+	case "":
+		return litrpc.ErrorVerbosity_ERROR_VERBOSITY_DEFAULT, nil
+	case "verbose":
+		return litrpc.ErrorVerbosity_ERROR_VERBOSITY_VERBOSE, nil
+	case "terse":
+		return litrpc.ErrorVerbosity_ERROR_VERBOSITY_TERSE, nil
+	default:
+		return 0, fmt.Errorf("unsupported error verbosity %s",
+			verbosity)
+	}
+}
+
+var accountFilterFlag = cli.StringFlag{
+	Name: "account",
+	Usage: "(optional) Only list the sessions that are linked to the " +
+		"account with the given ID.",
+}
+
+var (
+	stateFilterFlag = cli.StringSliceFlag{
+		Name: "state",
+		Usage: "(optional) Only list the sessions that are in the " +
+			"given state; options include created|in_use|" +
+			"revoked|expired. This flag can be specified " +
+			"multiple times to list sessions in any of the " +
+			"given states.",
+	}
+	typeFilterFlag = cli.StringSliceFlag{
+		Name: "type",
+		Usage: "(optional) Only list the sessions of the given " +
+			"type; options include readonly|admin|account|" +
+			"custom. This flag can be specified multiple times " +
+			"to list sessions of any of the given types.",
+	}
+	labelContainsFlag = cli.StringFlag{
+		Name: "label_contains",
+		Usage: "(optional) Only list the sessions whose label " +
+			"contains the given string.",
+	}
+	groupFilterFlag = cli.StringFlag{
+		Name: "group",
+		Usage: "(optional) Only list the sessions in the group of " +
+			"the session with the given hex encoded ID, " +
+			"including its revoked and expired predecessors, " +
+			"in the order they were created in.",
+	}
+)
+
 var listSessionCommand = cli.Command{
-	Name:        "list",
-	ShortName:   "l",
-	Usage:       "List Lightning Node Connect sessions.",
-	Description: "List sessions.",
+	Name:      "list",
+	ShortName: "l",
+	Usage:     "List Lightning Node Connect sessions.",
+	ArgsUsage: "[--state=STATE] [--type=TYPE] [--label_contains=STRING] " +
+		"[--group=ID]",
+	Description: "List sessions. The sessions are filtered by litd, so " +
+		"only the matching sessions are transferred.\n\n" +
+		"With --group, the lineage of a client is shown: all " +
+		"sessions that were linked to each other with " +
+		"`litcli sessions add --link_to`, from the first one to the " +
+		"latest one.",
+	Action: listSessions(),
+	Flags: []cli.Flag{
+		accountFilterFlag, stateFilterFlag, typeFilterFlag,
+		labelContainsFlag, groupFilterFlag,
+	},
 	Subcommands: []cli.Command{
 		listAllSessionsCommand,
 		listRevokedSessions,
@@ -175,7 +708,11 @@ var listAllSessionsCommand = cli.Command{
 	ShortName:   "a",
 	Usage:       "List all Lightning Node Connect sessions.",
 	Description: "List all sessions.\n",
-	Action:      listSessions(sessionFilterAll),
+	Action:      listSessions(),
+	Flags: []cli.Flag{
+		accountFilterFlag, stateFilterFlag, typeFilterFlag,
+		labelContainsFlag, groupFilterFlag,
+	},
 }
 
 var listRevokedSessions = cli.Command{
@@ -183,7 +720,11 @@ var listRevokedSessions = cli.Command{
 	ShortName:   "r",
 	Usage:       "List revoked Lightning Node Connect sessions.",
 	Description: "List revoked sessions.\n",
-	Action:      listSessions(sessionFilterRevoked),
+	Action:      listSessions(litrpc.SessionState_STATE_REVOKED),
+	Flags: []cli.Flag{
+		accountFilterFlag, typeFilterFlag, labelContainsFlag,
+		groupFilterFlag,
+	},
 }
 
 var listInUseSessions = cli.Command{
@@ -191,7 +732,11 @@ var listInUseSessions = cli.Command{
 	ShortName:   "u",
 	Usage:       "List in-use Lightning Node Connect sessions.",
 	Description: "List in-use sessions.\n",
-	Action:      listSessions(sessionFilterInUse),
+	Action:      listSessions(litrpc.SessionState_STATE_IN_USE),
+	Flags: []cli.Flag{
+		accountFilterFlag, typeFilterFlag, labelContainsFlag,
+		groupFilterFlag,
+	},
 }
 
 var listExpiredSessions = cli.Command{
@@ -199,7 +744,11 @@ var listExpiredSessions = cli.Command{
 	ShortName:   "e",
 	Usage:       "List expired Lightning Node Connect sessions.",
 	Description: "List expired sessions.\n",
-	Action:      listSessions(sessionFilterExpired),
+	Action:      listSessions(litrpc.SessionState_STATE_EXPIRED),
+	Flags: []cli.Flag{
+		accountFilterFlag, typeFilterFlag, labelContainsFlag,
+		groupFilterFlag,
+	},
 }
 
 var listCreatedSessions = cli.Command{
@@ -207,28 +756,49 @@ var listCreatedSessions = cli.Command{
 	ShortName:   "c",
 	Usage:       "List created Lightning Node Connect sessions.",
 	Description: "List created sessions.\n",
-	Action:      listSessions(sessionFilterCreated),
+	Action:      listSessions(litrpc.SessionState_STATE_CREATED),
+	Flags: []cli.Flag{
+		accountFilterFlag, typeFilterFlag, labelContainsFlag,
+		groupFilterFlag,
+	},
 }
 
-type sessionFilter uint32
+// listSessions returns the action of a list command that lists the sessions
+// in the given states, or in the states of the state flag if none are given.
+func listSessions(states ...litrpc.SessionState) func(*cli.Context) error {
+	return func(cli *cli.Context) error {
+		rpcStates := states
+		if len(rpcStates) == 0 {
+			stateFlags := cli.StringSlice(stateFilterFlag.Name)
+			for _, state := range stateFlags {
+				rpcState, err := parseSessionState(state)
+				if err != nil {
+					return err
+				}
+				rpcStates = append(rpcStates, rpcState)
+			}
+		}
 
-const (
-	sessionFilterAll sessionFilter = iota
-	sessionFilterExpired
-	sessionFilterInUse
-	sessionFilterRevoked
-	sessionFilterCreated
-)
+		var types []litrpc.SessionType
+		for _, typ := range cli.StringSlice(typeFilterFlag.Name) {
+			rpcType, err := parseSessionType(typ)
+			if err != nil {
+				return err
+			}
+			types = append(types, rpcType)
+		}
 
-var sessionStateMap = map[litrpc.SessionState]sessionFilter{
-	litrpc.SessionState_STATE_CREATED: sessionFilterCreated,
-	litrpc.SessionState_STATE_EXPIRED: sessionFilterExpired,
-	litrpc.SessionState_STATE_IN_USE:  sessionFilterInUse,
-	litrpc.SessionState_STATE_REVOKED: sessionFilterRevoked,
-}
+		var groupID []byte
+		if cli.IsSet(groupFilterFlag.Name) {
+			var err error
+			groupID, err = hex.DecodeString(
+				cli.String(groupFilterFlag.Name),
+			)
+			if err != nil {
+				return fmt.Errorf("invalid group: %w", err)
+			}
+		}
 
-func listSessions(filter sessionFilter) func(ctx *cli.Context) error {
-	return func(cli *cli.Context) error {
 		clientConn, cleanup, err := connectClient(cli, false)
 		if err != nil {
 			return err
@@ -236,49 +806,195 @@ func listSessions(filter sessionFilter) func(ctx *cli.Context) error {
 		defer cleanup()
 		client := litrpc.NewSessionsClient(clientConn)
 
+		req := &litrpc.ListSessionsRequest{
+			AccountId:     cli.String(accountFilterFlag.Name),
+			States:        rpcStates,
+			Types:         types,
+			LabelContains: cli.String(labelContainsFlag.Name),
+			GroupId:       groupID,
+		}
+
 		ctx := getContext()
-		resp, err := client.ListSessions(
-			ctx, &litrpc.ListSessionsRequest{},
-		)
+		resp, err := client.ListSessions(ctx, req)
 		if err != nil {
 			return err
 		}
 
-		if filter == sessionFilterAll {
-			printRespJSON(resp)
-			return nil
+		printRespJSON(resp)
+
+		return nil
+	}
+}
+
+func parseSessionState(state string) (litrpc.SessionState, error) {
+	switch state {
+	case "created":
+		return litrpc.SessionState_STATE_CREATED, nil
+	case "in_use":
+		return litrpc.SessionState_STATE_IN_USE, nil
+	case "revoked":
+		return litrpc.SessionState_STATE_REVOKED, nil
+	case "expired":
+		return litrpc.SessionState_STATE_EXPIRED, nil
+	default:
+		return 0, fmt.Errorf("unsupported session state %s", state)
+	}
+}
+
+var revokeSessionCommand = cli.Command{
+	Name:      "revoke",
+	ShortName: "r",
+	Usage:     "Revoke Lightning Node Connect sessions.",
+	ArgsUsage: "[--localpubkey=KEY] [--label_prefix=PREFIX] " +
+		"[--created_before=TIMESTAMP] [--expired] [--dry_run]",
+	Description: `Revoke active sessions. A single session can be revoked by
+its local pubkey. Multiple sessions can be revoked at once by combining the
+filter flags, in which case only the sessions that match all of the given
+filters are revoked. Use --dry_run to list the sessions that would be revoked
+without revoking them.
+
+Sessions that are already revoked or expired are skipped, so the command can
+safely be run again if it failed after revoking only some of the sessions.`,
+	Action: revokeSession,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name: "localpubkey",
+			Usage: "The local pubkey of the session to revoke. " +
+				"This flag can be specified multiple times " +
+				"to revoke multiple sessions.",
+		},
+		cli.StringFlag{
+			Name: "label_prefix",
+			Usage: "Revoke the sessions whose label starts with " +
+				"the given prefix.",
+		},
+		cli.Uint64Flag{
+			Name: "created_before",
+			Usage: "Revoke the sessions that were created before " +
+				"the given time, expressed in seconds since " +
+				"the unix epoch.",
+		},
+		cli.BoolFlag{
+			Name: "expired",
+			Usage: "Revoke the sessions whose expiry has " +
+				"already passed.",
+		},
+		cli.BoolFlag{
+			Name: "dry_run",
+			Usage: "Only list the sessions that would be " +
+				"revoked, without revoking them.",
+		},
+	},
+}
+
+func revokeSession(cli *cli.Context) error {
+	var pubkeys [][]byte
+	for _, keyStr := range cli.StringSlice("localpubkey") {
+		pubkey, err := hex.DecodeString(keyStr)
+		if err != nil {
+			return err
 		}
+		pubkeys = append(pubkeys, pubkey)
+	}
 
-		var sessions []*litrpc.Session
-		for _, session := range resp.Sessions {
-			if sessionStateMap[session.SessionState] != filter {
-				continue
-			}
+	req := &litrpc.RevokeSessionsRequest{
+		LabelPrefix:     cli.String("label_prefix"),
+		CreatedBefore:   cli.Uint64("created_before"),
+		Expired:         cli.Bool("expired"),
+		LocalPublicKeys: pubkeys,
+		DryRun:          cli.Bool("dry_run"),
+	}
 
-			sessions = append(sessions, session)
+	// A single session without any other filters is revoked directly,
+	// which fails if the session can't be revoked instead of skipping it.
+	single := len(pubkeys) == 1 && req.LabelPrefix == "" &&
+		req.CreatedBefore == 0 && !req.Expired && !req.DryRun
+
+	if len(pubkeys) == 0 && req.LabelPrefix == "" &&
+		req.CreatedBefore == 0 && !req.Expired {
+
+		return fmt.Errorf("either the localpubkey or one of the " +
+			"filter flags must be set")
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	ctx := getContext()
+	if single {
+		resp, err := client.RevokeSession(
+			ctx, &litrpc.RevokeSessionRequest{
+				LocalPublicKey: pubkeys[0],
+			},
+		)
+		if err != nil {
+			return err
 		}
 
-		printRespJSON(&litrpc.ListSessionsResponse{Sessions: sessions})
+		printRespJSON(resp)
+
 		return nil
 	}
+
+	resp, err := client.RevokeSessions(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
 }
 
-var revokeSessionCommand = cli.Command{
-	Name:        "revoke",
-	ShortName:   "r",
-	Usage:       "Revoke a Lightning Node Connect session.",
-	Description: "Revoke an active session.",
-	Action:      revokeSession,
+var extendSessionCommand = cli.Command{
+	Name:      "extend",
+	ShortName: "e",
+	Usage:     "Extend the expiry of a session.",
+	ArgsUsage: "id --expiry=SECONDS",
+	Description: `Sets a new expiry for the session with the given ID. The new
+expiry is given as the number of seconds from now that the session should
+remain active, the same as when the session is added. Sessions that are
+already revoked or expired can't be extended.
+
+A session that is currently running keeps running until the new expiry.
+Clients receive a macaroon that is valid until the new expiry the next time
+they connect.`,
+	Action: extendSession,
 	Flags: []cli.Flag{
 		cli.StringFlag{
-			Name:     "localpubkey",
-			Usage:    "The local pubkey of the session to revoke.",
+			Name:  "id",
+			Usage: "The hex encoded ID of the session.",
+		},
+		cli.Uint64Flag{
+			Name: "expiry",
+			Usage: "The number of seconds from now that the " +
+				"session should remain active.",
 			Required: true,
 		},
 	},
 }
 
-func revokeSession(cli *cli.Context) error {
+func extendSession(cli *cli.Context) error {
+	idStr := cli.String("id")
+	if idStr == "" {
+		idStr = cli.Args().First()
+	}
+	if idStr == "" {
+		return fmt.Errorf("the session ID must be set")
+	}
+
+	id, err := hex.DecodeString(idStr)
+	if err != nil {
+		return fmt.Errorf("invalid session ID: %w", err)
+	}
+
+	sessionLength := time.Second * time.Duration(cli.Uint64("expiry"))
+	sessionExpiry := time.Now().Add(sessionLength).Unix()
+
 	clientConn, cleanup, err := connectClient(cli, false)
 	if err != nil {
 		return err
@@ -286,21 +1002,365 @@ func revokeSession(cli *cli.Context) error {
 	defer cleanup()
 	client := litrpc.NewSessionsClient(clientConn)
 
-	pubkey, err := hex.DecodeString(cli.String("localpubkey"))
+	ctx := getContext()
+	resp, err := client.UpdateSessionExpiry(
+		ctx, &litrpc.UpdateSessionExpiryRequest{
+			Id:                     id,
+			ExpiryTimestampSeconds: uint64(sessionExpiry),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var connStatusCommand = cli.Command{
+	Name:      "connstatus",
+	Usage:     "Show the mailbox connection status of active sessions.",
+	ArgsUsage: "[--localpubkey=KEY] [--watch]",
+	Description: `Prints the latest mailbox connection status of each active
+session, or only of the session with the given local pubkey.
+
+With --watch, the command keeps running and prints an event whenever the
+connection of a session changes, for example when it is lost and the
+session reconnects with a backoff. The backoff can be configured with the
+lncreconnect.* options of litd.`,
+	Action: connStatus,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "localpubkey",
+			Usage: "Only show the status of the session with this " +
+				"hex encoded local pubkey.",
+		},
+		cli.BoolFlag{
+			Name: "watch",
+			Usage: "Keep running and print an event whenever the " +
+				"connection of a session changes, starting " +
+				"with the latest status of all sessions.",
+		},
+	},
+}
+
+func connStatus(cli *cli.Context) error {
+	pubKey, err := hex.DecodeString(cli.String("localpubkey"))
+	if err != nil {
+		return fmt.Errorf("invalid local pubkey: %w", err)
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
 	if err != nil {
 		return err
 	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	req := &litrpc.SubscribeConnectionStatusRequest{
+		LocalPublicKey: pubKey,
+		SnapshotOnly:   !cli.Bool("watch"),
+	}
 
 	ctx := getContext()
-	resp, err := client.RevokeSession(
-		ctx, &litrpc.RevokeSessionRequest{
-			LocalPublicKey: pubkey,
-		},
+	if req.SnapshotOnly {
+		return streamConnStatusEvents(ctx, client, req)
+	}
+
+	// If litd becomes unavailable, we subscribe again, which starts with
+	// a new snapshot of all sessions.
+	for {
+		err := streamConnStatusEvents(ctx, client, req)
+		switch {
+		case err == nil, ctx.Err() != nil:
+			return nil
+
+		case status.Code(err) != codes.Unavailable:
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "subscription lost (%v), "+
+			"resubscribing\n", err)
+
+		select {
+		case <-time.After(watchRetryDelay):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// streamConnStatusEvents subscribes to the session connection events and
+// prints each of them until the stream ends.
+func streamConnStatusEvents(ctx context.Context, client litrpc.SessionsClient,
+	req *litrpc.SubscribeConnectionStatusRequest) error {
+
+	stream, err := client.SubscribeConnectionStatus(
+		ctx, req, grpc.WaitForReady(true),
 	)
 	if err != nil {
 		return err
 	}
 
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
+
+var connectionStringCommand = cli.Command{
+	Name:      "connectionstring",
+	ShortName: "cs",
+	Usage:     "Print the connection string of a session.",
+	ArgsUsage: "id",
+	Description: `Prints the pairing phrase, the mailbox server and the
+remote public key of the session with the given ID, together with a connection
+string that pre-fills them when opened on a mobile device or in Terminal on the
+web. The connection string is the same one the LiT UI shows for the session.
+
+With the --qr flag, the connection string is also rendered as a QR code that
+can be scanned from the terminal. If the output isn't a terminal, the QR code
+is skipped and only the connection string is printed.`,
+	Action: connectionString,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "The hex encoded ID of the session.",
+		},
+		cli.BoolFlag{
+			Name: "qr",
+			Usage: "Also render the connection string as a QR " +
+				"code.",
+		},
+		cli.StringFlag{
+			Name: "connect_url",
+			Usage: "The URL of Terminal on the web that the " +
+				"connection string points to.",
+			Value: defaultConnectURL,
+		},
+	},
+}
+
+func connectionString(cli *cli.Context) error {
+	idStr := cli.String("id")
+	if idStr == "" {
+		idStr = cli.Args().First()
+	}
+	if idStr == "" {
+		return fmt.Errorf("the session ID must be set")
+	}
+
+	id, err := hex.DecodeString(idStr)
+	if err != nil {
+		return fmt.Errorf("invalid session ID: %w", err)
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewSessionsClient(clientConn)
+
+	ctx := getContext()
+	resp, err := client.ListSessions(ctx, &litrpc.ListSessionsRequest{})
+	if err != nil {
+		return err
+	}
+
+	var session *litrpc.Session
+	for _, s := range resp.Sessions {
+		if bytes.Equal(s.Id, id) {
+			session = s
+			break
+		}
+	}
+	if session == nil {
+		return fmt.Errorf("session %x not found", id)
+	}
+
+	switch session.SessionState {
+	case litrpc.SessionState_STATE_REVOKED,
+		litrpc.SessionState_STATE_EXPIRED:
+
+		return fmt.Errorf("session %x can no longer be used, its "+
+			"state is %v", id, session.SessionState)
+	}
+
+	remoteKey := "not paired yet"
+	if len(session.RemotePublicKey) != 0 {
+		remoteKey = hex.EncodeToString(session.RemotePublicKey)
+	}
+
+	connStr := sessionConnectionString(
+		cli.String("connect_url"), session,
+	)
+
+	fmt.Printf("Pairing phrase:    %s\n", session.PairingSecretMnemonic)
+	fmt.Printf("Mailbox server:    %s\n", session.MailboxServerAddr)
+	fmt.Printf("Remote public key: %s\n", remoteKey)
+	fmt.Printf("Connection string: %s\n", connStr)
+
+	if !cli.Bool("qr") {
+		return nil
+	}
+
+	// The QR code is only readable when it's drawn in a terminal, so we
+	// don't clutter redirected output with it.
+	if !term.IsTerminal(int(os.Stdout.Fd())) {
+		_, _ = fmt.Fprintln(os.Stderr, "Output is not a terminal, "+
+			"skipping QR code")
+
+		return nil
+	}
+
+	qr, err := qrcode.New(connStr, qrcode.Medium)
+	if err != nil {
+		return fmt.Errorf("error creating QR code: %w", err)
+	}
+
+	fmt.Println()
+	fmt.Print(qr.ToSmallString(false))
+
+	return nil
+}
+
+// sessionConnectionString returns the connection string of the given session,
+// which is the URL of Terminal on the web with the pairing phrase, the mailbox
+// server and the type of the session encoded in its fragment. It must match
+// the connection URL of the LiT UI, so that both can be used interchangeably.
+func sessionConnectionString(connectURL string,
+	session *litrpc.Session) string {
+
+	data := fmt.Sprintf(
+		"%s||%s||%s", session.PairingSecretMnemonic,
+		session.MailboxServerAddr, sessionTypeLabel(session.SessionType),
+	)
+
+	return fmt.Sprintf(
+		"%s#/connect/pair/%s", strings.TrimSuffix(connectURL, "/"),
+		base64.StdEncoding.EncodeToString([]byte(data)),
+	)
+}
+
+// sessionTypeLabel returns the label of the given session type that the LiT UI
+// uses in its connection strings.
+func sessionTypeLabel(sessionType litrpc.SessionType) string {
+	switch sessionType {
+	case litrpc.SessionType_TYPE_MACAROON_READONLY:
+		return "Read-Only"
+	case litrpc.SessionType_TYPE_MACAROON_ADMIN:
+		return "Admin"
+	case litrpc.SessionType_TYPE_MACAROON_CUSTOM:
+		return "Custom"
+	case litrpc.SessionType_TYPE_MACAROON_ACCOUNT:
+		return "Custodial"
+	case litrpc.SessionType_TYPE_UI_PASSWORD:
+		return "LiT UI Password"
+	default:
+		return "Unknown"
+	}
+}
+
+var connectSessionCommand = cli.Command{
+	Name:      "connect",
+	ShortName: "c",
+	Usage: "Test a Lightning Node Connect session by connecting to it " +
+		"through the mailbox.",
+	ArgsUsage: "pairing_phrase",
+	Description: `Establishes a Lightning Node Connect connection using the
+given pairing phrase, just like a remote client would, and calls the GetInfo
+RPC over it. This exercises the full mailbox path and can be used to verify
+that a newly created session works.
+
+Note that a session can only be paired with a single client, so a session that
+was used for this test cannot be used with another client afterwards.`,
+	Action: connectSession,
+	Flags: []cli.Flag{
+		mailboxServerAddrFlag,
+		devserver,
+		cli.DurationFlag{
+			Name: "timeout",
+			Usage: "The maximum time to wait for the connection " +
+				"to be established and the test call to " +
+				"complete.",
+			Value: defaultConnectTimeout,
+		},
+	},
+}
+
+func connectSession(cli *cli.Context) error {
+	// The pairing phrase can either be passed as a single quoted argument
+	// or as individual words.
+	words := strings.Fields(strings.Join(cli.Args(), " "))
+	if len(words) != mailbox.NumPassphraseWords {
+		return fmt.Errorf("the pairing phrase must consist of exactly "+
+			"%d words, got %d", mailbox.NumPassphraseWords,
+			len(words))
+	}
+
+	var mnemonicWords [mailbox.NumPassphraseWords]string
+	copy(mnemonicWords[:], words)
+	passphrase := mailbox.PassphraseMnemonicToEntropy(mnemonicWords)
+
+	privKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return fmt.Errorf("error creating local key: %w", err)
+	}
+	ecdh := &keychain.PrivKeyECDH{PrivKey: privKey}
+
+	ctx, cancel := context.WithTimeout(getContext(), cli.Duration("timeout"))
+	defer cancel()
+
+	mailboxServer := cli.String(mailboxServerAddrFlag.Name)
+	connData := mailbox.NewConnData(ecdh, nil, passphrase[:], nil, nil, nil)
+
+	tlsConfig := &tls.Config{}
+	if cli.Bool(devserver.Name) {
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	transportConn, err := mailbox.NewGrpcClient(
+		ctx, mailboxServer, connData,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+	)
+	if err != nil {
+		return fmt.Errorf("error connecting to mailbox server %s: %w",
+			mailboxServer, err)
+	}
+
+	noiseConn := mailbox.NewNoiseGrpcConn(connData)
+	dialOpts := []grpc.DialOption{
+		grpc.WithContextDialer(transportConn.Dial),
+		grpc.WithTransportCredentials(noiseConn),
+		grpc.WithPerRPCCredentials(noiseConn),
+		grpc.WithBlock(),
+	}
+
+	conn, err := grpc.DialContext(ctx, mailboxServer, dialOpts...)
+	if err != nil {
+		return fmt.Errorf("error establishing LNC connection: %w", err)
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	client := lnrpc.NewLightningClient(conn)
+	resp, err := client.GetInfo(ctx, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return fmt.Errorf("LNC connection established but test call "+
+			"failed: %w", err)
+	}
+
+	fmt.Println("LNC connection established successfully, node info:")
 	printRespJSON(resp)
 
 	return nil
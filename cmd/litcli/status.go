@@ -1,9 +1,19 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
 	"github.com/lightninglabs/lightning-terminal/litrpc"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var statusCommands = []cli.Command{
@@ -13,19 +23,129 @@ var statusCommands = []cli.Command{
 		Description: "View info about litd status.\n",
 		Category:    "LiT",
 		Action:      getStatus,
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name: "watch",
+				Usage: "Keep running and print an event " +
+					"whenever the state of a " +
+					"sub-server changes, starting " +
+					"with the current state of all " +
+					"sub-servers.",
+			},
+		},
+		Subcommands: []cli.Command{
+			startSubServerCommand,
+			stopSubServerCommand,
+		},
 	},
 }
 
+var startSubServerCommand = cli.Command{
+	Name:      "start",
+	Usage:     "Start an integrated sub-server.",
+	ArgsUsage: "subserver",
+	Description: "Starts an integrated sub-server (loop, pool, faraday " +
+		"or taproot-assets) that was stopped or failed with an " +
+		"error, without restarting litd.",
+	Action: startSubServer,
+}
+
+func startSubServer(cli *cli.Context) error {
+	name, err := subServerArg(cli)
+	if err != nil {
+		return err
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewStatusClient(clientConn)
+
+	resp, err := client.StartSubServer(
+		getContext(), &litrpc.StartSubServerRequest{Name: name},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var stopSubServerCommand = cli.Command{
+	Name:      "stop",
+	Usage:     "Stop an integrated sub-server.",
+	ArgsUsage: "subserver",
+	Description: "Gracefully stops an integrated sub-server (loop, pool, " +
+		"faraday or taproot-assets) while litd keeps running. Calls " +
+		"to the sub-server that are still in progress are aborted " +
+		"with an error.",
+	Action: stopSubServer,
+}
+
+func stopSubServer(cli *cli.Context) error {
+	name, err := subServerArg(cli)
+	if err != nil {
+		return err
+	}
+
+	clientConn, cleanup, err := connectClient(cli, false)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+	client := litrpc.NewStatusClient(clientConn)
+
+	resp, err := client.StopSubServer(
+		getContext(), &litrpc.StopSubServerRequest{Name: name},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+// subServerArg returns the name of the sub-server that is given as the only
+// argument of the command.
+func subServerArg(cli *cli.Context) (string, error) {
+	if cli.NArg() != 1 {
+		return "", errors.New("the name of exactly one sub-server " +
+			"must be given")
+	}
+
+	return cli.Args().First(), nil
+}
+
 func getStatus(cli *cli.Context) error {
-	clientConn, cleanup, err := connectClient(cli, true)
+	// The status is available before litd has created its macaroon, so we
+	// only send the macaroon if it exists. It is required if litd runs
+	// with --requiremacaroons.
+	_, macPath, err := extractPathArgs(cli)
+	if err != nil {
+		return err
+	}
+
+	clientConn, cleanup, err := connectClient(
+		cli, !lnrpc.FileExists(macPath),
+	)
 	if err != nil {
 		return err
 	}
 	defer cleanup()
 	litClient := litrpc.NewStatusClient(clientConn)
 
-	// Get LiT's status.
 	ctx := getContext()
+	if cli.Bool("watch") {
+		return watchStatus(ctx, litClient)
+	}
+
+	// Get LiT's status.
 	litResp, err := litClient.SubServerStatus(
 		ctx, &litrpc.SubServerStatusReq{},
 	)
@@ -46,3 +166,54 @@ func getStatus(cli *cli.Context) error {
 
 	return nil
 }
+
+// watchStatus prints every sub-server status event until the context is
+// canceled. If litd becomes unavailable, we subscribe again, which starts with
+// a new snapshot of all sub-servers.
+func watchStatus(ctx context.Context, client litrpc.StatusClient) error {
+	for {
+		err := streamStatusEvents(ctx, client)
+		switch {
+		case err == nil, ctx.Err() != nil:
+			return nil
+
+		case status.Code(err) != codes.Unavailable:
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "subscription lost (%v), "+
+			"resubscribing\n", err)
+
+		select {
+		case <-time.After(watchRetryDelay):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// streamStatusEvents subscribes to the sub-server status events and prints
+// each of them until the stream ends.
+func streamStatusEvents(ctx context.Context,
+	client litrpc.StatusClient) error {
+
+	stream, err := client.SubscribeSubServerStatus(
+		ctx, &litrpc.SubscribeSubServerStatusRequest{},
+		grpc.WaitForReady(true),
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
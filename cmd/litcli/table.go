@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/urfave/cli"
+)
+
+const (
+	// outputJSON is the output format that prints the response as JSON.
+	outputJSON = "json"
+
+	// outputTable is the output format that prints the response as a
+	// table with aligned columns.
+	outputTable = "table"
+)
+
+// outputFlag lets the user choose between the JSON and the table output of a
+// command. Commands that use it should call parseOutputFormat to read it.
+var outputFlag = cli.StringFlag{
+	Name: "output",
+	Usage: "The output format. Options include 'json' and " +
+		"'table'.",
+	Value: outputJSON,
+}
+
+// parseOutputFormat returns the output format that was chosen with the output
+// flag.
+func parseOutputFormat(ctx *cli.Context) (string, error) {
+	switch format := ctx.String(outputFlag.Name); format {
+	case outputJSON, outputTable:
+		return format, nil
+
+	default:
+		return "", fmt.Errorf("unknown output format %s. Valid "+
+			"options include '%s' and '%s'", format, outputJSON,
+			outputTable)
+	}
+}
+
+// table is a simple table with a header row that is printed with aligned
+// columns. Empty cells are printed as "-", so that every column stays
+// visible.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// newTable creates a new table with the given column names.
+func newTable(columns ...string) *table {
+	return &table{
+		header: columns,
+	}
+}
+
+// addRow appends a row with the given cells to the table.
+func (t *table) addRow(cells ...string) {
+	t.rows = append(t.rows, cells)
+}
+
+// write writes the table to the given writer.
+func (t *table) write(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	_, _ = fmt.Fprintln(w, strings.Join(t.header, "\t"))
+	for _, row := range t.rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			if cell == "" {
+				cell = "-"
+			}
+			cells[i] = cell
+		}
+
+		_, _ = fmt.Fprintln(w, strings.Join(cells, "\t"))
+	}
+
+	return w.Flush()
+}
+
+// print writes the table to stdout.
+func (t *table) print() error {
+	return t.write(os.Stdout)
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/lightninglabs/lightning-terminal/autopilotserver"
 	"github.com/lightninglabs/lightning-terminal/firewall"
 	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lightning-terminal/session"
 	"github.com/lightninglabs/lightning-terminal/subservers"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightninglabs/loop/loopd"
@@ -40,6 +42,10 @@ import (
 const (
 	defaultHTTPSListen = "127.0.0.1:8443"
 
+	// defaultTLSMinVersion is the default minimum TLS version accepted on
+	// the HTTPS listener.
+	defaultTLSMinVersion = "1.2"
+
 	uiPasswordMinLength = 8
 
 	ModeIntegrated = "integrated"
@@ -87,6 +93,11 @@ const (
 	DefaultMacaroonFilename = "lit.macaroon"
 
 	defaultFirstLNCConnTimeout = 10 * time.Minute
+
+	// defaultMacaroonRootKeyGracePeriod is the default time during which
+	// LiT's macaroons that were baked under a previous root key remain
+	// valid after the root key was rotated.
+	defaultMacaroonRootKeyGracePeriod = 24 * time.Hour
 )
 
 var (
@@ -159,21 +170,30 @@ type Config struct {
 	UIPasswordEnv  string   `long:"uipassword_env" description:"Same as uipassword but instead of passing in the value directly, read the password from the specified environment variable."`
 	DisableUI      bool     `long:"disableui" description:"If set to true, no web UI will be served and so the uipassword will also not need to be set."`
 
+	RestMacaroonHeader     string `long:"restmacaroonheader" description:"The name of an additional HTTP header that REST requests can pass their hex encoded macaroon in, for clients that can't set the Grpc-Metadata-Macaroon header. The Grpc-Metadata-Macaroon header takes precedence if both are set."`
+	RestMacaroonQueryParam bool   `long:"restmacaroonqueryparam" description:"Allow REST requests to pass their hex encoded macaroon in the macaroon query parameter. WARNING: URLs are much more likely to be exposed than headers, for example in the browser history, in the logs of reverse proxies or in the Referer header, so anyone who gets hold of such a URL can use the macaroon in it until it expires. Only enable this if the client can't set any header and use macaroons with limited permissions and a short timeout. LiT itself never logs the query parameter."`
+
 	LetsEncrypt       bool   `long:"letsencrypt" description:"Use Let's Encrypt to create a TLS certificate for the UI instead of using lnd's TLS certificate. Port 80 must be free to listen on and must be reachable from the internet for this to work."`
 	LetsEncryptHost   string `long:"letsencrypthost" description:"The host name to create a Let's Encrypt certificate for."`
 	LetsEncryptDir    string `long:"letsencryptdir" description:"The directory where the Let's Encrypt library will store its key and certificate."`
 	LetsEncryptListen string `long:"letsencryptlisten" description:"The IP:port on which LiT will listen for Let's Encrypt challenges. Let's Encrypt will always try to contact on port 80. Often non-root processes are not allowed to bind to ports lower than 1024. This configuration option allows a different port to be used, but must be used in combination with port forwarding from port 80. This configuration can also be used to specify another IP address to listen on, for example an IPv6 address."`
 
-	TLSCertPath     string   `long:"tlscertpath" description:"Path to write the self signed TLS certificate for LiT's RPC and REST proxy service (if Let's Encrypt is not used). This only applies to the HTTPSListen port."`
+	TLSCertPath     string   `long:"tlscertpath" description:"Path to write the self signed TLS certificate for LiT's RPC and REST proxy service (if Let's Encrypt is not used). This only applies to the HTTPSListen port. A certificate that is replaced on disk can be reloaded without a restart by sending a SIGHUP signal to litd."`
 	TLSKeyPath      string   `long:"tlskeypath" description:"Path to write the self signed TLS private key for LiT's RPC and REST proxy service (if Let's Encrypt is not used). This only applies to the HTTPSListen port."`
 	TLSExtraIPs     []string `long:"tlsextraip" description:"Adds an extra ip to the generated LiT TLS certificate (if Let's Encrypt is not used)"`
 	TLSExtraDomains []string `long:"tlsextradomain" description:"Adds an extra domain to the generated LiT TLS certificate (if Let's Encrypt is not used)"`
+	TLSMinVersion   string   `long:"tlsminversion" description:"The minimum TLS version that is accepted on the HTTPSListen port." choice:"1.2" choice:"1.3"`
+	TLSCipherSuites []string `long:"tlsciphersuite" description:"A TLS 1.2 cipher suite that is allowed on the HTTPSListen port, named as in Go's crypto/tls package, for example TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384. Can be specified multiple times. Only cipher suites with forward secrecy are accepted and TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256 must be included, as HTTP/2 requires it. Can't be used together with a minimum TLS version of 1.3, since TLS 1.3 cipher suites are not configurable. If not set, a secure default set is used."`
 
 	LitDir     string `long:"lit-dir" description:"The main directory where LiT looks for its configuration file. If LiT is running in 'remote' lnd mode, this is also the directory where the TLS certificates and log files are stored by default."`
 	ConfigFile string `long:"configfile" description:"Path to LiT's configuration file."`
 
 	MacaroonPath string `long:"macaroonpath" description:"Path to write the macaroon for litd's RPC and REST services if it doesn't exist."`
 
+	MacaroonRootKeyGracePeriod time.Duration `long:"macaroonrootkeygraceperiod" description:"The time during which litd's own macaroons, such as lit.macaroon, that were baked under the previous root key remain valid after the root key was rotated with the RotateRootKey RPC. The previous root key is invalidated afterwards. Set to 0 to invalidate it immediately. Account and session macaroons are baked under lnd's root keys and aren't affected by the rotation."`
+
+	RequireMacaroons bool `long:"requiremacaroons" description:"Require a valid macaroon for every RPC that litd validates itself, including read-only methods such as LiT's status service that are normally available without one. Requests without a macaroon are rejected with the Unauthenticated status code. lnd's wallet unlocker and state services are exempt, since no macaroon can exist or be verified before the wallet is unlocked. Calls to sub-servers that run in remote mode are only forwarded and validated by the remote daemon, so their unauthenticated methods stay available. For sub-servers in integrated mode, this also locks out other nodes from any unauthenticated endpoints, such as the taproot assets proof courier."`
+
 	FirstLNCConnDeadline time.Duration `long:"firstlncconndeadline" description:"The duration after a new LNC session will be revoked if no connection is made with it. This only applies for the first connection which is made using the pairing phrase. "`
 
 	// Network is the Bitcoin network we're running on. This will be parsed
@@ -195,6 +215,10 @@ type Config struct {
 	LndRPCTimeout      time.Duration `long:"lndrpctimeout" description:"The timeout for RPC calls to lnd from other sub servers. This can be adjusted for slow lnd instances to give loop/pool/faraday/taproot-assets more time when querying into lnd's RPC methods. This value should NOT be set to anything below 30 seconds to avoid problems."`
 	LndConnectInterval time.Duration `long:"lndconnectinterval" hidden:"true" description:"The interval at which LiT tries to connect to the lnd node. This value should only be changed for development mode."`
 
+	ReadyRequiredSubServers []string `long:"readyrequiredsubserver" description:"The name of a sub-server that must be running for the unauthenticated /ready HTTP endpoint to report LiT as ready, for example lnd, lit, loop, pool, faraday, taproot-assets or accounts. Can be specified multiple times. If not set, all sub-servers that aren't disabled must be running. The /health endpoint always reports the state of all sub-servers."`
+
+	StatusStaleThreshold time.Duration `long:"statusstalethreshold" description:"If set, LiT regularly checks that lnd is still responsive and reports the status of lnd as unknown instead of running if no check succeeded for this long. This prevents a hanging lnd from being reported as healthy. Set to 0 to disable the check."`
+
 	FaradayMode string          `long:"faraday-mode" description:"The mode to run faraday in, either 'integrated' (default), 'remote' or 'disable'. 'integrated' means faraday is started alongside the UI and everything is stored in faraday's main data directory, configure everything by using the --faraday.* flags. 'remote' means the UI connects to an existing faraday node and acts as a proxy for gRPC calls to it. 'disable' means that LiT is started without faraday." choice:"integrated" choice:"remote" choice:"disable"`
 	Faraday     *faraday.Config `group:"Integrated faraday options (use when faraday-mode=integrated)" namespace:"faraday"`
 
@@ -215,6 +239,14 @@ type Config struct {
 
 	Accounts *accounts.Config `group:"Accounts options" namespace:"accounts"`
 
+	Prometheus *PrometheusConfig `group:"Prometheus options" namespace:"prometheus"`
+
+	RPC *RPCConfig `group:"RPC options" namespace:"rpc"`
+
+	Fiat *FiatConfig `group:"Fiat display options" namespace:"fiat"`
+
+	LNCReconnect *session.ReconnectConfig `group:"LNC reconnect options" namespace:"lncreconnect"`
+
 	// faradayRpcConfig is a subset of faraday's full configuration that is
 	// passed into faraday's RPC server.
 	faradayRpcConfig *frdrpcserver.Config
@@ -285,9 +317,10 @@ func (c *Config) lndConnectParams() (string, lndclient.Network, string,
 func defaultConfig() *Config {
 	defaultLogCfg := build.DefaultLogConfig()
 	return &Config{
-		HTTPSListen: defaultHTTPSListen,
-		TLSCertPath: DefaultTLSCertPath,
-		TLSKeyPath:  defaultTLSKeyPath,
+		HTTPSListen:   defaultHTTPSListen,
+		TLSCertPath:   DefaultTLSCertPath,
+		TLSKeyPath:    defaultTLSKeyPath,
+		TLSMinVersion: defaultTLSMinVersion,
 		Remote: &subservers.RemoteConfig{
 			LitLogConfig:      defaultLogCfg,
 			LitDebugLevel:     defaultLogLevel,
@@ -344,9 +377,16 @@ func defaultConfig() *Config {
 		Autopilot: &autopilotserver.Config{
 			PingCadence: time.Hour,
 		},
-		Firewall:  firewall.DefaultConfig(),
-		Accounts:  &accounts.Config{},
-		DevConfig: defaultDevConfig(),
+		Firewall:   firewall.DefaultConfig(),
+		Accounts:   accounts.DefaultConfig(),
+		Prometheus: &PrometheusConfig{},
+		RPC:        &RPCConfig{},
+		Fiat:       &FiatConfig{},
+		DevConfig:  defaultDevConfig(),
+
+		MacaroonRootKeyGracePeriod: defaultMacaroonRootKeyGracePeriod,
+
+		LNCReconnect: session.DefaultReconnectConfig(),
 	}
 }
 
@@ -443,6 +483,40 @@ func loadAndValidateConfig(interceptor signal.Interceptor) (*Config, error) {
 			"to avoid problems", minimumRPCTimeout)
 	}
 
+	// The status of lnd is checked at half the stale threshold, so a too
+	// low value would flood lnd with checks.
+	if cfg.StatusStaleThreshold != 0 &&
+		cfg.StatusStaleThreshold < minimumStatusStaleThreshold {
+
+		return nil, fmt.Errorf("status stale threshold must be 0 or at "+
+			"least %v", minimumStatusStaleThreshold)
+	}
+
+	if cfg.MacaroonRootKeyGracePeriod < 0 {
+		return nil, errors.New("macaroon root key grace period must " +
+			"not be negative")
+	}
+
+	if err := cfg.LNCReconnect.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid LNC reconnect config: %w", err)
+	}
+
+	if err := cfg.Fiat.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid fiat config: %w", err)
+	}
+
+	knownSubServers := []string{
+		subservers.LND, subservers.LIT, subservers.LOOP,
+		subservers.POOL, subservers.TAP, subservers.FARADAY,
+		subservers.ACCOUNTS,
+	}
+	for _, name := range cfg.ReadyRequiredSubServers {
+		if !slices.Contains(knownSubServers, name) {
+			return nil, fmt.Errorf("unknown sub-server %s required "+
+				"for readiness", name)
+		}
+	}
+
 	// Validate the lightning-terminal config options.
 	litDir := lnd.CleanAndExpandPath(preCfg.LitDir)
 	cfg.LetsEncryptDir = lncfg.CleanAndExpandPath(cfg.LetsEncryptDir)
@@ -465,6 +539,10 @@ func loadAndValidateConfig(interceptor signal.Interceptor) (*Config, error) {
 		}
 	}
 
+	if _, _, err := cfg.tlsVersionAndCiphers(); err != nil {
+		return nil, err
+	}
+
 	// If the web UI is enabled, a UI password must be provided.
 	if !cfg.DisableUI {
 		err = readUIPassword(cfg)
@@ -594,6 +672,10 @@ func loadAndValidateConfig(interceptor signal.Interceptor) (*Config, error) {
 		}
 	}
 
+	if err := cfg.Remote.ValidateTimeouts(); err != nil {
+		return nil, err
+	}
+
 	return cfg, nil
 }
 
@@ -871,13 +953,18 @@ func readUIPassword(config *Config) error {
 		"variable that contains the password")
 }
 
-func buildTLSConfigForHttp2(config *Config) (*tls.Config, error) {
-	var tlsConfig *tls.Config
+func buildTLSConfigForHttp2(config *Config) (*tls.Config, *certReloader,
+	error) {
+
+	var (
+		tlsConfig *tls.Config
+		reloader  *certReloader
+	)
 
 	if config.LetsEncrypt {
 		serverName := config.LetsEncryptHost
 		if serverName == "" {
-			return nil, errors.New("let's encrypt host name " +
+			return nil, nil, errors.New("let's encrypt host name " +
 				"option is required for using let's encrypt")
 		}
 
@@ -923,7 +1010,7 @@ func buildTLSConfigForHttp2(config *Config) (*tls.Config, error) {
 				false, DefaultAutogenValidity,
 			)
 			if err != nil {
-				return nil, fmt.Errorf("failed creating "+
+				return nil, nil, fmt.Errorf("failed creating "+
 					"self-signed cert: %v", err)
 			}
 
@@ -933,31 +1020,115 @@ func buildTLSConfigForHttp2(config *Config) (*tls.Config, error) {
 				tlsCertPath, tlsKeyPath, certBytes, keyBytes,
 			)
 			if err != nil {
-				return nil, fmt.Errorf("failed storing "+
+				return nil, nil, fmt.Errorf("failed storing "+
 					"self-signed cert: %v", err)
 			}
 		}
 
-		tlsCert, _, err := cert.LoadCert(tlsCertPath, tlsKeyPath)
+		var err error
+		reloader, err = newCertReloader(tlsCertPath, tlsKeyPath)
 		if err != nil {
-			return nil, fmt.Errorf("failed reading TLS server "+
-				"keys: %v", err)
+			return nil, nil, err
 		}
-		tlsConfig = cert.TLSConfFromCert(tlsCert)
+
+		// The certificate is served through the reloader, so that it
+		// can be replaced without restarting the listener.
+		tlsConfig = cert.TLSConfFromCert(tls.Certificate{})
+		tlsConfig.Certificates = nil
+		tlsConfig.GetCertificate = reloader.GetCertificate
 	}
 
+	minVersion, cipherSuites, err := config.tlsVersionAndCiphers()
+	if err != nil {
+		return nil, nil, err
+	}
+	tlsConfig.MinVersion = minVersion
+
+	switch {
+	case len(cipherSuites) > 0:
+		tlsConfig.CipherSuites = cipherSuites
+
 	// lnd's cipher suites are too restrictive for HTTP/2, we need to add
 	// one of the default suites back to stop the HTTP/2 lib from
 	// complaining.
-	tlsConfig.CipherSuites = append(
-		tlsConfig.CipherSuites,
-		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
-	)
-	tlsConfig, err := connhelpers.TlsConfigWithHttp2Enabled(tlsConfig)
+	default:
+		tlsConfig.CipherSuites = append(
+			tlsConfig.CipherSuites,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		)
+	}
+
+	tlsConfig, err = connhelpers.TlsConfigWithHttp2Enabled(tlsConfig)
 	if err != nil {
-		return nil, fmt.Errorf("can't configure h2 handling: %v", err)
+		return nil, nil, fmt.Errorf("can't configure h2 handling: %v",
+			err)
+	}
+	return tlsConfig, reloader, nil
+}
+
+// tlsVersionAndCiphers parses the configured minimum TLS version and cipher
+// suites of the HTTPS listener. An empty list of cipher suites means that the
+// default cipher suites should be used. Insecure combinations are rejected.
+func (c *Config) tlsVersionAndCiphers() (uint16, []uint16, error) {
+	var minVersion uint16
+	switch c.TLSMinVersion {
+	case "", "1.2":
+		minVersion = tls.VersionTLS12
+
+	case "1.3":
+		minVersion = tls.VersionTLS13
+
+	default:
+		return 0, nil, fmt.Errorf("unsupported minimum TLS version %s",
+			c.TLSMinVersion)
 	}
-	return tlsConfig, nil
+
+	if len(c.TLSCipherSuites) == 0 {
+		return minVersion, nil, nil
+	}
+
+	if minVersion == tls.VersionTLS13 {
+		return 0, nil, fmt.Errorf("cipher suites can't be configured " +
+			"with a minimum TLS version of 1.3")
+	}
+
+	secureSuites := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		secureSuites[suite.Name] = suite.ID
+	}
+
+	var (
+		cipherSuites []uint16
+		hasHTTP2     bool
+	)
+	for _, name := range c.TLSCipherSuites {
+		id, ok := secureSuites[name]
+		if !ok {
+			return 0, nil, fmt.Errorf("unknown or insecure TLS "+
+				"cipher suite %s", name)
+		}
+
+		// Without an ephemeral key exchange, a leaked private key
+		// would allow all recorded traffic to be decrypted.
+		if !strings.HasPrefix(name, "TLS_ECDHE_") {
+			return 0, nil, fmt.Errorf("TLS cipher suite %s does "+
+				"not provide forward secrecy", name)
+		}
+
+		if id == tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256 {
+			hasHTTP2 = true
+		}
+
+		cipherSuites = append(cipherSuites, id)
+	}
+
+	if !hasHTTP2 {
+		return 0, nil, fmt.Errorf("TLS cipher suites must include " +
+			"TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256 which is " +
+			"required for HTTP/2")
+	}
+
+	return minVersion, cipherSuites, nil
 }
 
 // makeDirectories creates the directory given and if necessary any parent
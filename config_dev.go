@@ -129,7 +129,7 @@ func NewStores(cfg *Config, clock clock.Clock) (*stores, error) {
 	default:
 		accountStore, err := accounts.NewBoltStore(
 			filepath.Dir(cfg.MacaroonPath), accounts.DBFilename,
-			clock,
+			clock, cfg.Accounts.BoltStoreOptions()...,
 		)
 		if err != nil {
 			return stores, err
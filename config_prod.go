@@ -38,6 +38,7 @@ func NewStores(cfg *Config, clock clock.Clock) (*stores, error) {
 
 	acctStore, err := accounts.NewBoltStore(
 		filepath.Dir(cfg.MacaroonPath), accounts.DBFilename, clock,
+		cfg.Accounts.BoltStoreOptions()...,
 	)
 	if err != nil {
 		return stores, err
@@ -0,0 +1,95 @@
+package terminal
+
+import (
+	"crypto/tls"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestTLSVersionAndCiphers tests that the minimum TLS version and the cipher
+// suites of the HTTPS listener are parsed correctly and that insecure
+// combinations are rejected.
+func TestTLSVersionAndCiphers(t *testing.T) {
+	t.Parallel()
+
+	const http2Suite = "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256"
+
+	tests := []struct {
+		name           string
+		minVersion     string
+		cipherSuites   []string
+		expectedMin    uint16
+		expectedSuites []uint16
+		expectedErr    string
+	}{{
+		name:        "defaults",
+		expectedMin: tls.VersionTLS12,
+	}, {
+		name:        "tls 1.3",
+		minVersion:  "1.3",
+		expectedMin: tls.VersionTLS13,
+	}, {
+		name:        "unsupported version",
+		minVersion:  "1.1",
+		expectedErr: "unsupported minimum TLS version 1.1",
+	}, {
+		name:       "custom suites",
+		minVersion: "1.2",
+		cipherSuites: []string{
+			"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384", http2Suite,
+		},
+		expectedMin: tls.VersionTLS12,
+		expectedSuites: []uint16{
+			tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+			tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		},
+	}, {
+		name:         "unknown suite",
+		cipherSuites: []string{"TLS_UNKNOWN", http2Suite},
+		expectedErr:  "unknown or insecure TLS cipher suite TLS_UNKNOWN",
+	}, {
+		name: "insecure suite",
+		cipherSuites: []string{
+			"TLS_RSA_WITH_RC4_128_SHA", http2Suite,
+		},
+		expectedErr: "unknown or insecure TLS cipher suite",
+	}, {
+		name:         "no forward secrecy",
+		cipherSuites: []string{"TLS_AES_128_GCM_SHA256", http2Suite},
+		expectedErr:  "does not provide forward secrecy",
+	}, {
+		name: "missing http/2 suite",
+		cipherSuites: []string{
+			"TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+		},
+		expectedErr: "required for HTTP/2",
+	}, {
+		name:         "suites with tls 1.3",
+		minVersion:   "1.3",
+		cipherSuites: []string{http2Suite},
+		expectedErr:  "can't be configured with a minimum TLS version",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &Config{
+				TLSMinVersion:   test.minVersion,
+				TLSCipherSuites: test.cipherSuites,
+			}
+
+			minVersion, suites, err := cfg.tlsVersionAndCiphers()
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, test.expectedMin, minVersion)
+			require.Equal(t, test.expectedSuites, suites)
+		})
+	}
+}
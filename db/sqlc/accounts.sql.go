@@ -36,6 +36,26 @@ func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
 	return err
 }
 
+const deleteAccountAllowedDestinations = `-- name: DeleteAccountAllowedDestinations :exec
+DELETE FROM account_allowed_destinations
+WHERE account_id = $1
+`
+
+func (q *Queries) DeleteAccountAllowedDestinations(ctx context.Context, accountID int64) error {
+	_, err := q.db.ExecContext(ctx, deleteAccountAllowedDestinations, accountID)
+	return err
+}
+
+const deleteAccountCreditRule = `-- name: DeleteAccountCreditRule :exec
+DELETE FROM account_credit_rules
+WHERE memo_prefix = $1
+`
+
+func (q *Queries) DeleteAccountCreditRule(ctx context.Context, memoPrefix string) error {
+	_, err := q.db.ExecContext(ctx, deleteAccountCreditRule, memoPrefix)
+	return err
+}
+
 const deleteAccountPayment = `-- name: DeleteAccountPayment :exec
 DELETE FROM account_payments
 WHERE hash = $1
@@ -53,7 +73,7 @@ func (q *Queries) DeleteAccountPayment(ctx context.Context, arg DeleteAccountPay
 }
 
 const getAccount = `-- name: GetAccount :one
-SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
 FROM accounts
 WHERE id = $1
 `
@@ -70,12 +90,29 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.CurrentBalanceMsat,
 		&i.LastUpdated,
 		&i.Expiration,
+		&i.MaxHtlcMsat,
+		&i.SoftCapMsat,
+		&i.Sandbox,
+		&i.KeysendBudgetMsat,
+		&i.Version,
+		&i.NodeID,
+		&i.AmountlessMaxMsat,
+		&i.TopUpMinBalanceMsat,
+		&i.TopUpAmountMsat,
+		&i.RateLimitMaxMsat,
+		&i.RateLimitWindowSeconds,
+		&i.ExpiryWarningSeconds,
+		&i.MaxOpenInvoices,
+		&i.MaxInvoiceAmountMsat,
+		&i.OpenInvoices,
+		&i.CreatedAt,
+		&i.MaxPaymentMsat,
 	)
 	return i, err
 }
 
 const getAccountByLabel = `-- name: GetAccountByLabel :one
-SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
 FROM accounts
 WHERE label = $1
 `
@@ -92,6 +129,41 @@ func (q *Queries) GetAccountByLabel(ctx context.Context, label sql.NullString) (
 		&i.CurrentBalanceMsat,
 		&i.LastUpdated,
 		&i.Expiration,
+		&i.MaxHtlcMsat,
+		&i.SoftCapMsat,
+		&i.Sandbox,
+		&i.KeysendBudgetMsat,
+		&i.Version,
+		&i.NodeID,
+		&i.AmountlessMaxMsat,
+		&i.TopUpMinBalanceMsat,
+		&i.TopUpAmountMsat,
+		&i.RateLimitMaxMsat,
+		&i.RateLimitWindowSeconds,
+		&i.ExpiryWarningSeconds,
+		&i.MaxOpenInvoices,
+		&i.MaxInvoiceAmountMsat,
+		&i.OpenInvoices,
+		&i.CreatedAt,
+		&i.MaxPaymentMsat,
+	)
+	return i, err
+}
+
+const getAccountCreditRule = `-- name: GetAccountCreditRule :one
+SELECT id, memo_prefix, account_id, created_at
+FROM account_credit_rules
+WHERE memo_prefix = $1
+`
+
+func (q *Queries) GetAccountCreditRule(ctx context.Context, memoPrefix string) (AccountCreditRule, error) {
+	row := q.db.QueryRowContext(ctx, getAccountCreditRule, memoPrefix)
+	var i AccountCreditRule
+	err := row.Scan(
+		&i.ID,
+		&i.MemoPrefix,
+		&i.AccountID,
+		&i.CreatedAt,
 	)
 	return i, err
 }
@@ -142,7 +214,7 @@ func (q *Queries) GetAccountInvoice(ctx context.Context, arg GetAccountInvoicePa
 }
 
 const getAccountPayment = `-- name: GetAccountPayment :one
-SELECT account_id, hash, status, full_amount_msat FROM account_payments
+SELECT account_id, hash, status, full_amount_msat, fee_msat, destination FROM account_payments
 WHERE hash = $1
 AND account_id = $2
 `
@@ -160,24 +232,41 @@ func (q *Queries) GetAccountPayment(ctx context.Context, arg GetAccountPaymentPa
 		&i.Hash,
 		&i.Status,
 		&i.FullAmountMsat,
+		&i.FeeMsat,
+		&i.Destination,
 	)
 	return i, err
 }
 
 const insertAccount = `-- name: InsertAccount :one
-INSERT INTO accounts (type, initial_balance_msat, current_balance_msat, last_updated, label, alias, expiration)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO accounts (type, initial_balance_msat, current_balance_msat, last_updated, label, alias, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, created_at, max_payment_msat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
     RETURNING id
 `
 
 type InsertAccountParams struct {
-	Type               int16
-	InitialBalanceMsat int64
-	CurrentBalanceMsat int64
-	LastUpdated        time.Time
-	Label              sql.NullString
-	Alias              int64
-	Expiration         time.Time
+	Type                   int16
+	InitialBalanceMsat     int64
+	CurrentBalanceMsat     int64
+	LastUpdated            time.Time
+	Label                  sql.NullString
+	Alias                  int64
+	Expiration             time.Time
+	MaxHtlcMsat            int64
+	SoftCapMsat            int64
+	Sandbox                bool
+	KeysendBudgetMsat      int64
+	NodeID                 []byte
+	AmountlessMaxMsat      int64
+	TopUpMinBalanceMsat    int64
+	TopUpAmountMsat        int64
+	RateLimitMaxMsat       int64
+	RateLimitWindowSeconds int64
+	ExpiryWarningSeconds   int64
+	MaxOpenInvoices        int64
+	MaxInvoiceAmountMsat   int64
+	CreatedAt              time.Time
+	MaxPaymentMsat         int64
 }
 
 func (q *Queries) InsertAccount(ctx context.Context, arg InsertAccountParams) (int64, error) {
@@ -189,12 +278,211 @@ func (q *Queries) InsertAccount(ctx context.Context, arg InsertAccountParams) (i
 		arg.Label,
 		arg.Alias,
 		arg.Expiration,
+		arg.MaxHtlcMsat,
+		arg.SoftCapMsat,
+		arg.Sandbox,
+		arg.KeysendBudgetMsat,
+		arg.NodeID,
+		arg.AmountlessMaxMsat,
+		arg.TopUpMinBalanceMsat,
+		arg.TopUpAmountMsat,
+		arg.RateLimitMaxMsat,
+		arg.RateLimitWindowSeconds,
+		arg.ExpiryWarningSeconds,
+		arg.MaxOpenInvoices,
+		arg.MaxInvoiceAmountMsat,
+		arg.CreatedAt,
+		arg.MaxPaymentMsat,
 	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
 }
 
+const insertAccountAllowedDestination = `-- name: InsertAccountAllowedDestination :exec
+INSERT INTO account_allowed_destinations (account_id, node_id)
+VALUES ($1, $2)
+`
+
+type InsertAccountAllowedDestinationParams struct {
+	AccountID int64
+	NodeID    []byte
+}
+
+func (q *Queries) InsertAccountAllowedDestination(ctx context.Context, arg InsertAccountAllowedDestinationParams) error {
+	_, err := q.db.ExecContext(ctx, insertAccountAllowedDestination, arg.AccountID, arg.NodeID)
+	return err
+}
+
+const insertAccountCreditRule = `-- name: InsertAccountCreditRule :exec
+INSERT INTO account_credit_rules (memo_prefix, account_id, created_at)
+VALUES ($1, $2, $3)
+`
+
+type InsertAccountCreditRuleParams struct {
+	MemoPrefix string
+	AccountID  int64
+	CreatedAt  time.Time
+}
+
+func (q *Queries) InsertAccountCreditRule(ctx context.Context, arg InsertAccountCreditRuleParams) error {
+	_, err := q.db.ExecContext(ctx, insertAccountCreditRule, arg.MemoPrefix, arg.AccountID, arg.CreatedAt)
+	return err
+}
+
+const insertAccountEvent = `-- name: InsertAccountEvent :exec
+INSERT INTO account_events (account_alias, type, actor, details, created_at)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type InsertAccountEventParams struct {
+	AccountAlias int64
+	Type         int16
+	Actor        string
+	Details      string
+	CreatedAt    time.Time
+}
+
+func (q *Queries) InsertAccountEvent(ctx context.Context, arg InsertAccountEventParams) error {
+	_, err := q.db.ExecContext(ctx, insertAccountEvent,
+		arg.AccountAlias,
+		arg.Type,
+		arg.Actor,
+		arg.Details,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const insertAccountLedgerEntry = `-- name: InsertAccountLedgerEntry :exec
+INSERT INTO account_ledger (account_alias, type, amount_msat, balance_msat, reference, created_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type InsertAccountLedgerEntryParams struct {
+	AccountAlias int64
+	Type         int16
+	AmountMsat   int64
+	BalanceMsat  int64
+	Reference    []byte
+	CreatedAt    time.Time
+}
+
+func (q *Queries) InsertAccountLedgerEntry(ctx context.Context, arg InsertAccountLedgerEntryParams) error {
+	_, err := q.db.ExecContext(ctx, insertAccountLedgerEntry,
+		arg.AccountAlias,
+		arg.Type,
+		arg.AmountMsat,
+		arg.BalanceMsat,
+		arg.Reference,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const listAccountAllowedDestinations = `-- name: ListAccountAllowedDestinations :many
+SELECT node_id
+FROM account_allowed_destinations
+WHERE account_id = $1
+ORDER BY node_id
+`
+
+func (q *Queries) ListAccountAllowedDestinations(ctx context.Context, accountID int64) ([][]byte, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountAllowedDestinations, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items [][]byte
+	for rows.Next() {
+		var node_id []byte
+		if err := rows.Scan(&node_id); err != nil {
+			return nil, err
+		}
+		items = append(items, node_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountCreditRules = `-- name: ListAccountCreditRules :many
+SELECT r.memo_prefix, a.alias, r.created_at
+FROM account_credit_rules r
+JOIN accounts a ON r.account_id = a.id
+ORDER BY r.memo_prefix
+`
+
+type ListAccountCreditRulesRow struct {
+	MemoPrefix string
+	Alias      int64
+	CreatedAt  time.Time
+}
+
+func (q *Queries) ListAccountCreditRules(ctx context.Context) ([]ListAccountCreditRulesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountCreditRules)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListAccountCreditRulesRow
+	for rows.Next() {
+		var i ListAccountCreditRulesRow
+		if err := rows.Scan(&i.MemoPrefix, &i.Alias, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountEvents = `-- name: ListAccountEvents :many
+SELECT id, account_alias, type, actor, details, created_at
+FROM account_events
+WHERE account_alias = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAccountEvents(ctx context.Context, accountAlias int64) ([]AccountEvent, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountEvents, accountAlias)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountEvent
+	for rows.Next() {
+		var i AccountEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountAlias,
+			&i.Type,
+			&i.Actor,
+			&i.Details,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAccountInvoices = `-- name: ListAccountInvoices :many
 SELECT account_id, hash
 FROM account_invoices
@@ -224,8 +512,46 @@ func (q *Queries) ListAccountInvoices(ctx context.Context, accountID int64) ([]A
 	return items, nil
 }
 
+const listAccountLedgerEntries = `-- name: ListAccountLedgerEntries :many
+SELECT id, account_alias, type, amount_msat, balance_msat, reference, created_at
+FROM account_ledger
+WHERE account_alias = $1
+ORDER BY id
+`
+
+func (q *Queries) ListAccountLedgerEntries(ctx context.Context, accountAlias int64) ([]AccountLedger, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountLedgerEntries, accountAlias)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountLedger
+	for rows.Next() {
+		var i AccountLedger
+		if err := rows.Scan(
+			&i.ID,
+			&i.AccountAlias,
+			&i.Type,
+			&i.AmountMsat,
+			&i.BalanceMsat,
+			&i.Reference,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listAccountPayments = `-- name: ListAccountPayments :many
-SELECT account_id, hash, status, full_amount_msat
+SELECT account_id, hash, status, full_amount_msat, fee_msat, destination
 FROM account_payments
 WHERE account_id = $1
 `
@@ -244,6 +570,8 @@ func (q *Queries) ListAccountPayments(ctx context.Context, accountID int64) ([]A
 			&i.Hash,
 			&i.Status,
 			&i.FullAmountMsat,
+			&i.FeeMsat,
+			&i.Destination,
 		); err != nil {
 			return nil, err
 		}
@@ -258,13 +586,30 @@ func (q *Queries) ListAccountPayments(ctx context.Context, accountID int64) ([]A
 	return items, nil
 }
 
-const listAllAccounts = `-- name: ListAllAccounts :many
-SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration
+const listAccountsByBalanceAsc = `-- name: ListAccountsByBalanceAsc :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
 FROM accounts
+WHERE NOT CAST($1 AS BOOLEAN)
+    OR current_balance_msat > $2
+    OR (current_balance_msat = $2 AND alias >= $3)
+ORDER BY current_balance_msat ASC, alias ASC
+LIMIT $4
 `
 
-func (q *Queries) ListAllAccounts(ctx context.Context) ([]Account, error) {
-	rows, err := q.db.QueryContext(ctx, listAllAccounts)
+type ListAccountsByBalanceAscParams struct {
+	HasStart     bool
+	StartBalance int64
+	StartAlias   int64
+	Limit        int32
+}
+
+func (q *Queries) ListAccountsByBalanceAsc(ctx context.Context, arg ListAccountsByBalanceAscParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByBalanceAsc,
+		arg.HasStart,
+		arg.StartBalance,
+		arg.StartAlias,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -281,6 +626,23 @@ func (q *Queries) ListAllAccounts(ctx context.Context) ([]Account, error) {
 			&i.CurrentBalanceMsat,
 			&i.LastUpdated,
 			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
 		); err != nil {
 			return nil, err
 		}
@@ -295,67 +657,788 @@ func (q *Queries) ListAllAccounts(ctx context.Context) ([]Account, error) {
 	return items, nil
 }
 
-const setAccountIndex = `-- name: SetAccountIndex :exec
-INSERT INTO account_indices (name, value)
-VALUES ($1, $2)
-    ON CONFLICT (name)
-DO UPDATE SET value = $2
+const listAccountsByBalanceDesc = `-- name: ListAccountsByBalanceDesc :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+WHERE NOT CAST($1 AS BOOLEAN)
+    OR current_balance_msat < $2
+    OR (current_balance_msat = $2 AND alias <= $3)
+ORDER BY current_balance_msat DESC, alias DESC
+LIMIT $4
 `
 
-type SetAccountIndexParams struct {
-	Name  string
-	Value int64
+type ListAccountsByBalanceDescParams struct {
+	HasStart     bool
+	StartBalance int64
+	StartAlias   int64
+	Limit        int32
 }
 
-func (q *Queries) SetAccountIndex(ctx context.Context, arg SetAccountIndexParams) error {
-	_, err := q.db.ExecContext(ctx, setAccountIndex, arg.Name, arg.Value)
-	return err
+func (q *Queries) ListAccountsByBalanceDesc(ctx context.Context, arg ListAccountsByBalanceDescParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByBalanceDesc,
+		arg.HasStart,
+		arg.StartBalance,
+		arg.StartAlias,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const updateAccountBalance = `-- name: UpdateAccountBalance :one
-UPDATE accounts
-SET current_balance_msat = $1
-WHERE id = $2
-RETURNING id
+const listAccountsByCreatedAsc = `-- name: ListAccountsByCreatedAsc :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+WHERE NOT CAST($1 AS BOOLEAN)
+    OR created_at > $2
+    OR (created_at = $2 AND alias >= $3)
+ORDER BY created_at ASC, alias ASC
+LIMIT $4
 `
 
-type UpdateAccountBalanceParams struct {
-	CurrentBalanceMsat int64
-	ID                 int64
+type ListAccountsByCreatedAscParams struct {
+	HasStart       bool
+	StartCreatedAt time.Time
+	StartAlias     int64
+	Limit          int32
 }
 
-func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, updateAccountBalance, arg.CurrentBalanceMsat, arg.ID)
-	var id int64
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) ListAccountsByCreatedAsc(ctx context.Context, arg ListAccountsByCreatedAscParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByCreatedAsc,
+		arg.HasStart,
+		arg.StartCreatedAt,
+		arg.StartAlias,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const updateAccountExpiry = `-- name: UpdateAccountExpiry :one
-UPDATE accounts
-SET expiration = $1
-WHERE id = $2
-RETURNING id
+const listAccountsByCreatedDesc = `-- name: ListAccountsByCreatedDesc :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+WHERE NOT CAST($1 AS BOOLEAN)
+    OR created_at < $2
+    OR (created_at = $2 AND alias <= $3)
+ORDER BY created_at DESC, alias DESC
+LIMIT $4
 `
 
-type UpdateAccountExpiryParams struct {
-	Expiration time.Time
-	ID         int64
-}
-
-func (q *Queries) UpdateAccountExpiry(ctx context.Context, arg UpdateAccountExpiryParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, updateAccountExpiry, arg.Expiration, arg.ID)
-	var id int64
-	err := row.Scan(&id)
-	return id, err
+type ListAccountsByCreatedDescParams struct {
+	HasStart       bool
+	StartCreatedAt time.Time
+	StartAlias     int64
+	Limit          int32
 }
 
-const updateAccountLastUpdate = `-- name: UpdateAccountLastUpdate :one
-UPDATE accounts
-SET last_updated = $1
-WHERE id = $2
-RETURNING id
-`
+func (q *Queries) ListAccountsByCreatedDesc(ctx context.Context, arg ListAccountsByCreatedDescParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByCreatedDesc,
+		arg.HasStart,
+		arg.StartCreatedAt,
+		arg.StartAlias,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByExpirationAsc = `-- name: ListAccountsByExpirationAsc :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+WHERE NOT CAST($1 AS BOOLEAN)
+    OR (expiration = $2) > CAST($3 AS BOOLEAN)
+    OR ((expiration = $2) = CAST($3 AS BOOLEAN) AND (
+        expiration > $4
+        OR (expiration = $4 AND alias >= $5)
+    ))
+ORDER BY expiration = $2 ASC, expiration ASC, alias ASC
+LIMIT $6
+`
+
+type ListAccountsByExpirationAscParams struct {
+	HasStart        bool
+	Never           time.Time
+	StartNever      bool
+	StartExpiration time.Time
+	StartAlias      int64
+	Limit           int32
+}
+
+func (q *Queries) ListAccountsByExpirationAsc(ctx context.Context, arg ListAccountsByExpirationAscParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByExpirationAsc,
+		arg.HasStart,
+		arg.Never,
+		arg.StartNever,
+		arg.StartExpiration,
+		arg.StartAlias,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByExpirationDesc = `-- name: ListAccountsByExpirationDesc :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+WHERE NOT CAST($1 AS BOOLEAN)
+    OR (expiration = $2) < CAST($3 AS BOOLEAN)
+    OR ((expiration = $2) = CAST($3 AS BOOLEAN) AND (
+        expiration < $4
+        OR (expiration = $4 AND alias <= $5)
+    ))
+ORDER BY expiration = $2 DESC, expiration DESC, alias DESC
+LIMIT $6
+`
+
+type ListAccountsByExpirationDescParams struct {
+	HasStart        bool
+	Never           time.Time
+	StartNever      bool
+	StartExpiration time.Time
+	StartAlias      int64
+	Limit           int32
+}
+
+func (q *Queries) ListAccountsByExpirationDesc(ctx context.Context, arg ListAccountsByExpirationDescParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByExpirationDesc,
+		arg.HasStart,
+		arg.Never,
+		arg.StartNever,
+		arg.StartExpiration,
+		arg.StartAlias,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByLabelAsc = `-- name: ListAccountsByLabelAsc :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+WHERE NOT CAST($1 AS BOOLEAN)
+    OR COALESCE(label, '') > $2
+    OR (COALESCE(label, '') = $2 AND alias >= $3)
+ORDER BY COALESCE(label, '') ASC, alias ASC
+LIMIT $4
+`
+
+type ListAccountsByLabelAscParams struct {
+	HasStart   bool
+	StartLabel sql.NullString
+	StartAlias int64
+	Limit      int32
+}
+
+func (q *Queries) ListAccountsByLabelAsc(ctx context.Context, arg ListAccountsByLabelAscParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByLabelAsc,
+		arg.HasStart,
+		arg.StartLabel,
+		arg.StartAlias,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByLabelDesc = `-- name: ListAccountsByLabelDesc :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+WHERE NOT CAST($1 AS BOOLEAN)
+    OR COALESCE(label, '') < $2
+    OR (COALESCE(label, '') = $2 AND alias <= $3)
+ORDER BY COALESCE(label, '') DESC, alias DESC
+LIMIT $4
+`
+
+type ListAccountsByLabelDescParams struct {
+	HasStart   bool
+	StartLabel sql.NullString
+	StartAlias int64
+	Limit      int32
+}
+
+func (q *Queries) ListAccountsByLabelDesc(ctx context.Context, arg ListAccountsByLabelDescParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByLabelDesc,
+		arg.HasStart,
+		arg.StartLabel,
+		arg.StartAlias,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsPage = `-- name: ListAccountsPage :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+WHERE alias >= $1
+ORDER BY alias
+LIMIT $2
+`
+
+type ListAccountsPageParams struct {
+	Alias int64
+	Limit int32
+}
+
+func (q *Queries) ListAccountsPage(ctx context.Context, arg ListAccountsPageParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsPage, arg.Alias, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAllAccounts = `-- name: ListAllAccounts :many
+SELECT id, alias, label, type, initial_balance_msat, current_balance_msat, last_updated, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, version, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, open_invoices, created_at, max_payment_msat
+FROM accounts
+`
+
+func (q *Queries) ListAllAccounts(ctx context.Context) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAllAccounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.Type,
+			&i.InitialBalanceMsat,
+			&i.CurrentBalanceMsat,
+			&i.LastUpdated,
+			&i.Expiration,
+			&i.MaxHtlcMsat,
+			&i.SoftCapMsat,
+			&i.Sandbox,
+			&i.KeysendBudgetMsat,
+			&i.Version,
+			&i.NodeID,
+			&i.AmountlessMaxMsat,
+			&i.TopUpMinBalanceMsat,
+			&i.TopUpAmountMsat,
+			&i.RateLimitMaxMsat,
+			&i.RateLimitWindowSeconds,
+			&i.ExpiryWarningSeconds,
+			&i.MaxOpenInvoices,
+			&i.MaxInvoiceAmountMsat,
+			&i.OpenInvoices,
+			&i.CreatedAt,
+			&i.MaxPaymentMsat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const overwriteAccount = `-- name: OverwriteAccount :one
+UPDATE accounts
+SET type = $1, initial_balance_msat = $2, expiration = $3, label = $4, max_htlc_msat = $5, soft_cap_msat = $6, sandbox = $7, keysend_budget_msat = $8, node_id = $9, amountless_max_msat = $10, top_up_min_balance_msat = $11, top_up_amount_msat = $12, rate_limit_max_msat = $13, rate_limit_window_seconds = $14, expiry_warning_seconds = $15, max_open_invoices = $16, max_invoice_amount_msat = $17, max_payment_msat = $18
+WHERE id = $19
+RETURNING id
+`
+
+type OverwriteAccountParams struct {
+	Type                   int16
+	InitialBalanceMsat     int64
+	Expiration             time.Time
+	Label                  sql.NullString
+	MaxHtlcMsat            int64
+	SoftCapMsat            int64
+	Sandbox                bool
+	KeysendBudgetMsat      int64
+	NodeID                 []byte
+	AmountlessMaxMsat      int64
+	TopUpMinBalanceMsat    int64
+	TopUpAmountMsat        int64
+	RateLimitMaxMsat       int64
+	RateLimitWindowSeconds int64
+	ExpiryWarningSeconds   int64
+	MaxOpenInvoices        int64
+	MaxInvoiceAmountMsat   int64
+	MaxPaymentMsat         int64
+	ID                     int64
+}
+
+func (q *Queries) OverwriteAccount(ctx context.Context, arg OverwriteAccountParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, overwriteAccount,
+		arg.Type,
+		arg.InitialBalanceMsat,
+		arg.Expiration,
+		arg.Label,
+		arg.MaxHtlcMsat,
+		arg.SoftCapMsat,
+		arg.Sandbox,
+		arg.KeysendBudgetMsat,
+		arg.NodeID,
+		arg.AmountlessMaxMsat,
+		arg.TopUpMinBalanceMsat,
+		arg.TopUpAmountMsat,
+		arg.RateLimitMaxMsat,
+		arg.RateLimitWindowSeconds,
+		arg.ExpiryWarningSeconds,
+		arg.MaxOpenInvoices,
+		arg.MaxInvoiceAmountMsat,
+		arg.MaxPaymentMsat,
+		arg.ID,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const setAccountIndex = `-- name: SetAccountIndex :exec
+INSERT INTO account_indices (name, value)
+VALUES ($1, $2)
+    ON CONFLICT (name)
+DO UPDATE SET value = $2
+`
+
+type SetAccountIndexParams struct {
+	Name  string
+	Value int64
+}
+
+func (q *Queries) SetAccountIndex(ctx context.Context, arg SetAccountIndexParams) error {
+	_, err := q.db.ExecContext(ctx, setAccountIndex, arg.Name, arg.Value)
+	return err
+}
+
+const updateAccountBalance = `-- name: UpdateAccountBalance :one
+UPDATE accounts
+SET current_balance_msat = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountBalanceParams struct {
+	CurrentBalanceMsat int64
+	ID                 int64
+}
+
+func (q *Queries) UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountBalance, arg.CurrentBalanceMsat, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountExpiry = `-- name: UpdateAccountExpiry :one
+UPDATE accounts
+SET expiration = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountExpiryParams struct {
+	Expiration time.Time
+	ID         int64
+}
+
+func (q *Queries) UpdateAccountExpiry(ctx context.Context, arg UpdateAccountExpiryParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountExpiry, arg.Expiration, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountExpiryWarning = `-- name: UpdateAccountExpiryWarning :one
+UPDATE accounts
+SET expiry_warning_seconds = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountExpiryWarningParams struct {
+	ExpiryWarningSeconds int64
+	ID                   int64
+}
+
+func (q *Queries) UpdateAccountExpiryWarning(ctx context.Context, arg UpdateAccountExpiryWarningParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountExpiryWarning, arg.ExpiryWarningSeconds, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountLabel = `-- name: UpdateAccountLabel :one
+UPDATE accounts
+SET label = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountLabelParams struct {
+	Label sql.NullString
+	ID    int64
+}
+
+func (q *Queries) UpdateAccountLabel(ctx context.Context, arg UpdateAccountLabelParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountLabel, arg.Label, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountLastUpdate = `-- name: UpdateAccountLastUpdate :one
+UPDATE accounts
+SET last_updated = $1, version = version + 1
+WHERE id = $2
+RETURNING id
+`
 
 type UpdateAccountLastUpdateParams struct {
 	LastUpdated time.Time
@@ -369,11 +1452,165 @@ func (q *Queries) UpdateAccountLastUpdate(ctx context.Context, arg UpdateAccount
 	return id, err
 }
 
+const updateAccountMaxHTLC = `-- name: UpdateAccountMaxHTLC :one
+UPDATE accounts
+SET max_htlc_msat = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountMaxHTLCParams struct {
+	MaxHtlcMsat int64
+	ID          int64
+}
+
+func (q *Queries) UpdateAccountMaxHTLC(ctx context.Context, arg UpdateAccountMaxHTLCParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountMaxHTLC, arg.MaxHtlcMsat, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountMaxInvoiceAmount = `-- name: UpdateAccountMaxInvoiceAmount :one
+UPDATE accounts
+SET max_invoice_amount_msat = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountMaxInvoiceAmountParams struct {
+	MaxInvoiceAmountMsat int64
+	ID                   int64
+}
+
+func (q *Queries) UpdateAccountMaxInvoiceAmount(ctx context.Context, arg UpdateAccountMaxInvoiceAmountParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountMaxInvoiceAmount, arg.MaxInvoiceAmountMsat, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountMaxOpenInvoices = `-- name: UpdateAccountMaxOpenInvoices :one
+UPDATE accounts
+SET max_open_invoices = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountMaxOpenInvoicesParams struct {
+	MaxOpenInvoices int64
+	ID              int64
+}
+
+func (q *Queries) UpdateAccountMaxOpenInvoices(ctx context.Context, arg UpdateAccountMaxOpenInvoicesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountMaxOpenInvoices, arg.MaxOpenInvoices, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountMaxPayment = `-- name: UpdateAccountMaxPayment :one
+UPDATE accounts
+SET max_payment_msat = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountMaxPaymentParams struct {
+	MaxPaymentMsat int64
+	ID             int64
+}
+
+func (q *Queries) UpdateAccountMaxPayment(ctx context.Context, arg UpdateAccountMaxPaymentParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountMaxPayment, arg.MaxPaymentMsat, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountOpenInvoices = `-- name: UpdateAccountOpenInvoices :one
+UPDATE accounts
+SET open_invoices = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountOpenInvoicesParams struct {
+	OpenInvoices int64
+	ID           int64
+}
+
+func (q *Queries) UpdateAccountOpenInvoices(ctx context.Context, arg UpdateAccountOpenInvoicesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountOpenInvoices, arg.OpenInvoices, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountSoftCap = `-- name: UpdateAccountSoftCap :one
+UPDATE accounts
+SET soft_cap_msat = $1
+WHERE id = $2
+RETURNING id
+`
+
+type UpdateAccountSoftCapParams struct {
+	SoftCapMsat int64
+	ID          int64
+}
+
+func (q *Queries) UpdateAccountSoftCap(ctx context.Context, arg UpdateAccountSoftCapParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountSoftCap, arg.SoftCapMsat, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountSpendRateLimit = `-- name: UpdateAccountSpendRateLimit :one
+UPDATE accounts
+SET rate_limit_max_msat = $1, rate_limit_window_seconds = $2
+WHERE id = $3
+RETURNING id
+`
+
+type UpdateAccountSpendRateLimitParams struct {
+	RateLimitMaxMsat       int64
+	RateLimitWindowSeconds int64
+	ID                     int64
+}
+
+func (q *Queries) UpdateAccountSpendRateLimit(ctx context.Context, arg UpdateAccountSpendRateLimitParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountSpendRateLimit, arg.RateLimitMaxMsat, arg.RateLimitWindowSeconds, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateAccountTopUpPolicy = `-- name: UpdateAccountTopUpPolicy :one
+UPDATE accounts
+SET top_up_min_balance_msat = $1, top_up_amount_msat = $2
+WHERE id = $3
+RETURNING id
+`
+
+type UpdateAccountTopUpPolicyParams struct {
+	TopUpMinBalanceMsat int64
+	TopUpAmountMsat     int64
+	ID                  int64
+}
+
+func (q *Queries) UpdateAccountTopUpPolicy(ctx context.Context, arg UpdateAccountTopUpPolicyParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountTopUpPolicy, arg.TopUpMinBalanceMsat, arg.TopUpAmountMsat, arg.ID)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
 const upsertAccountPayment = `-- name: UpsertAccountPayment :exec
-INSERT INTO account_payments (account_id, hash, status, full_amount_msat)
-VALUES ($1, $2, $3, $4)
+INSERT INTO account_payments (account_id, hash, status, full_amount_msat, fee_msat, destination)
+VALUES ($1, $2, $3, $4, $5, $6)
 ON CONFLICT (account_id, hash)
-DO UPDATE SET status = $3, full_amount_msat = $4
+DO UPDATE SET status = $3, full_amount_msat = $4, fee_msat = $5, destination = $6
 `
 
 type UpsertAccountPaymentParams struct {
@@ -381,6 +1618,8 @@ type UpsertAccountPaymentParams struct {
 	Hash           []byte
 	Status         int16
 	FullAmountMsat int64
+	FeeMsat        int64
+	Destination    []byte
 }
 
 func (q *Queries) UpsertAccountPayment(ctx context.Context, arg UpsertAccountPaymentParams) error {
@@ -389,6 +1628,8 @@ func (q *Queries) UpsertAccountPayment(ctx context.Context, arg UpsertAccountPay
 		arg.Hash,
 		arg.Status,
 		arg.FullAmountMsat,
+		arg.FeeMsat,
+		arg.Destination,
 	)
 	return err
 }
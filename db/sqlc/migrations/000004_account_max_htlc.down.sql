@@ -0,0 +1 @@
+ALTER TABLE accounts DROP COLUMN max_htlc_msat;
@@ -0,0 +1,3 @@
+-- The maximum amount in millisatoshis that a single HTLC sent by the account
+-- may carry. A value of zero means that no limit is enforced.
+ALTER TABLE accounts ADD COLUMN max_htlc_msat BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+-- The verbosity of the errors that are returned to the client connected
+-- through the session. Zero means that errors are returned unmodified.
+ALTER TABLE sessions ADD COLUMN error_verbosity SMALLINT NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- The soft cap in millisatoshis on the total amount the account may spend
+-- before warnings are emitted for further payments. A value of zero means that
+-- no soft cap is set.
+ALTER TABLE accounts ADD COLUMN soft_cap_msat BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,3 @@
+-- Whether the account is a sandbox account that is only used for testing and
+-- should therefore be excluded from aggregate reports.
+ALTER TABLE accounts ADD COLUMN sandbox BOOLEAN NOT NULL DEFAULT FALSE;
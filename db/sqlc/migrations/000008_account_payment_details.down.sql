@@ -0,0 +1,2 @@
+ALTER TABLE account_payments DROP COLUMN destination;
+ALTER TABLE account_payments DROP COLUMN fee_msat;
@@ -0,0 +1,7 @@
+-- The routing fee in millisatoshis that was actually paid for the payment.
+-- This is only known once the payment has succeeded.
+ALTER TABLE account_payments ADD COLUMN fee_msat BIGINT NOT NULL DEFAULT 0;
+
+-- The public key of the node that the payment was sent to. This is only known
+-- once the payment has succeeded.
+ALTER TABLE account_payments ADD COLUMN destination BLOB;
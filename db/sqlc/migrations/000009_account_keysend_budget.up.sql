@@ -0,0 +1,3 @@
+-- The amount in millisatoshis that the account may spend on keysend payments
+-- per second. Zero means that keysend payments are not rate limited.
+ALTER TABLE accounts ADD COLUMN keysend_budget_msat BIGINT NOT NULL DEFAULT 0;
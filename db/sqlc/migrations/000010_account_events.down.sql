@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS account_events_account_alias_idx;
+DROP TABLE IF EXISTS account_events;
@@ -0,0 +1,28 @@
+-- The account_events table stores the lifecycle history of accounts, such as
+-- their creation, manual updates and removal. Events reference the account by
+-- its alias instead of its primary key so that the history of an account is
+-- kept after the account itself was removed.
+CREATE TABLE IF NOT EXISTS account_events (
+    -- The auto incrementing primary key. It also determines the order of the
+    -- events of an account.
+    id INTEGER PRIMARY KEY,
+
+    -- The alias of the account that the event belongs to.
+    account_alias BIGINT NOT NULL,
+
+    -- The kind of event.
+    type SMALLINT NOT NULL,
+
+    -- Who caused the event, for example the address of an RPC client.
+    actor TEXT NOT NULL,
+
+    -- A human-readable description of the change.
+    details TEXT NOT NULL,
+
+    -- The time at which the event happened.
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS account_events_account_alias_idx ON account_events (
+    account_alias
+);
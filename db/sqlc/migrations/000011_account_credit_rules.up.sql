@@ -0,0 +1,18 @@
+-- The account_credit_rules table maps invoice memo prefixes to accounts. An
+-- invoice that isn't associated with any account but has a memo starting with
+-- the prefix of a rule credits the account of that rule once it is settled.
+CREATE TABLE IF NOT EXISTS account_credit_rules (
+    -- The auto incrementing primary key.
+    id INTEGER PRIMARY KEY,
+
+    -- The memo prefix that invoices must have to match the rule. Every prefix
+    -- can only be mapped to a single account.
+    memo_prefix TEXT NOT NULL UNIQUE,
+
+    -- The account that is credited for invoices matching the rule.
+    account_id BIGINT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+
+    -- The time at which the rule was added. Only invoices settled after this
+    -- time are credited.
+    created_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1,3 @@
+-- The version of the account, which is incremented every time the account is
+-- updated. New accounts and accounts that existed before start at one.
+ALTER TABLE accounts ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,3 @@
+-- The identity public key of the lnd node the account is bound to. Accounts
+-- that existed before are not bound to a node and have no node ID.
+ALTER TABLE accounts ADD COLUMN node_id BLOB;
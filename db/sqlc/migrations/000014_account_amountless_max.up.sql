@@ -0,0 +1,4 @@
+-- The maximum amount in millisatoshis the account may pay to an invoice that
+-- doesn't specify an amount. Zero means that such payments are only limited by
+-- the balance.
+ALTER TABLE accounts ADD COLUMN amountless_max_msat BIGINT NOT NULL DEFAULT 0;
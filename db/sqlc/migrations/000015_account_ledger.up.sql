@@ -0,0 +1,34 @@
+-- The account_ledger table records every change of the balance of an account
+-- together with the resulting balance, so that the balance of an account can
+-- be audited over time. Entries are written in the same transaction as the
+-- balance change itself. Like the account events, they reference the account
+-- by its alias so that the ledger of an account is kept after the account
+-- itself was removed.
+CREATE TABLE IF NOT EXISTS account_ledger (
+    -- The auto incrementing primary key. It also determines the order of the
+    -- entries of an account.
+    id INTEGER PRIMARY KEY,
+
+    -- The alias of the account that the entry belongs to.
+    account_alias BIGINT NOT NULL,
+
+    -- The kind of balance change.
+    type SMALLINT NOT NULL,
+
+    -- The amount in millisatoshis by which the balance changed. Negative for
+    -- debits.
+    amount_msat BIGINT NOT NULL,
+
+    -- The balance of the account in millisatoshis after the change.
+    balance_msat BIGINT NOT NULL,
+
+    -- The invoice or payment hash the change refers to, if any.
+    reference BLOB,
+
+    -- The time at which the balance changed.
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS account_ledger_account_alias_idx ON account_ledger (
+    account_alias
+);
@@ -0,0 +1,2 @@
+ALTER TABLE accounts DROP COLUMN top_up_amount_msat;
+ALTER TABLE accounts DROP COLUMN top_up_min_balance_msat;
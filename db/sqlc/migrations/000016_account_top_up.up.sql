@@ -0,0 +1,6 @@
+-- The top-up policy of an account. Once a payment brings the balance of the
+-- account below top_up_min_balance_msat, it is credited with
+-- top_up_amount_msat from the configured top-up source account. A top-up
+-- amount of zero disables automatic top-ups.
+ALTER TABLE accounts ADD COLUMN top_up_min_balance_msat BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE accounts ADD COLUMN top_up_amount_msat BIGINT NOT NULL DEFAULT 0;
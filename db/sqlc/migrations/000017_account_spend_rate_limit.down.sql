@@ -0,0 +1,2 @@
+ALTER TABLE accounts DROP COLUMN rate_limit_window_seconds;
+ALTER TABLE accounts DROP COLUMN rate_limit_max_msat;
@@ -0,0 +1,5 @@
+-- The spend rate limit of an account. The account may spend at most
+-- rate_limit_max_msat on payments within any rolling window of
+-- rate_limit_window_seconds. A maximum of zero disables the limit.
+ALTER TABLE accounts ADD COLUMN rate_limit_max_msat BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE accounts ADD COLUMN rate_limit_window_seconds BIGINT NOT NULL DEFAULT 0;
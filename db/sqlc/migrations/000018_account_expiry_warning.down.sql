@@ -0,0 +1 @@
+ALTER TABLE accounts DROP COLUMN expiry_warning_seconds;
@@ -0,0 +1,3 @@
+-- The lead time before the expiration of an account at which a warning is
+-- emitted that the account expires soon. Zero disables the warning.
+ALTER TABLE accounts ADD COLUMN expiry_warning_seconds BIGINT NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS account_allowed_destinations_unique;
+DROP TABLE IF EXISTS account_allowed_destinations;
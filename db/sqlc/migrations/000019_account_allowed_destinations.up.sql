@@ -0,0 +1,14 @@
+-- The account_allowed_destinations table stores the public keys of the only
+-- nodes an account may send payments to. An account without any entries may
+-- pay any node.
+CREATE TABLE IF NOT EXISTS account_allowed_destinations (
+    -- The account that may pay the node.
+    account_id BIGINT NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+
+    -- The serialized public key of the node.
+    node_id BLOB NOT NULL
+);
+
+CREATE UNIQUE INDEX account_allowed_destinations_unique ON account_allowed_destinations (
+    account_id, node_id
+);
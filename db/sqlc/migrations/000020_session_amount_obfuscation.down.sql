@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS session_clear_amount_fields_unique;
+DROP TABLE IF EXISTS session_clear_amount_fields;
+ALTER TABLE sessions DROP COLUMN amount_bucket_size;
+ALTER TABLE sessions DROP COLUMN amount_variation_ppm;
+ALTER TABLE sessions DROP COLUMN amount_obfuscation;
@@ -0,0 +1,27 @@
+-- The strategy the privacy mapper uses to obfuscate the amounts that are
+-- returned to the client connected through the session. Zero means that
+-- amounts are randomized within a relative range.
+ALTER TABLE sessions ADD COLUMN amount_obfuscation SMALLINT NOT NULL DEFAULT 0;
+
+-- The maximum relative variation in parts per million by which amounts are
+-- randomized. Zero means that the default variation is used.
+ALTER TABLE sessions ADD COLUMN amount_variation_ppm BIGINT NOT NULL DEFAULT 0;
+
+-- The size of the buckets in satoshis that amounts are rounded down to if the
+-- bucket strategy is used.
+ALTER TABLE sessions ADD COLUMN amount_bucket_size BIGINT NOT NULL DEFAULT 0;
+
+-- The session_clear_amount_fields table contains the names of the amount fields
+-- that the privacy mapper doesn't obfuscate for a session.
+CREATE TABLE IF NOT EXISTS session_clear_amount_fields (
+    -- The ID of the session in the sessions table that this field is
+    -- associated with.
+    session_id BIGINT NOT NULL REFERENCES sessions(id) ON DELETE CASCADE,
+
+    -- The name of the amount field.
+    field_name TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX session_clear_amount_fields_unique ON session_clear_amount_fields (
+    session_id, field_name
+);
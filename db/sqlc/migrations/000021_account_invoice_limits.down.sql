@@ -0,0 +1,3 @@
+ALTER TABLE accounts DROP COLUMN open_invoices;
+ALTER TABLE accounts DROP COLUMN max_invoice_amount_msat;
+ALTER TABLE accounts DROP COLUMN max_open_invoices;
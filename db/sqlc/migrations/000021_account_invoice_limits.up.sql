@@ -0,0 +1,8 @@
+-- The maximum number of open invoices an account may have and the maximum
+-- amount of an invoice it may create. Zero means that they aren't limited.
+ALTER TABLE accounts ADD COLUMN max_open_invoices BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE accounts ADD COLUMN max_invoice_amount_msat BIGINT NOT NULL DEFAULT 0;
+
+-- The number of invoices created with an account that are neither settled
+-- nor canceled yet.
+ALTER TABLE accounts ADD COLUMN open_invoices BIGINT NOT NULL DEFAULT 0;
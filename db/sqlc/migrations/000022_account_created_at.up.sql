@@ -0,0 +1,4 @@
+-- The time at which an account was created. Accounts that already exist use
+-- the time of their last update instead.
+ALTER TABLE accounts ADD COLUMN created_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00';
+UPDATE accounts SET created_at = last_updated;
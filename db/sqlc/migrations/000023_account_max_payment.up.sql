@@ -0,0 +1,3 @@
+-- The maximum amount of a single payment sent by an account, not including
+-- routing fees. Zero means that it isn't limited.
+ALTER TABLE accounts ADD COLUMN max_payment_msat BIGINT NOT NULL DEFAULT 0;
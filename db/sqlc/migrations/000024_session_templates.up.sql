@@ -0,0 +1,15 @@
+-- The session_templates table contains named templates that new sessions can
+-- be created from.
+CREATE TABLE IF NOT EXISTS session_templates (
+    -- The auto incrementing primary key.
+    id INTEGER PRIMARY KEY,
+
+    -- The unique name of the template.
+    name TEXT NOT NULL UNIQUE,
+
+    -- The serialized parameters of the sessions created from the template.
+    config BLOB NOT NULL,
+
+    -- The time at which the template was last saved.
+    created_at TIMESTAMP NOT NULL
+);
@@ -10,14 +10,52 @@ import (
 )
 
 type Account struct {
-	ID                 int64
-	Alias              int64
-	Label              sql.NullString
-	Type               int16
-	InitialBalanceMsat int64
-	CurrentBalanceMsat int64
-	LastUpdated        time.Time
-	Expiration         time.Time
+	ID                     int64
+	Alias                  int64
+	Label                  sql.NullString
+	Type                   int16
+	InitialBalanceMsat     int64
+	CurrentBalanceMsat     int64
+	LastUpdated            time.Time
+	Expiration             time.Time
+	MaxHtlcMsat            int64
+	SoftCapMsat            int64
+	Sandbox                bool
+	KeysendBudgetMsat      int64
+	Version                int64
+	NodeID                 []byte
+	AmountlessMaxMsat      int64
+	TopUpMinBalanceMsat    int64
+	TopUpAmountMsat        int64
+	RateLimitMaxMsat       int64
+	RateLimitWindowSeconds int64
+	ExpiryWarningSeconds   int64
+	MaxOpenInvoices        int64
+	MaxInvoiceAmountMsat   int64
+	OpenInvoices           int64
+	CreatedAt              time.Time
+	MaxPaymentMsat         int64
+}
+
+type AccountAllowedDestination struct {
+	AccountID int64
+	NodeID    []byte
+}
+
+type AccountCreditRule struct {
+	ID         int64
+	MemoPrefix string
+	AccountID  int64
+	CreatedAt  time.Time
+}
+
+type AccountEvent struct {
+	ID           int64
+	AccountAlias int64
+	Type         int16
+	Actor        string
+	Details      string
+	CreatedAt    time.Time
 }
 
 type AccountIndex struct {
@@ -30,11 +68,23 @@ type AccountInvoice struct {
 	Hash      []byte
 }
 
+type AccountLedger struct {
+	ID           int64
+	AccountAlias int64
+	Type         int16
+	AmountMsat   int64
+	BalanceMsat  int64
+	Reference    []byte
+	CreatedAt    time.Time
+}
+
 type AccountPayment struct {
 	AccountID      int64
 	Hash           []byte
 	Status         int16
 	FullAmountMsat int64
+	FeeMsat        int64
+	Destination    []byte
 }
 
 type Feature struct {
@@ -58,24 +108,33 @@ type Rule struct {
 }
 
 type Session struct {
-	ID              int64
-	Alias           []byte
-	Label           string
-	State           int16
-	Type            int16
-	Expiry          time.Time
-	CreatedAt       time.Time
-	RevokedAt       sql.NullTime
-	ServerAddress   string
-	DevServer       bool
-	MacaroonRootKey int64
-	PairingSecret   []byte
-	LocalPrivateKey []byte
-	LocalPublicKey  []byte
-	RemotePublicKey []byte
-	Privacy         bool
-	AccountID       sql.NullInt64
-	GroupID         sql.NullInt64
+	ID                 int64
+	Alias              []byte
+	Label              string
+	State              int16
+	Type               int16
+	Expiry             time.Time
+	CreatedAt          time.Time
+	RevokedAt          sql.NullTime
+	ServerAddress      string
+	DevServer          bool
+	MacaroonRootKey    int64
+	PairingSecret      []byte
+	LocalPrivateKey    []byte
+	LocalPublicKey     []byte
+	RemotePublicKey    []byte
+	Privacy            bool
+	AccountID          sql.NullInt64
+	GroupID            sql.NullInt64
+	ErrorVerbosity     int16
+	AmountObfuscation  int16
+	AmountVariationPpm int64
+	AmountBucketSize   int64
+}
+
+type SessionClearAmountField struct {
+	SessionID int64
+	FieldName string
 }
 
 type SessionFeatureConfig struct {
@@ -103,3 +162,10 @@ type SessionPrivacyFlag struct {
 	SessionID int64
 	Flag      int32
 }
+
+type SessionTemplate struct {
+	ID        int64
+	Name      string
+	Config    []byte
+	CreatedAt time.Time
+}
@@ -12,14 +12,18 @@ import (
 type Querier interface {
 	AddAccountInvoice(ctx context.Context, arg AddAccountInvoiceParams) error
 	DeleteAccount(ctx context.Context, id int64) error
+	DeleteAccountAllowedDestinations(ctx context.Context, accountID int64) error
+	DeleteAccountCreditRule(ctx context.Context, memoPrefix string) error
 	DeleteAccountPayment(ctx context.Context, arg DeleteAccountPaymentParams) error
 	DeleteAllTempKVStores(ctx context.Context) error
 	DeleteFeatureKVStoreRecord(ctx context.Context, arg DeleteFeatureKVStoreRecordParams) error
 	DeleteGlobalKVStoreRecord(ctx context.Context, arg DeleteGlobalKVStoreRecordParams) error
 	DeleteSessionKVStoreRecord(ctx context.Context, arg DeleteSessionKVStoreRecordParams) error
+	DeleteSessionTemplate(ctx context.Context, name string) error
 	DeleteSessionsWithState(ctx context.Context, state int16) error
 	GetAccount(ctx context.Context, id int64) (Account, error)
 	GetAccountByLabel(ctx context.Context, label sql.NullString) (Account, error)
+	GetAccountCreditRule(ctx context.Context, memoPrefix string) (AccountCreditRule, error)
 	GetAccountIDByAlias(ctx context.Context, alias int64) (int64, error)
 	GetAccountIndex(ctx context.Context, name string) (int64, error)
 	GetAccountInvoice(ctx context.Context, arg GetAccountInvoiceParams) (AccountInvoice, error)
@@ -35,38 +39,73 @@ type Querier interface {
 	GetSessionByAlias(ctx context.Context, alias []byte) (Session, error)
 	GetSessionByID(ctx context.Context, id int64) (Session, error)
 	GetSessionByLocalPublicKey(ctx context.Context, localPublicKey []byte) (Session, error)
+	GetSessionClearAmountFields(ctx context.Context, sessionID int64) ([]SessionClearAmountField, error)
 	GetSessionFeatureConfigs(ctx context.Context, sessionID int64) ([]SessionFeatureConfig, error)
 	GetSessionIDByAlias(ctx context.Context, alias []byte) (int64, error)
 	GetSessionKVStoreRecord(ctx context.Context, arg GetSessionKVStoreRecordParams) ([]byte, error)
 	GetSessionMacaroonCaveats(ctx context.Context, sessionID int64) ([]SessionMacaroonCaveat, error)
 	GetSessionMacaroonPermissions(ctx context.Context, sessionID int64) ([]SessionMacaroonPermission, error)
 	GetSessionPrivacyFlags(ctx context.Context, sessionID int64) ([]SessionPrivacyFlag, error)
+	GetSessionTemplate(ctx context.Context, name string) (SessionTemplate, error)
 	GetSessionsInGroup(ctx context.Context, groupID sql.NullInt64) ([]Session, error)
 	InsertAccount(ctx context.Context, arg InsertAccountParams) (int64, error)
+	InsertAccountAllowedDestination(ctx context.Context, arg InsertAccountAllowedDestinationParams) error
+	InsertAccountCreditRule(ctx context.Context, arg InsertAccountCreditRuleParams) error
+	InsertAccountEvent(ctx context.Context, arg InsertAccountEventParams) error
+	InsertAccountLedgerEntry(ctx context.Context, arg InsertAccountLedgerEntryParams) error
 	InsertKVStoreRecord(ctx context.Context, arg InsertKVStoreRecordParams) error
 	InsertSession(ctx context.Context, arg InsertSessionParams) (int64, error)
+	InsertSessionClearAmountField(ctx context.Context, arg InsertSessionClearAmountFieldParams) error
 	InsertSessionFeatureConfig(ctx context.Context, arg InsertSessionFeatureConfigParams) error
 	InsertSessionMacaroonCaveat(ctx context.Context, arg InsertSessionMacaroonCaveatParams) error
 	InsertSessionMacaroonPermission(ctx context.Context, arg InsertSessionMacaroonPermissionParams) error
 	InsertSessionPrivacyFlag(ctx context.Context, arg InsertSessionPrivacyFlagParams) error
+	ListAccountAllowedDestinations(ctx context.Context, accountID int64) ([][]byte, error)
+	ListAccountCreditRules(ctx context.Context) ([]ListAccountCreditRulesRow, error)
+	ListAccountEvents(ctx context.Context, accountAlias int64) ([]AccountEvent, error)
 	ListAccountInvoices(ctx context.Context, accountID int64) ([]AccountInvoice, error)
+	ListAccountLedgerEntries(ctx context.Context, accountAlias int64) ([]AccountLedger, error)
 	ListAccountPayments(ctx context.Context, accountID int64) ([]AccountPayment, error)
+	ListAccountsByBalanceAsc(ctx context.Context, arg ListAccountsByBalanceAscParams) ([]Account, error)
+	ListAccountsByBalanceDesc(ctx context.Context, arg ListAccountsByBalanceDescParams) ([]Account, error)
+	ListAccountsByCreatedAsc(ctx context.Context, arg ListAccountsByCreatedAscParams) ([]Account, error)
+	ListAccountsByCreatedDesc(ctx context.Context, arg ListAccountsByCreatedDescParams) ([]Account, error)
+	ListAccountsByExpirationAsc(ctx context.Context, arg ListAccountsByExpirationAscParams) ([]Account, error)
+	ListAccountsByExpirationDesc(ctx context.Context, arg ListAccountsByExpirationDescParams) ([]Account, error)
+	ListAccountsByLabelAsc(ctx context.Context, arg ListAccountsByLabelAscParams) ([]Account, error)
+	ListAccountsByLabelDesc(ctx context.Context, arg ListAccountsByLabelDescParams) ([]Account, error)
+	ListAccountsPage(ctx context.Context, arg ListAccountsPageParams) ([]Account, error)
 	ListAllAccounts(ctx context.Context) ([]Account, error)
+	ListSessionTemplates(ctx context.Context) ([]SessionTemplate, error)
 	ListSessions(ctx context.Context) ([]Session, error)
+	ListSessionsByAccountID(ctx context.Context, accountID sql.NullInt64) ([]Session, error)
 	ListSessionsByState(ctx context.Context, state int16) ([]Session, error)
 	ListSessionsByType(ctx context.Context, type_ int16) ([]Session, error)
+	OverwriteAccount(ctx context.Context, arg OverwriteAccountParams) (int64, error)
 	SetAccountIndex(ctx context.Context, arg SetAccountIndexParams) error
+	SetSessionExpiry(ctx context.Context, arg SetSessionExpiryParams) error
 	SetSessionGroupID(ctx context.Context, arg SetSessionGroupIDParams) error
 	SetSessionRemotePublicKey(ctx context.Context, arg SetSessionRemotePublicKeyParams) error
 	SetSessionRevokedAt(ctx context.Context, arg SetSessionRevokedAtParams) error
 	UpdateAccountBalance(ctx context.Context, arg UpdateAccountBalanceParams) (int64, error)
 	UpdateAccountExpiry(ctx context.Context, arg UpdateAccountExpiryParams) (int64, error)
+	UpdateAccountExpiryWarning(ctx context.Context, arg UpdateAccountExpiryWarningParams) (int64, error)
+	UpdateAccountLabel(ctx context.Context, arg UpdateAccountLabelParams) (int64, error)
 	UpdateAccountLastUpdate(ctx context.Context, arg UpdateAccountLastUpdateParams) (int64, error)
+	UpdateAccountMaxHTLC(ctx context.Context, arg UpdateAccountMaxHTLCParams) (int64, error)
+	UpdateAccountMaxInvoiceAmount(ctx context.Context, arg UpdateAccountMaxInvoiceAmountParams) (int64, error)
+	UpdateAccountMaxOpenInvoices(ctx context.Context, arg UpdateAccountMaxOpenInvoicesParams) (int64, error)
+	UpdateAccountMaxPayment(ctx context.Context, arg UpdateAccountMaxPaymentParams) (int64, error)
+	UpdateAccountOpenInvoices(ctx context.Context, arg UpdateAccountOpenInvoicesParams) (int64, error)
+	UpdateAccountSoftCap(ctx context.Context, arg UpdateAccountSoftCapParams) (int64, error)
+	UpdateAccountSpendRateLimit(ctx context.Context, arg UpdateAccountSpendRateLimitParams) (int64, error)
+	UpdateAccountTopUpPolicy(ctx context.Context, arg UpdateAccountTopUpPolicyParams) (int64, error)
 	UpdateFeatureKVStoreRecord(ctx context.Context, arg UpdateFeatureKVStoreRecordParams) error
 	UpdateGlobalKVStoreRecord(ctx context.Context, arg UpdateGlobalKVStoreRecordParams) error
 	UpdateSessionKVStoreRecord(ctx context.Context, arg UpdateSessionKVStoreRecordParams) error
 	UpdateSessionState(ctx context.Context, arg UpdateSessionStateParams) error
 	UpsertAccountPayment(ctx context.Context, arg UpsertAccountPaymentParams) error
+	UpsertSessionTemplate(ctx context.Context, arg UpsertSessionTemplateParams) error
 }
 
 var _ Querier = (*Queries)(nil)
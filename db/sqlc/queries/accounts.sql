@@ -1,6 +1,6 @@
 -- name: InsertAccount :one
-INSERT INTO accounts (type, initial_balance_msat, current_balance_msat, last_updated, label, alias, expiration)
-VALUES ($1, $2, $3, $4, $5, $6, $7)
+INSERT INTO accounts (type, initial_balance_msat, current_balance_msat, last_updated, label, alias, expiration, max_htlc_msat, soft_cap_msat, sandbox, keysend_budget_msat, node_id, amountless_max_msat, top_up_min_balance_msat, top_up_amount_msat, rate_limit_max_msat, rate_limit_window_seconds, expiry_warning_seconds, max_open_invoices, max_invoice_amount_msat, created_at, max_payment_msat)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)
     RETURNING id;
 
 -- name: UpdateAccountBalance :one
@@ -15,9 +15,75 @@ SET expiration = $1
 WHERE id = $2
 RETURNING id;
 
+-- name: UpdateAccountMaxHTLC :one
+UPDATE accounts
+SET max_htlc_msat = $1
+WHERE id = $2
+RETURNING id;
+
+-- name: UpdateAccountSoftCap :one
+UPDATE accounts
+SET soft_cap_msat = $1
+WHERE id = $2
+RETURNING id;
+
+-- name: UpdateAccountTopUpPolicy :one
+UPDATE accounts
+SET top_up_min_balance_msat = $1, top_up_amount_msat = $2
+WHERE id = $3
+RETURNING id;
+
+-- name: UpdateAccountSpendRateLimit :one
+UPDATE accounts
+SET rate_limit_max_msat = $1, rate_limit_window_seconds = $2
+WHERE id = $3
+RETURNING id;
+
+-- name: OverwriteAccount :one
+UPDATE accounts
+SET type = $1, initial_balance_msat = $2, expiration = $3, label = $4, max_htlc_msat = $5, soft_cap_msat = $6, sandbox = $7, keysend_budget_msat = $8, node_id = $9, amountless_max_msat = $10, top_up_min_balance_msat = $11, top_up_amount_msat = $12, rate_limit_max_msat = $13, rate_limit_window_seconds = $14, expiry_warning_seconds = $15, max_open_invoices = $16, max_invoice_amount_msat = $17, max_payment_msat = $18
+WHERE id = $19
+RETURNING id;
+
+-- name: UpdateAccountExpiryWarning :one
+UPDATE accounts
+SET expiry_warning_seconds = $1
+WHERE id = $2
+RETURNING id;
+
+-- name: UpdateAccountMaxOpenInvoices :one
+UPDATE accounts
+SET max_open_invoices = $1
+WHERE id = $2
+RETURNING id;
+
+-- name: UpdateAccountMaxInvoiceAmount :one
+UPDATE accounts
+SET max_invoice_amount_msat = $1
+WHERE id = $2
+RETURNING id;
+
+-- name: UpdateAccountMaxPayment :one
+UPDATE accounts
+SET max_payment_msat = $1
+WHERE id = $2
+RETURNING id;
+
+-- name: UpdateAccountOpenInvoices :one
+UPDATE accounts
+SET open_invoices = $1
+WHERE id = $2
+RETURNING id;
+
+-- name: UpdateAccountLabel :one
+UPDATE accounts
+SET label = $1
+WHERE id = $2
+RETURNING id;
+
 -- name: UpdateAccountLastUpdate :one
 UPDATE accounts
-SET last_updated = $1
+SET last_updated = $1, version = version + 1
 WHERE id = $2
 RETURNING id;
 
@@ -31,10 +97,10 @@ WHERE hash = $1
 AND account_id = $2;
 
 -- name: UpsertAccountPayment :exec
-INSERT INTO account_payments (account_id, hash, status, full_amount_msat)
-VALUES ($1, $2, $3, $4)
+INSERT INTO account_payments (account_id, hash, status, full_amount_msat, fee_msat, destination)
+VALUES ($1, $2, $3, $4, $5, $6)
 ON CONFLICT (account_id, hash)
-DO UPDATE SET status = $3, full_amount_msat = $4;
+DO UPDATE SET status = $3, full_amount_msat = $4, fee_msat = $5, destination = $6;
 
 -- name: GetAccountPayment :one
 SELECT * FROM account_payments
@@ -64,6 +130,91 @@ WHERE id = $1;
 SELECT *
 FROM accounts;
 
+-- name: ListAccountsPage :many
+SELECT *
+FROM accounts
+WHERE alias >= $1
+ORDER BY alias
+LIMIT $2;
+
+-- name: ListAccountsByBalanceAsc :many
+SELECT *
+FROM accounts
+WHERE NOT CAST(sqlc.arg('has_start') AS BOOLEAN)
+    OR current_balance_msat > sqlc.arg('start_balance')
+    OR (current_balance_msat = sqlc.arg('start_balance') AND alias >= sqlc.arg('start_alias'))
+ORDER BY current_balance_msat ASC, alias ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListAccountsByBalanceDesc :many
+SELECT *
+FROM accounts
+WHERE NOT CAST(sqlc.arg('has_start') AS BOOLEAN)
+    OR current_balance_msat < sqlc.arg('start_balance')
+    OR (current_balance_msat = sqlc.arg('start_balance') AND alias <= sqlc.arg('start_alias'))
+ORDER BY current_balance_msat DESC, alias DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListAccountsByLabelAsc :many
+SELECT *
+FROM accounts
+WHERE NOT CAST(sqlc.arg('has_start') AS BOOLEAN)
+    OR COALESCE(label, '') > sqlc.arg('start_label')
+    OR (COALESCE(label, '') = sqlc.arg('start_label') AND alias >= sqlc.arg('start_alias'))
+ORDER BY COALESCE(label, '') ASC, alias ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListAccountsByLabelDesc :many
+SELECT *
+FROM accounts
+WHERE NOT CAST(sqlc.arg('has_start') AS BOOLEAN)
+    OR COALESCE(label, '') < sqlc.arg('start_label')
+    OR (COALESCE(label, '') = sqlc.arg('start_label') AND alias <= sqlc.arg('start_alias'))
+ORDER BY COALESCE(label, '') DESC, alias DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListAccountsByCreatedAsc :many
+SELECT *
+FROM accounts
+WHERE NOT CAST(sqlc.arg('has_start') AS BOOLEAN)
+    OR created_at > sqlc.arg('start_created_at')
+    OR (created_at = sqlc.arg('start_created_at') AND alias >= sqlc.arg('start_alias'))
+ORDER BY created_at ASC, alias ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListAccountsByCreatedDesc :many
+SELECT *
+FROM accounts
+WHERE NOT CAST(sqlc.arg('has_start') AS BOOLEAN)
+    OR created_at < sqlc.arg('start_created_at')
+    OR (created_at = sqlc.arg('start_created_at') AND alias <= sqlc.arg('start_alias'))
+ORDER BY created_at DESC, alias DESC
+LIMIT sqlc.arg('limit');
+
+-- name: ListAccountsByExpirationAsc :many
+SELECT *
+FROM accounts
+WHERE NOT CAST(sqlc.arg('has_start') AS BOOLEAN)
+    OR (expiration = sqlc.arg('never')) > CAST(sqlc.arg('start_never') AS BOOLEAN)
+    OR ((expiration = sqlc.arg('never')) = CAST(sqlc.arg('start_never') AS BOOLEAN) AND (
+        expiration > sqlc.arg('start_expiration')
+        OR (expiration = sqlc.arg('start_expiration') AND alias >= sqlc.arg('start_alias'))
+    ))
+ORDER BY expiration = sqlc.arg('never') ASC, expiration ASC, alias ASC
+LIMIT sqlc.arg('limit');
+
+-- name: ListAccountsByExpirationDesc :many
+SELECT *
+FROM accounts
+WHERE NOT CAST(sqlc.arg('has_start') AS BOOLEAN)
+    OR (expiration = sqlc.arg('never')) < CAST(sqlc.arg('start_never') AS BOOLEAN)
+    OR ((expiration = sqlc.arg('never')) = CAST(sqlc.arg('start_never') AS BOOLEAN) AND (
+        expiration < sqlc.arg('start_expiration')
+        OR (expiration = sqlc.arg('start_expiration') AND alias <= sqlc.arg('start_alias'))
+    ))
+ORDER BY expiration = sqlc.arg('never') DESC, expiration DESC, alias DESC
+LIMIT sqlc.arg('limit');
+
 -- name: ListAccountPayments :many
 SELECT *
 FROM account_payments
@@ -74,6 +225,20 @@ SELECT *
 FROM account_invoices
 WHERE account_id = $1;
 
+-- name: InsertAccountAllowedDestination :exec
+INSERT INTO account_allowed_destinations (account_id, node_id)
+VALUES ($1, $2);
+
+-- name: DeleteAccountAllowedDestinations :exec
+DELETE FROM account_allowed_destinations
+WHERE account_id = $1;
+
+-- name: ListAccountAllowedDestinations :many
+SELECT node_id
+FROM account_allowed_destinations
+WHERE account_id = $1
+ORDER BY node_id;
+
 -- name: GetAccountInvoice :one
 SELECT *
 FROM  account_invoices
@@ -90,3 +255,42 @@ DO UPDATE SET value = $2;
 SELECT value
 FROM account_indices
 WHERE name = $1;
+
+-- name: InsertAccountEvent :exec
+INSERT INTO account_events (account_alias, type, actor, details, created_at)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListAccountEvents :many
+SELECT *
+FROM account_events
+WHERE account_alias = $1
+ORDER BY id;
+
+-- name: InsertAccountLedgerEntry :exec
+INSERT INTO account_ledger (account_alias, type, amount_msat, balance_msat, reference, created_at)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: ListAccountLedgerEntries :many
+SELECT *
+FROM account_ledger
+WHERE account_alias = $1
+ORDER BY id;
+
+-- name: InsertAccountCreditRule :exec
+INSERT INTO account_credit_rules (memo_prefix, account_id, created_at)
+VALUES ($1, $2, $3);
+
+-- name: GetAccountCreditRule :one
+SELECT *
+FROM account_credit_rules
+WHERE memo_prefix = $1;
+
+-- name: DeleteAccountCreditRule :exec
+DELETE FROM account_credit_rules
+WHERE memo_prefix = $1;
+
+-- name: ListAccountCreditRules :many
+SELECT r.memo_prefix, a.alias, r.created_at
+FROM account_credit_rules r
+JOIN accounts a ON r.account_id = a.id
+ORDER BY r.memo_prefix;
@@ -2,11 +2,13 @@
 INSERT INTO sessions (
     alias, label, state, type, expiry, created_at,
     server_address, dev_server, macaroon_root_key, pairing_secret,
-    local_private_key, local_public_key, remote_public_key, privacy, group_id, account_id
+    local_private_key, local_public_key, remote_public_key, privacy, group_id, account_id,
+    error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7,
     $8, $9, $10, $11, $12,
-    $13, $14, $15, $16
+    $13, $14, $15, $16, $17,
+    $18, $19, $20
 ) RETURNING id;
 
 -- name: SetSessionGroupID :exec
@@ -55,6 +57,11 @@ SELECT * FROM sessions
 WHERE type = $1
 ORDER BY created_at;
 
+-- name: ListSessionsByAccountID :many
+SELECT * FROM sessions
+WHERE account_id = $1
+ORDER BY created_at;
+
 -- name: ListSessionsByState :many
 SELECT * FROM sessions
 WHERE state = $1
@@ -70,6 +77,11 @@ UPDATE sessions
 SET state = $1
 WHERE id = $2;
 
+-- name: SetSessionExpiry :exec
+UPDATE sessions
+SET expiry = $1
+WHERE id = $2;
+
 -- name: SetSessionRemotePublicKey :exec
 UPDATE sessions
 SET remote_public_key = $1
@@ -117,4 +129,36 @@ INSERT INTO session_privacy_flags (
 
 -- name: GetSessionPrivacyFlags :many
 SELECT * FROM session_privacy_flags
-WHERE session_id = $1;
\ No newline at end of file
+WHERE session_id = $1;
+
+-- name: InsertSessionClearAmountField :exec
+INSERT INTO session_clear_amount_fields (
+    session_id, field_name
+) VALUES (
+    $1, $2
+);
+
+-- name: GetSessionClearAmountFields :many
+SELECT * FROM session_clear_amount_fields
+WHERE session_id = $1;
+
+-- name: UpsertSessionTemplate :exec
+INSERT INTO session_templates (
+    name, config, created_at
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (name)
+DO UPDATE SET config = $2, created_at = $3;
+
+-- name: GetSessionTemplate :one
+SELECT * FROM session_templates
+WHERE name = $1;
+
+-- name: ListSessionTemplates :many
+SELECT * FROM session_templates
+ORDER BY name;
+
+-- name: DeleteSessionTemplate :exec
+DELETE FROM session_templates
+WHERE name = $1;
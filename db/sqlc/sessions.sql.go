@@ -11,6 +11,16 @@ import (
 	"time"
 )
 
+const deleteSessionTemplate = `-- name: DeleteSessionTemplate :exec
+DELETE FROM session_templates
+WHERE name = $1
+`
+
+func (q *Queries) DeleteSessionTemplate(ctx context.Context, name string) error {
+	_, err := q.db.ExecContext(ctx, deleteSessionTemplate, name)
+	return err
+}
+
 const deleteSessionsWithState = `-- name: DeleteSessionsWithState :exec
 DELETE FROM sessions
 WHERE state = $1
@@ -62,7 +72,7 @@ func (q *Queries) GetSessionAliasesInGroup(ctx context.Context, groupID sql.Null
 }
 
 const getSessionByAlias = `-- name: GetSessionByAlias :one
-SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id FROM sessions
+SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id, error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size FROM sessions
 WHERE alias = $1
 `
 
@@ -88,12 +98,16 @@ func (q *Queries) GetSessionByAlias(ctx context.Context, alias []byte) (Session,
 		&i.Privacy,
 		&i.AccountID,
 		&i.GroupID,
+		&i.ErrorVerbosity,
+		&i.AmountObfuscation,
+		&i.AmountVariationPpm,
+		&i.AmountBucketSize,
 	)
 	return i, err
 }
 
 const getSessionByID = `-- name: GetSessionByID :one
-SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id FROM sessions
+SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id, error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size FROM sessions
 WHERE id = $1
 `
 
@@ -119,12 +133,16 @@ func (q *Queries) GetSessionByID(ctx context.Context, id int64) (Session, error)
 		&i.Privacy,
 		&i.AccountID,
 		&i.GroupID,
+		&i.ErrorVerbosity,
+		&i.AmountObfuscation,
+		&i.AmountVariationPpm,
+		&i.AmountBucketSize,
 	)
 	return i, err
 }
 
 const getSessionByLocalPublicKey = `-- name: GetSessionByLocalPublicKey :one
-SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id FROM sessions
+SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id, error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size FROM sessions
 WHERE local_public_key = $1
 `
 
@@ -150,10 +168,42 @@ func (q *Queries) GetSessionByLocalPublicKey(ctx context.Context, localPublicKey
 		&i.Privacy,
 		&i.AccountID,
 		&i.GroupID,
+		&i.ErrorVerbosity,
+		&i.AmountObfuscation,
+		&i.AmountVariationPpm,
+		&i.AmountBucketSize,
 	)
 	return i, err
 }
 
+const getSessionClearAmountFields = `-- name: GetSessionClearAmountFields :many
+SELECT session_id, field_name FROM session_clear_amount_fields
+WHERE session_id = $1
+`
+
+func (q *Queries) GetSessionClearAmountFields(ctx context.Context, sessionID int64) ([]SessionClearAmountField, error) {
+	rows, err := q.db.QueryContext(ctx, getSessionClearAmountFields, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SessionClearAmountField
+	for rows.Next() {
+		var i SessionClearAmountField
+		if err := rows.Scan(&i.SessionID, &i.FieldName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSessionFeatureConfigs = `-- name: GetSessionFeatureConfigs :many
 SELECT session_id, feature_name, config FROM session_feature_configs
 WHERE session_id = $1
@@ -289,8 +339,25 @@ func (q *Queries) GetSessionPrivacyFlags(ctx context.Context, sessionID int64) (
 	return items, nil
 }
 
+const getSessionTemplate = `-- name: GetSessionTemplate :one
+SELECT id, name, config, created_at FROM session_templates
+WHERE name = $1
+`
+
+func (q *Queries) GetSessionTemplate(ctx context.Context, name string) (SessionTemplate, error) {
+	row := q.db.QueryRowContext(ctx, getSessionTemplate, name)
+	var i SessionTemplate
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Config,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const getSessionsInGroup = `-- name: GetSessionsInGroup :many
-SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id FROM sessions
+SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id, error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size FROM sessions
 WHERE group_id = $1
 `
 
@@ -322,6 +389,10 @@ func (q *Queries) GetSessionsInGroup(ctx context.Context, groupID sql.NullInt64)
 			&i.Privacy,
 			&i.AccountID,
 			&i.GroupID,
+			&i.ErrorVerbosity,
+			&i.AmountObfuscation,
+			&i.AmountVariationPpm,
+			&i.AmountBucketSize,
 		); err != nil {
 			return nil, err
 		}
@@ -340,31 +411,37 @@ const insertSession = `-- name: InsertSession :one
 INSERT INTO sessions (
     alias, label, state, type, expiry, created_at,
     server_address, dev_server, macaroon_root_key, pairing_secret,
-    local_private_key, local_public_key, remote_public_key, privacy, group_id, account_id
+    local_private_key, local_public_key, remote_public_key, privacy, group_id, account_id,
+    error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7,
     $8, $9, $10, $11, $12,
-    $13, $14, $15, $16
+    $13, $14, $15, $16, $17,
+    $18, $19, $20
 ) RETURNING id
 `
 
 type InsertSessionParams struct {
-	Alias           []byte
-	Label           string
-	State           int16
-	Type            int16
-	Expiry          time.Time
-	CreatedAt       time.Time
-	ServerAddress   string
-	DevServer       bool
-	MacaroonRootKey int64
-	PairingSecret   []byte
-	LocalPrivateKey []byte
-	LocalPublicKey  []byte
-	RemotePublicKey []byte
-	Privacy         bool
-	GroupID         sql.NullInt64
-	AccountID       sql.NullInt64
+	Alias              []byte
+	Label              string
+	State              int16
+	Type               int16
+	Expiry             time.Time
+	CreatedAt          time.Time
+	ServerAddress      string
+	DevServer          bool
+	MacaroonRootKey    int64
+	PairingSecret      []byte
+	LocalPrivateKey    []byte
+	LocalPublicKey     []byte
+	RemotePublicKey    []byte
+	Privacy            bool
+	GroupID            sql.NullInt64
+	AccountID          sql.NullInt64
+	ErrorVerbosity     int16
+	AmountObfuscation  int16
+	AmountVariationPpm int64
+	AmountBucketSize   int64
 }
 
 func (q *Queries) InsertSession(ctx context.Context, arg InsertSessionParams) (int64, error) {
@@ -385,12 +462,34 @@ func (q *Queries) InsertSession(ctx context.Context, arg InsertSessionParams) (i
 		arg.Privacy,
 		arg.GroupID,
 		arg.AccountID,
+		arg.ErrorVerbosity,
+		arg.AmountObfuscation,
+		arg.AmountVariationPpm,
+		arg.AmountBucketSize,
 	)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
 }
 
+const insertSessionClearAmountField = `-- name: InsertSessionClearAmountField :exec
+INSERT INTO session_clear_amount_fields (
+    session_id, field_name
+) VALUES (
+    $1, $2
+)
+`
+
+type InsertSessionClearAmountFieldParams struct {
+	SessionID int64
+	FieldName string
+}
+
+func (q *Queries) InsertSessionClearAmountField(ctx context.Context, arg InsertSessionClearAmountFieldParams) error {
+	_, err := q.db.ExecContext(ctx, insertSessionClearAmountField, arg.SessionID, arg.FieldName)
+	return err
+}
+
 const insertSessionFeatureConfig = `-- name: InsertSessionFeatureConfig :exec
 INSERT INTO session_feature_configs (
     session_id, feature_name, config
@@ -472,8 +571,41 @@ func (q *Queries) InsertSessionPrivacyFlag(ctx context.Context, arg InsertSessio
 	return err
 }
 
+const listSessionTemplates = `-- name: ListSessionTemplates :many
+SELECT id, name, config, created_at FROM session_templates
+ORDER BY name
+`
+
+func (q *Queries) ListSessionTemplates(ctx context.Context) ([]SessionTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionTemplates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SessionTemplate
+	for rows.Next() {
+		var i SessionTemplate
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Config,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSessions = `-- name: ListSessions :many
-SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id FROM sessions
+SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id, error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size FROM sessions
 ORDER BY created_at
 `
 
@@ -505,6 +637,62 @@ func (q *Queries) ListSessions(ctx context.Context) ([]Session, error) {
 			&i.Privacy,
 			&i.AccountID,
 			&i.GroupID,
+			&i.ErrorVerbosity,
+			&i.AmountObfuscation,
+			&i.AmountVariationPpm,
+			&i.AmountBucketSize,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSessionsByAccountID = `-- name: ListSessionsByAccountID :many
+SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id, error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size FROM sessions
+WHERE account_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) ListSessionsByAccountID(ctx context.Context, accountID sql.NullInt64) ([]Session, error) {
+	rows, err := q.db.QueryContext(ctx, listSessionsByAccountID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Session
+	for rows.Next() {
+		var i Session
+		if err := rows.Scan(
+			&i.ID,
+			&i.Alias,
+			&i.Label,
+			&i.State,
+			&i.Type,
+			&i.Expiry,
+			&i.CreatedAt,
+			&i.RevokedAt,
+			&i.ServerAddress,
+			&i.DevServer,
+			&i.MacaroonRootKey,
+			&i.PairingSecret,
+			&i.LocalPrivateKey,
+			&i.LocalPublicKey,
+			&i.RemotePublicKey,
+			&i.Privacy,
+			&i.AccountID,
+			&i.GroupID,
+			&i.ErrorVerbosity,
+			&i.AmountObfuscation,
+			&i.AmountVariationPpm,
+			&i.AmountBucketSize,
 		); err != nil {
 			return nil, err
 		}
@@ -520,7 +708,7 @@ func (q *Queries) ListSessions(ctx context.Context) ([]Session, error) {
 }
 
 const listSessionsByState = `-- name: ListSessionsByState :many
-SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id FROM sessions
+SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id, error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size FROM sessions
 WHERE state = $1
 ORDER BY created_at
 `
@@ -553,6 +741,10 @@ func (q *Queries) ListSessionsByState(ctx context.Context, state int16) ([]Sessi
 			&i.Privacy,
 			&i.AccountID,
 			&i.GroupID,
+			&i.ErrorVerbosity,
+			&i.AmountObfuscation,
+			&i.AmountVariationPpm,
+			&i.AmountBucketSize,
 		); err != nil {
 			return nil, err
 		}
@@ -568,7 +760,7 @@ func (q *Queries) ListSessionsByState(ctx context.Context, state int16) ([]Sessi
 }
 
 const listSessionsByType = `-- name: ListSessionsByType :many
-SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id FROM sessions
+SELECT id, alias, label, state, type, expiry, created_at, revoked_at, server_address, dev_server, macaroon_root_key, pairing_secret, local_private_key, local_public_key, remote_public_key, privacy, account_id, group_id, error_verbosity, amount_obfuscation, amount_variation_ppm, amount_bucket_size FROM sessions
 WHERE type = $1
 ORDER BY created_at
 `
@@ -601,6 +793,10 @@ func (q *Queries) ListSessionsByType(ctx context.Context, type_ int16) ([]Sessio
 			&i.Privacy,
 			&i.AccountID,
 			&i.GroupID,
+			&i.ErrorVerbosity,
+			&i.AmountObfuscation,
+			&i.AmountVariationPpm,
+			&i.AmountBucketSize,
 		); err != nil {
 			return nil, err
 		}
@@ -615,6 +811,22 @@ func (q *Queries) ListSessionsByType(ctx context.Context, type_ int16) ([]Sessio
 	return items, nil
 }
 
+const setSessionExpiry = `-- name: SetSessionExpiry :exec
+UPDATE sessions
+SET expiry = $1
+WHERE id = $2
+`
+
+type SetSessionExpiryParams struct {
+	Expiry time.Time
+	ID     int64
+}
+
+func (q *Queries) SetSessionExpiry(ctx context.Context, arg SetSessionExpiryParams) error {
+	_, err := q.db.ExecContext(ctx, setSessionExpiry, arg.Expiry, arg.ID)
+	return err
+}
+
 const setSessionGroupID = `-- name: SetSessionGroupID :exec
 UPDATE sessions
 SET group_id = $1
@@ -678,3 +890,24 @@ func (q *Queries) UpdateSessionState(ctx context.Context, arg UpdateSessionState
 	_, err := q.db.ExecContext(ctx, updateSessionState, arg.State, arg.ID)
 	return err
 }
+
+const upsertSessionTemplate = `-- name: UpsertSessionTemplate :exec
+INSERT INTO session_templates (
+    name, config, created_at
+) VALUES (
+    $1, $2, $3
+)
+ON CONFLICT (name)
+DO UPDATE SET config = $2, created_at = $3
+`
+
+type UpsertSessionTemplateParams struct {
+	Name      string
+	Config    []byte
+	CreatedAt time.Time
+}
+
+func (q *Queries) UpsertSessionTemplate(ctx context.Context, arg UpsertSessionTemplateParams) error {
+	_, err := q.db.ExecContext(ctx, upsertSessionTemplate, arg.Name, arg.Config, arg.CreatedAt)
+	return err
+}
@@ -0,0 +1,156 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+)
+
+// sessionEventStore is a session.Store that publishes an event to the events
+// broker of litd whenever the state of a session changes.
+type sessionEventStore struct {
+	session.Store
+
+	publish func(*litrpc.LitEvent)
+}
+
+// ShiftState updates the state of the session with the given ID to the "dest"
+// state and publishes the change.
+//
+// NOTE: this is part of the session.Store interface.
+func (s *sessionEventStore) ShiftState(ctx context.Context, id session.ID,
+	dest session.State) error {
+
+	if err := s.Store.ShiftState(ctx, id, dest); err != nil {
+		return err
+	}
+
+	// Reserved sessions aren't shown to the user yet, so their creation
+	// is only published once they are moved to the created state.
+	if dest == session.StateReserved {
+		return nil
+	}
+
+	sess, err := s.Store.GetSession(ctx, id)
+	if err != nil {
+		log.Errorf("Unable to fetch session %x for event: %v", id[:],
+			err)
+
+		return nil
+	}
+
+	event, err := marshalSessionChangeEvent(sess)
+	if err != nil {
+		log.Errorf("Unable to marshal event of session %x: %v", id[:],
+			err)
+
+		return nil
+	}
+
+	s.publish(event)
+
+	return nil
+}
+
+// marshalSessionChangeEvent converts a session into the event of its current
+// state.
+func marshalSessionChangeEvent(sess *session.Session) (*litrpc.LitEvent,
+	error) {
+
+	state, err := marshalRPCState(sess.State)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := marshalRPCType(sess.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	localPubKey := sess.LocalPublicKey.SerializeCompressed()
+
+	return &litrpc.LitEvent{
+		Category: litrpc.EventCategory_EVENT_CATEGORY_SESSION,
+		Event: &litrpc.LitEvent_Session{
+			Session: &litrpc.SessionChangeEvent{
+				SessionId:      sess.ID[:],
+				LocalPublicKey: localPubKey,
+				Label:          sess.Label,
+				SessionType:    typ,
+				State:          state,
+			},
+		},
+	}, nil
+}
+
+// publishFirewallDenial publishes the audit entry of a request that the
+// firewall denied.
+func (g *LightningTerminal) publishFirewallDenial(
+	entry *firewalldb.AuditEntry) {
+
+	decision, err := marshalAuditDecision(entry.Decision)
+	if err != nil {
+		log.Errorf("Unable to marshal firewall denial event: %v", err)
+
+		return
+	}
+
+	g.events.Publish(&litrpc.LitEvent{
+		Timestamp: entry.Timestamp.Unix(),
+		Category:  litrpc.EventCategory_EVENT_CATEGORY_FIREWALL,
+		Event: &litrpc.LitEvent_FirewallDenial{
+			FirewallDenial: &litrpc.AuditLogEntry{
+				TimestampNs: uint64(entry.Timestamp.UnixNano()),
+				SessionId:   entry.SessionID[:],
+				Uri:         entry.URI,
+				Decision:    decision,
+				RuleNames:   entry.RuleNames,
+				Reason:      entry.Reason,
+			},
+		},
+	})
+}
+
+// publishSubServerEvents publishes the changes of the status of the
+// sub-servers until the context is canceled.
+func (g *LightningTerminal) publishSubServerEvents(ctx context.Context) {
+	err := g.statusMgr.WatchSubServerStatus(
+		ctx, func(event *litrpc.SubServerStatusEvent) error {
+			g.events.Publish(&litrpc.LitEvent{
+				Category: litrpc.
+					EventCategory_EVENT_CATEGORY_SUB_SERVER,
+				Event: &litrpc.LitEvent_SubServer{
+					SubServer: event,
+				},
+			})
+
+			return nil
+		},
+	)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Errorf("Unable to watch sub-server status for events: %v",
+			err)
+	}
+}
+
+// publishAccountEvents publishes the changes of all accounts until the context
+// is canceled.
+func (g *LightningTerminal) publishAccountEvents(ctx context.Context) {
+	err := g.accountRpcServer.WatchAccountChanges(
+		ctx, func(event *litrpc.AccountChangeEvent) {
+			g.events.Publish(&litrpc.LitEvent{
+				Category: litrpc.
+					EventCategory_EVENT_CATEGORY_ACCOUNT,
+				Event: &litrpc.LitEvent_Account{
+					Account: event,
+				},
+			})
+		},
+	)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		log.Errorf("Unable to watch accounts for events: %v", err)
+	}
+}
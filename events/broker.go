@@ -0,0 +1,191 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+)
+
+const (
+	// subscriberBufferSize is the number of events that are buffered for
+	// each subscriber on top of the events that are replayed when it
+	// resumes. A subscriber that doesn't keep up is dropped.
+	subscriberBufferSize = 100
+
+	// backlogSize is the number of recent events that are kept in memory
+	// so that a subscriber can resume after it was disconnected.
+	backlogSize = 1000
+)
+
+var (
+	// ErrEventsMissed is returned when a subscriber resumes after an
+	// event that was published before litd was restarted or that is no
+	// longer kept in the backlog.
+	ErrEventsMissed = errors.New("events were missed")
+
+	// ErrSubscriberLagged is returned when the stream of a subscriber was
+	// ended because it didn't keep up with the events.
+	ErrSubscriberLagged = errors.New("event subscriber didn't keep up, " +
+		"resume with the last received sequence number")
+)
+
+// subscriber is a single subscriber of the broker.
+type subscriber struct {
+	// categories is the set of categories the subscriber is interested
+	// in. If it is empty, the subscriber receives all events.
+	categories map[litrpc.EventCategory]bool
+
+	events chan *litrpc.LitEvent
+}
+
+// wants returns true if the subscriber is interested in the given event.
+func (s *subscriber) wants(event *litrpc.LitEvent) bool {
+	return len(s.categories) == 0 || s.categories[event.Category]
+}
+
+// Broker numbers the events of all litd components that are published to it
+// and fans them out to the subscribers of their category. It keeps a backlog
+// of recent events for subscribers that resume. The numbers start over when
+// litd restarts, so every broker has a random stream ID that identifies the
+// numbers it issued.
+type Broker struct {
+	mu          sync.Mutex
+	streamID    string
+	seq         uint64
+	backlog     []*litrpc.LitEvent
+	nextID      uint64
+	subscribers map[uint64]*subscriber
+}
+
+// NewBroker creates a new Broker with a random stream ID and without any
+// subscribers.
+func NewBroker() *Broker {
+	var streamID [8]byte
+	_, _ = rand.Read(streamID[:])
+
+	return &Broker{
+		streamID:    hex.EncodeToString(streamID[:]),
+		subscribers: make(map[uint64]*subscriber),
+	}
+}
+
+// StreamID returns the random ID that identifies the sequence numbers issued
+// by the broker.
+func (b *Broker) StreamID() string {
+	return b.streamID
+}
+
+// Publish numbers the given event, adds it to the backlog and sends it to all
+// subscribers of its category. The event must not be modified afterwards. It
+// never blocks, so it is safe to call while other locks are held. Subscribers
+// that don't keep up are dropped instead of skipping the event, so that they
+// can resume without missing any.
+func (b *Broker) Publish(event *litrpc.LitEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event.Sequence = b.seq
+	event.StreamId = b.streamID
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().Unix()
+	}
+
+	b.backlog = append(b.backlog, event)
+	if len(b.backlog) > backlogSize {
+		b.backlog = b.backlog[1:]
+	}
+
+	for id, sub := range b.subscribers {
+		if !sub.wants(event) {
+			continue
+		}
+
+		select {
+		case sub.events <- event:
+		default:
+			log.Warnf("Dropping slow event subscriber %d", id)
+
+			close(sub.events)
+			delete(b.subscribers, id)
+		}
+	}
+}
+
+// Subscribe registers a new subscriber for the events of the given categories,
+// or of all categories if none are given. If a stream ID is given, all events
+// of the categories that were published after the event with the given
+// sequence number are delivered first. ErrEventsMissed is returned if those
+// events are no longer all known. The returned channel is closed if the
+// subscriber doesn't keep up. The returned function must be called to remove
+// the subscription once the caller is no longer interested in events.
+func (b *Broker) Subscribe(categories []litrpc.EventCategory,
+	streamID string, afterSeq uint64) (<-chan *litrpc.LitEvent, func(),
+	error) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &subscriber{
+		categories: make(map[litrpc.EventCategory]bool),
+	}
+	for _, category := range categories {
+		sub.categories[category] = true
+	}
+
+	var replay []*litrpc.LitEvent
+	if streamID != "" {
+		if streamID != b.streamID {
+			return nil, nil, fmt.Errorf("%w: stream %s was "+
+				"started before litd was restarted",
+				ErrEventsMissed, streamID)
+		}
+
+		// The backlog holds the events up to the current sequence
+		// number without gaps, so we can resume if the first event
+		// after the given one is still in there.
+		oldest := b.seq - uint64(len(b.backlog)) + 1
+		switch {
+		case afterSeq > b.seq:
+			return nil, nil, fmt.Errorf("unknown sequence number "+
+				"%d", afterSeq)
+
+		case afterSeq+1 < oldest:
+			return nil, nil, fmt.Errorf("%w: %d events were "+
+				"published since", ErrEventsMissed,
+				b.seq-afterSeq)
+		}
+
+		missed := b.backlog[len(b.backlog)-int(b.seq-afterSeq):]
+		for _, event := range missed {
+			if sub.wants(event) {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	sub.events = make(
+		chan *litrpc.LitEvent, len(replay)+subscriberBufferSize,
+	)
+	for _, event := range replay {
+		sub.events <- event
+	}
+
+	id := b.nextID
+	b.nextID++
+	b.subscribers[id] = sub
+
+	cancel := func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subscribers, id)
+	}
+
+	return sub.events, cancel, nil
+}
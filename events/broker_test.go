@@ -0,0 +1,113 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// newEvent returns a new event of the given category.
+func newEvent(category litrpc.EventCategory) *litrpc.LitEvent {
+	return &litrpc.LitEvent{Category: category}
+}
+
+// TestBroker tests that events are numbered, filtered by category and replayed
+// to subscribers that resume.
+func TestBroker(t *testing.T) {
+	t.Parallel()
+
+	var (
+		account = litrpc.EventCategory_EVENT_CATEGORY_ACCOUNT
+		session = litrpc.EventCategory_EVENT_CATEGORY_SESSION
+	)
+
+	b := NewBroker()
+
+	all, cancelAll, err := b.Subscribe(nil, "", 0)
+	require.NoError(t, err)
+	defer cancelAll()
+
+	sessions, cancelSessions, err := b.Subscribe(
+		[]litrpc.EventCategory{session}, "", 0,
+	)
+	require.NoError(t, err)
+	defer cancelSessions()
+
+	b.Publish(newEvent(account))
+	b.Publish(newEvent(session))
+	b.Publish(newEvent(account))
+
+	// The sequence numbers increase across all categories.
+	for i := uint64(1); i <= 3; i++ {
+		event := <-all
+		require.Equal(t, i, event.Sequence)
+		require.Equal(t, b.StreamID(), event.StreamId)
+		require.NotZero(t, event.Timestamp)
+	}
+
+	event := <-sessions
+	require.Equal(t, session, event.Category)
+	require.EqualValues(t, 2, event.Sequence)
+	require.Empty(t, sessions)
+
+	// Resuming replays the events that were published after the given
+	// one.
+	resumed, cancelResumed, err := b.Subscribe(
+		[]litrpc.EventCategory{account}, b.StreamID(), 1,
+	)
+	require.NoError(t, err)
+	defer cancelResumed()
+
+	event = <-resumed
+	require.EqualValues(t, 3, event.Sequence)
+	require.Empty(t, resumed)
+
+	b.Publish(newEvent(account))
+	event = <-resumed
+	require.EqualValues(t, 4, event.Sequence)
+
+	// Resuming a stream of a previous litd run or after an unknown event
+	// fails.
+	_, _, err = b.Subscribe(nil, "unknown", 1)
+	require.ErrorIs(t, err, ErrEventsMissed)
+
+	_, _, err = b.Subscribe(nil, b.StreamID(), 5)
+	require.Error(t, err)
+
+	// Once events are no longer in the backlog, resuming before them
+	// fails.
+	for i := 0; i < backlogSize; i++ {
+		b.Publish(newEvent(session))
+	}
+
+	_, _, err = b.Subscribe(nil, b.StreamID(), 3)
+	require.ErrorIs(t, err, ErrEventsMissed)
+
+	_, cancel, err := b.Subscribe(nil, b.StreamID(), 4)
+	require.NoError(t, err)
+	cancel()
+}
+
+// TestBrokerSlowSubscriber tests that a subscriber that doesn't keep up is
+// dropped.
+func TestBrokerSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	b := NewBroker()
+
+	events, cancel, err := b.Subscribe(nil, "", 0)
+	require.NoError(t, err)
+	defer cancel()
+
+	for i := 0; i <= subscriberBufferSize; i++ {
+		b.Publish(newEvent(litrpc.EventCategory_EVENT_CATEGORY_FIREWALL))
+	}
+
+	for i := 0; i < subscriberBufferSize; i++ {
+		<-events
+	}
+
+	_, ok := <-events
+	require.False(t, ok)
+}
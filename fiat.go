@@ -0,0 +1,44 @@
+package terminal
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// fiatCurrencyPlaceholder is replaced with the currency code in the
+	// URL of the fiat price source.
+	fiatCurrencyPlaceholder = "{currency}"
+)
+
+// FiatConfig holds the price source that litcli uses to show the fiat
+// equivalent of balances. LiT itself never queries the price source, it only
+// passes the configuration on to litcli.
+//
+//nolint:lll
+type FiatConfig struct {
+	PriceSource string `long:"pricesource" description:"The HTTP(S) URL that litcli fetches the price of one bitcoin from to show the fiat equivalent of account balances, for example with 'litcli accounts list --fiat=USD'. The placeholder {currency} in the URL is replaced with the currency code. The response must be a JSON document that contains the price as a number or numeric string under a key matching the currency code, such as {\"bitcoin\":{\"usd\":65000}}. litd itself never queries the price source."`
+	Currency    string `long:"currency" description:"The currency code that litcli shows the fiat equivalent of balances in if the currency isn't given explicitly, for example USD."`
+}
+
+// Validate checks that the price source is a valid HTTP(S) URL.
+func (c *FiatConfig) Validate() error {
+	if c.PriceSource == "" {
+		return nil
+	}
+
+	u, err := url.Parse(
+		strings.ReplaceAll(c.PriceSource, fiatCurrencyPlaceholder, "x"),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid price source URL: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("price source URL must use http or https, "+
+			"got %s", c.PriceSource)
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package firewall
+
+import (
+	"sync"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+)
+
+const (
+	// DefaultAuditLogBufferSize is the default number of audit entries
+	// that can be queued for writing before new entries are dropped.
+	DefaultAuditLogBufferSize = 1000
+
+	// maxAuditBatchSize is the maximum number of audit entries that are
+	// written in a single database transaction.
+	maxAuditBatchSize = 100
+)
+
+// AuditLogDB is the database the AuditLogger writes its entries to.
+type AuditLogDB interface {
+	// AddAuditEntries appends the given entries to the audit log.
+	AddAuditEntries(entries []*firewalldb.AuditEntry) error
+}
+
+// AuditLogger writes the decisions of the firewall to the audit log. Entries
+// are queued in a buffer and written by a separate goroutine so that logging
+// a decision never blocks the request path.
+type AuditLogger struct {
+	db AuditLogDB
+
+	entries chan *firewalldb.AuditEntry
+
+	startOnce sync.Once
+	stopOnce  sync.Once
+	quit      chan struct{}
+	wg        sync.WaitGroup
+}
+
+// NewAuditLogger creates a new AuditLogger that writes to the given database
+// and can queue up to bufferSize entries.
+func NewAuditLogger(db AuditLogDB, bufferSize int) *AuditLogger {
+	if bufferSize <= 0 {
+		bufferSize = DefaultAuditLogBufferSize
+	}
+
+	return &AuditLogger{
+		db:      db,
+		entries: make(chan *firewalldb.AuditEntry, bufferSize),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start starts the goroutine that writes the queued entries.
+func (a *AuditLogger) Start() {
+	a.startOnce.Do(func() {
+		a.wg.Add(1)
+		go a.writer()
+	})
+}
+
+// Stop writes any entries that are still queued and stops the logger.
+func (a *AuditLogger) Stop() {
+	a.stopOnce.Do(func() {
+		close(a.quit)
+		a.wg.Wait()
+	})
+}
+
+// Log queues the given entry for writing. If the buffer is full, the entry is
+// dropped and a warning is logged instead of waiting for room in the buffer.
+func (a *AuditLogger) Log(entry *firewalldb.AuditEntry) {
+	select {
+	case a.entries <- entry:
+	default:
+		log.Warnf("Audit log buffer is full, dropping %s decision "+
+			"for %s of session %x", entry.Decision, entry.URI,
+			entry.SessionID[:])
+	}
+}
+
+// writer writes the queued entries in batches until the logger is stopped.
+//
+// NOTE: This MUST be run as a goroutine.
+func (a *AuditLogger) writer() {
+	defer a.wg.Done()
+
+	for {
+		select {
+		case entry := <-a.entries:
+			a.write(a.collectBatch(entry))
+
+		case <-a.quit:
+			// Write everything that is still queued before we
+			// exit.
+			for {
+				select {
+				case entry := <-a.entries:
+					a.write(a.collectBatch(entry))
+
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// collectBatch returns a batch starting with the given entry that contains as
+// many of the queued entries as are available, up to the maximum batch size.
+func (a *AuditLogger) collectBatch(
+	first *firewalldb.AuditEntry) []*firewalldb.AuditEntry {
+
+	batch := []*firewalldb.AuditEntry{first}
+	for len(batch) < maxAuditBatchSize {
+		select {
+		case entry := <-a.entries:
+			batch = append(batch, entry)
+
+		default:
+			return batch
+		}
+	}
+
+	return batch
+}
+
+// write writes the given batch of entries to the database.
+func (a *AuditLogger) write(batch []*firewalldb.AuditEntry) {
+	if err := a.db.AddAuditEntries(batch); err != nil {
+		log.Errorf("Unable to write %d audit log entries: %v",
+			len(batch), err)
+	}
+}
@@ -0,0 +1,106 @@
+package firewall
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/stretchr/testify/require"
+)
+
+// mockAuditLogDB is an AuditLogDB that keeps its entries in memory. Writes
+// block until the unblock channel is closed.
+type mockAuditLogDB struct {
+	mu      sync.Mutex
+	entries []*firewalldb.AuditEntry
+
+	writing chan struct{}
+	unblock chan struct{}
+}
+
+// AddAuditEntries appends the given entries once writes are unblocked.
+func (m *mockAuditLogDB) AddAuditEntries(
+	entries []*firewalldb.AuditEntry) error {
+
+	select {
+	case m.writing <- struct{}{}:
+	default:
+	}
+
+	<-m.unblock
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.entries = append(m.entries, entries...)
+
+	return nil
+}
+
+// numEntries returns the number of entries that were written.
+func (m *mockAuditLogDB) numEntries() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.entries)
+}
+
+// TestAuditLogger tests that the AuditLogger writes all queued entries in
+// order, that logging doesn't block if the database is slow and that entries
+// are dropped instead once the buffer is full.
+func TestAuditLogger(t *testing.T) {
+	db := &mockAuditLogDB{
+		writing: make(chan struct{}, 1),
+		unblock: make(chan struct{}),
+	}
+
+	const bufferSize = 5
+	logger := NewAuditLogger(db, bufferSize)
+	logger.Start()
+
+	newEntry := func(i int) *firewalldb.AuditEntry {
+		return &firewalldb.AuditEntry{
+			Timestamp: time.Unix(int64(i), 0),
+			URI:       fmt.Sprintf("/uri/%d", i),
+			Decision:  firewalldb.AuditDecisionAllowed,
+		}
+	}
+
+	// The first entry is picked up by the writer, which then blocks on
+	// the database.
+	logger.Log(newEntry(0))
+	select {
+	case <-db.writing:
+	case <-time.After(time.Second):
+		t.Fatalf("entry not written")
+	}
+
+	// While the writer is blocked, logging must not block. Only as many
+	// entries as fit into the buffer are kept, all others are dropped.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for i := 1; i <= bufferSize+3; i++ {
+			logger.Log(newEntry(i))
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("logging blocked")
+	}
+
+	// Once the database is unblocked, all queued entries are written
+	// before the logger stops.
+	close(db.unblock)
+	logger.Stop()
+
+	require.Equal(t, bufferSize+1, db.numEntries())
+	for i, entry := range db.entries {
+		require.Equal(t, fmt.Sprintf("/uri/%d", i), entry.URI)
+	}
+}
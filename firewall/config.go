@@ -5,6 +5,8 @@ package firewall
 //nolint:lll
 type Config struct {
 	RequestLogger *RequestLoggerConfig `group:"request-logger" namespace:"request-logger" description:"request logger settings"`
+
+	AllowPrivacyExport bool `long:"allow-privacy-export" description:"Allow the real-pseudo pairs of the privacy mapper to be exported with the ExportPrivacyMapping RPC. The export reveals the real values behind the obfuscated data that was shared with autopilot, so it should only be enabled for migrations."`
 }
 
 // RequestLoggerConfig holds all the config options for the request logger.
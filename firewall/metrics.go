@@ -0,0 +1,36 @@
+package firewall
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// decisions counts the requests of sessions that were allowed or
+	// denied by the firewall, labeled by the decision and the ID of the
+	// session. The metric is registered with the default prometheus
+	// registry, which litd exposes if a Prometheus listen address is
+	// configured.
+	decisions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "litd",
+		Subsystem: "firewall",
+		Name:      "decisions_total",
+		Help: "Number of requests of sessions that were allowed or " +
+			"denied by the firewall.",
+	}, []string{"decision", "session_id"})
+
+	metricsOnce sync.Once
+)
+
+// registerMetrics registers the metrics of the firewall package with the
+// default prometheus registry. It is safe to call multiple times.
+func registerMetrics() {
+	metricsOnce.Do(func() {
+		err := prometheus.Register(decisions)
+		if err != nil {
+			log.Warnf("Unable to register firewall metrics: %v",
+				err)
+		}
+	})
+}
@@ -24,12 +24,11 @@ const (
 	// privacyMapperName is the name of the RequestLogger interceptor.
 	privacyMapperName = "lit-privacy-mapper"
 
-	// amountVariation and timeVariation are used to set the randomization
-	// of amounts and timestamps that are sent to the autopilot. Changing
-	// these values may lead to unintended consequences in the behavior of
-	// the autpilot.
-	amountVariation = 0.05
-	timeVariation   = time.Duration(10) * time.Minute
+	// timeVariation is used to set the randomization of timestamps that
+	// are sent to the autopilot. Changing this value may lead to
+	// unintended consequences in the behavior of the autpilot. The
+	// randomization of amounts is configured per session.
+	timeVariation = time.Duration(10) * time.Minute
 
 	// minTimeVariation and maxTimeVariation are the acceptable bounds
 	// between which timeVariation can be set.
@@ -111,7 +110,8 @@ func (p *PrivacyMapper) Intercept(ctx context.Context,
 		return nil, fmt.Errorf("could not extract ID from macaroon")
 	}
 
-	log.Tracef("PrivacyMapper: Intercepting %v", ri)
+	ctx = mid.AddLogID(ctx, "session_id", sessionID[:])
+	log.TraceS(ctx, "PrivacyMapper: Intercepting", "request", ri)
 
 	switch r := req.InterceptType.(type) {
 	case *lnrpc.RPCMiddlewareRequest_StreamAuth:
@@ -199,7 +199,9 @@ func (p *PrivacyMapper) checkAndReplaceIncomingRequest(ctx context.Context,
 
 	// If we don't have a handler for the URI, we don't allow the request
 	// to go through.
-	checker, ok := p.checkers(db, session.PrivacyFlags)[uri]
+	checker, ok := p.checkers(
+		db, session.PrivacyFlags, session.AmountObfuscation,
+	)[uri]
 	if !ok {
 		return nil, ErrNotSupportedByPrivacyMapper
 	}
@@ -229,7 +231,9 @@ func (p *PrivacyMapper) replaceOutgoingResponse(ctx context.Context, uri string,
 
 	// If we don't have a handler for the URI, we don't allow the response
 	// to go to avoid accidental leaks.
-	checker, ok := p.checkers(db, session.PrivacyFlags)[uri]
+	checker, ok := p.checkers(
+		db, session.PrivacyFlags, session.AmountObfuscation,
+	)[uri]
 	if !ok {
 		return nil, ErrNotSupportedByPrivacyMapper
 	}
@@ -246,7 +250,14 @@ func (p *PrivacyMapper) replaceOutgoingResponse(ctx context.Context, uri string,
 }
 
 func (p *PrivacyMapper) checkers(db firewalldb.PrivacyMapDB,
-	flags session.PrivacyFlags) map[string]mid.RoundTripChecker {
+	flags session.PrivacyFlags,
+	amountCfg session.AmountObfuscation) map[string]mid.RoundTripChecker {
+
+	amounts := &amountHider{
+		flags:    flags,
+		cfg:      amountCfg,
+		randIntn: p.randIntn,
+	}
 
 	return map[string]mid.RoundTripChecker{
 		"/lnrpc.Lightning/GetInfo": mid.NewResponseRewriter(
@@ -257,7 +268,7 @@ func (p *PrivacyMapper) checkers(db firewalldb.PrivacyMapDB,
 		"/lnrpc.Lightning/ForwardingHistory": mid.NewResponseRewriter(
 			&lnrpc.ForwardingHistoryRequest{},
 			&lnrpc.ForwardingHistoryResponse{},
-			handleFwdHistoryResponse(db, flags, p.randIntn),
+			handleFwdHistoryResponse(db, flags, amounts, p.randIntn),
 			mid.PassThroughErrorHandler,
 		),
 		"/lnrpc.Lightning/FeeReport": mid.NewResponseRewriter(
@@ -269,7 +280,9 @@ func (p *PrivacyMapper) checkers(db firewalldb.PrivacyMapDB,
 			&lnrpc.ListChannelsRequest{},
 			&lnrpc.ListChannelsResponse{},
 			handleListChannelsRequest(db, flags),
-			handleListChannelsResponse(db, flags, p.randIntn),
+			handleListChannelsResponse(
+				db, flags, amounts, p.randIntn,
+			),
 			mid.PassThroughErrorHandler,
 		),
 		"/lnrpc.Lightning/UpdateChannelPolicy": mid.NewFullRewriter(
@@ -282,19 +295,19 @@ func (p *PrivacyMapper) checkers(db firewalldb.PrivacyMapDB,
 		"/lnrpc.Lightning/WalletBalance": mid.NewResponseRewriter(
 			&lnrpc.WalletBalanceRequest{},
 			&lnrpc.WalletBalanceResponse{},
-			handleWalletBalanceResponse(db, flags, p.randIntn),
+			handleWalletBalanceResponse(db, amounts),
 			mid.PassThroughErrorHandler,
 		),
 		"/lnrpc.Lightning/ClosedChannels": mid.NewResponseRewriter(
 			&lnrpc.ClosedChannelsRequest{},
 			&lnrpc.ClosedChannelsResponse{},
-			handleClosedChannelsResponse(db, flags, p.randIntn),
+			handleClosedChannelsResponse(db, flags, amounts),
 			mid.PassThroughErrorHandler,
 		),
 		"/lnrpc.Lightning/PendingChannels": mid.NewResponseRewriter(
 			&lnrpc.PendingChannelsRequest{},
 			&lnrpc.PendingChannelsResponse{},
-			handlePendingChannelsResponse(db, flags, p.randIntn),
+			handlePendingChannelsResponse(db, flags, amounts),
 			mid.PassThroughErrorHandler,
 		),
 
@@ -375,7 +388,7 @@ func handleGetInfoResponse(db firewalldb.PrivacyMapDB,
 }
 
 func handleFwdHistoryResponse(db firewalldb.PrivacyMapDB,
-	flags session.PrivacyFlags,
+	flags session.PrivacyFlags, amounts *amountHider,
 	randIntn func(int) (int, error)) func(ctx context.Context,
 	r *lnrpc.ForwardingHistoryResponse) (proto.Message, error) {
 
@@ -411,27 +424,20 @@ func handleFwdHistoryResponse(db firewalldb.PrivacyMapDB,
 					}
 				}
 
-				amtOutMsat := fe.AmtOutMsat
-				feeMsat := fe.FeeMsat
-				if !flags.Contains(session.ClearAmounts) {
-					// We randomize the outgoing amount for
-					// privacy.
-					amtOutMsat, err = hideAmount(
-						randIntn, amountVariation,
-						amtOutMsat,
-					)
-					if err != nil {
-						return err
-					}
+				// We hide the outgoing amount for privacy.
+				amtOutMsat, err := amounts.hideMsat(
+					"amt_out_msat", fe.AmtOutMsat,
+				)
+				if err != nil {
+					return err
+				}
 
-					// We randomize fees for privacy.
-					feeMsat, err = hideAmount(
-						randIntn, amountVariation,
-						feeMsat,
-					)
-					if err != nil {
-						return err
-					}
+				// We hide fees for privacy.
+				feeMsat, err := amounts.hideMsat(
+					"fee_msat", fe.FeeMsat,
+				)
+				if err != nil {
+					return err
 				}
 
 				// Populate other fields in a consistent manner.
@@ -576,7 +582,7 @@ func handleListChannelsRequest(db firewalldb.PrivacyMapDB,
 }
 
 func handleListChannelsResponse(db firewalldb.PrivacyMapDB,
-	flags session.PrivacyFlags,
+	flags session.PrivacyFlags, amounts *amountHider,
 	randIntn func(int) (int, error)) func(ctx context.Context,
 	r *lnrpc.ListChannelsResponse) (proto.Message, error) {
 
@@ -641,8 +647,8 @@ func handleListChannelsResponse(db firewalldb.PrivacyMapDB,
 				// state as a non-funder.
 
 				// We randomize local/remote balances.
-				localBalance, err := maybeHideAmount(
-					flags, randIntn, c.LocalBalance,
+				localBalance, err := amounts.hide(
+					"local_balance", c.LocalBalance,
 				)
 				if err != nil {
 					return err
@@ -655,23 +661,24 @@ func handleListChannelsResponse(db firewalldb.PrivacyMapDB,
 				}
 
 				remoteBalance := c.RemoteBalance
-				if !flags.Contains(session.ClearAmounts) {
+				if amounts.hides("local_balance") {
 					// We adapt the remote balance
 					// accordingly.
 					remoteBalance = c.Capacity - localBalance
 				}
 
 				// We hide the total sats sent and received.
-				satsReceived, err := maybeHideAmount(
-					flags, randIntn,
+				satsReceived, err := amounts.hide(
+					"total_satoshis_received",
 					c.TotalSatoshisReceived,
 				)
 				if err != nil {
 					return err
 				}
 
-				satsSent, err := maybeHideAmount(
-					flags, randIntn, c.TotalSatoshisSent,
+				satsSent, err := amounts.hide(
+					"total_satoshis_sent",
+					c.TotalSatoshisSent,
 				)
 				if err != nil {
 					return err
@@ -689,8 +696,8 @@ func handleListChannelsResponse(db firewalldb.PrivacyMapDB,
 				}
 
 				// We hide the unsettled balance.
-				unsettled, err := maybeHideAmount(
-					flags, randIntn, c.UnsettledBalance,
+				unsettled, err := amounts.hide(
+					"unsettled_balance", c.UnsettledBalance,
 				)
 				if err != nil {
 					return err
@@ -857,43 +864,43 @@ func handleUpdatePolicyResponse(db firewalldb.PrivacyMapDB,
 }
 
 func handleWalletBalanceResponse(_ firewalldb.PrivacyMapDB,
-	flags session.PrivacyFlags,
-	randIntn func(int) (int, error)) func(ctx context.Context,
+	amounts *amountHider) func(ctx context.Context,
 	r *lnrpc.WalletBalanceResponse) (proto.Message, error) {
 
 	return func(_ context.Context, r *lnrpc.WalletBalanceResponse) (
 		proto.Message, error) {
 
-		totalBalance, err := maybeHideAmount(
-			flags, randIntn, r.TotalBalance,
+		totalBalance, err := amounts.hide(
+			"total_balance", r.TotalBalance,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		confirmedBalance, err := maybeHideAmount(
-			flags, randIntn, r.ConfirmedBalance,
+		confirmedBalance, err := amounts.hide(
+			"confirmed_balance", r.ConfirmedBalance,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		unconfirmedBalance, err := maybeHideAmount(
-			flags, randIntn, r.UnconfirmedBalance,
+		unconfirmedBalance, err := amounts.hide(
+			"unconfirmed_balance", r.UnconfirmedBalance,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		lockedBalance, err := maybeHideAmount(
-			flags, randIntn, r.LockedBalance,
+		lockedBalance, err := amounts.hide(
+			"locked_balance", r.LockedBalance,
 		)
 		if err != nil {
 			return nil, err
 		}
 
-		reservedBalanceAnchorChan, err := maybeHideAmount(
-			flags, randIntn, r.ReservedBalanceAnchorChan,
+		reservedBalanceAnchorChan, err := amounts.hide(
+			"reserved_balance_anchor_chan",
+			r.ReservedBalanceAnchorChan,
 		)
 		if err != nil {
 			return nil, err
@@ -904,15 +911,15 @@ func handleWalletBalanceResponse(_ firewalldb.PrivacyMapDB,
 			len(r.AccountBalance),
 		)
 		for k, v := range r.AccountBalance {
-			confirmed, err := maybeHideAmount(
-				flags, randIntn, v.ConfirmedBalance,
+			confirmed, err := amounts.hide(
+				"confirmed_balance", v.ConfirmedBalance,
 			)
 			if err != nil {
 				return nil, err
 			}
 
-			unconfirmed, err := maybeHideAmount(
-				flags, randIntn, v.UnconfirmedBalance,
+			unconfirmed, err := amounts.hide(
+				"unconfirmed_balance", v.UnconfirmedBalance,
 			)
 			if err != nil {
 				return nil, err
@@ -937,7 +944,7 @@ func handleWalletBalanceResponse(_ firewalldb.PrivacyMapDB,
 
 func handleClosedChannelsResponse(db firewalldb.PrivacyMapDB,
 	flags session.PrivacyFlags,
-	randIntn func(int) (int, error)) func(ctx context.Context,
+	amounts *amountHider) func(ctx context.Context,
 	r *lnrpc.ClosedChannelsResponse) (proto.Message, error) {
 
 	return func(ctx context.Context, r *lnrpc.ClosedChannelsResponse) (
@@ -964,15 +971,15 @@ func handleClosedChannelsResponse(db firewalldb.PrivacyMapDB,
 					}
 				}
 
-				capacity, err := maybeHideAmount(
-					flags, randIntn, c.Capacity,
+				capacity, err := amounts.hide(
+					"capacity", c.Capacity,
 				)
 				if err != nil {
 					return err
 				}
 
-				settledBalance, err := maybeHideAmount(
-					flags, randIntn, c.SettledBalance,
+				settledBalance, err := amounts.hide(
+					"settled_balance", c.SettledBalance,
 				)
 				if err != nil {
 					return err
@@ -1054,7 +1061,7 @@ func handleClosedChannelsResponse(db firewalldb.PrivacyMapDB,
 // channel.
 func obfuscatePendingChannel(ctx context.Context,
 	c *lnrpc.PendingChannelsResponse_PendingChannel,
-	tx firewalldb.PrivacyMapTx, randIntn func(int) (int, error),
+	tx firewalldb.PrivacyMapTx, amounts *amountHider,
 	flags session.PrivacyFlags) (
 	*lnrpc.PendingChannelsResponse_PendingChannel, error) {
 
@@ -1070,16 +1077,16 @@ func obfuscatePendingChannel(ctx context.Context,
 		}
 	}
 
-	capacity, err := maybeHideAmount(
-		flags, randIntn, c.Capacity,
+	capacity, err := amounts.hide(
+		"capacity", c.Capacity,
 	)
 	if err != nil {
 		return nil, err
 	}
 
 	// We randomize local/remote balances.
-	localBalance, err := maybeHideAmount(
-		flags, randIntn, c.LocalBalance,
+	localBalance, err := amounts.hide(
+		"local_balance", c.LocalBalance,
 	)
 	if err != nil {
 		return nil, err
@@ -1093,7 +1100,7 @@ func obfuscatePendingChannel(ctx context.Context,
 
 	// The remote balance is set constently to the local balance.
 	remoteBalance := c.RemoteBalance
-	if !flags.Contains(session.ClearAmounts) {
+	if amounts.hides("local_balance") {
 		remoteBalance = capacity - localBalance
 	}
 
@@ -1131,7 +1138,7 @@ func obfuscatePendingChannel(ctx context.Context,
 
 func handlePendingChannelsResponse(db firewalldb.PrivacyMapDB,
 	flags session.PrivacyFlags,
-	randIntn func(int) (int, error)) func(ctx context.Context,
+	amounts *amountHider) func(ctx context.Context,
 	r *lnrpc.PendingChannelsResponse) (proto.Message, error) {
 
 	return func(ctx context.Context, r *lnrpc.PendingChannelsResponse) (
@@ -1164,7 +1171,7 @@ func handlePendingChannelsResponse(db firewalldb.PrivacyMapDB,
 				var err error
 
 				pendingChannel, err := obfuscatePendingChannel(
-					ctx, c.Channel, tx, randIntn, flags,
+					ctx, c.Channel, tx, amounts, flags,
 				)
 				if err != nil {
 					return err
@@ -1188,7 +1195,7 @@ func handlePendingChannelsResponse(db firewalldb.PrivacyMapDB,
 				var err error
 
 				pendingChannel, err := obfuscatePendingChannel(
-					ctx, c.Channel, tx, randIntn, flags,
+					ctx, c.Channel, tx, amounts, flags,
 				)
 				if err != nil {
 					return err
@@ -1217,7 +1224,7 @@ func handlePendingChannelsResponse(db firewalldb.PrivacyMapDB,
 				var err error
 
 				pendingChannel, err := obfuscatePendingChannel(
-					ctx, c.Channel, tx, randIntn, flags,
+					ctx, c.Channel, tx, amounts, flags,
 				)
 				if err != nil {
 					return err
@@ -1233,8 +1240,8 @@ func handlePendingChannelsResponse(db firewalldb.PrivacyMapDB,
 					}
 				}
 
-				limboBalance, err := maybeHideAmount(
-					flags, randIntn, c.LimboBalance,
+				limboBalance, err := amounts.hide(
+					"limbo_balance", c.LimboBalance,
 				)
 				if err != nil {
 					return err
@@ -1244,8 +1251,8 @@ func handlePendingChannelsResponse(db firewalldb.PrivacyMapDB,
 					limboBalance = pendingChannel.Capacity
 				}
 
-				recoveredBalance, err := maybeHideAmount(
-					flags, randIntn, c.RecoveredBalance,
+				recoveredBalance, err := amounts.hide(
+					"recovered_balance", c.RecoveredBalance,
 				)
 				if err != nil {
 					return err
@@ -1278,14 +1285,14 @@ func handlePendingChannelsResponse(db firewalldb.PrivacyMapDB,
 				var err error
 
 				pendingChannel, err := obfuscatePendingChannel(
-					ctx, c.Channel, tx, randIntn, flags,
+					ctx, c.Channel, tx, amounts, flags,
 				)
 				if err != nil {
 					return err
 				}
 
-				limboBalance, err := maybeHideAmount(
-					flags, randIntn, c.LimboBalance,
+				limboBalance, err := amounts.hide(
+					"limbo_balance", c.LimboBalance,
 				)
 				if err != nil {
 					return err
@@ -1341,8 +1348,8 @@ func handlePendingChannelsResponse(db firewalldb.PrivacyMapDB,
 			return nil, err
 		}
 
-		totalLimbo, err := maybeHideAmount(
-			flags, randIntn, r.TotalLimboBalance,
+		totalLimbo, err := amounts.hide(
+			"total_limbo_balance", r.TotalLimboBalance,
 		)
 		if err != nil {
 			return nil, err
@@ -1704,22 +1711,67 @@ func handleConnectPeerRequest(db firewalldb.PrivacyMapDB,
 	}
 }
 
-// maybeHideAmount hides an amount if the privacy flag is not set.
-func maybeHideAmount(flags session.PrivacyFlags, randIntn func(int) (int,
-	error), a int64) (int64, error) {
+// amountHider hides the amounts of a response according to the privacy flags
+// and the amount obfuscation config of a session. Since both are fixed when
+// the session is created, all requests and responses of a session are handled
+// with the same strategy.
+type amountHider struct {
+	flags    session.PrivacyFlags
+	cfg      session.AmountObfuscation
+	randIntn func(int) (int, error)
+}
 
-	if !flags.Contains(session.ClearAmounts) {
-		hiddenAmount, err := hideAmount(
-			randIntn, amountVariation, uint64(a),
-		)
-		if err != nil {
-			return 0, err
-		}
+// hides returns true if the amount field with the given name is obfuscated.
+func (h *amountHider) hides(field string) bool {
+	return !h.flags.Contains(session.ClearAmounts) &&
+		!h.cfg.ClearsField(field)
+}
+
+// hide hides the given satoshi amount of the field with the given name.
+func (h *amountHider) hide(field string, a int64) (int64, error) {
+	hiddenAmount, err := h.hideAmount(field, uint64(a), 1)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(hiddenAmount), nil
+}
+
+// hideMsat hides the given millisatoshi amount of the field with the given
+// name.
+func (h *amountHider) hideMsat(field string, a uint64) (uint64, error) {
+	return h.hideAmount(field, a, 1000)
+}
+
+// hideAmount hides the given amount with the session's strategy. The unit is
+// the number of amount units per satoshi.
+func (h *amountHider) hideAmount(field string, amount,
+	unit uint64) (uint64, error) {
+
+	if !h.hides(field) {
+		return amount, nil
+	}
+
+	switch h.cfg.Strategy {
+	case session.AmountObfuscationRelative:
+		return hideAmount(h.randIntn, h.cfg.Variation(), amount)
+
+	case session.AmountObfuscationBucket:
+		return bucketAmount(h.cfg.BucketSize*unit, amount)
+
+	default:
+		return 0, fmt.Errorf("unknown amount obfuscation strategy "+
+			"<%d>", h.cfg.Strategy)
+	}
+}
 
-		return int64(hiddenAmount), nil
+// bucketAmount rounds an amount down to a multiple of the given bucket size.
+func bucketAmount(bucketSize, amount uint64) (uint64, error) {
+	if bucketSize == 0 {
+		return 0, fmt.Errorf("bucket amount: bucket size must be set")
 	}
 
-	return a, nil
+	return amount - amount%bucketSize, nil
 }
 
 // hideAmount symmetrically randomizes an amount around a given relative
@@ -137,6 +137,7 @@ func TestPrivacyMapper(t *testing.T) {
 	tests := []struct {
 		name                string
 		privacyFlags        session.PrivacyFlags
+		amountObfuscation   session.AmountObfuscation
 		uri                 string
 		msgType             rpcperms.InterceptType
 		msg                 proto.Message
@@ -451,6 +452,104 @@ func TestPrivacyMapper(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "WalletBalance Response custom variation",
+			uri:     "/lnrpc.Lightning/WalletBalance",
+			msgType: rpcperms.TypeResponse,
+			msg: &lnrpc.WalletBalanceResponse{
+				TotalBalance:              1_000_000,
+				ConfirmedBalance:          1_000_000,
+				UnconfirmedBalance:        1_000_000,
+				LockedBalance:             1_000_000,
+				ReservedBalanceAnchorChan: 1_000_000,
+				AccountBalance: map[string]*lnrpc.WalletAccountBalance{
+					"first": {
+						ConfirmedBalance:   1_000_000,
+						UnconfirmedBalance: 1_000_000,
+					},
+				},
+			},
+			amountObfuscation: session.AmountObfuscation{
+				VariationPPM: 100_000,
+				ClearFields: []string{
+					"locked_balance",
+					"unconfirmed_balance",
+				},
+			},
+			expectedReplacement: &lnrpc.WalletBalanceResponse{
+				TotalBalance:              900_100,
+				ConfirmedBalance:          900_100,
+				UnconfirmedBalance:        1_000_000,
+				LockedBalance:             1_000_000,
+				ReservedBalanceAnchorChan: 900_100,
+				AccountBalance: map[string]*lnrpc.WalletAccountBalance{
+					"first": {
+						ConfirmedBalance:   900_100,
+						UnconfirmedBalance: 1_000_000,
+					},
+				},
+			},
+		},
+		{
+			name:    "WalletBalance Response bucket",
+			uri:     "/lnrpc.Lightning/WalletBalance",
+			msgType: rpcperms.TypeResponse,
+			msg: &lnrpc.WalletBalanceResponse{
+				TotalBalance:              1_234_567,
+				ConfirmedBalance:          1_000_000,
+				UnconfirmedBalance:        234_567,
+				LockedBalance:             99_999,
+				ReservedBalanceAnchorChan: 100_000,
+			},
+			amountObfuscation: session.AmountObfuscation{
+				Strategy:   session.AmountObfuscationBucket,
+				BucketSize: 100_000,
+			},
+			expectedReplacement: &lnrpc.WalletBalanceResponse{
+				TotalBalance:              1_200_000,
+				ConfirmedBalance:          1_000_000,
+				UnconfirmedBalance:        200_000,
+				LockedBalance:             0,
+				ReservedBalanceAnchorChan: 100_000,
+				AccountBalance:            map[string]*lnrpc.WalletAccountBalance{},
+			},
+		},
+		{
+			name: "ForwardingHistory Response bucket",
+			uri:  "/lnrpc.Lightning/ForwardingHistory",
+			privacyFlags: []session.PrivacyFlag{
+				session.ClearChanIDs,
+				session.ClearTimeStamps,
+			},
+			amountObfuscation: session.AmountObfuscation{
+				Strategy:   session.AmountObfuscationBucket,
+				BucketSize: 1_000,
+			},
+			msgType: rpcperms.TypeResponse,
+			msg: &lnrpc.ForwardingHistoryResponse{
+				ForwardingEvents: []*lnrpc.ForwardingEvent{
+					{
+						AmtOutMsat:  2_500_500,
+						FeeMsat:     1_500_000,
+						TimestampNs: 1_000_000_000,
+					},
+				},
+			},
+			expectedReplacement: &lnrpc.ForwardingHistoryResponse{
+				ForwardingEvents: []*lnrpc.ForwardingEvent{
+					{
+						AmtIn:       3_000,
+						AmtInMsat:   3_000_000,
+						AmtOut:      2_000,
+						AmtOutMsat:  2_000_000,
+						Fee:         1_000,
+						FeeMsat:     1_000_000,
+						Timestamp:   1,
+						TimestampNs: 1_000_000_000,
+					},
+				},
+			},
+		},
 		{
 			name:    "ClosedChannels Response",
 			uri:     "/lnrpc.Lightning/ClosedChannels",
@@ -899,6 +998,9 @@ func TestPrivacyMapper(t *testing.T) {
 			pd.AddPair(sessionID, sessionID)
 			err = pd.AddPrivacyFlags(sessionID, test.privacyFlags)
 			require.NoError(t, err)
+			pd.AddAmountObfuscation(
+				sessionID, test.amountObfuscation,
+			)
 
 			// randIntn is used for deterministic testing.
 			randIntn := func(n int) (int, error) { return 100, nil }
@@ -984,7 +1086,8 @@ func TestPrivacyMapper(t *testing.T) {
 		// We test the independent outgoing amount (incoming amount
 		// would also be dependend on the fee variation).
 		amtOutMsat := msg.ForwardingEvents[0].AmtOutMsat
-		amtInterval := uint64(amountVariation * float64(amtOutMsat))
+		amtVariation := (&session.AmountObfuscation{}).Variation()
+		amtInterval := uint64(amtVariation * float64(amtOutMsat))
 		minAmt := amtOutMsat - amtInterval
 		maxAmt := amtOutMsat + amtInterval
 
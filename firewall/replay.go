@@ -0,0 +1,309 @@
+package firewall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/rules"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ruleViolationPrefix is the prefix of the error reason that the RuleEnforcer
+// records for an action that was denied by one of its rules.
+const ruleViolationPrefix = "rule violation"
+
+// ReplayResult is the outcome of re-evaluating a single past action against a
+// set of rules.
+type ReplayResult struct {
+	// Action is the action that was replayed.
+	Action *firewalldb.Action
+
+	// PreviouslyDenied is true if the action was originally rejected by
+	// the rules of the session.
+	PreviouslyDenied bool
+
+	// Denied is true if the action would be rejected by the replayed rules.
+	Denied bool
+
+	// Reason is the reason the action would be rejected. It is only set if
+	// Denied is true.
+	Reason string
+}
+
+// Changed returns true if the replayed rules come to a different decision
+// than the one that was originally made for the action.
+func (r *ReplayResult) Changed() bool {
+	return r.Denied != r.PreviouslyDenied
+}
+
+// Replay runs the given actions of a session through the given set of feature
+// rules in dry-run mode. Any state that stateful rules would normally persist
+// is written to a scratch store that is discarded afterwards, so replaying
+// never has an effect on the rules that are actually enforced. Time based
+// rules, such as the rate limit, are evaluated against the current actions
+// database and the current time, so their outcome is only an approximation of
+// what would have happened at the time of the original action.
+//
+// Actions that were recorded without their request parameters can't be
+// evaluated and are not included in the result.
+func (r *RuleEnforcer) Replay(ctx context.Context, sessionID session.ID,
+	featureRules map[string]map[string]rules.Values,
+	actions []*firewalldb.Action) ([]*ReplayResult, error) {
+
+	sess, err := r.sessionDB.GetSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	stores := make(map[string]*scratchKVStores)
+	results := make([]*ReplayResult, 0, len(actions))
+	for i, action := range actions {
+		if action.FeatureName == "" || len(action.RPCParamsJson) == 0 {
+			continue
+		}
+
+		result := &ReplayResult{
+			Action: action,
+			PreviouslyDenied: action.State ==
+				firewalldb.ActionStateError &&
+				strings.Contains(
+					action.ErrorReason, ruleViolationPrefix,
+				),
+		}
+
+		err := r.replayAction(
+			ctx, uint64(i), sess, featureRules, action, stores,
+		)
+		if err != nil {
+			result.Denied = true
+			result.Reason = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// replayAction evaluates a single action against the given feature rules. A
+// non-nil error means that the action would be denied.
+func (r *RuleEnforcer) replayAction(ctx context.Context, reqID uint64,
+	sess *session.Session, featureRules map[string]map[string]rules.Values,
+	action *firewalldb.Action, stores map[string]*scratchKVStores) error {
+
+	values, ok := featureRules[action.FeatureName]
+	if len(featureRules) != 0 && !ok {
+		return fmt.Errorf("feature %s does not correspond to a "+
+			"feature specified in the rules", action.FeatureName)
+	}
+
+	msg, err := requestFromAction(action)
+	if err != nil {
+		return fmt.Errorf("unable to parse request: %w", err)
+	}
+
+	allActionsDB := r.actionsDB.GetActionsReadDB(
+		sess.GroupID, action.FeatureName,
+	)
+
+	var errs []error
+	for name, value := range values {
+		storeKey := action.FeatureName + "/" + name
+		store, ok := stores[storeKey]
+		if !ok {
+			store = newScratchKVStores()
+			stores[storeKey] = store
+		}
+
+		cfg := &rules.ConfigImpl{
+			Stores:       store,
+			ActionsDB:    allActionsDB.GroupFeatureActionsDB(),
+			MethodPerms:  r.permsMgr.URIPermissions,
+			NodeID:       r.nodeID,
+			RouterClient: r.routerClient,
+			LndClient:    r.lndClient,
+			ReqID:        int64(reqID),
+			LndConnID:    r.lndConnID,
+		}
+
+		enforcer, err := r.ruleMgrs.InitEnforcer(ctx, cfg, name, value)
+		if err != nil {
+			return err
+		}
+
+		if _, err := enforcer.HandleRequest(
+			ctx, action.RPCMethod, msg,
+		); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s: %w", ruleViolationPrefix,
+			errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// requestFromAction reconstructs the request message of an action from its
+// recorded URI and JSON parameters.
+func requestFromAction(action *firewalldb.Action) (proto.Message, error) {
+	// A URI has the form /package.Service/Method which maps to the fully
+	// qualified method name package.Service.Method.
+	name := strings.Replace(
+		strings.TrimPrefix(action.RPCMethod, "/"), "/", ".", 1,
+	)
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(
+		protoreflect.FullName(name),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unknown method %s: %w", action.RPCMethod,
+			err)
+	}
+
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a method", action.RPCMethod)
+	}
+
+	msgType, err := protoregistry.GlobalTypes.FindMessageByName(
+		method.Input().FullName(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := msgType.New().Interface()
+	err = lnrpc.ProtoJSONUnmarshalOpts.Unmarshal(action.RPCParamsJson, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// scratchKVStores is an in-memory implementation of the firewalldb.KVStores
+// that is used to give stateful rules somewhere to write to during a replay.
+type scratchKVStores struct {
+	tx *scratchKVStoreTx
+}
+
+// newScratchKVStores constructs a new, empty scratchKVStores.
+func newScratchKVStores() *scratchKVStores {
+	return &scratchKVStores{
+		tx: &scratchKVStoreTx{
+			global:     newScratchKVStore(),
+			local:      newScratchKVStore(),
+			globalTemp: newScratchKVStore(),
+			localTemp:  newScratchKVStore(),
+		},
+	}
+}
+
+// A compile-time check to ensure that scratchKVStores implements the
+// firewalldb.KVStores interface.
+var _ firewalldb.KVStores = (*scratchKVStores)(nil)
+
+// Update runs the given function against the scratch stores.
+func (s *scratchKVStores) Update(ctx context.Context, f func(ctx context.Context,
+	tx firewalldb.KVStoreTx) error) error {
+
+	return f(ctx, s.tx)
+}
+
+// View runs the given function against the scratch stores.
+func (s *scratchKVStores) View(ctx context.Context, f func(ctx context.Context,
+	tx firewalldb.KVStoreTx) error) error {
+
+	return f(ctx, s.tx)
+}
+
+// scratchKVStoreTx gives access to the four scratch stores of a rule.
+type scratchKVStoreTx struct {
+	global     firewalldb.KVStore
+	local      firewalldb.KVStore
+	globalTemp firewalldb.KVStore
+	localTemp  firewalldb.KVStore
+}
+
+// A compile-time check to ensure that scratchKVStoreTx implements the
+// firewalldb.KVStoreTx interface.
+var _ firewalldb.KVStoreTx = (*scratchKVStoreTx)(nil)
+
+// Global returns the scratch version of the global store.
+func (s *scratchKVStoreTx) Global() firewalldb.KVStore {
+	return s.global
+}
+
+// Local returns the scratch version of the local store.
+func (s *scratchKVStoreTx) Local() firewalldb.KVStore {
+	return s.local
+}
+
+// GlobalTemp returns the scratch version of the temporary global store.
+func (s *scratchKVStoreTx) GlobalTemp() firewalldb.KVStore {
+	return s.globalTemp
+}
+
+// LocalTemp returns the scratch version of the temporary local store.
+func (s *scratchKVStoreTx) LocalTemp() firewalldb.KVStore {
+	return s.localTemp
+}
+
+// scratchKVStore is a simple in-memory key value store.
+type scratchKVStore struct {
+	mu    sync.Mutex
+	store map[string][]byte
+}
+
+// newScratchKVStore constructs a new, empty scratchKVStore.
+func newScratchKVStore() *scratchKVStore {
+	return &scratchKVStore{
+		store: make(map[string][]byte),
+	}
+}
+
+// A compile-time check to ensure that scratchKVStore implements the
+// firewalldb.KVStore interface.
+var _ firewalldb.KVStore = (*scratchKVStore)(nil)
+
+// Get fetches the value stored under the given key. If no value is found, nil
+// is returned.
+func (s *scratchKVStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.store[key], nil
+}
+
+// Set stores the given value under the given key.
+func (s *scratchKVStore) Set(_ context.Context, key string,
+	value []byte) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.store[key] = value
+
+	return nil
+}
+
+// Del deletes the value stored under the given key.
+func (s *scratchKVStore) Del(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.store, key)
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/perms"
+	"github.com/lightninglabs/lightning-terminal/rules"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// TestRequestFromAction makes sure that the request message of an action can
+// be reconstructed from its URI and JSON parameters.
+func TestRequestFromAction(t *testing.T) {
+	t.Parallel()
+
+	msg, err := requestFromAction(&firewalldb.Action{
+		RPCMethod: "/lnrpc.Lightning/UpdateChannelPolicy",
+		RPCParamsJson: []byte(
+			`{"base_fee_msat":"10","time_lock_delta":40}`,
+		),
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(&lnrpc.PolicyUpdateRequest{
+		BaseFeeMsat:   10,
+		TimeLockDelta: 40,
+	}, msg))
+
+	// An unknown method can't be replayed.
+	_, err = requestFromAction(&firewalldb.Action{
+		RPCMethod:     "/lnrpc.Lightning/DoesNotExist",
+		RPCParamsJson: []byte(`{}`),
+	})
+	require.Error(t, err)
+
+	// Neither can parameters that don't match the request type.
+	_, err = requestFromAction(&firewalldb.Action{
+		RPCMethod:     "/lnrpc.Lightning/UpdateChannelPolicy",
+		RPCParamsJson: []byte(`{"unknown_field":1}`),
+	})
+	require.Error(t, err)
+}
+
+// TestReplay tests that past actions are classified correctly when they are
+// replayed against a stricter and a looser set of rules, and that the state of
+// stateful rules is kept in scratch stores that don't outlive a replay.
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	sessDB := session.NewTestDB(t, clock.NewDefaultClock())
+	fwDB := firewalldb.NewTestDBWithSessions(t, sessDB)
+
+	sess, err := sessDB.NewSession(
+		ctx, "test", session.TypeAutopilot, time.Unix(1000, 0),
+		"something",
+	)
+	require.NoError(t, err)
+
+	permsMgr, err := perms.NewManager(false)
+	require.NoError(t, err)
+
+	enforcer := &RuleEnforcer{
+		actionsDB: fwDB,
+		sessionDB: sessDB,
+		permsMgr:  permsMgr,
+		ruleMgrs:  rules.NewRuleManagerSet(),
+	}
+
+	const feature = "AutoFees"
+
+	policyUpdate := func(baseFee int, state firewalldb.ActionState,
+		reason string) *firewalldb.Action {
+
+		return &firewalldb.Action{
+			FeatureName: feature,
+			RPCMethod:   "/lnrpc.Lightning/UpdateChannelPolicy",
+			RPCParamsJson: []byte(fmt.Sprintf(
+				`{"base_fee_msat":"%d","fee_rate_ppm":10,`+
+					`"time_lock_delta":40}`, baseFee,
+			)),
+			State:       state,
+			ErrorReason: reason,
+		}
+	}
+
+	var (
+		// allowed was let through by the original rules.
+		allowed = policyUpdate(100, firewalldb.ActionStateDone, "")
+
+		// denied was rejected by one of the original rules.
+		denied = policyUpdate(
+			5000, firewalldb.ActionStateError,
+			"rpc error: code = ResourceExhausted desc = rule "+
+				"violation: invalid base fee amount",
+		)
+
+		// failed was let through by the original rules but then
+		// failed in lnd, so it doesn't count as denied.
+		failed = policyUpdate(
+			5000, firewalldb.ActionStateError, "unknown channel",
+		)
+
+		// noParams and noFeature can't be replayed and are skipped.
+		noParams = &firewalldb.Action{
+			FeatureName: feature,
+			RPCMethod:   "/lnrpc.Lightning/UpdateChannelPolicy",
+			State:       firewalldb.ActionStateDone,
+		}
+		noFeature = &firewalldb.Action{
+			RPCMethod:     "/lnrpc.Lightning/GetInfo",
+			RPCParamsJson: []byte(`{}`),
+			State:         firewalldb.ActionStateDone,
+		}
+	)
+	actions := []*firewalldb.Action{
+		allowed, denied, failed, noParams, noFeature,
+	}
+
+	policyBounds := func(maxBase uint64) map[string]map[string]rules.Values {
+		bounds := &rules.ChanPolicyBounds{
+			MaxBaseMsat:  maxBase,
+			MaxRatePPM:   100,
+			MinCLTVDelta: 18,
+			MaxCLTVDelta: 100,
+			MaxHtlcMsat:  1000,
+		}
+
+		return map[string]map[string]rules.Values{
+			feature: {rules.ChanPolicyBoundsName: bounds},
+		}
+	}
+
+	type outcome struct {
+		action  *firewalldb.Action
+		denied  bool
+		changed bool
+	}
+
+	checkResults := func(results []*ReplayResult, expected []outcome) {
+		t.Helper()
+
+		require.Len(t, results, len(expected))
+		for i, e := range expected {
+			r := results[i]
+			require.Same(t, e.action, r.Action)
+			require.Equal(t, e.denied, r.Denied)
+			require.Equal(t, e.changed, r.Changed())
+
+			if !e.denied {
+				require.Empty(t, r.Reason)
+
+				continue
+			}
+			require.Contains(t, r.Reason, ruleViolationPrefix)
+		}
+	}
+
+	// With a stricter base fee bound, the action that was allowed before
+	// is now denied. The one that was denied stays denied, and the one
+	// that failed for a different reason is now denied by the rules.
+	results, err := enforcer.Replay(ctx, sess.ID, policyBounds(50), actions)
+	require.NoError(t, err)
+	require.False(t, results[0].PreviouslyDenied)
+	require.True(t, results[1].PreviouslyDenied)
+	require.False(t, results[2].PreviouslyDenied)
+	checkResults(results, []outcome{
+		{action: allowed, denied: true, changed: true},
+		{action: denied, denied: true, changed: false},
+		{action: failed, denied: true, changed: true},
+	})
+	require.Contains(t, results[0].Reason, "invalid base fee amount")
+
+	// With a looser base fee bound, the action that was denied before is
+	// now allowed.
+	results, err = enforcer.Replay(
+		ctx, sess.ID, policyBounds(10000), actions,
+	)
+	require.NoError(t, err)
+	checkResults(results, []outcome{
+		{action: allowed, denied: false, changed: false},
+		{action: denied, denied: false, changed: true},
+		{action: failed, denied: false, changed: false},
+	})
+
+	// An action of a feature that isn't part of the rules is denied.
+	results, err = enforcer.Replay(
+		ctx, sess.ID, map[string]map[string]rules.Values{
+			"OtherFeature": {},
+		}, []*firewalldb.Action{allowed},
+	)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.True(t, results[0].Denied)
+	require.True(t, results[0].Changed())
+	require.Contains(t, results[0].Reason, "does not correspond")
+
+	// A stateful rule keeps its state in the scratch stores. Two payments
+	// that each fit into the budget but not together, leave the second
+	// one denied.
+	payment := &firewalldb.Action{
+		FeatureName:   feature,
+		RPCMethod:     "/lnrpc.Lightning/SendPaymentSync",
+		RPCParamsJson: []byte(`{"amt":"60"}`),
+		State:         firewalldb.ActionStateDone,
+	}
+	budget := map[string]map[string]rules.Values{
+		feature: {
+			rules.SessionBudgetName: &rules.SessionBudget{
+				AbsoluteAmtSats: 100,
+			},
+		},
+	}
+	payments := []*firewalldb.Action{payment, payment}
+
+	for i := 0; i < 2; i++ {
+		// Each replay starts with empty scratch stores, so replaying
+		// twice leads to the same result.
+		results, err = enforcer.Replay(ctx, sess.ID, budget, payments)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.False(t, results[0].Denied)
+		require.True(t, results[1].Denied)
+		require.True(t, results[1].Changed())
+		require.Contains(
+			t, results[1].Reason, "exceeds the session budget",
+		)
+	}
+
+	// Nothing was written to the stores of the rule that is actually
+	// enforced.
+	spent, pending, err := rules.SessionBudgetSpent(
+		ctx, fwDB.GetKVStores(
+			rules.SessionBudgetName, sess.GroupID, feature,
+		),
+	)
+	require.NoError(t, err)
+	require.Zero(t, spent)
+	require.Zero(t, pending)
+
+	// Replaying for an unknown session fails.
+	_, err = enforcer.Replay(ctx, session.ID{9}, budget, payments)
+	require.Error(t, err)
+}
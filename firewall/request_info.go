@@ -1,9 +1,12 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lightning-terminal/session"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"gopkg.in/macaroon.v2"
 )
@@ -134,3 +137,19 @@ func (ri *RequestInfo) String() string {
 		ri.GRPCMessageType, ri.Streaming, strings.Join(ri.Caveats, ","),
 		ri.MetaInfo, ri.Rules)
 }
+
+// logCtx returns a copy of the given context with the ID of the session that
+// the request was made with added to its logging attributes, if the request
+// carries a session macaroon.
+func (ri *RequestInfo) logCtx(ctx context.Context) context.Context {
+	if ri.Macaroon == nil {
+		return ctx
+	}
+
+	sessionID, err := session.IDFromMacaroon(ri.Macaroon)
+	if err != nil {
+		return ctx
+	}
+
+	return mid.AddLogID(ctx, "session_id", sessionID[:])
+}
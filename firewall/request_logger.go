@@ -128,7 +128,7 @@ func (r *RequestLogger) CustomCaveatName() string {
 
 // Intercept processes an RPC middleware interception request and returns the
 // interception result which either accepts or rejects the intercepted message.
-func (r *RequestLogger) Intercept(_ context.Context,
+func (r *RequestLogger) Intercept(ctx context.Context,
 	req *lnrpc.RPCMiddlewareRequest) (*lnrpc.RPCMiddlewareResponse, error) {
 
 	ri, err := NewInfoFromRequest(req)
@@ -148,7 +148,8 @@ func (r *RequestLogger) Intercept(_ context.Context,
 		return mid.RPCOk(req)
 	}
 
-	log.Tracef("RequestLogger: Intercepting %v", ri)
+	ctx = ri.logCtx(ctx)
+	log.TraceS(ctx, "RequestLogger: Intercepting", "request", ri)
 
 	switch ri.MWRequestType {
 	case MWRequestTypeStreamAuth:
@@ -2,8 +2,10 @@ package firewall
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/lightninglabs/lightning-terminal/firewalldb"
 	"github.com/lightninglabs/lightning-terminal/perms"
@@ -45,6 +47,14 @@ type RuleEnforcer struct {
 
 	ruleMgrs rules.ManagerSet
 
+	// auditLog records the decision for every request. It may be nil, in
+	// which case no decisions are recorded.
+	auditLog *AuditLogger
+
+	// onDenied is called with the audit entry of every denied request. It
+	// may be nil.
+	onDenied func(*firewalldb.AuditEntry)
+
 	// lndConnID is a random identifier for an lnd run. It is used to
 	// generate unique request identifiers that amend the non-unique request
 	// identifiers that are passed from lnd.
@@ -64,7 +74,11 @@ func NewRuleEnforcer(ruleDB firewalldb.RulesDB,
 	lndClient lndclient.LightningClient, lndConnID string,
 	ruleMgrs rules.ManagerSet,
 	markActionErrored func(reqID uint64, reason string) error,
-	privMap firewalldb.NewPrivacyMapDB) *RuleEnforcer {
+	privMap firewalldb.NewPrivacyMapDB,
+	auditLog *AuditLogger,
+	onDenied func(*firewalldb.AuditEntry)) *RuleEnforcer {
+
+	registerMetrics()
 
 	return &RuleEnforcer{
 		ruleDB:            ruleDB,
@@ -79,6 +93,8 @@ func NewRuleEnforcer(ruleDB firewalldb.RulesDB,
 		newPrivMap:        privMap,
 		sessionDB:         sessionIDIndex,
 		lndConnID:         lndConnID,
+		auditLog:          auditLog,
+		onDenied:          onDenied,
 	}
 }
 
@@ -114,40 +130,23 @@ func (r *RuleEnforcer) Intercept(ctx context.Context,
 		return mid.RPCOk(req)
 	}
 
-	log.Tracef("RuleEnforcer: Intercepting %v", ri)
+	ctx = ri.logCtx(ctx)
+	log.TraceS(ctx, "RuleEnforcer: Intercepting", "request", ri)
 
-	if ri.MetaInfo == nil {
-		return mid.RPCErrString(req, "missing MetaInfo")
-	}
+	// Sessions that aren't used by the Autopilot don't carry any meta
+	// information and only have session wide rules, which apply to all
+	// requests regardless of the feature.
+	sessionRulesOnly := ri.MetaInfo == nil &&
+		len(ri.Rules.FeatureRules) == 0
 
-	// Ensure that the specified feature name is one listed in the macaroon.
-	featureName := ri.MetaInfo.Feature
-	_, ok := ri.Rules.FeatureRules[featureName]
-	if len(ri.Rules.FeatureRules) != 0 && !ok {
-		return mid.RPCErrString(req, "feature %s does not correspond "+
-			"to a feature specified in the macaroon caveat",
-			featureName)
-	}
-
-	// Ensure that the feature specified in the MetaInfo is one that we
-	// know about from our last interaction with the Autopilot server.
-	featurePerms, err := r.getFeaturePerms(ctx)
-	if err != nil {
-		return mid.RPCErrString(req, "unable to get feature "+
-			"permissions")
-	}
-
-	perms, ok := featurePerms[featureName]
-	if !ok {
-		return mid.RPCErrString(req, "feature %s is not a known "+
-			"feature", featureName)
-	}
+	if !sessionRulesOnly {
+		if err := r.checkFeaturePerms(ctx, ri); err != nil {
+			if ri.MWRequestType == MWRequestTypeRequest {
+				r.audit(ri, nil, err)
+			}
 
-	// Then check that this URI is allowed given the list of perms the
-	// Autopilot told us this feature could use.
-	if !perms[ri.URI] {
-		return mid.RPCErrString(req, "Method %s is not allowed for "+
-			"feature %s", ri.URI, featureName)
+			return mid.RPCErr(req, err)
+		}
 	}
 
 	switch ri.MWRequestType {
@@ -156,13 +155,18 @@ func (r *RuleEnforcer) Intercept(ctx context.Context,
 
 	// Parse incoming requests and act on them.
 	case MWRequestTypeRequest:
-		// Support for streaming requests is not yet implemented.
-		if ri.Streaming {
-			return mid.RPCErrString(req, "streaming requests not "+
-				"supported")
+		// Support for streaming requests is not yet implemented for
+		// feature rules. Session wide rules handle each message of a
+		// stream on its own.
+		if ri.Streaming && !sessionRulesOnly {
+			err := errors.New("streaming requests not supported")
+			r.audit(ri, nil, err)
+
+			return mid.RPCErr(req, err)
 		}
 
-		replacement, err := r.handleRequest(ctx, ri)
+		replacement, violatedRules, err := r.handleRequest(ctx, ri)
+		r.audit(ri, violatedRules, err)
 		if err != nil {
 			dbErr := r.markActionErrored(ri.RequestID, err.Error())
 			if dbErr != nil {
@@ -228,33 +232,136 @@ func (r *RuleEnforcer) Intercept(ctx context.Context,
 	}
 }
 
+// checkFeaturePerms makes sure that the feature given in the meta information
+// of the request is one that the macaroon was created for and that the feature
+// is allowed to call the URI of the request.
+func (r *RuleEnforcer) checkFeaturePerms(ctx context.Context,
+	ri *RequestInfo) error {
+
+	if ri.MetaInfo == nil {
+		return fmt.Errorf("missing MetaInfo")
+	}
+
+	// Ensure that the specified feature name is one listed in the macaroon.
+	// The macaroon lists exactly the features that the session was
+	// registered with, so the Autopilot may not use any other feature,
+	// even if it is one that it offers. Macaroons without any feature
+	// rules don't restrict the features, which is what sessions created
+	// before had.
+	featureName := ri.MetaInfo.Feature
+	_, ok := ri.Rules.FeatureRules[featureName]
+	if len(ri.Rules.FeatureRules) != 0 && !ok {
+		log.WarnS(ctx, "Autopilot tried to use a feature the session "+
+			"was not registered for", nil, "feature", featureName,
+			"uri", ri.URI)
+
+		return fmt.Errorf("feature %s does not correspond to a "+
+			"feature specified in the macaroon caveat", featureName)
+	}
+
+	// Ensure that the feature specified in the MetaInfo is one that we
+	// know about from our last interaction with the Autopilot server.
+	featurePerms, err := r.getFeaturePerms(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get feature permissions")
+	}
+
+	perms, ok := featurePerms[featureName]
+	if !ok {
+		return fmt.Errorf("feature %s is not a known feature",
+			featureName)
+	}
+
+	// Then check that this URI is allowed given the list of perms the
+	// Autopilot told us this feature could use.
+	if !perms[ri.URI] {
+		return fmt.Errorf("method %s is not allowed for feature %s",
+			ri.URI, featureName)
+	}
+
+	return nil
+}
+
+// audit records the decision for the given request in the metrics and the
+// audit log. The request was allowed if reqErr is nil, otherwise it was denied
+// by the given rules and the denial is also passed to the onDenied callback.
+func (r *RuleEnforcer) audit(ri *RequestInfo, violatedRules []string,
+	reqErr error) {
+
+	sessionID, err := session.IDFromMacaroon(ri.Macaroon)
+	if err != nil {
+		log.Warnf("Unable to record decision for %s: could not "+
+			"extract ID from macaroon: %v", ri.URI, err)
+
+		return
+	}
+
+	decision := firewalldb.AuditDecisionAllowed
+	if reqErr != nil {
+		decision = firewalldb.AuditDecisionDenied
+	}
+
+	decisions.WithLabelValues(
+		decision.String(), hex.EncodeToString(sessionID[:]),
+	).Inc()
+
+	if r.auditLog == nil && (reqErr == nil || r.onDenied == nil) {
+		return
+	}
+
+	entry := &firewalldb.AuditEntry{
+		Timestamp: time.Now(),
+		SessionID: sessionID,
+		URI:       ri.URI,
+		Decision:  decision,
+	}
+	if reqErr != nil {
+		entry.RuleNames = violatedRules
+		entry.Reason = reqErr.Error()
+
+		if r.onDenied != nil {
+			r.onDenied(entry)
+		}
+	}
+
+	if r.auditLog != nil {
+		r.auditLog.Log(entry)
+	}
+}
+
 // handleRequest gathers the rules that will need to enforced for the given
-// feature and runs the request against each of those.
+// feature and runs the request against each of those. If any of the rules
+// deny the request, their names are returned along with the error.
 func (r *RuleEnforcer) handleRequest(ctx context.Context,
-	ri *RequestInfo) (proto.Message, error) {
+	ri *RequestInfo) (proto.Message, []string, error) {
 
 	sessionID, err := session.IDFromMacaroon(ri.Macaroon)
 	if err != nil {
-		return nil, fmt.Errorf("could not extract ID from macaroon")
+		return nil, nil, fmt.Errorf("could not extract ID from " +
+			"macaroon")
 	}
 
 	rules, err := r.collectEnforcers(ctx, ri, sessionID)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing rules: %v", err)
+		return nil, nil, fmt.Errorf("error parsing rules: %v", err)
 	}
 
 	msg, err := mid.ParseProtobuf(
 		ri.GRPCMessageType, ri.Serialized,
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error parsing proto: %v", err)
+		return nil, nil, fmt.Errorf("error parsing proto: %v", err)
 	}
 
-	var errs []error
+	var (
+		errs          []error
+		violatedRules []string
+	)
 	for _, rule := range rules {
 		newRequest, err := rule.HandleRequest(ctx, ri.URI, msg)
 		if err != nil {
 			errs = append(errs, err)
+			violatedRules = append(violatedRules, rule.name)
 			continue
 		}
 
@@ -277,11 +384,13 @@ func (r *RuleEnforcer) handleRequest(ctx context.Context,
 		}
 
 		// We join any errors to report all rule violations.
-		return nil, status.Errorf(codes.ResourceExhausted,
-			"rule violation: %s", errors.Join(errs...))
+		return nil, violatedRules, status.Errorf(
+			codes.ResourceExhausted, "rule violation: %s",
+			errors.Join(errs...),
+		)
 	}
 
-	return msg, nil
+	return msg, nil, nil
 }
 
 // handleResponse gathers the rules that will need to be enforced for the given
@@ -351,16 +460,42 @@ func (r *RuleEnforcer) handleErrorResponse(ctx context.Context,
 	return parsedErr, nil
 }
 
+// namedEnforcer is a rule Enforcer along with the name of its rule.
+type namedEnforcer struct {
+	rules.Enforcer
+
+	name string
+}
+
 // collectRule initialises and returns all the Rules that need to be enforced
 // for the given request.
 func (r *RuleEnforcer) collectEnforcers(ctx context.Context, ri *RequestInfo,
-	sessionID session.ID) ([]rules.Enforcer, error) {
+	sessionID session.ID) ([]namedEnforcer, error) {
 
 	ruleEnforcers := make(
-		[]rules.Enforcer, 0,
+		[]namedEnforcer, 0,
 		len(ri.Rules.FeatureRules)+len(ri.Rules.SessionRules),
 	)
 
+	for rule, value := range ri.Rules.SessionRules {
+		r, err := r.initRule(
+			ctx, ri.RequestID, rule, []byte(value), "", sessionID,
+			true, ri.WithPrivacy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleEnforcers = append(ruleEnforcers, namedEnforcer{
+			Enforcer: r,
+			name:     rule,
+		})
+	}
+
+	if ri.MetaInfo == nil {
+		return ruleEnforcers, nil
+	}
+
 	for rule, value := range ri.Rules.FeatureRules[ri.MetaInfo.Feature] {
 		r, err := r.initRule(
 			ctx, ri.RequestID, rule, []byte(value),
@@ -370,7 +505,10 @@ func (r *RuleEnforcer) collectEnforcers(ctx context.Context, ri *RequestInfo,
 			return nil, err
 		}
 
-		ruleEnforcers = append(ruleEnforcers, r)
+		ruleEnforcers = append(ruleEnforcers, namedEnforcer{
+			Enforcer: r,
+			name:     rule,
+		})
 	}
 
 	return ruleEnforcers, nil
@@ -0,0 +1,86 @@
+package firewall
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestCheckFeaturePerms tests that the Autopilot can only use the features a
+// session was registered for, while sessions without any feature rules aren't
+// restricted to specific features.
+func TestCheckFeaturePerms(t *testing.T) {
+	t.Parallel()
+
+	const uri = "/lnrpc.Lightning/GetInfo"
+
+	enforcer := &RuleEnforcer{
+		getFeaturePerms: func(context.Context) (
+			map[string]map[string]bool, error) {
+
+			return map[string]map[string]bool{
+				"AutoFees":    {uri: true},
+				"HealthCheck": {uri: true},
+			}, nil
+		},
+	}
+
+	tests := []struct {
+		name         string
+		featureRules map[string]map[string]string
+		feature      string
+		expectedErr  string
+	}{{
+		name:    "no feature rules",
+		feature: "AutoFees",
+	}, {
+		name:         "empty feature rules",
+		featureRules: map[string]map[string]string{},
+		feature:      "HealthCheck",
+	}, {
+		name: "feature present",
+		featureRules: map[string]map[string]string{
+			"AutoFees": {},
+		},
+		feature: "AutoFees",
+	}, {
+		name: "feature missing",
+		featureRules: map[string]map[string]string{
+			"AutoFees": {},
+		},
+		feature: "HealthCheck",
+		expectedErr: "feature HealthCheck does not correspond to a " +
+			"feature specified in the macaroon caveat",
+	}, {
+		name:        "unknown feature",
+		feature:     "Unknown",
+		expectedErr: "feature Unknown is not a known feature",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ri := &RequestInfo{
+				URI: uri,
+				MetaInfo: &InterceptMetaInfo{
+					Feature: test.feature,
+				},
+				Rules: &InterceptRules{
+					FeatureRules: test.featureRules,
+				},
+			}
+
+			err := enforcer.checkFeaturePerms(
+				context.Background(), ri,
+			)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package firewalldb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/tlv"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	typeAuditTimestamp tlv.Type = 1
+	typeAuditSessionID tlv.Type = 2
+	typeAuditURI       tlv.Type = 3
+	typeAuditDecision  tlv.Type = 4
+	typeAuditRuleNames tlv.Type = 5
+	typeAuditReason    tlv.Type = 6
+)
+
+/*
+	The audit log is stored in the following structure in the KV db:
+
+	audit-log-bucket -> <entry-index> -> serialised audit entry
+
+	The entries are only ever appended, so the order of the entry indexes is
+	the order in which the decisions were written.
+*/
+
+var (
+	// auditLogBucketKey is the key that will be used for the audit log
+	// bucket.
+	auditLogBucketKey = []byte("audit-log-bucket")
+)
+
+// AuditDecision is the decision the firewall made for a request.
+type AuditDecision uint8
+
+const (
+	// AuditDecisionUnknown means that the decision was never initialised.
+	// This should never be the case.
+	AuditDecisionUnknown AuditDecision = 0
+
+	// AuditDecisionAllowed means that the request was passed on.
+	AuditDecisionAllowed AuditDecision = 1
+
+	// AuditDecisionDenied means that the request was rejected.
+	AuditDecisionDenied AuditDecision = 2
+)
+
+// String returns a human-readable representation of the decision.
+func (d AuditDecision) String() string {
+	switch d {
+	case AuditDecisionAllowed:
+		return "allowed"
+
+	case AuditDecisionDenied:
+		return "denied"
+
+	default:
+		return "unknown"
+	}
+}
+
+// AuditEntry records the decision the firewall made for a single request of a
+// session.
+type AuditEntry struct {
+	// Timestamp is the time at which the decision was made.
+	Timestamp time.Time
+
+	// SessionID is the ID of the session that made the request.
+	SessionID session.ID
+
+	// URI is the URI of the RPC method that was called.
+	URI string
+
+	// Decision is the decision the firewall made for the request.
+	Decision AuditDecision
+
+	// RuleNames are the names of the rules that denied the request. It is
+	// empty if the request was allowed or if it was denied for a reason
+	// other than a rule violation.
+	RuleNames []string
+
+	// Reason is the human-readable reason for why the request was denied.
+	// It will only be set if Decision is AuditDecisionDenied.
+	Reason string
+}
+
+// AuditLogQuery can be used to filter the entries returned by
+// ListAuditEntries.
+type AuditLogQuery struct {
+	// SessionID, if set, restricts the entries to those of the given
+	// session.
+	SessionID *session.ID
+
+	// StartTime, if set, restricts the entries to those that were made at
+	// or after the given time.
+	StartTime time.Time
+
+	// EndTime, if set, restricts the entries to those that were made at or
+	// before the given time.
+	EndTime time.Time
+
+	// MaxNum is the maximum number of entries to return. If it is set to
+	// 0, then no maximum is enforced.
+	MaxNum uint64
+}
+
+// matches returns true if the given entry passes the query's filters.
+func (q *AuditLogQuery) matches(e *AuditEntry) bool {
+	if q.SessionID != nil && e.SessionID != *q.SessionID {
+		return false
+	}
+
+	if !q.StartTime.IsZero() && e.Timestamp.Before(q.StartTime) {
+		return false
+	}
+
+	return q.EndTime.IsZero() || !e.Timestamp.After(q.EndTime)
+}
+
+// AddAuditEntries appends the given entries to the audit log in a single
+// transaction.
+func (db *BoltDB) AddAuditEntries(entries []*AuditEntry) error {
+	return db.DB.Update(func(tx *bbolt.Tx) error {
+		auditBucket, err := getBucket(tx, auditLogBucketKey)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			var buf bytes.Buffer
+			if err := serializeAuditEntry(&buf, entry); err != nil {
+				return err
+			}
+
+			nextIndex, err := auditBucket.NextSequence()
+			if err != nil {
+				return err
+			}
+
+			var index [8]byte
+			byteOrder.PutUint64(index[:], nextIndex)
+			err = auditBucket.Put(index[:], buf.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ListAuditEntries returns the entries of the audit log that pass the query's
+// filters, in the order in which they were written.
+func (db *BoltDB) ListAuditEntries(query *AuditLogQuery) ([]*AuditEntry,
+	error) {
+
+	if query == nil {
+		query = &AuditLogQuery{}
+	}
+
+	var entries []*AuditEntry
+	err := db.View(func(tx *bbolt.Tx) error {
+		auditBucket, err := getBucket(tx, auditLogBucketKey)
+		if err != nil {
+			return err
+		}
+
+		cursor := auditBucket.Cursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			entry, err := deserializeAuditEntry(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			if !query.matches(entry) {
+				continue
+			}
+
+			entries = append(entries, entry)
+
+			if query.MaxNum != 0 &&
+				uint64(len(entries)) >= query.MaxNum {
+
+				return nil
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// serializeAuditEntry serializes an audit entry into the given writer using
+// the tlv format.
+func serializeAuditEntry(w io.Writer, entry *AuditEntry) error {
+	if entry == nil {
+		return fmt.Errorf("audit entry cannot be nil")
+	}
+
+	var (
+		timestamp = uint64(entry.Timestamp.UnixNano())
+		sessionID = entry.SessionID[:]
+		uri       = []byte(entry.URI)
+		decision  = uint8(entry.Decision)
+		ruleNames = []byte(strings.Join(entry.RuleNames, ","))
+		reason    = []byte(entry.Reason)
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeAuditTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeAuditSessionID, &sessionID),
+		tlv.MakePrimitiveRecord(typeAuditURI, &uri),
+		tlv.MakePrimitiveRecord(typeAuditDecision, &decision),
+		tlv.MakePrimitiveRecord(typeAuditRuleNames, &ruleNames),
+		tlv.MakePrimitiveRecord(typeAuditReason, &reason),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// deserializeAuditEntry deserializes an audit entry from the given reader,
+// expecting the data to be encoded in the tlv format.
+func deserializeAuditEntry(r io.Reader) (*AuditEntry, error) {
+	var (
+		timestamp uint64
+		sessionID []byte
+		uri       []byte
+		decision  uint8
+		ruleNames []byte
+		reason    []byte
+	)
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeAuditTimestamp, &timestamp),
+		tlv.MakePrimitiveRecord(typeAuditSessionID, &sessionID),
+		tlv.MakePrimitiveRecord(typeAuditURI, &uri),
+		tlv.MakePrimitiveRecord(typeAuditDecision, &decision),
+		tlv.MakePrimitiveRecord(typeAuditRuleNames, &ruleNames),
+		tlv.MakePrimitiveRecord(typeAuditReason, &reason),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = tlvStream.DecodeWithParsedTypes(r)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := session.IDFromBytes(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &AuditEntry{
+		Timestamp: time.Unix(0, int64(timestamp)),
+		SessionID: id,
+		URI:       string(uri),
+		Decision:  AuditDecision(decision),
+		Reason:    string(reason),
+	}
+	if len(ruleNames) != 0 {
+		entry.RuleNames = strings.Split(string(ruleNames), ",")
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,90 @@
+package firewalldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuditLogStorage tests that audit entries are stored in the order in
+// which they were added and that they can be filtered by session and time.
+func TestAuditLogStorage(t *testing.T) {
+	db := NewTestDB(t)
+
+	entries, err := db.ListAuditEntries(nil)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	entry1 := &AuditEntry{
+		Timestamp: time.Unix(100, 0),
+		SessionID: sessionID1,
+		URI:       "/lnrpc.Lightning/GetInfo",
+		Decision:  AuditDecisionAllowed,
+	}
+	entry2 := &AuditEntry{
+		Timestamp: time.Unix(200, 500),
+		SessionID: sessionID2,
+		URI:       "/routerrpc.Router/SendPaymentV2",
+		Decision:  AuditDecisionDenied,
+		RuleNames: []string{"session-budget", "channel-restriction"},
+		Reason:    "rule violation: budget exceeded",
+	}
+	entry3 := &AuditEntry{
+		Timestamp: time.Unix(300, 0),
+		SessionID: sessionID1,
+		URI:       "/lnrpc.Lightning/ListChannels",
+		Decision:  AuditDecisionDenied,
+		Reason:    "feature is not allowed to call the method",
+	}
+
+	require.NoError(t, db.AddAuditEntries([]*AuditEntry{entry1, entry2}))
+	require.NoError(t, db.AddAuditEntries([]*AuditEntry{entry3}))
+
+	assertEntries := func(query *AuditLogQuery, expected ...*AuditEntry) {
+		t.Helper()
+
+		entries, err := db.ListAuditEntries(query)
+		require.NoError(t, err)
+		require.Len(t, entries, len(expected))
+
+		for i, e := range expected {
+			require.True(t, e.Timestamp.Equal(entries[i].Timestamp))
+
+			// The timestamps are compared above, as the location
+			// of the time isn't persisted.
+			entries[i].Timestamp = e.Timestamp
+			require.Equal(t, e, entries[i])
+		}
+	}
+
+	// Without a filter, all entries are returned in order.
+	assertEntries(nil, entry1, entry2, entry3)
+
+	// Filter by session.
+	assertEntries(&AuditLogQuery{SessionID: &sessionID1}, entry1, entry3)
+	assertEntries(&AuditLogQuery{SessionID: &sessionID2}, entry2)
+
+	// Filter by time range, both bounds are inclusive.
+	assertEntries(&AuditLogQuery{
+		StartTime: time.Unix(200, 500),
+	}, entry2, entry3)
+	assertEntries(&AuditLogQuery{
+		EndTime: time.Unix(200, 500),
+	}, entry1, entry2)
+	assertEntries(&AuditLogQuery{
+		StartTime: time.Unix(150, 0),
+		EndTime:   time.Unix(250, 0),
+	}, entry2)
+	assertEntries(&AuditLogQuery{
+		SessionID: &sessionID1,
+		StartTime: time.Unix(150, 0),
+	}, entry3)
+
+	// Limit the number of entries.
+	assertEntries(&AuditLogQuery{MaxNum: 2}, entry1, entry2)
+	assertEntries(&AuditLogQuery{
+		SessionID: &sessionID1,
+		MaxNum:    1,
+	}, entry1)
+}
@@ -133,6 +133,11 @@ func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
 			return err
 		}
 
+		_, err = tx.CreateBucketIfNotExists(auditLogBucketKey)
+		if err != nil {
+			return err
+		}
+
 		_, err = tx.CreateBucketIfNotExists(privacyBucketKey)
 		return err
 	})
@@ -11,6 +11,7 @@ type mockSessionDB struct {
 	sessionToGroupID  map[session.ID]session.ID
 	groupToSessionIDs map[session.ID][]session.ID
 	privacyFlags      map[session.ID]session.PrivacyFlags
+	amountObfuscation map[session.ID]session.AmountObfuscation
 }
 
 var _ SessionDB = (*mockSessionDB)(nil)
@@ -21,6 +22,9 @@ func NewMockSessionDB() *mockSessionDB {
 		sessionToGroupID:  make(map[session.ID]session.ID),
 		groupToSessionIDs: make(map[session.ID][]session.ID),
 		privacyFlags:      make(map[session.ID]session.PrivacyFlags),
+		amountObfuscation: make(
+			map[session.ID]session.AmountObfuscation,
+		),
 	}
 }
 
@@ -72,7 +76,11 @@ func (m *mockSessionDB) GetSession(_ context.Context,
 		return nil, fmt.Errorf("no privacy flags found for session ID")
 	}
 
-	return &session.Session{GroupID: s, PrivacyFlags: f}, nil
+	return &session.Session{
+		GroupID:           s,
+		PrivacyFlags:      f,
+		AmountObfuscation: m.amountObfuscation[id],
+	}, nil
 }
 
 // AddPrivacyFlags is a helper that adds privacy flags to the mock session db.
@@ -83,3 +91,11 @@ func (m *mockSessionDB) AddPrivacyFlags(sessionID session.ID,
 
 	return nil
 }
+
+// AddAmountObfuscation is a helper that adds an amount obfuscation config to
+// the mock session db.
+func (m *mockSessionDB) AddAmountObfuscation(sessionID session.ID,
+	cfg session.AmountObfuscation) {
+
+	m.amountObfuscation[sessionID] = cfg
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -232,6 +233,58 @@ func (p *privacyMapTx) FetchAllPairs(_ context.Context) (*PrivacyMapPairs,
 	return NewPrivacyMapPairs(pairs), nil
 }
 
+// ImportPairs persists the given real-to-pseudo pairs, for example to restore
+// a privacy map that was exported from another litd instance. Pairs that
+// already exist are skipped. If a real or pseudo value of any of the pairs is
+// already mapped to a different value, then an error is returned. The number
+// of newly added pairs is returned.
+func ImportPairs(ctx context.Context, tx PrivacyMapTx,
+	pairs map[string]string) (int, error) {
+
+	// Check all pairs before adding any of them so that the caller can
+	// easily tell which pair is conflicting.
+	newPairs := make(map[string]string, len(pairs))
+	for realStr, pseudoStr := range pairs {
+		if realStr == "" || pseudoStr == "" {
+			return 0, fmt.Errorf("real and pseudo values must " +
+				"be set")
+		}
+
+		pseudo, err := tx.RealToPseudo(ctx, realStr)
+		switch {
+		case err == nil && pseudo == pseudoStr:
+			continue
+
+		case err == nil:
+			return 0, fmt.Errorf("real value %s is already mapped "+
+				"to a different pseudo value", realStr)
+
+		case !errors.Is(err, ErrNoSuchKeyFound):
+			return 0, err
+		}
+
+		_, err = tx.PseudoToReal(ctx, pseudoStr)
+		switch {
+		case err == nil:
+			return 0, fmt.Errorf("pseudo value %s is already "+
+				"mapped to a different real value", pseudoStr)
+
+		case !errors.Is(err, ErrNoSuchKeyFound):
+			return 0, err
+		}
+
+		newPairs[realStr] = pseudoStr
+	}
+
+	for realStr, pseudoStr := range newPairs {
+		if err := tx.NewPair(ctx, realStr, pseudoStr); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(newPairs), nil
+}
+
 func HideString(ctx context.Context, tx PrivacyMapTx, real string) (string,
 	error) {
 
@@ -502,6 +555,19 @@ func (p *PrivacyMapPairs) GetPseudo(real string) (string, bool) {
 	return pseudo, ok
 }
 
+// Pairs returns a copy of all the real-to-pseudo pairs.
+func (p *PrivacyMapPairs) Pairs() map[string]string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pairs := make(map[string]string, len(p.pairs))
+	for realStr, pseudoStr := range p.pairs {
+		pairs[realStr] = pseudoStr
+	}
+
+	return pairs
+}
+
 // Add adds the passed set of real-to-pseudo pairs to the PrivacyMapPairs
 // structure. It will throw an error if the new pairs conflict with any of the
 // existing pairs.
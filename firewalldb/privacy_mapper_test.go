@@ -223,3 +223,92 @@ func TestPrivacyMapTxs(t *testing.T) {
 	})
 	require.ErrorIs(t, err, ErrNoSuchKeyFound)
 }
+
+// TestPrivacyMapImportPairs tests that exported privacy map pairs can be
+// imported into another group's privacy map and that conflicting pairs are
+// rejected.
+func TestPrivacyMapImportPairs(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tmpDir := t.TempDir()
+	db, err := NewBoltDB(tmpDir, "test.db", nil)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	pdb1 := db.PrivacyDB([4]byte{1, 1, 1, 1})
+	pdb2 := db.PrivacyDB([4]byte{2, 2, 2, 2})
+
+	// Populate the first privacy map and export its pairs.
+	var exported map[string]string
+	err = pdb1.Update(ctx, func(ctx context.Context,
+		tx PrivacyMapTx) error {
+
+		for i := 0; i < 5; i++ {
+			_, err := HideString(ctx, tx, fmt.Sprintf("real%d", i))
+			if err != nil {
+				return err
+			}
+		}
+
+		pairs, err := tx.FetchAllPairs(ctx)
+		if err != nil {
+			return err
+		}
+		exported = pairs.Pairs()
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, exported, 5)
+
+	// Importing the pairs into the second privacy map adds all of them.
+	// Importing them a second time is a no-op.
+	for _, expectedNum := range []int{5, 0} {
+		err = pdb2.Update(ctx, func(ctx context.Context,
+			tx PrivacyMapTx) error {
+
+			num, err := ImportPairs(ctx, tx, exported)
+			require.Equal(t, expectedNum, num)
+
+			return err
+		})
+		require.NoError(t, err)
+	}
+
+	err = pdb2.View(ctx, func(ctx context.Context, tx PrivacyMapTx) error {
+		pairs, err := tx.FetchAllPairs(ctx)
+		require.NoError(t, err)
+		require.Equal(t, exported, pairs.Pairs())
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	// A real or pseudo value that is already mapped to a different value
+	// can't be imported. Nothing is imported in that case.
+	conflicts := []map[string]string{
+		{"new-real": "new-pseudo", "real0": "other-pseudo"},
+		{"new-real": "new-pseudo", "other-real": exported["real0"]},
+		{"new-real": ""},
+	}
+	for _, pairs := range conflicts {
+		err = pdb2.Update(ctx, func(ctx context.Context,
+			tx PrivacyMapTx) error {
+
+			_, err := ImportPairs(ctx, tx, pairs)
+			return err
+		})
+		require.Error(t, err)
+
+		err = pdb2.View(ctx, func(ctx context.Context,
+			tx PrivacyMapTx) error {
+
+			_, err := tx.RealToPseudo(ctx, "new-real")
+			return err
+		})
+		require.ErrorIs(t, err, ErrNoSuchKeyFound)
+	}
+}
@@ -34,6 +34,34 @@ type FirewallClient interface {
 	// PrivacyMapConversion can be used map real values to their pseudo
 	// counterpart and vice versa.
 	PrivacyMapConversion(ctx context.Context, in *PrivacyMapConversionRequest, opts ...grpc.CallOption) (*PrivacyMapConversionResponse, error)
+	// litcli: `firewall replay`
+	// ReplayActions re-evaluates the past actions of a session against a proposed
+	// set of rules in dry-run mode and reports the actions for which the new rules
+	// would come to a different decision. Replaying has no effect on the rules
+	// that are enforced for the session. Only actions that were recorded with
+	// their request parameters can be replayed.
+	ReplayActions(ctx context.Context, in *ReplayActionsRequest, opts ...grpc.CallOption) (*ReplayActionsResponse, error)
+	// litcli: `firewall audit`
+	// FirewallAuditLog returns the decisions the firewall made for the requests
+	// of sessions that are subject to firewall rules, including the names of the
+	// rules that denied a request. The entries are returned in the order in which
+	// they were recorded.
+	FirewallAuditLog(ctx context.Context, in *FirewallAuditLogRequest, opts ...grpc.CallOption) (*FirewallAuditLogResponse, error)
+	// litcli: `privacy export`
+	// ExportPrivacyMapping returns all real-pseudo pairs of the privacy map of a
+	// session group, for example to restore them after moving to another litd
+	// instance. This includes the channel IDs, channel points and node pubkeys
+	// that were obfuscated for the group. Amounts are randomized on every request
+	// and are not stored, so they are not part of the export. Since the export
+	// reveals the de-anonymized values, it is only available if litd is started
+	// with `--firewall.allow-privacy-export` and every export is logged.
+	ExportPrivacyMapping(ctx context.Context, in *ExportPrivacyMappingRequest, opts ...grpc.CallOption) (*ExportPrivacyMappingResponse, error)
+	// litcli: `privacy import`
+	// ImportPrivacyMapping restores real-pseudo pairs from an export into the
+	// privacy map of a session group. Pairs that already exist are skipped. If a
+	// real or pseudo value is already mapped to a different value, nothing is
+	// imported.
+	ImportPrivacyMapping(ctx context.Context, in *ImportPrivacyMappingRequest, opts ...grpc.CallOption) (*ImportPrivacyMappingResponse, error)
 }
 
 type firewallClient struct {
@@ -62,6 +90,42 @@ func (c *firewallClient) PrivacyMapConversion(ctx context.Context, in *PrivacyMa
 	return out, nil
 }
 
+func (c *firewallClient) ReplayActions(ctx context.Context, in *ReplayActionsRequest, opts ...grpc.CallOption) (*ReplayActionsResponse, error) {
+	out := new(ReplayActionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Firewall/ReplayActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallClient) FirewallAuditLog(ctx context.Context, in *FirewallAuditLogRequest, opts ...grpc.CallOption) (*FirewallAuditLogResponse, error) {
+	out := new(FirewallAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Firewall/FirewallAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallClient) ExportPrivacyMapping(ctx context.Context, in *ExportPrivacyMappingRequest, opts ...grpc.CallOption) (*ExportPrivacyMappingResponse, error) {
+	out := new(ExportPrivacyMappingResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Firewall/ExportPrivacyMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *firewallClient) ImportPrivacyMapping(ctx context.Context, in *ImportPrivacyMappingRequest, opts ...grpc.CallOption) (*ImportPrivacyMappingResponse, error) {
+	out := new(ImportPrivacyMappingResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Firewall/ImportPrivacyMapping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FirewallServer is the server API for Firewall service.
 // All implementations must embed UnimplementedFirewallServer
 // for forward compatibility
@@ -82,6 +146,34 @@ type FirewallServer interface {
 	// PrivacyMapConversion can be used map real values to their pseudo
 	// counterpart and vice versa.
 	PrivacyMapConversion(context.Context, *PrivacyMapConversionRequest) (*PrivacyMapConversionResponse, error)
+	// litcli: `firewall replay`
+	// ReplayActions re-evaluates the past actions of a session against a proposed
+	// set of rules in dry-run mode and reports the actions for which the new rules
+	// would come to a different decision. Replaying has no effect on the rules
+	// that are enforced for the session. Only actions that were recorded with
+	// their request parameters can be replayed.
+	ReplayActions(context.Context, *ReplayActionsRequest) (*ReplayActionsResponse, error)
+	// litcli: `firewall audit`
+	// FirewallAuditLog returns the decisions the firewall made for the requests
+	// of sessions that are subject to firewall rules, including the names of the
+	// rules that denied a request. The entries are returned in the order in which
+	// they were recorded.
+	FirewallAuditLog(context.Context, *FirewallAuditLogRequest) (*FirewallAuditLogResponse, error)
+	// litcli: `privacy export`
+	// ExportPrivacyMapping returns all real-pseudo pairs of the privacy map of a
+	// session group, for example to restore them after moving to another litd
+	// instance. This includes the channel IDs, channel points and node pubkeys
+	// that were obfuscated for the group. Amounts are randomized on every request
+	// and are not stored, so they are not part of the export. Since the export
+	// reveals the de-anonymized values, it is only available if litd is started
+	// with `--firewall.allow-privacy-export` and every export is logged.
+	ExportPrivacyMapping(context.Context, *ExportPrivacyMappingRequest) (*ExportPrivacyMappingResponse, error)
+	// litcli: `privacy import`
+	// ImportPrivacyMapping restores real-pseudo pairs from an export into the
+	// privacy map of a session group. Pairs that already exist are skipped. If a
+	// real or pseudo value is already mapped to a different value, nothing is
+	// imported.
+	ImportPrivacyMapping(context.Context, *ImportPrivacyMappingRequest) (*ImportPrivacyMappingResponse, error)
 	mustEmbedUnimplementedFirewallServer()
 }
 
@@ -95,6 +187,18 @@ func (UnimplementedFirewallServer) ListActions(context.Context, *ListActionsRequ
 func (UnimplementedFirewallServer) PrivacyMapConversion(context.Context, *PrivacyMapConversionRequest) (*PrivacyMapConversionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PrivacyMapConversion not implemented")
 }
+func (UnimplementedFirewallServer) ReplayActions(context.Context, *ReplayActionsRequest) (*ReplayActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayActions not implemented")
+}
+func (UnimplementedFirewallServer) FirewallAuditLog(context.Context, *FirewallAuditLogRequest) (*FirewallAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FirewallAuditLog not implemented")
+}
+func (UnimplementedFirewallServer) ExportPrivacyMapping(context.Context, *ExportPrivacyMappingRequest) (*ExportPrivacyMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPrivacyMapping not implemented")
+}
+func (UnimplementedFirewallServer) ImportPrivacyMapping(context.Context, *ImportPrivacyMappingRequest) (*ImportPrivacyMappingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportPrivacyMapping not implemented")
+}
 func (UnimplementedFirewallServer) mustEmbedUnimplementedFirewallServer() {}
 
 // UnsafeFirewallServer may be embedded to opt out of forward compatibility for this service.
@@ -144,6 +248,78 @@ func _Firewall_PrivacyMapConversion_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Firewall_ReplayActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServer).ReplayActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Firewall/ReplayActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServer).ReplayActions(ctx, req.(*ReplayActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Firewall_FirewallAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FirewallAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServer).FirewallAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Firewall/FirewallAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServer).FirewallAuditLog(ctx, req.(*FirewallAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Firewall_ExportPrivacyMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPrivacyMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServer).ExportPrivacyMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Firewall/ExportPrivacyMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServer).ExportPrivacyMapping(ctx, req.(*ExportPrivacyMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Firewall_ImportPrivacyMapping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportPrivacyMappingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FirewallServer).ImportPrivacyMapping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Firewall/ImportPrivacyMapping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FirewallServer).ImportPrivacyMapping(ctx, req.(*ImportPrivacyMappingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Firewall_ServiceDesc is the grpc.ServiceDesc for Firewall service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -159,6 +335,22 @@ var Firewall_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PrivacyMapConversion",
 			Handler:    _Firewall_PrivacyMapConversion_Handler,
 		},
+		{
+			MethodName: "ReplayActions",
+			Handler:    _Firewall_ReplayActions_Handler,
+		},
+		{
+			MethodName: "FirewallAuditLog",
+			Handler:    _Firewall_FirewallAuditLog_Handler,
+		},
+		{
+			MethodName: "ExportPrivacyMapping",
+			Handler:    _Firewall_ExportPrivacyMapping_Handler,
+		},
+		{
+			MethodName: "ImportPrivacyMapping",
+			Handler:    _Firewall_ImportPrivacyMapping_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "firewall.proto",
@@ -20,6 +20,494 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SandboxFilter int32
+
+const (
+	// Sandbox accounts are listed along with all other accounts.
+	SandboxFilter_SANDBOX_INCLUDE SandboxFilter = 0
+	// Sandbox accounts are excluded.
+	SandboxFilter_SANDBOX_EXCLUDE SandboxFilter = 1
+	// Only sandbox accounts are listed.
+	SandboxFilter_SANDBOX_ONLY SandboxFilter = 2
+)
+
+// Enum value maps for SandboxFilter.
+var (
+	SandboxFilter_name = map[int32]string{
+		0: "SANDBOX_INCLUDE",
+		1: "SANDBOX_EXCLUDE",
+		2: "SANDBOX_ONLY",
+	}
+	SandboxFilter_value = map[string]int32{
+		"SANDBOX_INCLUDE": 0,
+		"SANDBOX_EXCLUDE": 1,
+		"SANDBOX_ONLY":    2,
+	}
+)
+
+func (x SandboxFilter) Enum() *SandboxFilter {
+	p := new(SandboxFilter)
+	*p = x
+	return p
+}
+
+func (x SandboxFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SandboxFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_accounts_proto_enumTypes[0].Descriptor()
+}
+
+func (SandboxFilter) Type() protoreflect.EnumType {
+	return &file_lit_accounts_proto_enumTypes[0]
+}
+
+func (x SandboxFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SandboxFilter.Descriptor instead.
+func (SandboxFilter) EnumDescriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{0}
+}
+
+type AccountSortField int32
+
+const (
+	// Accounts are listed in an internal order that doesn't change as accounts
+	// are created or removed.
+	AccountSortField_ACCOUNT_SORT_DEFAULT AccountSortField = 0
+	// Accounts are sorted by their current balance.
+	AccountSortField_ACCOUNT_SORT_BALANCE AccountSortField = 1
+	// Accounts are sorted by their expiration date. Accounts that don't expire
+	// are listed after all others in ascending order.
+	AccountSortField_ACCOUNT_SORT_EXPIRATION AccountSortField = 2
+	// Accounts are sorted by their label. Accounts without a label are listed
+	// before all others in ascending order.
+	AccountSortField_ACCOUNT_SORT_LABEL AccountSortField = 3
+	// Accounts are sorted by the time they were created.
+	AccountSortField_ACCOUNT_SORT_CREATED AccountSortField = 4
+)
+
+// Enum value maps for AccountSortField.
+var (
+	AccountSortField_name = map[int32]string{
+		0: "ACCOUNT_SORT_DEFAULT",
+		1: "ACCOUNT_SORT_BALANCE",
+		2: "ACCOUNT_SORT_EXPIRATION",
+		3: "ACCOUNT_SORT_LABEL",
+		4: "ACCOUNT_SORT_CREATED",
+	}
+	AccountSortField_value = map[string]int32{
+		"ACCOUNT_SORT_DEFAULT":    0,
+		"ACCOUNT_SORT_BALANCE":    1,
+		"ACCOUNT_SORT_EXPIRATION": 2,
+		"ACCOUNT_SORT_LABEL":      3,
+		"ACCOUNT_SORT_CREATED":    4,
+	}
+)
+
+func (x AccountSortField) Enum() *AccountSortField {
+	p := new(AccountSortField)
+	*p = x
+	return p
+}
+
+func (x AccountSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_accounts_proto_enumTypes[1].Descriptor()
+}
+
+func (AccountSortField) Type() protoreflect.EnumType {
+	return &file_lit_accounts_proto_enumTypes[1]
+}
+
+func (x AccountSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountSortField.Descriptor instead.
+func (AccountSortField) EnumDescriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{1}
+}
+
+type SortDirection int32
+
+const (
+	// The smallest value is listed first.
+	SortDirection_SORT_ASCENDING SortDirection = 0
+	// The largest value is listed first.
+	SortDirection_SORT_DESCENDING SortDirection = 1
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_ASCENDING",
+		1: "SORT_DESCENDING",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_ASCENDING":  0,
+		"SORT_DESCENDING": 1,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_accounts_proto_enumTypes[2].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_lit_accounts_proto_enumTypes[2]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{2}
+}
+
+type PaymentEventType int32
+
+const (
+	// The account service started tracking the payment.
+	PaymentEventType_PAYMENT_INITIATED PaymentEventType = 0
+	// lnd reported an update while the payment is still in flight.
+	PaymentEventType_PAYMENT_IN_FLIGHT PaymentEventType = 1
+	// The payment succeeded and the account was debited.
+	PaymentEventType_PAYMENT_SETTLED PaymentEventType = 2
+	// The payment failed.
+	PaymentEventType_PAYMENT_FAILED PaymentEventType = 3
+)
+
+// Enum value maps for PaymentEventType.
+var (
+	PaymentEventType_name = map[int32]string{
+		0: "PAYMENT_INITIATED",
+		1: "PAYMENT_IN_FLIGHT",
+		2: "PAYMENT_SETTLED",
+		3: "PAYMENT_FAILED",
+	}
+	PaymentEventType_value = map[string]int32{
+		"PAYMENT_INITIATED": 0,
+		"PAYMENT_IN_FLIGHT": 1,
+		"PAYMENT_SETTLED":   2,
+		"PAYMENT_FAILED":    3,
+	}
+)
+
+func (x PaymentEventType) Enum() *PaymentEventType {
+	p := new(PaymentEventType)
+	*p = x
+	return p
+}
+
+func (x PaymentEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_accounts_proto_enumTypes[3].Descriptor()
+}
+
+func (PaymentEventType) Type() protoreflect.EnumType {
+	return &file_lit_accounts_proto_enumTypes[3]
+}
+
+func (x PaymentEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentEventType.Descriptor instead.
+func (PaymentEventType) EnumDescriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{3}
+}
+
+type AccountLifecycleEventType int32
+
+const (
+	// The account was created.
+	AccountLifecycleEventType_LIFECYCLE_CREATED AccountLifecycleEventType = 0
+	// The settings or the balance of the account changed. Changes to the
+	// invoices and payments of the account that don't affect its balance are
+	// not reported.
+	AccountLifecycleEventType_LIFECYCLE_UPDATED AccountLifecycleEventType = 1
+	// The account was removed.
+	AccountLifecycleEventType_LIFECYCLE_REMOVED AccountLifecycleEventType = 2
+	// The account reached the expiry warning lead time before its expiration
+	// date.
+	AccountLifecycleEventType_LIFECYCLE_EXPIRING_SOON AccountLifecycleEventType = 3
+)
+
+// Enum value maps for AccountLifecycleEventType.
+var (
+	AccountLifecycleEventType_name = map[int32]string{
+		0: "LIFECYCLE_CREATED",
+		1: "LIFECYCLE_UPDATED",
+		2: "LIFECYCLE_REMOVED",
+		3: "LIFECYCLE_EXPIRING_SOON",
+	}
+	AccountLifecycleEventType_value = map[string]int32{
+		"LIFECYCLE_CREATED":       0,
+		"LIFECYCLE_UPDATED":       1,
+		"LIFECYCLE_REMOVED":       2,
+		"LIFECYCLE_EXPIRING_SOON": 3,
+	}
+)
+
+func (x AccountLifecycleEventType) Enum() *AccountLifecycleEventType {
+	p := new(AccountLifecycleEventType)
+	*p = x
+	return p
+}
+
+func (x AccountLifecycleEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountLifecycleEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_accounts_proto_enumTypes[4].Descriptor()
+}
+
+func (AccountLifecycleEventType) Type() protoreflect.EnumType {
+	return &file_lit_accounts_proto_enumTypes[4]
+}
+
+func (x AccountLifecycleEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountLifecycleEventType.Descriptor instead.
+func (AccountLifecycleEventType) EnumDescriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{4}
+}
+
+type AccountUpdateType int32
+
+const (
+	// The balance or the expiration date of the account changed.
+	AccountUpdateType_UPDATE_STATE AccountUpdateType = 0
+	// The account reached the expiry warning lead time before its expiration
+	// date. Sent once per expiration date, and right after the state when
+	// subscribing to an account that is already within its warning lead time.
+	AccountUpdateType_UPDATE_EXPIRING_SOON AccountUpdateType = 1
+)
+
+// Enum value maps for AccountUpdateType.
+var (
+	AccountUpdateType_name = map[int32]string{
+		0: "UPDATE_STATE",
+		1: "UPDATE_EXPIRING_SOON",
+	}
+	AccountUpdateType_value = map[string]int32{
+		"UPDATE_STATE":         0,
+		"UPDATE_EXPIRING_SOON": 1,
+	}
+)
+
+func (x AccountUpdateType) Enum() *AccountUpdateType {
+	p := new(AccountUpdateType)
+	*p = x
+	return p
+}
+
+func (x AccountUpdateType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountUpdateType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_accounts_proto_enumTypes[5].Descriptor()
+}
+
+func (AccountUpdateType) Type() protoreflect.EnumType {
+	return &file_lit_accounts_proto_enumTypes[5]
+}
+
+func (x AccountUpdateType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountUpdateType.Descriptor instead.
+func (AccountUpdateType) EnumDescriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{5}
+}
+
+type AccountEventType int32
+
+const (
+	// The account was created.
+	AccountEventType_ACCOUNT_CREATED AccountEventType = 0
+	// The balance of the account was set, credited or debited manually.
+	AccountEventType_ACCOUNT_BALANCE_UPDATED AccountEventType = 1
+	// The expiration date of the account was changed.
+	AccountEventType_ACCOUNT_EXPIRY_UPDATED AccountEventType = 2
+	// The max HTLC amount, the soft cap or the top-up policy of the account was
+	// changed.
+	AccountEventType_ACCOUNT_LIMITS_UPDATED AccountEventType = 3
+	// The label of the account was changed.
+	AccountEventType_ACCOUNT_LABEL_RENAMED AccountEventType = 4
+	// The account was removed.
+	AccountEventType_ACCOUNT_REMOVED AccountEventType = 5
+	// The account was topped up automatically by its top-up policy.
+	AccountEventType_ACCOUNT_TOPPED_UP AccountEventType = 6
+	// The account was created or overwritten by an import.
+	AccountEventType_ACCOUNT_IMPORTED AccountEventType = 7
+	// The root key of the account macaroon was replaced.
+	AccountEventType_ACCOUNT_MACAROON_ROTATED AccountEventType = 8
+)
+
+// Enum value maps for AccountEventType.
+var (
+	AccountEventType_name = map[int32]string{
+		0: "ACCOUNT_CREATED",
+		1: "ACCOUNT_BALANCE_UPDATED",
+		2: "ACCOUNT_EXPIRY_UPDATED",
+		3: "ACCOUNT_LIMITS_UPDATED",
+		4: "ACCOUNT_LABEL_RENAMED",
+		5: "ACCOUNT_REMOVED",
+		6: "ACCOUNT_TOPPED_UP",
+		7: "ACCOUNT_IMPORTED",
+		8: "ACCOUNT_MACAROON_ROTATED",
+	}
+	AccountEventType_value = map[string]int32{
+		"ACCOUNT_CREATED":          0,
+		"ACCOUNT_BALANCE_UPDATED":  1,
+		"ACCOUNT_EXPIRY_UPDATED":   2,
+		"ACCOUNT_LIMITS_UPDATED":   3,
+		"ACCOUNT_LABEL_RENAMED":    4,
+		"ACCOUNT_REMOVED":          5,
+		"ACCOUNT_TOPPED_UP":        6,
+		"ACCOUNT_IMPORTED":         7,
+		"ACCOUNT_MACAROON_ROTATED": 8,
+	}
+)
+
+func (x AccountEventType) Enum() *AccountEventType {
+	p := new(AccountEventType)
+	*p = x
+	return p
+}
+
+func (x AccountEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_accounts_proto_enumTypes[6].Descriptor()
+}
+
+func (AccountEventType) Type() protoreflect.EnumType {
+	return &file_lit_accounts_proto_enumTypes[6]
+}
+
+func (x AccountEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountEventType.Descriptor instead.
+func (AccountEventType) EnumDescriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{6}
+}
+
+type LedgerEntryType int32
+
+const (
+	// The initial balance of the account when it was created.
+	LedgerEntryType_LEDGER_OPENING LedgerEntryType = 0
+	// The balance of the account was set by updating the account.
+	LedgerEntryType_LEDGER_BALANCE_SET LedgerEntryType = 1
+	// The account was credited manually.
+	LedgerEntryType_LEDGER_CREDIT LedgerEntryType = 2
+	// The account was debited manually.
+	LedgerEntryType_LEDGER_DEBIT LedgerEntryType = 3
+	// The account was credited for a paid invoice.
+	LedgerEntryType_LEDGER_INVOICE LedgerEntryType = 4
+	// The account was debited for a settled payment, including its fee.
+	LedgerEntryType_LEDGER_PAYMENT LedgerEntryType = 5
+	// The balance of a removed account was swept into another account. It is
+	// recorded for both accounts.
+	LedgerEntryType_LEDGER_SWEEP LedgerEntryType = 6
+	// The account was topped up automatically from the top-up source account. It
+	// is recorded for both accounts.
+	LedgerEntryType_LEDGER_TOP_UP LedgerEntryType = 7
+	// Balance was transferred manually from one account to another. It is recorded
+	// for both accounts.
+	LedgerEntryType_LEDGER_TRANSFER LedgerEntryType = 8
+	// The account was created or overwritten by an import.
+	LedgerEntryType_LEDGER_IMPORT LedgerEntryType = 9
+)
+
+// Enum value maps for LedgerEntryType.
+var (
+	LedgerEntryType_name = map[int32]string{
+		0: "LEDGER_OPENING",
+		1: "LEDGER_BALANCE_SET",
+		2: "LEDGER_CREDIT",
+		3: "LEDGER_DEBIT",
+		4: "LEDGER_INVOICE",
+		5: "LEDGER_PAYMENT",
+		6: "LEDGER_SWEEP",
+		7: "LEDGER_TOP_UP",
+		8: "LEDGER_TRANSFER",
+		9: "LEDGER_IMPORT",
+	}
+	LedgerEntryType_value = map[string]int32{
+		"LEDGER_OPENING":     0,
+		"LEDGER_BALANCE_SET": 1,
+		"LEDGER_CREDIT":      2,
+		"LEDGER_DEBIT":       3,
+		"LEDGER_INVOICE":     4,
+		"LEDGER_PAYMENT":     5,
+		"LEDGER_SWEEP":       6,
+		"LEDGER_TOP_UP":      7,
+		"LEDGER_TRANSFER":    8,
+		"LEDGER_IMPORT":      9,
+	}
+)
+
+func (x LedgerEntryType) Enum() *LedgerEntryType {
+	p := new(LedgerEntryType)
+	*p = x
+	return p
+}
+
+func (x LedgerEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_accounts_proto_enumTypes[7].Descriptor()
+}
+
+func (LedgerEntryType) Type() protoreflect.EnumType {
+	return &file_lit_accounts_proto_enumTypes[7]
+}
+
+func (x LedgerEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerEntryType.Descriptor instead.
+func (LedgerEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{7}
+}
+
 type CreateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -29,10 +517,92 @@ type CreateAccountRequest struct {
 	// can be spent.
 	AccountBalance uint64 `protobuf:"varint,1,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
 	// The expiration date of the account as a timestamp. Set to 0 to never expire.
+	// If litd is configured with a default expiration, it is applied to accounts
+	// that are created with 0. If litd is configured with a max expiration, the
+	// request is rejected if the account would expire later than that. The
+	// account in the response carries the resulting expiration date.
 	ExpirationDate int64 `protobuf:"varint,2,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
 	// An optional label to identify the account. If the label is not empty, then
 	// it must be unique, otherwise it couldn't be used to query a single account.
+	// If it is empty and litd runs with accounts.autolabel, a unique label is
+	// generated.
 	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// The maximum amount in satoshis that a single HTLC sent by the account may
+	// carry, not including routing fees. Set to 0 to not limit the HTLC size.
+	MaxHtlcSat uint64 `protobuf:"varint,4,opt,name=max_htlc_sat,json=maxHtlcSat,proto3" json:"max_htlc_sat,omitempty"`
+	// An optional expiration date of the account macaroon as a timestamp. This is
+	// independent of the account's expiration date and can be used to force the
+	// macaroon to be re-issued periodically. It must not be after the account's
+	// expiration date, if one is set. Set to 0 to not add a separate macaroon
+	// expiry.
+	MacaroonExpirationDate int64 `protobuf:"varint,5,opt,name=macaroon_expiration_date,json=macaroonExpirationDate,proto3" json:"macaroon_expiration_date,omitempty"`
+	// An optional soft cap in satoshis on the total amount the account may spend.
+	// Payments that make the total spent amount exceed the soft cap are still
+	// allowed but cause a warning to be logged. Set to 0 to not set a soft cap.
+	SoftCapSat uint64 `protobuf:"varint,6,opt,name=soft_cap_sat,json=softCapSat,proto3" json:"soft_cap_sat,omitempty"`
+	// Marks the account as a sandbox account that is used for testing only.
+	// Sandbox accounts work like any other account but are excluded from
+	// aggregate reports unless explicitly requested.
+	Sandbox bool `protobuf:"varint,7,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	// The amount in satoshis the account may spend on keysend payments per
+	// second, not including routing fees. Unused budget accumulates for at most
+	// one second. Set to 0 to not limit keysend payments beyond the balance.
+	KeysendBudgetSatPerSec uint64 `protobuf:"varint,8,opt,name=keysend_budget_sat_per_sec,json=keysendBudgetSatPerSec,proto3" json:"keysend_budget_sat_per_sec,omitempty"`
+	// The hex encoded identity public key of the lnd node the account is bound
+	// to. Requests made with the account are only accepted while litd is
+	// connected to that node. If empty, the account is bound to the lnd node litd
+	// is currently connected to, which is the only node that is supported for
+	// now.
+	NodeId string `protobuf:"bytes,9,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// The maximum amount in satoshis the account may pay to an invoice that
+	// doesn't specify an amount. If set, payments to such invoices are rejected
+	// unless they explicitly state an amount that doesn't exceed this maximum.
+	// Set to 0 to only limit these payments by the balance.
+	AmountlessInvoiceMaxSat uint64 `protobuf:"varint,10,opt,name=amountless_invoice_max_sat,json=amountlessInvoiceMaxSat,proto3" json:"amountless_invoice_max_sat,omitempty"`
+	// The balance in satoshis below which the account is topped up automatically
+	// from the top-up source account litd is configured with. Must be set
+	// together with top_up_amount_sat.
+	MinBalanceSat uint64 `protobuf:"varint,11,opt,name=min_balance_sat,json=minBalanceSat,proto3" json:"min_balance_sat,omitempty"`
+	// The amount in satoshis the account is credited with whenever a payment
+	// brings its balance below min_balance_sat. Set to 0 to not top up the
+	// account automatically.
+	TopUpAmountSat uint64 `protobuf:"varint,12,opt,name=top_up_amount_sat,json=topUpAmountSat,proto3" json:"top_up_amount_sat,omitempty"`
+	// The maximum amount in satoshis, including routing fees, the account may
+	// spend on payments within any rolling window of window_seconds. Payments
+	// that would exceed it are rejected with an "account spend rate limit
+	// exceeded" error. Set to 0 to not limit the spend rate.
+	MaxSatPerWindow uint64 `protobuf:"varint,13,opt,name=max_sat_per_window,json=maxSatPerWindow,proto3" json:"max_sat_per_window,omitempty"`
+	// The length in seconds of the rolling window of the spend rate limit. Must be
+	// set together with max_sat_per_window.
+	WindowSeconds uint64 `protobuf:"varint,14,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// The hex encoded public keys of the only nodes the account may send payments
+	// to. Payments whose destination can't be determined, like payments without
+	// an invoice or a destination, are rejected as well. If empty, the account
+	// may pay any node.
+	AllowedDestinations []string `protobuf:"bytes,15,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+	// The maximum number of invoices created with the account that may be open,
+	// meaning neither settled nor canceled, at the same time. Invoices that would
+	// exceed it are rejected with an "account max open invoices exceeded" error.
+	// Set to 0 to not limit the number of open invoices.
+	MaxOpenInvoices uint32 `protobuf:"varint,16,opt,name=max_open_invoices,json=maxOpenInvoices,proto3" json:"max_open_invoices,omitempty"`
+	// The maximum amount in satoshis of an invoice created with the account. If
+	// set, invoices with a larger amount or without an amount are rejected with
+	// an "account max invoice amount exceeded" error. Set to 0 to not limit the
+	// invoice amount.
+	MaxInvoiceAmountSat uint64 `protobuf:"varint,17,opt,name=max_invoice_amount_sat,json=maxInvoiceAmountSat,proto3" json:"max_invoice_amount_sat,omitempty"`
+	// The maximum amount in satoshis that a single payment sent by the account
+	// may carry, not including routing fees. Unlike max_htlc_sat, it also limits
+	// payments that are split into multiple HTLCs. Larger payments are rejected
+	// with an "account max payment amount exceeded" error before the balance is
+	// checked. Set to 0 to not limit the payment amount.
+	MaxPaymentSat uint64 `protobuf:"varint,18,opt,name=max_payment_sat,json=maxPaymentSat,proto3" json:"max_payment_sat,omitempty"`
+	// An optional hex encoded ID of 8 bytes to create the account with, for
+	// example to map it to an ID of an external system. It must not be used by
+	// any other account and can't be all zeros. The macaroon root key of the
+	// account is derived from its first 4 bytes, so they must not match the
+	// first 4 bytes of another account's ID or the ID of a session either. If it
+	// is not set, a random ID is chosen.
+	Id string `protobuf:"bytes,19,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (x *CreateAccountRequest) Reset() {
@@ -88,6 +658,118 @@ func (x *CreateAccountRequest) GetLabel() string {
 	return ""
 }
 
+func (x *CreateAccountRequest) GetMaxHtlcSat() uint64 {
+	if x != nil {
+		return x.MaxHtlcSat
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetMacaroonExpirationDate() int64 {
+	if x != nil {
+		return x.MacaroonExpirationDate
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetSoftCapSat() uint64 {
+	if x != nil {
+		return x.SoftCapSat
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetSandbox() bool {
+	if x != nil {
+		return x.Sandbox
+	}
+	return false
+}
+
+func (x *CreateAccountRequest) GetKeysendBudgetSatPerSec() uint64 {
+	if x != nil {
+		return x.KeysendBudgetSatPerSec
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetAmountlessInvoiceMaxSat() uint64 {
+	if x != nil {
+		return x.AmountlessInvoiceMaxSat
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetMinBalanceSat() uint64 {
+	if x != nil {
+		return x.MinBalanceSat
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetTopUpAmountSat() uint64 {
+	if x != nil {
+		return x.TopUpAmountSat
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetMaxSatPerWindow() uint64 {
+	if x != nil {
+		return x.MaxSatPerWindow
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetWindowSeconds() uint64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetAllowedDestinations() []string {
+	if x != nil {
+		return x.AllowedDestinations
+	}
+	return nil
+}
+
+func (x *CreateAccountRequest) GetMaxOpenInvoices() uint32 {
+	if x != nil {
+		return x.MaxOpenInvoices
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetMaxInvoiceAmountSat() uint64 {
+	if x != nil {
+		return x.MaxInvoiceAmountSat
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetMaxPaymentSat() uint64 {
+	if x != nil {
+		return x.MaxPaymentSat
+	}
+	return 0
+}
+
+func (x *CreateAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type CreateAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -97,6 +779,10 @@ type CreateAccountResponse struct {
 	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	// The macaroon with all permissions required to access the account.
 	Macaroon []byte `protobuf:"bytes,2,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	// The resolved expiration date of the macaroon as a unix timestamp in
+	// seconds. If no macaroon expiration date was requested, this is the
+	// expiration date of the account. Zero means the macaroon does not expire.
+	MacaroonExpirationDate int64 `protobuf:"varint,3,opt,name=macaroon_expiration_date,json=macaroonExpirationDate,proto3" json:"macaroon_expiration_date,omitempty"`
 }
 
 func (x *CreateAccountResponse) Reset() {
@@ -145,6 +831,13 @@ func (x *CreateAccountResponse) GetMacaroon() []byte {
 	return nil
 }
 
+func (x *CreateAccountResponse) GetMacaroonExpirationDate() int64 {
+	if x != nil {
+		return x.MacaroonExpirationDate
+	}
+	return 0
+}
+
 type Account struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -169,6 +862,81 @@ type Account struct {
 	// An optional label to identify the account. If this is not empty, then it is
 	// guaranteed to be unique.
 	Label string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	// The maximum amount in satoshis that a single HTLC sent by the account may
+	// carry, not including routing fees. Zero means there is no limit.
+	MaxHtlcSat uint64 `protobuf:"varint,9,opt,name=max_htlc_sat,json=maxHtlcSat,proto3" json:"max_htlc_sat,omitempty"`
+	// The soft cap in satoshis on the total amount the account may spend before
+	// warnings are logged for further payments. Zero means there is no soft cap.
+	SoftCapSat uint64 `protobuf:"varint,10,opt,name=soft_cap_sat,json=softCapSat,proto3" json:"soft_cap_sat,omitempty"`
+	// Whether the account is a sandbox account that is used for testing only.
+	Sandbox bool `protobuf:"varint,11,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	// The amount in satoshis the account may spend on keysend payments per
+	// second. Zero means keysend payments are only limited by the balance.
+	KeysendBudgetSatPerSec uint64 `protobuf:"varint,12,opt,name=keysend_budget_sat_per_sec,json=keysendBudgetSatPerSec,proto3" json:"keysend_budget_sat_per_sec,omitempty"`
+	// The version of the account, which is incremented every time the account is
+	// changed. It can be passed to UpdateAccount to avoid overwriting concurrent
+	// changes.
+	Version uint64 `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	// The hex encoded identity public key of the lnd node the account is bound
+	// to. Empty for accounts that were created before accounts were bound to a
+	// node, which are accepted on any node.
+	NodeId string `protobuf:"bytes,14,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// The maximum amount in satoshis the account may pay to an invoice that
+	// doesn't specify an amount. Zero means these payments are only limited by
+	// the balance.
+	AmountlessInvoiceMaxSat uint64 `protobuf:"varint,15,opt,name=amountless_invoice_max_sat,json=amountlessInvoiceMaxSat,proto3" json:"amountless_invoice_max_sat,omitempty"`
+	// The balance in satoshis below which the account is topped up automatically.
+	// Zero if the account has no top-up policy.
+	MinBalanceSat uint64 `protobuf:"varint,16,opt,name=min_balance_sat,json=minBalanceSat,proto3" json:"min_balance_sat,omitempty"`
+	// The amount in satoshis the account is credited with on every automatic
+	// top-up. Zero if the account has no top-up policy.
+	TopUpAmountSat uint64 `protobuf:"varint,17,opt,name=top_up_amount_sat,json=topUpAmountSat,proto3" json:"top_up_amount_sat,omitempty"`
+	// The maximum amount in satoshis the account may spend on payments within any
+	// rolling window of window_seconds. Zero if the account has no spend rate
+	// limit.
+	MaxSatPerWindow uint64 `protobuf:"varint,18,opt,name=max_sat_per_window,json=maxSatPerWindow,proto3" json:"max_sat_per_window,omitempty"`
+	// The length in seconds of the rolling window of the spend rate limit. Zero if
+	// the account has no spend rate limit.
+	WindowSeconds uint64 `protobuf:"varint,19,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// The amount in satoshis the account can currently still spend within the
+	// window of its spend rate limit. Only set by AccountInfo for accounts with a
+	// spend rate limit.
+	WindowRemainingSat uint64 `protobuf:"varint,20,opt,name=window_remaining_sat,json=windowRemainingSat,proto3" json:"window_remaining_sat,omitempty"`
+	// The lead time in seconds before the expiration date at which an expiring
+	// soon update is sent for the account. Zero if the account has no expiry
+	// warning.
+	ExpiryWarningSeconds uint64 `protobuf:"varint,21,opt,name=expiry_warning_seconds,json=expiryWarningSeconds,proto3" json:"expiry_warning_seconds,omitempty"`
+	// The hex encoded public keys of the only nodes the account may send payments
+	// to. Empty if the account may pay any node.
+	AllowedDestinations []string `protobuf:"bytes,22,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+	// The maximum number of open invoices of the account. Zero if the number of
+	// open invoices isn't limited.
+	MaxOpenInvoices uint32 `protobuf:"varint,23,opt,name=max_open_invoices,json=maxOpenInvoices,proto3" json:"max_open_invoices,omitempty"`
+	// The maximum amount in satoshis of an invoice created with the account. Zero
+	// if the invoice amount isn't limited.
+	MaxInvoiceAmountSat uint64 `protobuf:"varint,24,opt,name=max_invoice_amount_sat,json=maxInvoiceAmountSat,proto3" json:"max_invoice_amount_sat,omitempty"`
+	// The number of invoices created with the account that are neither settled
+	// nor canceled. Invoices that were canceled or expired might still be counted
+	// until the account reaches its maximum number of open invoices.
+	OpenInvoices uint32 `protobuf:"varint,25,opt,name=open_invoices,json=openInvoices,proto3" json:"open_invoices,omitempty"`
+	// The current balance of the account in satoshis minus the full amounts,
+	// including the maximum routing fees, of all payments that are still
+	// in-flight. This is the amount the account can still spend. As the routing
+	// fees of in-flight payments are reserved in full, this can be lower than
+	// what remains once they are settled.
+	AvailableBalance int64 `protobuf:"varint,26,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"`
+	// The number of payments of the account that are still in-flight and
+	// reserve part of its balance. The payments are listed in payments with a
+	// state other than SUCCEEDED or FAILED.
+	PendingPayments uint32 `protobuf:"varint,27,opt,name=pending_payments,json=pendingPayments,proto3" json:"pending_payments,omitempty"`
+	// Timestamp of the time the account was created. For accounts that were
+	// created before the creation time was recorded, this is the time of their
+	// last update before that.
+	CreatedAt int64 `protobuf:"varint,28,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The maximum amount in satoshis that a single payment sent by the account
+	// may carry, not including routing fees. Zero if the payment amount isn't
+	// limited.
+	MaxPaymentSat uint64 `protobuf:"varint,29,opt,name=max_payment_sat,json=maxPaymentSat,proto3" json:"max_payment_sat,omitempty"`
 }
 
 func (x *Account) Reset() {
@@ -259,6 +1027,153 @@ func (x *Account) GetLabel() string {
 	return ""
 }
 
+func (x *Account) GetMaxHtlcSat() uint64 {
+	if x != nil {
+		return x.MaxHtlcSat
+	}
+	return 0
+}
+
+func (x *Account) GetSoftCapSat() uint64 {
+	if x != nil {
+		return x.SoftCapSat
+	}
+	return 0
+}
+
+func (x *Account) GetSandbox() bool {
+	if x != nil {
+		return x.Sandbox
+	}
+	return false
+}
+
+func (x *Account) GetKeysendBudgetSatPerSec() uint64 {
+	if x != nil {
+		return x.KeysendBudgetSatPerSec
+	}
+	return 0
+}
+
+func (x *Account) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Account) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Account) GetAmountlessInvoiceMaxSat() uint64 {
+	if x != nil {
+		return x.AmountlessInvoiceMaxSat
+	}
+	return 0
+}
+
+func (x *Account) GetMinBalanceSat() uint64 {
+	if x != nil {
+		return x.MinBalanceSat
+	}
+	return 0
+}
+
+func (x *Account) GetTopUpAmountSat() uint64 {
+	if x != nil {
+		return x.TopUpAmountSat
+	}
+	return 0
+}
+
+func (x *Account) GetMaxSatPerWindow() uint64 {
+	if x != nil {
+		return x.MaxSatPerWindow
+	}
+	return 0
+}
+
+func (x *Account) GetWindowSeconds() uint64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *Account) GetWindowRemainingSat() uint64 {
+	if x != nil {
+		return x.WindowRemainingSat
+	}
+	return 0
+}
+
+func (x *Account) GetExpiryWarningSeconds() uint64 {
+	if x != nil {
+		return x.ExpiryWarningSeconds
+	}
+	return 0
+}
+
+func (x *Account) GetAllowedDestinations() []string {
+	if x != nil {
+		return x.AllowedDestinations
+	}
+	return nil
+}
+
+func (x *Account) GetMaxOpenInvoices() uint32 {
+	if x != nil {
+		return x.MaxOpenInvoices
+	}
+	return 0
+}
+
+func (x *Account) GetMaxInvoiceAmountSat() uint64 {
+	if x != nil {
+		return x.MaxInvoiceAmountSat
+	}
+	return 0
+}
+
+func (x *Account) GetOpenInvoices() uint32 {
+	if x != nil {
+		return x.OpenInvoices
+	}
+	return 0
+}
+
+func (x *Account) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
+func (x *Account) GetPendingPayments() uint32 {
+	if x != nil {
+		return x.PendingPayments
+	}
+	return 0
+}
+
+func (x *Account) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Account) GetMaxPaymentSat() uint64 {
+	if x != nil {
+		return x.MaxPaymentSat
+	}
+	return 0
+}
+
 type AccountInvoice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -320,6 +1235,12 @@ type AccountPayment struct {
 	// routing fee estimated by the fee limit of the payment request. The actual
 	// debited amount will likely be lower if the fee is below the limit.
 	FullAmount int64 `protobuf:"varint,3,opt,name=full_amount,json=fullAmount,proto3" json:"full_amount,omitempty"`
+	// The routing fee in milli-satoshis that was actually paid for this payment.
+	// Only set once the payment has succeeded.
+	RoutingFeeMsat uint64 `protobuf:"varint,4,opt,name=routing_fee_msat,json=routingFeeMsat,proto3" json:"routing_fee_msat,omitempty"`
+	// The hex encoded public key of the node this payment was sent to. Only set
+	// once the payment has succeeded.
+	Destination string `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
 }
 
 func (x *AccountPayment) Reset() {
@@ -375,6 +1296,20 @@ func (x *AccountPayment) GetFullAmount() int64 {
 	return 0
 }
 
+func (x *AccountPayment) GetRoutingFeeMsat() uint64 {
+	if x != nil {
+		return x.RoutingFeeMsat
+	}
+	return 0
+}
+
+func (x *AccountPayment) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
 type UpdateAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -389,11 +1324,58 @@ type UpdateAccountRequest struct {
 	// Deprecated: Marked as deprecated in lit-accounts.proto.
 	AccountBalance int64 `protobuf:"varint,2,opt,name=account_balance,json=accountBalance,proto3" json:"account_balance,omitempty"`
 	// The new account expiry to set. Set to -1 to not update the expiry. Set to 0
-	// to never expire.
+	// to never expire. If litd is configured with a max expiration, the request
+	// is rejected if the account would expire later than that.
 	ExpirationDate int64 `protobuf:"varint,3,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
 	// The label of the account to update. If an account has no label, then the ID
 	// must be used instead.
 	Label string `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	// The new maximum amount in satoshis that a single HTLC sent by the account
+	// may carry. Set to 0 to not update the limit. Set to -1 to remove the limit.
+	MaxHtlcSat int64 `protobuf:"varint,5,opt,name=max_htlc_sat,json=maxHtlcSat,proto3" json:"max_htlc_sat,omitempty"`
+	// The new soft cap in satoshis on the total amount the account may spend
+	// before warnings are logged for further payments. Set to 0 to not update the
+	// soft cap. Set to -1 to remove the soft cap.
+	SoftCapSat int64 `protobuf:"varint,6,opt,name=soft_cap_sat,json=softCapSat,proto3" json:"soft_cap_sat,omitempty"`
+	// The version of the account the update is based on, as returned in the
+	// account. If set, the update is rejected if the account was changed in the
+	// meantime. Set to 0 to update the account regardless of its version.
+	Version uint64 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// The new balance in satoshis below which the account is topped up
+	// automatically. Must be set together with top_up_amount_sat.
+	MinBalanceSat int64 `protobuf:"varint,8,opt,name=min_balance_sat,json=minBalanceSat,proto3" json:"min_balance_sat,omitempty"`
+	// The new amount in satoshis the account is credited with on every automatic
+	// top-up. Set to 0 to not update the top-up policy. Set to -1 to remove the
+	// top-up policy.
+	TopUpAmountSat int64 `protobuf:"varint,9,opt,name=top_up_amount_sat,json=topUpAmountSat,proto3" json:"top_up_amount_sat,omitempty"`
+	// The new maximum amount in satoshis the account may spend on payments within
+	// any rolling window of window_seconds. Set to 0 to not update the spend rate
+	// limit. Set to -1 to remove the spend rate limit.
+	MaxSatPerWindow int64 `protobuf:"varint,10,opt,name=max_sat_per_window,json=maxSatPerWindow,proto3" json:"max_sat_per_window,omitempty"`
+	// The new length in seconds of the rolling window of the spend rate limit.
+	// Must be set together with max_sat_per_window.
+	WindowSeconds int64 `protobuf:"varint,11,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// The new lead time in seconds before the expiration date of the account at
+	// which an expiring soon update is sent to the subscribers of the account
+	// updates. Set to 0 to not update the expiry warning. Set to -1 to remove the
+	// expiry warning.
+	ExpiryWarningSeconds int64 `protobuf:"varint,12,opt,name=expiry_warning_seconds,json=expiryWarningSeconds,proto3" json:"expiry_warning_seconds,omitempty"`
+	// The new hex encoded public keys of the only nodes the account may send
+	// payments to. If empty, the allowed destinations are not updated unless
+	// clear_allowed_destinations is set.
+	AllowedDestinations []string `protobuf:"bytes,13,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+	// Remove the allowed destinations, so that the account may pay any node.
+	ClearAllowedDestinations bool `protobuf:"varint,14,opt,name=clear_allowed_destinations,json=clearAllowedDestinations,proto3" json:"clear_allowed_destinations,omitempty"`
+	// The new maximum number of open invoices of the account. Set to 0 to not
+	// update the maximum. Set to -1 to remove the limit.
+	MaxOpenInvoices int64 `protobuf:"varint,15,opt,name=max_open_invoices,json=maxOpenInvoices,proto3" json:"max_open_invoices,omitempty"`
+	// The new maximum amount in satoshis of an invoice created with the account.
+	// Set to 0 to not update the maximum. Set to -1 to remove the limit.
+	MaxInvoiceAmountSat int64 `protobuf:"varint,16,opt,name=max_invoice_amount_sat,json=maxInvoiceAmountSat,proto3" json:"max_invoice_amount_sat,omitempty"`
+	// The new maximum amount in satoshis that a single payment sent by the
+	// account may carry. Set to 0 to not update the limit. Set to -1 to remove
+	// the limit.
+	MaxPaymentSat int64 `protobuf:"varint,17,opt,name=max_payment_sat,json=maxPaymentSat,proto3" json:"max_payment_sat,omitempty"`
 }
 
 func (x *UpdateAccountRequest) Reset() {
@@ -457,6 +1439,154 @@ func (x *UpdateAccountRequest) GetLabel() string {
 	return ""
 }
 
+func (x *UpdateAccountRequest) GetMaxHtlcSat() int64 {
+	if x != nil {
+		return x.MaxHtlcSat
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetSoftCapSat() int64 {
+	if x != nil {
+		return x.SoftCapSat
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetMinBalanceSat() int64 {
+	if x != nil {
+		return x.MinBalanceSat
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetTopUpAmountSat() int64 {
+	if x != nil {
+		return x.TopUpAmountSat
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetMaxSatPerWindow() int64 {
+	if x != nil {
+		return x.MaxSatPerWindow
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetWindowSeconds() int64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetExpiryWarningSeconds() int64 {
+	if x != nil {
+		return x.ExpiryWarningSeconds
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetAllowedDestinations() []string {
+	if x != nil {
+		return x.AllowedDestinations
+	}
+	return nil
+}
+
+func (x *UpdateAccountRequest) GetClearAllowedDestinations() bool {
+	if x != nil {
+		return x.ClearAllowedDestinations
+	}
+	return false
+}
+
+func (x *UpdateAccountRequest) GetMaxOpenInvoices() int64 {
+	if x != nil {
+		return x.MaxOpenInvoices
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetMaxInvoiceAmountSat() int64 {
+	if x != nil {
+		return x.MaxInvoiceAmountSat
+	}
+	return 0
+}
+
+func (x *UpdateAccountRequest) GetMaxPaymentSat() int64 {
+	if x != nil {
+		return x.MaxPaymentSat
+	}
+	return 0
+}
+
+type RenameAccountLabelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the account to rename.
+	Account *AccountIdentifier `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The new label of the account. Leading and trailing whitespace is removed.
+	NewLabel string `protobuf:"bytes,2,opt,name=new_label,json=newLabel,proto3" json:"new_label,omitempty"`
+}
+
+func (x *RenameAccountLabelRequest) Reset() {
+	*x = RenameAccountLabelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameAccountLabelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameAccountLabelRequest) ProtoMessage() {}
+
+func (x *RenameAccountLabelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameAccountLabelRequest.ProtoReflect.Descriptor instead.
+func (*RenameAccountLabelRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RenameAccountLabelRequest) GetAccount() *AccountIdentifier {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *RenameAccountLabelRequest) GetNewLabel() string {
+	if x != nil {
+		return x.NewLabel
+	}
+	return ""
+}
+
 type CreditAccountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -471,7 +1601,7 @@ type CreditAccountRequest struct {
 func (x *CreditAccountRequest) Reset() {
 	*x = CreditAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[6]
+		mi := &file_lit_accounts_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -484,7 +1614,7 @@ func (x *CreditAccountRequest) String() string {
 func (*CreditAccountRequest) ProtoMessage() {}
 
 func (x *CreditAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[6]
+	mi := &file_lit_accounts_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -497,7 +1627,7 @@ func (x *CreditAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditAccountRequest.ProtoReflect.Descriptor instead.
 func (*CreditAccountRequest) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{6}
+	return file_lit_accounts_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CreditAccountRequest) GetAccount() *AccountIdentifier {
@@ -526,7 +1656,7 @@ type CreditAccountResponse struct {
 func (x *CreditAccountResponse) Reset() {
 	*x = CreditAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[7]
+		mi := &file_lit_accounts_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -539,7 +1669,7 @@ func (x *CreditAccountResponse) String() string {
 func (*CreditAccountResponse) ProtoMessage() {}
 
 func (x *CreditAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[7]
+	mi := &file_lit_accounts_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +1682,7 @@ func (x *CreditAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreditAccountResponse.ProtoReflect.Descriptor instead.
 func (*CreditAccountResponse) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{7}
+	return file_lit_accounts_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CreditAccountResponse) GetAccount() *Account {
@@ -571,12 +1701,15 @@ type DebitAccountRequest struct {
 	Account *AccountIdentifier `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	// The amount by which the account's balance should be debited.
 	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// An optional memo that is included in the debit event sent to the spend
+	// webhook, if one is configured.
+	Memo string `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
 }
 
 func (x *DebitAccountRequest) Reset() {
 	*x = DebitAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[8]
+		mi := &file_lit_accounts_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -589,7 +1722,7 @@ func (x *DebitAccountRequest) String() string {
 func (*DebitAccountRequest) ProtoMessage() {}
 
 func (x *DebitAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[8]
+	mi := &file_lit_accounts_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,7 +1735,7 @@ func (x *DebitAccountRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebitAccountRequest.ProtoReflect.Descriptor instead.
 func (*DebitAccountRequest) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{8}
+	return file_lit_accounts_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *DebitAccountRequest) GetAccount() *AccountIdentifier {
@@ -619,6 +1752,13 @@ func (x *DebitAccountRequest) GetAmount() uint64 {
 	return 0
 }
 
+func (x *DebitAccountRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
 type DebitAccountResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -631,7 +1771,7 @@ type DebitAccountResponse struct {
 func (x *DebitAccountResponse) Reset() {
 	*x = DebitAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[9]
+		mi := &file_lit_accounts_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -644,7 +1784,7 @@ func (x *DebitAccountResponse) String() string {
 func (*DebitAccountResponse) ProtoMessage() {}
 
 func (x *DebitAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[9]
+	mi := &file_lit_accounts_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,7 +1797,7 @@ func (x *DebitAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebitAccountResponse.ProtoReflect.Descriptor instead.
 func (*DebitAccountResponse) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{9}
+	return file_lit_accounts_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *DebitAccountResponse) GetAccount() *Account {
@@ -667,16 +1807,165 @@ func (x *DebitAccountResponse) GetAccount() *Account {
 	return nil
 }
 
-type ListAccountsRequest struct {
+type TransferAccountBalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the account to transfer the amount from.
+	Source *AccountIdentifier `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The identifier of the account to transfer the amount to.
+	Destination *AccountIdentifier `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	// The amount in satoshis to transfer.
+	Amount uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *TransferAccountBalanceRequest) Reset() {
+	*x = TransferAccountBalanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferAccountBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferAccountBalanceRequest) ProtoMessage() {}
+
+func (x *TransferAccountBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferAccountBalanceRequest.ProtoReflect.Descriptor instead.
+func (*TransferAccountBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TransferAccountBalanceRequest) GetSource() *AccountIdentifier {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *TransferAccountBalanceRequest) GetDestination() *AccountIdentifier {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+func (x *TransferAccountBalanceRequest) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TransferAccountBalanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The source account after the transfer.
+	Source *Account `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// The destination account after the transfer.
+	Destination *Account `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *TransferAccountBalanceResponse) Reset() {
+	*x = TransferAccountBalanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TransferAccountBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferAccountBalanceResponse) ProtoMessage() {}
+
+func (x *TransferAccountBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferAccountBalanceResponse.ProtoReflect.Descriptor instead.
+func (*TransferAccountBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransferAccountBalanceResponse) GetSource() *Account {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *TransferAccountBalanceResponse) GetDestination() *Account {
+	if x != nil {
+		return x.Destination
+	}
+	return nil
+}
+
+type ListAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Determines whether sandbox accounts are included in the list. By default
+	// all accounts are listed.
+	SandboxFilter SandboxFilter `protobuf:"varint,1,opt,name=sandbox_filter,json=sandboxFilter,proto3,enum=litrpc.SandboxFilter" json:"sandbox_filter,omitempty"`
+	// If set, only accounts with a label that starts with this prefix are
+	// listed.
+	LabelPrefix string `protobuf:"bytes,2,opt,name=label_prefix,json=labelPrefix,proto3" json:"label_prefix,omitempty"`
+	// If set, only accounts with a current balance of at least this amount in
+	// satoshis are listed.
+	MinBalance uint64 `protobuf:"varint,3,opt,name=min_balance,json=minBalance,proto3" json:"min_balance,omitempty"`
+	// Whether accounts that have expired are listed as well.
+	IncludeExpired bool `protobuf:"varint,4,opt,name=include_expired,json=includeExpired,proto3" json:"include_expired,omitempty"`
+	// The maximum number of accounts to return. If not set, all accounts that
+	// match the filters are returned in a single response.
+	PageSize uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of the previous response, to continue listing after
+	// the accounts of that response. The filters must be the same as in the
+	// request of the previous page.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// The account property to sort the accounts by. Accounts with the same value
+	// are sorted by their ID, so the order is the same across pages. The sort
+	// must be the same as in the request of the previous page.
+	SortBy AccountSortField `protobuf:"varint,7,opt,name=sort_by,json=sortBy,proto3,enum=litrpc.AccountSortField" json:"sort_by,omitempty"`
+	// The direction to sort the accounts in. It can only be set together with
+	// sort_by.
+	SortDir SortDirection `protobuf:"varint,8,opt,name=sort_dir,json=sortDir,proto3,enum=litrpc.SortDirection" json:"sort_dir,omitempty"`
 }
 
 func (x *ListAccountsRequest) Reset() {
 	*x = ListAccountsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[10]
+		mi := &file_lit_accounts_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -689,7 +1978,7 @@ func (x *ListAccountsRequest) String() string {
 func (*ListAccountsRequest) ProtoMessage() {}
 
 func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[10]
+	mi := &file_lit_accounts_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +1991,63 @@ func (x *ListAccountsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccountsRequest.ProtoReflect.Descriptor instead.
 func (*ListAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{10}
+	return file_lit_accounts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListAccountsRequest) GetSandboxFilter() SandboxFilter {
+	if x != nil {
+		return x.SandboxFilter
+	}
+	return SandboxFilter_SANDBOX_INCLUDE
+}
+
+func (x *ListAccountsRequest) GetLabelPrefix() string {
+	if x != nil {
+		return x.LabelPrefix
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetMinBalance() uint64 {
+	if x != nil {
+		return x.MinBalance
+	}
+	return 0
+}
+
+func (x *ListAccountsRequest) GetIncludeExpired() bool {
+	if x != nil {
+		return x.IncludeExpired
+	}
+	return false
+}
+
+func (x *ListAccountsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccountsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAccountsRequest) GetSortBy() AccountSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return AccountSortField_ACCOUNT_SORT_DEFAULT
+}
+
+func (x *ListAccountsRequest) GetSortDir() SortDirection {
+	if x != nil {
+		return x.SortDir
+	}
+	return SortDirection_SORT_ASCENDING
 }
 
 type ListAccountsResponse struct {
@@ -710,14 +2055,20 @@ type ListAccountsResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// All accounts in the account database.
+	// The accounts in the account database that match the filters.
 	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// The token to request the next page with. It is empty if there are no more
+	// accounts. Accounts are always listed in the same order, so the next page
+	// continues right after this one even if accounts are created or removed in
+	// between. If the accounts are sorted by a property, the account the next
+	// page starts with must not be removed in between.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
 func (x *ListAccountsResponse) Reset() {
 	*x = ListAccountsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[11]
+		mi := &file_lit_accounts_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -730,7 +2081,7 @@ func (x *ListAccountsResponse) String() string {
 func (*ListAccountsResponse) ProtoMessage() {}
 
 func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[11]
+	mi := &file_lit_accounts_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -743,7 +2094,7 @@ func (x *ListAccountsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAccountsResponse.ProtoReflect.Descriptor instead.
 func (*ListAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{11}
+	return file_lit_accounts_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *ListAccountsResponse) GetAccounts() []*Account {
@@ -753,36 +2104,40 @@ func (x *ListAccountsResponse) GetAccounts() []*Account {
 	return nil
 }
 
-type AccountInfoRequest struct {
+func (x *ListAccountsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListAccountGroupsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The hexadecimal ID of the account to remove. Either the ID or the label must
-	// be set.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The label of the account to remove. If an account has no label, then the ID
-	// must be used instead.
-	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The separator that splits the group from the rest of a label. Defaults to
+	// "/" if empty.
+	Separator string `protobuf:"bytes,1,opt,name=separator,proto3" json:"separator,omitempty"`
 }
 
-func (x *AccountInfoRequest) Reset() {
-	*x = AccountInfoRequest{}
+func (x *ListAccountGroupsRequest) Reset() {
+	*x = ListAccountGroupsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[12]
+		mi := &file_lit_accounts_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AccountInfoRequest) String() string {
+func (x *ListAccountGroupsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountInfoRequest) ProtoMessage() {}
+func (*ListAccountGroupsRequest) ProtoMessage() {}
 
-func (x *AccountInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[12]
+func (x *ListAccountGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,26 +2148,134 @@ func (x *AccountInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountInfoRequest.ProtoReflect.Descriptor instead.
-func (*AccountInfoRequest) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ListAccountGroupsRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *AccountInfoRequest) GetId() string {
+func (x *ListAccountGroupsRequest) GetSeparator() string {
 	if x != nil {
-		return x.Id
+		return x.Separator
 	}
 	return ""
 }
 
-func (x *AccountInfoRequest) GetLabel() string {
+type AccountGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The label prefix shared by the accounts of the group.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of accounts in the group.
+	NumAccounts uint32 `protobuf:"varint,2,opt,name=num_accounts,json=numAccounts,proto3" json:"num_accounts,omitempty"`
+}
+
+func (x *AccountGroup) Reset() {
+	*x = AccountGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountGroup) ProtoMessage() {}
+
+func (x *AccountGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountGroup.ProtoReflect.Descriptor instead.
+func (*AccountGroup) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AccountGroup) GetName() string {
 	if x != nil {
-		return x.Label
+		return x.Name
 	}
 	return ""
 }
 
-type RemoveAccountRequest struct {
+func (x *AccountGroup) GetNumAccounts() uint32 {
+	if x != nil {
+		return x.NumAccounts
+	}
+	return 0
+}
+
+type ListAccountGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The groups of accounts, sorted by name.
+	Groups []*AccountGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	// The number of accounts that don't belong to any group because they don't
+	// have a label or their label doesn't contain the separator.
+	NumUngrouped uint32 `protobuf:"varint,2,opt,name=num_ungrouped,json=numUngrouped,proto3" json:"num_ungrouped,omitempty"`
+}
+
+func (x *ListAccountGroupsResponse) Reset() {
+	*x = ListAccountGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAccountGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountGroupsResponse) ProtoMessage() {}
+
+func (x *ListAccountGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountGroupsResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAccountGroupsResponse) GetGroups() []*AccountGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListAccountGroupsResponse) GetNumUngrouped() uint32 {
+	if x != nil {
+		return x.NumUngrouped
+	}
+	return 0
+}
+
+type AccountInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -825,377 +2288,4211 @@ type RemoveAccountRequest struct {
 	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
 }
 
-func (x *RemoveAccountRequest) Reset() {
-	*x = RemoveAccountRequest{}
+func (x *AccountInfoRequest) Reset() {
+	*x = AccountInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[13]
+		mi := &file_lit_accounts_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveAccountRequest) String() string {
+func (x *AccountInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveAccountRequest) ProtoMessage() {}
+func (*AccountInfoRequest) ProtoMessage() {}
 
-func (x *RemoveAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[13]
+func (x *AccountInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RemoveAccountRequest.ProtoReflect.Descriptor instead.
-func (*RemoveAccountRequest) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *RemoveAccountRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *RemoveAccountRequest) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
-}
-
-type RemoveAccountResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *RemoveAccountResponse) Reset() {
-	*x = RemoveAccountResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *RemoveAccountResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RemoveAccountResponse) ProtoMessage() {}
-
-func (x *RemoveAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInfoRequest.ProtoReflect.Descriptor instead.
+func (*AccountInfoRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AccountInfoRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccountInfoRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type RemoveAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hexadecimal ID of the account to remove. Either the ID or the label must
+	// be set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account to remove. If an account has no label, then the ID
+	// must be used instead.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The hexadecimal ID of the account to credit the remaining balance of the
+	// removed account to. If neither the ID nor the label of a target account is
+	// set, the balance is dropped with the account.
+	SweepToId string `protobuf:"bytes,3,opt,name=sweep_to_id,json=sweepToId,proto3" json:"sweep_to_id,omitempty"`
+	// The label of the account to credit the remaining balance to.
+	SweepToLabel string `protobuf:"bytes,4,opt,name=sweep_to_label,json=sweepToLabel,proto3" json:"sweep_to_label,omitempty"`
+}
+
+func (x *RemoveAccountRequest) Reset() {
+	*x = RemoveAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccountRequest) ProtoMessage() {}
+
+func (x *RemoveAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccountRequest.ProtoReflect.Descriptor instead.
+func (*RemoveAccountRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RemoveAccountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveAccountRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RemoveAccountRequest) GetSweepToId() string {
+	if x != nil {
+		return x.SweepToId
+	}
+	return ""
+}
+
+func (x *RemoveAccountRequest) GetSweepToLabel() string {
+	if x != nil {
+		return x.SweepToLabel
+	}
+	return ""
+}
+
+type RemoveAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The balance in satoshis that was credited to the target account.
+	SweptBalanceSat uint64 `protobuf:"varint,1,opt,name=swept_balance_sat,json=sweptBalanceSat,proto3" json:"swept_balance_sat,omitempty"`
+	// The balance in millisatoshis that was credited to the target account.
+	SweptBalanceMsat uint64 `protobuf:"varint,2,opt,name=swept_balance_msat,json=sweptBalanceMsat,proto3" json:"swept_balance_msat,omitempty"`
+}
+
+func (x *RemoveAccountResponse) Reset() {
+	*x = RemoveAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveAccountResponse) ProtoMessage() {}
+
+func (x *RemoveAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveAccountResponse.ProtoReflect.Descriptor instead.
+func (*RemoveAccountResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveAccountResponse) GetSweptBalanceSat() uint64 {
+	if x != nil {
+		return x.SweptBalanceSat
+	}
+	return 0
+}
+
+func (x *RemoveAccountResponse) GetSweptBalanceMsat() uint64 {
+	if x != nil {
+		return x.SweptBalanceMsat
+	}
+	return 0
+}
+
+type PurgeExpiredAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, the expired accounts are only returned and not removed from the
+	// account database.
+	Preview bool `protobuf:"varint,1,opt,name=preview,proto3" json:"preview,omitempty"`
+}
+
+func (x *PurgeExpiredAccountsRequest) Reset() {
+	*x = PurgeExpiredAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeExpiredAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeExpiredAccountsRequest) ProtoMessage() {}
+
+func (x *PurgeExpiredAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeExpiredAccountsRequest.ProtoReflect.Descriptor instead.
+func (*PurgeExpiredAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PurgeExpiredAccountsRequest) GetPreview() bool {
+	if x != nil {
+		return x.Preview
+	}
+	return false
+}
+
+type PurgeExpiredAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The expired accounts that were removed, or that would be removed if the
+	// request was a preview.
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *PurgeExpiredAccountsResponse) Reset() {
+	*x = PurgeExpiredAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeExpiredAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeExpiredAccountsResponse) ProtoMessage() {}
+
+func (x *PurgeExpiredAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeExpiredAccountsResponse.ProtoReflect.Descriptor instead.
+func (*PurgeExpiredAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PurgeExpiredAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type AccountIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Identifier:
+	//
+	//	*AccountIdentifier_Id
+	//	*AccountIdentifier_Label
+	Identifier isAccountIdentifier_Identifier `protobuf_oneof:"identifier"`
+}
+
+func (x *AccountIdentifier) Reset() {
+	*x = AccountIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountIdentifier) ProtoMessage() {}
+
+func (x *AccountIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountIdentifier.ProtoReflect.Descriptor instead.
+func (*AccountIdentifier) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{23}
+}
+
+func (m *AccountIdentifier) GetIdentifier() isAccountIdentifier_Identifier {
+	if m != nil {
+		return m.Identifier
+	}
+	return nil
+}
+
+func (x *AccountIdentifier) GetId() string {
+	if x, ok := x.GetIdentifier().(*AccountIdentifier_Id); ok {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AccountIdentifier) GetLabel() string {
+	if x, ok := x.GetIdentifier().(*AccountIdentifier_Label); ok {
+		return x.Label
+	}
+	return ""
+}
+
+type isAccountIdentifier_Identifier interface {
+	isAccountIdentifier_Identifier()
+}
+
+type AccountIdentifier_Id struct {
+	// The ID of the account.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type AccountIdentifier_Label struct {
+	// The label of the account.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3,oneof"`
+}
+
+func (*AccountIdentifier_Id) isAccountIdentifier_Identifier() {}
+
+func (*AccountIdentifier_Label) isAccountIdentifier_Identifier() {}
+
+type SubscribePaymentEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the account to limit the events to. If not set, the
+	// events of all accounts are sent.
+	Account *AccountIdentifier `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *SubscribePaymentEventsRequest) Reset() {
+	*x = SubscribePaymentEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribePaymentEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePaymentEventsRequest) ProtoMessage() {}
+
+func (x *SubscribePaymentEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePaymentEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePaymentEventsRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SubscribePaymentEventsRequest) GetAccount() *AccountIdentifier {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type PaymentEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lifecycle stage of the payment.
+	Type PaymentEventType `protobuf:"varint,1,opt,name=type,proto3,enum=litrpc.PaymentEventType" json:"type,omitempty"`
+	// The hexadecimal ID of the account the payment is charged to.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The payment hash.
+	PaymentHash []byte `protobuf:"bytes,3,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// The amount of the payment in milli-satoshis excluding fees. For initiated
+	// payments, this is the full amount reserved for the payment, including the
+	// maximum routing fee.
+	AmountMsat uint64 `protobuf:"varint,4,opt,name=amount_msat,json=amountMsat,proto3" json:"amount_msat,omitempty"`
+	// The routing fee in milli-satoshis that was paid. Only set for settled
+	// payments.
+	FeeMsat uint64 `protobuf:"varint,5,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	// The unix timestamp in seconds at which the event occurred.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *PaymentEvent) Reset() {
+	*x = PaymentEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentEvent) ProtoMessage() {}
+
+func (x *PaymentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentEvent.ProtoReflect.Descriptor instead.
+func (*PaymentEvent) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *PaymentEvent) GetType() PaymentEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PaymentEventType_PAYMENT_INITIATED
+}
+
+func (x *PaymentEvent) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *PaymentEvent) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+func (x *PaymentEvent) GetAmountMsat() uint64 {
+	if x != nil {
+		return x.AmountMsat
+	}
+	return 0
+}
+
+func (x *PaymentEvent) GetFeeMsat() uint64 {
+	if x != nil {
+		return x.FeeMsat
+	}
+	return 0
+}
+
+func (x *PaymentEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type SubscribeAccountLifecycleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The resume token of the last event that was received. If set, all events
+	// that were emitted after that event are sent first. Only a limited number
+	// of recent events is kept and the events don't survive a restart of litd,
+	// so resuming can fail. In that case, the subscriber must subscribe without
+	// a token and list all accounts again.
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *SubscribeAccountLifecycleRequest) Reset() {
+	*x = SubscribeAccountLifecycleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeAccountLifecycleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAccountLifecycleRequest) ProtoMessage() {}
+
+func (x *SubscribeAccountLifecycleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAccountLifecycleRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAccountLifecycleRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SubscribeAccountLifecycleRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type AccountLifecycleEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of change.
+	Type AccountLifecycleEventType `protobuf:"varint,1,opt,name=type,proto3,enum=litrpc.AccountLifecycleEventType" json:"type,omitempty"`
+	// The hexadecimal ID of the account that changed.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The full account after the change. Not set for removed accounts.
+	Account *Account `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	// The unix timestamp in seconds at which the event occurred.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The token that can be passed to SubscribeAccountLifecycle to resume the
+	// subscription after this event.
+	ResumeToken string `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`
+}
+
+func (x *AccountLifecycleEvent) Reset() {
+	*x = AccountLifecycleEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountLifecycleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountLifecycleEvent) ProtoMessage() {}
+
+func (x *AccountLifecycleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountLifecycleEvent.ProtoReflect.Descriptor instead.
+func (*AccountLifecycleEvent) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AccountLifecycleEvent) GetType() AccountLifecycleEventType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountLifecycleEventType_LIFECYCLE_CREATED
+}
+
+func (x *AccountLifecycleEvent) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountLifecycleEvent) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountLifecycleEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AccountLifecycleEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type SubscribeAccountUpdatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The identifier of the account to stream the updates of.
+	Account *AccountIdentifier `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *SubscribeAccountUpdatesRequest) Reset() {
+	*x = SubscribeAccountUpdatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeAccountUpdatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeAccountUpdatesRequest) ProtoMessage() {}
+
+func (x *SubscribeAccountUpdatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeAccountUpdatesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeAccountUpdatesRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SubscribeAccountUpdatesRequest) GetAccount() *AccountIdentifier {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type AccountUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of update.
+	Type AccountUpdateType `protobuf:"varint,1,opt,name=type,proto3,enum=litrpc.AccountUpdateType" json:"type,omitempty"`
+	// The full state of the account.
+	Account *Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// The unix timestamp of the update.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *AccountUpdate) Reset() {
+	*x = AccountUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountUpdate) ProtoMessage() {}
+
+func (x *AccountUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountUpdate.ProtoReflect.Descriptor instead.
+func (*AccountUpdate) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AccountUpdate) GetType() AccountUpdateType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountUpdateType_UPDATE_STATE
+}
+
+func (x *AccountUpdate) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *AccountUpdate) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type VerifyAccountsStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VerifyAccountsStoreRequest) Reset() {
+	*x = VerifyAccountsStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAccountsStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAccountsStoreRequest) ProtoMessage() {}
+
+func (x *VerifyAccountsStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAccountsStoreRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAccountsStoreRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{30}
+}
+
+type AccountStoreIssue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of the issue. One of duplicate_label, invalid_label,
+	// negative_balance, shared_invoice, shared_payment or untracked_payment.
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The hexadecimal ID of the account the issue was found on.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// A human-readable description of the issue.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *AccountStoreIssue) Reset() {
+	*x = AccountStoreIssue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountStoreIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStoreIssue) ProtoMessage() {}
+
+func (x *AccountStoreIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStoreIssue.ProtoReflect.Descriptor instead.
+func (*AccountStoreIssue) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AccountStoreIssue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AccountStoreIssue) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountStoreIssue) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type VerifyAccountsStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of accounts that were checked.
+	NumAccounts uint32 `protobuf:"varint,1,opt,name=num_accounts,json=numAccounts,proto3" json:"num_accounts,omitempty"`
+	// All issues that were found. An empty list means the store is healthy.
+	Issues []*AccountStoreIssue `protobuf:"bytes,2,rep,name=issues,proto3" json:"issues,omitempty"`
+}
+
+func (x *VerifyAccountsStoreResponse) Reset() {
+	*x = VerifyAccountsStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyAccountsStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAccountsStoreResponse) ProtoMessage() {}
+
+func (x *VerifyAccountsStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAccountsStoreResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAccountsStoreResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VerifyAccountsStoreResponse) GetNumAccounts() uint32 {
+	if x != nil {
+		return x.NumAccounts
+	}
+	return 0
+}
+
+func (x *VerifyAccountsStoreResponse) GetIssues() []*AccountStoreIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+type GetAccountHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hexadecimal ID of the account to return the history of. Either the ID
+	// or the label must be set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account to return the history of. This only works for
+	// accounts that still exist.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *GetAccountHistoryRequest) Reset() {
+	*x = GetAccountHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountHistoryRequest) ProtoMessage() {}
+
+func (x *GetAccountHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetAccountHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAccountHistoryRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type AccountEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of event.
+	Type AccountEventType `protobuf:"varint,1,opt,name=type,proto3,enum=litrpc.AccountEventType" json:"type,omitempty"`
+	// The unix timestamp in seconds at which the event happened.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Who caused the event. Changes made through the accounts RPCs record the
+	// address of the client, changes made by litd itself record "system".
+	Actor string `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	// A human-readable description of the change.
+	Details string `protobuf:"bytes,4,opt,name=details,proto3" json:"details,omitempty"`
+}
+
+func (x *AccountEvent) Reset() {
+	*x = AccountEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountEvent) ProtoMessage() {}
+
+func (x *AccountEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountEvent.ProtoReflect.Descriptor instead.
+func (*AccountEvent) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AccountEvent) GetType() AccountEventType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountEventType_ACCOUNT_CREATED
+}
+
+func (x *AccountEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *AccountEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AccountEvent) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+type GetAccountHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The lifecycle events of the account, oldest first.
+	Events []*AccountEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetAccountHistoryResponse) Reset() {
+	*x = GetAccountHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountHistoryResponse) ProtoMessage() {}
+
+func (x *GetAccountHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetAccountHistoryResponse) GetEvents() []*AccountEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type GetAccountLedgerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hexadecimal ID of the account to return the ledger of. Either the ID or
+	// the label must be set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account to return the ledger of. This only works for
+	// accounts that still exist.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// If set, only entries recorded at or after this unix timestamp in seconds
+	// are returned.
+	StartTime uint64 `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// If set, only entries recorded at or before this unix timestamp in seconds
+	// are returned.
+	EndTime uint64 `protobuf:"varint,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *GetAccountLedgerRequest) Reset() {
+	*x = GetAccountLedgerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountLedgerRequest) ProtoMessage() {}
+
+func (x *GetAccountLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountLedgerRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetAccountLedgerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAccountLedgerRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *GetAccountLedgerRequest) GetStartTime() uint64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetAccountLedgerRequest) GetEndTime() uint64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+type LedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of balance change.
+	Type LedgerEntryType `protobuf:"varint,1,opt,name=type,proto3,enum=litrpc.LedgerEntryType" json:"type,omitempty"`
+	// The unix timestamp in seconds at which the balance changed.
+	Timestamp int64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The amount in millisatoshis by which the balance changed. It is negative if
+	// the balance went down.
+	DeltaMsat int64 `protobuf:"varint,3,opt,name=delta_msat,json=deltaMsat,proto3" json:"delta_msat,omitempty"`
+	// The balance of the account in millisatoshis after the change.
+	BalanceMsat int64 `protobuf:"varint,4,opt,name=balance_msat,json=balanceMsat,proto3" json:"balance_msat,omitempty"`
+	// The hex encoded hash of the invoice or payment that caused the change. It
+	// is empty for changes that weren't caused by either.
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerEntry) ProtoMessage() {}
+
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *LedgerEntry) GetType() LedgerEntryType {
+	if x != nil {
+		return x.Type
+	}
+	return LedgerEntryType_LEDGER_OPENING
+}
+
+func (x *LedgerEntry) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetDeltaMsat() int64 {
+	if x != nil {
+		return x.DeltaMsat
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetBalanceMsat() int64 {
+	if x != nil {
+		return x.BalanceMsat
+	}
+	return 0
+}
+
+func (x *LedgerEntry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+type GetAccountLedgerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ledger entries of the account, oldest first.
+	Entries []*LedgerEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetAccountLedgerResponse) Reset() {
+	*x = GetAccountLedgerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAccountLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountLedgerResponse) ProtoMessage() {}
+
+func (x *GetAccountLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountLedgerResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetAccountLedgerResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type AddCreditRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The memo prefix that settled invoices must have to credit the account. It
+	// must not be empty and not be used by another rule.
+	MemoPrefix string `protobuf:"bytes,1,opt,name=memo_prefix,json=memoPrefix,proto3" json:"memo_prefix,omitempty"`
+	// The hexadecimal ID of the account to credit. Either the ID or the label
+	// must be set.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account to credit.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+}
+
+func (x *AddCreditRuleRequest) Reset() {
+	*x = AddCreditRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddCreditRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCreditRuleRequest) ProtoMessage() {}
+
+func (x *AddCreditRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCreditRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddCreditRuleRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddCreditRuleRequest) GetMemoPrefix() string {
+	if x != nil {
+		return x.MemoPrefix
+	}
+	return ""
+}
+
+func (x *AddCreditRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddCreditRuleRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+type CreditRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The memo prefix that settled invoices must have to credit the account.
+	MemoPrefix string `protobuf:"bytes,1,opt,name=memo_prefix,json=memoPrefix,proto3" json:"memo_prefix,omitempty"`
+	// The hexadecimal ID of the account that is credited.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The unix timestamp in seconds at which the rule was added.
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *CreditRule) Reset() {
+	*x = CreditRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreditRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditRule) ProtoMessage() {}
+
+func (x *CreditRule) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditRule.ProtoReflect.Descriptor instead.
+func (*CreditRule) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CreditRule) GetMemoPrefix() string {
+	if x != nil {
+		return x.MemoPrefix
+	}
+	return ""
+}
+
+func (x *CreditRule) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CreditRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ListCreditRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCreditRulesRequest) Reset() {
+	*x = ListCreditRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCreditRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditRulesRequest) ProtoMessage() {}
+
+func (x *ListCreditRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCreditRulesRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{41}
+}
+
+type ListCreditRulesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All credit rules, ordered by their memo prefix.
+	Rules []*CreditRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *ListCreditRulesResponse) Reset() {
+	*x = ListCreditRulesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCreditRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditRulesResponse) ProtoMessage() {}
+
+func (x *ListCreditRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCreditRulesResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListCreditRulesResponse) GetRules() []*CreditRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type RemoveCreditRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The memo prefix of the rule to remove.
+	MemoPrefix string `protobuf:"bytes,1,opt,name=memo_prefix,json=memoPrefix,proto3" json:"memo_prefix,omitempty"`
+}
+
+func (x *RemoveCreditRuleRequest) Reset() {
+	*x = RemoveCreditRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCreditRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCreditRuleRequest) ProtoMessage() {}
+
+func (x *RemoveCreditRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCreditRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCreditRuleRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RemoveCreditRuleRequest) GetMemoPrefix() string {
+	if x != nil {
+		return x.MemoPrefix
+	}
+	return ""
+}
+
+type RemoveCreditRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveCreditRuleResponse) Reset() {
+	*x = RemoveCreditRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveCreditRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCreditRuleResponse) ProtoMessage() {}
+
+func (x *RemoveCreditRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCreditRuleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCreditRuleResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{44}
+}
+
+type GetTotalInFlightExposureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetTotalInFlightExposureRequest) Reset() {
+	*x = GetTotalInFlightExposureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTotalInFlightExposureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalInFlightExposureRequest) ProtoMessage() {}
+
+func (x *GetTotalInFlightExposureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalInFlightExposureRequest.ProtoReflect.Descriptor instead.
+func (*GetTotalInFlightExposureRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{45}
+}
+
+type GetTotalInFlightExposureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The total in-flight amount in satoshis.
+	TotalInFlightSat uint64 `protobuf:"varint,1,opt,name=total_in_flight_sat,json=totalInFlightSat,proto3" json:"total_in_flight_sat,omitempty"`
+	// The total in-flight amount in millisatoshis.
+	TotalInFlightMsat uint64 `protobuf:"varint,2,opt,name=total_in_flight_msat,json=totalInFlightMsat,proto3" json:"total_in_flight_msat,omitempty"`
+	// The number of in-flight payments.
+	NumPayments uint32 `protobuf:"varint,3,opt,name=num_payments,json=numPayments,proto3" json:"num_payments,omitempty"`
+	// The number of accounts with at least one in-flight payment.
+	NumAccounts uint32 `protobuf:"varint,4,opt,name=num_accounts,json=numAccounts,proto3" json:"num_accounts,omitempty"`
+}
+
+func (x *GetTotalInFlightExposureResponse) Reset() {
+	*x = GetTotalInFlightExposureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTotalInFlightExposureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTotalInFlightExposureResponse) ProtoMessage() {}
+
+func (x *GetTotalInFlightExposureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTotalInFlightExposureResponse.ProtoReflect.Descriptor instead.
+func (*GetTotalInFlightExposureResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetTotalInFlightExposureResponse) GetTotalInFlightSat() uint64 {
+	if x != nil {
+		return x.TotalInFlightSat
+	}
+	return 0
+}
+
+func (x *GetTotalInFlightExposureResponse) GetTotalInFlightMsat() uint64 {
+	if x != nil {
+		return x.TotalInFlightMsat
+	}
+	return 0
+}
+
+func (x *GetTotalInFlightExposureResponse) GetNumPayments() uint32 {
+	if x != nil {
+		return x.NumPayments
+	}
+	return 0
+}
+
+func (x *GetTotalInFlightExposureResponse) GetNumAccounts() uint32 {
+	if x != nil {
+		return x.NumAccounts
+	}
+	return 0
+}
+
+type MintPaymentMacaroonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hexadecimal ID of the account to bake the macaroon for. Either the ID
+	// or the label must be set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account to bake the macaroon for.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The payment hash of the only invoice the macaroon can be used to pay.
+	PaymentHash []byte `protobuf:"bytes,3,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+}
+
+func (x *MintPaymentMacaroonRequest) Reset() {
+	*x = MintPaymentMacaroonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintPaymentMacaroonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintPaymentMacaroonRequest) ProtoMessage() {}
+
+func (x *MintPaymentMacaroonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintPaymentMacaroonRequest.ProtoReflect.Descriptor instead.
+func (*MintPaymentMacaroonRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *MintPaymentMacaroonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MintPaymentMacaroonRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *MintPaymentMacaroonRequest) GetPaymentHash() []byte {
+	if x != nil {
+		return x.PaymentHash
+	}
+	return nil
+}
+
+type MintPaymentMacaroonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The macaroon that is restricted to paying the invoice.
+	Macaroon []byte `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+}
+
+func (x *MintPaymentMacaroonResponse) Reset() {
+	*x = MintPaymentMacaroonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MintPaymentMacaroonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintPaymentMacaroonResponse) ProtoMessage() {}
+
+func (x *MintPaymentMacaroonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintPaymentMacaroonResponse.ProtoReflect.Descriptor instead.
+func (*MintPaymentMacaroonResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MintPaymentMacaroonResponse) GetMacaroon() []byte {
+	if x != nil {
+		return x.Macaroon
+	}
+	return nil
+}
+
+type ExportAccountMacaroonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hexadecimal ID of the account to bake the macaroon for. Either the ID
+	// or the label must be set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account to bake the macaroon for.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// An optional expiration date of the macaroon as a timestamp. It must not be
+	// after the expiration date of the account. Set to 0 to let the macaroon
+	// expire with the account.
+	MacaroonExpirationDate int64 `protobuf:"varint,3,opt,name=macaroon_expiration_date,json=macaroonExpirationDate,proto3" json:"macaroon_expiration_date,omitempty"`
+}
+
+func (x *ExportAccountMacaroonRequest) Reset() {
+	*x = ExportAccountMacaroonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAccountMacaroonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountMacaroonRequest) ProtoMessage() {}
+
+func (x *ExportAccountMacaroonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountMacaroonRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountMacaroonRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExportAccountMacaroonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportAccountMacaroonRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ExportAccountMacaroonRequest) GetMacaroonExpirationDate() int64 {
+	if x != nil {
+		return x.MacaroonExpirationDate
+	}
+	return 0
+}
+
+type ExportAccountMacaroonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new macaroon that can be used to access the account.
+	Macaroon []byte `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	// The resolved expiration date of the macaroon as a unix timestamp in
+	// seconds. If no macaroon expiration date was requested, this is the
+	// expiration date of the account. Zero means the macaroon does not expire.
+	MacaroonExpirationDate int64 `protobuf:"varint,2,opt,name=macaroon_expiration_date,json=macaroonExpirationDate,proto3" json:"macaroon_expiration_date,omitempty"`
+}
+
+func (x *ExportAccountMacaroonResponse) Reset() {
+	*x = ExportAccountMacaroonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAccountMacaroonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountMacaroonResponse) ProtoMessage() {}
+
+func (x *ExportAccountMacaroonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountMacaroonResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccountMacaroonResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportAccountMacaroonResponse) GetMacaroon() []byte {
+	if x != nil {
+		return x.Macaroon
+	}
+	return nil
+}
+
+func (x *ExportAccountMacaroonResponse) GetMacaroonExpirationDate() int64 {
+	if x != nil {
+		return x.MacaroonExpirationDate
+	}
+	return 0
+}
+
+type RotateAccountMacaroonRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hexadecimal ID of the account to rotate the macaroon of. Either the ID
+	// or the label must be set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account to rotate the macaroon of.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// An optional expiration date of the new macaroon as a timestamp. It must not
+	// be after the expiration date of the account. Set to 0 to let the macaroon
+	// expire together with the account.
+	MacaroonExpirationDate int64 `protobuf:"varint,3,opt,name=macaroon_expiration_date,json=macaroonExpirationDate,proto3" json:"macaroon_expiration_date,omitempty"`
+}
+
+func (x *RotateAccountMacaroonRequest) Reset() {
+	*x = RotateAccountMacaroonRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateAccountMacaroonRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAccountMacaroonRequest) ProtoMessage() {}
+
+func (x *RotateAccountMacaroonRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAccountMacaroonRequest.ProtoReflect.Descriptor instead.
+func (*RotateAccountMacaroonRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RotateAccountMacaroonRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RotateAccountMacaroonRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *RotateAccountMacaroonRequest) GetMacaroonExpirationDate() int64 {
+	if x != nil {
+		return x.MacaroonExpirationDate
+	}
+	return 0
+}
+
+type RotateAccountMacaroonResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The new macaroon that can be used to access the account.
+	Macaroon []byte `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	// The resolved expiration date of the macaroon as a unix timestamp in
+	// seconds. If no macaroon expiration date was requested, this is the
+	// expiration date of the account. Zero means the macaroon does not expire.
+	MacaroonExpirationDate int64 `protobuf:"varint,2,opt,name=macaroon_expiration_date,json=macaroonExpirationDate,proto3" json:"macaroon_expiration_date,omitempty"`
+}
+
+func (x *RotateAccountMacaroonResponse) Reset() {
+	*x = RotateAccountMacaroonResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateAccountMacaroonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAccountMacaroonResponse) ProtoMessage() {}
+
+func (x *RotateAccountMacaroonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAccountMacaroonResponse.ProtoReflect.Descriptor instead.
+func (*RotateAccountMacaroonResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RotateAccountMacaroonResponse) GetMacaroon() []byte {
+	if x != nil {
+		return x.Macaroon
+	}
+	return nil
+}
+
+func (x *RotateAccountMacaroonResponse) GetMacaroonExpirationDate() int64 {
+	if x != nil {
+		return x.MacaroonExpirationDate
+	}
+	return 0
+}
+
+type EstimateAccountRunwayRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hexadecimal ID of the account to estimate the runway of. Either the ID
+	// or the label must be set.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account to estimate the runway of.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The window in seconds before now over which the spend rate is averaged.
+	// Defaults to 30 days if not set.
+	WindowSeconds uint64 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (x *EstimateAccountRunwayRequest) Reset() {
+	*x = EstimateAccountRunwayRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateAccountRunwayRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateAccountRunwayRequest) ProtoMessage() {}
+
+func (x *EstimateAccountRunwayRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateAccountRunwayRequest.ProtoReflect.Descriptor instead.
+func (*EstimateAccountRunwayRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *EstimateAccountRunwayRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EstimateAccountRunwayRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *EstimateAccountRunwayRequest) GetWindowSeconds() uint64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+type EstimateAccountRunwayResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The window in seconds over which the spend rate was averaged.
+	WindowSeconds uint64 `protobuf:"varint,1,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// The amount in satoshis the account spent within the window.
+	SpentSat uint64 `protobuf:"varint,2,opt,name=spent_sat,json=spentSat,proto3" json:"spent_sat,omitempty"`
+	// The number of payments the account made within the window.
+	NumPayments uint32 `protobuf:"varint,3,opt,name=num_payments,json=numPayments,proto3" json:"num_payments,omitempty"`
+	// The current balance of the account in satoshis.
+	CurrentBalance int64 `protobuf:"varint,4,opt,name=current_balance,json=currentBalance,proto3" json:"current_balance,omitempty"`
+	// The average amount in satoshis spent per day within the window.
+	SpendRateSatPerDay float64 `protobuf:"fixed64,5,opt,name=spend_rate_sat_per_day,json=spendRateSatPerDay,proto3" json:"spend_rate_sat_per_day,omitempty"`
+	// The unix timestamp in seconds at which the balance is projected to reach
+	// zero. Zero means that the account didn't spend anything within the
+	// window, so no depletion is projected.
+	DepletionDate int64 `protobuf:"varint,6,opt,name=depletion_date,json=depletionDate,proto3" json:"depletion_date,omitempty"`
+	// The number of days until the projected depletion date. Only set if a
+	// depletion date is projected.
+	DaysRemaining float64 `protobuf:"fixed64,7,opt,name=days_remaining,json=daysRemaining,proto3" json:"days_remaining,omitempty"`
+}
+
+func (x *EstimateAccountRunwayResponse) Reset() {
+	*x = EstimateAccountRunwayResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateAccountRunwayResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateAccountRunwayResponse) ProtoMessage() {}
+
+func (x *EstimateAccountRunwayResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateAccountRunwayResponse.ProtoReflect.Descriptor instead.
+func (*EstimateAccountRunwayResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *EstimateAccountRunwayResponse) GetWindowSeconds() uint64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *EstimateAccountRunwayResponse) GetSpentSat() uint64 {
+	if x != nil {
+		return x.SpentSat
+	}
+	return 0
+}
+
+func (x *EstimateAccountRunwayResponse) GetNumPayments() uint32 {
+	if x != nil {
+		return x.NumPayments
+	}
+	return 0
+}
+
+func (x *EstimateAccountRunwayResponse) GetCurrentBalance() int64 {
+	if x != nil {
+		return x.CurrentBalance
+	}
+	return 0
+}
+
+func (x *EstimateAccountRunwayResponse) GetSpendRateSatPerDay() float64 {
+	if x != nil {
+		return x.SpendRateSatPerDay
+	}
+	return 0
+}
+
+func (x *EstimateAccountRunwayResponse) GetDepletionDate() int64 {
+	if x != nil {
+		return x.DepletionDate
+	}
+	return 0
+}
+
+func (x *EstimateAccountRunwayResponse) GetDaysRemaining() float64 {
+	if x != nil {
+		return x.DaysRemaining
+	}
+	return 0
+}
+
+type ExportAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ExportAccountsRequest) Reset() {
+	*x = ExportAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountsRequest) ProtoMessage() {}
+
+func (x *ExportAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ExportAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{55}
+}
+
+type ExportedAccount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The hexadecimal ID of the account.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the account, if it has one.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The initial balance of the account in millisatoshis.
+	InitialBalanceMsat uint64 `protobuf:"varint,3,opt,name=initial_balance_msat,json=initialBalanceMsat,proto3" json:"initial_balance_msat,omitempty"`
+	// The current balance of the account in millisatoshis.
+	CurrentBalanceMsat int64 `protobuf:"varint,4,opt,name=current_balance_msat,json=currentBalanceMsat,proto3" json:"current_balance_msat,omitempty"`
+	// The expiration date of the account as a unix timestamp in seconds. Zero
+	// means the account never expires.
+	ExpirationDate int64 `protobuf:"varint,5,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	// The maximum amount of a single HTLC in millisatoshis. Zero is unlimited.
+	MaxHtlcMsat uint64 `protobuf:"varint,6,opt,name=max_htlc_msat,json=maxHtlcMsat,proto3" json:"max_htlc_msat,omitempty"`
+	// The soft cap of the account in millisatoshis. Zero means no soft cap.
+	SoftCapMsat uint64 `protobuf:"varint,7,opt,name=soft_cap_msat,json=softCapMsat,proto3" json:"soft_cap_msat,omitempty"`
+	// Whether the account is a sandbox account that is used for testing only.
+	Sandbox bool `protobuf:"varint,8,opt,name=sandbox,proto3" json:"sandbox,omitempty"`
+	// The keysend budget of the account in millisatoshis per second. Zero means
+	// keysend payments are not limited.
+	KeysendBudgetMsatPerSec uint64 `protobuf:"varint,9,opt,name=keysend_budget_msat_per_sec,json=keysendBudgetMsatPerSec,proto3" json:"keysend_budget_msat_per_sec,omitempty"`
+	// The hex encoded public key of the node the account is bound to, if it is
+	// bound to one.
+	NodeId string `protobuf:"bytes,10,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// The maximum amount in millisatoshis the account may be credited for a
+	// single amountless invoice. Zero means amountless invoices are not capped.
+	AmountlessInvoiceMaxMsat uint64 `protobuf:"varint,11,opt,name=amountless_invoice_max_msat,json=amountlessInvoiceMaxMsat,proto3" json:"amountless_invoice_max_msat,omitempty"`
+	// The balance in millisatoshis below which the account is topped up
+	// automatically. Zero means the account has no top-up policy.
+	TopUpMinBalanceMsat uint64 `protobuf:"varint,12,opt,name=top_up_min_balance_msat,json=topUpMinBalanceMsat,proto3" json:"top_up_min_balance_msat,omitempty"`
+	// The amount in millisatoshis the account is topped up with.
+	TopUpAmountMsat uint64 `protobuf:"varint,13,opt,name=top_up_amount_msat,json=topUpAmountMsat,proto3" json:"top_up_amount_msat,omitempty"`
+	// The maximum amount in millisatoshis the account may spend within the
+	// window of its spend rate limit. Zero means the account has no limit.
+	MaxMsatPerWindow uint64 `protobuf:"varint,14,opt,name=max_msat_per_window,json=maxMsatPerWindow,proto3" json:"max_msat_per_window,omitempty"`
+	// The length of the window of the spend rate limit in seconds.
+	WindowSeconds uint64 `protobuf:"varint,15,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// The lead time of the expiry warning in seconds.
+	ExpiryWarningSeconds uint64 `protobuf:"varint,16,opt,name=expiry_warning_seconds,json=expiryWarningSeconds,proto3" json:"expiry_warning_seconds,omitempty"`
+	// The hex encoded public keys of the only nodes the account may pay.
+	AllowedDestinations []string `protobuf:"bytes,17,rep,name=allowed_destinations,json=allowedDestinations,proto3" json:"allowed_destinations,omitempty"`
+	// The maximum number of open invoices of the account.
+	MaxOpenInvoices uint32 `protobuf:"varint,18,opt,name=max_open_invoices,json=maxOpenInvoices,proto3" json:"max_open_invoices,omitempty"`
+	// The maximum amount of an invoice created with the account in msat.
+	MaxInvoiceAmountMsat uint64 `protobuf:"varint,19,opt,name=max_invoice_amount_msat,json=maxInvoiceAmountMsat,proto3" json:"max_invoice_amount_msat,omitempty"`
+	// The maximum amount of a single payment sent by the account in msat.
+	MaxPaymentMsat uint64 `protobuf:"varint,20,opt,name=max_payment_msat,json=maxPaymentMsat,proto3" json:"max_payment_msat,omitempty"`
+}
+
+func (x *ExportedAccount) Reset() {
+	*x = ExportedAccount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportedAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportedAccount) ProtoMessage() {}
+
+func (x *ExportedAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportedAccount.ProtoReflect.Descriptor instead.
+func (*ExportedAccount) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ExportedAccount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExportedAccount) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ExportedAccount) GetInitialBalanceMsat() uint64 {
+	if x != nil {
+		return x.InitialBalanceMsat
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetCurrentBalanceMsat() int64 {
+	if x != nil {
+		return x.CurrentBalanceMsat
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetExpirationDate() int64 {
+	if x != nil {
+		return x.ExpirationDate
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetMaxHtlcMsat() uint64 {
+	if x != nil {
+		return x.MaxHtlcMsat
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetSoftCapMsat() uint64 {
+	if x != nil {
+		return x.SoftCapMsat
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetSandbox() bool {
+	if x != nil {
+		return x.Sandbox
+	}
+	return false
+}
+
+func (x *ExportedAccount) GetKeysendBudgetMsatPerSec() uint64 {
+	if x != nil {
+		return x.KeysendBudgetMsatPerSec
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ExportedAccount) GetAmountlessInvoiceMaxMsat() uint64 {
+	if x != nil {
+		return x.AmountlessInvoiceMaxMsat
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetTopUpMinBalanceMsat() uint64 {
+	if x != nil {
+		return x.TopUpMinBalanceMsat
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetTopUpAmountMsat() uint64 {
+	if x != nil {
+		return x.TopUpAmountMsat
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetMaxMsatPerWindow() uint64 {
+	if x != nil {
+		return x.MaxMsatPerWindow
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetWindowSeconds() uint64 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetExpiryWarningSeconds() uint64 {
+	if x != nil {
+		return x.ExpiryWarningSeconds
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetAllowedDestinations() []string {
+	if x != nil {
+		return x.AllowedDestinations
+	}
+	return nil
+}
+
+func (x *ExportedAccount) GetMaxOpenInvoices() uint32 {
+	if x != nil {
+		return x.MaxOpenInvoices
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetMaxInvoiceAmountMsat() uint64 {
+	if x != nil {
+		return x.MaxInvoiceAmountMsat
+	}
+	return 0
+}
+
+func (x *ExportedAccount) GetMaxPaymentMsat() uint64 {
+	if x != nil {
+		return x.MaxPaymentMsat
+	}
+	return 0
+}
+
+type ExportAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All accounts of the accounts database.
+	Accounts []*ExportedAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *ExportAccountsResponse) Reset() {
+	*x = ExportAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAccountsResponse) ProtoMessage() {}
+
+func (x *ExportAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ExportAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExportAccountsResponse) GetAccounts() []*ExportedAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type ImportAccountsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The accounts to import, as returned by ExportAccounts.
+	Accounts []*ExportedAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// Whether accounts that already exist should be overwritten with the
+	// imported balance and settings. Their invoices and payments are kept.
+	Overwrite bool `protobuf:"varint,2,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+}
+
+func (x *ImportAccountsRequest) Reset() {
+	*x = ImportAccountsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAccountsRequest) ProtoMessage() {}
+
+func (x *ImportAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ImportAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ImportAccountsRequest) GetAccounts() []*ExportedAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *ImportAccountsRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type ImportAccountsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The accounts as they were stored by the import.
+	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+}
+
+func (x *ImportAccountsResponse) Reset() {
+	*x = ImportAccountsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_accounts_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportAccountsResponse) ProtoMessage() {}
+
+func (x *ImportAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_accounts_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ImportAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_lit_accounts_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ImportAccountsResponse) GetAccounts() []*Account {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+var File_lit_accounts_proto protoreflect.FileDescriptor
+
+var file_lit_accounts_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x6c, 0x69, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x22, 0x9b, 0x06, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a,
+	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63, 0x53, 0x61, 0x74, 0x12,
+	0x38, 0x0a, 0x18, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x16, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x6f, 0x66,
+	0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x73, 0x6f, 0x66, 0x74, 0x43, 0x61, 0x70, 0x53, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x61,
+	0x6e, 0x64, 0x62, 0x6f, 0x78, 0x12, 0x3a, 0x0a, 0x1a, 0x6b, 0x65, 0x79, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6b, 0x65, 0x79, 0x73, 0x65,
+	0x6e, 0x64, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x53, 0x65,
+	0x63, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x4d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12,
+	0x29, 0x0a, 0x11, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x55,
+	0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65,
+	0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x31,
+	0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61,
+	0x78, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a,
+	0x16, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6d,
+	0x61, 0x78, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x61, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d, 0x61, 0x78,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x15, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x6d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0xa4, 0x09, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a,
+	0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x6d,
+	0x61, 0x78, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63, 0x53, 0x61, 0x74, 0x12, 0x20, 0x0a,
+	0x0c, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x43, 0x61, 0x70, 0x53, 0x61, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x12, 0x3a, 0x0a, 0x1a, 0x6b, 0x65, 0x79,
+	0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16, 0x6b,
+	0x65, 0x79, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x61, 0x74, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x1a, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x6c, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4d,
+	0x61, 0x78, 0x53, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x29, 0x0a,
+	0x11, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x61, 0x74, 0x12, 0x34,
+	0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6f,
+	0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x70, 0x65, 0x6e,
+	0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0c, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2b, 0x0a,
+	0x11, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x6d,
+	0x61, 0x78, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x22, 0x24, 0x0a, 0x0e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x28, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x74,
+	0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc7, 0x05, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63,
+	0x53, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x6f, 0x66, 0x74, 0x43,
+	0x61, 0x70, 0x53, 0x61, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x11, 0x74, 0x6f, 0x70, 0x5f, 0x75,
+	0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x57, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3c, 0x0a, 0x1a, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x18, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x6d, 0x61, 0x78,
+	0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x73, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d, 0x61, 0x78, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x61,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x22, 0x6d, 0x0a, 0x19, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x63, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x15, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x76,
+	0x0a, 0x13, 0x44, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x41, 0x0a, 0x14, 0x44, 0x65, 0x62, 0x69, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x1d, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x7c, 0x0a, 0x1e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x31,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xe1, 0x02, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0e, 0x73, 0x61, 0x6e,
+	0x64, 0x62, 0x6f, 0x78, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x61, 0x6e, 0x64, 0x62,
+	0x6f, 0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0d, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f,
+	0x78, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69,
+	0x6e, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0a, 0x6d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x31, 0x0a, 0x07, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x6f, 0x72,
+	0x74, 0x42, 0x79, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x6f, 0x72, 0x74, 0x5f, 0x64, 0x69, 0x72, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x6f,
+	0x72, 0x74, 0x44, 0x69, 0x72, 0x22, 0x6b, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x38, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x45, 0x0a, 0x0c,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x22, 0x6e, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x6e, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x55, 0x6e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x64, 0x22, 0x3a, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22,
+	0x82, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0b, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x77, 0x65, 0x65, 0x70, 0x54, 0x6f, 0x49, 0x64, 0x12, 0x24,
+	0x0a, 0x0e, 0x73, 0x77, 0x65, 0x65, 0x70, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x77, 0x65, 0x65, 0x70, 0x54, 0x6f, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x22, 0x71, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a,
+	0x11, 0x73, 0x77, 0x65, 0x70, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x77, 0x65, 0x70, 0x74, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x77, 0x65,
+	0x70, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x77, 0x65, 0x70, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x37, 0x0a, 0x1b, 0x50, 0x75, 0x72, 0x67, 0x65,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x22, 0x4b, 0x0a, 0x1c, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2b, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x4b, 0x0a,
+	0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x12, 0x10, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0c, 0x0a, 0x0a,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x54, 0x0a, 0x1d, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xd8, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21,
+	0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x45, 0x0a, 0x20, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
+	0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x15, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69,
+	0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x63,
+	0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x55,
+	0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x1c, 0x0a, 0x1a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a,
+	0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x73, 0x0a, 0x1b, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75,
+	0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x40, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x8a,
+	0x01, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x2c, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x49, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x79, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0xb8, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x17, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x49, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x6b, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x3a, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x22, 0x1a,
+	0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x0a, 0x1f, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x45, 0x78,
+	0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xc8, 0x01,
+	0x0a, 0x20, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x66,
+	0x6c, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x53, 0x61,
+	0x74, 0x12, 0x2f, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x66, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x65, 0x0a, 0x1a, 0x4d, 0x69, 0x6e, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x22,
+	0x39, 0x0a, 0x1b, 0x4d, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61,
+	0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x22, 0x7e, 0x0a, 0x1c, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x38, 0x0a, 0x18, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x16, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x75, 0x0a, 0x1d, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0x7e, 0x0a, 0x1c, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x65, 0x22, 0x75, 0x0a, 0x1d, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x38,
+	0x0a, 0x18, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x16, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x22, 0x6b, 0x0a, 0x1c, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x77, 0x61,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x25,
+	0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xb1, 0x02, 0x0a, 0x1d, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x77, 0x61, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b,
+	0x0a, 0x09, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x53, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
+	0x75, 0x6d, 0x5f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27,
+	0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x32, 0x0a, 0x16, 0x73, 0x70, 0x65, 0x6e, 0x64,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x64, 0x61,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x44, 0x61, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x64,
+	0x65, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x65, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61,
+	0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x61, 0x79, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x61, 0x79, 0x73,
+	0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x17, 0x0a, 0x15, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xeb, 0x06, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x30, 0x0a, 0x14,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30,
+	0x0a, 0x14, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
+	0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a,
+	0x0d, 0x73, 0x6f, 0x66, 0x74, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x6f, 0x66, 0x74, 0x43, 0x61, 0x70, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x73, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x12, 0x3c, 0x0a, 0x1b, 0x6b,
+	0x65, 0x79, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x17, 0x6b, 0x65, 0x79, 0x73, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x4d,
+	0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65,
+	0x49, 0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x6c, 0x65, 0x73, 0x73,
+	0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x18, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x6c,
+	0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x4d, 0x61, 0x78, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x34, 0x0a, 0x17, 0x74, 0x6f, 0x70, 0x5f, 0x75, 0x70, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x13, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x4d, 0x69, 0x6e, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x74, 0x6f, 0x70, 0x5f, 0x75,
+	0x70, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0f, 0x74, 0x6f, 0x70, 0x55, 0x70, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4d, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x31, 0x0a, 0x14, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f,
+	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
+	0x6d, 0x61, 0x78, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x14, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x41, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x61, 0x74,
+	0x22, 0x4d, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
+	0x6a, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x45, 0x0a, 0x16, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2a, 0x4b, 0x0a, 0x0d, 0x53, 0x61, 0x6e, 0x64, 0x62, 0x6f, 0x78, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x41, 0x4e, 0x44, 0x42, 0x4f, 0x58, 0x5f, 0x49,
+	0x4e, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x41, 0x4e, 0x44,
+	0x42, 0x4f, 0x58, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x44, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x53, 0x41, 0x4e, 0x44, 0x42, 0x4f, 0x58, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x2a,
+	0x95, 0x01, 0x0a, 0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x6f, 0x72, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x18,
+	0x0a, 0x14, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42,
+	0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54,
+	0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x10, 0x03, 0x12, 0x18, 0x0a,
+	0x14, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x43, 0x52,
+	0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x38, 0x0a, 0x0d, 0x53, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x52, 0x54,
+	0x5f, 0x41, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x2a, 0x69, 0x0a, 0x10, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54,
+	0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4c, 0x49, 0x47, 0x48,
+	0x54, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x41, 0x59, 0x4d,
+	0x45, 0x4e, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x7d, 0x0a, 0x19,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x49, 0x46,
+	0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x15, 0x0a, 0x11, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x49, 0x46, 0x45, 0x43,
+	0x59, 0x43, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1b,
+	0x0a, 0x17, 0x4c, 0x49, 0x46, 0x45, 0x43, 0x59, 0x43, 0x4c, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49,
+	0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x3f, 0x0a, 0x11, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53, 0x4f, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0xf7, 0x01, 0x0a,
+	0x10, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x45,
+	0x58, 0x50, 0x49, 0x52, 0x59, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54,
+	0x53, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x52, 0x45, 0x4e,
+	0x41, 0x4d, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x55, 0x50,
+	0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x07, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x43, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x5f, 0x4d, 0x41, 0x43, 0x41, 0x52, 0x4f, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x54,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x08, 0x2a, 0xd7, 0x01, 0x0a, 0x0f, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4c, 0x45,
+	0x44, 0x47, 0x45, 0x52, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45,
+	0x5f, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52,
+	0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x45, 0x44,
+	0x47, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x42, 0x49, 0x54, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x4c,
+	0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x10, 0x04, 0x12,
+	0x12, 0x0a, 0x0e, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4d, 0x45, 0x4e,
+	0x54, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x53, 0x57,
+	0x45, 0x45, 0x50, 0x10, 0x06, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f,
+	0x54, 0x4f, 0x50, 0x5f, 0x55, 0x50, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x4c, 0x45, 0x44, 0x47,
+	0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x10, 0x08, 0x12, 0x11, 0x0a,
+	0x0d, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x10, 0x09,
+	0x32, 0xb5, 0x12, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x4c, 0x0a,
+	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x43,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x44, 0x65, 0x62,
+	0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x44, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x25,
+	0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a,
+	0x12, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x21, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x4c,
+	0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x14,
+	0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x75,
+	0x72, 0x67, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x57, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x66, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x69, 0x66, 0x65,
+	0x63, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c,
+	0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4c, 0x69, 0x66, 0x65, 0x63, 0x79, 0x63, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x5a, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x13,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x22, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x12, 0x27, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x73, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x45, 0x78, 0x70, 0x6f, 0x73,
+	0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x20, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x75, 0x6e, 0x77, 0x61, 0x79, 0x12, 0x24, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75,
+	0x6e, 0x77, 0x61, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x77, 0x61, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x20, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0d,
+	0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12,
+	0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x13, 0x4d, 0x69,
+	0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f,
+	0x6e, 0x12, 0x22, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x74, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d,
+	0x69, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x15, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x64, 0x0a, 0x15, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x24, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67,
+	0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_lit_accounts_proto_rawDescOnce sync.Once
+	file_lit_accounts_proto_rawDescData = file_lit_accounts_proto_rawDesc
+)
+
+func file_lit_accounts_proto_rawDescGZIP() []byte {
+	file_lit_accounts_proto_rawDescOnce.Do(func() {
+		file_lit_accounts_proto_rawDescData = protoimpl.X.CompressGZIP(file_lit_accounts_proto_rawDescData)
+	})
+	return file_lit_accounts_proto_rawDescData
+}
+
+var file_lit_accounts_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_lit_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 60)
+var file_lit_accounts_proto_goTypes = []any{
+	(SandboxFilter)(0),                       // 0: litrpc.SandboxFilter
+	(AccountSortField)(0),                    // 1: litrpc.AccountSortField
+	(SortDirection)(0),                       // 2: litrpc.SortDirection
+	(PaymentEventType)(0),                    // 3: litrpc.PaymentEventType
+	(AccountLifecycleEventType)(0),           // 4: litrpc.AccountLifecycleEventType
+	(AccountUpdateType)(0),                   // 5: litrpc.AccountUpdateType
+	(AccountEventType)(0),                    // 6: litrpc.AccountEventType
+	(LedgerEntryType)(0),                     // 7: litrpc.LedgerEntryType
+	(*CreateAccountRequest)(nil),             // 8: litrpc.CreateAccountRequest
+	(*CreateAccountResponse)(nil),            // 9: litrpc.CreateAccountResponse
+	(*Account)(nil),                          // 10: litrpc.Account
+	(*AccountInvoice)(nil),                   // 11: litrpc.AccountInvoice
+	(*AccountPayment)(nil),                   // 12: litrpc.AccountPayment
+	(*UpdateAccountRequest)(nil),             // 13: litrpc.UpdateAccountRequest
+	(*RenameAccountLabelRequest)(nil),        // 14: litrpc.RenameAccountLabelRequest
+	(*CreditAccountRequest)(nil),             // 15: litrpc.CreditAccountRequest
+	(*CreditAccountResponse)(nil),            // 16: litrpc.CreditAccountResponse
+	(*DebitAccountRequest)(nil),              // 17: litrpc.DebitAccountRequest
+	(*DebitAccountResponse)(nil),             // 18: litrpc.DebitAccountResponse
+	(*TransferAccountBalanceRequest)(nil),    // 19: litrpc.TransferAccountBalanceRequest
+	(*TransferAccountBalanceResponse)(nil),   // 20: litrpc.TransferAccountBalanceResponse
+	(*ListAccountsRequest)(nil),              // 21: litrpc.ListAccountsRequest
+	(*ListAccountsResponse)(nil),             // 22: litrpc.ListAccountsResponse
+	(*ListAccountGroupsRequest)(nil),         // 23: litrpc.ListAccountGroupsRequest
+	(*AccountGroup)(nil),                     // 24: litrpc.AccountGroup
+	(*ListAccountGroupsResponse)(nil),        // 25: litrpc.ListAccountGroupsResponse
+	(*AccountInfoRequest)(nil),               // 26: litrpc.AccountInfoRequest
+	(*RemoveAccountRequest)(nil),             // 27: litrpc.RemoveAccountRequest
+	(*RemoveAccountResponse)(nil),            // 28: litrpc.RemoveAccountResponse
+	(*PurgeExpiredAccountsRequest)(nil),      // 29: litrpc.PurgeExpiredAccountsRequest
+	(*PurgeExpiredAccountsResponse)(nil),     // 30: litrpc.PurgeExpiredAccountsResponse
+	(*AccountIdentifier)(nil),                // 31: litrpc.AccountIdentifier
+	(*SubscribePaymentEventsRequest)(nil),    // 32: litrpc.SubscribePaymentEventsRequest
+	(*PaymentEvent)(nil),                     // 33: litrpc.PaymentEvent
+	(*SubscribeAccountLifecycleRequest)(nil), // 34: litrpc.SubscribeAccountLifecycleRequest
+	(*AccountLifecycleEvent)(nil),            // 35: litrpc.AccountLifecycleEvent
+	(*SubscribeAccountUpdatesRequest)(nil),   // 36: litrpc.SubscribeAccountUpdatesRequest
+	(*AccountUpdate)(nil),                    // 37: litrpc.AccountUpdate
+	(*VerifyAccountsStoreRequest)(nil),       // 38: litrpc.VerifyAccountsStoreRequest
+	(*AccountStoreIssue)(nil),                // 39: litrpc.AccountStoreIssue
+	(*VerifyAccountsStoreResponse)(nil),      // 40: litrpc.VerifyAccountsStoreResponse
+	(*GetAccountHistoryRequest)(nil),         // 41: litrpc.GetAccountHistoryRequest
+	(*AccountEvent)(nil),                     // 42: litrpc.AccountEvent
+	(*GetAccountHistoryResponse)(nil),        // 43: litrpc.GetAccountHistoryResponse
+	(*GetAccountLedgerRequest)(nil),          // 44: litrpc.GetAccountLedgerRequest
+	(*LedgerEntry)(nil),                      // 45: litrpc.LedgerEntry
+	(*GetAccountLedgerResponse)(nil),         // 46: litrpc.GetAccountLedgerResponse
+	(*AddCreditRuleRequest)(nil),             // 47: litrpc.AddCreditRuleRequest
+	(*CreditRule)(nil),                       // 48: litrpc.CreditRule
+	(*ListCreditRulesRequest)(nil),           // 49: litrpc.ListCreditRulesRequest
+	(*ListCreditRulesResponse)(nil),          // 50: litrpc.ListCreditRulesResponse
+	(*RemoveCreditRuleRequest)(nil),          // 51: litrpc.RemoveCreditRuleRequest
+	(*RemoveCreditRuleResponse)(nil),         // 52: litrpc.RemoveCreditRuleResponse
+	(*GetTotalInFlightExposureRequest)(nil),  // 53: litrpc.GetTotalInFlightExposureRequest
+	(*GetTotalInFlightExposureResponse)(nil), // 54: litrpc.GetTotalInFlightExposureResponse
+	(*MintPaymentMacaroonRequest)(nil),       // 55: litrpc.MintPaymentMacaroonRequest
+	(*MintPaymentMacaroonResponse)(nil),      // 56: litrpc.MintPaymentMacaroonResponse
+	(*ExportAccountMacaroonRequest)(nil),     // 57: litrpc.ExportAccountMacaroonRequest
+	(*ExportAccountMacaroonResponse)(nil),    // 58: litrpc.ExportAccountMacaroonResponse
+	(*RotateAccountMacaroonRequest)(nil),     // 59: litrpc.RotateAccountMacaroonRequest
+	(*RotateAccountMacaroonResponse)(nil),    // 60: litrpc.RotateAccountMacaroonResponse
+	(*EstimateAccountRunwayRequest)(nil),     // 61: litrpc.EstimateAccountRunwayRequest
+	(*EstimateAccountRunwayResponse)(nil),    // 62: litrpc.EstimateAccountRunwayResponse
+	(*ExportAccountsRequest)(nil),            // 63: litrpc.ExportAccountsRequest
+	(*ExportedAccount)(nil),                  // 64: litrpc.ExportedAccount
+	(*ExportAccountsResponse)(nil),           // 65: litrpc.ExportAccountsResponse
+	(*ImportAccountsRequest)(nil),            // 66: litrpc.ImportAccountsRequest
+	(*ImportAccountsResponse)(nil),           // 67: litrpc.ImportAccountsResponse
+}
+var file_lit_accounts_proto_depIdxs = []int32{
+	10, // 0: litrpc.CreateAccountResponse.account:type_name -> litrpc.Account
+	11, // 1: litrpc.Account.invoices:type_name -> litrpc.AccountInvoice
+	12, // 2: litrpc.Account.payments:type_name -> litrpc.AccountPayment
+	31, // 3: litrpc.RenameAccountLabelRequest.account:type_name -> litrpc.AccountIdentifier
+	31, // 4: litrpc.CreditAccountRequest.account:type_name -> litrpc.AccountIdentifier
+	10, // 5: litrpc.CreditAccountResponse.account:type_name -> litrpc.Account
+	31, // 6: litrpc.DebitAccountRequest.account:type_name -> litrpc.AccountIdentifier
+	10, // 7: litrpc.DebitAccountResponse.account:type_name -> litrpc.Account
+	31, // 8: litrpc.TransferAccountBalanceRequest.source:type_name -> litrpc.AccountIdentifier
+	31, // 9: litrpc.TransferAccountBalanceRequest.destination:type_name -> litrpc.AccountIdentifier
+	10, // 10: litrpc.TransferAccountBalanceResponse.source:type_name -> litrpc.Account
+	10, // 11: litrpc.TransferAccountBalanceResponse.destination:type_name -> litrpc.Account
+	0,  // 12: litrpc.ListAccountsRequest.sandbox_filter:type_name -> litrpc.SandboxFilter
+	1,  // 13: litrpc.ListAccountsRequest.sort_by:type_name -> litrpc.AccountSortField
+	2,  // 14: litrpc.ListAccountsRequest.sort_dir:type_name -> litrpc.SortDirection
+	10, // 15: litrpc.ListAccountsResponse.accounts:type_name -> litrpc.Account
+	24, // 16: litrpc.ListAccountGroupsResponse.groups:type_name -> litrpc.AccountGroup
+	10, // 17: litrpc.PurgeExpiredAccountsResponse.accounts:type_name -> litrpc.Account
+	31, // 18: litrpc.SubscribePaymentEventsRequest.account:type_name -> litrpc.AccountIdentifier
+	3,  // 19: litrpc.PaymentEvent.type:type_name -> litrpc.PaymentEventType
+	4,  // 20: litrpc.AccountLifecycleEvent.type:type_name -> litrpc.AccountLifecycleEventType
+	10, // 21: litrpc.AccountLifecycleEvent.account:type_name -> litrpc.Account
+	31, // 22: litrpc.SubscribeAccountUpdatesRequest.account:type_name -> litrpc.AccountIdentifier
+	5,  // 23: litrpc.AccountUpdate.type:type_name -> litrpc.AccountUpdateType
+	10, // 24: litrpc.AccountUpdate.account:type_name -> litrpc.Account
+	39, // 25: litrpc.VerifyAccountsStoreResponse.issues:type_name -> litrpc.AccountStoreIssue
+	6,  // 26: litrpc.AccountEvent.type:type_name -> litrpc.AccountEventType
+	42, // 27: litrpc.GetAccountHistoryResponse.events:type_name -> litrpc.AccountEvent
+	7,  // 28: litrpc.LedgerEntry.type:type_name -> litrpc.LedgerEntryType
+	45, // 29: litrpc.GetAccountLedgerResponse.entries:type_name -> litrpc.LedgerEntry
+	48, // 30: litrpc.ListCreditRulesResponse.rules:type_name -> litrpc.CreditRule
+	64, // 31: litrpc.ExportAccountsResponse.accounts:type_name -> litrpc.ExportedAccount
+	64, // 32: litrpc.ImportAccountsRequest.accounts:type_name -> litrpc.ExportedAccount
+	10, // 33: litrpc.ImportAccountsResponse.accounts:type_name -> litrpc.Account
+	8,  // 34: litrpc.Accounts.CreateAccount:input_type -> litrpc.CreateAccountRequest
+	13, // 35: litrpc.Accounts.UpdateAccount:input_type -> litrpc.UpdateAccountRequest
+	15, // 36: litrpc.Accounts.CreditAccount:input_type -> litrpc.CreditAccountRequest
+	17, // 37: litrpc.Accounts.DebitAccount:input_type -> litrpc.DebitAccountRequest
+	19, // 38: litrpc.Accounts.TransferAccountBalance:input_type -> litrpc.TransferAccountBalanceRequest
+	14, // 39: litrpc.Accounts.RenameAccountLabel:input_type -> litrpc.RenameAccountLabelRequest
+	21, // 40: litrpc.Accounts.ListAccounts:input_type -> litrpc.ListAccountsRequest
+	26, // 41: litrpc.Accounts.AccountInfo:input_type -> litrpc.AccountInfoRequest
+	27, // 42: litrpc.Accounts.RemoveAccount:input_type -> litrpc.RemoveAccountRequest
+	29, // 43: litrpc.Accounts.PurgeExpiredAccounts:input_type -> litrpc.PurgeExpiredAccountsRequest
+	32, // 44: litrpc.Accounts.SubscribePaymentEvents:input_type -> litrpc.SubscribePaymentEventsRequest
+	34, // 45: litrpc.Accounts.SubscribeAccountLifecycle:input_type -> litrpc.SubscribeAccountLifecycleRequest
+	36, // 46: litrpc.Accounts.SubscribeAccountUpdates:input_type -> litrpc.SubscribeAccountUpdatesRequest
+	38, // 47: litrpc.Accounts.VerifyAccountsStore:input_type -> litrpc.VerifyAccountsStoreRequest
+	53, // 48: litrpc.Accounts.GetTotalInFlightExposure:input_type -> litrpc.GetTotalInFlightExposureRequest
+	41, // 49: litrpc.Accounts.GetAccountHistory:input_type -> litrpc.GetAccountHistoryRequest
+	44, // 50: litrpc.Accounts.GetAccountLedger:input_type -> litrpc.GetAccountLedgerRequest
+	61, // 51: litrpc.Accounts.EstimateAccountRunway:input_type -> litrpc.EstimateAccountRunwayRequest
+	23, // 52: litrpc.Accounts.ListAccountGroups:input_type -> litrpc.ListAccountGroupsRequest
+	47, // 53: litrpc.Accounts.AddCreditRule:input_type -> litrpc.AddCreditRuleRequest
+	49, // 54: litrpc.Accounts.ListCreditRules:input_type -> litrpc.ListCreditRulesRequest
+	51, // 55: litrpc.Accounts.RemoveCreditRule:input_type -> litrpc.RemoveCreditRuleRequest
+	55, // 56: litrpc.Accounts.MintPaymentMacaroon:input_type -> litrpc.MintPaymentMacaroonRequest
+	57, // 57: litrpc.Accounts.ExportAccountMacaroon:input_type -> litrpc.ExportAccountMacaroonRequest
+	59, // 58: litrpc.Accounts.RotateAccountMacaroon:input_type -> litrpc.RotateAccountMacaroonRequest
+	63, // 59: litrpc.Accounts.ExportAccounts:input_type -> litrpc.ExportAccountsRequest
+	66, // 60: litrpc.Accounts.ImportAccounts:input_type -> litrpc.ImportAccountsRequest
+	9,  // 61: litrpc.Accounts.CreateAccount:output_type -> litrpc.CreateAccountResponse
+	10, // 62: litrpc.Accounts.UpdateAccount:output_type -> litrpc.Account
+	16, // 63: litrpc.Accounts.CreditAccount:output_type -> litrpc.CreditAccountResponse
+	18, // 64: litrpc.Accounts.DebitAccount:output_type -> litrpc.DebitAccountResponse
+	20, // 65: litrpc.Accounts.TransferAccountBalance:output_type -> litrpc.TransferAccountBalanceResponse
+	10, // 66: litrpc.Accounts.RenameAccountLabel:output_type -> litrpc.Account
+	22, // 67: litrpc.Accounts.ListAccounts:output_type -> litrpc.ListAccountsResponse
+	10, // 68: litrpc.Accounts.AccountInfo:output_type -> litrpc.Account
+	28, // 69: litrpc.Accounts.RemoveAccount:output_type -> litrpc.RemoveAccountResponse
+	30, // 70: litrpc.Accounts.PurgeExpiredAccounts:output_type -> litrpc.PurgeExpiredAccountsResponse
+	33, // 71: litrpc.Accounts.SubscribePaymentEvents:output_type -> litrpc.PaymentEvent
+	35, // 72: litrpc.Accounts.SubscribeAccountLifecycle:output_type -> litrpc.AccountLifecycleEvent
+	37, // 73: litrpc.Accounts.SubscribeAccountUpdates:output_type -> litrpc.AccountUpdate
+	40, // 74: litrpc.Accounts.VerifyAccountsStore:output_type -> litrpc.VerifyAccountsStoreResponse
+	54, // 75: litrpc.Accounts.GetTotalInFlightExposure:output_type -> litrpc.GetTotalInFlightExposureResponse
+	43, // 76: litrpc.Accounts.GetAccountHistory:output_type -> litrpc.GetAccountHistoryResponse
+	46, // 77: litrpc.Accounts.GetAccountLedger:output_type -> litrpc.GetAccountLedgerResponse
+	62, // 78: litrpc.Accounts.EstimateAccountRunway:output_type -> litrpc.EstimateAccountRunwayResponse
+	25, // 79: litrpc.Accounts.ListAccountGroups:output_type -> litrpc.ListAccountGroupsResponse
+	48, // 80: litrpc.Accounts.AddCreditRule:output_type -> litrpc.CreditRule
+	50, // 81: litrpc.Accounts.ListCreditRules:output_type -> litrpc.ListCreditRulesResponse
+	52, // 82: litrpc.Accounts.RemoveCreditRule:output_type -> litrpc.RemoveCreditRuleResponse
+	56, // 83: litrpc.Accounts.MintPaymentMacaroon:output_type -> litrpc.MintPaymentMacaroonResponse
+	58, // 84: litrpc.Accounts.ExportAccountMacaroon:output_type -> litrpc.ExportAccountMacaroonResponse
+	60, // 85: litrpc.Accounts.RotateAccountMacaroon:output_type -> litrpc.RotateAccountMacaroonResponse
+	65, // 86: litrpc.Accounts.ExportAccounts:output_type -> litrpc.ExportAccountsResponse
+	67, // 87: litrpc.Accounts.ImportAccounts:output_type -> litrpc.ImportAccountsResponse
+	61, // [61:88] is the sub-list for method output_type
+	34, // [34:61] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
+}
+
+func init() { file_lit_accounts_proto_init() }
+func file_lit_accounts_proto_init() {
+	if File_lit_accounts_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_lit_accounts_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*CreateAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Account); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountInvoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountPayment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*UpdateAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*RenameAccountLabelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CreditAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CreditAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*DebitAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*DebitAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferAccountBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*TransferAccountBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAccountGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*ListAccountGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[19].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeExpiredAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*PurgeExpiredAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribePaymentEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*PaymentEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeAccountLifecycleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountLifecycleEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeAccountUpdatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[29].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyAccountsStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountStoreIssue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*VerifyAccountsStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*AccountEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountLedgerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*LedgerEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*GetAccountLedgerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[39].Exporter = func(v any, i int) any {
+			switch v := v.(*AddCreditRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RemoveAccountResponse.ProtoReflect.Descriptor instead.
-func (*RemoveAccountResponse) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{14}
-}
-
-type AccountIdentifier struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Identifier:
-	//
-	//	*AccountIdentifier_Id
-	//	*AccountIdentifier_Label
-	Identifier isAccountIdentifier_Identifier `protobuf_oneof:"identifier"`
-}
-
-func (x *AccountIdentifier) Reset() {
-	*x = AccountIdentifier{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_accounts_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AccountIdentifier) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AccountIdentifier) ProtoMessage() {}
-
-func (x *AccountIdentifier) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_accounts_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		file_lit_accounts_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*CreditRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AccountIdentifier.ProtoReflect.Descriptor instead.
-func (*AccountIdentifier) Descriptor() ([]byte, []int) {
-	return file_lit_accounts_proto_rawDescGZIP(), []int{15}
-}
-
-func (m *AccountIdentifier) GetIdentifier() isAccountIdentifier_Identifier {
-	if m != nil {
-		return m.Identifier
-	}
-	return nil
-}
-
-func (x *AccountIdentifier) GetId() string {
-	if x, ok := x.GetIdentifier().(*AccountIdentifier_Id); ok {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *AccountIdentifier) GetLabel() string {
-	if x, ok := x.GetIdentifier().(*AccountIdentifier_Label); ok {
-		return x.Label
-	}
-	return ""
-}
-
-type isAccountIdentifier_Identifier interface {
-	isAccountIdentifier_Identifier()
-}
-
-type AccountIdentifier_Id struct {
-	// The ID of the account.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
-}
-
-type AccountIdentifier_Label struct {
-	// The label of the account.
-	Label string `protobuf:"bytes,2,opt,name=label,proto3,oneof"`
-}
-
-func (*AccountIdentifier_Id) isAccountIdentifier_Identifier() {}
-
-func (*AccountIdentifier_Label) isAccountIdentifier_Identifier() {}
-
-var File_lit_accounts_proto protoreflect.FileDescriptor
-
-var file_lit_accounts_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x6c, 0x69, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x22, 0x7e, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a,
-	0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x5e, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x22, 0xb3, 0x02, 0x0a,
-	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c,
-	0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x08,
-	0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x22, 0x24, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x5b, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14,
-	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x75, 0x6c, 0x6c, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2b,
-	0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x44, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x63, 0x0a, 0x14, 0x43, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x42, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x13, 0x44, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x41, 0x0a, 0x14, 0x44, 0x65, 0x62, 0x69, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x43, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x61, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x22, 0x3c, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x0a, 0x11, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x10,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x16, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x42, 0x0c, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x32, 0x86, 0x04, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x49, 0x0a, 0x0c, 0x44, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1b, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c,
-	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x62, 0x69, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69,
-	0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1b, 0x2e, 0x6c, 0x69, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69,
-	0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c, 0x69, 0x67, 0x68,
-	0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x6c,
-	0x69, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_lit_accounts_proto_rawDescOnce sync.Once
-	file_lit_accounts_proto_rawDescData = file_lit_accounts_proto_rawDesc
-)
-
-func file_lit_accounts_proto_rawDescGZIP() []byte {
-	file_lit_accounts_proto_rawDescOnce.Do(func() {
-		file_lit_accounts_proto_rawDescData = protoimpl.X.CompressGZIP(file_lit_accounts_proto_rawDescData)
-	})
-	return file_lit_accounts_proto_rawDescData
-}
-
-var file_lit_accounts_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
-var file_lit_accounts_proto_goTypes = []any{
-	(*CreateAccountRequest)(nil),  // 0: litrpc.CreateAccountRequest
-	(*CreateAccountResponse)(nil), // 1: litrpc.CreateAccountResponse
-	(*Account)(nil),               // 2: litrpc.Account
-	(*AccountInvoice)(nil),        // 3: litrpc.AccountInvoice
-	(*AccountPayment)(nil),        // 4: litrpc.AccountPayment
-	(*UpdateAccountRequest)(nil),  // 5: litrpc.UpdateAccountRequest
-	(*CreditAccountRequest)(nil),  // 6: litrpc.CreditAccountRequest
-	(*CreditAccountResponse)(nil), // 7: litrpc.CreditAccountResponse
-	(*DebitAccountRequest)(nil),   // 8: litrpc.DebitAccountRequest
-	(*DebitAccountResponse)(nil),  // 9: litrpc.DebitAccountResponse
-	(*ListAccountsRequest)(nil),   // 10: litrpc.ListAccountsRequest
-	(*ListAccountsResponse)(nil),  // 11: litrpc.ListAccountsResponse
-	(*AccountInfoRequest)(nil),    // 12: litrpc.AccountInfoRequest
-	(*RemoveAccountRequest)(nil),  // 13: litrpc.RemoveAccountRequest
-	(*RemoveAccountResponse)(nil), // 14: litrpc.RemoveAccountResponse
-	(*AccountIdentifier)(nil),     // 15: litrpc.AccountIdentifier
-}
-var file_lit_accounts_proto_depIdxs = []int32{
-	2,  // 0: litrpc.CreateAccountResponse.account:type_name -> litrpc.Account
-	3,  // 1: litrpc.Account.invoices:type_name -> litrpc.AccountInvoice
-	4,  // 2: litrpc.Account.payments:type_name -> litrpc.AccountPayment
-	15, // 3: litrpc.CreditAccountRequest.account:type_name -> litrpc.AccountIdentifier
-	2,  // 4: litrpc.CreditAccountResponse.account:type_name -> litrpc.Account
-	15, // 5: litrpc.DebitAccountRequest.account:type_name -> litrpc.AccountIdentifier
-	2,  // 6: litrpc.DebitAccountResponse.account:type_name -> litrpc.Account
-	2,  // 7: litrpc.ListAccountsResponse.accounts:type_name -> litrpc.Account
-	0,  // 8: litrpc.Accounts.CreateAccount:input_type -> litrpc.CreateAccountRequest
-	5,  // 9: litrpc.Accounts.UpdateAccount:input_type -> litrpc.UpdateAccountRequest
-	6,  // 10: litrpc.Accounts.CreditAccount:input_type -> litrpc.CreditAccountRequest
-	8,  // 11: litrpc.Accounts.DebitAccount:input_type -> litrpc.DebitAccountRequest
-	10, // 12: litrpc.Accounts.ListAccounts:input_type -> litrpc.ListAccountsRequest
-	12, // 13: litrpc.Accounts.AccountInfo:input_type -> litrpc.AccountInfoRequest
-	13, // 14: litrpc.Accounts.RemoveAccount:input_type -> litrpc.RemoveAccountRequest
-	1,  // 15: litrpc.Accounts.CreateAccount:output_type -> litrpc.CreateAccountResponse
-	2,  // 16: litrpc.Accounts.UpdateAccount:output_type -> litrpc.Account
-	7,  // 17: litrpc.Accounts.CreditAccount:output_type -> litrpc.CreditAccountResponse
-	9,  // 18: litrpc.Accounts.DebitAccount:output_type -> litrpc.DebitAccountResponse
-	11, // 19: litrpc.Accounts.ListAccounts:output_type -> litrpc.ListAccountsResponse
-	2,  // 20: litrpc.Accounts.AccountInfo:output_type -> litrpc.Account
-	14, // 21: litrpc.Accounts.RemoveAccount:output_type -> litrpc.RemoveAccountResponse
-	15, // [15:22] is the sub-list for method output_type
-	8,  // [8:15] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
-}
-
-func init() { file_lit_accounts_proto_init() }
-func file_lit_accounts_proto_init() {
-	if File_lit_accounts_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_lit_accounts_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateAccountRequest); i {
+		file_lit_accounts_proto_msgTypes[41].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCreditRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1206,8 +6503,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*CreateAccountResponse); i {
+		file_lit_accounts_proto_msgTypes[42].Exporter = func(v any, i int) any {
+			switch v := v.(*ListCreditRulesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1218,8 +6515,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*Account); i {
+		file_lit_accounts_proto_msgTypes[43].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveCreditRuleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1230,8 +6527,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*AccountInvoice); i {
+		file_lit_accounts_proto_msgTypes[44].Exporter = func(v any, i int) any {
+			switch v := v.(*RemoveCreditRuleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1242,8 +6539,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*AccountPayment); i {
+		file_lit_accounts_proto_msgTypes[45].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTotalInFlightExposureRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1254,8 +6551,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*UpdateAccountRequest); i {
+		file_lit_accounts_proto_msgTypes[46].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTotalInFlightExposureResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1266,8 +6563,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*CreditAccountRequest); i {
+		file_lit_accounts_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*MintPaymentMacaroonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1278,8 +6575,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*CreditAccountResponse); i {
+		file_lit_accounts_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*MintPaymentMacaroonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1290,8 +6587,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*DebitAccountRequest); i {
+		file_lit_accounts_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportAccountMacaroonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1302,8 +6599,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*DebitAccountResponse); i {
+		file_lit_accounts_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportAccountMacaroonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1314,8 +6611,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*ListAccountsRequest); i {
+		file_lit_accounts_proto_msgTypes[51].Exporter = func(v any, i int) any {
+			switch v := v.(*RotateAccountMacaroonRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1326,8 +6623,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*ListAccountsResponse); i {
+		file_lit_accounts_proto_msgTypes[52].Exporter = func(v any, i int) any {
+			switch v := v.(*RotateAccountMacaroonResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1338,8 +6635,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*AccountInfoRequest); i {
+		file_lit_accounts_proto_msgTypes[53].Exporter = func(v any, i int) any {
+			switch v := v.(*EstimateAccountRunwayRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1350,8 +6647,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveAccountRequest); i {
+		file_lit_accounts_proto_msgTypes[54].Exporter = func(v any, i int) any {
+			switch v := v.(*EstimateAccountRunwayResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1362,8 +6659,8 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*RemoveAccountResponse); i {
+		file_lit_accounts_proto_msgTypes[55].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportAccountsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1374,8 +6671,44 @@ func file_lit_accounts_proto_init() {
 				return nil
 			}
 		}
-		file_lit_accounts_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*AccountIdentifier); i {
+		file_lit_accounts_proto_msgTypes[56].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportedAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[57].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportAccountsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[58].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportAccountsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_accounts_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*ImportAccountsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1387,7 +6720,7 @@ func file_lit_accounts_proto_init() {
 			}
 		}
 	}
-	file_lit_accounts_proto_msgTypes[15].OneofWrappers = []any{
+	file_lit_accounts_proto_msgTypes[23].OneofWrappers = []any{
 		(*AccountIdentifier_Id)(nil),
 		(*AccountIdentifier_Label)(nil),
 	}
@@ -1396,13 +6729,14 @@ func file_lit_accounts_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lit_accounts_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      8,
+			NumMessages:   60,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_lit_accounts_proto_goTypes,
 		DependencyIndexes: file_lit_accounts_proto_depIdxs,
+		EnumInfos:         file_lit_accounts_proto_enumTypes,
 		MessageInfos:      file_lit_accounts_proto_msgTypes,
 	}.Build()
 	File_lit_accounts_proto = out.File
@@ -42,6 +42,16 @@ type AccountsClient interface {
 	// DebitAccount decreases the balance of an existing account in the account
 	// database.
 	DebitAccount(ctx context.Context, in *DebitAccountRequest, opts ...grpc.CallOption) (*DebitAccountResponse, error)
+	// litcli: `accounts transfer`
+	// TransferAccountBalance moves an amount from one account to another. Both
+	// balances are updated in a single database transaction, so no balance is
+	// lost if litd stops halfway. The transfer is rejected if the source doesn't
+	// have enough balance that isn't reserved for in-flight payments.
+	TransferAccountBalance(ctx context.Context, in *TransferAccountBalanceRequest, opts ...grpc.CallOption) (*TransferAccountBalanceResponse, error)
+	// litcli: `accounts rename-label`
+	// RenameAccountLabel changes the label of an existing account without
+	// touching any of its other fields. The new label must be unique.
+	RenameAccountLabel(ctx context.Context, in *RenameAccountLabelRequest, opts ...grpc.CallOption) (*Account, error)
 	// litcli: `accounts list`
 	// ListAccounts returns all accounts that are currently stored in the account
 	// database.
@@ -52,6 +62,116 @@ type AccountsClient interface {
 	// litcli: `accounts remove`
 	// RemoveAccount removes the given account from the account database.
 	RemoveAccount(ctx context.Context, in *RemoveAccountRequest, opts ...grpc.CallOption) (*RemoveAccountResponse, error)
+	// litcli: `accounts gc`
+	// PurgeExpiredAccounts removes all expired accounts from the account
+	// database. If preview is set, the accounts that would be removed are
+	// returned without removing them.
+	PurgeExpiredAccounts(ctx context.Context, in *PurgeExpiredAccountsRequest, opts ...grpc.CallOption) (*PurgeExpiredAccountsResponse, error)
+	// litcli: `accounts events`
+	// SubscribePaymentEvents streams the lifecycle events of all payments that
+	// are charged to an account, optionally limited to a single account. Only
+	// events that occur after the subscription was created are sent.
+	SubscribePaymentEvents(ctx context.Context, in *SubscribePaymentEventsRequest, opts ...grpc.CallOption) (Accounts_SubscribePaymentEventsClient, error)
+	// litcli: `accounts lifecycle`
+	// SubscribeAccountLifecycle streams an event whenever an account is created,
+	// updated or removed, so that an external system can maintain a mirror of
+	// all accounts. To build the mirror, subscribe first and then list all
+	// accounts. Every event carries a resume token that can be passed when
+	// subscribing again to receive the events that were missed in between.
+	SubscribeAccountLifecycle(ctx context.Context, in *SubscribeAccountLifecycleRequest, opts ...grpc.CallOption) (Accounts_SubscribeAccountLifecycleClient, error)
+	// litcli: `accounts info --watch`
+	// SubscribeAccountUpdates streams the full state of a single account, first
+	// when subscribing and then whenever its balance or expiration date changes.
+	// If the account has an expiry warning, an expiring soon update is sent once
+	// the account reaches the warning lead time before its expiration date. The
+	// stream ends once the account is removed. A client that is disconnected
+	// can simply subscribe again, as the current state is always sent first.
+	SubscribeAccountUpdates(ctx context.Context, in *SubscribeAccountUpdatesRequest, opts ...grpc.CallOption) (Accounts_SubscribeAccountUpdatesClient, error)
+	// litcli: `accounts verify`
+	// VerifyAccountsStore checks all accounts for inconsistencies, such as
+	// duplicate labels, negative balances, invoices or payments that are
+	// associated with more than one account and in-flight payments that aren't
+	// tracked. The store is only read, so this is safe to call on a live node.
+	VerifyAccountsStore(ctx context.Context, in *VerifyAccountsStoreRequest, opts ...grpc.CallOption) (*VerifyAccountsStoreResponse, error)
+	// litcli: `accounts exposure`
+	// GetTotalInFlightExposure returns the total amount of all payments charged
+	// to accounts that are currently in flight, including the maximum routing
+	// fees reserved for them. This is the amount the node could still lose to
+	// account holders if all of those payments succeed.
+	GetTotalInFlightExposure(ctx context.Context, in *GetTotalInFlightExposureRequest, opts ...grpc.CallOption) (*GetTotalInFlightExposureResponse, error)
+	// litcli: `accounts history`
+	// GetAccountHistory returns the lifecycle events of an account, such as its
+	// creation, manual changes of its balance, expiry, limits or label and its
+	// removal, in the order they happened. The history of a removed account can
+	// still be queried by its ID.
+	GetAccountHistory(ctx context.Context, in *GetAccountHistoryRequest, opts ...grpc.CallOption) (*GetAccountHistoryResponse, error)
+	// litcli: `accounts ledger`
+	// GetAccountLedger returns every change of the balance of an account, such as
+	// its opening balance, manual credits and debits, paid invoices and settled
+	// payments, together with the resulting balance, in the order they happened.
+	// The entries are recorded atomically with the balance change itself. The
+	// ledger of a removed account can still be queried by its ID.
+	GetAccountLedger(ctx context.Context, in *GetAccountLedgerRequest, opts ...grpc.CallOption) (*GetAccountLedgerResponse, error)
+	// litcli: `accounts runway`
+	// EstimateAccountRunway averages the amount an account spent on payments,
+	// including routing fees, over a recent window and estimates when its
+	// balance reaches zero if it keeps spending at that rate. Manual debits are
+	// not taken into account.
+	EstimateAccountRunway(ctx context.Context, in *EstimateAccountRunwayRequest, opts ...grpc.CallOption) (*EstimateAccountRunwayResponse, error)
+	// litcli: `accounts groups`
+	// ListAccountGroups returns the distinct groups of accounts with the number
+	// of accounts in each. The group of an account is the part of its label
+	// before the first occurrence of a separator, so the label "team/alice"
+	// belongs to the group "team". This can be used to populate a filter without
+	// listing all accounts.
+	ListAccountGroups(ctx context.Context, in *ListAccountGroupsRequest, opts ...grpc.CallOption) (*ListAccountGroupsResponse, error)
+	// litcli: `accounts credit-rule add`
+	// AddCreditRule maps an invoice memo prefix to an account. Once an invoice
+	// that isn't associated with any account is settled, the account with the
+	// rule for the longest prefix of its memo is credited with the paid amount.
+	// Only invoices settled after the rule was added are credited.
+	AddCreditRule(ctx context.Context, in *AddCreditRuleRequest, opts ...grpc.CallOption) (*CreditRule, error)
+	// litcli: `accounts credit-rule list`
+	// ListCreditRules returns all credit rules, ordered by their memo prefix.
+	ListCreditRules(ctx context.Context, in *ListCreditRulesRequest, opts ...grpc.CallOption) (*ListCreditRulesResponse, error)
+	// litcli: `accounts credit-rule remove`
+	// RemoveCreditRule removes the credit rule for a memo prefix. The rules of
+	// an account are also removed when the account is removed.
+	RemoveCreditRule(ctx context.Context, in *RemoveCreditRuleRequest, opts ...grpc.CallOption) (*RemoveCreditRuleResponse, error)
+	// litcli: `accounts mint-payment-macaroon`
+	// MintPaymentMacaroon bakes a macaroon for an existing account that can only
+	// be used to pay the invoice with the given payment hash. Once that payment
+	// has succeeded, the macaroon is rejected for all further requests.
+	MintPaymentMacaroon(ctx context.Context, in *MintPaymentMacaroonRequest, opts ...grpc.CallOption) (*MintPaymentMacaroonResponse, error)
+	// litcli: `accounts export-macaroon`
+	// ExportAccountMacaroon bakes a new macaroon for an existing account with
+	// the same permissions as the one returned by CreateAccount, for example to
+	// replace a lost macaroon file. The macaroon is baked under the same root
+	// key, so macaroons that were issued for the account before stay valid.
+	ExportAccountMacaroon(ctx context.Context, in *ExportAccountMacaroonRequest, opts ...grpc.CallOption) (*ExportAccountMacaroonResponse, error)
+	// litcli: `accounts rotate-macaroon`
+	// RotateAccountMacaroon replaces the root key of the macaroons of an existing
+	// account and returns a new macaroon with the same permissions as the one
+	// returned by CreateAccount. All macaroons that were issued for the account
+	// before, including exported and payment macaroons, fail authentication
+	// right away. The balance and settings of the account are not changed. The
+	// rotation is refused if the root key is shared with another account or a
+	// session, since their macaroons would stop working as well.
+	RotateAccountMacaroon(ctx context.Context, in *RotateAccountMacaroonRequest, opts ...grpc.CallOption) (*RotateAccountMacaroonResponse, error)
+	// litcli: `accounts export`
+	// ExportAccounts returns the balances and settings of all accounts, for
+	// example to back them up or to move them to another litd instance. Amounts
+	// are exported in millisatoshis, so that nothing is lost to rounding. The
+	// invoices and payments of the accounts are not exported, and neither are
+	// any macaroon secrets.
+	ExportAccounts(ctx context.Context, in *ExportAccountsRequest, opts ...grpc.CallOption) (*ExportAccountsResponse, error)
+	// litcli: `accounts import-db`
+	// ImportAccounts recreates accounts from an export with their original IDs.
+	// If any of the accounts already exists, nothing is imported unless
+	// overwrite is set. Macaroons that were issued for the accounts stay valid if
+	// they are imported into the litd instance that issued them. Otherwise, new
+	// macaroons need to be exported for the accounts.
+	ImportAccounts(ctx context.Context, in *ImportAccountsRequest, opts ...grpc.CallOption) (*ImportAccountsResponse, error)
 }
 
 type accountsClient struct {
@@ -98,6 +218,24 @@ func (c *accountsClient) DebitAccount(ctx context.Context, in *DebitAccountReque
 	return out, nil
 }
 
+func (c *accountsClient) TransferAccountBalance(ctx context.Context, in *TransferAccountBalanceRequest, opts ...grpc.CallOption) (*TransferAccountBalanceResponse, error) {
+	out := new(TransferAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/TransferAccountBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) RenameAccountLabel(ctx context.Context, in *RenameAccountLabelRequest, opts ...grpc.CallOption) (*Account, error) {
+	out := new(Account)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/RenameAccountLabel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountsClient) ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error) {
 	out := new(ListAccountsResponse)
 	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ListAccounts", in, out, opts...)
@@ -125,6 +263,237 @@ func (c *accountsClient) RemoveAccount(ctx context.Context, in *RemoveAccountReq
 	return out, nil
 }
 
+func (c *accountsClient) PurgeExpiredAccounts(ctx context.Context, in *PurgeExpiredAccountsRequest, opts ...grpc.CallOption) (*PurgeExpiredAccountsResponse, error) {
+	out := new(PurgeExpiredAccountsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/PurgeExpiredAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) SubscribePaymentEvents(ctx context.Context, in *SubscribePaymentEventsRequest, opts ...grpc.CallOption) (Accounts_SubscribePaymentEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Accounts_ServiceDesc.Streams[0], "/litrpc.Accounts/SubscribePaymentEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountsSubscribePaymentEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Accounts_SubscribePaymentEventsClient interface {
+	Recv() (*PaymentEvent, error)
+	grpc.ClientStream
+}
+
+type accountsSubscribePaymentEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountsSubscribePaymentEventsClient) Recv() (*PaymentEvent, error) {
+	m := new(PaymentEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *accountsClient) SubscribeAccountLifecycle(ctx context.Context, in *SubscribeAccountLifecycleRequest, opts ...grpc.CallOption) (Accounts_SubscribeAccountLifecycleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Accounts_ServiceDesc.Streams[1], "/litrpc.Accounts/SubscribeAccountLifecycle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountsSubscribeAccountLifecycleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Accounts_SubscribeAccountLifecycleClient interface {
+	Recv() (*AccountLifecycleEvent, error)
+	grpc.ClientStream
+}
+
+type accountsSubscribeAccountLifecycleClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountsSubscribeAccountLifecycleClient) Recv() (*AccountLifecycleEvent, error) {
+	m := new(AccountLifecycleEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *accountsClient) SubscribeAccountUpdates(ctx context.Context, in *SubscribeAccountUpdatesRequest, opts ...grpc.CallOption) (Accounts_SubscribeAccountUpdatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Accounts_ServiceDesc.Streams[2], "/litrpc.Accounts/SubscribeAccountUpdates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountsSubscribeAccountUpdatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Accounts_SubscribeAccountUpdatesClient interface {
+	Recv() (*AccountUpdate, error)
+	grpc.ClientStream
+}
+
+type accountsSubscribeAccountUpdatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountsSubscribeAccountUpdatesClient) Recv() (*AccountUpdate, error) {
+	m := new(AccountUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *accountsClient) VerifyAccountsStore(ctx context.Context, in *VerifyAccountsStoreRequest, opts ...grpc.CallOption) (*VerifyAccountsStoreResponse, error) {
+	out := new(VerifyAccountsStoreResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/VerifyAccountsStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) GetTotalInFlightExposure(ctx context.Context, in *GetTotalInFlightExposureRequest, opts ...grpc.CallOption) (*GetTotalInFlightExposureResponse, error) {
+	out := new(GetTotalInFlightExposureResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/GetTotalInFlightExposure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) GetAccountHistory(ctx context.Context, in *GetAccountHistoryRequest, opts ...grpc.CallOption) (*GetAccountHistoryResponse, error) {
+	out := new(GetAccountHistoryResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/GetAccountHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) GetAccountLedger(ctx context.Context, in *GetAccountLedgerRequest, opts ...grpc.CallOption) (*GetAccountLedgerResponse, error) {
+	out := new(GetAccountLedgerResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/GetAccountLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) EstimateAccountRunway(ctx context.Context, in *EstimateAccountRunwayRequest, opts ...grpc.CallOption) (*EstimateAccountRunwayResponse, error) {
+	out := new(EstimateAccountRunwayResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/EstimateAccountRunway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListAccountGroups(ctx context.Context, in *ListAccountGroupsRequest, opts ...grpc.CallOption) (*ListAccountGroupsResponse, error) {
+	out := new(ListAccountGroupsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ListAccountGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) AddCreditRule(ctx context.Context, in *AddCreditRuleRequest, opts ...grpc.CallOption) (*CreditRule, error) {
+	out := new(CreditRule)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/AddCreditRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ListCreditRules(ctx context.Context, in *ListCreditRulesRequest, opts ...grpc.CallOption) (*ListCreditRulesResponse, error) {
+	out := new(ListCreditRulesResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ListCreditRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) RemoveCreditRule(ctx context.Context, in *RemoveCreditRuleRequest, opts ...grpc.CallOption) (*RemoveCreditRuleResponse, error) {
+	out := new(RemoveCreditRuleResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/RemoveCreditRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) MintPaymentMacaroon(ctx context.Context, in *MintPaymentMacaroonRequest, opts ...grpc.CallOption) (*MintPaymentMacaroonResponse, error) {
+	out := new(MintPaymentMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/MintPaymentMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ExportAccountMacaroon(ctx context.Context, in *ExportAccountMacaroonRequest, opts ...grpc.CallOption) (*ExportAccountMacaroonResponse, error) {
+	out := new(ExportAccountMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ExportAccountMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) RotateAccountMacaroon(ctx context.Context, in *RotateAccountMacaroonRequest, opts ...grpc.CallOption) (*RotateAccountMacaroonResponse, error) {
+	out := new(RotateAccountMacaroonResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/RotateAccountMacaroon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ExportAccounts(ctx context.Context, in *ExportAccountsRequest, opts ...grpc.CallOption) (*ExportAccountsResponse, error) {
+	out := new(ExportAccountsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ExportAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsClient) ImportAccounts(ctx context.Context, in *ImportAccountsRequest, opts ...grpc.CallOption) (*ImportAccountsResponse, error) {
+	out := new(ImportAccountsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Accounts/ImportAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountsServer is the server API for Accounts service.
 // All implementations must embed UnimplementedAccountsServer
 // for forward compatibility
@@ -153,6 +522,16 @@ type AccountsServer interface {
 	// DebitAccount decreases the balance of an existing account in the account
 	// database.
 	DebitAccount(context.Context, *DebitAccountRequest) (*DebitAccountResponse, error)
+	// litcli: `accounts transfer`
+	// TransferAccountBalance moves an amount from one account to another. Both
+	// balances are updated in a single database transaction, so no balance is
+	// lost if litd stops halfway. The transfer is rejected if the source doesn't
+	// have enough balance that isn't reserved for in-flight payments.
+	TransferAccountBalance(context.Context, *TransferAccountBalanceRequest) (*TransferAccountBalanceResponse, error)
+	// litcli: `accounts rename-label`
+	// RenameAccountLabel changes the label of an existing account without
+	// touching any of its other fields. The new label must be unique.
+	RenameAccountLabel(context.Context, *RenameAccountLabelRequest) (*Account, error)
 	// litcli: `accounts list`
 	// ListAccounts returns all accounts that are currently stored in the account
 	// database.
@@ -163,6 +542,116 @@ type AccountsServer interface {
 	// litcli: `accounts remove`
 	// RemoveAccount removes the given account from the account database.
 	RemoveAccount(context.Context, *RemoveAccountRequest) (*RemoveAccountResponse, error)
+	// litcli: `accounts gc`
+	// PurgeExpiredAccounts removes all expired accounts from the account
+	// database. If preview is set, the accounts that would be removed are
+	// returned without removing them.
+	PurgeExpiredAccounts(context.Context, *PurgeExpiredAccountsRequest) (*PurgeExpiredAccountsResponse, error)
+	// litcli: `accounts events`
+	// SubscribePaymentEvents streams the lifecycle events of all payments that
+	// are charged to an account, optionally limited to a single account. Only
+	// events that occur after the subscription was created are sent.
+	SubscribePaymentEvents(*SubscribePaymentEventsRequest, Accounts_SubscribePaymentEventsServer) error
+	// litcli: `accounts lifecycle`
+	// SubscribeAccountLifecycle streams an event whenever an account is created,
+	// updated or removed, so that an external system can maintain a mirror of
+	// all accounts. To build the mirror, subscribe first and then list all
+	// accounts. Every event carries a resume token that can be passed when
+	// subscribing again to receive the events that were missed in between.
+	SubscribeAccountLifecycle(*SubscribeAccountLifecycleRequest, Accounts_SubscribeAccountLifecycleServer) error
+	// litcli: `accounts info --watch`
+	// SubscribeAccountUpdates streams the full state of a single account, first
+	// when subscribing and then whenever its balance or expiration date changes.
+	// If the account has an expiry warning, an expiring soon update is sent once
+	// the account reaches the warning lead time before its expiration date. The
+	// stream ends once the account is removed. A client that is disconnected
+	// can simply subscribe again, as the current state is always sent first.
+	SubscribeAccountUpdates(*SubscribeAccountUpdatesRequest, Accounts_SubscribeAccountUpdatesServer) error
+	// litcli: `accounts verify`
+	// VerifyAccountsStore checks all accounts for inconsistencies, such as
+	// duplicate labels, negative balances, invoices or payments that are
+	// associated with more than one account and in-flight payments that aren't
+	// tracked. The store is only read, so this is safe to call on a live node.
+	VerifyAccountsStore(context.Context, *VerifyAccountsStoreRequest) (*VerifyAccountsStoreResponse, error)
+	// litcli: `accounts exposure`
+	// GetTotalInFlightExposure returns the total amount of all payments charged
+	// to accounts that are currently in flight, including the maximum routing
+	// fees reserved for them. This is the amount the node could still lose to
+	// account holders if all of those payments succeed.
+	GetTotalInFlightExposure(context.Context, *GetTotalInFlightExposureRequest) (*GetTotalInFlightExposureResponse, error)
+	// litcli: `accounts history`
+	// GetAccountHistory returns the lifecycle events of an account, such as its
+	// creation, manual changes of its balance, expiry, limits or label and its
+	// removal, in the order they happened. The history of a removed account can
+	// still be queried by its ID.
+	GetAccountHistory(context.Context, *GetAccountHistoryRequest) (*GetAccountHistoryResponse, error)
+	// litcli: `accounts ledger`
+	// GetAccountLedger returns every change of the balance of an account, such as
+	// its opening balance, manual credits and debits, paid invoices and settled
+	// payments, together with the resulting balance, in the order they happened.
+	// The entries are recorded atomically with the balance change itself. The
+	// ledger of a removed account can still be queried by its ID.
+	GetAccountLedger(context.Context, *GetAccountLedgerRequest) (*GetAccountLedgerResponse, error)
+	// litcli: `accounts runway`
+	// EstimateAccountRunway averages the amount an account spent on payments,
+	// including routing fees, over a recent window and estimates when its
+	// balance reaches zero if it keeps spending at that rate. Manual debits are
+	// not taken into account.
+	EstimateAccountRunway(context.Context, *EstimateAccountRunwayRequest) (*EstimateAccountRunwayResponse, error)
+	// litcli: `accounts groups`
+	// ListAccountGroups returns the distinct groups of accounts with the number
+	// of accounts in each. The group of an account is the part of its label
+	// before the first occurrence of a separator, so the label "team/alice"
+	// belongs to the group "team". This can be used to populate a filter without
+	// listing all accounts.
+	ListAccountGroups(context.Context, *ListAccountGroupsRequest) (*ListAccountGroupsResponse, error)
+	// litcli: `accounts credit-rule add`
+	// AddCreditRule maps an invoice memo prefix to an account. Once an invoice
+	// that isn't associated with any account is settled, the account with the
+	// rule for the longest prefix of its memo is credited with the paid amount.
+	// Only invoices settled after the rule was added are credited.
+	AddCreditRule(context.Context, *AddCreditRuleRequest) (*CreditRule, error)
+	// litcli: `accounts credit-rule list`
+	// ListCreditRules returns all credit rules, ordered by their memo prefix.
+	ListCreditRules(context.Context, *ListCreditRulesRequest) (*ListCreditRulesResponse, error)
+	// litcli: `accounts credit-rule remove`
+	// RemoveCreditRule removes the credit rule for a memo prefix. The rules of
+	// an account are also removed when the account is removed.
+	RemoveCreditRule(context.Context, *RemoveCreditRuleRequest) (*RemoveCreditRuleResponse, error)
+	// litcli: `accounts mint-payment-macaroon`
+	// MintPaymentMacaroon bakes a macaroon for an existing account that can only
+	// be used to pay the invoice with the given payment hash. Once that payment
+	// has succeeded, the macaroon is rejected for all further requests.
+	MintPaymentMacaroon(context.Context, *MintPaymentMacaroonRequest) (*MintPaymentMacaroonResponse, error)
+	// litcli: `accounts export-macaroon`
+	// ExportAccountMacaroon bakes a new macaroon for an existing account with
+	// the same permissions as the one returned by CreateAccount, for example to
+	// replace a lost macaroon file. The macaroon is baked under the same root
+	// key, so macaroons that were issued for the account before stay valid.
+	ExportAccountMacaroon(context.Context, *ExportAccountMacaroonRequest) (*ExportAccountMacaroonResponse, error)
+	// litcli: `accounts rotate-macaroon`
+	// RotateAccountMacaroon replaces the root key of the macaroons of an existing
+	// account and returns a new macaroon with the same permissions as the one
+	// returned by CreateAccount. All macaroons that were issued for the account
+	// before, including exported and payment macaroons, fail authentication
+	// right away. The balance and settings of the account are not changed. The
+	// rotation is refused if the root key is shared with another account or a
+	// session, since their macaroons would stop working as well.
+	RotateAccountMacaroon(context.Context, *RotateAccountMacaroonRequest) (*RotateAccountMacaroonResponse, error)
+	// litcli: `accounts export`
+	// ExportAccounts returns the balances and settings of all accounts, for
+	// example to back them up or to move them to another litd instance. Amounts
+	// are exported in millisatoshis, so that nothing is lost to rounding. The
+	// invoices and payments of the accounts are not exported, and neither are
+	// any macaroon secrets.
+	ExportAccounts(context.Context, *ExportAccountsRequest) (*ExportAccountsResponse, error)
+	// litcli: `accounts import-db`
+	// ImportAccounts recreates accounts from an export with their original IDs.
+	// If any of the accounts already exists, nothing is imported unless
+	// overwrite is set. Macaroons that were issued for the accounts stay valid if
+	// they are imported into the litd instance that issued them. Otherwise, new
+	// macaroons need to be exported for the accounts.
+	ImportAccounts(context.Context, *ImportAccountsRequest) (*ImportAccountsResponse, error)
 	mustEmbedUnimplementedAccountsServer()
 }
 
@@ -182,6 +671,12 @@ func (UnimplementedAccountsServer) CreditAccount(context.Context, *CreditAccount
 func (UnimplementedAccountsServer) DebitAccount(context.Context, *DebitAccountRequest) (*DebitAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DebitAccount not implemented")
 }
+func (UnimplementedAccountsServer) TransferAccountBalance(context.Context, *TransferAccountBalanceRequest) (*TransferAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferAccountBalance not implemented")
+}
+func (UnimplementedAccountsServer) RenameAccountLabel(context.Context, *RenameAccountLabelRequest) (*Account, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameAccountLabel not implemented")
+}
 func (UnimplementedAccountsServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
@@ -191,6 +686,60 @@ func (UnimplementedAccountsServer) AccountInfo(context.Context, *AccountInfoRequ
 func (UnimplementedAccountsServer) RemoveAccount(context.Context, *RemoveAccountRequest) (*RemoveAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveAccount not implemented")
 }
+func (UnimplementedAccountsServer) PurgeExpiredAccounts(context.Context, *PurgeExpiredAccountsRequest) (*PurgeExpiredAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeExpiredAccounts not implemented")
+}
+func (UnimplementedAccountsServer) SubscribePaymentEvents(*SubscribePaymentEventsRequest, Accounts_SubscribePaymentEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePaymentEvents not implemented")
+}
+func (UnimplementedAccountsServer) SubscribeAccountLifecycle(*SubscribeAccountLifecycleRequest, Accounts_SubscribeAccountLifecycleServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAccountLifecycle not implemented")
+}
+func (UnimplementedAccountsServer) SubscribeAccountUpdates(*SubscribeAccountUpdatesRequest, Accounts_SubscribeAccountUpdatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeAccountUpdates not implemented")
+}
+func (UnimplementedAccountsServer) VerifyAccountsStore(context.Context, *VerifyAccountsStoreRequest) (*VerifyAccountsStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAccountsStore not implemented")
+}
+func (UnimplementedAccountsServer) GetTotalInFlightExposure(context.Context, *GetTotalInFlightExposureRequest) (*GetTotalInFlightExposureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalInFlightExposure not implemented")
+}
+func (UnimplementedAccountsServer) GetAccountHistory(context.Context, *GetAccountHistoryRequest) (*GetAccountHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountHistory not implemented")
+}
+func (UnimplementedAccountsServer) GetAccountLedger(context.Context, *GetAccountLedgerRequest) (*GetAccountLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountLedger not implemented")
+}
+func (UnimplementedAccountsServer) EstimateAccountRunway(context.Context, *EstimateAccountRunwayRequest) (*EstimateAccountRunwayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateAccountRunway not implemented")
+}
+func (UnimplementedAccountsServer) ListAccountGroups(context.Context, *ListAccountGroupsRequest) (*ListAccountGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountGroups not implemented")
+}
+func (UnimplementedAccountsServer) AddCreditRule(context.Context, *AddCreditRuleRequest) (*CreditRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCreditRule not implemented")
+}
+func (UnimplementedAccountsServer) ListCreditRules(context.Context, *ListCreditRulesRequest) (*ListCreditRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCreditRules not implemented")
+}
+func (UnimplementedAccountsServer) RemoveCreditRule(context.Context, *RemoveCreditRuleRequest) (*RemoveCreditRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCreditRule not implemented")
+}
+func (UnimplementedAccountsServer) MintPaymentMacaroon(context.Context, *MintPaymentMacaroonRequest) (*MintPaymentMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintPaymentMacaroon not implemented")
+}
+func (UnimplementedAccountsServer) ExportAccountMacaroon(context.Context, *ExportAccountMacaroonRequest) (*ExportAccountMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccountMacaroon not implemented")
+}
+func (UnimplementedAccountsServer) RotateAccountMacaroon(context.Context, *RotateAccountMacaroonRequest) (*RotateAccountMacaroonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAccountMacaroon not implemented")
+}
+func (UnimplementedAccountsServer) ExportAccounts(context.Context, *ExportAccountsRequest) (*ExportAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAccounts not implemented")
+}
+func (UnimplementedAccountsServer) ImportAccounts(context.Context, *ImportAccountsRequest) (*ImportAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportAccounts not implemented")
+}
 func (UnimplementedAccountsServer) mustEmbedUnimplementedAccountsServer() {}
 
 // UnsafeAccountsServer may be embedded to opt out of forward compatibility for this service.
@@ -276,6 +825,42 @@ func _Accounts_DebitAccount_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Accounts_TransferAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).TransferAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/TransferAccountBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).TransferAccountBalance(ctx, req.(*TransferAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_RenameAccountLabel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameAccountLabelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).RenameAccountLabel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/RenameAccountLabel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).RenameAccountLabel(ctx, req.(*RenameAccountLabelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Accounts_ListAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAccountsRequest)
 	if err := dec(in); err != nil {
@@ -330,6 +915,339 @@ func _Accounts_RemoveAccount_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Accounts_PurgeExpiredAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeExpiredAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).PurgeExpiredAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/PurgeExpiredAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).PurgeExpiredAccounts(ctx, req.(*PurgeExpiredAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_SubscribePaymentEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePaymentEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountsServer).SubscribePaymentEvents(m, &accountsSubscribePaymentEventsServer{stream})
+}
+
+type Accounts_SubscribePaymentEventsServer interface {
+	Send(*PaymentEvent) error
+	grpc.ServerStream
+}
+
+type accountsSubscribePaymentEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountsSubscribePaymentEventsServer) Send(m *PaymentEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Accounts_SubscribeAccountLifecycle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAccountLifecycleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountsServer).SubscribeAccountLifecycle(m, &accountsSubscribeAccountLifecycleServer{stream})
+}
+
+type Accounts_SubscribeAccountLifecycleServer interface {
+	Send(*AccountLifecycleEvent) error
+	grpc.ServerStream
+}
+
+type accountsSubscribeAccountLifecycleServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountsSubscribeAccountLifecycleServer) Send(m *AccountLifecycleEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Accounts_SubscribeAccountUpdates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeAccountUpdatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountsServer).SubscribeAccountUpdates(m, &accountsSubscribeAccountUpdatesServer{stream})
+}
+
+type Accounts_SubscribeAccountUpdatesServer interface {
+	Send(*AccountUpdate) error
+	grpc.ServerStream
+}
+
+type accountsSubscribeAccountUpdatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountsSubscribeAccountUpdatesServer) Send(m *AccountUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Accounts_VerifyAccountsStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAccountsStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).VerifyAccountsStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/VerifyAccountsStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).VerifyAccountsStore(ctx, req.(*VerifyAccountsStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_GetTotalInFlightExposure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalInFlightExposureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).GetTotalInFlightExposure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/GetTotalInFlightExposure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).GetTotalInFlightExposure(ctx, req.(*GetTotalInFlightExposureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_GetAccountHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).GetAccountHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/GetAccountHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).GetAccountHistory(ctx, req.(*GetAccountHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_GetAccountLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).GetAccountLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/GetAccountLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).GetAccountLedger(ctx, req.(*GetAccountLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_EstimateAccountRunway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateAccountRunwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).EstimateAccountRunway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/EstimateAccountRunway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).EstimateAccountRunway(ctx, req.(*EstimateAccountRunwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListAccountGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListAccountGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ListAccountGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListAccountGroups(ctx, req.(*ListAccountGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_AddCreditRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCreditRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).AddCreditRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/AddCreditRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).AddCreditRule(ctx, req.(*AddCreditRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ListCreditRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreditRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ListCreditRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ListCreditRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ListCreditRules(ctx, req.(*ListCreditRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_RemoveCreditRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCreditRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).RemoveCreditRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/RemoveCreditRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).RemoveCreditRule(ctx, req.(*RemoveCreditRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_MintPaymentMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintPaymentMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).MintPaymentMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/MintPaymentMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).MintPaymentMacaroon(ctx, req.(*MintPaymentMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ExportAccountMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ExportAccountMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ExportAccountMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ExportAccountMacaroon(ctx, req.(*ExportAccountMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_RotateAccountMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAccountMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).RotateAccountMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/RotateAccountMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).RotateAccountMacaroon(ctx, req.(*RotateAccountMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ExportAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ExportAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ExportAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ExportAccounts(ctx, req.(*ExportAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Accounts_ImportAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountsServer).ImportAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Accounts/ImportAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountsServer).ImportAccounts(ctx, req.(*ImportAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Accounts_ServiceDesc is the grpc.ServiceDesc for Accounts service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -353,6 +1271,14 @@ var Accounts_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DebitAccount",
 			Handler:    _Accounts_DebitAccount_Handler,
 		},
+		{
+			MethodName: "TransferAccountBalance",
+			Handler:    _Accounts_TransferAccountBalance_Handler,
+		},
+		{
+			MethodName: "RenameAccountLabel",
+			Handler:    _Accounts_RenameAccountLabel_Handler,
+		},
 		{
 			MethodName: "ListAccounts",
 			Handler:    _Accounts_ListAccounts_Handler,
@@ -365,7 +1291,83 @@ var Accounts_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveAccount",
 			Handler:    _Accounts_RemoveAccount_Handler,
 		},
+		{
+			MethodName: "PurgeExpiredAccounts",
+			Handler:    _Accounts_PurgeExpiredAccounts_Handler,
+		},
+		{
+			MethodName: "VerifyAccountsStore",
+			Handler:    _Accounts_VerifyAccountsStore_Handler,
+		},
+		{
+			MethodName: "GetTotalInFlightExposure",
+			Handler:    _Accounts_GetTotalInFlightExposure_Handler,
+		},
+		{
+			MethodName: "GetAccountHistory",
+			Handler:    _Accounts_GetAccountHistory_Handler,
+		},
+		{
+			MethodName: "GetAccountLedger",
+			Handler:    _Accounts_GetAccountLedger_Handler,
+		},
+		{
+			MethodName: "EstimateAccountRunway",
+			Handler:    _Accounts_EstimateAccountRunway_Handler,
+		},
+		{
+			MethodName: "ListAccountGroups",
+			Handler:    _Accounts_ListAccountGroups_Handler,
+		},
+		{
+			MethodName: "AddCreditRule",
+			Handler:    _Accounts_AddCreditRule_Handler,
+		},
+		{
+			MethodName: "ListCreditRules",
+			Handler:    _Accounts_ListCreditRules_Handler,
+		},
+		{
+			MethodName: "RemoveCreditRule",
+			Handler:    _Accounts_RemoveCreditRule_Handler,
+		},
+		{
+			MethodName: "MintPaymentMacaroon",
+			Handler:    _Accounts_MintPaymentMacaroon_Handler,
+		},
+		{
+			MethodName: "ExportAccountMacaroon",
+			Handler:    _Accounts_ExportAccountMacaroon_Handler,
+		},
+		{
+			MethodName: "RotateAccountMacaroon",
+			Handler:    _Accounts_RotateAccountMacaroon_Handler,
+		},
+		{
+			MethodName: "ExportAccounts",
+			Handler:    _Accounts_ExportAccounts_Handler,
+		},
+		{
+			MethodName: "ImportAccounts",
+			Handler:    _Accounts_ImportAccounts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePaymentEvents",
+			Handler:       _Accounts_SubscribePaymentEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeAccountLifecycle",
+			Handler:       _Accounts_SubscribeAccountLifecycle_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeAccountUpdates",
+			Handler:       _Accounts_SubscribeAccountUpdates_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lit-accounts.proto",
 }
@@ -78,6 +78,110 @@ func (SessionType) EnumDescriptor() ([]byte, []int) {
 	return file_lit_sessions_proto_rawDescGZIP(), []int{0}
 }
 
+type ErrorVerbosity int32
+
+const (
+	// The default verbosity of the session type is used. Errors are returned
+	// unmodified to the clients of admin sessions and stripped of their message
+	// for all other session types.
+	ErrorVerbosity_ERROR_VERBOSITY_DEFAULT ErrorVerbosity = 0
+	// Errors are stripped of their message and only the gRPC status code is
+	// returned to the client.
+	ErrorVerbosity_ERROR_VERBOSITY_TERSE ErrorVerbosity = 1
+	// Errors are returned to the client unmodified.
+	ErrorVerbosity_ERROR_VERBOSITY_VERBOSE ErrorVerbosity = 2
+)
+
+// Enum value maps for ErrorVerbosity.
+var (
+	ErrorVerbosity_name = map[int32]string{
+		0: "ERROR_VERBOSITY_DEFAULT",
+		1: "ERROR_VERBOSITY_TERSE",
+		2: "ERROR_VERBOSITY_VERBOSE",
+	}
+	ErrorVerbosity_value = map[string]int32{
+		"ERROR_VERBOSITY_DEFAULT": 0,
+		"ERROR_VERBOSITY_TERSE":   1,
+		"ERROR_VERBOSITY_VERBOSE": 2,
+	}
+)
+
+func (x ErrorVerbosity) Enum() *ErrorVerbosity {
+	p := new(ErrorVerbosity)
+	*p = x
+	return p
+}
+
+func (x ErrorVerbosity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorVerbosity) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_sessions_proto_enumTypes[1].Descriptor()
+}
+
+func (ErrorVerbosity) Type() protoreflect.EnumType {
+	return &file_lit_sessions_proto_enumTypes[1]
+}
+
+func (x ErrorVerbosity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorVerbosity.Descriptor instead.
+func (ErrorVerbosity) EnumDescriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{1}
+}
+
+type AmountObfuscationStrategy int32
+
+const (
+	// Amounts are randomized symmetrically within a relative range around the
+	// real amount.
+	AmountObfuscationStrategy_AMOUNT_OBFUSCATION_RELATIVE AmountObfuscationStrategy = 0
+	// Amounts are rounded down to a multiple of a fixed bucket size.
+	AmountObfuscationStrategy_AMOUNT_OBFUSCATION_BUCKET AmountObfuscationStrategy = 1
+)
+
+// Enum value maps for AmountObfuscationStrategy.
+var (
+	AmountObfuscationStrategy_name = map[int32]string{
+		0: "AMOUNT_OBFUSCATION_RELATIVE",
+		1: "AMOUNT_OBFUSCATION_BUCKET",
+	}
+	AmountObfuscationStrategy_value = map[string]int32{
+		"AMOUNT_OBFUSCATION_RELATIVE": 0,
+		"AMOUNT_OBFUSCATION_BUCKET":   1,
+	}
+)
+
+func (x AmountObfuscationStrategy) Enum() *AmountObfuscationStrategy {
+	p := new(AmountObfuscationStrategy)
+	*p = x
+	return p
+}
+
+func (x AmountObfuscationStrategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AmountObfuscationStrategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_sessions_proto_enumTypes[2].Descriptor()
+}
+
+func (AmountObfuscationStrategy) Type() protoreflect.EnumType {
+	return &file_lit_sessions_proto_enumTypes[2]
+}
+
+func (x AmountObfuscationStrategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AmountObfuscationStrategy.Descriptor instead.
+func (AmountObfuscationStrategy) EnumDescriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{2}
+}
+
 type SessionState int32
 
 const (
@@ -117,11 +221,11 @@ func (x SessionState) String() string {
 }
 
 func (SessionState) Descriptor() protoreflect.EnumDescriptor {
-	return file_lit_sessions_proto_enumTypes[1].Descriptor()
+	return file_lit_sessions_proto_enumTypes[3].Descriptor()
 }
 
 func (SessionState) Type() protoreflect.EnumType {
-	return &file_lit_sessions_proto_enumTypes[1]
+	return &file_lit_sessions_proto_enumTypes[3]
 }
 
 func (x SessionState) Number() protoreflect.EnumNumber {
@@ -130,7 +234,60 @@ func (x SessionState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SessionState.Descriptor instead.
 func (SessionState) EnumDescriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{1}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{3}
+}
+
+type ConnectionState int32
+
+const (
+	// The session is connected to its mailbox server.
+	ConnectionState_CONNECTION_STATE_CONNECTED ConnectionState = 0
+	// The session lost the connection to its mailbox server.
+	ConnectionState_CONNECTION_STATE_DISCONNECTED ConnectionState = 1
+	// The session failed to connect to its mailbox server and makes another
+	// attempt after the backoff given in the event.
+	ConnectionState_CONNECTION_STATE_RECONNECTING ConnectionState = 2
+)
+
+// Enum value maps for ConnectionState.
+var (
+	ConnectionState_name = map[int32]string{
+		0: "CONNECTION_STATE_CONNECTED",
+		1: "CONNECTION_STATE_DISCONNECTED",
+		2: "CONNECTION_STATE_RECONNECTING",
+	}
+	ConnectionState_value = map[string]int32{
+		"CONNECTION_STATE_CONNECTED":    0,
+		"CONNECTION_STATE_DISCONNECTED": 1,
+		"CONNECTION_STATE_RECONNECTING": 2,
+	}
+)
+
+func (x ConnectionState) Enum() *ConnectionState {
+	p := new(ConnectionState)
+	*p = x
+	return p
+}
+
+func (x ConnectionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConnectionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_sessions_proto_enumTypes[4].Descriptor()
+}
+
+func (ConnectionState) Type() protoreflect.EnumType {
+	return &file_lit_sessions_proto_enumTypes[4]
+}
+
+func (x ConnectionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConnectionState.Descriptor instead.
+func (ConnectionState) EnumDescriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{4}
 }
 
 type AddSessionRequest struct {
@@ -145,7 +302,9 @@ type AddSessionRequest struct {
 	SessionType SessionType `protobuf:"varint,2,opt,name=session_type,json=sessionType,proto3,enum=litrpc.SessionType" json:"session_type,omitempty"`
 	// The time at which the session should automatically be revoked.
 	ExpiryTimestampSeconds uint64 `protobuf:"varint,3,opt,name=expiry_timestamp_seconds,json=expiryTimestampSeconds,proto3" json:"expiry_timestamp_seconds,omitempty"`
-	// The address of the mailbox server that the LNC connection should use.
+	// The address of the mailbox server that the LNC connection should use, of
+	// the form host:port. It is stored with the session and overrides the
+	// default mailbox server, which is used if it isn't set.
 	MailboxServerAddr string `protobuf:"bytes,4,opt,name=mailbox_server_addr,json=mailboxServerAddr,proto3" json:"mailbox_server_addr,omitempty"`
 	// If set to true, tls will be skipped  when connecting to the mailbox.
 	DevServer bool `protobuf:"varint,5,opt,name=dev_server,json=devServer,proto3" json:"dev_server,omitempty"`
@@ -154,6 +313,49 @@ type AddSessionRequest struct {
 	// The ID of the account to associate this session with. This should only be
 	// set if the session_type is TYPE_MACAROON_ACCOUNT.
 	AccountId string `protobuf:"bytes,7,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The verbosity of the errors returned to the client connected through the
+	// session. Terse errors only contain the gRPC status code and hide any
+	// details about the node's internals. If not set, the errors of admin
+	// sessions are verbose and those of all other session types are terse.
+	// Verbose errors must be requested explicitly for non-admin sessions.
+	ErrorVerbosity ErrorVerbosity `protobuf:"varint,8,opt,name=error_verbosity,json=errorVerbosity,proto3,enum=litrpc.ErrorVerbosity" json:"error_verbosity,omitempty"`
+	// Any custom first-party caveats to add to the session's macaroon, given as
+	// the caveat conditions. These are added in addition to the caveats of the
+	// session type and can be used with all session types. Only the conditions
+	// that lnd can check are accepted: time-before, ipaddr, iprange and
+	// lnd-custom caveats of the middlewares registered by LiT (account,
+	// lit-mac-fw and privacy).
+	MacaroonCustomCaveats []string `protobuf:"bytes,9,rep,name=macaroon_custom_caveats,json=macaroonCustomCaveats,proto3" json:"macaroon_custom_caveats,omitempty"`
+	// The full URIs of the RPCs, for example /lnrpc.Lightning/GetInfo, that the
+	// session's macaroon should be restricted to. This can only be set for the
+	// admin and readonly session types. For readonly sessions, all of the URIs
+	// must be read-only.
+	AllowedUris []string `protobuf:"bytes,10,rep,name=allowed_uris,json=allowedUris,proto3" json:"allowed_uris,omitempty"`
+	// The total amount in satoshis that the session can spend in off-chain
+	// payments over its lifetime, including routing fees. Once the budget is
+	// used up, any further payments are rejected by the firewall. Zero means
+	// that the session has no spend budget. This requires the firewall, which
+	// is only available if the autopilot is enabled.
+	SpendBudgetSat uint64 `protobuf:"varint,11,opt,name=spend_budget_sat,json=spendBudgetSat,proto3" json:"spend_budget_sat,omitempty"`
+	// The rules that the firewall should enforce for all requests made through
+	// the session. Only session wide rules, for example session-rate-limit and
+	// session-budget, can be set. This requires the firewall, which is only
+	// available if the autopilot is enabled.
+	SessionRules *RulesMap `protobuf:"bytes,12,opt,name=session_rules,json=sessionRules,proto3" json:"session_rules,omitempty"`
+	// If set to true, the session is only added if a TLS connection to the
+	// mailbox server can be established. The certificate of the mailbox server
+	// isn't verified if dev_server is set.
+	VerifyMailboxServer bool `protobuf:"varint,13,opt,name=verify_mailbox_server,json=verifyMailboxServer,proto3" json:"verify_mailbox_server,omitempty"`
+	// The ID of an existing session that the new session should be linked to.
+	// The new session is added to the group of the given session, so that the
+	// sessions can be recognized as the same client over time, for example when
+	// a session is rotated. All other sessions in the group must already be
+	// revoked or expired. The state of session wide firewall rules is kept per
+	// group, so the new session continues with the spent amount of the spend
+	// budget and the calls counted by the rate limits of the group. Autopilot
+	// sessions can't be linked to here, they must be linked with
+	// AddAutopilotSession instead.
+	LinkedSessionId []byte `protobuf:"bytes,14,opt,name=linked_session_id,json=linkedSessionId,proto3" json:"linked_session_id,omitempty"`
 }
 
 func (x *AddSessionRequest) Reset() {
@@ -237,6 +439,137 @@ func (x *AddSessionRequest) GetAccountId() string {
 	return ""
 }
 
+func (x *AddSessionRequest) GetErrorVerbosity() ErrorVerbosity {
+	if x != nil {
+		return x.ErrorVerbosity
+	}
+	return ErrorVerbosity_ERROR_VERBOSITY_DEFAULT
+}
+
+func (x *AddSessionRequest) GetMacaroonCustomCaveats() []string {
+	if x != nil {
+		return x.MacaroonCustomCaveats
+	}
+	return nil
+}
+
+func (x *AddSessionRequest) GetAllowedUris() []string {
+	if x != nil {
+		return x.AllowedUris
+	}
+	return nil
+}
+
+func (x *AddSessionRequest) GetSpendBudgetSat() uint64 {
+	if x != nil {
+		return x.SpendBudgetSat
+	}
+	return 0
+}
+
+func (x *AddSessionRequest) GetSessionRules() *RulesMap {
+	if x != nil {
+		return x.SessionRules
+	}
+	return nil
+}
+
+func (x *AddSessionRequest) GetVerifyMailboxServer() bool {
+	if x != nil {
+		return x.VerifyMailboxServer
+	}
+	return false
+}
+
+func (x *AddSessionRequest) GetLinkedSessionId() []byte {
+	if x != nil {
+		return x.LinkedSessionId
+	}
+	return nil
+}
+
+type AmountObfuscation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The strategy that the privacy mapper uses to obfuscate amounts.
+	Strategy AmountObfuscationStrategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=litrpc.AmountObfuscationStrategy" json:"strategy,omitempty"`
+	// The maximum relative variation, in parts per million, by which amounts are
+	// randomized if the relative strategy is used. If it is zero, a variation of
+	// 50000 ppm (5%) is used.
+	VariationPpm uint32 `protobuf:"varint,2,opt,name=variation_ppm,json=variationPpm,proto3" json:"variation_ppm,omitempty"`
+	// The size of the buckets in satoshis that amounts are rounded down to if
+	// the bucket strategy is used. Millisatoshi amounts are rounded to the same
+	// buckets.
+	BucketSizeSat uint64 `protobuf:"varint,3,opt,name=bucket_size_sat,json=bucketSizeSat,proto3" json:"bucket_size_sat,omitempty"`
+	// The proto field names of the amounts that should not be obfuscated, for
+	// example "fee_msat" or "local_balance". Fields that are derived from one of
+	// these fields, like the remote balance of a channel, follow the obfuscation
+	// of that field.
+	ClearFields []string `protobuf:"bytes,4,rep,name=clear_fields,json=clearFields,proto3" json:"clear_fields,omitempty"`
+}
+
+func (x *AmountObfuscation) Reset() {
+	*x = AmountObfuscation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AmountObfuscation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmountObfuscation) ProtoMessage() {}
+
+func (x *AmountObfuscation) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmountObfuscation.ProtoReflect.Descriptor instead.
+func (*AmountObfuscation) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AmountObfuscation) GetStrategy() AmountObfuscationStrategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return AmountObfuscationStrategy_AMOUNT_OBFUSCATION_RELATIVE
+}
+
+func (x *AmountObfuscation) GetVariationPpm() uint32 {
+	if x != nil {
+		return x.VariationPpm
+	}
+	return 0
+}
+
+func (x *AmountObfuscation) GetBucketSizeSat() uint64 {
+	if x != nil {
+		return x.BucketSizeSat
+	}
+	return 0
+}
+
+func (x *AmountObfuscation) GetClearFields() []string {
+	if x != nil {
+		return x.ClearFields
+	}
+	return nil
+}
+
 type MacaroonPermission struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -259,7 +592,7 @@ type MacaroonPermission struct {
 func (x *MacaroonPermission) Reset() {
 	*x = MacaroonPermission{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[1]
+		mi := &file_lit_sessions_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -272,7 +605,7 @@ func (x *MacaroonPermission) String() string {
 func (*MacaroonPermission) ProtoMessage() {}
 
 func (x *MacaroonPermission) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[1]
+	mi := &file_lit_sessions_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +618,7 @@ func (x *MacaroonPermission) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MacaroonPermission.ProtoReflect.Descriptor instead.
 func (*MacaroonPermission) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{1}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MacaroonPermission) GetEntity() string {
@@ -314,7 +647,7 @@ type AddSessionResponse struct {
 func (x *AddSessionResponse) Reset() {
 	*x = AddSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[2]
+		mi := &file_lit_sessions_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -327,7 +660,7 @@ func (x *AddSessionResponse) String() string {
 func (*AddSessionResponse) ProtoMessage() {}
 
 func (x *AddSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[2]
+	mi := &file_lit_sessions_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +673,7 @@ func (x *AddSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSessionResponse.ProtoReflect.Descriptor instead.
 func (*AddSessionResponse) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{2}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *AddSessionResponse) GetSession() *Session {
@@ -410,12 +743,30 @@ type Session struct {
 	// Privacy flags used for the session that determine how the privacy mapper
 	// operates.
 	PrivacyFlags uint64 `protobuf:"varint,19,opt,name=privacy_flags,json=privacyFlags,proto3" json:"privacy_flags,omitempty"`
+	// The verbosity of the errors returned to the client connected through the
+	// session.
+	ErrorVerbosity ErrorVerbosity `protobuf:"varint,20,opt,name=error_verbosity,json=errorVerbosity,proto3,enum=litrpc.ErrorVerbosity" json:"error_verbosity,omitempty"`
+	// The total amount in satoshis that the session can spend in off-chain
+	// payments over its lifetime. Zero means that the session has no spend
+	// budget.
+	SpendBudgetSat uint64 `protobuf:"varint,21,opt,name=spend_budget_sat,json=spendBudgetSat,proto3" json:"spend_budget_sat,omitempty"`
+	// The amount in satoshis that is left of the spend budget of the session.
+	// Payments that are still in flight are counted as spent. This is only set
+	// if the session has a spend budget.
+	SpendBudgetRemainingSat uint64 `protobuf:"varint,22,opt,name=spend_budget_remaining_sat,json=spendBudgetRemainingSat,proto3" json:"spend_budget_remaining_sat,omitempty"`
+	// The session wide rules that the firewall enforces for all requests made
+	// through the session.
+	SessionRules *RulesMap `protobuf:"bytes,23,opt,name=session_rules,json=sessionRules,proto3" json:"session_rules,omitempty"`
+	// How the privacy mapper obfuscates the amounts returned to the client
+	// connected through the session. This is only set if the session uses the
+	// privacy mapper.
+	AmountObfuscation *AmountObfuscation `protobuf:"bytes,24,opt,name=amount_obfuscation,json=amountObfuscation,proto3" json:"amount_obfuscation,omitempty"`
 }
 
 func (x *Session) Reset() {
 	*x = Session{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[3]
+		mi := &file_lit_sessions_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -428,7 +779,7 @@ func (x *Session) String() string {
 func (*Session) ProtoMessage() {}
 
 func (x *Session) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[3]
+	mi := &file_lit_sessions_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +792,7 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Session.ProtoReflect.Descriptor instead.
 func (*Session) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{3}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Session) GetId() []byte {
@@ -577,6 +928,41 @@ func (x *Session) GetPrivacyFlags() uint64 {
 	return 0
 }
 
+func (x *Session) GetErrorVerbosity() ErrorVerbosity {
+	if x != nil {
+		return x.ErrorVerbosity
+	}
+	return ErrorVerbosity_ERROR_VERBOSITY_DEFAULT
+}
+
+func (x *Session) GetSpendBudgetSat() uint64 {
+	if x != nil {
+		return x.SpendBudgetSat
+	}
+	return 0
+}
+
+func (x *Session) GetSpendBudgetRemainingSat() uint64 {
+	if x != nil {
+		return x.SpendBudgetRemainingSat
+	}
+	return 0
+}
+
+func (x *Session) GetSessionRules() *RulesMap {
+	if x != nil {
+		return x.SessionRules
+	}
+	return nil
+}
+
+func (x *Session) GetAmountObfuscation() *AmountObfuscation {
+	if x != nil {
+		return x.AmountObfuscation
+	}
+	return nil
+}
+
 type MacaroonRecipe struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -591,7 +977,7 @@ type MacaroonRecipe struct {
 func (x *MacaroonRecipe) Reset() {
 	*x = MacaroonRecipe{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[4]
+		mi := &file_lit_sessions_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -604,7 +990,7 @@ func (x *MacaroonRecipe) String() string {
 func (*MacaroonRecipe) ProtoMessage() {}
 
 func (x *MacaroonRecipe) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[4]
+	mi := &file_lit_sessions_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,7 +1003,7 @@ func (x *MacaroonRecipe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MacaroonRecipe.ProtoReflect.Descriptor instead.
 func (*MacaroonRecipe) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{4}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *MacaroonRecipe) GetPermissions() []*MacaroonPermission {
@@ -638,12 +1024,30 @@ type ListSessionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// If set, only the sessions that are linked to the account with the given
+	// hexadecimal ID are returned.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// If set, only the sessions that are in one of the given states are
+	// returned.
+	States []SessionState `protobuf:"varint,2,rep,packed,name=states,proto3,enum=litrpc.SessionState" json:"states,omitempty"`
+	// If set, only the sessions that are of one of the given types are returned.
+	Types []SessionType `protobuf:"varint,3,rep,packed,name=types,proto3,enum=litrpc.SessionType" json:"types,omitempty"`
+	// If set, only the sessions whose label contains the given string are
+	// returned. The comparison is case-sensitive.
+	LabelContains string `protobuf:"bytes,4,opt,name=label_contains,json=labelContains,proto3" json:"label_contains,omitempty"`
+	// If set, only the sessions in the group of the session with the given ID
+	// are returned, including the revoked and expired predecessors of the
+	// session. The ID of the group itself, which is the ID of the first session
+	// in the group, can be given as well. The sessions are returned in the
+	// order they were created in.
+	GroupId []byte `protobuf:"bytes,5,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
 }
 
 func (x *ListSessionsRequest) Reset() {
 	*x = ListSessionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[5]
+		mi := &file_lit_sessions_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -656,7 +1060,7 @@ func (x *ListSessionsRequest) String() string {
 func (*ListSessionsRequest) ProtoMessage() {}
 
 func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[5]
+	mi := &file_lit_sessions_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -669,7 +1073,42 @@ func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
 func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{5}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListSessionsRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetStates() []SessionState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+func (x *ListSessionsRequest) GetTypes() []SessionType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *ListSessionsRequest) GetLabelContains() string {
+	if x != nil {
+		return x.LabelContains
+	}
+	return ""
+}
+
+func (x *ListSessionsRequest) GetGroupId() []byte {
+	if x != nil {
+		return x.GroupId
+	}
+	return nil
 }
 
 type ListSessionsResponse struct {
@@ -684,7 +1123,7 @@ type ListSessionsResponse struct {
 func (x *ListSessionsResponse) Reset() {
 	*x = ListSessionsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[6]
+		mi := &file_lit_sessions_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -697,7 +1136,7 @@ func (x *ListSessionsResponse) String() string {
 func (*ListSessionsResponse) ProtoMessage() {}
 
 func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[6]
+	mi := &file_lit_sessions_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +1149,7 @@ func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
 func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{6}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ListSessionsResponse) GetSessions() []*Session {
@@ -733,7 +1172,7 @@ type RevokeSessionRequest struct {
 func (x *RevokeSessionRequest) Reset() {
 	*x = RevokeSessionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[7]
+		mi := &file_lit_sessions_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -746,7 +1185,7 @@ func (x *RevokeSessionRequest) String() string {
 func (*RevokeSessionRequest) ProtoMessage() {}
 
 func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[7]
+	mi := &file_lit_sessions_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -759,7 +1198,7 @@ func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
 func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{7}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *RevokeSessionRequest) GetLocalPublicKey() []byte {
@@ -778,7 +1217,7 @@ type RevokeSessionResponse struct {
 func (x *RevokeSessionResponse) Reset() {
 	*x = RevokeSessionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[8]
+		mi := &file_lit_sessions_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -791,7 +1230,7 @@ func (x *RevokeSessionResponse) String() string {
 func (*RevokeSessionResponse) ProtoMessage() {}
 
 func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[8]
+	mi := &file_lit_sessions_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,7 +1243,271 @@ func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
 func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{8}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{9}
+}
+
+type RevokeSessionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only the sessions whose label starts with the given prefix are
+	// revoked.
+	LabelPrefix string `protobuf:"bytes,1,opt,name=label_prefix,json=labelPrefix,proto3" json:"label_prefix,omitempty"`
+	// If set, only the sessions that were created before the given unix
+	// timestamp in seconds are revoked.
+	CreatedBefore uint64 `protobuf:"varint,2,opt,name=created_before,json=createdBefore,proto3" json:"created_before,omitempty"`
+	// If set, only the sessions whose expiry has passed but that haven't been
+	// marked as expired yet are revoked.
+	Expired bool `protobuf:"varint,3,opt,name=expired,proto3" json:"expired,omitempty"`
+	// If set, only the sessions with one of the given local static keys are
+	// revoked. All keys must belong to known sessions.
+	// When using REST, the keys must be encoded as base64url.
+	LocalPublicKeys [][]byte `protobuf:"bytes,4,rep,name=local_public_keys,json=localPublicKeys,proto3" json:"local_public_keys,omitempty"`
+	// If set, the matching sessions are only returned but not revoked.
+	DryRun bool `protobuf:"varint,5,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (x *RevokeSessionsRequest) Reset() {
+	*x = RevokeSessionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RevokeSessionsRequest) GetLabelPrefix() string {
+	if x != nil {
+		return x.LabelPrefix
+	}
+	return ""
+}
+
+func (x *RevokeSessionsRequest) GetCreatedBefore() uint64 {
+	if x != nil {
+		return x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *RevokeSessionsRequest) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *RevokeSessionsRequest) GetLocalPublicKeys() [][]byte {
+	if x != nil {
+		return x.LocalPublicKeys
+	}
+	return nil
+}
+
+func (x *RevokeSessionsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RevokeSessionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of sessions that were revoked, or that would have been revoked
+	// if dry_run was set.
+	NumRevoked uint32 `protobuf:"varint,1,opt,name=num_revoked,json=numRevoked,proto3" json:"num_revoked,omitempty"`
+	// The sessions that were revoked, or that would have been revoked if dry_run
+	// was set. They are returned in the state they were in before the call.
+	Sessions []*Session `protobuf:"bytes,2,rep,name=sessions,proto3" json:"sessions,omitempty"`
+}
+
+func (x *RevokeSessionsResponse) Reset() {
+	*x = RevokeSessionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeSessionsResponse) GetNumRevoked() uint32 {
+	if x != nil {
+		return x.NumRevoked
+	}
+	return 0
+}
+
+func (x *RevokeSessionsResponse) GetSessions() []*Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type UpdateSessionExpiryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the session to update. Either the ID or the local public key
+	// must be set.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The local static key of the session to update.
+	LocalPublicKey []byte `protobuf:"bytes,2,opt,name=local_public_key,json=localPublicKey,proto3" json:"local_public_key,omitempty"`
+	// The new expiry of the session as a unix timestamp in seconds. It must be
+	// in the future.
+	ExpiryTimestampSeconds uint64 `protobuf:"varint,3,opt,name=expiry_timestamp_seconds,json=expiryTimestampSeconds,proto3" json:"expiry_timestamp_seconds,omitempty"`
+}
+
+func (x *UpdateSessionExpiryRequest) Reset() {
+	*x = UpdateSessionExpiryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSessionExpiryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionExpiryRequest) ProtoMessage() {}
+
+func (x *UpdateSessionExpiryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionExpiryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSessionExpiryRequest) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UpdateSessionExpiryRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UpdateSessionExpiryRequest) GetLocalPublicKey() []byte {
+	if x != nil {
+		return x.LocalPublicKey
+	}
+	return nil
+}
+
+func (x *UpdateSessionExpiryRequest) GetExpiryTimestampSeconds() uint64 {
+	if x != nil {
+		return x.ExpiryTimestampSeconds
+	}
+	return 0
+}
+
+type UpdateSessionExpiryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The session with its new expiry.
+	Session *Session `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *UpdateSessionExpiryResponse) Reset() {
+	*x = UpdateSessionExpiryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateSessionExpiryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSessionExpiryResponse) ProtoMessage() {}
+
+func (x *UpdateSessionExpiryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSessionExpiryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSessionExpiryResponse) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UpdateSessionExpiryResponse) GetSession() *Session {
+	if x != nil {
+		return x.Session
+	}
+	return nil
 }
 
 type RulesMap struct {
@@ -820,7 +1523,7 @@ type RulesMap struct {
 func (x *RulesMap) Reset() {
 	*x = RulesMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[9]
+		mi := &file_lit_sessions_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -833,7 +1536,7 @@ func (x *RulesMap) String() string {
 func (*RulesMap) ProtoMessage() {}
 
 func (x *RulesMap) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[9]
+	mi := &file_lit_sessions_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,7 +1549,7 @@ func (x *RulesMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RulesMap.ProtoReflect.Descriptor instead.
 func (*RulesMap) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{9}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RulesMap) GetRules() map[string]*RuleValue {
@@ -872,13 +1575,16 @@ type RuleValue struct {
 	//	*RuleValue_ChannelRestrict
 	//	*RuleValue_PeerRestrict
 	//	*RuleValue_ChannelConstraint
+	//	*RuleValue_SessionBudget
+	//	*RuleValue_SessionRateLimit
+	//	*RuleValue_MemoRequired
 	Value isRuleValue_Value `protobuf_oneof:"value"`
 }
 
 func (x *RuleValue) Reset() {
 	*x = RuleValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[10]
+		mi := &file_lit_sessions_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -891,7 +1597,7 @@ func (x *RuleValue) String() string {
 func (*RuleValue) ProtoMessage() {}
 
 func (x *RuleValue) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[10]
+	mi := &file_lit_sessions_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -904,7 +1610,7 @@ func (x *RuleValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RuleValue.ProtoReflect.Descriptor instead.
 func (*RuleValue) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{10}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{15}
 }
 
 func (m *RuleValue) GetValue() isRuleValue_Value {
@@ -977,6 +1683,27 @@ func (x *RuleValue) GetChannelConstraint() *ChannelConstraint {
 	return nil
 }
 
+func (x *RuleValue) GetSessionBudget() *SessionBudget {
+	if x, ok := x.GetValue().(*RuleValue_SessionBudget); ok {
+		return x.SessionBudget
+	}
+	return nil
+}
+
+func (x *RuleValue) GetSessionRateLimit() *SessionRateLimit {
+	if x, ok := x.GetValue().(*RuleValue_SessionRateLimit); ok {
+		return x.SessionRateLimit
+	}
+	return nil
+}
+
+func (x *RuleValue) GetMemoRequired() *MemoRequired {
+	if x, ok := x.GetValue().(*RuleValue_MemoRequired); ok {
+		return x.MemoRequired
+	}
+	return nil
+}
+
 type isRuleValue_Value interface {
 	isRuleValue_Value()
 }
@@ -1017,6 +1744,18 @@ type RuleValue_ChannelConstraint struct {
 	ChannelConstraint *ChannelConstraint `protobuf:"bytes,9,opt,name=channel_constraint,json=channelConstraint,proto3,oneof"`
 }
 
+type RuleValue_SessionBudget struct {
+	SessionBudget *SessionBudget `protobuf:"bytes,10,opt,name=session_budget,json=sessionBudget,proto3,oneof"`
+}
+
+type RuleValue_SessionRateLimit struct {
+	SessionRateLimit *SessionRateLimit `protobuf:"bytes,11,opt,name=session_rate_limit,json=sessionRateLimit,proto3,oneof"`
+}
+
+type RuleValue_MemoRequired struct {
+	MemoRequired *MemoRequired `protobuf:"bytes,12,opt,name=memo_required,json=memoRequired,proto3,oneof"`
+}
+
 func (*RuleValue_RateLimit) isRuleValue_Value() {}
 
 func (*RuleValue_ChanPolicyBounds) isRuleValue_Value() {}
@@ -1035,6 +1774,12 @@ func (*RuleValue_PeerRestrict) isRuleValue_Value() {}
 
 func (*RuleValue_ChannelConstraint) isRuleValue_Value() {}
 
+func (*RuleValue_SessionBudget) isRuleValue_Value() {}
+
+func (*RuleValue_SessionRateLimit) isRuleValue_Value() {}
+
+func (*RuleValue_MemoRequired) isRuleValue_Value() {}
+
 type RateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1049,7 +1794,7 @@ type RateLimit struct {
 func (x *RateLimit) Reset() {
 	*x = RateLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[11]
+		mi := &file_lit_sessions_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1062,7 +1807,7 @@ func (x *RateLimit) String() string {
 func (*RateLimit) ProtoMessage() {}
 
 func (x *RateLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[11]
+	mi := &file_lit_sessions_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1075,7 +1820,7 @@ func (x *RateLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
 func (*RateLimit) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{11}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *RateLimit) GetReadLimit() *Rate {
@@ -1106,7 +1851,7 @@ type Rate struct {
 func (x *Rate) Reset() {
 	*x = Rate{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[12]
+		mi := &file_lit_sessions_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1119,7 +1864,7 @@ func (x *Rate) String() string {
 func (*Rate) ProtoMessage() {}
 
 func (x *Rate) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[12]
+	mi := &file_lit_sessions_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1132,7 +1877,7 @@ func (x *Rate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Rate.ProtoReflect.Descriptor instead.
 func (*Rate) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{12}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Rate) GetIterations() uint32 {
@@ -1165,7 +1910,7 @@ type HistoryLimit struct {
 func (x *HistoryLimit) Reset() {
 	*x = HistoryLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[13]
+		mi := &file_lit_sessions_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1178,7 +1923,7 @@ func (x *HistoryLimit) String() string {
 func (*HistoryLimit) ProtoMessage() {}
 
 func (x *HistoryLimit) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[13]
+	mi := &file_lit_sessions_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,7 +1936,7 @@ func (x *HistoryLimit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HistoryLimit.ProtoReflect.Descriptor instead.
 func (*HistoryLimit) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{13}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *HistoryLimit) GetStartTime() uint64 {
@@ -1234,7 +1979,7 @@ type ChannelPolicyBounds struct {
 func (x *ChannelPolicyBounds) Reset() {
 	*x = ChannelPolicyBounds{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[14]
+		mi := &file_lit_sessions_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1247,7 +1992,7 @@ func (x *ChannelPolicyBounds) String() string {
 func (*ChannelPolicyBounds) ProtoMessage() {}
 
 func (x *ChannelPolicyBounds) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[14]
+	mi := &file_lit_sessions_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1260,7 +2005,7 @@ func (x *ChannelPolicyBounds) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelPolicyBounds.ProtoReflect.Descriptor instead.
 func (*ChannelPolicyBounds) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{14}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ChannelPolicyBounds) GetMinBaseMsat() uint64 {
@@ -1333,7 +2078,7 @@ type OffChainBudget struct {
 func (x *OffChainBudget) Reset() {
 	*x = OffChainBudget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[15]
+		mi := &file_lit_sessions_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1346,7 +2091,7 @@ func (x *OffChainBudget) String() string {
 func (*OffChainBudget) ProtoMessage() {}
 
 func (x *OffChainBudget) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[15]
+	mi := &file_lit_sessions_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1359,7 +2104,7 @@ func (x *OffChainBudget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OffChainBudget.ProtoReflect.Descriptor instead.
 func (*OffChainBudget) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{15}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *OffChainBudget) GetMaxAmtMsat() uint64 {
@@ -1390,7 +2135,7 @@ type OnChainBudget struct {
 func (x *OnChainBudget) Reset() {
 	*x = OnChainBudget{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[16]
+		mi := &file_lit_sessions_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1403,7 +2148,7 @@ func (x *OnChainBudget) String() string {
 func (*OnChainBudget) ProtoMessage() {}
 
 func (x *OnChainBudget) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[16]
+	mi := &file_lit_sessions_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +2161,7 @@ func (x *OnChainBudget) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnChainBudget.ProtoReflect.Descriptor instead.
 func (*OnChainBudget) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{16}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *OnChainBudget) GetAbsoluteAmtSats() uint64 {
@@ -1433,6 +2178,222 @@ func (x *OnChainBudget) GetMaxSatPerVByte() uint64 {
 	return 0
 }
 
+type SessionBudget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum amount that can be spent in off-chain payments over the
+	// lifetime of a session, including routing fees.
+	AbsoluteAmtSats uint64 `protobuf:"varint,1,opt,name=absolute_amt_sats,json=absoluteAmtSats,proto3" json:"absolute_amt_sats,omitempty"`
+}
+
+func (x *SessionBudget) Reset() {
+	*x = SessionBudget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionBudget) ProtoMessage() {}
+
+func (x *SessionBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionBudget.ProtoReflect.Descriptor instead.
+func (*SessionBudget) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SessionBudget) GetAbsoluteAmtSats() uint64 {
+	if x != nil {
+		return x.AbsoluteAmtSats
+	}
+	return 0
+}
+
+type SessionRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The limits on the number of calls. A call has to be within all the limits
+	// whose method pattern matches its URI.
+	Limits []*MethodRateLimit `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+}
+
+func (x *SessionRateLimit) Reset() {
+	*x = SessionRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionRateLimit) ProtoMessage() {}
+
+func (x *SessionRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionRateLimit.ProtoReflect.Descriptor instead.
+func (*SessionRateLimit) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SessionRateLimit) GetLimits() []*MethodRateLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type MemoRequired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A regular expression that the memo of the invoices that a session creates
+	// and the description of the invoices that it pays must match, for example
+	// '^order-[0-9]+$'. If it is empty, any memo that is set is accepted.
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
+}
+
+func (x *MemoRequired) Reset() {
+	*x = MemoRequired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoRequired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoRequired) ProtoMessage() {}
+
+func (x *MemoRequired) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoRequired.ProtoReflect.Descriptor instead.
+func (*MemoRequired) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MemoRequired) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+type MethodRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A regular expression that is matched against the full URI of a method, for
+	// example '/lnrpc\.Lightning/.*' or '^/routerrpc\.Router/SendPaymentV2$'.
+	MethodPattern string `protobuf:"bytes,1,opt,name=method_pattern,json=methodPattern,proto3" json:"method_pattern,omitempty"`
+	// The number of calls to the matching methods that are allowed within the
+	// window.
+	Calls uint32 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty"`
+	// The length of the window in seconds.
+	WindowSeconds uint32 `protobuf:"varint,3,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (x *MethodRateLimit) Reset() {
+	*x = MethodRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodRateLimit) ProtoMessage() {}
+
+func (x *MethodRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodRateLimit.ProtoReflect.Descriptor instead.
+func (*MethodRateLimit) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MethodRateLimit) GetMethodPattern() string {
+	if x != nil {
+		return x.MethodPattern
+	}
+	return ""
+}
+
+func (x *MethodRateLimit) GetCalls() uint32 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *MethodRateLimit) GetWindowSeconds() uint32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
 type SendToSelf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1442,7 +2403,7 @@ type SendToSelf struct {
 func (x *SendToSelf) Reset() {
 	*x = SendToSelf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[17]
+		mi := &file_lit_sessions_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1455,7 +2416,7 @@ func (x *SendToSelf) String() string {
 func (*SendToSelf) ProtoMessage() {}
 
 func (x *SendToSelf) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[17]
+	mi := &file_lit_sessions_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1468,7 +2429,7 @@ func (x *SendToSelf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendToSelf.ProtoReflect.Descriptor instead.
 func (*SendToSelf) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{17}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{26}
 }
 
 type ChannelRestrict struct {
@@ -1479,12 +2440,16 @@ type ChannelRestrict struct {
 	// A list of channel IDs that the Autopilot should _not_ perform any actions
 	// on.
 	ChannelIds []uint64 `protobuf:"varint,1,rep,packed,name=channel_ids,json=channelIds,proto3" json:"channel_ids,omitempty"`
+	// A list of channel IDs that are the only ones that actions may be performed
+	// on. Payments must then specify their outgoing channel. Only one of
+	// channel_ids and allowed_channel_ids can be set.
+	AllowedChannelIds []uint64 `protobuf:"varint,2,rep,packed,name=allowed_channel_ids,json=allowedChannelIds,proto3" json:"allowed_channel_ids,omitempty"`
 }
 
 func (x *ChannelRestrict) Reset() {
 	*x = ChannelRestrict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[18]
+		mi := &file_lit_sessions_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1497,7 +2462,7 @@ func (x *ChannelRestrict) String() string {
 func (*ChannelRestrict) ProtoMessage() {}
 
 func (x *ChannelRestrict) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[18]
+	mi := &file_lit_sessions_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,7 +2475,7 @@ func (x *ChannelRestrict) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelRestrict.ProtoReflect.Descriptor instead.
 func (*ChannelRestrict) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{18}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ChannelRestrict) GetChannelIds() []uint64 {
@@ -1520,6 +2485,13 @@ func (x *ChannelRestrict) GetChannelIds() []uint64 {
 	return nil
 }
 
+func (x *ChannelRestrict) GetAllowedChannelIds() []uint64 {
+	if x != nil {
+		return x.AllowedChannelIds
+	}
+	return nil
+}
+
 type PeerRestrict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1527,12 +2499,15 @@ type PeerRestrict struct {
 
 	// A list of peer IDs that the Autopilot should _not_ perform any actions on.
 	PeerIds []string `protobuf:"bytes,1,rep,name=peer_ids,json=peerIds,proto3" json:"peer_ids,omitempty"`
+	// A list of peer IDs that are the only ones that actions may be performed
+	// on. Only one of peer_ids and allowed_peer_ids can be set.
+	AllowedPeerIds []string `protobuf:"bytes,2,rep,name=allowed_peer_ids,json=allowedPeerIds,proto3" json:"allowed_peer_ids,omitempty"`
 }
 
 func (x *PeerRestrict) Reset() {
 	*x = PeerRestrict{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[19]
+		mi := &file_lit_sessions_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1545,7 +2520,7 @@ func (x *PeerRestrict) String() string {
 func (*PeerRestrict) ProtoMessage() {}
 
 func (x *PeerRestrict) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[19]
+	mi := &file_lit_sessions_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1558,7 +2533,7 @@ func (x *PeerRestrict) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PeerRestrict.ProtoReflect.Descriptor instead.
 func (*PeerRestrict) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{19}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *PeerRestrict) GetPeerIds() []string {
@@ -1568,6 +2543,13 @@ func (x *PeerRestrict) GetPeerIds() []string {
 	return nil
 }
 
+func (x *PeerRestrict) GetAllowedPeerIds() []string {
+	if x != nil {
+		return x.AllowedPeerIds
+	}
+	return nil
+}
+
 type ChannelConstraint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1588,7 +2570,7 @@ type ChannelConstraint struct {
 func (x *ChannelConstraint) Reset() {
 	*x = ChannelConstraint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_sessions_proto_msgTypes[20]
+		mi := &file_lit_sessions_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1601,7 +2583,7 @@ func (x *ChannelConstraint) String() string {
 func (*ChannelConstraint) ProtoMessage() {}
 
 func (x *ChannelConstraint) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_sessions_proto_msgTypes[20]
+	mi := &file_lit_sessions_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1614,7 +2596,7 @@ func (x *ChannelConstraint) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChannelConstraint.ProtoReflect.Descriptor instead.
 func (*ChannelConstraint) Descriptor() ([]byte, []int) {
-	return file_lit_sessions_proto_rawDescGZIP(), []int{20}
+	return file_lit_sessions_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ChannelConstraint) GetMinCapacitySat() uint64 {
@@ -1624,39 +2606,643 @@ func (x *ChannelConstraint) GetMinCapacitySat() uint64 {
 	return 0
 }
 
-func (x *ChannelConstraint) GetMaxCapacitySat() uint64 {
+func (x *ChannelConstraint) GetMaxCapacitySat() uint64 {
+	if x != nil {
+		return x.MaxCapacitySat
+	}
+	return 0
+}
+
+func (x *ChannelConstraint) GetMaxPushSat() uint64 {
+	if x != nil {
+		return x.MaxPushSat
+	}
+	return 0
+}
+
+func (x *ChannelConstraint) GetPrivateAllowed() bool {
+	if x != nil {
+		return x.PrivateAllowed
+	}
+	return false
+}
+
+func (x *ChannelConstraint) GetPublicAllowed() bool {
+	if x != nil {
+		return x.PublicAllowed
+	}
+	return false
+}
+
+type SubscribeConnectionStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If set, only events of the session with this local static key are sent.
+	// When using REST, this field must be encoded as base64url.
+	LocalPublicKey []byte `protobuf:"bytes,1,opt,name=local_public_key,json=localPublicKey,proto3" json:"local_public_key,omitempty"`
+	// If set, only the snapshot of the latest status of the active sessions is
+	// sent and the stream is closed afterwards.
+	SnapshotOnly bool `protobuf:"varint,2,opt,name=snapshot_only,json=snapshotOnly,proto3" json:"snapshot_only,omitempty"`
+}
+
+func (x *SubscribeConnectionStatusRequest) Reset() {
+	*x = SubscribeConnectionStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeConnectionStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeConnectionStatusRequest) ProtoMessage() {}
+
+func (x *SubscribeConnectionStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeConnectionStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeConnectionStatusRequest) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SubscribeConnectionStatusRequest) GetLocalPublicKey() []byte {
+	if x != nil {
+		return x.LocalPublicKey
+	}
+	return nil
+}
+
+func (x *SubscribeConnectionStatusRequest) GetSnapshotOnly() bool {
+	if x != nil {
+		return x.SnapshotOnly
+	}
+	return false
+}
+
+type ConnectionStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The local static key of the session whose connection changed.
+	LocalPublicKey []byte `protobuf:"bytes,1,opt,name=local_public_key,json=localPublicKey,proto3" json:"local_public_key,omitempty"`
+	// The new state of the connection.
+	State ConnectionState `protobuf:"varint,2,opt,name=state,proto3,enum=litrpc.ConnectionState" json:"state,omitempty"`
+	// Set to true if a client is currently connected through the mailbox. Only
+	// set if the state is CONNECTION_STATE_CONNECTED.
+	ClientConnected bool `protobuf:"varint,3,opt,name=client_connected,json=clientConnected,proto3" json:"client_connected,omitempty"`
+	// The number of the attempt to reconnect since the backoff was last reset.
+	// Only set if the state is CONNECTION_STATE_RECONNECTING.
+	Attempt uint32 `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// The time in milliseconds until the attempt to reconnect is made. Only set
+	// if the state is CONNECTION_STATE_RECONNECTING.
+	BackoffMs uint64 `protobuf:"varint,5,opt,name=backoff_ms,json=backoffMs,proto3" json:"backoff_ms,omitempty"`
+	// The unix timestamp in seconds at which the state changed.
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Set to true if the event is part of the snapshot of all active sessions
+	// that is sent when subscribing rather than the result of a state change.
+	Snapshot bool `protobuf:"varint,7,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *ConnectionStatusEvent) Reset() {
+	*x = ConnectionStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStatusEvent) ProtoMessage() {}
+
+func (x *ConnectionStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStatusEvent.ProtoReflect.Descriptor instead.
+func (*ConnectionStatusEvent) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ConnectionStatusEvent) GetLocalPublicKey() []byte {
+	if x != nil {
+		return x.LocalPublicKey
+	}
+	return nil
+}
+
+func (x *ConnectionStatusEvent) GetState() ConnectionState {
+	if x != nil {
+		return x.State
+	}
+	return ConnectionState_CONNECTION_STATE_CONNECTED
+}
+
+func (x *ConnectionStatusEvent) GetClientConnected() bool {
+	if x != nil {
+		return x.ClientConnected
+	}
+	return false
+}
+
+func (x *ConnectionStatusEvent) GetAttempt() uint32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *ConnectionStatusEvent) GetBackoffMs() uint64 {
+	if x != nil {
+		return x.BackoffMs
+	}
+	return 0
+}
+
+func (x *ConnectionStatusEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ConnectionStatusEvent) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
+type SessionTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique name of the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The type of the sessions created from the template.
+	SessionType SessionType `protobuf:"varint,2,opt,name=session_type,json=sessionType,proto3,enum=litrpc.SessionType" json:"session_type,omitempty"`
+	// The number of seconds that the sessions created from the template remain
+	// active for. Zero means that the creator of the session decides.
+	ExpirySeconds uint64 `protobuf:"varint,3,opt,name=expiry_seconds,json=expirySeconds,proto3" json:"expiry_seconds,omitempty"`
+	// The address of the mailbox server that the sessions should use.
+	MailboxServerAddr string `protobuf:"bytes,4,opt,name=mailbox_server_addr,json=mailboxServerAddr,proto3" json:"mailbox_server_addr,omitempty"`
+	// If set to true, tls will be skipped when connecting to the mailbox.
+	DevServer bool `protobuf:"varint,5,opt,name=dev_server,json=devServer,proto3" json:"dev_server,omitempty"`
+	// Any custom permissions to add to the sessions' macaroon.
+	MacaroonCustomPermissions []*MacaroonPermission `protobuf:"bytes,6,rep,name=macaroon_custom_permissions,json=macaroonCustomPermissions,proto3" json:"macaroon_custom_permissions,omitempty"`
+	// The ID of the account that account sessions are associated with.
+	AccountId string `protobuf:"bytes,7,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The verbosity of the errors returned to the connected clients. If not set,
+	// the default of the session type is used.
+	ErrorVerbosity ErrorVerbosity `protobuf:"varint,8,opt,name=error_verbosity,json=errorVerbosity,proto3,enum=litrpc.ErrorVerbosity" json:"error_verbosity,omitempty"`
+	// Any custom first-party caveats to add to the sessions' macaroon.
+	MacaroonCustomCaveats []string `protobuf:"bytes,9,rep,name=macaroon_custom_caveats,json=macaroonCustomCaveats,proto3" json:"macaroon_custom_caveats,omitempty"`
+	// The full URIs of the RPCs that admin and readonly sessions should be
+	// restricted to.
+	AllowedUris []string `protobuf:"bytes,10,rep,name=allowed_uris,json=allowedUris,proto3" json:"allowed_uris,omitempty"`
+	// The total amount in satoshis that each session can spend in off-chain
+	// payments over its lifetime, including routing fees.
+	SpendBudgetSat uint64 `protobuf:"varint,11,opt,name=spend_budget_sat,json=spendBudgetSat,proto3" json:"spend_budget_sat,omitempty"`
+	// The session wide rules that the firewall should enforce.
+	SessionRules *RulesMap `protobuf:"bytes,12,opt,name=session_rules,json=sessionRules,proto3" json:"session_rules,omitempty"`
+	// The unix timestamp in seconds at which the template was last saved. This is
+	// ignored when saving a template.
+	CreatedAt uint64 `protobuf:"varint,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *SessionTemplate) Reset() {
+	*x = SessionTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionTemplate) ProtoMessage() {}
+
+func (x *SessionTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionTemplate.ProtoReflect.Descriptor instead.
+func (*SessionTemplate) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SessionTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetSessionType() SessionType {
+	if x != nil {
+		return x.SessionType
+	}
+	return SessionType_TYPE_MACAROON_READONLY
+}
+
+func (x *SessionTemplate) GetExpirySeconds() uint64 {
+	if x != nil {
+		return x.ExpirySeconds
+	}
+	return 0
+}
+
+func (x *SessionTemplate) GetMailboxServerAddr() string {
+	if x != nil {
+		return x.MailboxServerAddr
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetDevServer() bool {
+	if x != nil {
+		return x.DevServer
+	}
+	return false
+}
+
+func (x *SessionTemplate) GetMacaroonCustomPermissions() []*MacaroonPermission {
+	if x != nil {
+		return x.MacaroonCustomPermissions
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *SessionTemplate) GetErrorVerbosity() ErrorVerbosity {
+	if x != nil {
+		return x.ErrorVerbosity
+	}
+	return ErrorVerbosity_ERROR_VERBOSITY_DEFAULT
+}
+
+func (x *SessionTemplate) GetMacaroonCustomCaveats() []string {
+	if x != nil {
+		return x.MacaroonCustomCaveats
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetAllowedUris() []string {
+	if x != nil {
+		return x.AllowedUris
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetSpendBudgetSat() uint64 {
+	if x != nil {
+		return x.SpendBudgetSat
+	}
+	return 0
+}
+
+func (x *SessionTemplate) GetSessionRules() *RulesMap {
+	if x != nil {
+		return x.SessionRules
+	}
+	return nil
+}
+
+func (x *SessionTemplate) GetCreatedAt() uint64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type SaveSessionTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The template to save.
+	Template *SessionTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// If set, an existing template with the same name is replaced. Otherwise,
+	// saving a template under a name that is already used fails.
+	Overwrite bool `protobuf:"varint,2,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+}
+
+func (x *SaveSessionTemplateRequest) Reset() {
+	*x = SaveSessionTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSessionTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSessionTemplateRequest) ProtoMessage() {}
+
+func (x *SaveSessionTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSessionTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveSessionTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SaveSessionTemplateRequest) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *SaveSessionTemplateRequest) GetOverwrite() bool {
+	if x != nil {
+		return x.Overwrite
+	}
+	return false
+}
+
+type SaveSessionTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The saved template.
+	Template *SessionTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+}
+
+func (x *SaveSessionTemplateResponse) Reset() {
+	*x = SaveSessionTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveSessionTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSessionTemplateResponse) ProtoMessage() {}
+
+func (x *SaveSessionTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSessionTemplateResponse.ProtoReflect.Descriptor instead.
+func (*SaveSessionTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SaveSessionTemplateResponse) GetTemplate() *SessionTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type ListSessionTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSessionTemplatesRequest) Reset() {
+	*x = ListSessionTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionTemplatesRequest) ProtoMessage() {}
+
+func (x *ListSessionTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{35}
+}
+
+type ListSessionTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// All session templates, sorted by their name.
+	Templates []*SessionTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *ListSessionTemplatesResponse) Reset() {
+	*x = ListSessionTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSessionTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionTemplatesResponse) ProtoMessage() {}
+
+func (x *ListSessionTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListSessionTemplatesResponse) GetTemplates() []*SessionTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteSessionTemplateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the template to remove.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *DeleteSessionTemplateRequest) Reset() {
+	*x = DeleteSessionTemplateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSessionTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteSessionTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSessionTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteSessionTemplateRequest) GetName() string {
 	if x != nil {
-		return x.MaxCapacitySat
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *ChannelConstraint) GetMaxPushSat() uint64 {
-	if x != nil {
-		return x.MaxPushSat
-	}
-	return 0
+type DeleteSessionTemplateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ChannelConstraint) GetPrivateAllowed() bool {
-	if x != nil {
-		return x.PrivateAllowed
+func (x *DeleteSessionTemplateResponse) Reset() {
+	*x = DeleteSessionTemplateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_sessions_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ChannelConstraint) GetPublicAllowed() bool {
-	if x != nil {
-		return x.PublicAllowed
+func (x *DeleteSessionTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSessionTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteSessionTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_sessions_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSessionTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSessionTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_lit_sessions_proto_rawDescGZIP(), []int{38}
 }
 
 var File_lit_sessions_proto protoreflect.FileDescriptor
 
 var file_lit_sessions_proto_rawDesc = []byte{
 	0x0a, 0x12, 0x6c, 0x69, 0x74, 0x2d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x22, 0xe9, 0x02, 0x0a,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x22, 0xca, 0x05, 0x0a,
 	0x11, 0x41, 0x64, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73,
@@ -1679,253 +3265,533 @@ var file_lit_sessions_proto_rawDesc = []byte{
 	0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x65,
 	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63,
 	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x12, 0x4d, 0x61, 0x63, 0x61,
-	0x72, 0x6f, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f,
-	0x0a, 0x12, 0x41, 0x64, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0x97, 0x08, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
-	0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0c,
-	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x5f, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61, 0x63,
+	0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x76,
+	0x65, 0x61, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x6d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x76, 0x65, 0x61, 0x74,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x69,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x55, 0x72, 0x69, 0x73, 0x12, 0x2c, 0x0a, 0x10, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
+	0x30, 0x01, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53,
+	0x61, 0x74, 0x12, 0x35, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x5f, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x4d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2a, 0x0a,
+	0x11, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3d, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x21, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x70, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x76, 0x61, 0x72, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x70, 0x6d, 0x12, 0x2a, 0x0a, 0x0f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x0d, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x69, 0x7a, 0x65, 0x53, 0x61, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x73, 0x22, 0x44, 0x0a, 0x12, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc8, 0x0a, 0x0a, 0x07, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3c,
+	0x0a, 0x18, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x16, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x65, 0x76, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x64, 0x65, 0x76, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70,
+	0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x21, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x52, 0x0e, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x16, 0x61, 0x75, 0x74, 0x6f, 0x70, 0x69, 0x6c, 0x6f, 0x74,
+	0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14,
+	0x61, 0x75, 0x74, 0x6f, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x5f, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x76,
+	0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x56, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x56, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x02, 0x30, 0x01, 0x52, 0x0e, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x64, 0x67, 0x65,
+	0x74, 0x53, 0x61, 0x74, 0x12, 0x3f, 0x0a, 0x1a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x61, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x17, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x48, 0x0a, 0x12,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x11, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x62, 0x66, 0x75, 0x73,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x59, 0x0a, 0x19, 0x41, 0x75, 0x74, 0x6f, 0x70, 0x69,
+	0x6c, 0x6f, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x68, 0x0a, 0x0e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x50, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x76, 0x65, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x76, 0x65, 0x61, 0x74, 0x73, 0x22, 0xcf,
+	0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
 	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x16, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x76, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x64, 0x65, 0x76, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x70, 0x61, 0x69, 0x72, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x17, 0x70, 0x61, 0x69, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f,
-	0x6e, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x70, 0x61, 0x69, 0x72, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
-	0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61,
-	0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65,
-	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x6d, 0x61, 0x63,
-	0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x61,
-	0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x0e, 0x6d, 0x61, 0x63, 0x61,
-	0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x5f, 0x0a, 0x16, 0x61, 0x75, 0x74,
-	0x6f, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6c, 0x69, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x70,
-	0x69, 0x6c, 0x6f, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x61, 0x75, 0x74, 0x6f, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x46,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0a, 0x72, 0x65,
-	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
-	0x30, 0x01, 0x52, 0x09, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a,
-	0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0f, 0x66, 0x65, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x12, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63,
-	0x79, 0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x1a, 0x59, 0x0a, 0x19, 0x41,
-	0x75, 0x74, 0x6f, 0x70, 0x69, 0x6c, 0x6f, 0x74, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x68, 0x0a, 0x0e, 0x4d, 0x61, 0x63,
-	0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x70,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f,
-	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x61, 0x76,
-	0x65, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x61, 0x76, 0x65,
-	0x61, 0x74, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x43, 0x0a, 0x14, 0x4c, 0x69,
-	0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64,
+	0x22, 0x43, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x40, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xc4, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x29, 0x0a,
+	0x0e, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0f, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22, 0x66, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
 	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x40, 0x0a, 0x14, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
-	0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x08, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x4b, 0x0a, 0x0a, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xde, 0x04, 0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6c, 0x69, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x09,
-	0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4b, 0x0a, 0x12, 0x63, 0x68, 0x61,
-	0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x6f, 0x75, 0x6e,
-	0x64, 0x73, 0x48, 0x00, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x10, 0x6f, 0x66, 0x66, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42,
-	0x75, 0x64, 0x67, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x0f, 0x6f, 0x6e, 0x5f, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x6e, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64,
-	0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
-	0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x53, 0x65,
-	0x6c, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66,
-	0x12, 0x44, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x72,
-	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x11, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x42,
-	0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x67, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x12, 0x2d, 0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
-	0x2e, 0x52, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x22, 0x43, 0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69,
-	0x74, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d,
-	0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75,
-	0x6d, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
-	0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
-	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x02, 0x0a, 0x13, 0x43, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64,
-	0x73, 0x12, 0x26, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6d, 0x69,
-	0x6e, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
-	0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65,
-	0x50, 0x70, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f,
-	0x70, 0x70, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x61,
-	0x74, 0x65, 0x50, 0x70, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x74,
-	0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d,
-	0x69, 0x6e, 0x43, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x6d,
-	0x61, 0x78, 0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74,
-	0x61, 0x12, 0x26, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73,
-	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6d, 0x69,
-	0x6e, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
-	0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61,
-	0x74, 0x22, 0x5e, 0x0a, 0x0e, 0x4f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x64,
-	0x67, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d,
-	0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6d,
-	0x61, 0x78, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0d, 0x6d, 0x61, 0x78,
-	0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61,
-	0x74, 0x22, 0x6f, 0x0a, 0x0d, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x64, 0x67,
-	0x65, 0x74, 0x12, 0x2e, 0x0a, 0x11, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x61,
-	0x6d, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
-	0x01, 0x52, 0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x41, 0x6d, 0x74, 0x53, 0x61,
-	0x74, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x76, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02,
-	0x30, 0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x42, 0x79,
-	0x74, 0x65, 0x22, 0x0c, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66,
-	0x22, 0x36, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x72,
-	0x69, 0x63, 0x74, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x63, 0x68,
-	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x22, 0x29, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72,
-	0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72,
-	0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72,
-	0x49, 0x64, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43,
-	0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x10, 0x6d, 0x69, 0x6e,
-	0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x43, 0x61, 0x70, 0x61,
-	0x63, 0x69, 0x74, 0x79, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x63,
-	0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x70, 0x61, 0x63, 0x69,
-	0x74, 0x79, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x75, 0x73,
-	0x68, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
-	0x0a, 0x6d, 0x61, 0x78, 0x50, 0x75, 0x73, 0x68, 0x53, 0x61, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x6c,
-	0x6f, 0x77, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x61,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x2a, 0xa1, 0x01, 0x0a, 0x0b,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x43, 0x41, 0x52, 0x4f, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41,
-	0x44, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f,
-	0x4d, 0x41, 0x43, 0x41, 0x52, 0x4f, 0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01,
-	0x12, 0x18, 0x0a, 0x14, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x43, 0x41, 0x52, 0x4f, 0x4f,
-	0x4e, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x49, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x03,
-	0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x50, 0x49, 0x4c,
-	0x4f, 0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x43,
-	0x41, 0x52, 0x4f, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x05, 0x2a,
-	0x6d, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x55,
-	0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45,
-	0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45,
-	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10, 0x04, 0x32, 0xe8,
-	0x01, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x41,
-	0x64, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72,
-	0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
-	0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x49, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x1b, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x52,
-	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6c,
-	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
-	0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2d,
-	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x94, 0x01, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28,
+	0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x18, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x16,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x48, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x8a, 0x01, 0x0a, 0x08, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x12, 0x31, 0x0a,
+	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x2e, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x1a, 0x4b, 0x0a, 0x0a, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa5, 0x06,
+	0x0a, 0x09, 0x52, 0x75, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x72,
+	0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x48, 0x00, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x4b, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x48, 0x00, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x3b, 0x0a, 0x0d,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x42, 0x0a, 0x10, 0x6f, 0x66, 0x66,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x66, 0x66,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0e, 0x6f,
+	0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x3f, 0x0a,
+	0x0f, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x48, 0x00, 0x52,
+	0x0d, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x36,
+	0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64,
+	0x54, 0x6f, 0x53, 0x65, 0x6c, 0x66, 0x12, 0x44, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x3b, 0x0a, 0x0d,
+	0x70, 0x65, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x4a, 0x0a, 0x12, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74,
+	0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x75,
+	0x64, 0x67, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x12, 0x48, 0x0a, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x3b, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c,
+	0x6d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x67, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x61, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x2d, 0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x61,
+	0x74, 0x65, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x43,
+	0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x5f, 0x68, 0x6f,
+	0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x48, 0x6f,
+	0x75, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x0c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc5, 0x02, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x26,
+	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x42, 0x61,
+	0x73, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61,
+	0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x20,
+	0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x50, 0x70, 0x6d,
+	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x70, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x50,
+	0x70, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x43,
+	0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6c, 0x74, 0x76, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x43, 0x6c, 0x74, 0x76, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x26,
+	0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x48, 0x74,
+	0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x74,
+	0x6c, 0x63, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x48, 0x74, 0x6c, 0x63, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x5e,
+	0x0a, 0x0e, 0x4f, 0x66, 0x66, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x12, 0x24, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x41,
+	0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65,
+	0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x6f,
+	0x0a, 0x0d, 0x4f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x12,
+	0x2e, 0x0a, 0x11, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x74, 0x5f,
+	0x73, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0f,
+	0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x73, 0x12,
+	0x2e, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x53, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x42, 0x79, 0x74, 0x65, 0x22,
+	0x3f, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x12, 0x2e, 0x0a, 0x11, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x74,
+	0x5f, 0x73, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x0f, 0x61, 0x62, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x65, 0x41, 0x6d, 0x74, 0x53, 0x61, 0x74, 0x73,
+	0x22, 0x43, 0x0a, 0x10, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x06, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x22, 0x28, 0x0a, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22,
+	0x75, 0x0a, 0x0f, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x70, 0x61, 0x74,
+	0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c,
+	0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x6f,
+	0x53, 0x65, 0x6c, 0x66, 0x22, 0x6a, 0x0a, 0x0f, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x13,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x11, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x73,
+	0x22, 0x53, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74,
+	0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x65,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x10, 0x6d,
+	0x69, 0x6e, 0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x43, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x43, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x53, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x75, 0x73, 0x68, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30,
+	0x01, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x50, 0x75, 0x73, 0x68, 0x53, 0x61, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x71, 0x0a,
+	0x20, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x4f, 0x6e, 0x6c, 0x79,
+	0x22, 0x8e, 0x02, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x22, 0xf6, 0x04, 0x0a, 0x0f, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x29, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0d, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x6d, 0x61, 0x69, 0x6c, 0x62, 0x6f, 0x78, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x61, 0x69, 0x6c, 0x62,
+	0x6f, 0x78, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x64, 0x65, 0x76, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x64, 0x65, 0x76, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x1b, 0x6d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x70,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x6d, 0x61,
+	0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f,
+	0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x56, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x0e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x56, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x5f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x63, 0x61, 0x76, 0x65, 0x61,
+	0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f,
+	0x6f, 0x6e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x61, 0x76, 0x65, 0x61, 0x74, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x72,
+	0x69, 0x73, 0x12, 0x2c, 0x0a, 0x10, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01,
+	0x52, 0x0e, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x64, 0x67, 0x65, 0x74, 0x53, 0x61, 0x74,
+	0x12, 0x35, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6f, 0x0a, 0x1a, 0x53, 0x61,
+	0x76, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x22, 0x52, 0x0a, 0x1b, 0x53,
+	0x61, 0x76, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x08, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22,
+	0x1d, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55,
+	0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x32, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1f, 0x0a, 0x1d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xa1, 0x01, 0x0a, 0x0b, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x4d, 0x41, 0x43, 0x41, 0x52, 0x4f, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x44,
+	0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
+	0x41, 0x43, 0x41, 0x52, 0x4f, 0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x01, 0x12,
+	0x18, 0x0a, 0x14, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x43, 0x41, 0x52, 0x4f, 0x4f, 0x4e,
+	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x49, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x03, 0x12,
+	0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x55, 0x54, 0x4f, 0x50, 0x49, 0x4c, 0x4f,
+	0x54, 0x10, 0x04, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x43, 0x41,
+	0x52, 0x4f, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x05, 0x2a, 0x65,
+	0x0a, 0x0e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79,
+	0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x4f, 0x53,
+	0x49, 0x54, 0x59, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x4f, 0x53, 0x49, 0x54, 0x59,
+	0x5f, 0x54, 0x45, 0x52, 0x53, 0x45, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x56, 0x45, 0x52, 0x42, 0x4f, 0x53, 0x49, 0x54, 0x59, 0x5f, 0x56, 0x45, 0x52, 0x42,
+	0x4f, 0x53, 0x45, 0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x19, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f,
+	0x62, 0x66, 0x75, 0x73, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x12, 0x1f, 0x0a, 0x1b, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4f, 0x42, 0x46,
+	0x55, 0x53, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4c, 0x41, 0x54, 0x49, 0x56,
+	0x45, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x41, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x4f, 0x42,
+	0x46, 0x55, 0x53, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x55, 0x43, 0x4b, 0x45, 0x54,
+	0x10, 0x01, 0x2a, 0x6d, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41,
+	0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x49,
+	0x4e, 0x5f, 0x55, 0x53, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54,
+	0x41, 0x54, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x12, 0x0a,
+	0x0e, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x52, 0x56, 0x45, 0x44, 0x10,
+	0x04, 0x2a, 0x77, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x4f, 0x4e, 0x4e,
+	0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x4e, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f,
+	0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x32, 0xaa, 0x06, 0x0a, 0x08, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1b, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x22, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x28, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x12, 0x5e,
+	0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x22, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x61, 0x76, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
+	0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x23, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x64, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x25, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c,
+	0x61, 0x62, 0x73, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1940,72 +3806,127 @@ func file_lit_sessions_proto_rawDescGZIP() []byte {
 	return file_lit_sessions_proto_rawDescData
 }
 
-var file_lit_sessions_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_lit_sessions_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_lit_sessions_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_lit_sessions_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_lit_sessions_proto_goTypes = []any{
-	(SessionType)(0),              // 0: litrpc.SessionType
-	(SessionState)(0),             // 1: litrpc.SessionState
-	(*AddSessionRequest)(nil),     // 2: litrpc.AddSessionRequest
-	(*MacaroonPermission)(nil),    // 3: litrpc.MacaroonPermission
-	(*AddSessionResponse)(nil),    // 4: litrpc.AddSessionResponse
-	(*Session)(nil),               // 5: litrpc.Session
-	(*MacaroonRecipe)(nil),        // 6: litrpc.MacaroonRecipe
-	(*ListSessionsRequest)(nil),   // 7: litrpc.ListSessionsRequest
-	(*ListSessionsResponse)(nil),  // 8: litrpc.ListSessionsResponse
-	(*RevokeSessionRequest)(nil),  // 9: litrpc.RevokeSessionRequest
-	(*RevokeSessionResponse)(nil), // 10: litrpc.RevokeSessionResponse
-	(*RulesMap)(nil),              // 11: litrpc.RulesMap
-	(*RuleValue)(nil),             // 12: litrpc.RuleValue
-	(*RateLimit)(nil),             // 13: litrpc.RateLimit
-	(*Rate)(nil),                  // 14: litrpc.Rate
-	(*HistoryLimit)(nil),          // 15: litrpc.HistoryLimit
-	(*ChannelPolicyBounds)(nil),   // 16: litrpc.ChannelPolicyBounds
-	(*OffChainBudget)(nil),        // 17: litrpc.OffChainBudget
-	(*OnChainBudget)(nil),         // 18: litrpc.OnChainBudget
-	(*SendToSelf)(nil),            // 19: litrpc.SendToSelf
-	(*ChannelRestrict)(nil),       // 20: litrpc.ChannelRestrict
-	(*PeerRestrict)(nil),          // 21: litrpc.PeerRestrict
-	(*ChannelConstraint)(nil),     // 22: litrpc.ChannelConstraint
-	nil,                           // 23: litrpc.Session.AutopilotFeatureInfoEntry
-	nil,                           // 24: litrpc.Session.FeatureConfigsEntry
-	nil,                           // 25: litrpc.RulesMap.RulesEntry
+	(SessionType)(0),                         // 0: litrpc.SessionType
+	(ErrorVerbosity)(0),                      // 1: litrpc.ErrorVerbosity
+	(AmountObfuscationStrategy)(0),           // 2: litrpc.AmountObfuscationStrategy
+	(SessionState)(0),                        // 3: litrpc.SessionState
+	(ConnectionState)(0),                     // 4: litrpc.ConnectionState
+	(*AddSessionRequest)(nil),                // 5: litrpc.AddSessionRequest
+	(*AmountObfuscation)(nil),                // 6: litrpc.AmountObfuscation
+	(*MacaroonPermission)(nil),               // 7: litrpc.MacaroonPermission
+	(*AddSessionResponse)(nil),               // 8: litrpc.AddSessionResponse
+	(*Session)(nil),                          // 9: litrpc.Session
+	(*MacaroonRecipe)(nil),                   // 10: litrpc.MacaroonRecipe
+	(*ListSessionsRequest)(nil),              // 11: litrpc.ListSessionsRequest
+	(*ListSessionsResponse)(nil),             // 12: litrpc.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),             // 13: litrpc.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),            // 14: litrpc.RevokeSessionResponse
+	(*RevokeSessionsRequest)(nil),            // 15: litrpc.RevokeSessionsRequest
+	(*RevokeSessionsResponse)(nil),           // 16: litrpc.RevokeSessionsResponse
+	(*UpdateSessionExpiryRequest)(nil),       // 17: litrpc.UpdateSessionExpiryRequest
+	(*UpdateSessionExpiryResponse)(nil),      // 18: litrpc.UpdateSessionExpiryResponse
+	(*RulesMap)(nil),                         // 19: litrpc.RulesMap
+	(*RuleValue)(nil),                        // 20: litrpc.RuleValue
+	(*RateLimit)(nil),                        // 21: litrpc.RateLimit
+	(*Rate)(nil),                             // 22: litrpc.Rate
+	(*HistoryLimit)(nil),                     // 23: litrpc.HistoryLimit
+	(*ChannelPolicyBounds)(nil),              // 24: litrpc.ChannelPolicyBounds
+	(*OffChainBudget)(nil),                   // 25: litrpc.OffChainBudget
+	(*OnChainBudget)(nil),                    // 26: litrpc.OnChainBudget
+	(*SessionBudget)(nil),                    // 27: litrpc.SessionBudget
+	(*SessionRateLimit)(nil),                 // 28: litrpc.SessionRateLimit
+	(*MemoRequired)(nil),                     // 29: litrpc.MemoRequired
+	(*MethodRateLimit)(nil),                  // 30: litrpc.MethodRateLimit
+	(*SendToSelf)(nil),                       // 31: litrpc.SendToSelf
+	(*ChannelRestrict)(nil),                  // 32: litrpc.ChannelRestrict
+	(*PeerRestrict)(nil),                     // 33: litrpc.PeerRestrict
+	(*ChannelConstraint)(nil),                // 34: litrpc.ChannelConstraint
+	(*SubscribeConnectionStatusRequest)(nil), // 35: litrpc.SubscribeConnectionStatusRequest
+	(*ConnectionStatusEvent)(nil),            // 36: litrpc.ConnectionStatusEvent
+	(*SessionTemplate)(nil),                  // 37: litrpc.SessionTemplate
+	(*SaveSessionTemplateRequest)(nil),       // 38: litrpc.SaveSessionTemplateRequest
+	(*SaveSessionTemplateResponse)(nil),      // 39: litrpc.SaveSessionTemplateResponse
+	(*ListSessionTemplatesRequest)(nil),      // 40: litrpc.ListSessionTemplatesRequest
+	(*ListSessionTemplatesResponse)(nil),     // 41: litrpc.ListSessionTemplatesResponse
+	(*DeleteSessionTemplateRequest)(nil),     // 42: litrpc.DeleteSessionTemplateRequest
+	(*DeleteSessionTemplateResponse)(nil),    // 43: litrpc.DeleteSessionTemplateResponse
+	nil,                                      // 44: litrpc.Session.AutopilotFeatureInfoEntry
+	nil,                                      // 45: litrpc.Session.FeatureConfigsEntry
+	nil,                                      // 46: litrpc.RulesMap.RulesEntry
 }
 var file_lit_sessions_proto_depIdxs = []int32{
 	0,  // 0: litrpc.AddSessionRequest.session_type:type_name -> litrpc.SessionType
-	3,  // 1: litrpc.AddSessionRequest.macaroon_custom_permissions:type_name -> litrpc.MacaroonPermission
-	5,  // 2: litrpc.AddSessionResponse.session:type_name -> litrpc.Session
-	1,  // 3: litrpc.Session.session_state:type_name -> litrpc.SessionState
-	0,  // 4: litrpc.Session.session_type:type_name -> litrpc.SessionType
-	6,  // 5: litrpc.Session.macaroon_recipe:type_name -> litrpc.MacaroonRecipe
-	23, // 6: litrpc.Session.autopilot_feature_info:type_name -> litrpc.Session.AutopilotFeatureInfoEntry
-	24, // 7: litrpc.Session.feature_configs:type_name -> litrpc.Session.FeatureConfigsEntry
-	3,  // 8: litrpc.MacaroonRecipe.permissions:type_name -> litrpc.MacaroonPermission
-	5,  // 9: litrpc.ListSessionsResponse.sessions:type_name -> litrpc.Session
-	25, // 10: litrpc.RulesMap.rules:type_name -> litrpc.RulesMap.RulesEntry
-	13, // 11: litrpc.RuleValue.rate_limit:type_name -> litrpc.RateLimit
-	16, // 12: litrpc.RuleValue.chan_policy_bounds:type_name -> litrpc.ChannelPolicyBounds
-	15, // 13: litrpc.RuleValue.history_limit:type_name -> litrpc.HistoryLimit
-	17, // 14: litrpc.RuleValue.off_chain_budget:type_name -> litrpc.OffChainBudget
-	18, // 15: litrpc.RuleValue.on_chain_budget:type_name -> litrpc.OnChainBudget
-	19, // 16: litrpc.RuleValue.send_to_self:type_name -> litrpc.SendToSelf
-	20, // 17: litrpc.RuleValue.channel_restrict:type_name -> litrpc.ChannelRestrict
-	21, // 18: litrpc.RuleValue.peer_restrict:type_name -> litrpc.PeerRestrict
-	22, // 19: litrpc.RuleValue.channel_constraint:type_name -> litrpc.ChannelConstraint
-	14, // 20: litrpc.RateLimit.read_limit:type_name -> litrpc.Rate
-	14, // 21: litrpc.RateLimit.write_limit:type_name -> litrpc.Rate
-	11, // 22: litrpc.Session.AutopilotFeatureInfoEntry.value:type_name -> litrpc.RulesMap
-	12, // 23: litrpc.RulesMap.RulesEntry.value:type_name -> litrpc.RuleValue
-	2,  // 24: litrpc.Sessions.AddSession:input_type -> litrpc.AddSessionRequest
-	7,  // 25: litrpc.Sessions.ListSessions:input_type -> litrpc.ListSessionsRequest
-	9,  // 26: litrpc.Sessions.RevokeSession:input_type -> litrpc.RevokeSessionRequest
-	4,  // 27: litrpc.Sessions.AddSession:output_type -> litrpc.AddSessionResponse
-	8,  // 28: litrpc.Sessions.ListSessions:output_type -> litrpc.ListSessionsResponse
-	10, // 29: litrpc.Sessions.RevokeSession:output_type -> litrpc.RevokeSessionResponse
-	27, // [27:30] is the sub-list for method output_type
-	24, // [24:27] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	7,  // 1: litrpc.AddSessionRequest.macaroon_custom_permissions:type_name -> litrpc.MacaroonPermission
+	1,  // 2: litrpc.AddSessionRequest.error_verbosity:type_name -> litrpc.ErrorVerbosity
+	19, // 3: litrpc.AddSessionRequest.session_rules:type_name -> litrpc.RulesMap
+	2,  // 4: litrpc.AmountObfuscation.strategy:type_name -> litrpc.AmountObfuscationStrategy
+	9,  // 5: litrpc.AddSessionResponse.session:type_name -> litrpc.Session
+	3,  // 6: litrpc.Session.session_state:type_name -> litrpc.SessionState
+	0,  // 7: litrpc.Session.session_type:type_name -> litrpc.SessionType
+	10, // 8: litrpc.Session.macaroon_recipe:type_name -> litrpc.MacaroonRecipe
+	44, // 9: litrpc.Session.autopilot_feature_info:type_name -> litrpc.Session.AutopilotFeatureInfoEntry
+	45, // 10: litrpc.Session.feature_configs:type_name -> litrpc.Session.FeatureConfigsEntry
+	1,  // 11: litrpc.Session.error_verbosity:type_name -> litrpc.ErrorVerbosity
+	19, // 12: litrpc.Session.session_rules:type_name -> litrpc.RulesMap
+	6,  // 13: litrpc.Session.amount_obfuscation:type_name -> litrpc.AmountObfuscation
+	7,  // 14: litrpc.MacaroonRecipe.permissions:type_name -> litrpc.MacaroonPermission
+	3,  // 15: litrpc.ListSessionsRequest.states:type_name -> litrpc.SessionState
+	0,  // 16: litrpc.ListSessionsRequest.types:type_name -> litrpc.SessionType
+	9,  // 17: litrpc.ListSessionsResponse.sessions:type_name -> litrpc.Session
+	9,  // 18: litrpc.RevokeSessionsResponse.sessions:type_name -> litrpc.Session
+	9,  // 19: litrpc.UpdateSessionExpiryResponse.session:type_name -> litrpc.Session
+	46, // 20: litrpc.RulesMap.rules:type_name -> litrpc.RulesMap.RulesEntry
+	21, // 21: litrpc.RuleValue.rate_limit:type_name -> litrpc.RateLimit
+	24, // 22: litrpc.RuleValue.chan_policy_bounds:type_name -> litrpc.ChannelPolicyBounds
+	23, // 23: litrpc.RuleValue.history_limit:type_name -> litrpc.HistoryLimit
+	25, // 24: litrpc.RuleValue.off_chain_budget:type_name -> litrpc.OffChainBudget
+	26, // 25: litrpc.RuleValue.on_chain_budget:type_name -> litrpc.OnChainBudget
+	31, // 26: litrpc.RuleValue.send_to_self:type_name -> litrpc.SendToSelf
+	32, // 27: litrpc.RuleValue.channel_restrict:type_name -> litrpc.ChannelRestrict
+	33, // 28: litrpc.RuleValue.peer_restrict:type_name -> litrpc.PeerRestrict
+	34, // 29: litrpc.RuleValue.channel_constraint:type_name -> litrpc.ChannelConstraint
+	27, // 30: litrpc.RuleValue.session_budget:type_name -> litrpc.SessionBudget
+	28, // 31: litrpc.RuleValue.session_rate_limit:type_name -> litrpc.SessionRateLimit
+	29, // 32: litrpc.RuleValue.memo_required:type_name -> litrpc.MemoRequired
+	22, // 33: litrpc.RateLimit.read_limit:type_name -> litrpc.Rate
+	22, // 34: litrpc.RateLimit.write_limit:type_name -> litrpc.Rate
+	30, // 35: litrpc.SessionRateLimit.limits:type_name -> litrpc.MethodRateLimit
+	4,  // 36: litrpc.ConnectionStatusEvent.state:type_name -> litrpc.ConnectionState
+	0,  // 37: litrpc.SessionTemplate.session_type:type_name -> litrpc.SessionType
+	7,  // 38: litrpc.SessionTemplate.macaroon_custom_permissions:type_name -> litrpc.MacaroonPermission
+	1,  // 39: litrpc.SessionTemplate.error_verbosity:type_name -> litrpc.ErrorVerbosity
+	19, // 40: litrpc.SessionTemplate.session_rules:type_name -> litrpc.RulesMap
+	37, // 41: litrpc.SaveSessionTemplateRequest.template:type_name -> litrpc.SessionTemplate
+	37, // 42: litrpc.SaveSessionTemplateResponse.template:type_name -> litrpc.SessionTemplate
+	37, // 43: litrpc.ListSessionTemplatesResponse.templates:type_name -> litrpc.SessionTemplate
+	19, // 44: litrpc.Session.AutopilotFeatureInfoEntry.value:type_name -> litrpc.RulesMap
+	20, // 45: litrpc.RulesMap.RulesEntry.value:type_name -> litrpc.RuleValue
+	5,  // 46: litrpc.Sessions.AddSession:input_type -> litrpc.AddSessionRequest
+	11, // 47: litrpc.Sessions.ListSessions:input_type -> litrpc.ListSessionsRequest
+	13, // 48: litrpc.Sessions.RevokeSession:input_type -> litrpc.RevokeSessionRequest
+	15, // 49: litrpc.Sessions.RevokeSessions:input_type -> litrpc.RevokeSessionsRequest
+	17, // 50: litrpc.Sessions.UpdateSessionExpiry:input_type -> litrpc.UpdateSessionExpiryRequest
+	35, // 51: litrpc.Sessions.SubscribeConnectionStatus:input_type -> litrpc.SubscribeConnectionStatusRequest
+	38, // 52: litrpc.Sessions.SaveSessionTemplate:input_type -> litrpc.SaveSessionTemplateRequest
+	40, // 53: litrpc.Sessions.ListSessionTemplates:input_type -> litrpc.ListSessionTemplatesRequest
+	42, // 54: litrpc.Sessions.DeleteSessionTemplate:input_type -> litrpc.DeleteSessionTemplateRequest
+	8,  // 55: litrpc.Sessions.AddSession:output_type -> litrpc.AddSessionResponse
+	12, // 56: litrpc.Sessions.ListSessions:output_type -> litrpc.ListSessionsResponse
+	14, // 57: litrpc.Sessions.RevokeSession:output_type -> litrpc.RevokeSessionResponse
+	16, // 58: litrpc.Sessions.RevokeSessions:output_type -> litrpc.RevokeSessionsResponse
+	18, // 59: litrpc.Sessions.UpdateSessionExpiry:output_type -> litrpc.UpdateSessionExpiryResponse
+	36, // 60: litrpc.Sessions.SubscribeConnectionStatus:output_type -> litrpc.ConnectionStatusEvent
+	39, // 61: litrpc.Sessions.SaveSessionTemplate:output_type -> litrpc.SaveSessionTemplateResponse
+	41, // 62: litrpc.Sessions.ListSessionTemplates:output_type -> litrpc.ListSessionTemplatesResponse
+	43, // 63: litrpc.Sessions.DeleteSessionTemplate:output_type -> litrpc.DeleteSessionTemplateResponse
+	55, // [55:64] is the sub-list for method output_type
+	46, // [46:55] is the sub-list for method input_type
+	46, // [46:46] is the sub-list for extension type_name
+	46, // [46:46] is the sub-list for extension extendee
+	0,  // [0:46] is the sub-list for field type_name
 }
 
 func init() { file_lit_sessions_proto_init() }
@@ -2027,7 +3948,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*MacaroonPermission); i {
+			switch v := v.(*AmountObfuscation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2039,7 +3960,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*AddSessionResponse); i {
+			switch v := v.(*MacaroonPermission); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2051,7 +3972,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*Session); i {
+			switch v := v.(*AddSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2063,7 +3984,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*MacaroonRecipe); i {
+			switch v := v.(*Session); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2075,7 +3996,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSessionsRequest); i {
+			switch v := v.(*MacaroonRecipe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2087,7 +4008,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*ListSessionsResponse); i {
+			switch v := v.(*ListSessionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2099,7 +4020,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*RevokeSessionRequest); i {
+			switch v := v.(*ListSessionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2111,7 +4032,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*RevokeSessionResponse); i {
+			switch v := v.(*RevokeSessionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2123,7 +4044,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*RulesMap); i {
+			switch v := v.(*RevokeSessionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2135,7 +4056,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*RuleValue); i {
+			switch v := v.(*RevokeSessionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2147,7 +4068,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*RateLimit); i {
+			switch v := v.(*RevokeSessionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2159,7 +4080,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*Rate); i {
+			switch v := v.(*UpdateSessionExpiryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2171,7 +4092,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*HistoryLimit); i {
+			switch v := v.(*UpdateSessionExpiryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2183,7 +4104,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*ChannelPolicyBounds); i {
+			switch v := v.(*RulesMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2195,7 +4116,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*OffChainBudget); i {
+			switch v := v.(*RuleValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2207,7 +4128,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*OnChainBudget); i {
+			switch v := v.(*RateLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2219,7 +4140,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*SendToSelf); i {
+			switch v := v.(*Rate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2231,7 +4152,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*ChannelRestrict); i {
+			switch v := v.(*HistoryLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2243,7 +4164,7 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*PeerRestrict); i {
+			switch v := v.(*ChannelPolicyBounds); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2255,6 +4176,114 @@ func file_lit_sessions_proto_init() {
 			}
 		}
 		file_lit_sessions_proto_msgTypes[20].Exporter = func(v any, i int) any {
+			switch v := v.(*OffChainBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[21].Exporter = func(v any, i int) any {
+			switch v := v.(*OnChainBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[22].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionBudget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[23].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*MemoRequired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*MethodRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*SendToSelf); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*ChannelRestrict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*PeerRestrict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*ChannelConstraint); i {
 			case 0:
 				return &v.state
@@ -2266,8 +4295,116 @@ func file_lit_sessions_proto_init() {
 				return nil
 			}
 		}
+		file_lit_sessions_proto_msgTypes[30].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeConnectionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[31].Exporter = func(v any, i int) any {
+			switch v := v.(*ConnectionStatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*SessionTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[33].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveSessionTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[34].Exporter = func(v any, i int) any {
+			switch v := v.(*SaveSessionTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[35].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSessionTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[36].Exporter = func(v any, i int) any {
+			switch v := v.(*ListSessionTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[37].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteSessionTemplateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_sessions_proto_msgTypes[38].Exporter = func(v any, i int) any {
+			switch v := v.(*DeleteSessionTemplateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_lit_sessions_proto_msgTypes[10].OneofWrappers = []any{
+	file_lit_sessions_proto_msgTypes[15].OneofWrappers = []any{
 		(*RuleValue_RateLimit)(nil),
 		(*RuleValue_ChanPolicyBounds)(nil),
 		(*RuleValue_HistoryLimit)(nil),
@@ -2277,14 +4414,17 @@ func file_lit_sessions_proto_init() {
 		(*RuleValue_ChannelRestrict)(nil),
 		(*RuleValue_PeerRestrict)(nil),
 		(*RuleValue_ChannelConstraint)(nil),
+		(*RuleValue_SessionBudget)(nil),
+		(*RuleValue_SessionRateLimit)(nil),
+		(*RuleValue_MemoRequired)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lit_sessions_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   24,
+			NumEnums:      5,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
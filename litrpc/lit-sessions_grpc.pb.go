@@ -22,12 +22,50 @@ type SessionsClient interface {
 	// AddSession adds and starts a new LNC session.
 	AddSession(ctx context.Context, in *AddSessionRequest, opts ...grpc.CallOption) (*AddSessionResponse, error)
 	// litcli: `sessions list`
-	// ListSessions returns all sessions known to the session store.
+	// ListSessions returns all sessions known to the session store, optionally
+	// only those that match the given filters.
 	ListSessions(ctx context.Context, in *ListSessionsRequest, opts ...grpc.CallOption) (*ListSessionsResponse, error)
 	// litcli: `sessions revoke`
 	// RevokeSession revokes a single session and also stops it if it is currently
 	// active.
 	RevokeSession(ctx context.Context, in *RevokeSessionRequest, opts ...grpc.CallOption) (*RevokeSessionResponse, error)
+	// litcli: `sessions revoke`
+	// RevokeSessions revokes all active sessions that match the given filters
+	// in one call and also stops them if they are currently active. At least one
+	// filter must be set, and a session must match all of the set filters to be
+	// revoked. Sessions that are already revoked or expired are skipped, so the
+	// call can safely be repeated if it failed after revoking only some of the
+	// sessions.
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error)
+	// litcli: `sessions extend`
+	// UpdateSessionExpiry sets a new expiry for an existing session that is
+	// neither revoked nor expired, so that it can be used for longer without
+	// pairing a new client. A running session is not interrupted and keeps
+	// running until the new expiry. Session macaroons don't carry the expiry
+	// as a caveat, it is checked against the stored session on every call
+	// instead. So a connected client can keep using its macaroon without
+	// reconnecting.
+	UpdateSessionExpiry(ctx context.Context, in *UpdateSessionExpiryRequest, opts ...grpc.CallOption) (*UpdateSessionExpiryResponse, error)
+	// litcli: `sessions connstatus --watch`
+	// SubscribeConnectionStatus streams an event whenever the mailbox connection
+	// of an active session changes, for example when it is lost and the session
+	// starts to reconnect with a backoff. The latest status of each active
+	// session is sent first, so a client that subscribes late or reconnects
+	// always starts with a full snapshot. A client that falls behind is
+	// disconnected and has to subscribe again.
+	SubscribeConnectionStatus(ctx context.Context, in *SubscribeConnectionStatusRequest, opts ...grpc.CallOption) (Sessions_SubscribeConnectionStatusClient, error)
+	// litcli: `sessions template save`
+	// SaveSessionTemplate stores a named set of session parameters in the session
+	// database. New sessions can be created with the parameters of the template
+	// as defaults, for example with `litcli sessions add --template`.
+	SaveSessionTemplate(ctx context.Context, in *SaveSessionTemplateRequest, opts ...grpc.CallOption) (*SaveSessionTemplateResponse, error)
+	// litcli: `sessions template list`
+	// ListSessionTemplates returns all session templates, sorted by their name.
+	ListSessionTemplates(ctx context.Context, in *ListSessionTemplatesRequest, opts ...grpc.CallOption) (*ListSessionTemplatesResponse, error)
+	// litcli: `sessions template delete`
+	// DeleteSessionTemplate removes the session template with the given name.
+	// Sessions that were created from it are not affected.
+	DeleteSessionTemplate(ctx context.Context, in *DeleteSessionTemplateRequest, opts ...grpc.CallOption) (*DeleteSessionTemplateResponse, error)
 }
 
 type sessionsClient struct {
@@ -65,6 +103,83 @@ func (c *sessionsClient) RevokeSession(ctx context.Context, in *RevokeSessionReq
 	return out, nil
 }
 
+func (c *sessionsClient) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...grpc.CallOption) (*RevokeSessionsResponse, error) {
+	out := new(RevokeSessionsResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/RevokeSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) UpdateSessionExpiry(ctx context.Context, in *UpdateSessionExpiryRequest, opts ...grpc.CallOption) (*UpdateSessionExpiryResponse, error) {
+	out := new(UpdateSessionExpiryResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/UpdateSessionExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) SubscribeConnectionStatus(ctx context.Context, in *SubscribeConnectionStatusRequest, opts ...grpc.CallOption) (Sessions_SubscribeConnectionStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sessions_ServiceDesc.Streams[0], "/litrpc.Sessions/SubscribeConnectionStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &sessionsSubscribeConnectionStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Sessions_SubscribeConnectionStatusClient interface {
+	Recv() (*ConnectionStatusEvent, error)
+	grpc.ClientStream
+}
+
+type sessionsSubscribeConnectionStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *sessionsSubscribeConnectionStatusClient) Recv() (*ConnectionStatusEvent, error) {
+	m := new(ConnectionStatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *sessionsClient) SaveSessionTemplate(ctx context.Context, in *SaveSessionTemplateRequest, opts ...grpc.CallOption) (*SaveSessionTemplateResponse, error) {
+	out := new(SaveSessionTemplateResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/SaveSessionTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) ListSessionTemplates(ctx context.Context, in *ListSessionTemplatesRequest, opts ...grpc.CallOption) (*ListSessionTemplatesResponse, error) {
+	out := new(ListSessionTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/ListSessionTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionsClient) DeleteSessionTemplate(ctx context.Context, in *DeleteSessionTemplateRequest, opts ...grpc.CallOption) (*DeleteSessionTemplateResponse, error) {
+	out := new(DeleteSessionTemplateResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Sessions/DeleteSessionTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SessionsServer is the server API for Sessions service.
 // All implementations must embed UnimplementedSessionsServer
 // for forward compatibility
@@ -73,12 +188,50 @@ type SessionsServer interface {
 	// AddSession adds and starts a new LNC session.
 	AddSession(context.Context, *AddSessionRequest) (*AddSessionResponse, error)
 	// litcli: `sessions list`
-	// ListSessions returns all sessions known to the session store.
+	// ListSessions returns all sessions known to the session store, optionally
+	// only those that match the given filters.
 	ListSessions(context.Context, *ListSessionsRequest) (*ListSessionsResponse, error)
 	// litcli: `sessions revoke`
 	// RevokeSession revokes a single session and also stops it if it is currently
 	// active.
 	RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error)
+	// litcli: `sessions revoke`
+	// RevokeSessions revokes all active sessions that match the given filters
+	// in one call and also stops them if they are currently active. At least one
+	// filter must be set, and a session must match all of the set filters to be
+	// revoked. Sessions that are already revoked or expired are skipped, so the
+	// call can safely be repeated if it failed after revoking only some of the
+	// sessions.
+	RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error)
+	// litcli: `sessions extend`
+	// UpdateSessionExpiry sets a new expiry for an existing session that is
+	// neither revoked nor expired, so that it can be used for longer without
+	// pairing a new client. A running session is not interrupted and keeps
+	// running until the new expiry. Session macaroons don't carry the expiry
+	// as a caveat, it is checked against the stored session on every call
+	// instead. So a connected client can keep using its macaroon without
+	// reconnecting.
+	UpdateSessionExpiry(context.Context, *UpdateSessionExpiryRequest) (*UpdateSessionExpiryResponse, error)
+	// litcli: `sessions connstatus --watch`
+	// SubscribeConnectionStatus streams an event whenever the mailbox connection
+	// of an active session changes, for example when it is lost and the session
+	// starts to reconnect with a backoff. The latest status of each active
+	// session is sent first, so a client that subscribes late or reconnects
+	// always starts with a full snapshot. A client that falls behind is
+	// disconnected and has to subscribe again.
+	SubscribeConnectionStatus(*SubscribeConnectionStatusRequest, Sessions_SubscribeConnectionStatusServer) error
+	// litcli: `sessions template save`
+	// SaveSessionTemplate stores a named set of session parameters in the session
+	// database. New sessions can be created with the parameters of the template
+	// as defaults, for example with `litcli sessions add --template`.
+	SaveSessionTemplate(context.Context, *SaveSessionTemplateRequest) (*SaveSessionTemplateResponse, error)
+	// litcli: `sessions template list`
+	// ListSessionTemplates returns all session templates, sorted by their name.
+	ListSessionTemplates(context.Context, *ListSessionTemplatesRequest) (*ListSessionTemplatesResponse, error)
+	// litcli: `sessions template delete`
+	// DeleteSessionTemplate removes the session template with the given name.
+	// Sessions that were created from it are not affected.
+	DeleteSessionTemplate(context.Context, *DeleteSessionTemplateRequest) (*DeleteSessionTemplateResponse, error)
 	mustEmbedUnimplementedSessionsServer()
 }
 
@@ -95,6 +248,24 @@ func (UnimplementedSessionsServer) ListSessions(context.Context, *ListSessionsRe
 func (UnimplementedSessionsServer) RevokeSession(context.Context, *RevokeSessionRequest) (*RevokeSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
 }
+func (UnimplementedSessionsServer) RevokeSessions(context.Context, *RevokeSessionsRequest) (*RevokeSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessions not implemented")
+}
+func (UnimplementedSessionsServer) UpdateSessionExpiry(context.Context, *UpdateSessionExpiryRequest) (*UpdateSessionExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSessionExpiry not implemented")
+}
+func (UnimplementedSessionsServer) SubscribeConnectionStatus(*SubscribeConnectionStatusRequest, Sessions_SubscribeConnectionStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeConnectionStatus not implemented")
+}
+func (UnimplementedSessionsServer) SaveSessionTemplate(context.Context, *SaveSessionTemplateRequest) (*SaveSessionTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSessionTemplate not implemented")
+}
+func (UnimplementedSessionsServer) ListSessionTemplates(context.Context, *ListSessionTemplatesRequest) (*ListSessionTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessionTemplates not implemented")
+}
+func (UnimplementedSessionsServer) DeleteSessionTemplate(context.Context, *DeleteSessionTemplateRequest) (*DeleteSessionTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSessionTemplate not implemented")
+}
 func (UnimplementedSessionsServer) mustEmbedUnimplementedSessionsServer() {}
 
 // UnsafeSessionsServer may be embedded to opt out of forward compatibility for this service.
@@ -162,6 +333,117 @@ func _Sessions_RevokeSession_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Sessions_RevokeSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).RevokeSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/RevokeSessions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).RevokeSessions(ctx, req.(*RevokeSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_UpdateSessionExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSessionExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).UpdateSessionExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/UpdateSessionExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).UpdateSessionExpiry(ctx, req.(*UpdateSessionExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_SubscribeConnectionStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeConnectionStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SessionsServer).SubscribeConnectionStatus(m, &sessionsSubscribeConnectionStatusServer{stream})
+}
+
+type Sessions_SubscribeConnectionStatusServer interface {
+	Send(*ConnectionStatusEvent) error
+	grpc.ServerStream
+}
+
+type sessionsSubscribeConnectionStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *sessionsSubscribeConnectionStatusServer) Send(m *ConnectionStatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Sessions_SaveSessionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSessionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).SaveSessionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/SaveSessionTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).SaveSessionTemplate(ctx, req.(*SaveSessionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_ListSessionTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSessionTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).ListSessionTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/ListSessionTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).ListSessionTemplates(ctx, req.(*ListSessionTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sessions_DeleteSessionTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSessionTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionsServer).DeleteSessionTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Sessions/DeleteSessionTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionsServer).DeleteSessionTemplate(ctx, req.(*DeleteSessionTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Sessions_ServiceDesc is the grpc.ServiceDesc for Sessions service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -181,7 +463,33 @@ var Sessions_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeSession",
 			Handler:    _Sessions_RevokeSession_Handler,
 		},
+		{
+			MethodName: "RevokeSessions",
+			Handler:    _Sessions_RevokeSessions_Handler,
+		},
+		{
+			MethodName: "UpdateSessionExpiry",
+			Handler:    _Sessions_UpdateSessionExpiry_Handler,
+		},
+		{
+			MethodName: "SaveSessionTemplate",
+			Handler:    _Sessions_SaveSessionTemplate_Handler,
+		},
+		{
+			MethodName: "ListSessionTemplates",
+			Handler:    _Sessions_ListSessionTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteSessionTemplate",
+			Handler:    _Sessions_DeleteSessionTemplate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeConnectionStatus",
+			Handler:       _Sessions_SubscribeConnectionStatus_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lit-sessions.proto",
 }
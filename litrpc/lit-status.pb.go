@@ -20,6 +20,71 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SubServerState int32
+
+const (
+	// The sub-server is enabled but hasn't started running yet.
+	SubServerState_SUB_SERVER_STATE_STARTING SubServerState = 0
+	// The sub-server is running.
+	SubServerState_SUB_SERVER_STATE_RUNNING SubServerState = 1
+	// The sub-server failed with the error given in the status.
+	SubServerState_SUB_SERVER_STATE_ERRORED SubServerState = 2
+	// The sub-server was stopped.
+	SubServerState_SUB_SERVER_STATE_STOPPED SubServerState = 3
+	// The sub-server is disabled.
+	SubServerState_SUB_SERVER_STATE_DISABLED SubServerState = 4
+	// The status of the sub-server has gone stale, so it is not known whether
+	// it is still running.
+	SubServerState_SUB_SERVER_STATE_UNKNOWN SubServerState = 5
+)
+
+// Enum value maps for SubServerState.
+var (
+	SubServerState_name = map[int32]string{
+		0: "SUB_SERVER_STATE_STARTING",
+		1: "SUB_SERVER_STATE_RUNNING",
+		2: "SUB_SERVER_STATE_ERRORED",
+		3: "SUB_SERVER_STATE_STOPPED",
+		4: "SUB_SERVER_STATE_DISABLED",
+		5: "SUB_SERVER_STATE_UNKNOWN",
+	}
+	SubServerState_value = map[string]int32{
+		"SUB_SERVER_STATE_STARTING": 0,
+		"SUB_SERVER_STATE_RUNNING":  1,
+		"SUB_SERVER_STATE_ERRORED":  2,
+		"SUB_SERVER_STATE_STOPPED":  3,
+		"SUB_SERVER_STATE_DISABLED": 4,
+		"SUB_SERVER_STATE_UNKNOWN":  5,
+	}
+)
+
+func (x SubServerState) Enum() *SubServerState {
+	p := new(SubServerState)
+	*p = x
+	return p
+}
+
+func (x SubServerState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubServerState) Descriptor() protoreflect.EnumDescriptor {
+	return file_lit_status_proto_enumTypes[0].Descriptor()
+}
+
+func (SubServerState) Type() protoreflect.EnumType {
+	return &file_lit_status_proto_enumTypes[0]
+}
+
+func (x SubServerState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubServerState.Descriptor instead.
+func (SubServerState) EnumDescriptor() ([]byte, []int) {
+	return file_lit_status_proto_rawDescGZIP(), []int{0}
+}
+
 type SubServerStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -58,6 +123,303 @@ func (*SubServerStatusReq) Descriptor() ([]byte, []int) {
 	return file_lit_status_proto_rawDescGZIP(), []int{0}
 }
 
+type StartSubServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the sub-server to start.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StartSubServerRequest) Reset() {
+	*x = StartSubServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_status_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSubServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSubServerRequest) ProtoMessage() {}
+
+func (x *StartSubServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_status_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSubServerRequest.ProtoReflect.Descriptor instead.
+func (*StartSubServerRequest) Descriptor() ([]byte, []int) {
+	return file_lit_status_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartSubServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StartSubServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The status of the sub-server after it was started.
+	Status *SubServerStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StartSubServerResponse) Reset() {
+	*x = StartSubServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_status_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartSubServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartSubServerResponse) ProtoMessage() {}
+
+func (x *StartSubServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_status_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartSubServerResponse.ProtoReflect.Descriptor instead.
+func (*StartSubServerResponse) Descriptor() ([]byte, []int) {
+	return file_lit_status_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StartSubServerResponse) GetStatus() *SubServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type StopSubServerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the sub-server to stop.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *StopSubServerRequest) Reset() {
+	*x = StopSubServerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_status_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopSubServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopSubServerRequest) ProtoMessage() {}
+
+func (x *StopSubServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_status_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopSubServerRequest.ProtoReflect.Descriptor instead.
+func (*StopSubServerRequest) Descriptor() ([]byte, []int) {
+	return file_lit_status_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StopSubServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type StopSubServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The status of the sub-server after it was stopped.
+	Status *SubServerStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *StopSubServerResponse) Reset() {
+	*x = StopSubServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_status_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopSubServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopSubServerResponse) ProtoMessage() {}
+
+func (x *StopSubServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_status_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopSubServerResponse.ProtoReflect.Descriptor instead.
+func (*StopSubServerResponse) Descriptor() ([]byte, []int) {
+	return file_lit_status_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StopSubServerResponse) GetStatus() *SubServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type SubscribeSubServerStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribeSubServerStatusRequest) Reset() {
+	*x = SubscribeSubServerStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_status_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeSubServerStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeSubServerStatusRequest) ProtoMessage() {}
+
+func (x *SubscribeSubServerStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_status_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeSubServerStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeSubServerStatusRequest) Descriptor() ([]byte, []int) {
+	return file_lit_status_proto_rawDescGZIP(), []int{5}
+}
+
+type SubServerStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the sub-server whose status changed.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The current status of the sub-server.
+	Status *SubServerStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Set to true if the event is part of the snapshot of all sub-servers that
+	// is sent when subscribing rather than the result of a state change.
+	Snapshot bool `protobuf:"varint,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *SubServerStatusEvent) Reset() {
+	*x = SubServerStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_lit_status_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubServerStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubServerStatusEvent) ProtoMessage() {}
+
+func (x *SubServerStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_lit_status_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubServerStatusEvent.ProtoReflect.Descriptor instead.
+func (*SubServerStatusEvent) Descriptor() ([]byte, []int) {
+	return file_lit_status_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SubServerStatusEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubServerStatusEvent) GetStatus() *SubServerStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SubServerStatusEvent) GetSnapshot() bool {
+	if x != nil {
+		return x.Snapshot
+	}
+	return false
+}
+
 type SubServerStatusResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -70,7 +432,7 @@ type SubServerStatusResp struct {
 func (x *SubServerStatusResp) Reset() {
 	*x = SubServerStatusResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_status_proto_msgTypes[1]
+		mi := &file_lit_status_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -83,7 +445,7 @@ func (x *SubServerStatusResp) String() string {
 func (*SubServerStatusResp) ProtoMessage() {}
 
 func (x *SubServerStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_status_proto_msgTypes[1]
+	mi := &file_lit_status_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -96,7 +458,7 @@ func (x *SubServerStatusResp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubServerStatusResp.ProtoReflect.Descriptor instead.
 func (*SubServerStatusResp) Descriptor() ([]byte, []int) {
-	return file_lit_status_proto_rawDescGZIP(), []int{1}
+	return file_lit_status_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubServerStatusResp) GetSubServers() map[string]*SubServerStatus {
@@ -123,12 +485,19 @@ type SubServerStatus struct {
 	// which is unique to the sub-server, and which is not the standard
 	// disabled, running or errored state.
 	CustomStatus string `protobuf:"bytes,4,opt,name=custom_status,json=customStatus,proto3" json:"custom_status,omitempty"`
+	// last_updated is the unix timestamp in seconds at which the status was last
+	// set or confirmed by a health check. If a health check is configured for
+	// the sub-server and the status hasn't been confirmed within the configured
+	// threshold, running is reported as false and custom_status as "unknown".
+	LastUpdated int64 `protobuf:"varint,5,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	// state is the lifecycle state of the sub-server.
+	State SubServerState `protobuf:"varint,6,opt,name=state,proto3,enum=litrpc.SubServerState" json:"state,omitempty"`
 }
 
 func (x *SubServerStatus) Reset() {
 	*x = SubServerStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_lit_status_proto_msgTypes[2]
+		mi := &file_lit_status_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -141,7 +510,7 @@ func (x *SubServerStatus) String() string {
 func (*SubServerStatus) ProtoMessage() {}
 
 func (x *SubServerStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_lit_status_proto_msgTypes[2]
+	mi := &file_lit_status_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -154,7 +523,7 @@ func (x *SubServerStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubServerStatus.ProtoReflect.Descriptor instead.
 func (*SubServerStatus) Descriptor() ([]byte, []int) {
-	return file_lit_status_proto_rawDescGZIP(), []int{2}
+	return file_lit_status_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SubServerStatus) GetDisabled() bool {
@@ -185,42 +554,114 @@ func (x *SubServerStatus) GetCustomStatus() string {
 	return ""
 }
 
+func (x *SubServerStatus) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *SubServerStatus) GetState() SubServerState {
+	if x != nil {
+		return x.State
+	}
+	return SubServerState_SUB_SERVER_STATE_STARTING
+}
+
 var File_lit_status_proto protoreflect.FileDescriptor
 
 var file_lit_status_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x6c, 0x69, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x06, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x75,
 	0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
-	0x22, 0xbb, 0x01, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x22, 0x2b, 0x0a, 0x15, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x49, 0x0a,
+	0x16, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x2a, 0x0a, 0x14, 0x53, 0x74, 0x6f, 0x70,
+	0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x15, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x75, 0x62, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
 	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x1a, 0x56, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x82,
-	0x01, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23,
-	0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x32, 0x54, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4a, 0x0a,
-	0x0f, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x6c,
-	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e,
-	0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2d,
-	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x21,
+	0x0a, 0x1f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x62, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x77, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x13, 0x53,
+	0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x4c, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x1a, 0x56, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75,
+	0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd3, 0x01, 0x0a, 0x0f, 0x53, 0x75, 0x62,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a,
+	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2a, 0xc6,
+	0x01, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x55, 0x42, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x1c, 0x0a, 0x18, 0x53, 0x55, 0x42, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x1c,
+	0x0a, 0x18, 0x53, 0x55, 0x42, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18,
+	0x53, 0x55, 0x42, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x53, 0x55,
+	0x42, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x44,
+	0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x55, 0x42,
+	0x5f, 0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x05, 0x32, 0xd8, 0x02, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x4a, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1b, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63,
+	0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x62, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x75, 0x62,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x75, 0x62,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x75, 0x62, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x75, 0x62, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f,
+	0x70, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61,
+	0x6c, 0x2f, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -235,23 +676,41 @@ func file_lit_status_proto_rawDescGZIP() []byte {
 	return file_lit_status_proto_rawDescData
 }
 
-var file_lit_status_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_lit_status_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_lit_status_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_lit_status_proto_goTypes = []any{
-	(*SubServerStatusReq)(nil),  // 0: litrpc.SubServerStatusReq
-	(*SubServerStatusResp)(nil), // 1: litrpc.SubServerStatusResp
-	(*SubServerStatus)(nil),     // 2: litrpc.SubServerStatus
-	nil,                         // 3: litrpc.SubServerStatusResp.SubServersEntry
+	(SubServerState)(0),                     // 0: litrpc.SubServerState
+	(*SubServerStatusReq)(nil),              // 1: litrpc.SubServerStatusReq
+	(*StartSubServerRequest)(nil),           // 2: litrpc.StartSubServerRequest
+	(*StartSubServerResponse)(nil),          // 3: litrpc.StartSubServerResponse
+	(*StopSubServerRequest)(nil),            // 4: litrpc.StopSubServerRequest
+	(*StopSubServerResponse)(nil),           // 5: litrpc.StopSubServerResponse
+	(*SubscribeSubServerStatusRequest)(nil), // 6: litrpc.SubscribeSubServerStatusRequest
+	(*SubServerStatusEvent)(nil),            // 7: litrpc.SubServerStatusEvent
+	(*SubServerStatusResp)(nil),             // 8: litrpc.SubServerStatusResp
+	(*SubServerStatus)(nil),                 // 9: litrpc.SubServerStatus
+	nil,                                     // 10: litrpc.SubServerStatusResp.SubServersEntry
 }
 var file_lit_status_proto_depIdxs = []int32{
-	3, // 0: litrpc.SubServerStatusResp.sub_servers:type_name -> litrpc.SubServerStatusResp.SubServersEntry
-	2, // 1: litrpc.SubServerStatusResp.SubServersEntry.value:type_name -> litrpc.SubServerStatus
-	0, // 2: litrpc.Status.SubServerStatus:input_type -> litrpc.SubServerStatusReq
-	1, // 3: litrpc.Status.SubServerStatus:output_type -> litrpc.SubServerStatusResp
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	9,  // 0: litrpc.StartSubServerResponse.status:type_name -> litrpc.SubServerStatus
+	9,  // 1: litrpc.StopSubServerResponse.status:type_name -> litrpc.SubServerStatus
+	9,  // 2: litrpc.SubServerStatusEvent.status:type_name -> litrpc.SubServerStatus
+	10, // 3: litrpc.SubServerStatusResp.sub_servers:type_name -> litrpc.SubServerStatusResp.SubServersEntry
+	0,  // 4: litrpc.SubServerStatus.state:type_name -> litrpc.SubServerState
+	9,  // 5: litrpc.SubServerStatusResp.SubServersEntry.value:type_name -> litrpc.SubServerStatus
+	1,  // 6: litrpc.Status.SubServerStatus:input_type -> litrpc.SubServerStatusReq
+	6,  // 7: litrpc.Status.SubscribeSubServerStatus:input_type -> litrpc.SubscribeSubServerStatusRequest
+	2,  // 8: litrpc.Status.StartSubServer:input_type -> litrpc.StartSubServerRequest
+	4,  // 9: litrpc.Status.StopSubServer:input_type -> litrpc.StopSubServerRequest
+	8,  // 10: litrpc.Status.SubServerStatus:output_type -> litrpc.SubServerStatusResp
+	7,  // 11: litrpc.Status.SubscribeSubServerStatus:output_type -> litrpc.SubServerStatusEvent
+	3,  // 12: litrpc.Status.StartSubServer:output_type -> litrpc.StartSubServerResponse
+	5,  // 13: litrpc.Status.StopSubServer:output_type -> litrpc.StopSubServerResponse
+	10, // [10:14] is the sub-list for method output_type
+	6,  // [6:10] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_lit_status_proto_init() }
@@ -273,7 +732,7 @@ func file_lit_status_proto_init() {
 			}
 		}
 		file_lit_status_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*SubServerStatusResp); i {
+			switch v := v.(*StartSubServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -285,6 +744,78 @@ func file_lit_status_proto_init() {
 			}
 		}
 		file_lit_status_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*StartSubServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_status_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*StopSubServerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_status_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*StopSubServerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_status_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*SubscribeSubServerStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_status_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*SubServerStatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_status_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*SubServerStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_lit_status_proto_msgTypes[8].Exporter = func(v any, i int) any {
 			switch v := v.(*SubServerStatus); i {
 			case 0:
 				return &v.state
@@ -302,13 +833,14 @@ func file_lit_status_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_lit_status_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_lit_status_proto_goTypes,
 		DependencyIndexes: file_lit_status_proto_depIdxs,
+		EnumInfos:         file_lit_status_proto_enumTypes,
 		MessageInfos:      file_lit_status_proto_msgTypes,
 	}.Build()
 	File_lit_status_proto = out.File
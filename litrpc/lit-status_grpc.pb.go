@@ -19,6 +19,24 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type StatusClient interface {
 	SubServerStatus(ctx context.Context, in *SubServerStatusReq, opts ...grpc.CallOption) (*SubServerStatusResp, error)
+	// litcli: `status --watch`
+	// SubscribeSubServerStatus streams an event whenever the state of a
+	// sub-server changes, for example when it starts running or fails with an
+	// error. The current status of all sub-servers is sent first, so a client
+	// that subscribes late or reconnects always starts with a full snapshot.
+	// A client that falls behind receives the latest status of each sub-server
+	// that changed in the meantime instead of every single transition.
+	SubscribeSubServerStatus(ctx context.Context, in *SubscribeSubServerStatusRequest, opts ...grpc.CallOption) (Status_SubscribeSubServerStatusClient, error)
+	// litcli: `status start`
+	// StartSubServer starts an integrated sub-server (loop, pool, faraday or
+	// taproot-assets) that was stopped with StopSubServer or failed with an
+	// error, without restarting LiT.
+	StartSubServer(ctx context.Context, in *StartSubServerRequest, opts ...grpc.CallOption) (*StartSubServerResponse, error)
+	// litcli: `status stop`
+	// StopSubServer gracefully stops an integrated sub-server while LiT keeps
+	// running. New calls to the sub-server are rejected right away and calls
+	// that are still in progress are aborted with an UNAVAILABLE error.
+	StopSubServer(ctx context.Context, in *StopSubServerRequest, opts ...grpc.CallOption) (*StopSubServerResponse, error)
 }
 
 type statusClient struct {
@@ -38,11 +56,79 @@ func (c *statusClient) SubServerStatus(ctx context.Context, in *SubServerStatusR
 	return out, nil
 }
 
+func (c *statusClient) SubscribeSubServerStatus(ctx context.Context, in *SubscribeSubServerStatusRequest, opts ...grpc.CallOption) (Status_SubscribeSubServerStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Status_ServiceDesc.Streams[0], "/litrpc.Status/SubscribeSubServerStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &statusSubscribeSubServerStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Status_SubscribeSubServerStatusClient interface {
+	Recv() (*SubServerStatusEvent, error)
+	grpc.ClientStream
+}
+
+type statusSubscribeSubServerStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *statusSubscribeSubServerStatusClient) Recv() (*SubServerStatusEvent, error) {
+	m := new(SubServerStatusEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *statusClient) StartSubServer(ctx context.Context, in *StartSubServerRequest, opts ...grpc.CallOption) (*StartSubServerResponse, error) {
+	out := new(StartSubServerResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Status/StartSubServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statusClient) StopSubServer(ctx context.Context, in *StopSubServerRequest, opts ...grpc.CallOption) (*StopSubServerResponse, error) {
+	out := new(StopSubServerResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Status/StopSubServer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatusServer is the server API for Status service.
 // All implementations must embed UnimplementedStatusServer
 // for forward compatibility
 type StatusServer interface {
 	SubServerStatus(context.Context, *SubServerStatusReq) (*SubServerStatusResp, error)
+	// litcli: `status --watch`
+	// SubscribeSubServerStatus streams an event whenever the state of a
+	// sub-server changes, for example when it starts running or fails with an
+	// error. The current status of all sub-servers is sent first, so a client
+	// that subscribes late or reconnects always starts with a full snapshot.
+	// A client that falls behind receives the latest status of each sub-server
+	// that changed in the meantime instead of every single transition.
+	SubscribeSubServerStatus(*SubscribeSubServerStatusRequest, Status_SubscribeSubServerStatusServer) error
+	// litcli: `status start`
+	// StartSubServer starts an integrated sub-server (loop, pool, faraday or
+	// taproot-assets) that was stopped with StopSubServer or failed with an
+	// error, without restarting LiT.
+	StartSubServer(context.Context, *StartSubServerRequest) (*StartSubServerResponse, error)
+	// litcli: `status stop`
+	// StopSubServer gracefully stops an integrated sub-server while LiT keeps
+	// running. New calls to the sub-server are rejected right away and calls
+	// that are still in progress are aborted with an UNAVAILABLE error.
+	StopSubServer(context.Context, *StopSubServerRequest) (*StopSubServerResponse, error)
 	mustEmbedUnimplementedStatusServer()
 }
 
@@ -53,6 +139,15 @@ type UnimplementedStatusServer struct {
 func (UnimplementedStatusServer) SubServerStatus(context.Context, *SubServerStatusReq) (*SubServerStatusResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubServerStatus not implemented")
 }
+func (UnimplementedStatusServer) SubscribeSubServerStatus(*SubscribeSubServerStatusRequest, Status_SubscribeSubServerStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeSubServerStatus not implemented")
+}
+func (UnimplementedStatusServer) StartSubServer(context.Context, *StartSubServerRequest) (*StartSubServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartSubServer not implemented")
+}
+func (UnimplementedStatusServer) StopSubServer(context.Context, *StopSubServerRequest) (*StopSubServerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopSubServer not implemented")
+}
 func (UnimplementedStatusServer) mustEmbedUnimplementedStatusServer() {}
 
 // UnsafeStatusServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +179,63 @@ func _Status_SubServerStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Status_SubscribeSubServerStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeSubServerStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StatusServer).SubscribeSubServerStatus(m, &statusSubscribeSubServerStatusServer{stream})
+}
+
+type Status_SubscribeSubServerStatusServer interface {
+	Send(*SubServerStatusEvent) error
+	grpc.ServerStream
+}
+
+type statusSubscribeSubServerStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *statusSubscribeSubServerStatusServer) Send(m *SubServerStatusEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Status_StartSubServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSubServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServer).StartSubServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Status/StartSubServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServer).StartSubServer(ctx, req.(*StartSubServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Status_StopSubServer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopSubServerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatusServer).StopSubServer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Status/StopSubServer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatusServer).StopSubServer(ctx, req.(*StopSubServerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Status_ServiceDesc is the grpc.ServiceDesc for Status service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,7 +247,21 @@ var Status_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SubServerStatus",
 			Handler:    _Status_SubServerStatus_Handler,
 		},
+		{
+			MethodName: "StartSubServer",
+			Handler:    _Status_StartSubServer_Handler,
+		},
+		{
+			MethodName: "StopSubServer",
+			Handler:    _Status_StopSubServer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeSubServerStatus",
+			Handler:       _Status_SubscribeSubServerStatus_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lit-status.proto",
 }
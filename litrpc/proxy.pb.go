@@ -20,6 +20,1003 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type EventCategory int32
+
+const (
+	// The creation, balance changes and removal of accounts.
+	EventCategory_EVENT_CATEGORY_ACCOUNT EventCategory = 0
+	// The creation, revocation and expiry of sessions.
+	EventCategory_EVENT_CATEGORY_SESSION EventCategory = 1
+	// The state changes of sub-servers.
+	EventCategory_EVENT_CATEGORY_SUB_SERVER EventCategory = 2
+	// The requests that were denied by the firewall.
+	EventCategory_EVENT_CATEGORY_FIREWALL EventCategory = 3
+)
+
+// Enum value maps for EventCategory.
+var (
+	EventCategory_name = map[int32]string{
+		0: "EVENT_CATEGORY_ACCOUNT",
+		1: "EVENT_CATEGORY_SESSION",
+		2: "EVENT_CATEGORY_SUB_SERVER",
+		3: "EVENT_CATEGORY_FIREWALL",
+	}
+	EventCategory_value = map[string]int32{
+		"EVENT_CATEGORY_ACCOUNT":    0,
+		"EVENT_CATEGORY_SESSION":    1,
+		"EVENT_CATEGORY_SUB_SERVER": 2,
+		"EVENT_CATEGORY_FIREWALL":   3,
+	}
+)
+
+func (x EventCategory) Enum() *EventCategory {
+	p := new(EventCategory)
+	*p = x
+	return p
+}
+
+func (x EventCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_proxy_proto_enumTypes[0].Descriptor()
+}
+
+func (EventCategory) Type() protoreflect.EnumType {
+	return &file_proxy_proto_enumTypes[0]
+}
+
+func (x EventCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventCategory.Descriptor instead.
+func (EventCategory) EnumDescriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{0}
+}
+
+type AccountChangeType int32
+
+const (
+	// The account was created.
+	AccountChangeType_ACCOUNT_CHANGE_CREATED AccountChangeType = 0
+	// The balance of the account increased.
+	AccountChangeType_ACCOUNT_CHANGE_CREDITED AccountChangeType = 1
+	// The balance of the account decreased.
+	AccountChangeType_ACCOUNT_CHANGE_DEBITED AccountChangeType = 2
+	// The settings of the account changed, but not its balance.
+	AccountChangeType_ACCOUNT_CHANGE_UPDATED AccountChangeType = 3
+	// The account was removed.
+	AccountChangeType_ACCOUNT_CHANGE_REMOVED AccountChangeType = 4
+	// The account reached the expiry warning lead time before its expiration
+	// date.
+	AccountChangeType_ACCOUNT_CHANGE_EXPIRING_SOON AccountChangeType = 5
+)
+
+// Enum value maps for AccountChangeType.
+var (
+	AccountChangeType_name = map[int32]string{
+		0: "ACCOUNT_CHANGE_CREATED",
+		1: "ACCOUNT_CHANGE_CREDITED",
+		2: "ACCOUNT_CHANGE_DEBITED",
+		3: "ACCOUNT_CHANGE_UPDATED",
+		4: "ACCOUNT_CHANGE_REMOVED",
+		5: "ACCOUNT_CHANGE_EXPIRING_SOON",
+	}
+	AccountChangeType_value = map[string]int32{
+		"ACCOUNT_CHANGE_CREATED":       0,
+		"ACCOUNT_CHANGE_CREDITED":      1,
+		"ACCOUNT_CHANGE_DEBITED":       2,
+		"ACCOUNT_CHANGE_UPDATED":       3,
+		"ACCOUNT_CHANGE_REMOVED":       4,
+		"ACCOUNT_CHANGE_EXPIRING_SOON": 5,
+	}
+)
+
+func (x AccountChangeType) Enum() *AccountChangeType {
+	p := new(AccountChangeType)
+	*p = x
+	return p
+}
+
+func (x AccountChangeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AccountChangeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proxy_proto_enumTypes[1].Descriptor()
+}
+
+func (AccountChangeType) Type() protoreflect.EnumType {
+	return &file_proxy_proto_enumTypes[1]
+}
+
+func (x AccountChangeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AccountChangeType.Descriptor instead.
+func (AccountChangeType) EnumDescriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{1}
+}
+
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The categories of the events to stream. If empty, the events of all
+	// categories are streamed.
+	Categories []EventCategory `protobuf:"varint,1,rep,packed,name=categories,proto3,enum=litrpc.EventCategory" json:"categories,omitempty"`
+	// The stream ID of the last event that was received. If set, the events of
+	// the requested categories that were published after the event with the
+	// after_sequence number are sent first. Only a limited number of recent
+	// events is kept and the events don't survive a restart of litd, so
+	// resuming fails if any of the events that were published since are no
+	// longer known.
+	StreamId string `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// The sequence number of the last event that was received. Only used if the
+	// stream_id is set.
+	AfterSequence uint64 `protobuf:"varint,3,opt,name=after_sequence,json=afterSequence,proto3" json:"after_sequence,omitempty"`
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubscribeEventsRequest) GetCategories() []EventCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SubscribeEventsRequest) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+func (x *SubscribeEventsRequest) GetAfterSequence() uint64 {
+	if x != nil {
+		return x.AfterSequence
+	}
+	return 0
+}
+
+type LitEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of the event. It increases by one for every event that litd
+	// publishes, across all categories. A stream never skips any event of the
+	// requested categories. If a subscriber doesn't keep up, its stream is ended
+	// with an error instead, after which it can resume the stream with the last
+	// sequence number that it received.
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// The random ID of the stream of events, which is chosen when litd starts.
+	// The sequence numbers start over with a new ID.
+	StreamId string `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// The unix timestamp in seconds at which the event occurred.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// The category of the event.
+	Category EventCategory `protobuf:"varint,4,opt,name=category,proto3,enum=litrpc.EventCategory" json:"category,omitempty"`
+	// Types that are assignable to Event:
+	//
+	//	*LitEvent_Account
+	//	*LitEvent_Session
+	//	*LitEvent_SubServer
+	//	*LitEvent_FirewallDenial
+	Event isLitEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *LitEvent) Reset() {
+	*x = LitEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LitEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LitEvent) ProtoMessage() {}
+
+func (x *LitEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LitEvent.ProtoReflect.Descriptor instead.
+func (*LitEvent) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LitEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LitEvent) GetStreamId() string {
+	if x != nil {
+		return x.StreamId
+	}
+	return ""
+}
+
+func (x *LitEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LitEvent) GetCategory() EventCategory {
+	if x != nil {
+		return x.Category
+	}
+	return EventCategory_EVENT_CATEGORY_ACCOUNT
+}
+
+func (m *LitEvent) GetEvent() isLitEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *LitEvent) GetAccount() *AccountChangeEvent {
+	if x, ok := x.GetEvent().(*LitEvent_Account); ok {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *LitEvent) GetSession() *SessionChangeEvent {
+	if x, ok := x.GetEvent().(*LitEvent_Session); ok {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *LitEvent) GetSubServer() *SubServerStatusEvent {
+	if x, ok := x.GetEvent().(*LitEvent_SubServer); ok {
+		return x.SubServer
+	}
+	return nil
+}
+
+func (x *LitEvent) GetFirewallDenial() *AuditLogEntry {
+	if x, ok := x.GetEvent().(*LitEvent_FirewallDenial); ok {
+		return x.FirewallDenial
+	}
+	return nil
+}
+
+type isLitEvent_Event interface {
+	isLitEvent_Event()
+}
+
+type LitEvent_Account struct {
+	// The change to an account, for EVENT_CATEGORY_ACCOUNT.
+	Account *AccountChangeEvent `protobuf:"bytes,5,opt,name=account,proto3,oneof"`
+}
+
+type LitEvent_Session struct {
+	// The change to a session, for EVENT_CATEGORY_SESSION.
+	Session *SessionChangeEvent `protobuf:"bytes,6,opt,name=session,proto3,oneof"`
+}
+
+type LitEvent_SubServer struct {
+	// The new status of a sub-server, for EVENT_CATEGORY_SUB_SERVER.
+	SubServer *SubServerStatusEvent `protobuf:"bytes,7,opt,name=sub_server,json=subServer,proto3,oneof"`
+}
+
+type LitEvent_FirewallDenial struct {
+	// The request that was denied, for EVENT_CATEGORY_FIREWALL.
+	FirewallDenial *AuditLogEntry `protobuf:"bytes,8,opt,name=firewall_denial,json=firewallDenial,proto3,oneof"`
+}
+
+func (*LitEvent_Account) isLitEvent_Event() {}
+
+func (*LitEvent_Session) isLitEvent_Event() {}
+
+func (*LitEvent_SubServer) isLitEvent_Event() {}
+
+func (*LitEvent_FirewallDenial) isLitEvent_Event() {}
+
+type AccountChangeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The kind of change.
+	Type AccountChangeType `protobuf:"varint,1,opt,name=type,proto3,enum=litrpc.AccountChangeType" json:"type,omitempty"`
+	// The hexadecimal ID of the account that changed.
+	AccountId string `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The amount in millisatoshis by which the balance of the account changed.
+	// It is positive if the account was credited and negative if it was debited.
+	BalanceChangeMsat int64 `protobuf:"varint,3,opt,name=balance_change_msat,json=balanceChangeMsat,proto3" json:"balance_change_msat,omitempty"`
+	// The full account after the change. Not set for removed accounts.
+	Account *Account `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *AccountChangeEvent) Reset() {
+	*x = AccountChangeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountChangeEvent) ProtoMessage() {}
+
+func (x *AccountChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountChangeEvent.ProtoReflect.Descriptor instead.
+func (*AccountChangeEvent) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountChangeEvent) GetType() AccountChangeType {
+	if x != nil {
+		return x.Type
+	}
+	return AccountChangeType_ACCOUNT_CHANGE_CREATED
+}
+
+func (x *AccountChangeEvent) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AccountChangeEvent) GetBalanceChangeMsat() int64 {
+	if x != nil {
+		return x.BalanceChangeMsat
+	}
+	return 0
+}
+
+func (x *AccountChangeEvent) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type SessionChangeEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the session.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// The local public key of the session.
+	LocalPublicKey []byte `protobuf:"bytes,2,opt,name=local_public_key,json=localPublicKey,proto3" json:"local_public_key,omitempty"`
+	// The label of the session.
+	Label string `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	// The type of the session.
+	SessionType SessionType `protobuf:"varint,4,opt,name=session_type,json=sessionType,proto3,enum=litrpc.SessionType" json:"session_type,omitempty"`
+	// The new state of the session. Sessions are reported once they are created
+	// and whenever they are revoked or expire.
+	State SessionState `protobuf:"varint,5,opt,name=state,proto3,enum=litrpc.SessionState" json:"state,omitempty"`
+}
+
+func (x *SessionChangeEvent) Reset() {
+	*x = SessionChangeEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionChangeEvent) ProtoMessage() {}
+
+func (x *SessionChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionChangeEvent.ProtoReflect.Descriptor instead.
+func (*SessionChangeEvent) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SessionChangeEvent) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (x *SessionChangeEvent) GetLocalPublicKey() []byte {
+	if x != nil {
+		return x.LocalPublicKey
+	}
+	return nil
+}
+
+func (x *SessionChangeEvent) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SessionChangeEvent) GetSessionType() SessionType {
+	if x != nil {
+		return x.SessionType
+	}
+	return SessionType_TYPE_MACAROON_READONLY
+}
+
+func (x *SessionChangeEvent) GetState() SessionState {
+	if x != nil {
+		return x.State
+	}
+	return SessionState_STATE_CREATED
+}
+
+type WhoAmIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WhoAmIRequest) Reset() {
+	*x = WhoAmIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhoAmIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoAmIRequest) ProtoMessage() {}
+
+func (x *WhoAmIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoAmIRequest.ProtoReflect.Descriptor instead.
+func (*WhoAmIRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{4}
+}
+
+type WhoAmIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The URIs of all calls that the macaroon is allowed to make.
+	AllowedUris []string `protobuf:"bytes,1,rep,name=allowed_uris,json=allowedUris,proto3" json:"allowed_uris,omitempty"`
+	// The ID of the root key that the macaroon was baked under.
+	MacaroonRootKeyId uint64 `protobuf:"varint,2,opt,name=macaroon_root_key_id,json=macaroonRootKeyId,proto3" json:"macaroon_root_key_id,omitempty"`
+	// Whether the macaroon is a super macaroon baked by LiT.
+	SuperMacaroon bool `protobuf:"varint,3,opt,name=super_macaroon,json=superMacaroon,proto3" json:"super_macaroon,omitempty"`
+	// The unix timestamp in seconds after which the macaroon expires. This is 0
+	// if the macaroon doesn't expire.
+	MacaroonExpiry int64 `protobuf:"varint,4,opt,name=macaroon_expiry,json=macaroonExpiry,proto3" json:"macaroon_expiry,omitempty"`
+	// The session that the macaroon was baked for. This is only set if the
+	// macaroon belongs to a session.
+	Session *CallerSession `protobuf:"bytes,5,opt,name=session,proto3" json:"session,omitempty"`
+	// The account that the macaroon is linked to, including its balance. This is
+	// only set if the macaroon or its session is linked to an account.
+	Account *Account `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
+	// The session wide rules that the firewall enforces for the macaroon.
+	SessionRules *RulesMap `protobuf:"bytes,7,opt,name=session_rules,json=sessionRules,proto3" json:"session_rules,omitempty"`
+	// The rules that the firewall enforces for the macaroon, keyed by the name of
+	// the autopilot feature that they apply to.
+	FeatureRules map[string]*RulesMap `protobuf:"bytes,8,rep,name=feature_rules,json=featureRules,proto3" json:"feature_rules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *WhoAmIResponse) Reset() {
+	*x = WhoAmIResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhoAmIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoAmIResponse) ProtoMessage() {}
+
+func (x *WhoAmIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoAmIResponse.ProtoReflect.Descriptor instead.
+func (*WhoAmIResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WhoAmIResponse) GetAllowedUris() []string {
+	if x != nil {
+		return x.AllowedUris
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetMacaroonRootKeyId() uint64 {
+	if x != nil {
+		return x.MacaroonRootKeyId
+	}
+	return 0
+}
+
+func (x *WhoAmIResponse) GetSuperMacaroon() bool {
+	if x != nil {
+		return x.SuperMacaroon
+	}
+	return false
+}
+
+func (x *WhoAmIResponse) GetMacaroonExpiry() int64 {
+	if x != nil {
+		return x.MacaroonExpiry
+	}
+	return 0
+}
+
+func (x *WhoAmIResponse) GetSession() *CallerSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetSessionRules() *RulesMap {
+	if x != nil {
+		return x.SessionRules
+	}
+	return nil
+}
+
+func (x *WhoAmIResponse) GetFeatureRules() map[string]*RulesMap {
+	if x != nil {
+		return x.FeatureRules
+	}
+	return nil
+}
+
+type CheckPermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The raw macaroon to check. If it isn't set, the macaroon that the call is
+	// made with is checked.
+	Macaroon []byte `protobuf:"bytes,1,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+	// The full URI of the RPC to check, for example /lnrpc.Lightning/GetInfo.
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckPermissionRequest) GetMacaroon() []byte {
+	if x != nil {
+		return x.Macaroon
+	}
+	return nil
+}
+
+func (x *CheckPermissionRequest) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type CheckPermissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Whether the macaroon would be authorized to call the URI.
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// The reason why the call would be denied. This is empty if it is allowed.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The first-party caveat of the macaroon that would deny the call, for example
+	// an expired "time-before" caveat. This is only set if the call would be
+	// denied because of a caveat.
+	BlockingCaveat string `protobuf:"bytes,3,opt,name=blocking_caveat,json=blockingCaveat,proto3" json:"blocking_caveat,omitempty"`
+	// The permissions required to call the URI that the macaroon doesn't have.
+	// This is only set if the call would be denied because of missing
+	// permissions.
+	MissingPermissions []*MacaroonPermission `protobuf:"bytes,4,rep,name=missing_permissions,json=missingPermissions,proto3" json:"missing_permissions,omitempty"`
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CheckPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckPermissionResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CheckPermissionResponse) GetBlockingCaveat() string {
+	if x != nil {
+		return x.BlockingCaveat
+	}
+	return ""
+}
+
+func (x *CheckPermissionResponse) GetMissingPermissions() []*MacaroonPermission {
+	if x != nil {
+		return x.MissingPermissions
+	}
+	return nil
+}
+
+type CallerSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the session.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The label of the session.
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// The type of the session.
+	SessionType SessionType `protobuf:"varint,3,opt,name=session_type,json=sessionType,proto3,enum=litrpc.SessionType" json:"session_type,omitempty"`
+	// The state of the session.
+	SessionState SessionState `protobuf:"varint,4,opt,name=session_state,json=sessionState,proto3,enum=litrpc.SessionState" json:"session_state,omitempty"`
+	// The unix timestamp in seconds after which the session expires.
+	ExpiryTimestampSeconds uint64 `protobuf:"varint,5,opt,name=expiry_timestamp_seconds,json=expiryTimestampSeconds,proto3" json:"expiry_timestamp_seconds,omitempty"`
+}
+
+func (x *CallerSession) Reset() {
+	*x = CallerSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CallerSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CallerSession) ProtoMessage() {}
+
+func (x *CallerSession) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CallerSession.ProtoReflect.Descriptor instead.
+func (*CallerSession) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CallerSession) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *CallerSession) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CallerSession) GetSessionType() SessionType {
+	if x != nil {
+		return x.SessionType
+	}
+	return SessionType_TYPE_MACAROON_READONLY
+}
+
+func (x *CallerSession) GetSessionState() SessionState {
+	if x != nil {
+		return x.SessionState
+	}
+	return SessionState_STATE_CREATED
+}
+
+func (x *CallerSession) GetExpiryTimestampSeconds() uint64 {
+	if x != nil {
+		return x.ExpiryTimestampSeconds
+	}
+	return 0
+}
+
+type RotateRootKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RotateRootKeyRequest) Reset() {
+	*x = RotateRootKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateRootKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateRootKeyRequest) ProtoMessage() {}
+
+func (x *RotateRootKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateRootKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateRootKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{9}
+}
+
+type RotateRootKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of the new root key.
+	RootKeyId uint64 `protobuf:"varint,1,opt,name=root_key_id,json=rootKeyId,proto3" json:"root_key_id,omitempty"`
+	// The ID of the root key that was replaced.
+	PreviousRootKeyId uint64 `protobuf:"varint,2,opt,name=previous_root_key_id,json=previousRootKeyId,proto3" json:"previous_root_key_id,omitempty"`
+	// The unix timestamp in seconds after which macaroons that were baked under
+	// the previous root key are no longer valid.
+	PreviousExpiry int64 `protobuf:"varint,3,opt,name=previous_expiry,json=previousExpiry,proto3" json:"previous_expiry,omitempty"`
+	// The hex encoded LiT macaroon that was baked under the new root key.
+	Macaroon string `protobuf:"bytes,4,opt,name=macaroon,proto3" json:"macaroon,omitempty"`
+}
+
+func (x *RotateRootKeyResponse) Reset() {
+	*x = RotateRootKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proxy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateRootKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateRootKeyResponse) ProtoMessage() {}
+
+func (x *RotateRootKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateRootKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateRootKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RotateRootKeyResponse) GetRootKeyId() uint64 {
+	if x != nil {
+		return x.RootKeyId
+	}
+	return 0
+}
+
+func (x *RotateRootKeyResponse) GetPreviousRootKeyId() uint64 {
+	if x != nil {
+		return x.PreviousRootKeyId
+	}
+	return 0
+}
+
+func (x *RotateRootKeyResponse) GetPreviousExpiry() int64 {
+	if x != nil {
+		return x.PreviousExpiry
+	}
+	return 0
+}
+
+func (x *RotateRootKeyResponse) GetMacaroon() string {
+	if x != nil {
+		return x.Macaroon
+	}
+	return ""
+}
+
 type BakeSuperMacaroonRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -35,7 +1032,7 @@ type BakeSuperMacaroonRequest struct {
 func (x *BakeSuperMacaroonRequest) Reset() {
 	*x = BakeSuperMacaroonRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[0]
+		mi := &file_proxy_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -48,7 +1045,7 @@ func (x *BakeSuperMacaroonRequest) String() string {
 func (*BakeSuperMacaroonRequest) ProtoMessage() {}
 
 func (x *BakeSuperMacaroonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[0]
+	mi := &file_proxy_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -61,7 +1058,7 @@ func (x *BakeSuperMacaroonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BakeSuperMacaroonRequest.ProtoReflect.Descriptor instead.
 func (*BakeSuperMacaroonRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{0}
+	return file_proxy_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BakeSuperMacaroonRequest) GetRootKeyIdSuffix() uint32 {
@@ -90,7 +1087,7 @@ type BakeSuperMacaroonResponse struct {
 func (x *BakeSuperMacaroonResponse) Reset() {
 	*x = BakeSuperMacaroonResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[1]
+		mi := &file_proxy_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -103,7 +1100,7 @@ func (x *BakeSuperMacaroonResponse) String() string {
 func (*BakeSuperMacaroonResponse) ProtoMessage() {}
 
 func (x *BakeSuperMacaroonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[1]
+	mi := &file_proxy_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,7 +1113,7 @@ func (x *BakeSuperMacaroonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BakeSuperMacaroonResponse.ProtoReflect.Descriptor instead.
 func (*BakeSuperMacaroonResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{1}
+	return file_proxy_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BakeSuperMacaroonResponse) GetMacaroon() string {
@@ -135,7 +1132,7 @@ type StopDaemonRequest struct {
 func (x *StopDaemonRequest) Reset() {
 	*x = StopDaemonRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[2]
+		mi := &file_proxy_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -148,7 +1145,7 @@ func (x *StopDaemonRequest) String() string {
 func (*StopDaemonRequest) ProtoMessage() {}
 
 func (x *StopDaemonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[2]
+	mi := &file_proxy_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +1158,7 @@ func (x *StopDaemonRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopDaemonRequest.ProtoReflect.Descriptor instead.
 func (*StopDaemonRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{2}
+	return file_proxy_proto_rawDescGZIP(), []int{13}
 }
 
 type StopDaemonResponse struct {
@@ -173,7 +1170,7 @@ type StopDaemonResponse struct {
 func (x *StopDaemonResponse) Reset() {
 	*x = StopDaemonResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[3]
+		mi := &file_proxy_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -186,7 +1183,7 @@ func (x *StopDaemonResponse) String() string {
 func (*StopDaemonResponse) ProtoMessage() {}
 
 func (x *StopDaemonResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[3]
+	mi := &file_proxy_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,7 +1196,7 @@ func (x *StopDaemonResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopDaemonResponse.ProtoReflect.Descriptor instead.
 func (*StopDaemonResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{3}
+	return file_proxy_proto_rawDescGZIP(), []int{14}
 }
 
 type GetInfoRequest struct {
@@ -211,7 +1208,7 @@ type GetInfoRequest struct {
 func (x *GetInfoRequest) Reset() {
 	*x = GetInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[4]
+		mi := &file_proxy_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -224,7 +1221,7 @@ func (x *GetInfoRequest) String() string {
 func (*GetInfoRequest) ProtoMessage() {}
 
 func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[4]
+	mi := &file_proxy_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -237,7 +1234,7 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{4}
+	return file_proxy_proto_rawDescGZIP(), []int{15}
 }
 
 type GetInfoResponse struct {
@@ -247,12 +1244,22 @@ type GetInfoResponse struct {
 
 	// The version of the LiTd software that the node is running.
 	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The ID of the root key that LiT's own macaroons are currently baked under.
+	// This is only set once LiT's macaroon service has started.
+	MacaroonRootKeyId uint64 `protobuf:"varint,2,opt,name=macaroon_root_key_id,json=macaroonRootKeyId,proto3" json:"macaroon_root_key_id,omitempty"`
+	// The URL of the price source that clients can use to show the fiat
+	// equivalent of balances, as configured with fiat.pricesource. The
+	// placeholder {currency} in the URL is to be replaced with the currency code.
+	FiatPriceSource string `protobuf:"bytes,3,opt,name=fiat_price_source,json=fiatPriceSource,proto3" json:"fiat_price_source,omitempty"`
+	// The default currency code to show fiat amounts in, as configured with
+	// fiat.currency.
+	FiatCurrency string `protobuf:"bytes,4,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
 }
 
 func (x *GetInfoResponse) Reset() {
 	*x = GetInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proxy_proto_msgTypes[5]
+		mi := &file_proxy_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -265,7 +1272,7 @@ func (x *GetInfoResponse) String() string {
 func (*GetInfoResponse) ProtoMessage() {}
 
 func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proto_msgTypes[5]
+	mi := &file_proxy_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +1285,7 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proto_rawDescGZIP(), []int{5}
+	return file_proxy_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetInfoResponse) GetVersion() string {
@@ -288,46 +1295,254 @@ func (x *GetInfoResponse) GetVersion() string {
 	return ""
 }
 
+func (x *GetInfoResponse) GetMacaroonRootKeyId() uint64 {
+	if x != nil {
+		return x.MacaroonRootKeyId
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetFiatPriceSource() string {
+	if x != nil {
+		return x.FiatPriceSource
+	}
+	return ""
+}
+
+func (x *GetInfoResponse) GetFiatCurrency() string {
+	if x != nil {
+		return x.FiatCurrency
+	}
+	return ""
+}
+
 var File_proxy_proto protoreflect.FileDescriptor
 
 var file_proxy_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6c,
-	0x69, 0x74, 0x72, 0x70, 0x63, 0x22, 0x64, 0x0a, 0x18, 0x42, 0x61, 0x6b, 0x65, 0x53, 0x75, 0x70,
-	0x65, 0x72, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
-	0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72,
-	0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x37, 0x0a, 0x19, 0x42,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x1a, 0x0e, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6c, 0x69, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x12, 0x6c, 0x69, 0x74, 0x2d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x10, 0x6c,
+	0x69, 0x74, 0x2d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x97, 0x01, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x29,
+	0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x0d, 0x61, 0x66, 0x74, 0x65,
+	0x72, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x92, 0x03, 0x0a, 0x08, 0x4c, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x02, 0x30, 0x01, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x31, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x48, 0x00, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x07,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x07, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x75, 0x62, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x09, 0x73, 0x75, 0x62, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0f, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x5f,
+	0x64, 0x65, 0x6e, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6c,
+	0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0e, 0x66, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x44,
+	0x65, 0x6e, 0x69, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xbd,
+	0x01, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x11, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xd7,
+	0x01, 0x0a, 0x12, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x57, 0x68, 0x6f, 0x41,
+	0x6d, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xe9, 0x03, 0x0a, 0x0e, 0x57, 0x68,
+	0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x75, 0x72, 0x69, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x72, 0x69, 0x73, 0x12,
+	0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x75, 0x70, 0x65, 0x72, 0x4d,
+	0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x12, 0x2f, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x29, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x0d,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x1a, 0x51, 0x0a, 0x11, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x46, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0xc1, 0x01,
+	0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x76, 0x65, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x61,
+	0x76, 0x65, 0x61, 0x74, 0x12, 0x4b, 0x0a, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x12, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0xe2, 0x01, 0x0a, 0x0d, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x36, 0x0a, 0x0c, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x39, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x18,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x16,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xad,
+	0x01, 0x0a, 0x15, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x72, 0x6f, 0x6f, 0x74,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72,
+	0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x14, 0x70, 0x72, 0x65, 0x76,
+	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x52, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65,
+	0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x22, 0x64,
+	0x0a, 0x18, 0x42, 0x61, 0x6b, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x4d, 0x61, 0x63, 0x61, 0x72,
+	0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x12, 0x72, 0x6f,
+	0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x72, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x49,
+	0x64, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64,
+	0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x37, 0x0a, 0x19, 0x42, 0x61, 0x6b, 0x65, 0x53, 0x75, 0x70, 0x65,
+	0x72, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x22, 0x13, 0x0a,
+	0x11, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xad, 0x01, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x61, 0x63, 0x61,
+	0x72, 0x6f, 0x6f, 0x6e, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x6d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e,
+	0x52, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x66, 0x69, 0x61,
+	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x66, 0x69, 0x61, 0x74, 0x50, 0x72, 0x69, 0x63, 0x65, 0x53,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x61, 0x74, 0x5f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69,
+	0x61, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x2a, 0x83, 0x01, 0x0a, 0x0d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1a, 0x0a, 0x16,
+	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x43, 0x41, 0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49,
+	0x4f, 0x4e, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41,
+	0x54, 0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x53, 0x55, 0x42, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x41, 0x54,
+	0x45, 0x47, 0x4f, 0x52, 0x59, 0x5f, 0x46, 0x49, 0x52, 0x45, 0x57, 0x41, 0x4c, 0x4c, 0x10, 0x03,
+	0x2a, 0xc2, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x48,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x44, 0x49, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x5f, 0x44, 0x45, 0x42, 0x49, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x41,
+	0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x41, 0x43, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x41, 0x43, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x53,
+	0x4f, 0x4f, 0x4e, 0x10, 0x05, 0x32, 0x84, 0x04, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12,
+	0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x74,
+	0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x53,
+	0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x58, 0x0a, 0x11, 0x42, 0x61, 0x6b, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x4d, 0x61, 0x63,
+	0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42,
 	0x61, 0x6b, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x63, 0x61,
-	0x72, 0x6f, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x63, 0x61,
-	0x72, 0x6f, 0x6f, 0x6e, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x74, 0x6f,
-	0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x10, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x22, 0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0xe2,
-	0x01, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x3a, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x6c, 0x69,
-	0x74, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d,
-	0x6f, 0x6e, 0x12, 0x19, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70,
-	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x44, 0x61, 0x65, 0x6d, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x11, 0x42, 0x61, 0x6b,
-	0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x12, 0x20,
-	0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x53, 0x75, 0x70, 0x65,
-	0x72, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x42, 0x61, 0x6b, 0x65, 0x53, 0x75,
-	0x70, 0x65, 0x72, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f,
-	0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e,
-	0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63,
+	0x2e, 0x42, 0x61, 0x6b, 0x65, 0x53, 0x75, 0x70, 0x65, 0x72, 0x4d, 0x61, 0x63, 0x61, 0x72, 0x6f,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x2e, 0x6c, 0x69,
+	0x74, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x57, 0x68, 0x6f, 0x41,
+	0x6d, 0x49, 0x12, 0x15, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x57, 0x68, 0x6f, 0x41,
+	0x6d, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x2e, 0x57, 0x68, 0x6f, 0x41, 0x6d, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x52, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70, 0x63, 0x2e, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0f, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x6c, 0x69, 0x74, 0x72, 0x70,
+	0x63, 0x2e, 0x4c, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01, 0x42, 0x34, 0x5a, 0x32,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x6e, 0x69, 0x6e, 0x67, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x6e, 0x69,
+	0x6e, 0x67, 0x2d, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x6c, 0x2f, 0x6c, 0x69, 0x74, 0x72,
+	0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -342,27 +1557,75 @@ func file_proxy_proto_rawDescGZIP() []byte {
 	return file_proxy_proto_rawDescData
 }
 
-var file_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_proxy_proto_goTypes = []any{
-	(*BakeSuperMacaroonRequest)(nil),  // 0: litrpc.BakeSuperMacaroonRequest
-	(*BakeSuperMacaroonResponse)(nil), // 1: litrpc.BakeSuperMacaroonResponse
-	(*StopDaemonRequest)(nil),         // 2: litrpc.StopDaemonRequest
-	(*StopDaemonResponse)(nil),        // 3: litrpc.StopDaemonResponse
-	(*GetInfoRequest)(nil),            // 4: litrpc.GetInfoRequest
-	(*GetInfoResponse)(nil),           // 5: litrpc.GetInfoResponse
+	(EventCategory)(0),                // 0: litrpc.EventCategory
+	(AccountChangeType)(0),            // 1: litrpc.AccountChangeType
+	(*SubscribeEventsRequest)(nil),    // 2: litrpc.SubscribeEventsRequest
+	(*LitEvent)(nil),                  // 3: litrpc.LitEvent
+	(*AccountChangeEvent)(nil),        // 4: litrpc.AccountChangeEvent
+	(*SessionChangeEvent)(nil),        // 5: litrpc.SessionChangeEvent
+	(*WhoAmIRequest)(nil),             // 6: litrpc.WhoAmIRequest
+	(*WhoAmIResponse)(nil),            // 7: litrpc.WhoAmIResponse
+	(*CheckPermissionRequest)(nil),    // 8: litrpc.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),   // 9: litrpc.CheckPermissionResponse
+	(*CallerSession)(nil),             // 10: litrpc.CallerSession
+	(*RotateRootKeyRequest)(nil),      // 11: litrpc.RotateRootKeyRequest
+	(*RotateRootKeyResponse)(nil),     // 12: litrpc.RotateRootKeyResponse
+	(*BakeSuperMacaroonRequest)(nil),  // 13: litrpc.BakeSuperMacaroonRequest
+	(*BakeSuperMacaroonResponse)(nil), // 14: litrpc.BakeSuperMacaroonResponse
+	(*StopDaemonRequest)(nil),         // 15: litrpc.StopDaemonRequest
+	(*StopDaemonResponse)(nil),        // 16: litrpc.StopDaemonResponse
+	(*GetInfoRequest)(nil),            // 17: litrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),           // 18: litrpc.GetInfoResponse
+	nil,                               // 19: litrpc.WhoAmIResponse.FeatureRulesEntry
+	(*SubServerStatusEvent)(nil),      // 20: litrpc.SubServerStatusEvent
+	(*AuditLogEntry)(nil),             // 21: litrpc.AuditLogEntry
+	(*Account)(nil),                   // 22: litrpc.Account
+	(SessionType)(0),                  // 23: litrpc.SessionType
+	(SessionState)(0),                 // 24: litrpc.SessionState
+	(*RulesMap)(nil),                  // 25: litrpc.RulesMap
+	(*MacaroonPermission)(nil),        // 26: litrpc.MacaroonPermission
 }
 var file_proxy_proto_depIdxs = []int32{
-	4, // 0: litrpc.Proxy.GetInfo:input_type -> litrpc.GetInfoRequest
-	2, // 1: litrpc.Proxy.StopDaemon:input_type -> litrpc.StopDaemonRequest
-	0, // 2: litrpc.Proxy.BakeSuperMacaroon:input_type -> litrpc.BakeSuperMacaroonRequest
-	5, // 3: litrpc.Proxy.GetInfo:output_type -> litrpc.GetInfoResponse
-	3, // 4: litrpc.Proxy.StopDaemon:output_type -> litrpc.StopDaemonResponse
-	1, // 5: litrpc.Proxy.BakeSuperMacaroon:output_type -> litrpc.BakeSuperMacaroonResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: litrpc.SubscribeEventsRequest.categories:type_name -> litrpc.EventCategory
+	0,  // 1: litrpc.LitEvent.category:type_name -> litrpc.EventCategory
+	4,  // 2: litrpc.LitEvent.account:type_name -> litrpc.AccountChangeEvent
+	5,  // 3: litrpc.LitEvent.session:type_name -> litrpc.SessionChangeEvent
+	20, // 4: litrpc.LitEvent.sub_server:type_name -> litrpc.SubServerStatusEvent
+	21, // 5: litrpc.LitEvent.firewall_denial:type_name -> litrpc.AuditLogEntry
+	1,  // 6: litrpc.AccountChangeEvent.type:type_name -> litrpc.AccountChangeType
+	22, // 7: litrpc.AccountChangeEvent.account:type_name -> litrpc.Account
+	23, // 8: litrpc.SessionChangeEvent.session_type:type_name -> litrpc.SessionType
+	24, // 9: litrpc.SessionChangeEvent.state:type_name -> litrpc.SessionState
+	10, // 10: litrpc.WhoAmIResponse.session:type_name -> litrpc.CallerSession
+	22, // 11: litrpc.WhoAmIResponse.account:type_name -> litrpc.Account
+	25, // 12: litrpc.WhoAmIResponse.session_rules:type_name -> litrpc.RulesMap
+	19, // 13: litrpc.WhoAmIResponse.feature_rules:type_name -> litrpc.WhoAmIResponse.FeatureRulesEntry
+	26, // 14: litrpc.CheckPermissionResponse.missing_permissions:type_name -> litrpc.MacaroonPermission
+	23, // 15: litrpc.CallerSession.session_type:type_name -> litrpc.SessionType
+	24, // 16: litrpc.CallerSession.session_state:type_name -> litrpc.SessionState
+	25, // 17: litrpc.WhoAmIResponse.FeatureRulesEntry.value:type_name -> litrpc.RulesMap
+	17, // 18: litrpc.Proxy.GetInfo:input_type -> litrpc.GetInfoRequest
+	15, // 19: litrpc.Proxy.StopDaemon:input_type -> litrpc.StopDaemonRequest
+	13, // 20: litrpc.Proxy.BakeSuperMacaroon:input_type -> litrpc.BakeSuperMacaroonRequest
+	11, // 21: litrpc.Proxy.RotateRootKey:input_type -> litrpc.RotateRootKeyRequest
+	6,  // 22: litrpc.Proxy.WhoAmI:input_type -> litrpc.WhoAmIRequest
+	8,  // 23: litrpc.Proxy.CheckPermission:input_type -> litrpc.CheckPermissionRequest
+	2,  // 24: litrpc.Proxy.SubscribeEvents:input_type -> litrpc.SubscribeEventsRequest
+	18, // 25: litrpc.Proxy.GetInfo:output_type -> litrpc.GetInfoResponse
+	16, // 26: litrpc.Proxy.StopDaemon:output_type -> litrpc.StopDaemonResponse
+	14, // 27: litrpc.Proxy.BakeSuperMacaroon:output_type -> litrpc.BakeSuperMacaroonResponse
+	12, // 28: litrpc.Proxy.RotateRootKey:output_type -> litrpc.RotateRootKeyResponse
+	7,  // 29: litrpc.Proxy.WhoAmI:output_type -> litrpc.WhoAmIResponse
+	9,  // 30: litrpc.Proxy.CheckPermission:output_type -> litrpc.CheckPermissionResponse
+	3,  // 31: litrpc.Proxy.SubscribeEvents:output_type -> litrpc.LitEvent
+	25, // [25:32] is the sub-list for method output_type
+	18, // [18:25] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_proxy_proto_init() }
@@ -370,9 +1633,13 @@ func file_proxy_proto_init() {
 	if File_proxy_proto != nil {
 		return
 	}
+	file_firewall_proto_init()
+	file_lit_accounts_proto_init()
+	file_lit_sessions_proto_init()
+	file_lit_status_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_proxy_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*BakeSuperMacaroonRequest); i {
+			switch v := v.(*SubscribeEventsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -384,7 +1651,7 @@ func file_proxy_proto_init() {
 			}
 		}
 		file_proxy_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*BakeSuperMacaroonResponse); i {
+			switch v := v.(*LitEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -396,7 +1663,7 @@ func file_proxy_proto_init() {
 			}
 		}
 		file_proxy_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*StopDaemonRequest); i {
+			switch v := v.(*AccountChangeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -408,7 +1675,7 @@ func file_proxy_proto_init() {
 			}
 		}
 		file_proxy_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*StopDaemonResponse); i {
+			switch v := v.(*SessionChangeEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -420,7 +1687,7 @@ func file_proxy_proto_init() {
 			}
 		}
 		file_proxy_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*GetInfoRequest); i {
+			switch v := v.(*WhoAmIRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -432,6 +1699,138 @@ func file_proxy_proto_init() {
 			}
 		}
 		file_proxy_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*WhoAmIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckPermissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*CheckPermissionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*CallerSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RotateRootKeyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RotateRootKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*BakeSuperMacaroonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*BakeSuperMacaroonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*StopDaemonRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*StopDaemonResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*GetInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proxy_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*GetInfoResponse); i {
 			case 0:
 				return &v.state
@@ -444,18 +1843,25 @@ func file_proxy_proto_init() {
 			}
 		}
 	}
+	file_proxy_proto_msgTypes[1].OneofWrappers = []any{
+		(*LitEvent_Account)(nil),
+		(*LitEvent_Session)(nil),
+		(*LitEvent_SubServer)(nil),
+		(*LitEvent_FirewallDenial)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proxy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proxy_proto_goTypes,
 		DependencyIndexes: file_proxy_proto_depIdxs,
+		EnumInfos:         file_proxy_proto_enumTypes,
 		MessageInfos:      file_proxy_proto_msgTypes,
 	}.Build()
 	File_proxy_proto = out.File
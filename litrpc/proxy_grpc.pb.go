@@ -29,6 +29,37 @@ type ProxyClient interface {
 	// BakeSuperMacaroon bakes a new macaroon that includes permissions for
 	// all the active daemons that LiT is connected to.
 	BakeSuperMacaroon(ctx context.Context, in *BakeSuperMacaroonRequest, opts ...grpc.CallOption) (*BakeSuperMacaroonResponse, error)
+	// litcli: `rotaterootkey`
+	// RotateRootKey creates a new root key for LiT's own macaroons and bakes a
+	// new LiT macaroon under it, which replaces the one on disk. Macaroons that
+	// were baked under the previous root key remain valid for the configured
+	// grace period, after which the previous root key is invalidated.
+	//
+	// Only the macaroons of LiT's own macaroon service, such as lit.macaroon,
+	// are affected. Account macaroons and session macaroons, including super
+	// macaroons, are baked by lnd under lnd's root keys and stay valid. The
+	// macaroons of an account can be replaced with RotateAccountMacaroon and
+	// the macaroon of a session is invalidated by revoking the session.
+	RotateRootKey(ctx context.Context, in *RotateRootKeyRequest, opts ...grpc.CallOption) (*RotateRootKeyResponse, error)
+	// litcli: `whoami`
+	// WhoAmI describes the privileges of the macaroon that the call is made
+	// with: the URIs it may call and, if it belongs to one, the session it was
+	// baked for, the account it is linked to and the firewall rules that are
+	// enforced for it.
+	WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error)
+	// litcli: `macaroon check`
+	// CheckPermission checks whether a macaroon would be authorized to call the
+	// given URI, without making the call. The macaroon is validated the same way
+	// the call itself would be, including its caveats. If the call would be
+	// denied, the caveat or the permissions that block it are returned.
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	// litcli: `events`
+	// SubscribeEvents streams the significant events of litd in the order they
+	// happen: changes to accounts and sessions, state changes of sub-servers and
+	// requests that were denied by the firewall. The events can be filtered by
+	// category. Every event carries a sequence number, which a subscriber can
+	// use to resume the stream after a reconnect without missing any events.
+	SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (Proxy_SubscribeEventsClient, error)
 }
 
 type proxyClient struct {
@@ -66,6 +97,65 @@ func (c *proxyClient) BakeSuperMacaroon(ctx context.Context, in *BakeSuperMacaro
 	return out, nil
 }
 
+func (c *proxyClient) RotateRootKey(ctx context.Context, in *RotateRootKeyRequest, opts ...grpc.CallOption) (*RotateRootKeyResponse, error) {
+	out := new(RotateRootKeyResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Proxy/RotateRootKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) WhoAmI(ctx context.Context, in *WhoAmIRequest, opts ...grpc.CallOption) (*WhoAmIResponse, error) {
+	out := new(WhoAmIResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Proxy/WhoAmI", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error) {
+	out := new(CheckPermissionResponse)
+	err := c.cc.Invoke(ctx, "/litrpc.Proxy/CheckPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SubscribeEvents(ctx context.Context, in *SubscribeEventsRequest, opts ...grpc.CallOption) (Proxy_SubscribeEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Proxy_ServiceDesc.Streams[0], "/litrpc.Proxy/SubscribeEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &proxySubscribeEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Proxy_SubscribeEventsClient interface {
+	Recv() (*LitEvent, error)
+	grpc.ClientStream
+}
+
+type proxySubscribeEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *proxySubscribeEventsClient) Recv() (*LitEvent, error) {
+	m := new(LitEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ProxyServer is the server API for Proxy service.
 // All implementations must embed UnimplementedProxyServer
 // for forward compatibility
@@ -81,6 +171,37 @@ type ProxyServer interface {
 	// BakeSuperMacaroon bakes a new macaroon that includes permissions for
 	// all the active daemons that LiT is connected to.
 	BakeSuperMacaroon(context.Context, *BakeSuperMacaroonRequest) (*BakeSuperMacaroonResponse, error)
+	// litcli: `rotaterootkey`
+	// RotateRootKey creates a new root key for LiT's own macaroons and bakes a
+	// new LiT macaroon under it, which replaces the one on disk. Macaroons that
+	// were baked under the previous root key remain valid for the configured
+	// grace period, after which the previous root key is invalidated.
+	//
+	// Only the macaroons of LiT's own macaroon service, such as lit.macaroon,
+	// are affected. Account macaroons and session macaroons, including super
+	// macaroons, are baked by lnd under lnd's root keys and stay valid. The
+	// macaroons of an account can be replaced with RotateAccountMacaroon and
+	// the macaroon of a session is invalidated by revoking the session.
+	RotateRootKey(context.Context, *RotateRootKeyRequest) (*RotateRootKeyResponse, error)
+	// litcli: `whoami`
+	// WhoAmI describes the privileges of the macaroon that the call is made
+	// with: the URIs it may call and, if it belongs to one, the session it was
+	// baked for, the account it is linked to and the firewall rules that are
+	// enforced for it.
+	WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error)
+	// litcli: `macaroon check`
+	// CheckPermission checks whether a macaroon would be authorized to call the
+	// given URI, without making the call. The macaroon is validated the same way
+	// the call itself would be, including its caveats. If the call would be
+	// denied, the caveat or the permissions that block it are returned.
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	// litcli: `events`
+	// SubscribeEvents streams the significant events of litd in the order they
+	// happen: changes to accounts and sessions, state changes of sub-servers and
+	// requests that were denied by the firewall. The events can be filtered by
+	// category. Every event carries a sequence number, which a subscriber can
+	// use to resume the stream after a reconnect without missing any events.
+	SubscribeEvents(*SubscribeEventsRequest, Proxy_SubscribeEventsServer) error
 	mustEmbedUnimplementedProxyServer()
 }
 
@@ -97,6 +218,18 @@ func (UnimplementedProxyServer) StopDaemon(context.Context, *StopDaemonRequest)
 func (UnimplementedProxyServer) BakeSuperMacaroon(context.Context, *BakeSuperMacaroonRequest) (*BakeSuperMacaroonResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BakeSuperMacaroon not implemented")
 }
+func (UnimplementedProxyServer) RotateRootKey(context.Context, *RotateRootKeyRequest) (*RotateRootKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateRootKey not implemented")
+}
+func (UnimplementedProxyServer) WhoAmI(context.Context, *WhoAmIRequest) (*WhoAmIResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhoAmI not implemented")
+}
+func (UnimplementedProxyServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedProxyServer) SubscribeEvents(*SubscribeEventsRequest, Proxy_SubscribeEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeEvents not implemented")
+}
 func (UnimplementedProxyServer) mustEmbedUnimplementedProxyServer() {}
 
 // UnsafeProxyServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +297,81 @@ func _Proxy_BakeSuperMacaroon_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_RotateRootKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateRootKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).RotateRootKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Proxy/RotateRootKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).RotateRootKey(ctx, req.(*RotateRootKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_WhoAmI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhoAmIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).WhoAmI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Proxy/WhoAmI",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).WhoAmI(ctx, req.(*WhoAmIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/litrpc.Proxy/CheckPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SubscribeEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProxyServer).SubscribeEvents(m, &proxySubscribeEventsServer{stream})
+}
+
+type Proxy_SubscribeEventsServer interface {
+	Send(*LitEvent) error
+	grpc.ServerStream
+}
+
+type proxySubscribeEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *proxySubscribeEventsServer) Send(m *LitEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Proxy_ServiceDesc is the grpc.ServiceDesc for Proxy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,7 +391,25 @@ var Proxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BakeSuperMacaroon",
 			Handler:    _Proxy_BakeSuperMacaroon_Handler,
 		},
+		{
+			MethodName: "RotateRootKey",
+			Handler:    _Proxy_RotateRootKey_Handler,
+		},
+		{
+			MethodName: "WhoAmI",
+			Handler:    _Proxy_WhoAmI_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _Proxy_CheckPermission_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeEvents",
+			Handler:       _Proxy_SubscribeEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
 }
@@ -8,8 +8,10 @@ import (
 	"github.com/lightninglabs/lightning-terminal/accounts"
 	"github.com/lightninglabs/lightning-terminal/autopilotserver"
 	"github.com/lightninglabs/lightning-terminal/db"
+	"github.com/lightninglabs/lightning-terminal/events"
 	"github.com/lightninglabs/lightning-terminal/firewall"
 	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	litmac "github.com/lightninglabs/lightning-terminal/macaroons"
 	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
 	"github.com/lightninglabs/lightning-terminal/rules"
 	"github.com/lightninglabs/lightning-terminal/session"
@@ -91,6 +93,8 @@ func SetupLoggers(root *build.SubLoggerManager, intercept signal.Interceptor) {
 		root, subservers.Subsystem, intercept, subservers.UseLogger,
 	)
 	lnd.AddSubLogger(root, db.Subsystem, intercept, db.UseLogger)
+	lnd.AddSubLogger(root, litmac.Subsystem, intercept, litmac.UseLogger)
+	lnd.AddSubLogger(root, events.Subsystem, intercept, events.UseLogger)
 
 	// Add daemon loggers to lnd's root logger.
 	faraday.SetupLoggers(root, intercept)
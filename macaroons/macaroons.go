@@ -15,16 +15,13 @@ import (
 type Baker func(ctx context.Context, rootKeyID uint64,
 	perms []bakery.Op, caveats []macaroon.Caveat) (string, error)
 
+// RootKeyDeleter is a function type for deleting the root key of a super
+// macaroon, which invalidates all macaroons baked under it.
+type RootKeyDeleter func(ctx context.Context, rootKeyID uint64) error
+
 // RootKeyIDFromMacaroon extracts the root key ID of the passed macaroon.
 func RootKeyIDFromMacaroon(mac *macaroon.Macaroon) (uint64, error) {
-	rawID := mac.Id()
-	if rawID[0] != byte(bakery.LatestVersion) {
-		return 0, fmt.Errorf("mac id is not on the latest version")
-	}
-
-	decodedID := &lnrpc.MacaroonId{}
-	idProto := rawID[1:]
-	err := proto.Unmarshal(idProto, decodedID)
+	decodedID, err := decodeMacaroonID(mac)
 	if err != nil {
 		return 0, err
 	}
@@ -33,3 +30,39 @@ func RootKeyIDFromMacaroon(mac *macaroon.Macaroon) (uint64, error) {
 	// number.
 	return strconv.ParseUint(string(decodedID.StorageId), 10, 64)
 }
+
+// OpsFromMacaroon extracts the permissions that the passed macaroon was baked
+// with.
+func OpsFromMacaroon(mac *macaroon.Macaroon) ([]bakery.Op, error) {
+	decodedID, err := decodeMacaroonID(mac)
+	if err != nil {
+		return nil, err
+	}
+
+	var ops []bakery.Op
+	for _, op := range decodedID.Ops {
+		for _, action := range op.Actions {
+			ops = append(ops, bakery.Op{
+				Entity: op.Entity,
+				Action: action,
+			})
+		}
+	}
+
+	return ops, nil
+}
+
+// decodeMacaroonID decodes the identifier of the passed macaroon.
+func decodeMacaroonID(mac *macaroon.Macaroon) (*lnrpc.MacaroonId, error) {
+	rawID := mac.Id()
+	if len(rawID) == 0 || rawID[0] != byte(bakery.LatestVersion) {
+		return nil, fmt.Errorf("mac id is not on the latest version")
+	}
+
+	decodedID := &lnrpc.MacaroonId{}
+	if err := proto.Unmarshal(rawID[1:], decodedID); err != nil {
+		return nil, err
+	}
+
+	return decodedID, nil
+}
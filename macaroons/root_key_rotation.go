@@ -0,0 +1,401 @@
+package macaroons
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+// retireRetryDelay is the time after which the invalidation of a previous root
+// key is retried if it failed.
+const retireRetryDelay = time.Minute
+
+// RootKeyService is the part of a macaroon service that is needed to rotate
+// its root key. It is implemented by lnd's macaroons.Service.
+type RootKeyService interface {
+	// NewMacaroon bakes a new macaroon with the given operations under the
+	// root key with the given ID. The root key is created if it doesn't
+	// exist yet.
+	NewMacaroon(ctx context.Context, rootKeyID []byte,
+		ops ...bakery.Op) (*bakery.Macaroon, error)
+
+	// ListMacaroonIDs returns the IDs of all root keys of the service.
+	ListMacaroonIDs(ctx context.Context) ([][]byte, error)
+
+	// DeleteMacaroonID deletes the root key with the given ID.
+	DeleteMacaroonID(ctx context.Context, rootKeyID []byte) ([]byte,
+		error)
+
+	// SetRootKey replaces the default root key with the given one.
+	SetRootKey(rootKey []byte) error
+}
+
+// RootKeyRotatorConfig holds the configuration of a RootKeyRotator.
+type RootKeyRotatorConfig struct {
+	// Service is the macaroon service whose root key is rotated.
+	Service RootKeyService
+
+	// RequiredPerms defines all method paths and the permissions required
+	// when accessing those paths. The macaroon that is baked under a new
+	// root key contains all of these permissions.
+	RequiredPerms map[string][]bakery.Op
+
+	// MacaroonPath is the path of the default macaroon of the service. It
+	// is replaced by a macaroon that is baked under the new root key on
+	// each rotation. If it is empty, no macaroon is written to disk.
+	MacaroonPath string
+
+	// GracePeriod is the time during which macaroons that were baked under
+	// the previous root key remain valid after a rotation.
+	GracePeriod time.Duration
+
+	// Clock is used to determine when a previous root key is invalidated.
+	Clock clock.Clock
+}
+
+// RootKeyRotation describes a completed root key rotation.
+type RootKeyRotation struct {
+	// RootKeyID is the ID of the new root key.
+	RootKeyID uint64
+
+	// PreviousRootKeyID is the ID of the root key that was replaced.
+	PreviousRootKeyID uint64
+
+	// PreviousExpiry is the time at which macaroons that were baked under
+	// the previous root key become invalid.
+	PreviousExpiry time.Time
+
+	// Macaroon is the hex encoded macaroon that was baked under the new
+	// root key.
+	Macaroon string
+}
+
+// RootKeyRotator rotates the root key of a macaroon service. All macaroons that
+// were baked under a previous root key remain valid for the configured grace
+// period after a rotation, after which the previous root key is invalidated.
+//
+// The root key IDs are numbers that are increased on each rotation, so the root
+// key with the highest ID is the current one. When the rotator is started, any
+// other root key that still exists is invalidated once the grace period has
+// passed, since the time of its rotation isn't stored.
+type RootKeyRotator struct {
+	cfg *RootKeyRotatorConfig
+
+	// currentID is the ID of the root key that new macaroons are baked
+	// under.
+	currentID uint64
+
+	// retiring holds the time at which each previous root key that still
+	// exists is invalidated.
+	retiring map[uint64]time.Time
+
+	// rotated is used to notify the main loop about a rotation.
+	rotated chan struct{}
+
+	mu sync.Mutex
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+// NewRootKeyRotator creates a new RootKeyRotator.
+func NewRootKeyRotator(cfg *RootKeyRotatorConfig) *RootKeyRotator {
+	return &RootKeyRotator{
+		cfg:      cfg,
+		retiring: make(map[uint64]time.Time),
+		rotated:  make(chan struct{}, 1),
+	}
+}
+
+// Start determines the current root key, makes sure that the macaroon on disk
+// was baked under it and starts invalidating previous root keys once their
+// grace period has passed.
+func (r *RootKeyRotator) Start(ctx context.Context) error {
+	ids, err := r.cfg.Service.ListMacaroonIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to list root key IDs: %w", err)
+	}
+
+	r.mu.Lock()
+	var parsedIDs []uint64
+	for _, rawID := range ids {
+		id, err := strconv.ParseUint(string(rawID), 10, 64)
+		if err != nil {
+			// This isn't a root key that was created by us.
+			continue
+		}
+
+		parsedIDs = append(parsedIDs, id)
+		if id > r.currentID {
+			r.currentID = id
+		}
+	}
+
+	// The default root key can't be deleted, so it is found again on each
+	// start even if it was already invalidated. Invalidating it again
+	// doesn't do any harm though.
+	expiry := r.cfg.Clock.Now().Add(r.cfg.GracePeriod)
+	for _, id := range parsedIDs {
+		if id != r.currentID {
+			r.retiring[id] = expiry
+		}
+	}
+	r.mu.Unlock()
+
+	// The service bakes the macaroon on disk under the default root key
+	// if it doesn't exist yet, so we bake it under the current one again.
+	if r.cfg.MacaroonPath != "" {
+		err := r.ensureMacaroonFile(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Current macaroon root key ID is %d", r.currentID)
+
+	ctx, cancel := context.WithCancel(ctx)
+	r.cancel = cancel
+
+	r.wg.Add(1)
+	go r.retireLoop(ctx)
+
+	return nil
+}
+
+// Stop stops invalidating previous root keys.
+func (r *RootKeyRotator) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+
+	r.wg.Wait()
+}
+
+// CurrentRootKeyID returns the ID of the root key that new macaroons are baked
+// under.
+func (r *RootKeyRotator) CurrentRootKeyID() uint64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.currentID
+}
+
+// Rotate creates a new root key, bakes a new macaroon under it and schedules
+// the invalidation of the previous root key once the grace period has passed.
+func (r *RootKeyRotator) Rotate(ctx context.Context) (*RootKeyRotation,
+	error) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	newID := r.currentID + 1
+	macHex, err := r.bakeMacaroon(ctx, newID)
+	if err != nil {
+		return nil, err
+	}
+
+	rotation := &RootKeyRotation{
+		RootKeyID:         newID,
+		PreviousRootKeyID: r.currentID,
+		PreviousExpiry:    r.cfg.Clock.Now().Add(r.cfg.GracePeriod),
+		Macaroon:          macHex,
+	}
+	r.retiring[r.currentID] = rotation.PreviousExpiry
+	r.currentID = newID
+
+	log.Infof("Rotated macaroon root key from ID %d to ID %d, macaroons "+
+		"baked under the previous root key are valid until %v",
+		rotation.PreviousRootKeyID, rotation.RootKeyID,
+		rotation.PreviousExpiry)
+
+	select {
+	case r.rotated <- struct{}{}:
+	default:
+	}
+
+	return rotation, nil
+}
+
+// retireLoop invalidates previous root keys whenever their grace period has
+// passed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *RootKeyRotator) retireLoop(ctx context.Context) {
+	defer r.wg.Done()
+
+	for {
+		r.retireExpired(ctx)
+
+		var tick <-chan time.Time
+		if next, ok := r.nextExpiry(); ok {
+			tick = r.cfg.Clock.TickAfter(
+				next.Sub(r.cfg.Clock.Now()),
+			)
+		}
+
+		select {
+		case <-tick:
+		case <-r.rotated:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// nextExpiry returns the earliest time at which a previous root key is
+// invalidated, if there is any.
+func (r *RootKeyRotator) nextExpiry() (time.Time, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		next time.Time
+		ok   bool
+	)
+	for _, expiry := range r.retiring {
+		if !ok || expiry.Before(next) {
+			next = expiry
+			ok = true
+		}
+	}
+
+	return next, ok
+}
+
+// retireExpired invalidates all previous root keys whose grace period has
+// passed.
+func (r *RootKeyRotator) retireExpired(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.cfg.Clock.Now()
+	for id, expiry := range r.retiring {
+		if now.Before(expiry) {
+			continue
+		}
+
+		// The root key stays valid until it is invalidated, so we
+		// retry after a delay instead of giving up on it.
+		if err := r.retire(ctx, id); err != nil {
+			r.retiring[id] = now.Add(retireRetryDelay)
+
+			log.Errorf("Unable to invalidate macaroon root key "+
+				"with ID %d, retrying in %v: %v", id,
+				retireRetryDelay, err)
+
+			continue
+		}
+
+		delete(r.retiring, id)
+
+		log.Infof("Invalidated macaroon root key with ID %d", id)
+	}
+}
+
+// retire invalidates the root key with the given ID and with it all macaroons
+// that were baked under it.
+func (r *RootKeyRotator) retire(ctx context.Context, id uint64) error {
+	rawID := []byte(strconv.FormatUint(id, 10))
+
+	// The default root key can't be deleted, so we replace it with a new
+	// random key instead.
+	if bytes.Equal(rawID, macaroons.DefaultRootKeyID) {
+		rootKey := make([]byte, macaroons.RootKeyLen)
+		if _, err := rand.Read(rootKey); err != nil {
+			return err
+		}
+
+		return r.cfg.Service.SetRootKey(rootKey)
+	}
+
+	_, err := r.cfg.Service.DeleteMacaroonID(ctx, rawID)
+
+	return err
+}
+
+// ensureMacaroonFile bakes the macaroon on disk under the current root key if
+// it doesn't exist or was baked under a different root key.
+func (r *RootKeyRotator) ensureMacaroonFile(ctx context.Context) error {
+	macBytes, err := os.ReadFile(r.cfg.MacaroonPath)
+	if err == nil {
+		mac := &macaroon.Macaroon{}
+		err = mac.UnmarshalBinary(macBytes)
+		if err != nil {
+			return fmt.Errorf("unable to decode macaroon %s: %w",
+				r.cfg.MacaroonPath, err)
+		}
+
+		id, err := RootKeyIDFromMacaroon(mac)
+		if err == nil && id == r.currentID {
+			return nil
+		}
+	}
+
+	_, err = r.bakeMacaroon(ctx, r.currentID)
+
+	return err
+}
+
+// bakeMacaroon bakes a macaroon with all required permissions under the root
+// key with the given ID and writes it to disk if a macaroon path is set. The
+// root key is created if it doesn't exist yet.
+func (r *RootKeyRotator) bakeMacaroon(ctx context.Context,
+	id uint64) (string, error) {
+
+	mac, err := r.cfg.Service.NewMacaroon(
+		ctx, []byte(strconv.FormatUint(id, 10)),
+		requiredOps(r.cfg.RequiredPerms)...,
+	)
+	if err != nil {
+		return "", fmt.Errorf("unable to bake macaroon: %w", err)
+	}
+
+	macBytes, err := mac.M().MarshalBinary()
+	if err != nil {
+		return "", err
+	}
+
+	if r.cfg.MacaroonPath != "" {
+		err = os.WriteFile(r.cfg.MacaroonPath, macBytes, 0644)
+		if err != nil {
+			return "", fmt.Errorf("unable to write macaroon %s: %w",
+				r.cfg.MacaroonPath, err)
+		}
+	}
+
+	return hex.EncodeToString(macBytes), nil
+}
+
+// requiredOps returns the deduplicated list of all operations in the given
+// required permissions.
+func requiredOps(requiredPerms map[string][]bakery.Op) []bakery.Op {
+	seen := make(map[bakery.Op]struct{})
+
+	var ops []bakery.Op
+	for _, methodOps := range requiredPerms {
+		for _, op := range methodOps {
+			if _, ok := seen[op]; ok {
+				continue
+			}
+
+			seen[op] = struct{}{}
+			ops = append(ops, op)
+		}
+	}
+
+	return ops
+}
+
+// A compile-time check to ensure that lnd's macaroon service can be rotated.
+var _ RootKeyService = (*macaroons.Service)(nil)
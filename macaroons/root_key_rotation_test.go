@@ -0,0 +1,329 @@
+package macaroons
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon.v2"
+)
+
+var (
+	testRequiredPerms = map[string][]bakery.Op{
+		"/litrpc.Proxy/GetInfo": {{
+			Entity: "proxy",
+			Action: "read",
+		}},
+	}
+
+	testGracePeriod = time.Hour
+
+	testTimeout = time.Second
+)
+
+// newTestMacaroonService creates a macaroon service that is backed by an
+// unlocked bolt root key store in a temporary directory.
+func newTestMacaroonService(t *testing.T) (*macaroons.Service,
+	*macaroons.RootKeyStorage) {
+
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, filepath.Join(t.TempDir(), "macaroons.db"),
+		true, kvdb.DefaultDBTimeout,
+	)
+	require.NoError(t, err)
+
+	rks, err := macaroons.NewRootKeyStorage(db)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, rks.Close())
+	})
+
+	pw := []byte("password")
+	require.NoError(t, rks.CreateUnlock(&pw))
+
+	service, err := macaroons.NewService(rks, "litd", false)
+	require.NoError(t, err)
+
+	return service, rks
+}
+
+// waitForTicker waits until the rotator has scheduled its next invalidation.
+func waitForTicker(t *testing.T, tickSignal chan time.Duration) {
+	select {
+	case <-tickSignal:
+	case <-time.After(testTimeout):
+		t.Fatalf("rotator didn't schedule an invalidation")
+	}
+}
+
+// macaroonFileRootKeyID returns the root key ID of the macaroon at the given
+// path.
+func macaroonFileRootKeyID(t *testing.T, path string) uint64 {
+	macBytes, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	mac := &macaroon.Macaroon{}
+	require.NoError(t, mac.UnmarshalBinary(macBytes))
+
+	id, err := RootKeyIDFromMacaroon(mac)
+	require.NoError(t, err)
+
+	return id
+}
+
+// TestRootKeyRotator tests that a rotation creates a new root key, bakes the
+// macaroon on disk under it and invalidates the previous root key once the
+// grace period has passed.
+func TestRootKeyRotator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	service, rks := newTestMacaroonService(t)
+	tickSignal := make(chan time.Duration, 10)
+	testClock := clock.NewTestClockWithTickSignal(
+		time.Unix(1000, 0), tickSignal,
+	)
+	macPath := filepath.Join(t.TempDir(), "lit.macaroon")
+
+	// Without a macaroon on disk, the rotator bakes one under the default
+	// root key.
+	rotator := NewRootKeyRotator(&RootKeyRotatorConfig{
+		Service:       service,
+		RequiredPerms: testRequiredPerms,
+		MacaroonPath:  macPath,
+		GracePeriod:   testGracePeriod,
+		Clock:         testClock,
+	})
+	require.NoError(t, rotator.Start(ctx))
+	require.EqualValues(t, 0, rotator.CurrentRootKeyID())
+	require.EqualValues(t, 0, macaroonFileRootKeyID(t, macPath))
+
+	defaultKey, err := rks.Get(ctx, macaroons.DefaultRootKeyID)
+	require.NoError(t, err)
+
+	// Rotate the root key twice. Both previous root keys must still be
+	// valid during the grace period.
+	rotation, err := rotator.Rotate(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, rotation.RootKeyID)
+	require.EqualValues(t, 0, rotation.PreviousRootKeyID)
+	require.Equal(
+		t, testClock.Now().Add(testGracePeriod),
+		rotation.PreviousExpiry,
+	)
+	require.NotEmpty(t, rotation.Macaroon)
+	require.EqualValues(t, 1, macaroonFileRootKeyID(t, macPath))
+	waitForTicker(t, tickSignal)
+
+	testClock.SetTime(testClock.Now().Add(testGracePeriod / 2))
+
+	rotation, err = rotator.Rotate(ctx)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, rotation.RootKeyID)
+	require.EqualValues(t, 1, rotation.PreviousRootKeyID)
+	require.EqualValues(t, 2, rotator.CurrentRootKeyID())
+	waitForTicker(t, tickSignal)
+
+	key, err := rks.Get(ctx, macaroons.DefaultRootKeyID)
+	require.NoError(t, err)
+	require.Equal(t, defaultKey, key)
+
+	_, err = rks.Get(ctx, []byte("1"))
+	require.NoError(t, err)
+
+	// Once the grace period of the first rotation has passed, the default
+	// root key is replaced, while the second one is still valid.
+	testClock.SetTime(testClock.Now().Add(testGracePeriod / 2))
+	waitForTicker(t, tickSignal)
+
+	key, err = rks.Get(ctx, macaroons.DefaultRootKeyID)
+	require.NoError(t, err)
+	require.NotEqual(t, defaultKey, key)
+
+	_, err = rks.Get(ctx, []byte("1"))
+	require.NoError(t, err)
+
+	// After the grace period of the second rotation, the root key with ID
+	// 1 is deleted.
+	testClock.SetTime(testClock.Now().Add(testGracePeriod / 2))
+	require.Eventually(t, func() bool {
+		_, err := rks.Get(ctx, []byte("1"))
+
+		return err != nil
+	}, testTimeout, 10*time.Millisecond)
+
+	_, err = rks.Get(ctx, []byte("2"))
+	require.NoError(t, err)
+
+	rotator.Stop()
+
+	// A restarted rotator continues with the latest root key.
+	rotator = NewRootKeyRotator(&RootKeyRotatorConfig{
+		Service:       service,
+		RequiredPerms: testRequiredPerms,
+		MacaroonPath:  macPath,
+		GracePeriod:   testGracePeriod,
+		Clock:         testClock,
+	})
+	require.NoError(t, rotator.Start(ctx))
+	t.Cleanup(rotator.Stop)
+
+	require.EqualValues(t, 2, rotator.CurrentRootKeyID())
+	require.EqualValues(t, 2, macaroonFileRootKeyID(t, macPath))
+}
+
+// TestRootKeyRotatorRestart tests that a started rotator bakes the macaroon on
+// disk under the latest root key and invalidates all previous root keys once
+// the grace period has passed.
+func TestRootKeyRotatorRestart(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	service, rks := newTestMacaroonService(t)
+	tickSignal := make(chan time.Duration, 10)
+	testClock := clock.NewTestClockWithTickSignal(
+		time.Unix(1000, 0), tickSignal,
+	)
+	macPath := filepath.Join(t.TempDir(), "lit.macaroon")
+
+	// Create a few root keys as if they were left over from previous
+	// rotations, while the macaroon on disk was baked under the default
+	// root key again.
+	ops := requiredOps(testRequiredPerms)
+	for _, id := range []string{"3", "5"} {
+		_, err := service.NewMacaroon(ctx, []byte(id), ops...)
+		require.NoError(t, err)
+	}
+	mac, err := service.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, ops...,
+	)
+	require.NoError(t, err)
+	macBytes, err := mac.M().MarshalBinary()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(macPath, macBytes, 0644))
+
+	defaultKey, err := rks.Get(ctx, macaroons.DefaultRootKeyID)
+	require.NoError(t, err)
+
+	rotator := NewRootKeyRotator(&RootKeyRotatorConfig{
+		Service:       service,
+		RequiredPerms: testRequiredPerms,
+		MacaroonPath:  macPath,
+		GracePeriod:   testGracePeriod,
+		Clock:         testClock,
+	})
+	require.NoError(t, rotator.Start(ctx))
+	t.Cleanup(rotator.Stop)
+
+	require.EqualValues(t, 5, rotator.CurrentRootKeyID())
+	require.EqualValues(t, 5, macaroonFileRootKeyID(t, macPath))
+	waitForTicker(t, tickSignal)
+
+	testClock.SetTime(testClock.Now().Add(testGracePeriod))
+	require.Eventually(t, func() bool {
+		_, err := rks.Get(ctx, []byte("3"))
+		if err == nil {
+			return false
+		}
+
+		key, err := rks.Get(ctx, macaroons.DefaultRootKeyID)
+
+		return err == nil && string(key) != string(defaultKey)
+	}, testTimeout, 10*time.Millisecond)
+
+	_, err = rks.Get(ctx, []byte("5"))
+	require.NoError(t, err)
+}
+
+// failingDeleteService is a macaroon service that fails to delete root keys a
+// given number of times.
+type failingDeleteService struct {
+	*macaroons.Service
+
+	mu    sync.Mutex
+	fails int
+}
+
+// DeleteMacaroonID deletes the root key with the given ID, unless it should
+// still fail.
+func (s *failingDeleteService) DeleteMacaroonID(ctx context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	s.mu.Lock()
+	if s.fails > 0 {
+		s.fails--
+		s.mu.Unlock()
+
+		return nil, errors.New("delete failed")
+	}
+	s.mu.Unlock()
+
+	return s.Service.DeleteMacaroonID(ctx, rootKeyID)
+}
+
+// TestRootKeyRotatorRetry tests that the invalidation of a previous root key is
+// retried if it fails, instead of leaving the root key valid.
+func TestRootKeyRotatorRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	macService, rks := newTestMacaroonService(t)
+	service := &failingDeleteService{Service: macService, fails: 1}
+	tickSignal := make(chan time.Duration, 10)
+	testClock := clock.NewTestClockWithTickSignal(
+		time.Unix(1000, 0), tickSignal,
+	)
+
+	ops := requiredOps(testRequiredPerms)
+	for _, id := range []string{"1", "2"} {
+		_, err := service.NewMacaroon(ctx, []byte(id), ops...)
+		require.NoError(t, err)
+	}
+
+	rotator := NewRootKeyRotator(&RootKeyRotatorConfig{
+		Service:       service,
+		RequiredPerms: testRequiredPerms,
+		GracePeriod:   testGracePeriod,
+		Clock:         testClock,
+	})
+	require.NoError(t, rotator.Start(ctx))
+	t.Cleanup(rotator.Stop)
+
+	require.EqualValues(t, 2, rotator.CurrentRootKeyID())
+	waitForTicker(t, tickSignal)
+
+	// The first attempt to delete the root key with ID 1 fails, so it is
+	// scheduled again.
+	testClock.SetTime(testClock.Now().Add(testGracePeriod))
+
+	select {
+	case d := <-tickSignal:
+		require.Equal(t, retireRetryDelay, d)
+
+	case <-time.After(testTimeout):
+		t.Fatalf("rotator didn't schedule a retry")
+	}
+
+	_, err := rks.Get(ctx, []byte("1"))
+	require.NoError(t, err)
+
+	// The retry deletes it.
+	testClock.SetTime(testClock.Now().Add(retireRetryDelay))
+	require.Eventually(t, func() bool {
+		_, err := rks.Get(ctx, []byte("1"))
+
+		return err != nil
+	}, testTimeout, 10*time.Millisecond)
+
+	_, err = rks.Get(ctx, []byte("2"))
+	require.NoError(t, err)
+}
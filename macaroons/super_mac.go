@@ -71,6 +71,24 @@ func isSuperMacaroonRootKeyID(rootKeyID uint64) bool {
 	return bytes.HasPrefix(rootKeyBytes, SuperMacaroonRootKeyPrefix[:])
 }
 
+// DeleteSuperMacaroonRootKey uses the lnd client to delete the root key with
+// the given ID, so that macaroons baked under it no longer pass authentication.
+// A new root key is generated by lnd the next time a macaroon with the same
+// root key ID is baked.
+func DeleteSuperMacaroonRootKey(ctx context.Context, lnd lnrpc.LightningClient,
+	rootKeyID uint64) error {
+
+	if lnd == nil {
+		return errors.New("lnd not yet connected")
+	}
+
+	_, err := lnd.DeleteMacaroonID(ctx, &lnrpc.DeleteMacaroonIDRequest{
+		RootKeyId: rootKeyID,
+	})
+
+	return err
+}
+
 // BakeSuperMacaroon uses the lnd client to bake a macaroon that can include
 // permissions for multiple daemons.
 func BakeSuperMacaroon(ctx context.Context, lnd lnrpc.LightningClient,
@@ -0,0 +1,249 @@
+package terminal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	litmac "github.com/lightninglabs/lightning-terminal/macaroons"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// authNone is the auth label of requests without any credentials.
+	authNone = "none"
+
+	// authBasic is the auth label of requests that use the UI password.
+	authBasic = "basic"
+
+	// authSuperMacaroon is the auth label of requests that use a LiT super
+	// macaroon.
+	authSuperMacaroon = "super_macaroon"
+
+	// authMacaroon is the auth label of requests that use any other
+	// macaroon.
+	authMacaroon = "macaroon"
+
+	// unknownMethod is the method label of requests for a method that
+	// isn't known to LiT. We don't use the requested method itself, since
+	// anyone could otherwise create an arbitrary number of time series.
+	unknownMethod = "unknown"
+
+	// storeMetricsTimeout is the maximum time it may take to query the
+	// stores for the session and account metrics on a scrape.
+	storeMetricsTimeout = 10 * time.Second
+)
+
+// PrometheusConfig holds the configuration of LiT's Prometheus metrics
+// endpoint.
+type PrometheusConfig struct {
+	Listen string `long:"listen" description:"The host:port to serve Prometheus metrics on at /metrics. The endpoint doesn't require any authentication, so it should not be reachable from the outside. If not set, no metrics are served."`
+}
+
+var (
+	// rpcRequests counts the gRPC requests that were handled by LiT,
+	// labeled by method, sub-server, the kind of credentials that were
+	// used and the resulting status code. Only the kind of credentials is
+	// recorded, never the credentials themselves.
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "litd",
+		Subsystem: "rpc",
+		Name:      "requests_total",
+		Help:      "Number of gRPC requests handled by LiT.",
+	}, []string{"method", "subserver", "auth", "code"})
+
+	metricsOnce sync.Once
+)
+
+// observeRequest counts a request for the given method that finished with the
+// given error.
+func (p *rpcProxy) observeRequest(ctx context.Context, method string,
+	err error) {
+
+	subServer, ok := p.subServerForURI(method)
+	if !ok {
+		method = unknownMethod
+		subServer = unknownMethod
+	}
+
+	rpcRequests.WithLabelValues(
+		method, subServer, requestAuth(ctx), status.Code(err).String(),
+	).Inc()
+}
+
+// requestAuth returns the kind of credentials that are attached to the
+// incoming request of the given context.
+func requestAuth(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	macHeader := md.Get(HeaderMacaroon)
+	switch {
+	case len(macHeader) == 1 && litmac.IsSuperMacaroon(macHeader[0]):
+		return authSuperMacaroon
+
+	case len(macHeader) > 0:
+		return authMacaroon
+
+	case len(md.Get("authorization")) > 0:
+		return authBasic
+
+	default:
+		return authNone
+	}
+}
+
+// storeCollector is a prometheus.Collector that reports the number of active
+// sessions and the total balance of all accounts, which are read from the
+// stores on every scrape.
+type storeCollector struct {
+	stores *stores
+
+	activeSessions *prometheus.Desc
+	accountBalance *prometheus.Desc
+}
+
+// newStoreCollector creates a new storeCollector for the given stores.
+func newStoreCollector(s *stores) *storeCollector {
+	return &storeCollector{
+		stores: s,
+		activeSessions: prometheus.NewDesc(
+			"litd_sessions_active", "Number of sessions that "+
+				"are created or in use.", nil, nil,
+		),
+		accountBalance: prometheus.NewDesc(
+			"litd_accounts_balance_msat", "Total current "+
+				"balance of all accounts in millisatoshis.",
+			nil, nil,
+		),
+	}
+}
+
+// Describe sends the descriptors of all metrics of the collector.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (c *storeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.activeSessions
+	ch <- c.accountBalance
+}
+
+// Collect reads the current values of all metrics of the collector from the
+// stores. A metric that can't be read is left out.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (c *storeCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(), storeMetricsTimeout,
+	)
+	defer cancel()
+
+	var numSessions int
+	for _, state := range []session.State{
+		session.StateCreated, session.StateInUse,
+	} {
+		sessions, err := c.stores.sessions.ListSessionsByState(
+			ctx, state,
+		)
+		if err != nil {
+			log.Errorf("Unable to list sessions for metrics: %v",
+				err)
+
+			numSessions = -1
+			break
+		}
+
+		numSessions += len(sessions)
+	}
+	if numSessions >= 0 {
+		ch <- prometheus.MustNewConstMetric(
+			c.activeSessions, prometheus.GaugeValue,
+			float64(numSessions),
+		)
+	}
+
+	accts, err := c.stores.accounts.Accounts(ctx)
+	if err != nil {
+		log.Errorf("Unable to list accounts for metrics: %v", err)
+
+		return
+	}
+
+	var balance int64
+	for _, acct := range accts {
+		balance += acct.CurrentBalance
+	}
+	ch <- prometheus.MustNewConstMetric(
+		c.accountBalance, prometheus.GaugeValue, float64(balance),
+	)
+}
+
+// registerMetrics registers LiT's own metrics and those of its sub-server
+// status with the default prometheus registry, which lnd also exposes if it is
+// built with monitoring support and runs in integrated mode. It is safe to
+// call multiple times.
+func (g *LightningTerminal) registerMetrics() {
+	metricsOnce.Do(func() {
+		err := prometheus.Register(rpcRequests)
+		if err != nil {
+			log.Warnf("Unable to register RPC metrics: %v", err)
+		}
+
+		err = prometheus.Register(g.statusMgr.Collector())
+		if err != nil {
+			log.Warnf("Unable to register status metrics: %v", err)
+		}
+	})
+}
+
+// registerStoreMetrics registers the metrics that are read from the stores
+// with the default prometheus registry. It must only be called once the
+// stores are created.
+func (g *LightningTerminal) registerStoreMetrics() {
+	err := prometheus.Register(newStoreCollector(g.stores))
+	if err != nil {
+		log.Warnf("Unable to register store metrics: %v", err)
+	}
+}
+
+// startMetricsServer starts serving the metrics of the default prometheus
+// registry on the configured listen address, if there is one.
+func (g *LightningTerminal) startMetricsServer() error {
+	if g.cfg.Prometheus == nil || g.cfg.Prometheus.Listen == "" {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", g.cfg.Prometheus.Listen)
+	if err != nil {
+		return fmt.Errorf("unable to listen on %v: %w",
+			g.cfg.Prometheus.Listen, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	g.metricsServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: defaultServerTimeout,
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		log.Infof("Serving Prometheus metrics on: %v",
+			listener.Addr())
+
+		err := g.metricsServer.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("Prometheus metrics server error: %v", err)
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package terminal
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	litmac "github.com/lightninglabs/lightning-terminal/macaroons"
+	"github.com/lightninglabs/lightning-terminal/subservers"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+// checkPermission checks whether the given hex encoded macaroon would be
+// authorized to call the given URI. The macaroon is validated by the same
+// validators that the RPC interceptors use for the URI, so the result only
+// leaves out the custom caveats that are enforced on the content of a request,
+// such as the balance of an account or the firewall rules of a session.
+func (g *LightningTerminal) checkPermission(ctx context.Context, macHex,
+	uri string) (*litrpc.CheckPermissionResponse, error) {
+
+	requiredPerms, ok := g.permsMgr.URIPermissions(uri)
+	if !ok {
+		return nil, fmt.Errorf("unknown URI %s", uri)
+	}
+
+	mac, err := litmac.ParseMacaroon(macHex)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse macaroon: %w", err)
+	}
+
+	// Super macaroons are always validated by LiT. Any other macaroon for
+	// a sub-server that runs remotely is only validated by the remote
+	// daemon, which we can't ask to do so without making the call.
+	superMac := litmac.IsSuperMacaroon(macHex)
+	if handled, name := g.subServerMgr.Handles(uri); handled && !superMac {
+		ss, ok := g.subServerMgr.GetServer(name)
+		if ok && ss.Remote() {
+			return nil, fmt.Errorf("calls to %s are validated by "+
+				"the remote %s daemon and can't be checked",
+				uri, name)
+		}
+	}
+
+	ctx = metadata.NewIncomingContext(
+		ctx, metadata.Pairs(HeaderMacaroon, macHex),
+	)
+
+	// Calls to lnd with a macaroon that isn't a super macaroon are
+	// validated by lnd itself, so we let lnd check the macaroon the same
+	// way it checks super macaroons.
+	var validateErr error
+	if !superMac && g.permsMgr.IsSubServerURI(subservers.LND, uri) &&
+		!g.permsMgr.IsWhiteListedURL(uri) {
+
+		macBytes, err := hex.DecodeString(macHex)
+		if err != nil {
+			return nil, err
+		}
+
+		validateErr = g.validateSuperMacaroon(
+			ctx, macBytes, requiredPerms, uri,
+		)
+	} else {
+		validateErr = g.ValidateMacaroon(ctx, requiredPerms, uri)
+	}
+
+	if validateErr == nil {
+		return &litrpc.CheckPermissionResponse{Allowed: true}, nil
+	}
+
+	resp := &litrpc.CheckPermissionResponse{
+		Reason: validateErr.Error(),
+	}
+
+	ops, err := litmac.OpsFromMacaroon(mac)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode permissions: %w", err)
+	}
+
+	// If the macaroon has the permissions for the URI, the call is denied
+	// by one of its caveats, which the validators name in their error.
+	if slices.Contains(g.permsMgr.PermittedURIs(ops), uri) {
+		for _, caveat := range mac.Caveats() {
+			if caveat.VerificationId != nil {
+				continue
+			}
+
+			if strings.Contains(resp.Reason, string(caveat.Id)) {
+				resp.BlockingCaveat = string(caveat.Id)
+				break
+			}
+		}
+
+		return resp, nil
+	}
+
+	granted := make(map[bakery.Op]bool, len(ops))
+	for _, op := range ops {
+		granted[op] = true
+	}
+	for _, op := range requiredPerms {
+		if granted[op] {
+			continue
+		}
+
+		resp.MissingPermissions = append(
+			resp.MissingPermissions, &litrpc.MacaroonPermission{
+				Entity: op.Entity,
+				Action: op.Action,
+			},
+		)
+	}
+
+	return resp, nil
+}
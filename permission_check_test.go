@@ -0,0 +1,176 @@
+package terminal
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/perms"
+	"github.com/lightninglabs/lightning-terminal/status"
+	"github.com/lightninglabs/lightning-terminal/subservers"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightninglabs/loop/loopd"
+	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+)
+
+// TestCheckPermission tests that a macaroon is reported as allowed only if it
+// would pass the validation of the URI, and that a denial names the missing
+// permissions or the blocking caveat.
+func TestCheckPermission(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	permsMgr, err := perms.NewManager(false)
+	require.NoError(t, err)
+
+	// Loop runs remotely, so its own macaroons can't be checked by us.
+	subServerMgr := subservers.NewManager(
+		permsMgr, status.NewStatusManager(),
+	)
+	err = subServerMgr.AddServer(
+		subservers.NewLoopSubServer(
+			&loopd.Config{}, &subservers.RemoteDaemonConfig{}, true,
+		), true,
+	)
+	require.NoError(t, err)
+
+	rks, db, err := lndclient.NewBoltMacaroonStore(
+		t.TempDir(), lncfg.MacaroonDBName, time.Second,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, db.Close())
+	})
+
+	macService, err := lndclient.NewMacaroonService(
+		&lndclient.MacaroonServiceConfig{
+			RootKeyStore:     rks,
+			MacaroonLocation: "litd",
+			StatelessInit:    true,
+			RequiredPerms:    perms.RequiredPermissions,
+			DBPassword:       []byte("password"),
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, macService.Start())
+	t.Cleanup(func() {
+		require.NoError(t, macService.Stop())
+	})
+
+	g := &LightningTerminal{
+		cfg:                    &Config{},
+		permsMgr:               permsMgr,
+		subServerMgr:           subServerMgr,
+		macaroonService:        macService,
+		macaroonServiceStarted: true,
+	}
+
+	sessionsRead := bakery.Op{Entity: "sessions", Action: "read"}
+	accountsRead := bakery.Op{Entity: "account", Action: "read"}
+	swapsRead := bakery.Op{Entity: "swap", Action: "read"}
+
+	// bake returns a hex encoded macaroon with the given permissions and
+	// first party caveats.
+	bake := func(caveats []string, ops ...bakery.Op) string {
+		mac, err := macService.NewMacaroon(
+			ctx, macaroons.DefaultRootKeyID, ops...,
+		)
+		require.NoError(t, err)
+
+		for _, caveat := range caveats {
+			err := mac.M().AddFirstPartyCaveat([]byte(caveat))
+			require.NoError(t, err)
+		}
+
+		macBytes, err := mac.M().MarshalBinary()
+		require.NoError(t, err)
+
+		return hex.EncodeToString(macBytes)
+	}
+
+	expired := checkers.TimeBeforeCaveat(
+		time.Now().Add(-time.Hour),
+	).Condition
+
+	const listSessions = "/litrpc.Sessions/ListSessions"
+
+	tests := []struct {
+		name        string
+		mac         string
+		uri         string
+		expected    *litrpc.CheckPermissionResponse
+		expectedErr string
+	}{{
+		name: "allowed",
+		mac:  bake(nil, sessionsRead),
+		uri:  listSessions,
+		expected: &litrpc.CheckPermissionResponse{
+			Allowed: true,
+		},
+	}, {
+		name: "missing permission",
+		mac:  bake(nil, accountsRead),
+		uri:  listSessions,
+		expected: &litrpc.CheckPermissionResponse{
+			MissingPermissions: []*litrpc.MacaroonPermission{{
+				Entity: sessionsRead.Entity,
+				Action: sessionsRead.Action,
+			}},
+		},
+	}, {
+		name: "blocking caveat",
+		mac:  bake([]string{expired}, sessionsRead),
+		uri:  listSessions,
+		expected: &litrpc.CheckPermissionResponse{
+			BlockingCaveat: expired,
+		},
+	}, {
+		name:        "remote sub-server",
+		mac:         bake(nil, swapsRead),
+		uri:         "/looprpc.SwapClient/ListSwaps",
+		expectedErr: "validated by the remote loop daemon",
+	}, {
+		name:        "unknown URI",
+		mac:         bake(nil, sessionsRead),
+		uri:         "/litrpc.Sessions/Unknown",
+		expectedErr: "unknown URI",
+	}, {
+		name:        "invalid macaroon",
+		mac:         "zz",
+		uri:         listSessions,
+		expectedErr: "unable to parse macaroon",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := g.checkPermission(ctx, test.mac, test.uri)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, test.expected.Allowed, resp.Allowed)
+			require.Equal(
+				t, test.expected.BlockingCaveat,
+				resp.BlockingCaveat,
+			)
+			require.Equal(
+				t, test.expected.MissingPermissions,
+				resp.MissingPermissions,
+			)
+
+			if !resp.Allowed {
+				require.NotEmpty(t, resp.Reason)
+			}
+		})
+	}
+}
@@ -2,11 +2,13 @@ package perms
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/lightningnetwork/lnd"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
@@ -211,6 +213,73 @@ func (pm *Manager) MatchRegexURI(uriRegex string) ([]string, bool) {
 	return matches, true
 }
 
+// PermittedURIs returns all URIs known to the manager that a macaroon with the
+// given permissions is allowed to call, sorted alphabetically. A URI is
+// permitted if the permissions contain all the permissions it requires or if
+// they explicitly allow the URI itself.
+func (pm *Manager) PermittedURIs(ops []bakery.Op) []string {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	granted := make(map[bakery.Op]bool, len(ops))
+	for _, op := range ops {
+		granted[op] = true
+	}
+
+	var uris []string
+	for uri, required := range pm.perms {
+		customURI := bakery.Op{
+			Entity: macaroons.PermissionEntityCustomURI,
+			Action: uri,
+		}
+		if granted[customURI] {
+			uris = append(uris, uri)
+			continue
+		}
+
+		permitted := true
+		for _, op := range required {
+			if !granted[op] {
+				permitted = false
+				break
+			}
+		}
+
+		if permitted {
+			uris = append(uris, uri)
+		}
+	}
+	sort.Strings(uris)
+
+	return uris
+}
+
+// ServiceNames returns the full names of all gRPC services that have at least
+// one method that the manager knows about, for example "lnrpc.Lightning".
+func (pm *Manager) ServiceNames() []string {
+	pm.mu.RLock()
+	defer pm.mu.RUnlock()
+
+	seen := make(map[string]struct{})
+	var names []string
+	for uri := range pm.perms {
+		// A URI has the form "/<package>.<service>/<method>".
+		name, _, ok := strings.Cut(strings.TrimPrefix(uri, "/"), "/")
+		if !ok || name == "" {
+			continue
+		}
+
+		if _, ok := seen[name]; ok {
+			continue
+		}
+
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+
+	return names
+}
+
 // ActivePermissions returns all the available active permissions that the
 // manager is aware of. Optionally, readOnly can be set to true if only the
 // read-only permissions should be returned.
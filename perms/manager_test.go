@@ -85,3 +85,76 @@ func TestMatchRegexURI(t *testing.T) {
 	require.False(t, isRegex)
 	require.Empty(t, uris)
 }
+
+// TestServiceNames tests that the ServiceNames method of the Manager returns
+// each service of the known URIs exactly once.
+func TestServiceNames(t *testing.T) {
+	m := &Manager{
+		perms: map[string][]bakery.Op{
+			"/lnrpc.WalletUnlocker/GenSeed":    {},
+			"/lnrpc.WalletUnlocker/InitWallet": {},
+			"/lnrpc.Lightning/SendCoins": {{
+				Entity: "onchain",
+				Action: "write",
+			}},
+			"/litrpc.Sessions/AddSession": {{
+				Entity: "sessions",
+				Action: "write",
+			}},
+			"invalid": {},
+		},
+	}
+
+	require.ElementsMatch(t, m.ServiceNames(), []string{
+		"lnrpc.WalletUnlocker", "lnrpc.Lightning", "litrpc.Sessions",
+	})
+}
+
+// TestPermittedURIs tests that only the URIs whose required permissions are
+// all granted, or that are granted explicitly, are permitted.
+func TestPermittedURIs(t *testing.T) {
+	m := &Manager{
+		perms: map[string][]bakery.Op{
+			"/lnrpc.WalletUnlocker/GenSeed": {},
+			"/lnrpc.Lightning/SendCoins": {{
+				Entity: "onchain",
+				Action: "write",
+			}},
+			"/lnrpc.Lightning/OpenChannel": {{
+				Entity: "onchain",
+				Action: "write",
+			}, {
+				Entity: "offchain",
+				Action: "write",
+			}},
+			"/litrpc.Sessions/AddSession": {{
+				Entity: "sessions",
+				Action: "write",
+			}},
+		},
+	}
+
+	// Whitelisted URIs are always permitted.
+	require.Equal(
+		t, []string{"/lnrpc.WalletUnlocker/GenSeed"},
+		m.PermittedURIs(nil),
+	)
+
+	// A URI is only permitted if all its permissions are granted.
+	require.Equal(t, []string{
+		"/lnrpc.Lightning/SendCoins",
+		"/lnrpc.WalletUnlocker/GenSeed",
+	}, m.PermittedURIs([]bakery.Op{{
+		Entity: "onchain",
+		Action: "write",
+	}}))
+
+	// A URI can also be granted explicitly.
+	require.Equal(t, []string{
+		"/litrpc.Sessions/AddSession",
+		"/lnrpc.WalletUnlocker/GenSeed",
+	}, m.PermittedURIs([]bakery.Op{{
+		Entity: "uri",
+		Action: "/litrpc.Sessions/AddSession",
+	}}))
+}
@@ -20,6 +20,30 @@ var (
 			Entity: "sessions",
 			Action: "write",
 		}},
+		"/litrpc.Sessions/RevokeSessions": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/UpdateSessionExpiry": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/SubscribeConnectionStatus": {{
+			Entity: "sessions",
+			Action: "read",
+		}},
+		"/litrpc.Sessions/SaveSessionTemplate": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
+		"/litrpc.Sessions/ListSessionTemplates": {{
+			Entity: "sessions",
+			Action: "read",
+		}},
+		"/litrpc.Sessions/DeleteSessionTemplate": {{
+			Entity: "sessions",
+			Action: "write",
+		}},
 		"/litrpc.Accounts/CreateAccount": {{
 			Entity: "account",
 			Action: "write",
@@ -36,6 +60,14 @@ var (
 			Entity: "account",
 			Action: "write",
 		}},
+		"/litrpc.Accounts/TransferAccountBalance": {{
+			Entity: "account",
+			Action: "write",
+		}},
+		"/litrpc.Accounts/RenameAccountLabel": {{
+			Entity: "account",
+			Action: "write",
+		}},
 		"/litrpc.Accounts/ListAccounts": {{
 			Entity: "account",
 			Action: "read",
@@ -48,6 +80,78 @@ var (
 			Entity: "account",
 			Action: "write",
 		}},
+		"/litrpc.Accounts/PurgeExpiredAccounts": {{
+			Entity: "account",
+			Action: "write",
+		}},
+		"/litrpc.Accounts/SubscribePaymentEvents": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/SubscribeAccountLifecycle": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/SubscribeAccountUpdates": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/ExportAccounts": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/ImportAccounts": {{
+			Entity: "account",
+			Action: "write",
+		}},
+		"/litrpc.Accounts/VerifyAccountsStore": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/GetTotalInFlightExposure": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/GetAccountHistory": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/GetAccountLedger": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/EstimateAccountRunway": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/ListAccountGroups": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/AddCreditRule": {{
+			Entity: "account",
+			Action: "write",
+		}},
+		"/litrpc.Accounts/ListCreditRules": {{
+			Entity: "account",
+			Action: "read",
+		}},
+		"/litrpc.Accounts/RemoveCreditRule": {{
+			Entity: "account",
+			Action: "write",
+		}},
+		"/litrpc.Accounts/MintPaymentMacaroon": {{
+			Entity: "account",
+			Action: "write",
+		}},
+		"/litrpc.Accounts/ExportAccountMacaroon": {{
+			Entity: "account",
+			Action: "write",
+		}},
+		"/litrpc.Accounts/RotateAccountMacaroon": {{
+			Entity: "account",
+			Action: "write",
+		}},
 		"/litrpc.Firewall/ListActions": {{
 			Entity: "actions",
 			Action: "read",
@@ -72,6 +176,22 @@ var (
 			Entity: "privacymap",
 			Action: "read",
 		}},
+		"/litrpc.Firewall/ReplayActions": {{
+			Entity: "actions",
+			Action: "read",
+		}},
+		"/litrpc.Firewall/FirewallAuditLog": {{
+			Entity: "actions",
+			Action: "read",
+		}},
+		"/litrpc.Firewall/ExportPrivacyMapping": {{
+			Entity: "privacymap",
+			Action: "export",
+		}},
+		"/litrpc.Firewall/ImportPrivacyMapping": {{
+			Entity: "privacymap",
+			Action: "write",
+		}},
 		"/litrpc.Proxy/StopDaemon": {{
 			Entity: "proxy",
 			Action: "write",
@@ -84,6 +204,51 @@ var (
 			Entity: "supermacaroon",
 			Action: "write",
 		}},
+		"/litrpc.Proxy/RotateRootKey": {{
+			Entity: "proxy",
+			Action: "write",
+		}},
+		"/litrpc.Proxy/WhoAmI": {{
+			Entity: "proxy",
+			Action: "read",
+		}},
+		"/litrpc.Proxy/CheckPermission": {{
+			Entity: "proxy",
+			Action: "read",
+		}},
+		"/litrpc.Proxy/SubscribeEvents": {{
+			Entity: "proxy",
+			Action: "read",
+		}, {
+			Entity: "account",
+			Action: "read",
+		}, {
+			Entity: "sessions",
+			Action: "read",
+		}, {
+			Entity: "actions",
+			Action: "read",
+		}},
+		"/litrpc.Status/StartSubServer": {{
+			Entity: "proxy",
+			Action: "write",
+		}},
+		"/litrpc.Status/StopSubServer": {{
+			Entity: "proxy",
+			Action: "write",
+		}},
+
+		// The gRPC reflection service describes all services that LiT
+		// serves, so it requires the same permission as GetInfo.
+		"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo": {{
+			Entity: "proxy",
+			Action: "read",
+		}},
+		"/grpc.reflection.v1alpha.ServerReflection/" +
+			"ServerReflectionInfo": {{
+			Entity: "proxy",
+			Action: "read",
+		}},
 	}
 
 	// whiteListedLNDMethods is a map of all lnd RPC methods that don't
@@ -105,7 +270,8 @@ var (
 	whiteListedLitMethods = map[string][]bakery.Op{
 		// The Status service must be available at all times, even
 		// before we can check macaroons, so we whitelist it.
-		"/litrpc.Status/SubServerStatus": {},
+		"/litrpc.Status/SubServerStatus":          {},
+		"/litrpc.Status/SubscribeSubServerStatus": {},
 	}
 
 	// lndSubServerNameToTag is a map from the name of an LND subserver to
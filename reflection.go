@@ -0,0 +1,63 @@
+package terminal
+
+import (
+	"github.com/lightninglabs/lightning-terminal/perms"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	v1reflectiongrpc "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	v1alphareflectiongrpc "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// RPCConfig holds the configuration of LiT's gRPC server.
+type RPCConfig struct {
+	EnableReflection bool `long:"enablereflection" description:"Serve the gRPC server reflection service for all services that LiT serves or proxies and whose descriptors are known to LiT, including those of the sub-servers. This allows tools like grpcurl to be used without supplying the proto files. The reflection service requires a macaroon with the same permission as LiT's GetInfo RPC."`
+}
+
+// reflectionServices is a reflection.ServiceInfoProvider that provides the
+// names of all gRPC services that LiT knows the permissions of, which includes
+// the services that are proxied to the sub-servers. Services without a known
+// descriptor are left out, since they can't be described.
+type reflectionServices struct {
+	permsMgr *perms.Manager
+}
+
+// GetServiceInfo returns the names of all services that can be described.
+// Only the names are used by the reflection service.
+//
+// NOTE: This is part of the reflection.ServiceInfoProvider interface.
+func (r *reflectionServices) GetServiceInfo() map[string]grpc.ServiceInfo {
+	services := make(map[string]grpc.ServiceInfo)
+	for _, name := range r.permsMgr.ServiceNames() {
+		_, err := protoregistry.GlobalFiles.FindDescriptorByName(
+			protoreflect.FullName(name),
+		)
+		if err != nil {
+			continue
+		}
+
+		services[name] = grpc.ServiceInfo{}
+	}
+
+	return services
+}
+
+// registerReflection registers both versions of the gRPC server reflection
+// service on the proxy's gRPC server. All calls to it pass through the proxy's
+// interceptors, so they are subject to the same macaroon checks as any other
+// call.
+func (p *rpcProxy) registerReflection() {
+	opts := reflection.ServerOptions{
+		Services:           &reflectionServices{permsMgr: p.permsMgr},
+		DescriptorResolver: protoregistry.GlobalFiles,
+		ExtensionResolver:  protoregistry.GlobalTypes,
+	}
+
+	v1reflectiongrpc.RegisterServerReflectionServer(
+		p.grpcServer, reflection.NewServerV1(opts),
+	)
+	v1alphareflectiongrpc.RegisterServerReflectionServer(
+		p.grpcServer, reflection.NewServer(opts),
+	)
+}
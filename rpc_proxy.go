@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"github.com/lightninglabs/lightning-terminal/events"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
 	litmac "github.com/lightninglabs/lightning-terminal/macaroons"
 	"github.com/lightninglabs/lightning-terminal/perms"
@@ -25,6 +26,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"gopkg.in/macaroon.v2"
 )
 
@@ -37,6 +40,8 @@ const (
 
 	lndBakeMacMethod      = "/lnrpc.Lightning/BakeMacaroon"
 	litBakeSuperMacMethod = "/litrpc.Proxy/BakeSuperMacaroon"
+	litWhoAmIMethod       = "/litrpc.Proxy/WhoAmI"
+	litCheckPermMethod    = "/litrpc.Proxy/CheckPermission"
 )
 
 var (
@@ -73,7 +78,9 @@ func (e *proxyErr) Unwrap() error {
 func newRpcProxy(cfg *Config, validator macaroons.MacaroonValidator,
 	superMacValidator litmac.SuperMacaroonValidator,
 	permsMgr *perms.Manager, subServerMgr *subservers.Manager,
-	statusMgr *litstatus.Manager, getLNDClient lndBasicClientFn) *rpcProxy {
+	statusMgr *litstatus.Manager, getLNDClient lndBasicClientFn,
+	getRootKeyRotator rootKeyRotatorFn, whoAmI whoAmIFn,
+	checkPermission checkPermissionFn, events *events.Broker) *rpcProxy {
 
 	// The gRPC web calls are protected by HTTP basic auth which is defined
 	// by base64(username:password). Because we only have a password, we
@@ -96,6 +103,10 @@ func newRpcProxy(cfg *Config, validator macaroons.MacaroonValidator,
 		subServerMgr:      subServerMgr,
 		statusMgr:         statusMgr,
 		getBasicLNDClient: getLNDClient,
+		getRootKeyRotator: getRootKeyRotator,
+		whoAmI:            whoAmI,
+		checkPermission:   checkPermission,
+		events:            events,
 	}
 	p.grpcServer = grpc.NewServer(
 		// From the grpxProxy doc: This codec is *crucial* to the
@@ -108,6 +119,10 @@ func newRpcProxy(cfg *Config, validator macaroons.MacaroonValidator,
 		),
 	)
 
+	if cfg.RPC != nil && cfg.RPC.EnableReflection {
+		p.registerReflection()
+	}
+
 	// Create the gRPC web proxy that wraps the just created grpcServer and
 	// converts the browser's gRPC web calls into native gRPC.
 	options := []grpcweb.Option{
@@ -172,6 +187,10 @@ type rpcProxy struct {
 	subServerMgr      *subservers.Manager
 	statusMgr         *litstatus.Manager
 	getBasicLNDClient lndBasicClientFn
+	getRootKeyRotator rootKeyRotatorFn
+	whoAmI            whoAmIFn
+	checkPermission   checkPermissionFn
+	events            *events.Broker
 
 	bakeSuperMac bakeSuperMac
 
@@ -194,6 +213,20 @@ type bakeSuperMac func(ctx context.Context, rootKeyID uint32,
 // it is available.
 type lndBasicClientFn func() (lnrpc.LightningClient, error)
 
+// rootKeyRotatorFn can be used to obtain access to the rotator of the root key
+// of LiT's own macaroons if it is available.
+type rootKeyRotatorFn func() (*litmac.RootKeyRotator, error)
+
+// whoAmIFn can be used to describe the privileges of a hex encoded macaroon
+// that was already validated.
+type whoAmIFn func(ctx context.Context,
+	macHex string) (*litrpc.WhoAmIResponse, error)
+
+// checkPermissionFn can be used to check whether a hex encoded macaroon would
+// be authorized to call a URI.
+type checkPermissionFn func(ctx context.Context, macHex,
+	uri string) (*litrpc.CheckPermissionResponse, error)
+
 // Start creates initial connection to lnd.
 func (p *rpcProxy) Start(lndConn *grpc.ClientConn,
 	bakeSuperMac bakeSuperMac) error {
@@ -239,9 +272,19 @@ func (p *rpcProxy) StopDaemon(_ context.Context,
 func (p *rpcProxy) GetInfo(_ context.Context, _ *litrpc.GetInfoRequest) (
 	*litrpc.GetInfoResponse, error) {
 
-	return &litrpc.GetInfoResponse{
+	resp := &litrpc.GetInfoResponse{
 		Version: Version(),
-	}, nil
+	}
+	if p.cfg.Fiat != nil {
+		resp.FiatPriceSource = p.cfg.Fiat.PriceSource
+		resp.FiatCurrency = p.cfg.Fiat.Currency
+	}
+
+	if rotator, err := p.getRootKeyRotator(); err == nil {
+		resp.MacaroonRootKeyId = rotator.CurrentRootKeyID()
+	}
+
+	return resp, nil
 }
 
 // BakeSuperMacaroon bakes a new macaroon that includes permissions for
@@ -266,6 +309,127 @@ func (p *rpcProxy) BakeSuperMacaroon(ctx context.Context,
 	}, nil
 }
 
+// RotateRootKey creates a new root key for LiT's own macaroons and bakes a new
+// LiT macaroon under it. Macaroons that were baked under the previous root key
+// remain valid for the configured grace period.
+//
+// NOTE: this is part of the litrpc.ProxyServiceServer interface.
+func (p *rpcProxy) RotateRootKey(ctx context.Context,
+	_ *litrpc.RotateRootKeyRequest) (*litrpc.RotateRootKeyResponse, error) {
+
+	log.Infof("RotateRootKey rpc request received")
+
+	rotator, err := p.getRootKeyRotator()
+	if err != nil {
+		return nil, err
+	}
+
+	rotation, err := rotator.Rotate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to rotate root key: %w", err)
+	}
+
+	return &litrpc.RotateRootKeyResponse{
+		RootKeyId:         rotation.RootKeyID,
+		PreviousRootKeyId: rotation.PreviousRootKeyID,
+		PreviousExpiry:    rotation.PreviousExpiry.Unix(),
+		Macaroon:          rotation.Macaroon,
+	}, nil
+}
+
+// WhoAmI describes the privileges of the macaroon that the call is made with.
+//
+// NOTE: this is part of the litrpc.ProxyServiceServer interface.
+func (p *rpcProxy) WhoAmI(ctx context.Context, _ *litrpc.WhoAmIRequest) (
+	*litrpc.WhoAmIResponse, error) {
+
+	// The interceptor converts the basic auth of calls from the UI into
+	// the macaroon it validates, but doesn't pass it on to the handler.
+	ctx, err := p.convertBasicAuth(ctx, litWhoAmIMethod, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	macHex, err := macaroons.RawMacaroonFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.whoAmI(ctx, macHex)
+}
+
+// CheckPermission checks whether a macaroon would be authorized to call the
+// given URI. If no macaroon is given, the macaroon of the call is checked.
+//
+// NOTE: this is part of the litrpc.ProxyServiceServer interface.
+func (p *rpcProxy) CheckPermission(ctx context.Context,
+	req *litrpc.CheckPermissionRequest) (*litrpc.CheckPermissionResponse,
+	error) {
+
+	if req.Uri == "" {
+		return nil, fmt.Errorf("a URI must be specified")
+	}
+
+	if len(req.Macaroon) > 0 {
+		return p.checkPermission(
+			ctx, hex.EncodeToString(req.Macaroon), req.Uri,
+		)
+	}
+
+	// The interceptor converts the basic auth of calls from the UI into
+	// the macaroon it validates, but doesn't pass it on to the handler.
+	macCtx, err := p.convertBasicAuth(ctx, litCheckPermMethod, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	macHex, err := macaroons.RawMacaroonFromContext(macCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.checkPermission(ctx, macHex, req.Uri)
+}
+
+// SubscribeEvents streams the events of the accounts, sessions, sub-servers
+// and firewall of litd. If a stream ID is given, the stream resumes after the
+// event with the given sequence number.
+//
+// NOTE: this is part of the litrpc.ProxyServiceServer interface.
+func (p *rpcProxy) SubscribeEvents(req *litrpc.SubscribeEventsRequest,
+	stream litrpc.Proxy_SubscribeEventsServer) error {
+
+	ctx := stream.Context()
+
+	log.Infof("[subscribeevents] categories=%v, stream_id=%s, "+
+		"after_sequence=%d", req.Categories, req.StreamId,
+		req.AfterSequence)
+
+	litEvents, cancel, err := p.events.Subscribe(
+		req.Categories, req.StreamId, req.AfterSequence,
+	)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	for {
+		select {
+		case event, ok := <-litEvents:
+			if !ok {
+				return events.ErrSubscriberLagged
+			}
+
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
 // isHandling checks if the specified request is something to be handled by lnd
 // or any of the attached sub daemons. If true is returned, the call was handled
 // by the RPC proxy and the caller MUST NOT handle it again. If false is
@@ -368,6 +532,11 @@ func (p *rpcProxy) makeDirector(allowLitRPC bool) func(ctx context.Context,
 			)
 		}
 		if handled {
+			outCtx = withDefaultDeadline(
+				ctx, outCtx, requestURI,
+				p.subServerMgr.RemoteTimeout(requestURI),
+			)
+
 			return outCtx, conn, nil
 		}
 
@@ -380,15 +549,67 @@ func (p *rpcProxy) makeDirector(allowLitRPC bool) func(ctx context.Context,
 			)
 		}
 
+		if p.cfg.lndRemote {
+			outCtx = withDefaultDeadline(
+				ctx, outCtx, requestURI,
+				p.cfg.Remote.Lnd.Timeout,
+			)
+		}
+
 		return outCtx, p.lndConn, nil
 	}
 }
 
+// withDefaultDeadline returns the outgoing context of a forwarded call with the
+// given timeout applied as its deadline. The context is returned unchanged if
+// the caller already set a deadline, if the timeout is zero or if the call
+// isn't a unary one, since streaming calls like subscriptions are expected to
+// run for a long time.
+func withDefaultDeadline(ctx, outCtx context.Context, requestURI string,
+	timeout time.Duration) context.Context {
+
+	if timeout == 0 || !isUnaryMethod(requestURI) {
+		return outCtx
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return outCtx
+	}
+
+	outCtx, cancel := context.WithTimeout(outCtx, timeout)
+
+	// The director can't hand the cancel function to the proxy, so we
+	// release the timer once the incoming call completes instead.
+	context.AfterFunc(ctx, cancel)
+
+	return outCtx
+}
+
+// isUnaryMethod returns true if the given request URI belongs to a known gRPC
+// method that neither streams requests nor responses.
+func isUnaryMethod(requestURI string) bool {
+	name := strings.ReplaceAll(strings.TrimPrefix(requestURI, "/"), "/", ".")
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(
+		protoreflect.FullName(name),
+	)
+	if err != nil {
+		return false
+	}
+
+	method, ok := desc.(protoreflect.MethodDescriptor)
+
+	return ok && !method.IsStreamingClient() && !method.IsStreamingServer()
+}
+
 // UnaryServerInterceptor is a gRPC interceptor that checks whether the
 // request is authorized by the included macaroons.
 func (p *rpcProxy) UnaryServerInterceptor(ctx context.Context, req interface{},
-	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{},
-	error) {
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ interface{},
+	err error) {
+
+	defer func() {
+		p.observeRequest(ctx, info.FullMethod, err)
+	}()
 
 	uriPermissions, ok := p.permsMgr.URIPermissions(info.FullMethod)
 	if !ok {
@@ -399,6 +620,13 @@ func (p *rpcProxy) UnaryServerInterceptor(ctx context.Context, req interface{},
 		return nil, err
 	}
 
+	// A call to an integrated sub-server is aborted with a clear error if
+	// the sub-server is stopped while the call is in progress.
+	ctx, callDone := p.subServerMgr.TrackCall(ctx, info.FullMethod)
+	defer func() {
+		err = callDone(err)
+	}()
+
 	// For now, basic authentication is just a quick fix until we
 	// have proper macaroon support implemented in the UI. We allow
 	// gRPC web requests to have it and "convert" the auth into a
@@ -513,7 +741,11 @@ func (p *rpcProxy) UnaryServerInterceptor(ctx context.Context, req interface{},
 // request is authorized by the included macaroons.
 func (p *rpcProxy) StreamServerInterceptor(srv interface{},
 	ss grpc.ServerStream, info *grpc.StreamServerInfo,
-	handler grpc.StreamHandler) error {
+	handler grpc.StreamHandler) (err error) {
+
+	defer func() {
+		p.observeRequest(ss.Context(), info.FullMethod, err)
+	}()
 
 	uriPermissions, ok := p.permsMgr.URIPermissions(info.FullMethod)
 	if !ok {
@@ -524,6 +756,16 @@ func (p *rpcProxy) StreamServerInterceptor(srv interface{},
 		return err
 	}
 
+	// A call to an integrated sub-server is aborted with a clear error if
+	// the sub-server is stopped while the call is in progress.
+	callCtx, callDone := p.subServerMgr.TrackCall(
+		ss.Context(), info.FullMethod,
+	)
+	ss = &trackedServerStream{ServerStream: ss, ctx: callCtx}
+	defer func() {
+		err = callDone(err)
+	}()
+
 	// For now, basic authentication is just a quick fix until we
 	// have proper macaroon support implemented in the UI. We allow
 	// gRPC web requests to have it and "convert" the auth into a
@@ -555,6 +797,21 @@ func (p *rpcProxy) StreamServerInterceptor(srv interface{},
 	return handler(srv, ss)
 }
 
+// trackedServerStream is a server stream whose context is cancelled once the
+// integrated sub-server that handles it is stopped.
+type trackedServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+// Context returns the context of the stream.
+//
+// NOTE: This is part of the grpc.ServerStream interface.
+func (s *trackedServerStream) Context() context.Context {
+	return s.ctx
+}
+
 // convertBasicAuth tries to convert the HTTP authorization header into a
 // macaroon based authentication header.
 func (p *rpcProxy) convertBasicAuth(ctx context.Context,
@@ -713,20 +970,8 @@ func (p *rpcProxy) checkSubSystemStarted(requestURI string) error {
 		return ErrWaitingToStart
 	}
 
-	handled, system := p.subServerMgr.Handles(requestURI)
-	switch {
-	case handled:
-
-	case isAccountsReq(requestURI):
-		system = subservers.ACCOUNTS
-
-	case p.permsMgr.IsSubServerURI(subservers.LIT, requestURI):
-		system = subservers.LIT
-
-	case p.permsMgr.IsSubServerURI(subservers.LND, requestURI):
-		system = subservers.LND
-
-	default:
+	system, ok := p.subServerForURI(requestURI)
+	if !ok {
 		return ErrUnknownRequest
 	}
 
@@ -748,6 +993,30 @@ func (p *rpcProxy) checkSubSystemStarted(requestURI string) error {
 	return nil
 }
 
+// subServerForURI returns the name of the sub-server that handles the given
+// URI. The second return value is false if no sub-server handles it.
+func (p *rpcProxy) subServerForURI(requestURI string) (string, bool) {
+	handled, system := p.subServerMgr.Handles(requestURI)
+	switch {
+	case handled:
+		return system, true
+
+	case isAccountsReq(requestURI):
+		return subservers.ACCOUNTS, true
+
+	case isStatusReq(requestURI) || isProxyReq(requestURI) ||
+		p.permsMgr.IsSubServerURI(subservers.LIT, requestURI):
+
+		return subservers.LIT, true
+
+	case p.permsMgr.IsSubServerURI(subservers.LND, requestURI):
+		return subservers.LND, true
+
+	default:
+		return "", false
+	}
+}
+
 // readMacaroon tries to read the macaroon file at the specified path and create
 // gRPC dial options from it.
 func readMacaroon(macPath string) ([]byte, error) {
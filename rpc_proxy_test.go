@@ -0,0 +1,135 @@
+package terminal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// unaryURI is the URI of a unary lnd call.
+	unaryURI = "/lnrpc.Lightning/GetInfo"
+
+	// streamingURI is the URI of a server streaming lnd call.
+	streamingURI = "/lnrpc.Lightning/SubscribeInvoices"
+)
+
+// TestIsUnaryMethod tests that only known methods that neither stream requests
+// nor responses are reported as unary.
+func TestIsUnaryMethod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		uri      string
+		expected bool
+	}{{
+		name:     "unary",
+		uri:      unaryURI,
+		expected: true,
+	}, {
+		name: "server streaming",
+		uri:  streamingURI,
+	}, {
+		name: "bidirectional streaming",
+		uri:  "/lnrpc.Lightning/SendPayment",
+	}, {
+		name: "unknown method",
+		uri:  "/lnrpc.Lightning/Unknown",
+	}, {
+		name: "service",
+		uri:  "/lnrpc.Lightning",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.Equal(t, test.expected, isUnaryMethod(test.uri))
+		})
+	}
+}
+
+// TestWithDefaultDeadline tests that the default deadline is only applied to
+// unary calls that don't have a deadline set by the caller yet, and that it is
+// released once the incoming call completes.
+func TestWithDefaultDeadline(t *testing.T) {
+	t.Parallel()
+
+	const timeout = time.Minute
+
+	tests := []struct {
+		name             string
+		uri              string
+		timeout          time.Duration
+		callerDeadline   bool
+		expectedDeadline bool
+	}{{
+		name:             "unary call",
+		uri:              unaryURI,
+		timeout:          timeout,
+		expectedDeadline: true,
+	}, {
+		name:           "caller deadline is kept",
+		uri:            unaryURI,
+		timeout:        timeout,
+		callerDeadline: true,
+	}, {
+		name:    "streaming call",
+		uri:     streamingURI,
+		timeout: timeout,
+	}, {
+		name: "zero timeout",
+		uri:  unaryURI,
+	}, {
+		name:    "unknown URI",
+		uri:     "/lnrpc.Lightning/Unknown",
+		timeout: timeout,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			if test.callerDeadline {
+				ctx, cancel = context.WithTimeout(ctx, time.Hour)
+				defer cancel()
+			}
+
+			// The outgoing context is derived from the background
+			// context, just like the one of the proxy director.
+			outCtx := context.Background()
+
+			start := time.Now()
+			newCtx := withDefaultDeadline(
+				ctx, outCtx, test.uri, test.timeout,
+			)
+
+			deadline, ok := newCtx.Deadline()
+			if !test.expectedDeadline {
+				require.False(t, ok)
+				require.Equal(t, outCtx, newCtx)
+
+				return
+			}
+			require.True(t, ok)
+			require.WithinDuration(
+				t, start.Add(test.timeout), deadline,
+				time.Second,
+			)
+
+			// Completing the incoming call releases the deadline of
+			// the outgoing context.
+			require.NoError(t, newCtx.Err())
+			cancel()
+			require.Eventually(t, func() bool {
+				return newCtx.Err() == context.Canceled
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
@@ -14,6 +14,7 @@ const (
 type Config struct {
 	Disabled         bool          `long:"disabled" description:"Disable the RPC middleware"`
 	InterceptTimeout time.Duration `long:"intercept-timeout" description:"The maximum time the RPC middleware is allowed to take for intercepting each RPC request"`
+	HashLogIDs       bool          `long:"hash-log-ids" description:"Replace the account IDs and session IDs that are added to the log output of intercepted requests with a keyed hash. The key is random and only kept in memory, so the log lines of one identifier can be correlated while litd is running, but the logs can't be linked to an identifier without the key, nor across restarts"`
 }
 
 // DefaultConfig returns the default RPC middleware configuration.
@@ -0,0 +1,61 @@
+package rpcmiddleware
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/btcsuite/btclog/v2"
+)
+
+// logIDKeySize is the size of the key that identifiers are hashed with before
+// they are logged.
+const logIDKeySize = 32
+
+// logIDKeyKey is the context key under which the manager stores the key that
+// identifiers that are logged in the scope of a request are hashed with.
+type logIDKeyKey struct{}
+
+// newLogIDKey returns a new random key for hashing logged identifiers.
+func newLogIDKey() []byte {
+	key := make([]byte, logIDKeySize)
+	_, _ = rand.Read(key)
+
+	return key
+}
+
+// withLogIDKey returns a copy of the context that records the key that the
+// identifiers logged in the scope of the request should be hashed with. If the
+// key is empty, the identifiers aren't hashed.
+func withLogIDKey(ctx context.Context, key []byte) context.Context {
+	return context.WithValue(ctx, logIDKeyKey{}, key)
+}
+
+// LogID returns the representation of the given identifier (for example an
+// account ID or session ID) that should be used when logging it in the scope
+// of the request with the given context. If the middleware manager was
+// configured to hash identifiers, the first six bytes of the HMAC-SHA256 of the
+// identifier are returned prefixed with "h:". The HMAC key is only kept in
+// memory, so the same identifier maps to the same value for as long as litd is
+// running, but the value can't be reversed by trying all possible identifiers
+// without knowing the key. Otherwise, the hex encoded identifier is returned.
+func LogID(ctx context.Context, id []byte) string {
+	key, _ := ctx.Value(logIDKeyKey{}).([]byte)
+	if len(key) == 0 {
+		return hex.EncodeToString(id)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(id)
+
+	return "h:" + hex.EncodeToString(mac.Sum(nil)[:6])
+}
+
+// AddLogID returns a copy of the context with the given identifier added to
+// its logging attributes. Every structured log line that is written with the
+// returned context will contain the identifier as returned by LogID.
+func AddLogID(ctx context.Context, key string, id []byte) context.Context {
+	return btclog.WithCtx(ctx, key, LogID(ctx, id))
+}
@@ -0,0 +1,36 @@
+package rpcmiddleware
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLogID tests that identifiers are only hashed if the context carries a
+// key and that the hash depends on that key.
+func TestLogID(t *testing.T) {
+	t.Parallel()
+
+	id := []byte{0x01, 0x02, 0x03, 0x04}
+
+	ctx := context.Background()
+	require.Equal(t, "01020304", LogID(ctx, id))
+
+	plainCtx := withLogIDKey(ctx, nil)
+	require.Equal(t, "01020304", LogID(plainCtx, id))
+
+	key := make([]byte, logIDKeySize)
+	hashCtx := withLogIDKey(ctx, key)
+	hashed := LogID(hashCtx, id)
+	require.Equal(t, "h:268ba34e03bb", hashed)
+	require.NotContains(t, hashed, "01020304")
+
+	// The same identifier always maps to the same value for a given key,
+	// but the value is different for another key.
+	require.Equal(t, hashed, LogID(hashCtx, id))
+
+	otherCtx := withLogIDKey(ctx, newLogIDKey())
+	require.NotEqual(t, hashed, LogID(otherCtx, id))
+	require.Len(t, LogID(otherCtx, id), len(hashed))
+}
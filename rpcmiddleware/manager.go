@@ -5,12 +5,15 @@ import (
 	"sync"
 	"time"
 
+	"github.com/btcsuite/btclog/v2"
 	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
 )
 
 // Manager is the main middleware manager service.
 type Manager struct {
 	interceptTimeout time.Duration
+	logIDKey         []byte
 	lndClient        lndclient.LightningClient
 	interceptors     []RequestInterceptor
 
@@ -21,13 +24,22 @@ type Manager struct {
 	stopOnce    sync.Once
 }
 
-// NewManager returns a new middleware manager.
-func NewManager(interceptTimeout time.Duration,
+// NewManager returns a new middleware manager. If hashLogIDs is true, the
+// identifiers that interceptors add to the logging context of a request are
+// hashed with a random key that is created for the lifetime of the manager
+// before being logged.
+func NewManager(interceptTimeout time.Duration, hashLogIDs bool,
 	lndClient lndclient.LightningClient, errChan chan<- error,
 	interceptors ...RequestInterceptor) *Manager {
 
+	var logIDKey []byte
+	if hashLogIDs {
+		logIDKey = newLogIDKey()
+	}
+
 	return &Manager{
 		interceptTimeout: interceptTimeout,
+		logIDKey:         logIDKey,
 		lndClient:        lndClient,
 		interceptors:     interceptors,
 		mainErrChan:      errChan,
@@ -43,7 +55,7 @@ func (f *Manager) Start(ctx context.Context) error {
 	for _, i := range f.interceptors {
 		errChan, err := f.lndClient.RegisterRPCMiddleware(
 			ctxc, i.Name(), i.CustomCaveatName(), i.ReadOnly(),
-			f.interceptTimeout, i.Intercept,
+			f.interceptTimeout, f.interceptWithLogCtx(i),
 		)
 		if err != nil {
 			cancel()
@@ -88,6 +100,23 @@ func (f *Manager) Start(ctx context.Context) error {
 	return nil
 }
 
+// interceptWithLogCtx returns an interception handler for the given
+// interceptor that adds the request ID to the logging context of each
+// intercepted request before handing it to the interceptor.
+func (f *Manager) interceptWithLogCtx(i RequestInterceptor) func(
+	context.Context, *lnrpc.RPCMiddlewareRequest) (
+	*lnrpc.RPCMiddlewareResponse, error) {
+
+	return func(ctx context.Context, req *lnrpc.RPCMiddlewareRequest) (
+		*lnrpc.RPCMiddlewareResponse, error) {
+
+		ctx = withLogIDKey(ctx, f.logIDKey)
+		ctx = btclog.WithCtx(ctx, "request_id", req.RequestId)
+
+		return i.Intercept(ctx, req)
+	}
+}
+
 // Stop shuts down the middleware manager.
 func (f *Manager) Stop() {
 	f.stopOnce.Do(func() {
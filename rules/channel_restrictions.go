@@ -10,6 +10,7 @@ import (
 	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
 	"github.com/lightninglabs/lightning-terminal/session"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -78,10 +79,20 @@ func (c *ChannelRestrictMgr) NewEnforcer(ctx context.Context, cfg Config,
 		}
 	}
 
+	allowMap := make(map[uint64]bool, len(channels.AllowList))
+	for _, chanID := range channels.AllowList {
+		allowMap[chanID] = true
+		err := c.maybeUpdateChannelMaps(ctx, cfg, chanID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &ChannelRestrictEnforcer{
 		mgr:             c,
 		ChannelRestrict: channels,
 		channelMap:      chanMap,
+		allowMap:        allowMap,
 	}, nil
 }
 
@@ -97,16 +108,25 @@ func (c *ChannelRestrictMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
 		return nil, fmt.Errorf("incorrect RuleValue type")
 	}
 
-	chanIDs := rv.ChannelRestrict.ChannelIds
+	var (
+		chanIDs    = rv.ChannelRestrict.ChannelIds
+		allowedIDs = rv.ChannelRestrict.AllowedChannelIds
+	)
 
-	if len(chanIDs) == 0 {
+	if len(chanIDs) == 0 && len(allowedIDs) == 0 {
 		return nil, fmt.Errorf("channel restrict list cannot be " +
 			"empty. If no channel restrictions should be applied " +
 			"then there is no need to add the rule")
 	}
 
+	if len(chanIDs) != 0 && len(allowedIDs) != 0 {
+		return nil, fmt.Errorf("only one of a channel deny list and " +
+			"a channel allow list can be set")
+	}
+
 	return &ChannelRestrict{
-		DenyList: chanIDs,
+		DenyList:  chanIDs,
+		AllowList: allowedIDs,
 	}, nil
 }
 
@@ -180,6 +200,7 @@ type ChannelRestrictEnforcer struct {
 	mgr *ChannelRestrictMgr
 	*ChannelRestrict
 	channelMap map[uint64]bool
+	allowMap   map[uint64]bool
 }
 
 // HandleRequest checks the validity of a request using the ChannelRestrict
@@ -244,9 +265,107 @@ func (c *ChannelRestrictEnforcer) HandleErrorResponse(_ context.Context,
 	return nil, nil
 }
 
+// checkChannel returns an error if the channel with the given ID may not be
+// acted upon.
+func (c *ChannelRestrictEnforcer) checkChannel(chanID uint64) error {
+	if len(c.allowMap) != 0 {
+		if !c.allowMap[chanID] {
+			return fmt.Errorf("illegal action on channel not in " +
+				"channel allow list")
+		}
+
+		return nil
+	}
+
+	if c.channelMap[chanID] {
+		return fmt.Errorf("illegal action on channel in channel " +
+			"restriction list")
+	}
+
+	return nil
+}
+
+// checkChanPoint returns an error if the channel with the given channel point
+// may not be acted upon.
+func (c *ChannelRestrictEnforcer) checkChanPoint(
+	chanPoint *lnrpc.ChannelPoint) error {
+
+	if chanPoint == nil {
+		return fmt.Errorf("no channel point specified")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	index := chanPoint.GetOutputIndex()
+	point := fmt.Sprintf("%s:%d", txid.String(), index)
+
+	id, ok := c.mgr.getChannelID(point)
+	if !ok {
+		// All the channels of the allow list are known to the manager,
+		// so an unknown channel can't be on it.
+		if len(c.allowMap) != 0 {
+			return fmt.Errorf("illegal action on channel not in " +
+				"channel allow list")
+		}
+
+		return nil
+	}
+
+	return c.checkChannel(id)
+}
+
+// checkOutgoingChans returns an error if a payment doesn't restrict its
+// outgoing channels or if any of them may not be acted upon. Without such a
+// restriction, a payment could be sent over any of our channels.
+func (c *ChannelRestrictEnforcer) checkOutgoingChans(chanIDs ...uint64) error {
+	var restricted bool
+	for _, chanID := range chanIDs {
+		if chanID == 0 {
+			continue
+		}
+
+		if err := c.checkChannel(chanID); err != nil {
+			return err
+		}
+		restricted = true
+	}
+
+	if !restricted {
+		return fmt.Errorf("the outgoing channel must be specified " +
+			"when using a channel restriction list")
+	}
+
+	return nil
+}
+
+// checkRoute returns an error if the first hop of the given route uses a
+// channel that may not be acted upon.
+func (c *ChannelRestrictEnforcer) checkRoute(route *lnrpc.Route) error {
+	if len(route.GetHops()) == 0 {
+		return fmt.Errorf("no route specified")
+	}
+
+	return c.checkOutgoingChans(route.Hops[0].ChanId)
+}
+
 // checkers returns a map of URI to rpcmiddleware.RoundTripChecker which define
 // how the URI should be handled.
 func (c *ChannelRestrictEnforcer) checkers() map[string]mid.RoundTripChecker {
+	checkSendRequest := func(_ context.Context,
+		r *lnrpc.SendRequest) error {
+
+		return c.checkOutgoingChans(r.GetOutgoingChanId())
+	}
+
+	checkSendToRouteRequest := func(_ context.Context,
+		r *lnrpc.SendToRouteRequest) error {
+
+		return c.checkRoute(r.GetRoute())
+	}
+
 	return map[string]mid.RoundTripChecker{
 		"/lnrpc.Lightning/UpdateChannelPolicy": mid.NewRequestChecker(
 			&lnrpc.PolicyUpdateRequest{},
@@ -260,54 +379,93 @@ func (c *ChannelRestrictEnforcer) checkers() map[string]mid.RoundTripChecker {
 						"a channel restriction list")
 				}
 
-				chanPoint := r.GetChanPoint()
-				if chanPoint == nil {
-					return fmt.Errorf("no channel point " +
-						"specified")
-				}
+				return c.checkChanPoint(r.GetChanPoint())
+			},
+		),
+		"/lnrpc.Lightning/CloseChannel": mid.NewRequestChecker(
+			&lnrpc.CloseChannelRequest{},
+			&lnrpc.CloseStatusUpdate{},
+			func(ctx context.Context,
+				r *lnrpc.CloseChannelRequest) error {
 
-				txid, err := lnrpc.GetChanPointFundingTxid(
-					chanPoint,
-				)
-				if err != nil {
-					return err
-				}
+				return c.checkChanPoint(r.GetChannelPoint())
+			},
+		),
+		"/lnrpc.Lightning/AbandonChannel": mid.NewRequestChecker(
+			&lnrpc.AbandonChannelRequest{},
+			&lnrpc.AbandonChannelResponse{},
+			func(ctx context.Context,
+				r *lnrpc.AbandonChannelRequest) error {
 
-				index := chanPoint.GetOutputIndex()
-				point := fmt.Sprintf(
-					"%s:%d", txid.String(), index,
+				return c.checkChanPoint(r.GetChannelPoint())
+			},
+		),
+		"/lnrpc.Lightning/SendPayment": mid.NewRequestChecker(
+			&lnrpc.SendRequest{}, &lnrpc.SendResponse{},
+			checkSendRequest,
+		),
+		"/lnrpc.Lightning/SendPaymentSync": mid.NewRequestChecker(
+			&lnrpc.SendRequest{}, &lnrpc.SendResponse{},
+			checkSendRequest,
+		),
+		"/lnrpc.Lightning/SendToRoute": mid.NewRequestChecker(
+			&lnrpc.SendToRouteRequest{}, &lnrpc.SendResponse{},
+			checkSendToRouteRequest,
+		),
+		"/lnrpc.Lightning/SendToRouteSync": mid.NewRequestChecker(
+			&lnrpc.SendToRouteRequest{}, &lnrpc.SendResponse{},
+			checkSendToRouteRequest,
+		),
+		"/routerrpc.Router/SendPaymentV2": mid.NewRequestChecker(
+			&routerrpc.SendPaymentRequest{}, &lnrpc.Payment{},
+			func(ctx context.Context,
+				r *routerrpc.SendPaymentRequest) error {
+
+				// The deprecated single outgoing channel is
+				// still honored by lnd, so it is checked too.
+				//nolint:staticcheck
+				chanIDs := append(
+					[]uint64{r.GetOutgoingChanId()},
+					r.GetOutgoingChanIds()...,
 				)
 
-				id, ok := c.mgr.getChannelID(point)
-				if !ok {
-					return nil
-				}
-
-				if c.channelMap[id] {
-					return fmt.Errorf("illegal action on " +
-						"channel in channel " +
-						"restriction list")
-				}
+				return c.checkOutgoingChans(chanIDs...)
+			},
+		),
+		"/routerrpc.Router/SendToRouteV2": mid.NewRequestChecker(
+			&routerrpc.SendToRouteRequest{}, &lnrpc.HTLCAttempt{},
+			func(ctx context.Context,
+				r *routerrpc.SendToRouteRequest) error {
 
-				return nil
+				return c.checkRoute(r.GetRoute())
 			},
 		),
 	}
 }
 
 // ChannelRestrict is a rule prevents calls from acting upon a given set of
-// channels.
+// channels, or upon any but a given set of channels.
 type ChannelRestrict struct {
 	// DenyList is a list of SCIDs that should not be acted upon by
 	// any call.
 	DenyList []uint64 `json:"channel_deny_list"`
+
+	// AllowList is a list of SCIDs that are the only ones that may be
+	// acted upon. Only one of DenyList and AllowList can be set.
+	AllowList []uint64 `json:"channel_allow_list,omitempty"`
 }
 
 // VerifySane checks that the value of the values is ok given the min and max
-// allowed values. This is a noop for the ChannelRestrict rule.
+// allowed values. The min and max values are not used for the ChannelRestrict
+// rule, only one of the deny and allow lists may be set.
 //
 // NOTE: this is part of the Values interface.
 func (c *ChannelRestrict) VerifySane(_, _ Values) error {
+	if len(c.DenyList) != 0 && len(c.AllowList) != 0 {
+		return fmt.Errorf("only one of a channel deny list and a " +
+			"channel allow list can be set")
+	}
+
 	return nil
 }
 
@@ -325,7 +483,8 @@ func (c *ChannelRestrict) ToProto() *litrpc.RuleValue {
 	return &litrpc.RuleValue{
 		Value: &litrpc.RuleValue_ChannelRestrict{
 			ChannelRestrict: &litrpc.ChannelRestrict{
-				ChannelIds: c.DenyList,
+				ChannelIds:        c.DenyList,
+				AllowedChannelIds: c.AllowList,
 			},
 		},
 	}
@@ -340,34 +499,53 @@ func (c *ChannelRestrict) PseudoToReal(ctx context.Context,
 	db firewalldb.PrivacyMapDB, flags session.PrivacyFlags) (Values,
 	error) {
 
-	restrictList := make([]uint64, len(c.DenyList))
-
 	// We don't obfuscate the channel IDs if the channel id flag is set.
 	if flags.Contains(session.ClearChanIDs) {
-		copy(restrictList, c.DenyList)
-
-		return &ChannelRestrict{DenyList: restrictList}, nil
+		return &ChannelRestrict{
+			DenyList:  copyChanIDs(c.DenyList),
+			AllowList: copyChanIDs(c.AllowList),
+		}, nil
 	}
 
+	var restrict ChannelRestrict
 	err := db.View(ctx, func(ctx context.Context,
 		tx firewalldb.PrivacyMapTx) error {
 
-		for i, chanID := range c.DenyList {
-			real, err := firewalldb.RevealUint64(ctx, tx, chanID)
-			if err != nil {
-				return err
+		reveal := func(pseudoIDs []uint64) ([]uint64, error) {
+			if pseudoIDs == nil {
+				return nil, nil
+			}
+
+			realIDs := make([]uint64, len(pseudoIDs))
+			for i, chanID := range pseudoIDs {
+				real, err := firewalldb.RevealUint64(
+					ctx, tx, chanID,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				realIDs[i] = real
 			}
 
-			restrictList[i] = real
+			return realIDs, nil
 		}
 
-		return nil
+		var err error
+		restrict.DenyList, err = reveal(c.DenyList)
+		if err != nil {
+			return err
+		}
+
+		restrict.AllowList, err = reveal(c.AllowList)
+
+		return err
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &ChannelRestrict{DenyList: restrictList}, nil
+	return &restrict, nil
 }
 
 // RealToPseudo converts all the real channel IDs into pseudo IDs. It returns a
@@ -379,35 +557,69 @@ func (c *ChannelRestrict) RealToPseudo(_ context.Context,
 	db firewalldb.PrivacyMapReader,
 	flags session.PrivacyFlags) (Values, map[string]string, error) {
 
-	pseudoIDs := make([]uint64, len(c.DenyList))
 	privMapPairs := make(map[string]string)
 
 	// We don't obfuscate the channel IDs if the channel id flag is set.
 	if flags.Contains(session.ClearChanIDs) {
-		copy(pseudoIDs, c.DenyList)
-
-		return &ChannelRestrict{DenyList: pseudoIDs}, privMapPairs, nil
+		return &ChannelRestrict{
+			DenyList:  copyChanIDs(c.DenyList),
+			AllowList: copyChanIDs(c.AllowList),
+		}, privMapPairs, nil
 	}
 
-	for i, c := range c.DenyList {
-		// TODO(elle): check that this channel actually exists
+	hide := func(realIDs []uint64) ([]uint64, error) {
+		if realIDs == nil {
+			return nil, nil
+		}
+
+		pseudoIDs := make([]uint64, len(realIDs))
+		for i, c := range realIDs {
+			// TODO(elle): check that this channel actually exists
+
+			chanID := firewalldb.Uint64ToStr(c)
+			pseudo, ok := pseudoFromReal(db, privMapPairs, chanID)
+			if ok {
+				p, err := firewalldb.StrToUint64(pseudo)
+				if err != nil {
+					return nil, err
+				}
 
-		chanID := firewalldb.Uint64ToStr(c)
-		pseudo, ok := pseudoFromReal(db, privMapPairs, chanID)
-		if ok {
-			p, err := firewalldb.StrToUint64(pseudo)
-			if err != nil {
-				return nil, nil, err
+				pseudoIDs[i] = p
+				continue
 			}
 
-			pseudoIDs[i] = p
-			continue
+			pseudoCp, pseudoCpStr := firewalldb.NewPseudoUint64()
+			privMapPairs[chanID] = pseudoCpStr
+			pseudoIDs[i] = pseudoCp
 		}
 
-		pseudoCp, pseudoCpStr := firewalldb.NewPseudoUint64()
-		privMapPairs[chanID] = pseudoCpStr
-		pseudoIDs[i] = pseudoCp
+		return pseudoIDs, nil
+	}
+
+	denyList, err := hide(c.DenyList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowList, err := hide(c.AllowList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ChannelRestrict{
+		DenyList:  denyList,
+		AllowList: allowList,
+	}, privMapPairs, nil
+}
+
+// copyChanIDs returns a copy of the given list of channel IDs.
+func copyChanIDs(chanIDs []uint64) []uint64 {
+	if chanIDs == nil {
+		return nil
 	}
 
-	return &ChannelRestrict{DenyList: pseudoIDs}, privMapPairs, nil
+	c := make([]uint64, len(chanIDs))
+	copy(c, chanIDs)
+
+	return c
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/lightninglabs/lightning-terminal/session"
 	"github.com/lightninglabs/lndclient"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/stretchr/testify/require"
 )
 
@@ -137,6 +138,162 @@ func TestChannelRestrictCheckRequest(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestChannelRestrictAllowList ensures that the ChannelRestrictEnforcer only
+// allows actions on the channels of an allow list, and that payments and
+// channel closes are checked against the restriction list.
+func TestChannelRestrictAllowList(t *testing.T) {
+	txid1, index1, err := newTXID()
+	require.NoError(t, err)
+
+	txid2, index2, err := newTXID()
+	require.NoError(t, err)
+
+	chanPointStr1 := fmt.Sprintf("%s:%d", hex.EncodeToString(txid1), index1)
+	chanPointStr2 := fmt.Sprintf("%s:%d", hex.EncodeToString(txid2), index2)
+
+	chanID1, _ := firewalldb.NewPseudoUint64()
+	chanID2, _ := firewalldb.NewPseudoUint64()
+
+	ctx := context.Background()
+	mgr := NewChannelRestrictMgr()
+	cfg := &mockLndClient{
+		channels: []lndclient.ChannelInfo{
+			{
+				ChannelID:    chanID1,
+				ChannelPoint: chanPointStr1,
+			},
+			{
+				ChannelID:    chanID2,
+				ChannelPoint: chanPointStr2,
+			},
+		},
+	}
+
+	// Only one of the deny and allow lists can be set.
+	require.Error(t, (&ChannelRestrict{
+		DenyList:  []uint64{chanID1},
+		AllowList: []uint64{chanID2},
+	}).VerifySane(nil, nil))
+
+	enf, err := mgr.NewEnforcer(ctx, cfg, &ChannelRestrict{
+		AllowList: []uint64{chanID1},
+	})
+	require.NoError(t, err)
+
+	chanPoint1 := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+			FundingTxidStr: hex.EncodeToString(txid1),
+		},
+		OutputIndex: index1,
+	}
+	chanPoint2 := &lnrpc.ChannelPoint{
+		FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+			FundingTxidStr: hex.EncodeToString(txid2),
+		},
+		OutputIndex: index2,
+	}
+
+	const (
+		updatePolicyURI = "/lnrpc.Lightning/UpdateChannelPolicy"
+		closeURI        = "/lnrpc.Lightning/CloseChannel"
+		sendSyncURI     = "/lnrpc.Lightning/SendPaymentSync"
+		sendV2URI       = "/routerrpc.Router/SendPaymentV2"
+		routeV2URI      = "/routerrpc.Router/SendToRouteV2"
+	)
+
+	// Policy updates are only allowed for the channel in the allow list.
+	_, err = enf.HandleRequest(ctx, updatePolicyURI,
+		&lnrpc.PolicyUpdateRequest{
+			Scope: &lnrpc.PolicyUpdateRequest_ChanPoint{
+				ChanPoint: chanPoint1,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, updatePolicyURI,
+		&lnrpc.PolicyUpdateRequest{
+			Scope: &lnrpc.PolicyUpdateRequest_ChanPoint{
+				ChanPoint: chanPoint2,
+			},
+		},
+	)
+	require.ErrorContains(t, err, "channel not in channel allow list")
+
+	// The same goes for closing channels.
+	_, err = enf.HandleRequest(ctx, closeURI, &lnrpc.CloseChannelRequest{
+		ChannelPoint: chanPoint1,
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, closeURI, &lnrpc.CloseChannelRequest{
+		ChannelPoint: chanPoint2,
+	})
+	require.ErrorContains(t, err, "channel not in channel allow list")
+
+	// Payments must specify an outgoing channel that is in the allow list.
+	_, err = enf.HandleRequest(ctx, sendSyncURI, &lnrpc.SendRequest{})
+	require.ErrorContains(t, err, "outgoing channel must be specified")
+
+	_, err = enf.HandleRequest(ctx, sendSyncURI, &lnrpc.SendRequest{
+		OutgoingChanId: chanID2,
+	})
+	require.ErrorContains(t, err, "channel not in channel allow list")
+
+	_, err = enf.HandleRequest(ctx, sendSyncURI, &lnrpc.SendRequest{
+		OutgoingChanId: chanID1,
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		OutgoingChanIds: []uint64{chanID1, chanID2},
+	})
+	require.ErrorContains(t, err, "channel not in channel allow list")
+
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		OutgoingChanIds: []uint64{chanID1},
+	})
+	require.NoError(t, err)
+
+	// For routes, the channel of the first hop is checked.
+	routeReq := func(chanID uint64) *routerrpc.SendToRouteRequest {
+		return &routerrpc.SendToRouteRequest{
+			Route: &lnrpc.Route{
+				Hops: []*lnrpc.Hop{
+					{ChanId: chanID},
+					{ChanId: 1234},
+				},
+			},
+		}
+	}
+
+	_, err = enf.HandleRequest(ctx, routeV2URI, routeReq(chanID2))
+	require.ErrorContains(t, err, "channel not in channel allow list")
+
+	_, err = enf.HandleRequest(ctx, routeV2URI, routeReq(chanID1))
+	require.NoError(t, err)
+
+	// With a deny list, payments over the denied channel are rejected
+	// while payments over any other channel are allowed.
+	enf, err = mgr.NewEnforcer(ctx, cfg, &ChannelRestrict{
+		DenyList: []uint64{chanID1},
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		OutgoingChanIds: []uint64{chanID1},
+	})
+	require.ErrorContains(t, err, "channel in channel restriction list")
+
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		OutgoingChanIds: []uint64{chanID2},
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, routeV2URI, routeReq(chanID1))
+	require.ErrorContains(t, err, "channel in channel restriction list")
+}
+
 func newTXID() ([]byte, uint32, error) {
 	var b [32]byte
 	if _, err := rand.Read(b[:]); err != nil {
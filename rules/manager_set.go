@@ -28,9 +28,29 @@ func NewRuleManagerSet() ManagerSet {
 		ChannelRestrictName:  NewChannelRestrictMgr(),
 		PeersRestrictName:    NewPeerRestrictMgr(),
 		ChanConstraintName:   &ChanConstraintMgr{},
+		SessionBudgetName:    &SessionBudgetMgr{},
+		SessionRateLimitName: &SessionRateLimitMgr{},
+		MemoRequiredName:     &MemoRequiredMgr{},
 	}
 }
 
+// sessionRules is the set of rules that apply to all requests of a session,
+// independent of any Autopilot feature, and can be set when a session is
+// created.
+var sessionRules = map[string]bool{
+	SessionBudgetName:    true,
+	SessionRateLimitName: true,
+	ChannelRestrictName:  true,
+	PeersRestrictName:    true,
+	MemoRequiredName:     true,
+}
+
+// IsSessionRule returns true if the rule with the given name applies to all
+// requests of a session and can be set when a session is created.
+func IsSessionRule(name string) bool {
+	return sessionRules[name]
+}
+
 // InitEnforcer gets the appropriate rule Manager for the given name and uses it
 // to create an appropriate rule Enforcer.
 func (m ManagerSet) InitEnforcer(ctx context.Context, cfg Config, name string,
@@ -0,0 +1,321 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Compile-time checks to ensure that MemoRequired, MemoRequiredMgr and
+	// MemoRequiredEnforcer implement the appropriate Manager, Enforcer and
+	// Values interface.
+	_ Manager  = (*MemoRequiredMgr)(nil)
+	_ Enforcer = (*MemoRequiredEnforcer)(nil)
+	_ Values   = (*MemoRequired)(nil)
+)
+
+// MemoRequiredName is the string identifier of the MemoRequiredMgr rule.
+const MemoRequiredName = "memo-required"
+
+// MemoRequiredMgr manages the MemoRequired rule. See docs/memo_required.md for
+// more information on the rule.
+type MemoRequiredMgr struct{}
+
+// Stop cleans up the resources held by the manager.
+//
+// NOTE: This is part of the Manager interface.
+func (m *MemoRequiredMgr) Stop() error {
+	return nil
+}
+
+// NewEnforcer constructs a new MemoRequiredEnforcer rule enforcer using the
+// passed values and config.
+//
+// NOTE: This is part of the Manager interface.
+func (m *MemoRequiredMgr) NewEnforcer(_ context.Context, cfg Config,
+	values Values) (Enforcer, error) {
+
+	memo, ok := values.(*MemoRequired)
+	if !ok {
+		return nil, fmt.Errorf("values must be of type "+
+			"MemoRequired, got %T", values)
+	}
+
+	pattern, err := memo.compile()
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemoRequiredEnforcer{
+		memoRequiredConfig: cfg,
+		MemoRequired:       memo,
+		pattern:            pattern,
+	}, nil
+}
+
+// NewValueFromProto converts the given proto value into a MemoRequired Values
+// object. An error is returned if the pattern isn't a valid regular
+// expression.
+//
+// NOTE: This is part of the Manager interface.
+func (m *MemoRequiredMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
+	error) {
+
+	rv, ok := v.Value.(*litrpc.RuleValue_MemoRequired)
+	if !ok {
+		return nil, fmt.Errorf("incorrect RuleValue type %T", v.Value)
+	}
+
+	memo := &MemoRequired{Pattern: rv.MemoRequired.Pattern}
+	if _, err := memo.compile(); err != nil {
+		return nil, err
+	}
+
+	return memo, nil
+}
+
+// EmptyValue returns a new instance of MemoRequired.
+//
+// NOTE: This is part of the Manager interface.
+func (m *MemoRequiredMgr) EmptyValue() Values {
+	return &MemoRequired{}
+}
+
+// memoRequiredConfig is the config required by MemoRequiredMgr. It can be
+// derived from the main rules Config struct.
+type memoRequiredConfig interface {
+	GetLndClient() lndclient.LightningClient
+}
+
+// MemoRequiredEnforcer enforces requests against a MemoRequired rule.
+type MemoRequiredEnforcer struct {
+	memoRequiredConfig
+	*MemoRequired
+
+	pattern *regexp.Regexp
+}
+
+// HandleRequest checks that the memo of a new invoice or the description of
+// the invoice that is paid matches the pattern of the rule.
+//
+// NOTE: this is part of the Rule interface.
+func (m *MemoRequiredEnforcer) HandleRequest(ctx context.Context, uri string,
+	msg proto.Message) (proto.Message, error) {
+
+	checker, ok := m.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesRequest(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker "+
+			"for URI %s does not accept request of type %v",
+			uri, msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleRequest(ctx, msg)
+}
+
+// HandleResponse handles and possible alters a response. This is a noop for
+// the MemoRequired rule.
+//
+// NOTE: this is part of the Rule interface.
+func (m *MemoRequiredEnforcer) HandleResponse(_ context.Context, _ string,
+	_ proto.Message) (proto.Message, error) {
+
+	return nil, nil
+}
+
+// HandleErrorResponse handles and possible alters an error. This is a noop for
+// the MemoRequired rule.
+//
+// NOTE: this is part of the Enforcer interface.
+func (m *MemoRequiredEnforcer) HandleErrorResponse(_ context.Context,
+	_ string, _ error) (error, error) {
+
+	return nil, nil
+}
+
+// checkers returns a map of URI to rpcmiddleware.RoundTripChecker which define
+// how the URI should be handled.
+func (m *MemoRequiredEnforcer) checkers() map[string]mid.RoundTripChecker {
+	return map[string]mid.RoundTripChecker{
+		"/lnrpc.Lightning/AddInvoice": mid.NewRequestChecker(
+			&lnrpc.Invoice{}, &lnrpc.AddInvoiceResponse{},
+			func(_ context.Context, r *lnrpc.Invoice) error {
+				return m.checkMemo(r.Memo)
+			},
+		),
+		"/invoicesrpc.Invoices/AddHoldInvoice": mid.NewRequestChecker(
+			&invoicesrpc.AddHoldInvoiceRequest{},
+			&invoicesrpc.AddHoldInvoiceResp{},
+			func(_ context.Context,
+				r *invoicesrpc.AddHoldInvoiceRequest) error {
+
+				return m.checkMemo(r.Memo)
+			},
+		),
+		"/lnrpc.Lightning/SendPaymentSync": mid.NewRequestChecker(
+			&lnrpc.SendRequest{}, &lnrpc.SendResponse{},
+			func(ctx context.Context, r *lnrpc.SendRequest) error {
+				return m.checkPayment(ctx, r.PaymentRequest)
+			},
+		),
+		"/routerrpc.Router/SendPaymentV2": mid.NewRequestChecker(
+			&routerrpc.SendPaymentRequest{}, &lnrpc.Payment{},
+			func(ctx context.Context,
+				r *routerrpc.SendPaymentRequest) error {
+
+				return m.checkPayment(ctx, r.PaymentRequest)
+			},
+		),
+		"/routerrpc.Router/SendPayment": mid.NewRequestChecker(
+			&routerrpc.SendPaymentRequest{},
+			&routerrpc.PaymentStatus{},
+			func(ctx context.Context,
+				r *routerrpc.SendPaymentRequest) error {
+
+				return m.checkPayment(ctx, r.PaymentRequest)
+			},
+		),
+
+		// The deprecated streaming payment call can send multiple
+		// payments over a single request, and payments to a route don't
+		// have an invoice with a memo, so they are all denied.
+		"/lnrpc.Lightning/SendPayment": mid.NewRequestDenier(
+			&lnrpc.SendRequest{}, &lnrpc.SendResponse{},
+		),
+		"/lnrpc.Lightning/SendToRoute": mid.NewRequestDenier(
+			&lnrpc.SendToRouteRequest{}, &lnrpc.SendResponse{},
+		),
+		"/lnrpc.Lightning/SendToRouteSync": mid.NewRequestDenier(
+			&lnrpc.SendToRouteRequest{}, &lnrpc.SendResponse{},
+		),
+		"/routerrpc.Router/SendToRouteV2": mid.NewRequestDenier(
+			&routerrpc.SendToRouteRequest{}, &lnrpc.HTLCAttempt{},
+		),
+		"/routerrpc.Router/SendToRoute": mid.NewRequestDenier(
+			&routerrpc.SendToRouteRequest{},
+			&routerrpc.SendToRouteResponse{},
+		),
+	}
+}
+
+// checkPayment checks that the description of the invoice that is paid
+// matches the pattern of the rule. Payments without an invoice, such as
+// keysend payments, don't have a memo and are rejected.
+func (m *MemoRequiredEnforcer) checkPayment(ctx context.Context,
+	invoice string) error {
+
+	if invoice == "" {
+		return m.checkMemo("")
+	}
+
+	payReq, err := m.GetLndClient().DecodePaymentRequest(ctx, invoice)
+	if err != nil {
+		return fmt.Errorf("unable to decode payment request: %w",
+			err)
+	}
+
+	return m.checkMemo(payReq.Description)
+}
+
+// checkMemo checks that the given memo is set and matches the pattern of the
+// rule.
+func (m *MemoRequiredEnforcer) checkMemo(memo string) error {
+	if memo == "" {
+		return fmt.Errorf("a memo matching the pattern '%s' is "+
+			"required", m.Pattern)
+	}
+
+	if !m.pattern.MatchString(memo) {
+		return fmt.Errorf("memo '%s' doesn't match the required "+
+			"pattern '%s'", memo, m.Pattern)
+	}
+
+	return nil
+}
+
+// MemoRequired are the static values that determine the memo that the
+// invoices a session creates or pays must have.
+type MemoRequired struct {
+	// Pattern is a regular expression that the memo must match. If it is
+	// empty, any memo that is set is accepted.
+	Pattern string `json:"pattern"`
+}
+
+// compile compiles the pattern of the rule.
+func (m *MemoRequired) compile() (*regexp.Regexp, error) {
+	pattern, err := regexp.Compile(m.Pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid memo pattern %s: %w",
+			m.Pattern, err)
+	}
+
+	return pattern, nil
+}
+
+// VerifySane checks that the value of the values is ok given the min and max
+// allowed values. The memo rule is set when a session is created and isn't
+// bounded by an Autopilot feature, so only the pattern itself is validated.
+//
+// NOTE: this is part of the Values interface.
+func (m *MemoRequired) VerifySane(_, _ Values) error {
+	_, err := m.compile()
+
+	return err
+}
+
+// RuleName returns the name of the rule that these values are to be used with.
+//
+// NOTE: this is part of the Values interface.
+func (m *MemoRequired) RuleName() string {
+	return MemoRequiredName
+}
+
+// ToProto converts the rule Values to the litrpc counterpart.
+//
+// NOTE: this is part of the Values interface.
+func (m *MemoRequired) ToProto() *litrpc.RuleValue {
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_MemoRequired{
+			MemoRequired: &litrpc.MemoRequired{
+				Pattern: m.Pattern,
+			},
+		},
+	}
+}
+
+// PseudoToReal attempts to convert any appropriate pseudo fields in the rule
+// Values to their corresponding real values. It uses the passed PrivacyMapDB to
+// find the real values. This is a no-op for the MemoRequired rule.
+//
+// NOTE: this is part of the Values interface.
+func (m *MemoRequired) PseudoToReal(_ context.Context,
+	_ firewalldb.PrivacyMapDB, _ session.PrivacyFlags) (Values, error) {
+
+	return m, nil
+}
+
+// RealToPseudo converts the rule Values to a new one that uses pseudo keys,
+// channel IDs, channel points etc. It returns a map of real to pseudo strings
+// that should be persisted. This is a no-op for the MemoRequired rule.
+//
+// NOTE: this is part of the Values interface.
+func (m *MemoRequired) RealToPseudo(_ context.Context,
+	_ firewalldb.PrivacyMapReader, _ session.PrivacyFlags) (Values,
+	map[string]string, error) {
+
+	return m, nil, nil
+}
@@ -0,0 +1,172 @@
+package rules
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMemoRequiredNewValueFromProto tests that invalid patterns are rejected
+// when converting the rule from its proto counterpart.
+func TestMemoRequiredNewValueFromProto(t *testing.T) {
+	mgr := &MemoRequiredMgr{}
+
+	toProto := func(pattern string) *litrpc.RuleValue {
+		return &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_MemoRequired{
+				MemoRequired: &litrpc.MemoRequired{
+					Pattern: pattern,
+				},
+			},
+		}
+	}
+
+	// The pattern must be a valid regular expression.
+	_, err := mgr.NewValueFromProto(toProto("("))
+	require.Error(t, err)
+
+	v, err := mgr.NewValueFromProto(toProto("^order-[0-9]+$"))
+	require.NoError(t, err)
+	require.Equal(t, &MemoRequired{Pattern: "^order-[0-9]+$"}, v)
+
+	// Converting the values back results in the original proto.
+	require.Equal(t, toProto("^order-[0-9]+$"), v.ToProto())
+}
+
+// mockMemoDecoder is a mock lnd client that decodes payment requests to ones
+// with the description they are mapped to.
+type mockMemoDecoder struct {
+	lndclient.LightningClient
+
+	descriptions map[string]string
+}
+
+// DecodePaymentRequest returns a payment request with the description that
+// the given invoice is mapped to.
+func (m *mockMemoDecoder) DecodePaymentRequest(_ context.Context,
+	invoice string) (*lndclient.PaymentRequest, error) {
+
+	description, ok := m.descriptions[invoice]
+	if !ok {
+		return nil, fmt.Errorf("invalid invoice")
+	}
+
+	return &lndclient.PaymentRequest{Description: description}, nil
+}
+
+// TestMemoRequiredCheckRequest checks that invoices and payments are correctly
+// accepted or denied based on their memo.
+func TestMemoRequiredCheckRequest(t *testing.T) {
+	ctx := context.Background()
+
+	cfg := &ConfigImpl{
+		LndClient: &mockMemoDecoder{
+			descriptions: map[string]string{
+				"lnbc1matching":  "order-42",
+				"lnbc1other":     "coffee",
+				"lnbc1emptymemo": "",
+			},
+		},
+	}
+
+	mgr := &MemoRequiredMgr{}
+	enf, err := mgr.NewEnforcer(ctx, cfg, &MemoRequired{
+		Pattern: "^order-[0-9]+$",
+	})
+	require.NoError(t, err)
+
+	const (
+		addInvoiceURI  = "/lnrpc.Lightning/AddInvoice"
+		holdInvoiceURI = "/invoicesrpc.Invoices/AddHoldInvoice"
+		sendSyncURI    = "/lnrpc.Lightning/SendPaymentSync"
+		sendV2URI      = "/routerrpc.Router/SendPaymentV2"
+	)
+
+	// Invoices are checked by their memo.
+	_, err = enf.HandleRequest(ctx, addInvoiceURI, &lnrpc.Invoice{
+		Memo: "order-42",
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, addInvoiceURI, &lnrpc.Invoice{
+		Memo: "coffee",
+	})
+	require.ErrorContains(t, err, "memo 'coffee' doesn't match the "+
+		"required pattern '^order-[0-9]+$'")
+
+	_, err = enf.HandleRequest(ctx, addInvoiceURI, &lnrpc.Invoice{})
+	require.ErrorContains(t, err, "a memo matching the pattern "+
+		"'^order-[0-9]+$' is required")
+
+	_, err = enf.HandleRequest(
+		ctx, holdInvoiceURI, &invoicesrpc.AddHoldInvoiceRequest{
+			Memo: "order-7",
+		},
+	)
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(
+		ctx, holdInvoiceURI, &invoicesrpc.AddHoldInvoiceRequest{},
+	)
+	require.Error(t, err)
+
+	// Payments are checked by the description of the paid invoice.
+	_, err = enf.HandleRequest(ctx, sendSyncURI, &lnrpc.SendRequest{
+		PaymentRequest: "lnbc1matching",
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, sendSyncURI, &lnrpc.SendRequest{
+		PaymentRequest: "lnbc1other",
+	})
+	require.ErrorContains(t, err, "memo 'coffee' doesn't match")
+
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		PaymentRequest: "lnbc1matching",
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		PaymentRequest: "lnbc1emptymemo",
+	})
+	require.ErrorContains(t, err, "is required")
+
+	// Payments without an invoice, such as keysend payments, have no memo.
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		Dest: make([]byte, 33),
+		Amt:  1000,
+	})
+	require.ErrorContains(t, err, "is required")
+
+	// Payments to a route are always denied.
+	_, err = enf.HandleRequest(
+		ctx, "/routerrpc.Router/SendToRouteV2",
+		&routerrpc.SendToRouteRequest{},
+	)
+	require.Error(t, err)
+
+	// Other requests aren't affected.
+	_, err = enf.HandleRequest(
+		ctx, "/lnrpc.Lightning/GetInfo", &lnrpc.GetInfoRequest{},
+	)
+	require.NoError(t, err)
+
+	// With an empty pattern, any memo that is set is accepted.
+	enf, err = mgr.NewEnforcer(ctx, cfg, &MemoRequired{})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, addInvoiceURI, &lnrpc.Invoice{
+		Memo: "coffee",
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, addInvoiceURI, &lnrpc.Invoice{})
+	require.Error(t, err)
+}
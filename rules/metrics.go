@@ -0,0 +1,36 @@
+package rules
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// rateLimitViolations counts the requests that were rejected because
+	// they exceeded a session rate limit, labeled by the method pattern of
+	// the limit. The metric is registered with the default prometheus
+	// registry, which lnd exposes if it is built with monitoring support
+	// and litd runs in integrated mode.
+	rateLimitViolations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "litd",
+		Subsystem: "firewall",
+		Name:      "session_rate_limit_violations_total",
+		Help: "Number of requests that were rejected because they " +
+			"exceeded a session rate limit.",
+	}, []string{"pattern"})
+
+	metricsOnce sync.Once
+)
+
+// registerMetrics registers the metrics of the rules package with the default
+// prometheus registry. It is safe to call multiple times.
+func registerMetrics() {
+	metricsOnce.Do(func() {
+		err := prometheus.Register(rateLimitViolations)
+		if err != nil {
+			log.Warnf("Unable to register session rate limit "+
+				"metrics: %v", err)
+		}
+	})
+}
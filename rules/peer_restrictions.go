@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/lightninglabs/lightning-terminal/firewalldb"
@@ -70,11 +71,20 @@ func (c *PeerRestrictMgr) NewEnforcer(ctx context.Context, cfg Config,
 		}
 	}
 
+	allowMap := make(map[string]bool, len(peers.AllowList))
+	for _, peerID := range peers.AllowList {
+		allowMap[peerID] = true
+		if err := c.maybeUpdateMaps(ctx, cfg, peerID); err != nil {
+			return nil, err
+		}
+	}
+
 	return &PeerRestrictEnforcer{
 		cfg:          cfg,
 		mgr:          c,
 		PeerRestrict: peers,
 		peerMap:      peerMap,
+		allowMap:     allowMap,
 	}, nil
 }
 
@@ -90,16 +100,25 @@ func (c *PeerRestrictMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
 		return nil, fmt.Errorf("incorrect RuleValue type")
 	}
 
-	peerIDs := rv.PeerRestrict.PeerIds
+	var (
+		peerIDs    = rv.PeerRestrict.PeerIds
+		allowedIDs = rv.PeerRestrict.AllowedPeerIds
+	)
 
-	if len(peerIDs) == 0 {
+	if len(peerIDs) == 0 && len(allowedIDs) == 0 {
 		return nil, fmt.Errorf("peer restrict list cannot be " +
 			"empty. If no channel restrictions should be applied " +
 			"then there is no need to add the rule")
 	}
 
+	if len(peerIDs) != 0 && len(allowedIDs) != 0 {
+		return nil, fmt.Errorf("only one of a peer deny list and a " +
+			"peer allow list can be set")
+	}
+
 	return &PeerRestrict{
-		DenyList: peerIDs,
+		DenyList:  peerIDs,
+		AllowList: allowedIDs,
 	}, nil
 }
 
@@ -187,7 +206,8 @@ type PeerRestrictEnforcer struct {
 	cfg peerRestrictCfg
 	*PeerRestrict
 
-	peerMap map[string]bool
+	peerMap  map[string]bool
+	allowMap map[string]bool
 }
 
 // HandleRequest checks the validity of a request using the PeerRestrict
@@ -252,19 +272,67 @@ func (c *PeerRestrictEnforcer) HandleErrorResponse(_ context.Context,
 	return nil, nil
 }
 
+// checkPeerID returns an error if the peer with the given ID may not be acted
+// upon.
+func (c *PeerRestrictEnforcer) checkPeerID(peerID string) error {
+	if len(c.allowMap) != 0 {
+		if !c.allowMap[peerID] {
+			return fmt.Errorf("illegal action on peer not in peer " +
+				"allow list")
+		}
+
+		return nil
+	}
+
+	if c.peerMap[peerID] {
+		return fmt.Errorf("illegal action on peer in peer " +
+			"restriction list")
+	}
+
+	return nil
+}
+
+// checkChanPoint returns an error if the peer of the channel with the given
+// channel point may not be acted upon.
+func (c *PeerRestrictEnforcer) checkChanPoint(ctx context.Context,
+	chanPoint *lnrpc.ChannelPoint) error {
+
+	if chanPoint == nil {
+		return fmt.Errorf("no channel point specified")
+	}
+
+	txid, err := lnrpc.GetChanPointFundingTxid(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	index := chanPoint.GetOutputIndex()
+	point := fmt.Sprintf("%s:%d", txid, index)
+
+	peerID, ok, err := c.mgr.getPeerFromChanPoint(ctx, c.cfg, point)
+	if err != nil {
+		return err
+	} else if !ok {
+		// If the peer of the channel is unknown, it can't be on the
+		// allow list.
+		if len(c.allowMap) != 0 {
+			return fmt.Errorf("illegal action on peer not in " +
+				"peer allow list")
+		}
+
+		return nil
+	}
+
+	return c.checkPeerID(peerID)
+}
+
 // checkers returns a map of URI to rpcmiddleware.RoundTripChecker which define
 // how the URI should be handled.
 func (c *PeerRestrictEnforcer) checkers() map[string]mid.RoundTripChecker {
 	// checkPeer is a helper function which checks if the given request
 	// is allowed to be executed on the peer.
 	checkPeer := func(req ChanOpenReq) error {
-		pubkey := hex.EncodeToString(req.GetNodePubkey())
-		if c.peerMap[pubkey] {
-			return fmt.Errorf("illegal action on peer in peer " +
-				"restriction list")
-		}
-
-		return nil
+		return c.checkPeerID(hex.EncodeToString(req.GetNodePubkey()))
 	}
 
 	return map[string]mid.RoundTripChecker{
@@ -280,38 +348,16 @@ func (c *PeerRestrictEnforcer) checkers() map[string]mid.RoundTripChecker {
 						"a peer restriction list")
 				}
 
-				chanPoint := r.GetChanPoint()
-				if chanPoint == nil {
-					return fmt.Errorf("no channel point " +
-						"specified")
-				}
-
-				txid, err := lnrpc.GetChanPointFundingTxid(
-					chanPoint,
-				)
-				if err != nil {
-					return err
-				}
-
-				index := chanPoint.GetOutputIndex()
-				point := fmt.Sprintf("%s:%d", txid, index)
-
-				peerID, ok, err := c.mgr.getPeerFromChanPoint(
-					ctx, c.cfg, point,
-				)
-				if err != nil {
-					return err
-				} else if !ok {
-					return nil
-				}
-
-				if c.peerMap[peerID] {
-					return fmt.Errorf("illegal action on " +
-						"peer in peer restriction " +
-						"list")
-				}
+				return c.checkChanPoint(ctx, r.GetChanPoint())
+			},
+		),
+		"/lnrpc.Lightning/OpenChannel": mid.NewRequestChecker(
+			&lnrpc.OpenChannelRequest{},
+			&lnrpc.OpenStatusUpdate{},
+			func(_ context.Context,
+				r *lnrpc.OpenChannelRequest) error {
 
-				return nil
+				return checkPeer(r)
 			},
 		),
 		"/lnrpc.Lightning/OpenChannelSync": mid.NewRequestChecker(
@@ -338,21 +384,75 @@ func (c *PeerRestrictEnforcer) checkers() map[string]mid.RoundTripChecker {
 				return nil
 			},
 		),
+		"/lnrpc.Lightning/CloseChannel": mid.NewRequestChecker(
+			&lnrpc.CloseChannelRequest{},
+			&lnrpc.CloseStatusUpdate{},
+			func(ctx context.Context,
+				r *lnrpc.CloseChannelRequest) error {
+
+				return c.checkChanPoint(ctx, r.GetChannelPoint())
+			},
+		),
+		"/lnrpc.Lightning/AbandonChannel": mid.NewRequestChecker(
+			&lnrpc.AbandonChannelRequest{},
+			&lnrpc.AbandonChannelResponse{},
+			func(ctx context.Context,
+				r *lnrpc.AbandonChannelRequest) error {
+
+				return c.checkChanPoint(ctx, r.GetChannelPoint())
+			},
+		),
+		"/lnrpc.Lightning/ConnectPeer": mid.NewRequestChecker(
+			&lnrpc.ConnectPeerRequest{},
+			&lnrpc.ConnectPeerResponse{},
+			func(_ context.Context,
+				r *lnrpc.ConnectPeerRequest) error {
+
+				if r.GetAddr() == nil {
+					return fmt.Errorf("no peer address " +
+						"specified")
+				}
+
+				return c.checkPeerID(
+					strings.ToLower(r.Addr.Pubkey),
+				)
+			},
+		),
+		"/lnrpc.Lightning/DisconnectPeer": mid.NewRequestChecker(
+			&lnrpc.DisconnectPeerRequest{},
+			&lnrpc.DisconnectPeerResponse{},
+			func(_ context.Context,
+				r *lnrpc.DisconnectPeerRequest) error {
+
+				return c.checkPeerID(strings.ToLower(r.PubKey))
+			},
+		),
 	}
 }
 
-// PeerRestrict is a rule prevents calls from acting upon a given set of peers.
+// PeerRestrict is a rule prevents calls from acting upon a given set of peers,
+// or upon any but a given set of peers.
 type PeerRestrict struct {
 	// DenyList is a list of peer ids that should not be acted upon by any
 	// call.
 	DenyList []string `json:"peer_deny_list"`
+
+	// AllowList is a list of peer ids that are the only ones that may be
+	// acted upon. Only one of DenyList and AllowList can be set.
+	AllowList []string `json:"peer_allow_list,omitempty"`
 }
 
 // VerifySane checks that the value of the values is ok given the min and max
-// allowed values. This is a noop for the PeerRestrict rule.
+// allowed values. The min and max values are not used for the PeerRestrict
+// rule, only one of the deny and allow lists may be set.
 //
 // NOTE: this is part of the Values interface.
 func (c *PeerRestrict) VerifySane(_, _ Values) error {
+	if len(c.DenyList) != 0 && len(c.AllowList) != 0 {
+		return fmt.Errorf("only one of a peer deny list and a peer " +
+			"allow list can be set")
+	}
+
 	return nil
 }
 
@@ -370,7 +470,8 @@ func (c *PeerRestrict) ToProto() *litrpc.RuleValue {
 	return &litrpc.RuleValue{
 		Value: &litrpc.RuleValue_PeerRestrict{
 			PeerRestrict: &litrpc.PeerRestrict{
-				PeerIds: c.DenyList,
+				PeerIds:        c.DenyList,
+				AllowedPeerIds: c.AllowList,
 			},
 		},
 	}
@@ -385,37 +486,54 @@ func (c *PeerRestrict) PseudoToReal(ctx context.Context,
 	db firewalldb.PrivacyMapDB, flags session.PrivacyFlags) (Values,
 	error) {
 
-	restrictList := make([]string, len(c.DenyList))
-
 	// We don't obfuscate if the clear pubkeys flag is set.
 	if flags.Contains(session.ClearPubkeys) {
-		copy(restrictList, c.DenyList)
-
-		return &PeerRestrict{DenyList: restrictList}, nil
+		return &PeerRestrict{
+			DenyList:  copyPeerIDs(c.DenyList),
+			AllowList: copyPeerIDs(c.AllowList),
+		}, nil
 	}
 
+	var restrict PeerRestrict
 	err := db.View(ctx, func(ctx context.Context,
 		tx firewalldb.PrivacyMapTx) error {
 
-		for i, peerPubKey := range c.DenyList {
-			real, err := firewalldb.RevealString(
-				ctx, tx, peerPubKey,
-			)
-			if err != nil {
-				return err
+		reveal := func(pseudoIDs []string) ([]string, error) {
+			if pseudoIDs == nil {
+				return nil, nil
 			}
 
-			restrictList[i] = real
+			realIDs := make([]string, len(pseudoIDs))
+			for i, peerPubKey := range pseudoIDs {
+				real, err := firewalldb.RevealString(
+					ctx, tx, peerPubKey,
+				)
+				if err != nil {
+					return nil, err
+				}
+
+				realIDs[i] = real
+			}
+
+			return realIDs, nil
 		}
 
-		return nil
+		var err error
+		restrict.DenyList, err = reveal(c.DenyList)
+		if err != nil {
+			return err
+		}
+
+		restrict.AllowList, err = reveal(c.AllowList)
+
+		return err
 	},
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &PeerRestrict{DenyList: restrictList}, nil
+	return &restrict, nil
 }
 
 // RealToPseudo converts all the real peer IDs into pseudo IDs. It returns a map
@@ -427,36 +545,70 @@ func (c *PeerRestrict) RealToPseudo(_ context.Context,
 	db firewalldb.PrivacyMapReader,
 	flags session.PrivacyFlags) (Values, map[string]string, error) {
 
-	pseudoIDs := make([]string, len(c.DenyList))
 	privMapPairs := make(map[string]string)
 
 	// We don't obfuscate if the clear pubkeys flag is set.
 	if flags.Contains(session.ClearPubkeys) {
-		copy(pseudoIDs, c.DenyList)
-
-		return &PeerRestrict{DenyList: pseudoIDs}, privMapPairs, nil
+		return &PeerRestrict{
+			DenyList:  copyPeerIDs(c.DenyList),
+			AllowList: copyPeerIDs(c.AllowList),
+		}, privMapPairs, nil
 	}
 
-	for i, id := range c.DenyList {
-		// TODO(elle): check that this peer is actually one of our
-		//  channel peers.
+	hide := func(realIDs []string) ([]string, error) {
+		if realIDs == nil {
+			return nil, nil
+		}
+
+		pseudoIDs := make([]string, len(realIDs))
+		for i, id := range realIDs {
+			// TODO(elle): check that this peer is actually one of
+			//  our channel peers.
+
+			pseudo, ok := pseudoFromReal(db, privMapPairs, id)
+			if ok {
+				pseudoIDs[i] = pseudo
+				continue
+			}
+
+			pseudo, err := firewalldb.NewPseudoStr(len(id))
+			if err != nil {
+				return nil, err
+			}
 
-		pseudo, ok := pseudoFromReal(db, privMapPairs, id)
-		if ok {
+			privMapPairs[id] = pseudo
 			pseudoIDs[i] = pseudo
-			continue
 		}
 
-		pseudo, err := firewalldb.NewPseudoStr(len(id))
-		if err != nil {
-			return nil, nil, err
-		}
+		return pseudoIDs, nil
+	}
+
+	denyList, err := hide(c.DenyList)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	allowList, err := hide(c.AllowList)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		privMapPairs[id] = pseudo
-		pseudoIDs[i] = pseudo
+	return &PeerRestrict{
+		DenyList:  denyList,
+		AllowList: allowList,
+	}, privMapPairs, nil
+}
+
+// copyPeerIDs returns a copy of the given list of peer IDs.
+func copyPeerIDs(peerIDs []string) []string {
+	if peerIDs == nil {
+		return nil
 	}
 
-	return &PeerRestrict{DenyList: pseudoIDs}, privMapPairs, nil
+	c := make([]string, len(peerIDs))
+	copy(c, peerIDs)
+
+	return c
 }
 
 // pseudoFromReal is a helper that can be used to get the associated pseudo
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -200,6 +201,191 @@ func TestPeerRestrictCheckRequest(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// TestPeerRestrictAllowList ensures that the PeerRestrictEnforcer only allows
+// opening, closing and connecting to the peers of an allow list, and that it
+// can be combined with a channel restriction.
+func TestPeerRestrictAllowList(t *testing.T) {
+	txid1, index1, err := newTXID()
+	require.NoError(t, err)
+
+	txid2, index2, err := newTXID()
+	require.NoError(t, err)
+
+	chanPointStr1 := fmt.Sprintf("%s:%d", hex.EncodeToString(txid1), index1)
+	chanPointStr2 := fmt.Sprintf("%s:%d", hex.EncodeToString(txid2), index2)
+
+	chanID1, _ := firewalldb.NewPseudoUint64()
+	chanID2, _ := firewalldb.NewPseudoUint64()
+
+	peerID1, err := firewalldb.NewPseudoStr(66)
+	require.NoError(t, err)
+
+	peerID2, err := firewalldb.NewPseudoStr(66)
+	require.NoError(t, err)
+
+	peerKey1, err := route.NewVertexFromStr(peerID1)
+	require.NoError(t, err)
+
+	peerKey2, err := route.NewVertexFromStr(peerID2)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	cfg := &mockLndClient{
+		channels: []lndclient.ChannelInfo{
+			{
+				ChannelID:    chanID1,
+				ChannelPoint: chanPointStr1,
+				PubKeyBytes:  peerKey1,
+			},
+			{
+				ChannelID:    chanID2,
+				ChannelPoint: chanPointStr2,
+				PubKeyBytes:  peerKey2,
+			},
+		},
+	}
+
+	// Only one of the deny and allow lists can be set.
+	require.Error(t, (&PeerRestrict{
+		DenyList:  []string{peerID1},
+		AllowList: []string{peerID2},
+	}).VerifySane(nil, nil))
+
+	enf, err := NewPeerRestrictMgr().NewEnforcer(ctx, cfg, &PeerRestrict{
+		AllowList: []string{peerID1},
+	})
+	require.NoError(t, err)
+
+	const (
+		openURI       = "/lnrpc.Lightning/OpenChannel"
+		openSyncURI   = "/lnrpc.Lightning/OpenChannelSync"
+		closeURI      = "/lnrpc.Lightning/CloseChannel"
+		connectURI    = "/lnrpc.Lightning/ConnectPeer"
+		disconnectURI = "/lnrpc.Lightning/DisconnectPeer"
+	)
+
+	// Opening a channel is only allowed to the peer in the allow list.
+	for _, uri := range []string{openURI, openSyncURI} {
+		_, err = enf.HandleRequest(ctx, uri, &lnrpc.OpenChannelRequest{
+			NodePubkey: peerKey1[:],
+		})
+		require.NoError(t, err)
+
+		_, err = enf.HandleRequest(ctx, uri, &lnrpc.OpenChannelRequest{
+			NodePubkey: peerKey2[:],
+		})
+		require.ErrorContains(t, err, "peer not in peer allow list")
+	}
+
+	// Closing a channel is only allowed for channels with the peer in the
+	// allow list.
+	closeReq := func(txid []byte,
+		index uint32) *lnrpc.CloseChannelRequest {
+
+		return &lnrpc.CloseChannelRequest{
+			ChannelPoint: &lnrpc.ChannelPoint{
+				FundingTxid: &lnrpc.ChannelPoint_FundingTxidStr{
+					FundingTxidStr: hex.EncodeToString(
+						txid,
+					),
+				},
+				OutputIndex: index,
+			},
+		}
+	}
+
+	_, err = enf.HandleRequest(ctx, closeURI, closeReq(txid1, index1))
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, closeURI, closeReq(txid2, index2))
+	require.ErrorContains(t, err, "peer not in peer allow list")
+
+	// Channels that are unknown can't be closed either.
+	txid3, index3, err := newTXID()
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, closeURI, closeReq(txid3, index3))
+	require.ErrorContains(t, err, "peer not in peer allow list")
+
+	// Connecting to and disconnecting from peers is only allowed for the
+	// peer in the allow list, regardless of the case of its pubkey.
+	_, err = enf.HandleRequest(ctx, connectURI, &lnrpc.ConnectPeerRequest{
+		Addr: &lnrpc.LightningAddress{
+			Pubkey: strings.ToUpper(peerID1),
+			Host:   "localhost:9735",
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, connectURI, &lnrpc.ConnectPeerRequest{
+		Addr: &lnrpc.LightningAddress{
+			Pubkey: peerID2,
+			Host:   "localhost:9735",
+		},
+	})
+	require.ErrorContains(t, err, "peer not in peer allow list")
+
+	_, err = enf.HandleRequest(ctx, connectURI, &lnrpc.ConnectPeerRequest{})
+	require.ErrorContains(t, err, "no peer address specified")
+
+	_, err = enf.HandleRequest(
+		ctx, disconnectURI, &lnrpc.DisconnectPeerRequest{
+			PubKey: peerID2,
+		},
+	)
+	require.ErrorContains(t, err, "peer not in peer allow list")
+
+	// With a deny list, only the denied peer is rejected.
+	enf, err = NewPeerRestrictMgr().NewEnforcer(ctx, cfg, &PeerRestrict{
+		DenyList: []string{peerID1},
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleRequest(ctx, connectURI, &lnrpc.ConnectPeerRequest{
+		Addr: &lnrpc.LightningAddress{
+			Pubkey: peerID1,
+			Host:   "localhost:9735",
+		},
+	})
+	require.ErrorContains(t, err, "peer in peer restriction list")
+
+	_, err = enf.HandleRequest(ctx, closeURI, closeReq(txid2, index2))
+	require.NoError(t, err)
+
+	// A peer and a channel restriction can both be active, in which case
+	// a request has to pass both of them.
+	peerEnf, err := NewPeerRestrictMgr().NewEnforcer(
+		ctx, cfg, &PeerRestrict{AllowList: []string{peerID1, peerID2}},
+	)
+	require.NoError(t, err)
+
+	chanEnf, err := NewChannelRestrictMgr().NewEnforcer(
+		ctx, cfg, &ChannelRestrict{AllowList: []uint64{chanID1}},
+	)
+	require.NoError(t, err)
+
+	handle := func(req *lnrpc.CloseChannelRequest) error {
+		for _, enf := range []Enforcer{peerEnf, chanEnf} {
+			_, err := enf.HandleRequest(ctx, closeURI, req)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	require.NoError(t, handle(closeReq(txid1, index1)))
+	require.ErrorContains(
+		t, handle(closeReq(txid2, index2)),
+		"channel not in channel allow list",
+	)
+	require.ErrorContains(
+		t, handle(closeReq(txid3, index3)),
+		"peer not in peer allow list",
+	)
+}
+
 // TestPeerRestrictionRealToPseudo tests that the PeerRestriction's RealToPseudo
 // method correctly determines which real strings to generate pseudo pairs for
 // based on the privacy map db passed to it.
@@ -0,0 +1,623 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	mid "github.com/lightninglabs/lightning-terminal/rpcmiddleware"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Compile-time checks to ensure that SessionBudget, SessionBudgetMgr
+	// and SessionBudgetEnforcer implement the appropriate Manager, Enforcer
+	// and Values interface.
+	_ Manager  = (*SessionBudgetMgr)(nil)
+	_ Enforcer = (*SessionBudgetEnforcer)(nil)
+	_ Values   = (*SessionBudget)(nil)
+)
+
+const (
+	// SessionBudgetName is the string identifier of the SessionBudgetMgr
+	// rule.
+	SessionBudgetName = "session-budget"
+
+	// sessionSpentKey is the key that will be used in the persisted KV
+	// store to store the total amount that has been spent in msat.
+	sessionSpentKey = "session-spent-amt-msat"
+
+	// sessionPendingKey is the key that will be used in the persisted KV
+	// store to keep track of the total pending amount in msat.
+	sessionPendingKey = "session-pending-amt-msat"
+)
+
+// SessionBudgetMgr manages the SessionBudget rule. See
+// docs/session_budget.md for more information on the rule.
+type SessionBudgetMgr struct {
+	// The mutex is used to ensure that only one Enforcer created by the
+	// manager can run the HandleRequest and HandleResponse functions at
+	// any given time. This prevents db entry race conditions.
+	sync.Mutex
+}
+
+// Stop cleans up the resources held by the manager.
+//
+// NOTE: This is part of the Manager interface.
+func (s *SessionBudgetMgr) Stop() error {
+	return nil
+}
+
+// NewEnforcer constructs a new SessionBudgetEnforcer rule enforcer using the
+// passed values and config.
+//
+// NOTE: This is part of the Manager interface.
+func (s *SessionBudgetMgr) NewEnforcer(_ context.Context, cfg Config,
+	values Values) (Enforcer, error) {
+
+	budget, ok := values.(*SessionBudget)
+	if !ok {
+		return nil, fmt.Errorf("values must be of type "+
+			"SessionBudget, got %T", values)
+	}
+
+	return &SessionBudgetEnforcer{
+		sessionBudgetConfig: cfg,
+		SessionBudget:       budget,
+		SessionBudgetMgr:    s,
+	}, nil
+}
+
+// NewValueFromProto converts the given proto value into a SessionBudget Values
+// object.
+//
+// NOTE: This is part of the Manager interface.
+func (s *SessionBudgetMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
+	error) {
+
+	rv, ok := v.Value.(*litrpc.RuleValue_SessionBudget)
+	if !ok {
+		return nil, fmt.Errorf("incorrect RuleValue type %T", v.Value)
+	}
+
+	return &SessionBudget{
+		AbsoluteAmtSats: rv.SessionBudget.AbsoluteAmtSats,
+	}, nil
+}
+
+// EmptyValue returns a new instance of SessionBudget.
+//
+// NOTE: This is part of the Manager interface.
+func (s *SessionBudgetMgr) EmptyValue() Values {
+	return &SessionBudget{}
+}
+
+// sessionBudgetConfig is the config required by SessionBudgetMgr. It can be
+// derived from the main rules Config struct.
+type sessionBudgetConfig interface {
+	GetStores() firewalldb.KVStores
+	GetReqID() int64
+	GetLndConnID() string
+	GetLndClient() lndclient.LightningClient
+}
+
+// SessionBudgetEnforcer enforces requests and responses against a
+// SessionBudget rule.
+type SessionBudgetEnforcer struct {
+	sessionBudgetConfig
+	*SessionBudget
+	*SessionBudgetMgr
+}
+
+// HandleRequest checks the validity of a request using the SessionBudgetMgr
+// rpcmiddleware.RoundTripCheckers.
+//
+// NOTE: this is part of the Rule interface.
+func (s *SessionBudgetEnforcer) HandleRequest(ctx context.Context, uri string,
+	msg proto.Message) (proto.Message, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	checker, ok := s.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesRequest(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker "+
+			"for URI %s does not accept request of type %v",
+			uri, msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleRequest(ctx, msg)
+}
+
+// HandleResponse handles and possible alters a response.
+//
+// NOTE: this is part of the Rule interface.
+func (s *SessionBudgetEnforcer) HandleResponse(ctx context.Context,
+	uri string, msg proto.Message) (proto.Message, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	checker, ok := s.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	if !checker.HandlesResponse(msg.ProtoReflect().Type()) {
+		return nil, fmt.Errorf("invalid implementation, checker for "+
+			"URI %s does not accept response of type %v", uri,
+			msg.ProtoReflect().Type())
+	}
+
+	return checker.HandleResponse(ctx, msg)
+}
+
+// HandleErrorResponse handles and possible alters an error. This is used to
+// release the amount that was reserved for the failed payment.
+//
+// NOTE: this is part of the Enforcer interface.
+func (s *SessionBudgetEnforcer) HandleErrorResponse(ctx context.Context,
+	uri string, respErr error) (error, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	checker, ok := s.checkers()[uri]
+	if !ok {
+		return nil, nil
+	}
+
+	return checker.HandleErrorResponse(ctx, respErr)
+}
+
+// checkers returns a map of URI to rpcmiddleware.RoundTripChecker which define
+// how the URI should be handled.
+func (s *SessionBudgetEnforcer) checkers() map[string]mid.RoundTripChecker {
+	// handlePaymentError releases the reserved amount of any payment that
+	// failed with an error.
+	handlePaymentError := func(ctx context.Context,
+		respErr error) (error, error) {
+
+		if err := s.handlePaymentFailed(ctx); err != nil {
+			return nil, err
+		}
+
+		return respErr, nil
+	}
+
+	// handleSendResponse settles the reserved amount of the synchronous
+	// payment calls, which report a failed payment in the response.
+	handleSendResponse := func(ctx context.Context,
+		r *lnrpc.SendResponse) (proto.Message, error) {
+
+		if r.PaymentError != "" || r.PaymentRoute == nil {
+			return nil, s.handlePaymentFailed(ctx)
+		}
+
+		return nil, s.handlePaymentSucceeded(
+			ctx, uint64(r.PaymentRoute.TotalAmtMsat),
+		)
+	}
+
+	return map[string]mid.RoundTripChecker{
+		"/lnrpc.Lightning/SendPaymentSync": mid.NewFullChecker(
+			&lnrpc.SendRequest{}, &lnrpc.SendResponse{},
+			func(ctx context.Context, r *lnrpc.SendRequest) error {
+				amt, err := s.paymentAmount(
+					ctx, r.PaymentRequest, r.Amt,
+					r.AmtMsat,
+				)
+				if err != nil {
+					return err
+				}
+
+				return s.handlePendingPayment(ctx, amt)
+			},
+			handleSendResponse, handlePaymentError,
+		),
+		"/lnrpc.Lightning/SendToRouteSync": mid.NewFullChecker(
+			&lnrpc.SendToRouteRequest{}, &lnrpc.SendResponse{},
+			func(ctx context.Context,
+				r *lnrpc.SendToRouteRequest) error {
+
+				return s.handlePendingPayment(
+					ctx, routeAmount(r.Route),
+				)
+			},
+			handleSendResponse, handlePaymentError,
+		),
+		"/routerrpc.Router/SendPaymentV2": mid.NewFullChecker(
+			&routerrpc.SendPaymentRequest{}, &lnrpc.Payment{},
+			func(ctx context.Context,
+				r *routerrpc.SendPaymentRequest) error {
+
+				amt, err := s.paymentAmount(
+					ctx, r.PaymentRequest, r.Amt,
+					r.AmtMsat,
+				)
+				if err != nil {
+					return err
+				}
+
+				return s.handlePendingPayment(ctx, amt)
+			},
+			func(ctx context.Context,
+				r *lnrpc.Payment) (proto.Message, error) {
+
+				switch r.Status {
+				case lnrpc.Payment_SUCCEEDED:
+					return nil, s.handlePaymentSucceeded(
+						ctx, uint64(
+							r.ValueMsat+r.FeeMsat,
+						),
+					)
+
+				case lnrpc.Payment_FAILED:
+					return nil, s.handlePaymentFailed(ctx)
+				}
+
+				return nil, nil
+			}, handlePaymentError,
+		),
+		"/routerrpc.Router/SendToRouteV2": mid.NewFullChecker(
+			&routerrpc.SendToRouteRequest{}, &lnrpc.HTLCAttempt{},
+			func(ctx context.Context,
+				r *routerrpc.SendToRouteRequest) error {
+
+				return s.handlePendingPayment(
+					ctx, routeAmount(r.Route),
+				)
+			},
+			func(ctx context.Context,
+				r *lnrpc.HTLCAttempt) (proto.Message, error) {
+
+				switch r.Status {
+				case lnrpc.HTLCAttempt_SUCCEEDED:
+					return nil, s.handlePaymentSucceeded(
+						ctx, routeAmount(r.Route),
+					)
+
+				case lnrpc.HTLCAttempt_FAILED:
+					return nil, s.handlePaymentFailed(ctx)
+				}
+
+				return nil, nil
+			}, handlePaymentError,
+		),
+
+		// The deprecated streaming payment calls can send multiple
+		// payments over a single request, which we can't attribute to
+		// the budget.
+		"/lnrpc.Lightning/SendPayment": mid.NewRequestDenier(
+			&lnrpc.SendRequest{}, &lnrpc.SendResponse{},
+		),
+		"/lnrpc.Lightning/SendToRoute": mid.NewRequestDenier(
+			&lnrpc.SendToRouteRequest{}, &lnrpc.SendResponse{},
+		),
+		"/routerrpc.Router/SendPayment": mid.NewRequestDenier(
+			&routerrpc.SendPaymentRequest{},
+			&routerrpc.PaymentStatus{},
+		),
+		"/routerrpc.Router/SendToRoute": mid.NewRequestDenier(
+			&routerrpc.SendToRouteRequest{},
+			&routerrpc.SendToRouteResponse{},
+		),
+	}
+}
+
+// SessionBudget are the static values that determine the total amount that a
+// session can spend in off-chain payments.
+type SessionBudget struct {
+	AbsoluteAmtSats uint64 `json:"absolute_amt_sats"`
+}
+
+// VerifySane checks that the value of the values is ok given the min and max
+// allowed values.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionBudget) VerifySane(minVal, maxVal Values) error {
+	minBudget, ok := minVal.(*SessionBudget)
+	if !ok {
+		return fmt.Errorf("min value is not of type SessionBudget")
+	}
+
+	maxBudget, ok := maxVal.(*SessionBudget)
+	if !ok {
+		return fmt.Errorf("max value is not of type SessionBudget")
+	}
+
+	if s.AbsoluteAmtSats < minBudget.AbsoluteAmtSats {
+		return fmt.Errorf("session budget is below the minimum " +
+			"required amount")
+	}
+
+	if maxBudget.AbsoluteAmtSats != 0 &&
+		s.AbsoluteAmtSats > maxBudget.AbsoluteAmtSats {
+
+		return fmt.Errorf("session budget is above the maximum " +
+			"allowed amount")
+	}
+
+	return nil
+}
+
+// RuleName returns the name of the rule that these values are to be used with.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionBudget) RuleName() string {
+	return SessionBudgetName
+}
+
+// ToProto converts the rule Values to the litrpc counterpart.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionBudget) ToProto() *litrpc.RuleValue {
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_SessionBudget{
+			SessionBudget: &litrpc.SessionBudget{
+				AbsoluteAmtSats: s.AbsoluteAmtSats,
+			},
+		},
+	}
+}
+
+// PseudoToReal attempts to convert any appropriate pseudo fields in the rule
+// Values to their corresponding real values. It uses the passed PrivacyMapDB to
+// find the real values. This is a no-op for the SessionBudget rule.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionBudget) PseudoToReal(_ context.Context,
+	_ firewalldb.PrivacyMapDB, _ session.PrivacyFlags) (Values, error) {
+
+	return s, nil
+}
+
+// RealToPseudo converts the rule Values to a new one that uses pseudo keys,
+// channel IDs, channel points etc. It returns a map of real to pseudo strings
+// that should be persisted. This is a no-op for the SessionBudget rule.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionBudget) RealToPseudo(_ context.Context,
+	_ firewalldb.PrivacyMapReader, _ session.PrivacyFlags) (Values,
+	map[string]string, error) {
+
+	return s, nil, nil
+}
+
+// SessionBudgetSpent returns the amount in msat that was spent and that is
+// still pending against the session budget whose state is kept in the given
+// stores.
+func SessionBudgetSpent(ctx context.Context, stores firewalldb.KVStores) (
+	uint64, uint64, error) {
+
+	var spent, pending uint64
+	err := stores.View(ctx, func(ctx context.Context,
+		tx firewalldb.KVStoreTx) error {
+
+		var err error
+		spent, pending, err = getSessionBudgetState(ctx, tx)
+
+		return err
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return spent, pending, nil
+}
+
+// paymentAmount returns the amount in msat that a payment for the given
+// invoice or, if no invoice is given, for the given amount will send.
+func (s *SessionBudgetEnforcer) paymentAmount(ctx context.Context,
+	invoice string, amt, amtMsat int64) (uint64, error) {
+
+	explicitAmt := uint64(amtMsat)
+	if amt != 0 {
+		explicitAmt = uint64(amt) * 1000
+	}
+
+	if invoice == "" || explicitAmt != 0 {
+		return explicitAmt, nil
+	}
+
+	payReq, err := s.GetLndClient().DecodePaymentRequest(ctx, invoice)
+	if err != nil {
+		return 0, fmt.Errorf("unable to decode payment request: %w",
+			err)
+	}
+
+	return uint64(payReq.Value), nil
+}
+
+// routeAmount returns the amount in msat that is sent over the given route,
+// including the fees.
+func routeAmount(route *lnrpc.Route) uint64 {
+	if route == nil {
+		return 0
+	}
+
+	return uint64(route.TotalAmtMsat)
+}
+
+// pendingReqKey returns the key under which the amount that is reserved for
+// the current request is stored.
+func (s *SessionBudgetEnforcer) pendingReqKey() string {
+	return fmt.Sprintf("%s:%s-%d", sessionPendingKey, s.GetLndConnID(),
+		s.GetReqID())
+}
+
+// handlePendingPayment checks that a payment of the given amount in msat can
+// be afforded given the current budget. If it can, the amount is reserved
+// until the outcome of the payment is known.
+func (s *SessionBudgetEnforcer) handlePendingPayment(ctx context.Context,
+	amtMsat uint64) error {
+
+	return s.GetStores().Update(ctx, func(ctx context.Context,
+		tx firewalldb.KVStoreTx) error {
+
+		spent, pending, err := getSessionBudgetState(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		budget := s.AbsoluteAmtSats * 1000
+		if spent+pending+amtMsat > budget {
+			return fmt.Errorf("payment of %v msat exceeds the "+
+				"session budget of %v sat (%v msat spent, %v "+
+				"msat pending)", amtMsat, s.AbsoluteAmtSats,
+				spent, pending)
+		}
+
+		store := tx.Local()
+		reqKey := s.pendingReqKey()
+
+		reqBytes, err := store.Get(ctx, reqKey)
+		if err != nil {
+			return err
+		}
+		if len(reqBytes) != 0 {
+			return fmt.Errorf("pending payment already exists for "+
+				"request %s", reqKey)
+		}
+
+		err = setSessionBudgetAmt(ctx, store, reqKey, amtMsat)
+		if err != nil {
+			return err
+		}
+
+		return setSessionBudgetAmt(
+			ctx, store, sessionPendingKey, pending+amtMsat,
+		)
+	})
+}
+
+// handlePaymentSucceeded moves the amount reserved for the current request
+// from the pending to the spent amount. The amount that was actually sent,
+// including any fees, is counted as spent.
+func (s *SessionBudgetEnforcer) handlePaymentSucceeded(ctx context.Context,
+	sentMsat uint64) error {
+
+	return s.settlePendingPayment(ctx, true, sentMsat)
+}
+
+// handlePaymentFailed releases the amount reserved for the current request.
+func (s *SessionBudgetEnforcer) handlePaymentFailed(ctx context.Context) error {
+	return s.settlePendingPayment(ctx, false, 0)
+}
+
+// settlePendingPayment removes the amount reserved for the current request
+// from the pending amount. If the payment succeeded, the sent amount is added
+// to the spent amount, falling back to the reserved amount if it is unknown.
+// Requests without a reserved amount were already settled, as some requests
+// have multiple responses.
+func (s *SessionBudgetEnforcer) settlePendingPayment(ctx context.Context,
+	succeeded bool, sentMsat uint64) error {
+
+	return s.GetStores().Update(ctx, func(ctx context.Context,
+		tx firewalldb.KVStoreTx) error {
+
+		spent, pending, err := getSessionBudgetState(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		store := tx.Local()
+		reqKey := s.pendingReqKey()
+
+		reqBytes, err := store.Get(ctx, reqKey)
+		if err != nil {
+			return err
+		}
+		if len(reqBytes) == 0 {
+			return nil
+		}
+
+		var reserved sessionBudgetAmt
+		if err := json.Unmarshal(reqBytes, &reserved); err != nil {
+			return err
+		}
+
+		if pending < reserved.AmountMsat {
+			return fmt.Errorf("total pending cannot be less than " +
+				"the reserved amount")
+		}
+
+		if err := store.Del(ctx, reqKey); err != nil {
+			return err
+		}
+
+		err = setSessionBudgetAmt(
+			ctx, store, sessionPendingKey,
+			pending-reserved.AmountMsat,
+		)
+		if err != nil {
+			return err
+		}
+
+		if !succeeded {
+			return nil
+		}
+
+		if sentMsat == 0 {
+			sentMsat = reserved.AmountMsat
+		}
+
+		return setSessionBudgetAmt(
+			ctx, store, sessionSpentKey, spent+sentMsat,
+		)
+	})
+}
+
+// sessionBudgetAmt is the persisted form of an amount of the session budget.
+type sessionBudgetAmt struct {
+	AmountMsat uint64 `json:"amount_msat"`
+}
+
+// getSessionBudgetState fetches the spent and the pending amount in msat of
+// the session budget.
+func getSessionBudgetState(ctx context.Context,
+	tx firewalldb.KVStoreTx) (uint64, uint64, error) {
+
+	store := tx.Local()
+
+	var amts [2]sessionBudgetAmt
+	for i, key := range []string{sessionSpentKey, sessionPendingKey} {
+		b, err := store.Get(ctx, key)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		if len(b) == 0 {
+			continue
+		}
+
+		if err := json.Unmarshal(b, &amts[i]); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return amts[0].AmountMsat, amts[1].AmountMsat, nil
+}
+
+// setSessionBudgetAmt stores the given amount in msat under the given key.
+func setSessionBudgetAmt(ctx context.Context, store firewalldb.KVStore,
+	key string, amtMsat uint64) error {
+
+	b, err := json.Marshal(&sessionBudgetAmt{AmountMsat: amtMsat})
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ctx, key, b)
+}
@@ -0,0 +1,197 @@
+package rules
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/lndclient"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSessionBudgetVerifySane tests that the SessionBudget VerifySane method
+// correctly verifies the budget against the given min and max values.
+func TestSessionBudgetVerifySane(t *testing.T) {
+	var (
+		minVal = &SessionBudget{AbsoluteAmtSats: 1000}
+		maxVal = &SessionBudget{AbsoluteAmtSats: 5000}
+	)
+
+	require.NoError(t, (&SessionBudget{
+		AbsoluteAmtSats: 1000,
+	}).VerifySane(minVal, maxVal))
+	require.NoError(t, (&SessionBudget{
+		AbsoluteAmtSats: 5000,
+	}).VerifySane(minVal, maxVal))
+	require.Error(t, (&SessionBudget{
+		AbsoluteAmtSats: 999,
+	}).VerifySane(minVal, maxVal))
+	require.Error(t, (&SessionBudget{
+		AbsoluteAmtSats: 5001,
+	}).VerifySane(minVal, maxVal))
+
+	// A max value of zero means that there is no upper bound.
+	require.NoError(t, (&SessionBudget{
+		AbsoluteAmtSats: 5001,
+	}).VerifySane(minVal, &SessionBudget{}))
+}
+
+// mockInvoiceDecoder is a mock lnd client that decodes any payment request to
+// one for a fixed amount.
+type mockInvoiceDecoder struct {
+	lndclient.LightningClient
+
+	amt lnwire.MilliSatoshi
+}
+
+// DecodePaymentRequest returns a payment request for the amount of the mock.
+func (m *mockInvoiceDecoder) DecodePaymentRequest(_ context.Context,
+	_ string) (*lndclient.PaymentRequest, error) {
+
+	return &lndclient.PaymentRequest{Value: m.amt}, nil
+}
+
+// TestSessionBudgetCheckRequest checks that payments are correctly accepted or
+// denied based on the SessionBudget rule values and that the spent amount is
+// tracked across requests.
+func TestSessionBudgetCheckRequest(t *testing.T) {
+	ctx := context.Background()
+
+	tx := newMockKVStoresTx()
+	stores := &mockKVStores{tx: tx}
+	cfg := &ConfigImpl{
+		Stores:    stores,
+		LndConnID: "test",
+		LndClient: &mockInvoiceDecoder{amt: 30_000},
+	}
+
+	mgr := &SessionBudgetMgr{}
+	enf, err := mgr.NewEnforcer(ctx, cfg, &SessionBudget{
+		AbsoluteAmtSats: 100,
+	})
+	require.NoError(t, err)
+
+	assertBudget := func(expectedSpent, expectedPending uint64) {
+		t.Helper()
+
+		spent, pending, err := SessionBudgetSpent(ctx, stores)
+		require.NoError(t, err)
+		require.Equal(t, expectedSpent, spent)
+		require.Equal(t, expectedPending, pending)
+	}
+
+	const (
+		sendSyncURI = "/lnrpc.Lightning/SendPaymentSync"
+		sendV2URI   = "/routerrpc.Router/SendPaymentV2"
+		routeV2URI  = "/routerrpc.Router/SendToRouteV2"
+	)
+
+	// A request for an irrelevant URI should not affect the budget.
+	_, err = enf.HandleRequest(ctx, "random-URI", nil)
+	require.NoError(t, err)
+	assertBudget(0, 0)
+
+	// A payment of the amount of the invoice is reserved, and settled with
+	// the amount that was actually sent once it succeeded.
+	_, err = enf.HandleRequest(ctx, sendSyncURI, &lnrpc.SendRequest{
+		PaymentRequest: "lnbc1",
+	})
+	require.NoError(t, err)
+	assertBudget(0, 30_000)
+
+	_, err = enf.HandleResponse(ctx, sendSyncURI, &lnrpc.SendResponse{
+		PaymentRoute: &lnrpc.Route{TotalAmtMsat: 30_500},
+	})
+	require.NoError(t, err)
+	assertBudget(30_500, 0)
+
+	// A payment that fails releases its reserved amount.
+	cfg.ReqID = 1
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		Amt: 50,
+	})
+	require.NoError(t, err)
+	assertBudget(30_500, 50_000)
+
+	// The second request for the same ID is rejected.
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		Amt: 10,
+	})
+	require.ErrorContains(t, err, "pending payment already exists")
+
+	// Updates for payments that are still in flight don't change the
+	// budget.
+	_, err = enf.HandleResponse(ctx, sendV2URI, &lnrpc.Payment{
+		Status: lnrpc.Payment_IN_FLIGHT,
+	})
+	require.NoError(t, err)
+	assertBudget(30_500, 50_000)
+
+	_, err = enf.HandleResponse(ctx, sendV2URI, &lnrpc.Payment{
+		Status: lnrpc.Payment_FAILED,
+	})
+	require.NoError(t, err)
+	assertBudget(30_500, 0)
+
+	// A payment that exceeds what is left of the budget is rejected,
+	// including the amount that is still pending for other payments.
+	cfg.ReqID = 2
+	_, err = enf.HandleRequest(
+		ctx, routeV2URI, &routerrpc.SendToRouteRequest{
+			Route: &lnrpc.Route{TotalAmtMsat: 40_000},
+		},
+	)
+	require.NoError(t, err)
+	assertBudget(30_500, 40_000)
+
+	cfg.ReqID = 3
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		AmtMsat: 30_000,
+	})
+	require.ErrorContains(t, err, "exceeds the session budget")
+	assertBudget(30_500, 40_000)
+
+	// An error response for the request releases its reserved amount.
+	cfg.ReqID = 2
+	_, err = enf.HandleErrorResponse(ctx, routeV2URI, errors.New("no good"))
+	require.NoError(t, err)
+	assertBudget(30_500, 0)
+
+	// Now the payment fits into the budget.
+	cfg.ReqID = 3
+	_, err = enf.HandleRequest(ctx, sendV2URI, &routerrpc.SendPaymentRequest{
+		AmtMsat: 69_500,
+	})
+	require.NoError(t, err)
+
+	_, err = enf.HandleResponse(ctx, sendV2URI, &lnrpc.Payment{
+		Status:    lnrpc.Payment_SUCCEEDED,
+		ValueMsat: 69_500,
+	})
+	require.NoError(t, err)
+	assertBudget(100_000, 0)
+
+	// Repeated updates of a settled payment don't count twice.
+	_, err = enf.HandleResponse(ctx, sendV2URI, &lnrpc.Payment{
+		Status:    lnrpc.Payment_SUCCEEDED,
+		ValueMsat: 69_500,
+	})
+	require.NoError(t, err)
+	assertBudget(100_000, 0)
+
+	// The budget is used up, so any further payment is rejected.
+	cfg.ReqID = 4
+	_, err = enf.HandleRequest(ctx, sendSyncURI, &lnrpc.SendRequest{
+		AmtMsat: 1,
+	})
+	require.ErrorContains(t, err, "exceeds the session budget")
+
+	// The deprecated streaming payment calls are always rejected.
+	_, err = enf.HandleRequest(
+		ctx, "/lnrpc.Lightning/SendPayment", &lnrpc.SendRequest{},
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,357 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// Compile-time checks to ensure that SessionRateLimit,
+	// SessionRateLimitMgr and SessionRateLimitEnforcer implement the
+	// appropriate Manager, Enforcer and Values interface.
+	_ Manager  = (*SessionRateLimitMgr)(nil)
+	_ Enforcer = (*SessionRateLimitEnforcer)(nil)
+	_ Values   = (*SessionRateLimit)(nil)
+)
+
+const (
+	// SessionRateLimitName is the string identifier of the
+	// SessionRateLimitMgr rule.
+	SessionRateLimitName = "session-rate-limit"
+
+	// rateLimitCallsKey is the prefix of the keys that will be used in the
+	// temporary KV store to store the times of the calls that were counted
+	// towards a limit.
+	rateLimitCallsKey = "rate-limit-calls"
+)
+
+// SessionRateLimitMgr manages the SessionRateLimit rule. See
+// docs/session_rate_limit.md for more information on the rule.
+type SessionRateLimitMgr struct {
+	// The mutex is used to ensure that only one Enforcer created by the
+	// manager can count a call at any given time. This prevents db entry
+	// race conditions.
+	sync.Mutex
+}
+
+// Stop cleans up the resources held by the manager.
+//
+// NOTE: This is part of the Manager interface.
+func (s *SessionRateLimitMgr) Stop() error {
+	return nil
+}
+
+// NewEnforcer constructs a new SessionRateLimitEnforcer rule enforcer using
+// the passed values and config.
+//
+// NOTE: This is part of the Manager interface.
+func (s *SessionRateLimitMgr) NewEnforcer(_ context.Context, cfg Config,
+	values Values) (Enforcer, error) {
+
+	limits, ok := values.(*SessionRateLimit)
+	if !ok {
+		return nil, fmt.Errorf("values must be of type "+
+			"SessionRateLimit, got %T", values)
+	}
+
+	registerMetrics()
+
+	return &SessionRateLimitEnforcer{
+		sessionRateLimitConfig: cfg,
+		SessionRateLimit:       limits,
+		SessionRateLimitMgr:    s,
+	}, nil
+}
+
+// NewValueFromProto converts the given proto value into a SessionRateLimit
+// Values object. An error is returned if any of the limits is invalid.
+//
+// NOTE: This is part of the Manager interface.
+func (s *SessionRateLimitMgr) NewValueFromProto(v *litrpc.RuleValue) (Values,
+	error) {
+
+	rv, ok := v.Value.(*litrpc.RuleValue_SessionRateLimit)
+	if !ok {
+		return nil, fmt.Errorf("incorrect RuleValue type %T", v.Value)
+	}
+
+	limits := make([]*MethodRateLimit, 0, len(rv.SessionRateLimit.Limits))
+	for _, l := range rv.SessionRateLimit.Limits {
+		limit := &MethodRateLimit{
+			MethodPattern: l.MethodPattern,
+			Calls:         l.Calls,
+			WindowSeconds: l.WindowSeconds,
+		}
+		if err := limit.validate(); err != nil {
+			return nil, err
+		}
+
+		limits = append(limits, limit)
+	}
+
+	if len(limits) == 0 {
+		return nil, fmt.Errorf("at least one rate limit must be set")
+	}
+
+	return &SessionRateLimit{Limits: limits}, nil
+}
+
+// EmptyValue returns a new instance of SessionRateLimit.
+//
+// NOTE: This is part of the Manager interface.
+func (s *SessionRateLimitMgr) EmptyValue() Values {
+	return &SessionRateLimit{}
+}
+
+// sessionRateLimitConfig is the config required by SessionRateLimitMgr. It
+// can be derived from the main rules Config struct.
+type sessionRateLimitConfig interface {
+	GetStores() firewalldb.KVStores
+}
+
+// SessionRateLimitEnforcer enforces requests against a SessionRateLimit rule.
+type SessionRateLimitEnforcer struct {
+	sessionRateLimitConfig
+	*SessionRateLimit
+	*SessionRateLimitMgr
+}
+
+// HandleRequest checks that the request doesn't exceed any of the limits
+// whose method pattern matches the URI of the request, and counts it towards
+// those limits if it doesn't.
+//
+// NOTE: this is part of the Rule interface.
+func (s *SessionRateLimitEnforcer) HandleRequest(ctx context.Context,
+	uri string, _ proto.Message) (proto.Message, error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	now := time.Now()
+	err := s.GetStores().Update(ctx, func(ctx context.Context,
+		tx firewalldb.KVStoreTx) error {
+
+		store := tx.LocalTemp()
+		for i, limit := range s.Limits {
+			matches, err := limit.matches(uri)
+			if err != nil {
+				return err
+			}
+			if !matches {
+				continue
+			}
+
+			key := fmt.Sprintf("%s:%d:%s", rateLimitCallsKey, i,
+				limit.MethodPattern)
+
+			calls, err := getRateLimitCalls(ctx, store, key)
+			if err != nil {
+				return err
+			}
+
+			// Only the calls that happened within the window of
+			// the limit are counted.
+			windowStart := now.Add(-limit.window()).UnixNano()
+			recent := calls[:0]
+			for _, call := range calls {
+				if call > windowStart {
+					recent = append(recent, call)
+				}
+			}
+
+			if uint32(len(recent)) >= limit.Calls {
+				rateLimitViolations.WithLabelValues(
+					limit.MethodPattern,
+				).Inc()
+
+				return fmt.Errorf("rate limit of %d calls per "+
+					"%v exceeded for %s", limit.Calls,
+					limit.window(), limit.MethodPattern)
+			}
+
+			recent = append(recent, now.UnixNano())
+			err = setRateLimitCalls(ctx, store, key, recent)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return nil, err
+}
+
+// HandleResponse handles and possible alters a response. This is a noop for
+// the SessionRateLimit rule.
+//
+// NOTE: this is part of the Rule interface.
+func (s *SessionRateLimitEnforcer) HandleResponse(_ context.Context, _ string,
+	_ proto.Message) (proto.Message, error) {
+
+	return nil, nil
+}
+
+// HandleErrorResponse handles and possible alters an error. This is a noop for
+// the SessionRateLimit rule.
+//
+// NOTE: this is part of the Enforcer interface.
+func (s *SessionRateLimitEnforcer) HandleErrorResponse(_ context.Context,
+	_ string, _ error) (error, error) {
+
+	return nil, nil
+}
+
+// getRateLimitCalls returns the times, as unix nanoseconds, of the calls that
+// are stored under the given key.
+func getRateLimitCalls(ctx context.Context, store firewalldb.KVStore,
+	key string) ([]int64, error) {
+
+	b, err := store.Get(ctx, key)
+	if err != nil || len(b) == 0 {
+		return nil, err
+	}
+
+	var calls []int64
+	if err := json.Unmarshal(b, &calls); err != nil {
+		return nil, err
+	}
+
+	return calls, nil
+}
+
+// setRateLimitCalls stores the given call times under the given key.
+func setRateLimitCalls(ctx context.Context, store firewalldb.KVStore,
+	key string, calls []int64) error {
+
+	b, err := json.Marshal(calls)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(ctx, key, b)
+}
+
+// MethodRateLimit limits the number of calls to the RPC methods whose URI
+// matches the method pattern within a time window.
+type MethodRateLimit struct {
+	// MethodPattern is a regular expression that is matched against the
+	// full URI of a method, for example /lnrpc.Lightning/GetInfo.
+	MethodPattern string `json:"method_pattern"`
+
+	// Calls is the number of calls that are allowed within the window.
+	Calls uint32 `json:"calls"`
+
+	// WindowSeconds is the length of the window in seconds.
+	WindowSeconds uint32 `json:"window_seconds"`
+}
+
+// validate checks that the limit allows at least one call in a non-empty
+// window and that its method pattern is a valid regular expression.
+func (m *MethodRateLimit) validate() error {
+	if m.Calls == 0 || m.WindowSeconds == 0 {
+		return fmt.Errorf("rate limit for %s must allow at least one "+
+			"call within a window of at least one second",
+			m.MethodPattern)
+	}
+
+	if _, err := regexp.Compile(m.MethodPattern); err != nil {
+		return fmt.Errorf("invalid method pattern %s: %w",
+			m.MethodPattern, err)
+	}
+
+	return nil
+}
+
+// matches returns true if the limit applies to the method with the given URI.
+func (m *MethodRateLimit) matches(uri string) (bool, error) {
+	return regexp.MatchString(m.MethodPattern, uri)
+}
+
+// window returns the length of the window of the limit.
+func (m *MethodRateLimit) window() time.Duration {
+	return time.Duration(m.WindowSeconds) * time.Second
+}
+
+// SessionRateLimit are the static values that limit the number of calls that
+// a session can make to the methods matching each of the limits.
+type SessionRateLimit struct {
+	Limits []*MethodRateLimit `json:"limits"`
+}
+
+// VerifySane checks that the value of the values is ok given the min and max
+// allowed values. Session rate limits are set when a session is created and
+// aren't bounded by an Autopilot feature, so only the limits themselves are
+// validated.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionRateLimit) VerifySane(_, _ Values) error {
+	for _, limit := range s.Limits {
+		if err := limit.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RuleName returns the name of the rule that these values are to be used with.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionRateLimit) RuleName() string {
+	return SessionRateLimitName
+}
+
+// ToProto converts the rule Values to the litrpc counterpart.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionRateLimit) ToProto() *litrpc.RuleValue {
+	limits := make([]*litrpc.MethodRateLimit, 0, len(s.Limits))
+	for _, limit := range s.Limits {
+		limits = append(limits, &litrpc.MethodRateLimit{
+			MethodPattern: limit.MethodPattern,
+			Calls:         limit.Calls,
+			WindowSeconds: limit.WindowSeconds,
+		})
+	}
+
+	return &litrpc.RuleValue{
+		Value: &litrpc.RuleValue_SessionRateLimit{
+			SessionRateLimit: &litrpc.SessionRateLimit{
+				Limits: limits,
+			},
+		},
+	}
+}
+
+// PseudoToReal attempts to convert any appropriate pseudo fields in the rule
+// Values to their corresponding real values. It uses the passed PrivacyMapDB to
+// find the real values. This is a no-op for the SessionRateLimit rule.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionRateLimit) PseudoToReal(_ context.Context,
+	_ firewalldb.PrivacyMapDB, _ session.PrivacyFlags) (Values, error) {
+
+	return s, nil
+}
+
+// RealToPseudo converts the rule Values to a new one that uses pseudo keys,
+// channel IDs, channel points etc. It returns a map of real to pseudo strings
+// that should be persisted. This is a no-op for the SessionRateLimit rule.
+//
+// NOTE: this is part of the Values interface.
+func (s *SessionRateLimit) RealToPseudo(_ context.Context,
+	_ firewalldb.PrivacyMapReader, _ session.PrivacyFlags) (Values,
+	map[string]string, error) {
+
+	return s, nil, nil
+}
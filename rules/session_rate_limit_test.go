@@ -0,0 +1,152 @@
+package rules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSessionRateLimitNewValueFromProto tests that invalid rate limits are
+// rejected when converting them from their proto counterpart.
+func TestSessionRateLimitNewValueFromProto(t *testing.T) {
+	mgr := &SessionRateLimitMgr{}
+
+	toProto := func(limits ...*litrpc.MethodRateLimit) *litrpc.RuleValue {
+		return &litrpc.RuleValue{
+			Value: &litrpc.RuleValue_SessionRateLimit{
+				SessionRateLimit: &litrpc.SessionRateLimit{
+					Limits: limits,
+				},
+			},
+		}
+	}
+
+	// At least one limit must be set.
+	_, err := mgr.NewValueFromProto(toProto())
+	require.Error(t, err)
+
+	// A limit must allow at least one call.
+	_, err = mgr.NewValueFromProto(toProto(&litrpc.MethodRateLimit{
+		MethodPattern: ".*",
+		WindowSeconds: 60,
+	}))
+	require.Error(t, err)
+
+	// A limit must have a window.
+	_, err = mgr.NewValueFromProto(toProto(&litrpc.MethodRateLimit{
+		MethodPattern: ".*",
+		Calls:         1,
+	}))
+	require.Error(t, err)
+
+	// The method pattern must be a valid regular expression.
+	_, err = mgr.NewValueFromProto(toProto(&litrpc.MethodRateLimit{
+		MethodPattern: "(",
+		Calls:         1,
+		WindowSeconds: 60,
+	}))
+	require.Error(t, err)
+
+	v, err := mgr.NewValueFromProto(toProto(&litrpc.MethodRateLimit{
+		MethodPattern: "/lnrpc\\..*",
+		Calls:         60,
+		WindowSeconds: 60,
+	}))
+	require.NoError(t, err)
+	require.Equal(t, &SessionRateLimit{
+		Limits: []*MethodRateLimit{{
+			MethodPattern: "/lnrpc\\..*",
+			Calls:         60,
+			WindowSeconds: 60,
+		}},
+	}, v)
+
+	// Converting the values back results in the original proto.
+	require.Equal(t, toProto(&litrpc.MethodRateLimit{
+		MethodPattern: "/lnrpc\\..*",
+		Calls:         60,
+		WindowSeconds: 60,
+	}), v.ToProto())
+}
+
+// TestSessionRateLimitCheckRequest checks that requests are correctly accepted
+// or denied based on the SessionRateLimit rule values.
+func TestSessionRateLimitCheckRequest(t *testing.T) {
+	ctx := context.Background()
+
+	tx := newMockKVStoresTx()
+	cfg := &ConfigImpl{
+		Stores: &mockKVStores{tx: tx},
+	}
+
+	values := &SessionRateLimit{
+		Limits: []*MethodRateLimit{
+			{
+				MethodPattern: "/lnrpc\\.Lightning/.*",
+				Calls:         2,
+				WindowSeconds: 60,
+			},
+			{
+				MethodPattern: "/routerrpc\\..*",
+				Calls:         1,
+				WindowSeconds: 60,
+			},
+		},
+	}
+
+	mgr := &SessionRateLimitMgr{}
+	enf, err := mgr.NewEnforcer(ctx, cfg, values)
+	require.NoError(t, err)
+
+	const (
+		getInfoURI = "/lnrpc.Lightning/GetInfo"
+		sendV2URI  = "/routerrpc.Router/SendPaymentV2"
+	)
+
+	// Requests that don't match any of the patterns are never limited.
+	const loopOutURI = "/looprpc.SwapClient/LoopOut"
+	for i := 0; i < 5; i++ {
+		_, err = enf.HandleRequest(ctx, loopOutURI, nil)
+		require.NoError(t, err)
+	}
+
+	// The first two calls are within the limit, the third one exceeds it.
+	_, err = enf.HandleRequest(ctx, getInfoURI, nil)
+	require.NoError(t, err)
+	_, err = enf.HandleRequest(ctx, getInfoURI, nil)
+	require.NoError(t, err)
+	_, err = enf.HandleRequest(ctx, getInfoURI, nil)
+	require.ErrorContains(t, err, "rate limit of 2 calls per 1m0s exceeded")
+
+	// The calls of each limit are counted separately.
+	_, err = enf.HandleRequest(ctx, sendV2URI, nil)
+	require.NoError(t, err)
+	_, err = enf.HandleRequest(ctx, sendV2URI, nil)
+	require.ErrorContains(t, err, "rate limit of 1 calls per 1m0s exceeded")
+
+	// Move the stored calls of the first limit back in time so that they
+	// fall outside the window. The limit should then allow calls again.
+	key := fmt.Sprintf("%s:%d:%s", rateLimitCallsKey, 0,
+		values.Limits[0].MethodPattern)
+
+	past := time.Now().Add(-time.Minute).UnixNano()
+	b, err := json.Marshal([]int64{past, past})
+	require.NoError(t, err)
+	require.NoError(t, tx.LocalTemp().Set(ctx, key, b))
+
+	_, err = enf.HandleRequest(ctx, getInfoURI, nil)
+	require.NoError(t, err)
+	_, err = enf.HandleRequest(ctx, getInfoURI, nil)
+	require.NoError(t, err)
+	_, err = enf.HandleRequest(ctx, getInfoURI, nil)
+	require.Error(t, err)
+
+	// The second limit is unaffected.
+	_, err = enf.HandleRequest(ctx, sendV2URI, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package session
+
+import (
+	"fmt"
+	"slices"
+)
+
+// AmountObfuscationStrategy determines how the privacy mapper obfuscates the
+// amounts that are returned to the client connected through a session.
+type AmountObfuscationStrategy uint8
+
+const (
+	// AmountObfuscationRelative means that amounts are randomized
+	// symmetrically within a relative range around the real amount.
+	AmountObfuscationRelative AmountObfuscationStrategy = 0
+
+	// AmountObfuscationBucket means that amounts are rounded down to a
+	// multiple of a fixed bucket size.
+	AmountObfuscationBucket AmountObfuscationStrategy = 1
+)
+
+const (
+	// DefaultAmountVariationPPM is the relative variation, in parts per
+	// million, that is used by the relative strategy if no variation is
+	// set explicitly.
+	DefaultAmountVariationPPM = 50_000
+
+	// maxAmountVariationPPM is the maximum relative variation, in parts
+	// per million, that can be used by the relative strategy.
+	maxAmountVariationPPM = 1_000_000
+)
+
+// AmountFields are the names of the amount fields that the privacy mapper
+// obfuscates. The names are the proto field names of the responses that
+// contain them. Fields that are derived from one of these fields, like the
+// remote balance of a channel, follow the obfuscation of that field.
+var AmountFields = []string{
+	"amt_out_msat",
+	"fee_msat",
+	"local_balance",
+	"total_satoshis_received",
+	"total_satoshis_sent",
+	"unsettled_balance",
+	"total_balance",
+	"confirmed_balance",
+	"unconfirmed_balance",
+	"locked_balance",
+	"reserved_balance_anchor_chan",
+	"capacity",
+	"settled_balance",
+	"limbo_balance",
+	"recovered_balance",
+	"total_limbo_balance",
+}
+
+// AmountObfuscation holds the configuration of how the privacy mapper
+// obfuscates the amounts that are returned to the client connected through a
+// session. The zero value uses the relative strategy with the default
+// variation. Amounts are not obfuscated at all if the ClearAmounts privacy
+// flag is set.
+type AmountObfuscation struct {
+	// Strategy is the strategy that is used to obfuscate amounts.
+	Strategy AmountObfuscationStrategy
+
+	// VariationPPM is the maximum relative variation, in parts per
+	// million, by which amounts are randomized if the relative strategy is
+	// used. If it is zero, DefaultAmountVariationPPM is used.
+	VariationPPM uint32
+
+	// BucketSize is the size of the buckets, in satoshis, that amounts are
+	// rounded down to if the bucket strategy is used. Millisatoshi amounts
+	// are rounded to the same buckets.
+	BucketSize uint64
+
+	// ClearFields are the names of the amount fields that are not
+	// obfuscated. See AmountFields for the available names.
+	ClearFields []string
+}
+
+// isZero returns true if none of the configuration options are set.
+func (a *AmountObfuscation) isZero() bool {
+	return a.Strategy == AmountObfuscationRelative && a.VariationPPM == 0 &&
+		a.BucketSize == 0 && len(a.ClearFields) == 0
+}
+
+// Variation returns the relative variation that is used by the relative
+// strategy as a fraction between 0 and 1.
+func (a *AmountObfuscation) Variation() float64 {
+	variationPPM := a.VariationPPM
+	if variationPPM == 0 {
+		variationPPM = DefaultAmountVariationPPM
+	}
+
+	return float64(variationPPM) / maxAmountVariationPPM
+}
+
+// ClearsField returns true if the amount field with the given name should not
+// be obfuscated.
+func (a *AmountObfuscation) ClearsField(field string) bool {
+	return slices.Contains(a.ClearFields, field)
+}
+
+// Validate returns an error if the configuration is invalid.
+func (a *AmountObfuscation) Validate() error {
+	switch a.Strategy {
+	case AmountObfuscationRelative:
+		if a.VariationPPM > maxAmountVariationPPM {
+			return fmt.Errorf("amount variation of %d ppm exceeds "+
+				"the maximum of %d ppm", a.VariationPPM,
+				maxAmountVariationPPM)
+		}
+
+		if a.BucketSize != 0 {
+			return fmt.Errorf("bucket size can only be set for " +
+				"the bucket strategy")
+		}
+
+	case AmountObfuscationBucket:
+		if a.BucketSize == 0 {
+			return fmt.Errorf("bucket size must be set for the " +
+				"bucket strategy")
+		}
+
+		if a.VariationPPM != 0 {
+			return fmt.Errorf("amount variation can only be set " +
+				"for the relative strategy")
+		}
+
+	default:
+		return fmt.Errorf("unknown amount obfuscation strategy <%d>",
+			a.Strategy)
+	}
+
+	for _, field := range a.ClearFields {
+		if !slices.Contains(AmountFields, field) {
+			return fmt.Errorf("unknown amount field %s", field)
+		}
+	}
+
+	return nil
+}
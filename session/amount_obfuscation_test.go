@@ -0,0 +1,94 @@
+package session
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestAmountObfuscationValidate tests that invalid amount obfuscation configs
+// are rejected.
+func TestAmountObfuscationValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		cfg         AmountObfuscation
+		expectedErr string
+	}{
+		{
+			name: "default",
+			cfg:  AmountObfuscation{},
+		},
+		{
+			name: "relative",
+			cfg: AmountObfuscation{
+				VariationPPM: 200_000,
+				ClearFields:  []string{"capacity", "fee_msat"},
+			},
+		},
+		{
+			name: "variation too large",
+			cfg: AmountObfuscation{
+				VariationPPM: 1_000_001,
+			},
+			expectedErr: "exceeds the maximum",
+		},
+		{
+			name: "relative with bucket size",
+			cfg: AmountObfuscation{
+				BucketSize: 1_000,
+			},
+			expectedErr: "bucket size can only be set",
+		},
+		{
+			name: "bucket",
+			cfg: AmountObfuscation{
+				Strategy:   AmountObfuscationBucket,
+				BucketSize: 1_000,
+			},
+		},
+		{
+			name: "bucket without size",
+			cfg: AmountObfuscation{
+				Strategy: AmountObfuscationBucket,
+			},
+			expectedErr: "bucket size must be set",
+		},
+		{
+			name: "bucket with variation",
+			cfg: AmountObfuscation{
+				Strategy:     AmountObfuscationBucket,
+				BucketSize:   1_000,
+				VariationPPM: 1_000,
+			},
+			expectedErr: "amount variation can only be set",
+		},
+		{
+			name: "unknown strategy",
+			cfg: AmountObfuscation{
+				Strategy: 5,
+			},
+			expectedErr: "unknown amount obfuscation strategy",
+		},
+		{
+			name: "unknown field",
+			cfg: AmountObfuscation{
+				ClearFields: []string{"alias"},
+			},
+			expectedErr: "unknown amount field alias",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.cfg.Validate()
+			if test.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorContains(t, err, test.expectedErr)
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package session
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lightning-node-connect/mailbox"
+)
+
+// connEventBufferSize is the number of connection events that are buffered for
+// each subscriber. A subscriber that doesn't keep up is dropped.
+const connEventBufferSize = 100
+
+// ConnEventType denotes the kind of change to the mailbox connection of a
+// session that a ConnEvent describes.
+type ConnEventType uint8
+
+const (
+	// ConnEventConnected is emitted when a session is connected to its
+	// mailbox server and ready to be used, or when a client connects to
+	// it.
+	ConnEventConnected ConnEventType = iota
+
+	// ConnEventDisconnected is emitted when a session loses the connection
+	// to its mailbox server.
+	ConnEventDisconnected
+
+	// ConnEventReconnecting is emitted before a session makes another
+	// attempt to accept a connection after the previous attempt failed.
+	ConnEventReconnecting
+)
+
+// String returns a human-readable representation of the event type.
+func (t ConnEventType) String() string {
+	switch t {
+	case ConnEventConnected:
+		return "connected"
+
+	case ConnEventDisconnected:
+		return "disconnected"
+
+	case ConnEventReconnecting:
+		return "reconnecting"
+
+	default:
+		return "unknown"
+	}
+}
+
+// ConnEvent describes a change to the mailbox connection of a session.
+type ConnEvent struct {
+	// Type is the kind of change.
+	Type ConnEventType
+
+	// SessionID is the ID of the session whose connection changed.
+	SessionID ID
+
+	// LocalPublicKey is the local static key of the session whose
+	// connection changed.
+	LocalPublicKey *btcec.PublicKey
+
+	// ClientConnected is true if a client is currently connected through
+	// the mailbox. It is only set for connected events.
+	ClientConnected bool
+
+	// Attempt is the number of the attempt to reconnect since the backoff
+	// was last reset. It is only set for reconnecting events.
+	Attempt uint32
+
+	// Backoff is the time until the attempt to reconnect is made. It is
+	// only set for reconnecting events.
+	Backoff time.Duration
+
+	// Timestamp is the time at which the event was emitted.
+	Timestamp time.Time
+}
+
+// connEventFromStatus returns the connection event that describes the given
+// new mailbox status of a session.
+func connEventFromStatus(session *Session,
+	status mailbox.ServerStatus) *ConnEvent {
+
+	event := &ConnEvent{
+		Type:           ConnEventConnected,
+		SessionID:      session.ID,
+		LocalPublicKey: session.LocalPublicKey,
+		Timestamp:      time.Now(),
+	}
+
+	switch status {
+	case mailbox.ServerStatusNotConnected:
+		event.Type = ConnEventDisconnected
+
+	case mailbox.ServerStatusInUse:
+		event.ClientConnected = true
+	}
+
+	return event
+}
+
+// connEventBroker fans out connection events to all current subscribers and
+// keeps track of the latest event of each active session.
+type connEventBroker struct {
+	mu          sync.Mutex
+	nextID      uint64
+	subscribers map[uint64]chan *ConnEvent
+
+	// latest holds the latest event of each active session.
+	latest map[sessionID]*ConnEvent
+}
+
+// newConnEventBroker creates a new connEventBroker without any subscribers.
+func newConnEventBroker() *connEventBroker {
+	return &connEventBroker{
+		subscribers: make(map[uint64]chan *ConnEvent),
+		latest:      make(map[sessionID]*ConnEvent),
+	}
+}
+
+// subscribe registers a new subscriber and returns the latest event of each
+// active session, sorted by session ID. The returned channel receives all
+// events that are published after the snapshot was taken and is closed if the
+// subscriber doesn't keep up with them. The returned function must be called
+// to remove the subscriber.
+func (b *connEventBroker) subscribe() ([]*ConnEvent, <-chan *ConnEvent,
+	func()) {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	snapshot := make([]*ConnEvent, 0, len(b.latest))
+	for _, event := range b.latest {
+		snapshot = append(snapshot, event)
+	}
+	sort.Slice(snapshot, func(i, j int) bool {
+		return bytes.Compare(
+			snapshot[i].SessionID[:], snapshot[j].SessionID[:],
+		) < 0
+	})
+
+	id := b.nextID
+	b.nextID++
+
+	events := make(chan *ConnEvent, connEventBufferSize)
+	b.subscribers[id] = events
+
+	return snapshot, events, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		if _, ok := b.subscribers[id]; ok {
+			delete(b.subscribers, id)
+			close(events)
+		}
+	}
+}
+
+// publish sends the given event to all subscribers. It never blocks, a
+// subscriber whose buffer is full is dropped instead.
+func (b *connEventBroker) publish(event *ConnEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var id sessionID
+	copy(id[:], event.LocalPublicKey.SerializeCompressed())
+	b.latest[id] = event
+
+	for subID, events := range b.subscribers {
+		select {
+		case events <- event:
+		default:
+			log.Warnf("Dropping slow subscriber of session " +
+				"connection events")
+
+			delete(b.subscribers, subID)
+			close(events)
+		}
+	}
+}
+
+// forget removes the latest event of the given session once the session is no
+// longer active.
+func (b *connEventBroker) forget(id sessionID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.latest, id)
+}
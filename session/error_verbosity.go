@@ -0,0 +1,63 @@
+package session
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// terseErrorMsg is the message that replaces the original error message of
+// any error returned to a client connected through a session with terse error
+// verbosity.
+const terseErrorMsg = "request failed"
+
+// terseError strips the given error of all its details and only keeps the
+// gRPC status code, if there is one.
+func terseError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return status.Error(status.Code(err), terseErrorMsg)
+}
+
+// errorVerbosityServerOpts returns the gRPC server options that need to be
+// added to a session's gRPC server in order to enforce the given error
+// verbosity.
+func errorVerbosityServerOpts(verbosity ErrorVerbosity) []grpc.ServerOption {
+	if verbosity != ErrorVerbosityTerse {
+		return nil
+	}
+
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(func(ctx context.Context,
+			req interface{}, _ *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler) (interface{}, error) {
+
+			resp, err := handler(ctx, req)
+			if err != nil {
+				log.Debugf("Stripping error details for terse "+
+					"session: %v", err)
+
+				return nil, terseError(err)
+			}
+
+			return resp, nil
+		}),
+		grpc.ChainStreamInterceptor(func(srv interface{},
+			ss grpc.ServerStream, _ *grpc.StreamServerInfo,
+			handler grpc.StreamHandler) error {
+
+			err := handler(srv, ss)
+			if err != nil {
+				log.Debugf("Stripping error details for terse "+
+					"session: %v", err)
+
+				return terseError(err)
+			}
+
+			return nil
+		}),
+	}
+}
@@ -0,0 +1,42 @@
+package session
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestTerseError tests that errors are stripped of their details while the
+// gRPC status code is kept.
+func TestTerseError(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, terseError(nil))
+
+	err := terseError(errors.New("unable to open db at /home/user/.lit"))
+	require.Equal(t, codes.Unknown, status.Code(err))
+	require.Equal(t, terseErrorMsg, status.Convert(err).Message())
+
+	err = terseError(status.Error(
+		codes.NotFound, "channel 1234 not found in graph",
+	))
+	require.Equal(t, codes.NotFound, status.Code(err))
+	require.Equal(t, terseErrorMsg, status.Convert(err).Message())
+}
+
+// TestErrorVerbosityServerOpts tests that server options are only added for
+// sessions with terse error verbosity.
+func TestErrorVerbosityServerOpts(t *testing.T) {
+	t.Parallel()
+
+	require.Empty(t, errorVerbosityServerOpts(ErrorVerbosityVerbose))
+	require.Len(t, errorVerbosityServerOpts(ErrorVerbosityTerse), 2)
+
+	// Make sure the resulting options can be used to create a server.
+	srv := grpc.NewServer(errorVerbosityServerOpts(ErrorVerbosityTerse)...)
+	srv.Stop()
+}
@@ -18,4 +18,23 @@ var (
 	ErrSessionsInGroupStillActive = errors.New(
 		"group has active sessions",
 	)
+
+	// ErrSessionNotActive is returned when an attempt is made to change a
+	// session that is already revoked or expired.
+	ErrSessionNotActive = errors.New("session is no longer active")
+
+	// ErrTemplateNotFound is returned when a session template with the
+	// given name doesn't exist.
+	ErrTemplateNotFound = errors.New("session template not found")
+
+	// ErrTemplateExists is returned when an attempt is made to save a
+	// session template under a name that is already used without
+	// overwriting it.
+	ErrTemplateExists = errors.New("session template already exists")
+
+	// ErrConnEventSubscriberLagged is returned when a subscription to the
+	// connection events of the sessions is ended because the subscriber
+	// didn't keep up with the events.
+	ErrConnEventSubscriberLagged = errors.New("session connection event " +
+		"subscriber fell behind, subscribe again")
 )
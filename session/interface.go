@@ -26,6 +26,21 @@ const (
 	TypeMacaroonAccount  Type = 5
 )
 
+// ErrorVerbosity determines how much detail is included in the errors that are
+// returned to a client connected through a session.
+type ErrorVerbosity uint8
+
+const (
+	// ErrorVerbosityVerbose means that errors are returned to the client
+	// unmodified.
+	ErrorVerbosityVerbose ErrorVerbosity = 0
+
+	// ErrorVerbosityTerse means that errors are stripped of their message
+	// before being returned to the client so that no details about the
+	// node's internals are leaked. Only the gRPC status code is kept.
+	ErrorVerbosityTerse ErrorVerbosity = 1
+)
+
 // State represents the state of a session.
 type State uint8
 
@@ -122,6 +137,14 @@ type Session struct {
 
 	// AccountID is an optional account that the session has been linked to.
 	AccountID fn.Option[accounts.AccountID]
+
+	// ErrorVerbosity determines how much detail is included in the errors
+	// returned to the client connected through the session.
+	ErrorVerbosity ErrorVerbosity
+
+	// AmountObfuscation determines how the privacy mapper obfuscates the
+	// amounts returned to the client connected through the session.
+	AmountObfuscation AmountObfuscation
 }
 
 // buildSession creates a new session with the given user-defined parameters.
@@ -169,6 +192,8 @@ func buildSession(id ID, localPrivKey *btcec.PrivateKey, label string, typ Type,
 		GroupID:           groupID,
 		MacaroonRecipe:    opts.macaroonRecipe,
 		AccountID:         opts.accountID,
+		ErrorVerbosity:    opts.errorVerbosity,
+		AmountObfuscation: opts.amountObfuscation,
 	}
 
 	if len(opts.featureConfig) != 0 {
@@ -205,6 +230,14 @@ type sessionOptions struct {
 
 	// accountID is an optional account that the session has been linked to.
 	accountID fn.Option[accounts.AccountID]
+
+	// errorVerbosity is the verbosity of the errors returned to the client
+	// connected through the session.
+	errorVerbosity ErrorVerbosity
+
+	// amountObfuscation determines how the privacy mapper obfuscates the
+	// amounts returned to the client connected through the session.
+	amountObfuscation AmountObfuscation
 }
 
 // defaultSessionOptions returns a new sessionOptions struct with default
@@ -274,6 +307,22 @@ func WithAccount(id accounts.AccountID) Option {
 	}
 }
 
+// WithErrorVerbosity can be used to set the verbosity of the errors that are
+// returned to the client connected through the session.
+func WithErrorVerbosity(verbosity ErrorVerbosity) Option {
+	return func(o *sessionOptions) {
+		o.errorVerbosity = verbosity
+	}
+}
+
+// WithAmountObfuscation can be used to set how the privacy mapper obfuscates
+// the amounts that are returned to the client connected through the session.
+func WithAmountObfuscation(cfg AmountObfuscation) Option {
+	return func(o *sessionOptions) {
+		o.amountObfuscation = cfg
+	}
+}
+
 // IDToGroupIndex defines an interface for the session ID to group ID index.
 type IDToGroupIndex interface {
 	// GetGroupID will return the group ID for the given session ID.
@@ -284,6 +333,40 @@ type IDToGroupIndex interface {
 	GetSessionIDs(ctx context.Context, groupID ID) ([]ID, error)
 }
 
+// Template is a named set of parameters that new sessions can be created from.
+// The parameters are stored in a serialized form that is only interpreted by
+// the caller.
+type Template struct {
+	// Name is the unique name of the template.
+	Name string
+
+	// Config holds the serialized parameters of the sessions created from
+	// the template.
+	Config []byte
+
+	// CreatedAt is the time at which the template was last saved.
+	CreatedAt time.Time
+}
+
+// TemplateStore defines an interface for the storage of session templates.
+type TemplateStore interface {
+	// SaveTemplate stores the given config as the template with the given
+	// name. If a template with the name already exists, it is only
+	// replaced if overwrite is set, otherwise ErrTemplateExists is
+	// returned.
+	SaveTemplate(ctx context.Context, name string, config []byte,
+		overwrite bool) (*Template, error)
+
+	// GetTemplate fetches the template with the given name.
+	GetTemplate(ctx context.Context, name string) (*Template, error)
+
+	// ListTemplates returns all templates, sorted by their name.
+	ListTemplates(ctx context.Context) ([]*Template, error)
+
+	// DeleteTemplate removes the template with the given name.
+	DeleteTemplate(ctx context.Context, name string) error
+}
+
 // Store is the interface a persistent storage must implement for storing and
 // retrieving Terminal Connect sessions.
 type Store interface {
@@ -305,6 +388,12 @@ type Store interface {
 	// ListSessionsByType returns all sessions of the given type.
 	ListSessionsByType(ctx context.Context, t Type) ([]*Session, error)
 
+	// ListSessionsByAccount returns all sessions that are linked to the
+	// account with the given ID. If the account does not exist,
+	// accounts.ErrAccNotFound is returned.
+	ListSessionsByAccount(ctx context.Context, id accounts.AccountID) (
+		[]*Session, error)
+
 	// ListSessionsByState returns all sessions currently known to the store
 	// that are in the given state.
 	ListSessionsByState(ctx context.Context, state State) ([]*Session,
@@ -315,6 +404,11 @@ type Store interface {
 	UpdateSessionRemotePubKey(ctx context.Context, id ID,
 		remotePubKey *btcec.PublicKey) error
 
+	// UpdateSessionExpiry sets the expiry of the session with the given
+	// ID. ErrSessionNotActive is returned if the session is already
+	// revoked or expired.
+	UpdateSessionExpiry(ctx context.Context, id ID, expiry time.Time) error
+
 	// GetSession fetches the session with the given ID.
 	GetSession(ctx context.Context, id ID) (*Session, error)
 
@@ -327,4 +421,6 @@ type Store interface {
 	ShiftState(ctx context.Context, id ID, dest State) error
 
 	IDToGroupIndex
+
+	TemplateStore
 }
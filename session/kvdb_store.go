@@ -27,6 +27,7 @@ var (
 	//	   -> id-index     -> <session-id> -> key -> <session key>
 	// 			                   -> group -> <group-ID>
 	// 	   -> group-id-index -> <group-id> -> session-id -> sequence -> <session-id>
+	// 	   -> state-index  -> <state>      -> <session key>
 	sessionBucketKey = []byte("session")
 
 	// idIndexKey is the key used to define the id-index sub-bucket within
@@ -53,6 +54,19 @@ var (
 	// IDs associated with the given group ID.
 	sessionIDKey = []byte("session-id")
 
+	// stateIndexKey is the key used to define the state-index sub-bucket
+	// within the main session bucket. This bucket will be used to store
+	// the keys of all sessions that are in a given state, so that they can
+	// be listed without reading every session.
+	stateIndexKey = []byte("state-index")
+
+	// templateBucketKey is the top level bucket where the session templates
+	// are stored, indexed by their name.
+	//
+	// The template bucket has the following structure:
+	// session-template -> <name> -> <serialised template>
+	templateBucketKey = []byte("session-template")
+
 	// ErrDBInitErr is returned when a bucket that we expect to have been
 	// set up during DB initialisation is not found.
 	ErrDBInitErr = errors.New("db did not initialise properly")
@@ -172,6 +186,16 @@ func initDB(filepath string, firstInit bool) (*bbolt.DB, error) {
 		}
 
 		_, err = sessionBkt.CreateBucketIfNotExists(groupIDIndexKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = sessionBkt.CreateBucketIfNotExists(stateIndexKey)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.CreateBucketIfNotExists(templateBucketKey)
 
 		return err
 	})
@@ -288,6 +312,11 @@ func (db *BoltStore) NewSession(ctx context.Context, label string, typ Type,
 			return err
 		}
 
+		err = addToStateIndex(sessionBucket, session.State, sessionKey)
+		if err != nil {
+			return err
+		}
+
 		return putSession(sessionBucket, session)
 	})
 	if err != nil {
@@ -321,6 +350,35 @@ func (db *BoltStore) UpdateSessionRemotePubKey(_ context.Context, id ID,
 	})
 }
 
+// UpdateSessionExpiry sets the expiry of the session with the given ID.
+// ErrSessionNotActive is returned if the session is already revoked or
+// expired.
+//
+// NOTE: this is part of the Store interface.
+func (db *BoltStore) UpdateSessionExpiry(_ context.Context, id ID,
+	expiry time.Time) error {
+
+	return db.Update(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		session, err := getSessionByID(sessionBucket, id)
+		if err != nil {
+			return err
+		}
+
+		if session.State.Terminal() {
+			return ErrSessionNotActive
+		}
+
+		session.Expiry = expiry.UTC()
+
+		return putSession(sessionBucket, session)
+	})
+}
+
 // GetSessionByLocalPub fetches the session with the given local pub key.
 //
 // NOTE: this is part of the Store interface.
@@ -381,8 +439,75 @@ func (db *BoltStore) ListSessionsByType(_ context.Context, t Type) ([]*Session,
 func (db *BoltStore) ListSessionsByState(_ context.Context, state State) (
 	[]*Session, error) {
 
+	var sessions []*Session
+	err := db.View(func(tx *bbolt.Tx) error {
+		sessionBucket, err := getBucket(tx, sessionBucketKey)
+		if err != nil {
+			return err
+		}
+
+		stateIndexBkt := sessionBucket.Bucket(stateIndexKey)
+		if stateIndexBkt == nil {
+			return ErrDBInitErr
+		}
+
+		// If no session was ever in the given state, there is no
+		// bucket for it yet.
+		stateBkt := stateIndexBkt.Bucket([]byte{byte(state)})
+		if stateBkt == nil {
+			return nil
+		}
+
+		return stateBkt.ForEach(func(key, _ []byte) error {
+			v := sessionBucket.Get(key)
+			if len(v) == 0 {
+				return fmt.Errorf("%w: session %x of the "+
+					"state index", ErrSessionNotFound, key)
+			}
+
+			session, err := DeserializeSession(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			sessions = append(sessions, session)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Make sure to sort the sessions by creation time.
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreatedAt.Before(sessions[j].CreatedAt)
+	})
+
+	return sessions, nil
+}
+
+// ListSessionsByAccount returns all sessions that are linked to the account
+// with the given ID. The account link of a session is decoded from the account
+// caveat of its macaroon recipe.
+//
+// NOTE: this is part of the Store interface.
+func (db *BoltStore) ListSessionsByAccount(ctx context.Context,
+	id accounts.AccountID) ([]*Session, error) {
+
+	// Make sure the account exists so that we return the same error as
+	// the SQL store for unknown accounts.
+	if _, err := db.accounts.Account(ctx, id); err != nil {
+		return nil, err
+	}
+
 	return db.listSessions(func(s *Session) bool {
-		return s.State == state
+		var linked bool
+		s.AccountID.WhenSome(func(acctID accounts.AccountID) {
+			linked = acctID == id
+		})
+
+		return linked
 	})
 }
 
@@ -463,6 +588,13 @@ func (db *BoltStore) DeleteReservedSessions(_ context.Context) error {
 				return err
 			}
 
+			err = removeFromStateIndex(
+				sessionBucket, session.State, k,
+			)
+			if err != nil {
+				return err
+			}
+
 			idIndexBkt := sessionBucket.Bucket(idIndexKey)
 			if idIndexBkt == nil {
 				return ErrDBInitErr
@@ -553,6 +685,19 @@ func (db *BoltStore) ShiftState(_ context.Context, id ID, dest State) error {
 				"from %d to %d", session.State, dest)
 		}
 
+		sessionKey := getSessionKey(session)
+		err = removeFromStateIndex(
+			sessionBucket, session.State, sessionKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = addToStateIndex(sessionBucket, dest, sessionKey)
+		if err != nil {
+			return err
+		}
+
 		session.State = dest
 
 		// If the session is terminal, we set the revoked at time to the
@@ -825,6 +970,44 @@ func getSessionByID(bucket *bbolt.Bucket, id ID) (*Session, error) {
 	return DeserializeSession(bytes.NewReader(v))
 }
 
+// addToStateIndex adds the session with the given key to the state index of the
+// given state.
+func addToStateIndex(sessionBkt *bbolt.Bucket, state State,
+	sessionKey []byte) error {
+
+	stateIndexBkt := sessionBkt.Bucket(stateIndexKey)
+	if stateIndexBkt == nil {
+		return ErrDBInitErr
+	}
+
+	stateBkt, err := stateIndexBkt.CreateBucketIfNotExists(
+		[]byte{byte(state)},
+	)
+	if err != nil {
+		return err
+	}
+
+	return stateBkt.Put(sessionKey, []byte{})
+}
+
+// removeFromStateIndex removes the session with the given key from the state
+// index of the given state.
+func removeFromStateIndex(sessionBkt *bbolt.Bucket, state State,
+	sessionKey []byte) error {
+
+	stateIndexBkt := sessionBkt.Bucket(stateIndexKey)
+	if stateIndexBkt == nil {
+		return ErrDBInitErr
+	}
+
+	stateBkt := stateIndexBkt.Bucket([]byte{byte(state)})
+	if stateBkt == nil {
+		return nil
+	}
+
+	return stateBkt.Delete(sessionKey)
+}
+
 func putSession(bucket *bbolt.Bucket, session *Session) error {
 	var buf bytes.Buffer
 	if err := SerializeSession(&buf, session); err != nil {
@@ -833,3 +1016,122 @@ func putSession(bucket *bbolt.Bucket, session *Session) error {
 
 	return bucket.Put(getSessionKey(session), buf.Bytes())
 }
+
+// SaveTemplate stores the given config as the template with the given name. If
+// a template with the name already exists, it is only replaced if overwrite
+// is set, otherwise ErrTemplateExists is returned.
+//
+// NOTE: this is part of the TemplateStore interface.
+func (db *BoltStore) SaveTemplate(_ context.Context, name string,
+	config []byte, overwrite bool) (*Template, error) {
+
+	tmpl := &Template{
+		Name:      name,
+		Config:    config,
+		CreatedAt: db.clock.Now().UTC().Truncate(time.Second),
+	}
+	err := db.Update(func(tx *bbolt.Tx) error {
+		templateBucket, err := getBucket(tx, templateBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if templateBucket.Get([]byte(name)) != nil && !overwrite {
+			return ErrTemplateExists
+		}
+
+		var buf bytes.Buffer
+		if err := serializeTemplate(&buf, tmpl); err != nil {
+			return err
+		}
+
+		return templateBucket.Put([]byte(name), buf.Bytes())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// GetTemplate fetches the template with the given name.
+//
+// NOTE: this is part of the TemplateStore interface.
+func (db *BoltStore) GetTemplate(_ context.Context, name string) (*Template,
+	error) {
+
+	var tmpl *Template
+	err := db.View(func(tx *bbolt.Tx) error {
+		templateBucket, err := getBucket(tx, templateBucketKey)
+		if err != nil {
+			return err
+		}
+
+		templateBytes := templateBucket.Get([]byte(name))
+		if templateBytes == nil {
+			return ErrTemplateNotFound
+		}
+
+		tmpl, err = deserializeTemplate(
+			name, bytes.NewReader(templateBytes),
+		)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// ListTemplates returns all templates, sorted by their name.
+//
+// NOTE: this is part of the TemplateStore interface.
+func (db *BoltStore) ListTemplates(_ context.Context) ([]*Template, error) {
+	var templates []*Template
+	err := db.View(func(tx *bbolt.Tx) error {
+		templateBucket, err := getBucket(tx, templateBucketKey)
+		if err != nil {
+			return err
+		}
+
+		// The keys of a bucket are iterated in byte order, which is
+		// the order of the names.
+		return templateBucket.ForEach(func(k, v []byte) error {
+			tmpl, err := deserializeTemplate(
+				string(k), bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			templates = append(templates, tmpl)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// DeleteTemplate removes the template with the given name.
+//
+// NOTE: this is part of the TemplateStore interface.
+func (db *BoltStore) DeleteTemplate(_ context.Context, name string) error {
+	return db.Update(func(tx *bbolt.Tx) error {
+		templateBucket, err := getBucket(tx, templateBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if templateBucket.Get([]byte(name)) == nil {
+			return ErrTemplateNotFound
+		}
+
+		return templateBucket.Delete([]byte(name))
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/lightninglabs/lightning-terminal/session/migration1"
 	"github.com/lightninglabs/lightning-terminal/session/migration2"
+	"github.com/lightninglabs/lightning-terminal/session/migration3"
 	"go.etcd.io/bbolt"
 )
 
@@ -39,6 +40,7 @@ var (
 			)
 		},
 		migration2.MigrateSessionIDToGroupIndex,
+		migration3.MigrateSessionStateIndex,
 	}
 
 	latestDBVersion = uint32(len(dbVersions))
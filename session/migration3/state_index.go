@@ -0,0 +1,96 @@
+package migration3
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/tlv"
+	"go.etcd.io/bbolt"
+)
+
+var (
+	// sessionBucketKey is the top level bucket where we can find all
+	// information about sessions. These sessions are indexed by their
+	// public key.
+	//
+	// The session bucket has the following structure:
+	// session -> <key>       -> <serialised session>
+	//	   -> id-index    -> <session-id> -> key   -> <session key>
+	// 			                  -> group -> <group-ID>
+	// 	   -> group-id-index -> <group-id> -> session-id -> sequence -> <session-id>
+	// 	   -> state-index -> <state> -> <session key>
+	sessionBucketKey = []byte("session")
+
+	// stateIndexKey is the key used to define the state-index sub-bucket
+	// within the main session bucket. This bucket will be used to store
+	// the keys of the sessions that are in a given state.
+	stateIndexKey = []byte("state-index")
+)
+
+// typeState is the tlv type of the state of a serialised session.
+const typeState tlv.Type = 2
+
+// MigrateSessionStateIndex back-fills the session state index so that it has
+// an entry for all sessions that the session store is currently aware of.
+func MigrateSessionStateIndex(tx *bbolt.Tx) error {
+	sessionBucket := tx.Bucket(sessionBucketKey)
+	if sessionBucket == nil {
+		return fmt.Errorf("session bucket not found")
+	}
+
+	stateIndexBkt, err := sessionBucket.CreateBucketIfNotExists(
+		stateIndexKey,
+	)
+	if err != nil {
+		return err
+	}
+
+	return sessionBucket.ForEach(func(key, v []byte) error {
+		// The index sub-buckets are also returned here, skip those
+		// (identified by nil value).
+		if v == nil {
+			return nil
+		}
+
+		state, err := deserializeState(v)
+		if err != nil {
+			return fmt.Errorf("unable to read state of session "+
+				"%x: %w", key, err)
+		}
+
+		stateBkt, err := stateIndexBkt.CreateBucketIfNotExists(
+			[]byte{state},
+		)
+		if err != nil {
+			return err
+		}
+
+		return stateBkt.Put(key, []byte{})
+	})
+}
+
+// deserializeState decodes only the state of the given serialised session.
+// All other records of the session are skipped.
+func deserializeState(v []byte) (uint8, error) {
+	var state uint8
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeState, &state),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+		bytes.NewReader(v),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	if _, ok := parsedTypes[typeState]; !ok {
+		return 0, errors.New("session has no state")
+	}
+
+	return state, nil
+}
@@ -0,0 +1,91 @@
+package migration3
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lightning-terminal/session/migtest"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+// TestMigrateSessionStateIndex tests that the MigrateSessionStateIndex
+// migration correctly back-fills the session state index.
+func TestMigrateSessionStateIndex(t *testing.T) {
+	t.Parallel()
+
+	// Make a few sessions in different states.
+	sess1Key, sess1 := newSession(t, 0)
+	sess2Key, sess2 := newSession(t, 2)
+	sess3Key, sess3 := newSession(t, 0)
+
+	// The id-index is left untouched by the migration.
+	idIndex := map[string]interface{}{
+		"id": map[string]interface{}{
+			"key": string(sess1Key),
+		},
+	}
+
+	// sessionDBBefore is what our session DB will look like before the
+	// migration.
+	sessionDBBefore := map[string]interface{}{
+		string(sess1Key): string(sess1),
+		string(sess2Key): string(sess2),
+		string(sess3Key): string(sess3),
+		"id-index":       idIndex,
+	}
+
+	before := func(tx *bbolt.Tx) error {
+		return migtest.RestoreDB(tx, sessionBucketKey, sessionDBBefore)
+	}
+
+	// sessionDBAfter is what our session DB will look like after the
+	// migration.
+	sessionDBAfter := map[string]interface{}{
+		string(sess1Key): string(sess1),
+		string(sess2Key): string(sess2),
+		string(sess3Key): string(sess3),
+		"id-index":       idIndex,
+		string(stateIndexKey): map[string]interface{}{
+			string([]byte{0}): map[string]interface{}{
+				string(sess1Key): "",
+				string(sess3Key): "",
+			},
+			string([]byte{2}): map[string]interface{}{
+				string(sess2Key): "",
+			},
+		},
+	}
+
+	after := func(tx *bbolt.Tx) error {
+		return migtest.VerifyDB(tx, sessionBucketKey, sessionDBAfter)
+	}
+
+	migtest.ApplyMigration(
+		t, before, after, MigrateSessionStateIndex, false,
+	)
+}
+
+// newSession is a helper function that can be used to generate a new session
+// key and a serialised session in the given state. Besides the state, the
+// session contains a label record that must be skipped by the migration.
+func newSession(t *testing.T, state uint8) ([]byte, []byte) {
+	privateKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	key := privateKey.PubKey().SerializeCompressed()
+
+	label := []byte("label")
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(1, &label),
+		tlv.MakePrimitiveRecord(typeState, &state),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, tlvStream.Encode(&buf))
+
+	return key, buf.Bytes()
+}
@@ -0,0 +1,266 @@
+package session
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/lightninglabs/lightning-node-connect/mailbox"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+)
+
+const (
+	// DefaultReconnectInitialBackoff is the default time a session waits
+	// before its first attempt to reconnect to its mailbox server.
+	DefaultReconnectInitialBackoff = time.Second
+
+	// DefaultReconnectMaxBackoff is the default upper limit of the time a
+	// session waits between two attempts to reconnect.
+	DefaultReconnectMaxBackoff = 2 * time.Minute
+
+	// DefaultReconnectBackoffMultiplier is the default factor the time a
+	// session waits is multiplied by after each failed attempt.
+	DefaultReconnectBackoffMultiplier = 1.6
+
+	// DefaultReconnectResetAfter is the default time a connection must have
+	// lasted for the backoff to start over with the initial backoff.
+	DefaultReconnectResetAfter = time.Minute
+
+	// reconnectJitter is the factor by which the backoff of the gRPC
+	// connection to the mailbox server is randomized.
+	reconnectJitter = 0.2
+
+	// minMailboxConnectTimeout is the minimum time an attempt to connect to
+	// the mailbox server is given to succeed.
+	minMailboxConnectTimeout = 20 * time.Second
+)
+
+// ReconnectConfig holds the backoff that sessions use to reconnect to their
+// mailbox server after the connection dropped.
+type ReconnectConfig struct {
+	// InitialBackoff is the time to wait before the first attempt to
+	// reconnect.
+	InitialBackoff time.Duration `long:"initialbackoff" description:"The time a session waits before its first attempt to reconnect to its mailbox server."`
+
+	// MaxBackoff is the upper limit of the time to wait between two
+	// attempts.
+	MaxBackoff time.Duration `long:"maxbackoff" description:"The upper limit of the time a session waits between two attempts to reconnect to its mailbox server."`
+
+	// BackoffMultiplier is the factor the time to wait is multiplied by
+	// after each failed attempt.
+	BackoffMultiplier float64 `long:"backoffmultiplier" description:"The factor the time a session waits is multiplied by after each failed attempt to reconnect to its mailbox server."`
+
+	// ResetAfter is the time a connection must have lasted for the backoff
+	// to start over.
+	ResetAfter time.Duration `long:"resetafter" description:"The time a connection through the mailbox server must have lasted for the backoff of the next reconnect to start over with the initial backoff. A connection that drops earlier continues the backoff."`
+}
+
+// DefaultReconnectConfig returns the default reconnect backoff of sessions.
+func DefaultReconnectConfig() *ReconnectConfig {
+	return &ReconnectConfig{
+		InitialBackoff:    DefaultReconnectInitialBackoff,
+		MaxBackoff:        DefaultReconnectMaxBackoff,
+		BackoffMultiplier: DefaultReconnectBackoffMultiplier,
+		ResetAfter:        DefaultReconnectResetAfter,
+	}
+}
+
+// Validate checks that the backoff is positive and never decreases.
+func (c *ReconnectConfig) Validate() error {
+	switch {
+	case c.InitialBackoff <= 0:
+		return errors.New("initial reconnect backoff must be positive")
+
+	case c.MaxBackoff < c.InitialBackoff:
+		return errors.New("maximum reconnect backoff must not be " +
+			"lower than the initial backoff")
+
+	case c.BackoffMultiplier < 1:
+		return errors.New("reconnect backoff multiplier must be at " +
+			"least 1")
+
+	case c.ResetAfter < 0:
+		return errors.New("reconnect backoff reset time must not be " +
+			"negative")
+	}
+
+	return nil
+}
+
+// dialOption returns the gRPC dial option that makes the connection to the
+// mailbox server use the backoff when it reconnects. gRPC starts over with the
+// initial backoff as soon as it has reconnected.
+func (c *ReconnectConfig) dialOption() grpc.DialOption {
+	return grpc.WithConnectParams(grpc.ConnectParams{
+		Backoff: backoff.Config{
+			BaseDelay:  c.InitialBackoff,
+			Multiplier: c.BackoffMultiplier,
+			Jitter:     reconnectJitter,
+			MaxDelay:   c.MaxBackoff,
+		},
+		MinConnectTimeout: minMailboxConnectTimeout,
+	})
+}
+
+// reconnectBackoff keeps track of the time to wait before the next attempt to
+// reconnect.
+type reconnectBackoff struct {
+	cfg *ReconnectConfig
+
+	// attempt is the number of attempts since the backoff was last reset.
+	attempt uint32
+
+	// next is the time to wait before the next attempt.
+	next time.Duration
+}
+
+// newReconnectBackoff creates a new reconnectBackoff that starts with the
+// initial backoff of the given config.
+func newReconnectBackoff(cfg *ReconnectConfig) *reconnectBackoff {
+	return &reconnectBackoff{
+		cfg:  cfg,
+		next: cfg.InitialBackoff,
+	}
+}
+
+// nextAttempt returns the number of the next attempt and the time to wait
+// before it, and increases the backoff for the attempt after that.
+func (b *reconnectBackoff) nextAttempt() (uint32, time.Duration) {
+	b.attempt++
+	delay := b.next
+
+	b.next = time.Duration(float64(b.next) * b.cfg.BackoffMultiplier)
+	if b.next > b.cfg.MaxBackoff {
+		b.next = b.cfg.MaxBackoff
+	}
+
+	return b.attempt, delay
+}
+
+// connectionEnded resets the backoff if the connection that ended after the
+// given duration lasted long enough.
+func (b *reconnectBackoff) connectionEnded(lasted time.Duration) {
+	if lasted < b.cfg.ResetAfter {
+		return
+	}
+
+	b.attempt = 0
+	b.next = b.cfg.InitialBackoff
+}
+
+// reconnectListener is a net.Listener that retries to accept a connection from
+// the wrapped mailbox listener with a backoff if an attempt fails temporarily,
+// instead of letting the gRPC server retry with its fixed timing.
+type reconnectListener struct {
+	net.Listener
+
+	// onReconnect is called before each attempt to reconnect with the
+	// number of the attempt and the time until it is made.
+	onReconnect func(attempt uint32, delay time.Duration)
+
+	mu      sync.Mutex
+	backoff *reconnectBackoff
+
+	quit      chan struct{}
+	closeOnce sync.Once
+}
+
+// newReconnectListener creates a new reconnectListener that wraps the given
+// mailbox listener.
+func newReconnectListener(l net.Listener, cfg *ReconnectConfig,
+	onReconnect func(attempt uint32,
+		delay time.Duration)) *reconnectListener {
+
+	return &reconnectListener{
+		Listener:    l,
+		onReconnect: onReconnect,
+		backoff:     newReconnectBackoff(cfg),
+		quit:        make(chan struct{}),
+	}
+}
+
+// Accept waits for the next connection through the mailbox. Temporary errors
+// are retried with the backoff until the listener is closed.
+//
+// NOTE: This is part of the net.Listener interface.
+func (l *reconnectListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err == nil {
+			// The noise handshake of the session requires the
+			// connection to be a mailbox connection.
+			proxyConn, ok := conn.(mailbox.ProxyConn)
+			if !ok {
+				return conn, nil
+			}
+
+			return &reconnectConn{
+				ProxyConn: proxyConn,
+				listener:  l,
+				start:     time.Now(),
+			}, nil
+		}
+
+		var tempErr interface{ Temporary() bool }
+		if !errors.As(err, &tempErr) || !tempErr.Temporary() {
+			return nil, err
+		}
+
+		l.mu.Lock()
+		attempt, delay := l.backoff.nextAttempt()
+		l.mu.Unlock()
+
+		log.Debugf("Unable to accept mailbox connection, reconnecting "+
+			"in %v (attempt %d): %v", delay, attempt, err)
+		l.onReconnect(attempt, delay)
+
+		select {
+		case <-time.After(delay):
+		case <-l.quit:
+			return nil, net.ErrClosed
+		}
+	}
+}
+
+// Close closes the wrapped mailbox listener and stops any pending reconnect.
+//
+// NOTE: This is part of the net.Listener interface.
+func (l *reconnectListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.quit)
+	})
+
+	return l.Listener.Close()
+}
+
+// connectionEnded is called once a connection that was accepted through the
+// listener is closed after the given duration.
+func (l *reconnectListener) connectionEnded(lasted time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.backoff.connectionEnded(lasted)
+}
+
+// reconnectConn is a connection that was accepted by a reconnectListener. It
+// reports how long it lasted once it is closed.
+type reconnectConn struct {
+	mailbox.ProxyConn
+
+	listener  *reconnectListener
+	start     time.Time
+	closeOnce sync.Once
+}
+
+// Close closes the wrapped connection.
+//
+// NOTE: This is part of the net.Conn interface.
+func (c *reconnectConn) Close() error {
+	c.closeOnce.Do(func() {
+		c.listener.connectionEnded(time.Since(c.start))
+	})
+
+	return c.ProxyConn.Close()
+}
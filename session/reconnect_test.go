@@ -0,0 +1,222 @@
+package session
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lightning-node-connect/mailbox"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReconnectConfigValidate tests that only backoffs that are positive and
+// never decrease are accepted.
+func TestReconnectConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, DefaultReconnectConfig().Validate())
+
+	for name, modify := range map[string]func(*ReconnectConfig){
+		"zero initial": func(c *ReconnectConfig) {
+			c.InitialBackoff = 0
+		},
+		"max below initial": func(c *ReconnectConfig) {
+			c.MaxBackoff = c.InitialBackoff / 2
+		},
+		"decreasing": func(c *ReconnectConfig) {
+			c.BackoffMultiplier = 0.5
+		},
+		"negative reset": func(c *ReconnectConfig) {
+			c.ResetAfter = -time.Second
+		},
+	} {
+		cfg := DefaultReconnectConfig()
+		modify(cfg)
+		require.Error(t, cfg.Validate(), name)
+	}
+}
+
+// TestReconnectBackoff tests that the backoff grows up to the maximum and only
+// starts over after a connection lasted long enough.
+func TestReconnectBackoff(t *testing.T) {
+	t.Parallel()
+
+	b := newReconnectBackoff(&ReconnectConfig{
+		InitialBackoff:    time.Second,
+		MaxBackoff:        5 * time.Second,
+		BackoffMultiplier: 2,
+		ResetAfter:        time.Minute,
+	})
+
+	expectAttempt := func(attempt uint32, delay time.Duration) {
+		t.Helper()
+
+		a, d := b.nextAttempt()
+		require.Equal(t, attempt, a)
+		require.Equal(t, delay, d)
+	}
+
+	expectAttempt(1, time.Second)
+	expectAttempt(2, 2*time.Second)
+	expectAttempt(3, 4*time.Second)
+	expectAttempt(4, 5*time.Second)
+
+	// A connection that drops quickly doesn't reset the backoff.
+	b.connectionEnded(time.Second)
+	expectAttempt(5, 5*time.Second)
+
+	// A sustained connection does.
+	b.connectionEnded(time.Minute)
+	expectAttempt(1, time.Second)
+}
+
+// mockListener is a net.Listener that returns the queued results of Accept.
+type mockListener struct {
+	net.Listener
+
+	results chan error
+}
+
+func (l *mockListener) Accept() (net.Conn, error) {
+	if err := <-l.results; err != nil {
+		return nil, err
+	}
+
+	return mockProxyConn{}, nil
+}
+
+func (l *mockListener) Close() error { return nil }
+
+// temporaryErr is an error that the mailbox listener returns if it failed to
+// connect to the mailbox server.
+type temporaryErr struct{}
+
+func (temporaryErr) Error() string   { return "temporary" }
+func (temporaryErr) Temporary() bool { return true }
+
+// mockProxyConn is a mailbox connection that can only be closed.
+type mockProxyConn struct {
+	mailbox.ProxyConn
+}
+
+func (mockProxyConn) Close() error { return nil }
+
+// TestReconnectListener tests that the listener retries temporary errors with
+// the backoff and resets it after a sustained connection.
+func TestReconnectListener(t *testing.T) {
+	t.Parallel()
+
+	inner := &mockListener{results: make(chan error, 10)}
+
+	var attempts []uint32
+	l := newReconnectListener(inner, &ReconnectConfig{
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        10 * time.Millisecond,
+		BackoffMultiplier: 2,
+		ResetAfter:        50 * time.Millisecond,
+	}, func(attempt uint32, _ time.Duration) {
+		attempts = append(attempts, attempt)
+	})
+
+	// Two failed attempts are retried before the connection succeeds.
+	inner.results <- temporaryErr{}
+	inner.results <- temporaryErr{}
+	inner.results <- nil
+	conn, err := l.Accept()
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2}, attempts)
+
+	// The connection drops quickly, so the backoff continues.
+	require.NoError(t, conn.Close())
+	inner.results <- temporaryErr{}
+	inner.results <- nil
+	conn, err = l.Accept()
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2, 3}, attempts)
+
+	// After a sustained connection the backoff starts over.
+	time.Sleep(50 * time.Millisecond)
+	require.NoError(t, conn.Close())
+	inner.results <- temporaryErr{}
+	inner.results <- nil
+	_, err = l.Accept()
+	require.NoError(t, err)
+	require.Equal(t, []uint32{1, 2, 3, 1}, attempts)
+
+	// Other errors are returned directly.
+	errFatal := errors.New("fatal")
+	inner.results <- errFatal
+	_, err = l.Accept()
+	require.ErrorIs(t, err, errFatal)
+
+	// Closing the listener stops a pending reconnect.
+	l.backoff.next = time.Hour
+	inner.results <- temporaryErr{}
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := l.Accept()
+		errChan <- err
+	}()
+	require.Eventually(t, func() bool {
+		return len(inner.results) == 0
+	}, time.Second, time.Millisecond)
+	require.NoError(t, l.Close())
+	require.ErrorIs(t, <-errChan, net.ErrClosed)
+}
+
+// TestConnEventBroker tests that subscribers receive the latest event of each
+// active session first and that slow subscribers are dropped.
+func TestConnEventBroker(t *testing.T) {
+	t.Parallel()
+
+	newEvent := func(key *btcec.PublicKey,
+		typ ConnEventType) *ConnEvent {
+
+		return &ConnEvent{Type: typ, LocalPublicKey: key}
+	}
+
+	key1, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	key2, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	b := newConnEventBroker()
+	b.publish(newEvent(key1.PubKey(), ConnEventConnected))
+	b.publish(newEvent(key1.PubKey(), ConnEventDisconnected))
+	b.publish(newEvent(key2.PubKey(), ConnEventReconnecting))
+
+	snapshot, events, cancel := b.subscribe()
+	require.Len(t, snapshot, 2)
+
+	types := map[ConnEventType]bool{}
+	for _, event := range snapshot {
+		types[event.Type] = true
+	}
+	require.Equal(t, map[ConnEventType]bool{
+		ConnEventDisconnected: true,
+		ConnEventReconnecting: true,
+	}, types)
+
+	// A stopped session is no longer part of the snapshot.
+	var id sessionID
+	copy(id[:], key2.PubKey().SerializeCompressed())
+	b.forget(id)
+
+	snapshot, _, cancel2 := b.subscribe()
+	require.Len(t, snapshot, 1)
+	cancel2()
+
+	// New events are delivered to the subscriber.
+	b.publish(newEvent(key1.PubKey(), ConnEventConnected))
+	require.Equal(t, ConnEventConnected, (<-events).Type)
+
+	// A subscriber that doesn't keep up is dropped.
+	for i := 0; i <= connEventBufferSize; i++ {
+		b.publish(newEvent(key1.PubKey(), ConnEventConnected))
+	}
+	for range events {
+	}
+	cancel()
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
@@ -35,7 +36,8 @@ func newMailboxSession() *mailboxSession {
 }
 
 func (m *mailboxSession) start(session *Session,
-	serverCreator GRPCServerCreator, authData []byte,
+	serverCreator GRPCServerCreator, reconnectCfg *ReconnectConfig,
+	connEvents *connEventBroker, authData []byte,
 	onUpdate func(ctx context.Context, id ID,
 		remote *btcec.PublicKey) error,
 	onNewStatus func(s mailbox.ServerStatus)) error {
@@ -57,32 +59,61 @@ func (m *mailboxSession) start(session *Session,
 		}, nil,
 	)
 
+	// The status callback is called while the mailbox server holds its
+	// lock, which is fine as publishing a connection event never blocks.
+	onStatus := func(status mailbox.ServerStatus) {
+		connEvents.publish(connEventFromStatus(session, status))
+
+		if onNewStatus != nil {
+			onNewStatus(status)
+		}
+	}
+
 	// Start the mailbox gRPC server.
 	mailboxServer, err := mailbox.NewServer(
-		session.ServerAddr, keys, onNewStatus,
+		session.ServerAddr, keys, onStatus,
 		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
 			Time: 2 * time.Minute,
 		}),
+		reconnectCfg.dialOption(),
 	)
 	if err != nil {
 		return err
 	}
 
+	listener := newReconnectListener(
+		mailboxServer, reconnectCfg,
+		func(attempt uint32, delay time.Duration) {
+			connEvents.publish(&ConnEvent{
+				Type:           ConnEventReconnecting,
+				SessionID:      session.ID,
+				LocalPublicKey: session.LocalPublicKey,
+				Attempt:        attempt,
+				Backoff:        delay,
+				Timestamp:      time.Now(),
+			})
+		},
+	)
+
 	noiseConn := mailbox.NewNoiseGrpcConn(keys)
-	m.server = serverCreator(grpc.Creds(noiseConn))
+	serverOpts := append(
+		[]grpc.ServerOption{grpc.Creds(noiseConn)},
+		errorVerbosityServerOpts(session.ErrorVerbosity)...,
+	)
+	m.server = serverCreator(serverOpts...)
 
 	m.wg.Add(1)
-	go m.run(mailboxServer)
+	go m.run(listener)
 
 	return nil
 }
 
-func (m *mailboxSession) run(mailboxServer *mailbox.Server) {
+func (m *mailboxSession) run(listener net.Listener) {
 	defer m.wg.Done()
 
-	log.Infof("Mailbox RPC server listening on %s", mailboxServer.Addr())
-	if err := m.server.Serve(mailboxServer); err != nil {
+	log.Infof("Mailbox RPC server listening on %s", listener.Addr())
+	if err := m.server.Serve(listener); err != nil {
 		log.Errorf("Unable to serve mailbox gRPC: %v", err)
 	}
 }
@@ -97,15 +128,27 @@ func (m *mailboxSession) stop() {
 type Server struct {
 	serverCreator GRPCServerCreator
 
+	// reconnectCfg is the backoff that the sessions use to reconnect to
+	// their mailbox server.
+	reconnectCfg *ReconnectConfig
+
+	// connEvents notifies subscribers about changes to the mailbox
+	// connections of the sessions.
+	connEvents *connEventBroker
+
 	activeSessions    map[sessionID]*mailboxSession
 	activeSessionsMtx sync.Mutex
 
 	quit chan struct{}
 }
 
-func NewServer(serverCreator GRPCServerCreator) *Server {
+func NewServer(serverCreator GRPCServerCreator,
+	reconnectCfg *ReconnectConfig) *Server {
+
 	return &Server{
 		serverCreator:  serverCreator,
+		reconnectCfg:   reconnectCfg,
+		connEvents:     newConnEventBroker(),
 		activeSessions: make(map[sessionID]*mailboxSession),
 		quit:           make(chan struct{}),
 	}
@@ -131,10 +174,22 @@ func (s *Server) StartSession(session *Session, authData []byte,
 	s.activeSessions[id] = sess
 
 	return sess.quit, sess.start(
-		session, s.serverCreator, authData, onUpdate, onNewStatus,
+		session, s.serverCreator, s.reconnectCfg, s.connEvents,
+		authData, onUpdate, onNewStatus,
 	)
 }
 
+// SubscribeConnEvents returns the latest connection event of each active
+// session and a channel that receives an event whenever the mailbox connection
+// of an active session changes afterwards. The channel is closed if the
+// subscriber doesn't keep up with the events. The returned function must be
+// called to cancel the subscription.
+func (s *Server) SubscribeConnEvents() ([]*ConnEvent, <-chan *ConnEvent,
+	func()) {
+
+	return s.connEvents.subscribe()
+}
+
 func (s *Server) StopSession(localPublicKey *btcec.PublicKey) error {
 	s.activeSessionsMtx.Lock()
 	defer s.activeSessionsMtx.Unlock()
@@ -149,6 +204,7 @@ func (s *Server) StopSession(localPublicKey *btcec.PublicKey) error {
 
 	s.activeSessions[id].stop()
 	delete(s.activeSessions, id)
+	s.connEvents.forget(id)
 
 	return nil
 }
@@ -160,5 +216,6 @@ func (s *Server) Stop() {
 	for id, session := range s.activeSessions {
 		session.stop()
 		delete(s.activeSessions, id)
+		s.connEvents.forget(id)
 	}
 }
@@ -0,0 +1,53 @@
+package session
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// DefaultMailboxServerAddr is the address of the mailbox server that sessions
+// use if no other mailbox server is set.
+const DefaultMailboxServerAddr = "mailbox.terminal.lightning.today:443"
+
+// ValidateServerAddr checks that the given mailbox server address is of the
+// form host:port.
+func ValidateServerAddr(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("invalid mailbox server address %q: %w", addr,
+			err)
+	}
+
+	if host == "" {
+		return fmt.Errorf("invalid mailbox server address %q: missing "+
+			"host", addr)
+	}
+
+	portNum, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || portNum == 0 {
+		return fmt.Errorf("invalid mailbox server address %q: invalid "+
+			"port %q", addr, port)
+	}
+
+	return nil
+}
+
+// VerifyServerAddr makes sure that a TLS connection can be established to the
+// mailbox server with the given address. The certificate of a dev server isn't
+// verified, just like when a session connects to it.
+func VerifyServerAddr(ctx context.Context, addr string, devServer bool) error {
+	dialer := &tls.Dialer{
+		Config: &tls.Config{InsecureSkipVerify: devServer},
+	}
+
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return fmt.Errorf("unable to connect to mailbox server %s: %w",
+			addr, err)
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,49 @@
+package session
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestValidateServerAddr tests that only mailbox server addresses of the form
+// host:port are accepted.
+func TestValidateServerAddr(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateServerAddr(DefaultMailboxServerAddr))
+	require.NoError(t, ValidateServerAddr("127.0.0.1:11110"))
+	require.NoError(t, ValidateServerAddr("[::1]:443"))
+
+	for _, addr := range []string{
+		"", "mailbox.example.com", ":443", "mailbox.example.com:",
+		"mailbox.example.com:0", "mailbox.example.com:https",
+		"mailbox.example.com:70000",
+	} {
+		require.Error(t, ValidateServerAddr(addr), addr)
+	}
+}
+
+// TestVerifyServerAddr tests that a mailbox server is only reachable if its
+// certificate is valid, unless it is a dev server.
+func TestVerifyServerAddr(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+	addr := strings.TrimPrefix(server.URL, "https://")
+
+	// The test server uses a self-signed certificate.
+	require.ErrorContains(
+		t, VerifyServerAddr(ctx, addr, false), "certificate",
+	)
+	require.NoError(t, VerifyServerAddr(ctx, addr, true))
+
+	server.Close()
+	require.Error(t, VerifyServerAddr(ctx, addr, true))
+}
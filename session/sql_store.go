@@ -32,21 +32,29 @@ type SQLQueries interface {
 	GetSessionIDByAlias(ctx context.Context, legacyID []byte) (int64, error)
 	GetSessionMacaroonPermissions(ctx context.Context, sessionID int64) ([]sqlc.SessionMacaroonPermission, error)
 	GetSessionPrivacyFlags(ctx context.Context, sessionID int64) ([]sqlc.SessionPrivacyFlag, error)
+	GetSessionClearAmountFields(ctx context.Context, sessionID int64) ([]sqlc.SessionClearAmountField, error)
 	InsertSessionFeatureConfig(ctx context.Context, arg sqlc.InsertSessionFeatureConfigParams) error
 	SetSessionRevokedAt(ctx context.Context, arg sqlc.SetSessionRevokedAtParams) error
 	InsertSessionMacaroonCaveat(ctx context.Context, arg sqlc.InsertSessionMacaroonCaveatParams) error
 	InsertSessionMacaroonPermission(ctx context.Context, arg sqlc.InsertSessionMacaroonPermissionParams) error
 	InsertSessionPrivacyFlag(ctx context.Context, arg sqlc.InsertSessionPrivacyFlagParams) error
+	InsertSessionClearAmountField(ctx context.Context, arg sqlc.InsertSessionClearAmountFieldParams) error
 	InsertSession(ctx context.Context, arg sqlc.InsertSessionParams) (int64, error)
 	ListSessions(ctx context.Context) ([]sqlc.Session, error)
 	ListSessionsByType(ctx context.Context, sessionType int16) ([]sqlc.Session, error)
 	ListSessionsByState(ctx context.Context, state int16) ([]sqlc.Session, error)
+	ListSessionsByAccountID(ctx context.Context, accountID sql.NullInt64) ([]sqlc.Session, error)
 	SetSessionRemotePublicKey(ctx context.Context, arg sqlc.SetSessionRemotePublicKeyParams) error
+	SetSessionExpiry(ctx context.Context, arg sqlc.SetSessionExpiryParams) error
 	SetSessionGroupID(ctx context.Context, arg sqlc.SetSessionGroupIDParams) error
 	UpdateSessionState(ctx context.Context, arg sqlc.UpdateSessionStateParams) error
 	DeleteSessionsWithState(ctx context.Context, state int16) error
 	GetAccountIDByAlias(ctx context.Context, alias int64) (int64, error)
 	GetAccount(ctx context.Context, id int64) (sqlc.Account, error)
+	UpsertSessionTemplate(ctx context.Context, arg sqlc.UpsertSessionTemplateParams) error
+	GetSessionTemplate(ctx context.Context, name string) (sqlc.SessionTemplate, error)
+	ListSessionTemplates(ctx context.Context) ([]sqlc.SessionTemplate, error)
+	DeleteSessionTemplate(ctx context.Context, name string) error
 }
 
 var _ Store = (*SQLStore)(nil)
@@ -159,6 +167,16 @@ func (s *SQLStore) NewSession(ctx context.Context, label string, typ Type,
 			LocalPublicKey:  localKey,
 			Privacy:         sess.WithPrivacyMapper,
 			AccountID:       acctIDInt64,
+			ErrorVerbosity:  int16(sess.ErrorVerbosity),
+			AmountObfuscation: int16(
+				sess.AmountObfuscation.Strategy,
+			),
+			AmountVariationPpm: int64(
+				sess.AmountObfuscation.VariationPPM,
+			),
+			AmountBucketSize: int64(
+				sess.AmountObfuscation.BucketSize,
+			),
 		})
 		if err != nil {
 			return fmt.Errorf("unable to insert session: %w", err)
@@ -280,6 +298,20 @@ func (s *SQLStore) NewSession(ctx context.Context, label string, typ Type,
 			}
 		}
 
+		// Write the amount fields that should not be obfuscated.
+		for _, field := range sess.AmountObfuscation.ClearFields {
+			err := db.InsertSessionClearAmountField(
+				ctx, sqlc.InsertSessionClearAmountFieldParams{
+					SessionID: dbID,
+					FieldName: field,
+				},
+			)
+			if err != nil {
+				return fmt.Errorf("unable to insert clear "+
+					"amount field: %w", err)
+			}
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -330,6 +362,56 @@ func (s *SQLStore) ListSessionsByType(ctx context.Context, t Type) ([]*Session,
 	return sessions, err
 }
 
+// ListSessionsByAccount returns all sessions that are linked to the account
+// with the given ID.
+//
+// NOTE: this is part of the Store interface.
+func (s *SQLStore) ListSessionsByAccount(ctx context.Context,
+	id accounts.AccountID) ([]*Session, error) {
+
+	var (
+		readTxOpts = db.NewQueryReadTx()
+		sessions   []*Session
+	)
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLQueries) error {
+		acctAlias, err := id.ToInt64()
+		if err != nil {
+			return err
+		}
+
+		acctDBID, err := db.GetAccountIDByAlias(ctx, acctAlias)
+		if errors.Is(err, sql.ErrNoRows) {
+			return accounts.ErrAccNotFound
+		} else if err != nil {
+			return err
+		}
+
+		dbSessions, err := db.ListSessionsByAccountID(
+			ctx, sql.NullInt64{
+				Int64: acctDBID,
+				Valid: true,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("could not list sessions: %w", err)
+		}
+
+		for _, dbSess := range dbSessions {
+			sess, err := unmarshalSession(ctx, db, dbSess)
+			if err != nil {
+				return fmt.Errorf("could not unmarshal "+
+					"session: %w", err)
+			}
+
+			sessions = append(sessions, sess)
+		}
+
+		return nil
+	})
+
+	return sessions, err
+}
+
 // ListSessionsByState returns all sessions currently known to the store that
 // are in the given state.
 //
@@ -524,6 +606,161 @@ func (s *SQLStore) UpdateSessionRemotePubKey(ctx context.Context, alias ID,
 	})
 }
 
+// UpdateSessionExpiry sets the expiry of the session with the given ID.
+// ErrSessionNotActive is returned if the session is already revoked or
+// expired.
+//
+// NOTE: this is part of the Store interface.
+func (s *SQLStore) UpdateSessionExpiry(ctx context.Context, alias ID,
+	expiry time.Time) error {
+
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		dbSession, err := db.GetSessionByAlias(ctx, alias[:])
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: %w", ErrSessionNotFound, err)
+		} else if err != nil {
+			return fmt.Errorf("unable to get session: %w", err)
+		}
+
+		if State(dbSession.State).Terminal() {
+			return ErrSessionNotActive
+		}
+
+		return db.SetSessionExpiry(ctx, sqlc.SetSessionExpiryParams{
+			ID:     dbSession.ID,
+			Expiry: expiry.UTC(),
+		})
+	})
+}
+
+// SaveTemplate stores the given config as the template with the given name. If
+// a template with the name already exists, it is only replaced if overwrite
+// is set, otherwise ErrTemplateExists is returned.
+//
+// NOTE: this is part of the TemplateStore interface.
+func (s *SQLStore) SaveTemplate(ctx context.Context, name string,
+	config []byte, overwrite bool) (*Template, error) {
+
+	tmpl := &Template{
+		Name:      name,
+		Config:    config,
+		CreatedAt: s.clock.Now().UTC().Truncate(time.Second),
+	}
+
+	var writeTxOpts db.QueriesTxOptions
+	err := s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		_, err := db.GetSessionTemplate(ctx, name)
+		switch {
+		case err == nil && !overwrite:
+			return ErrTemplateExists
+
+		case err != nil && !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("unable to get session template: %w",
+				err)
+		}
+
+		return db.UpsertSessionTemplate(
+			ctx, sqlc.UpsertSessionTemplateParams{
+				Name:      name,
+				Config:    config,
+				CreatedAt: tmpl.CreatedAt,
+			},
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// GetTemplate fetches the template with the given name.
+//
+// NOTE: this is part of the TemplateStore interface.
+func (s *SQLStore) GetTemplate(ctx context.Context, name string) (*Template,
+	error) {
+
+	var (
+		readTxOpts = db.NewQueryReadTx()
+		tmpl       *Template
+	)
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLQueries) error {
+		dbTemplate, err := db.GetSessionTemplate(ctx, name)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTemplateNotFound
+		} else if err != nil {
+			return fmt.Errorf("unable to get session template: %w",
+				err)
+		}
+
+		tmpl = unmarshalTemplate(dbTemplate)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+// ListTemplates returns all templates, sorted by their name.
+//
+// NOTE: this is part of the TemplateStore interface.
+func (s *SQLStore) ListTemplates(ctx context.Context) ([]*Template, error) {
+	var (
+		readTxOpts = db.NewQueryReadTx()
+		templates  []*Template
+	)
+	err := s.db.ExecTx(ctx, &readTxOpts, func(db SQLQueries) error {
+		dbTemplates, err := db.ListSessionTemplates(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, dbTemplate := range dbTemplates {
+			templates = append(
+				templates, unmarshalTemplate(dbTemplate),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+// DeleteTemplate removes the template with the given name.
+//
+// NOTE: this is part of the TemplateStore interface.
+func (s *SQLStore) DeleteTemplate(ctx context.Context, name string) error {
+	var writeTxOpts db.QueriesTxOptions
+	return s.db.ExecTx(ctx, &writeTxOpts, func(db SQLQueries) error {
+		_, err := db.GetSessionTemplate(ctx, name)
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrTemplateNotFound
+		} else if err != nil {
+			return fmt.Errorf("unable to get session template: %w",
+				err)
+		}
+
+		return db.DeleteSessionTemplate(ctx, name)
+	})
+}
+
+// unmarshalTemplate converts the given sqlc.SessionTemplate into a Template.
+func unmarshalTemplate(dbTemplate sqlc.SessionTemplate) *Template {
+	return &Template{
+		Name:      dbTemplate.Name,
+		Config:    dbTemplate.Config,
+		CreatedAt: dbTemplate.CreatedAt.UTC(),
+	}
+}
+
 // getSqlUnusedAliasAndKeyPair can be used to generate a new, unused, local
 // private key and session Alias pair. Care must be taken to ensure that no
 // other thread calls this before the returned Alias and key pair from this
@@ -773,6 +1010,25 @@ func unmarshalSession(ctx context.Context, db SQLQueries,
 		privFlags = unmarshalPrivacyFlags(privacyFlags)
 	}
 
+	clearFields, err := db.GetSessionClearAmountFields(ctx, dbSess.ID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get clear amount fields: %v",
+			err)
+	}
+
+	amountObfuscation := AmountObfuscation{
+		Strategy: AmountObfuscationStrategy(
+			dbSess.AmountObfuscation,
+		),
+		VariationPPM: uint32(dbSess.AmountVariationPpm),
+		BucketSize:   uint64(dbSess.AmountBucketSize),
+	}
+	for _, field := range clearFields {
+		amountObfuscation.ClearFields = append(
+			amountObfuscation.ClearFields, field.FieldName,
+		)
+	}
+
 	var pairingSecret [mailbox.NumPassphraseEntropyBytes]byte
 	copy(pairingSecret[:], dbSess.PairingSecret)
 
@@ -797,6 +1053,8 @@ func unmarshalSession(ctx context.Context, db SQLQueries,
 		MacaroonRecipe:    macRecipe,
 		FeatureConfig:     featureCfgs,
 		AccountID:         acctAlias,
+		ErrorVerbosity:    ErrorVerbosity(dbSess.ErrorVerbosity),
+		AmountObfuscation: amountObfuscation,
 	}, nil
 }
 
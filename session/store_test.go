@@ -321,6 +321,15 @@ func TestStateShift(t *testing.T) {
 	require.Equal(t, StateRevoked, s1.State)
 	require.True(t, clock.Now().Equal(s1.RevokedAt))
 
+	// The session should only be listed under its new state.
+	sessions, err := db.ListSessionsByState(ctx, StateCreated)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+	sessions, err = db.ListSessionsByState(ctx, StateRevoked)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, s1.ID, sessions[0].ID)
+
 	// Trying to do the same state shift again should succeed since the
 	// session is already in the expected "dest" state. The revoked-at time
 	// should not have changed though.
@@ -336,6 +345,144 @@ func TestStateShift(t *testing.T) {
 	require.ErrorContains(t, err, "illegal session state transition")
 }
 
+// TestUpdateSessionExpiry tests that the expiry of an active session can be
+// changed, while revoked sessions can't be extended anymore.
+func TestUpdateSessionExpiry(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	clock := clock.NewTestClock(testTime)
+	db := NewTestDB(t, clock)
+
+	s1 := createSession(t, db, "label 1")
+
+	newExpiry := s1.Expiry.Add(24 * time.Hour)
+	require.NoError(t, db.UpdateSessionExpiry(ctx, s1.ID, newExpiry))
+
+	s1, err := db.GetSession(ctx, s1.ID)
+	require.NoError(t, err)
+	require.True(t, newExpiry.Equal(s1.Expiry))
+
+	// Once the session is revoked, its expiry can't be changed anymore.
+	require.NoError(t, db.ShiftState(ctx, s1.ID, StateRevoked))
+	err = db.UpdateSessionExpiry(ctx, s1.ID, newExpiry.Add(time.Hour))
+	require.ErrorIs(t, err, ErrSessionNotActive)
+
+	// Unknown sessions can't be updated either.
+	err = db.UpdateSessionExpiry(ctx, ID{1, 2, 3, 4}, newExpiry)
+	require.ErrorIs(t, err, ErrSessionNotFound)
+}
+
+// TestSessionTemplates tests that session templates can be saved, listed,
+// overwritten and deleted.
+func TestSessionTemplates(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	clock := clock.NewTestClock(testTime)
+	db := NewTestDB(t, clock)
+
+	templates, err := db.ListTemplates(ctx)
+	require.NoError(t, err)
+	require.Empty(t, templates)
+
+	_, err = db.GetTemplate(ctx, "readonly")
+	require.ErrorIs(t, err, ErrTemplateNotFound)
+
+	tmpl1, err := db.SaveTemplate(ctx, "readonly", []byte{1, 2}, false)
+	require.NoError(t, err)
+	require.Equal(t, &Template{
+		Name:      "readonly",
+		Config:    []byte{1, 2},
+		CreatedAt: testTime,
+	}, tmpl1)
+
+	_, err = db.SaveTemplate(ctx, "admin", []byte{3}, false)
+	require.NoError(t, err)
+
+	// Templates are listed by their name.
+	templates, err = db.ListTemplates(ctx)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	require.Equal(t, "admin", templates[0].Name)
+	require.Equal(t, tmpl1, templates[1])
+
+	// A template is only replaced if it should be overwritten.
+	_, err = db.SaveTemplate(ctx, "readonly", []byte{4}, false)
+	require.ErrorIs(t, err, ErrTemplateExists)
+
+	clock.SetTime(testTime.Add(time.Hour))
+	_, err = db.SaveTemplate(ctx, "readonly", []byte{4}, true)
+	require.NoError(t, err)
+
+	tmpl1, err = db.GetTemplate(ctx, "readonly")
+	require.NoError(t, err)
+	require.Equal(t, []byte{4}, tmpl1.Config)
+	require.Equal(t, testTime.Add(time.Hour), tmpl1.CreatedAt)
+
+	require.NoError(t, db.DeleteTemplate(ctx, "readonly"))
+	err = db.DeleteTemplate(ctx, "readonly")
+	require.ErrorIs(t, err, ErrTemplateNotFound)
+
+	templates, err = db.ListTemplates(ctx)
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+}
+
+// TestErrorVerbosity tests that the error verbosity of a session is persisted
+// correctly.
+func TestErrorVerbosity(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	clock := clock.NewTestClock(testTime)
+	db := NewTestDB(t, clock)
+
+	// A session created without an explicit error verbosity should default
+	// to verbose errors.
+	s1 := createSession(t, db, "session 1")
+	require.Equal(t, ErrorVerbosityVerbose, s1.ErrorVerbosity)
+
+	// A session created with terse error verbosity should keep it across
+	// reads.
+	s2 := createSession(
+		t, db, "session 2", withErrorVerbosity(ErrorVerbosityTerse),
+	)
+	require.Equal(t, ErrorVerbosityTerse, s2.ErrorVerbosity)
+
+	s2, err := db.GetSessionByLocalPub(ctx, s2.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, ErrorVerbosityTerse, s2.ErrorVerbosity)
+}
+
+// TestAmountObfuscation tests that the amount obfuscation config of a session
+// is persisted correctly.
+func TestAmountObfuscation(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	clock := clock.NewTestClock(testTime)
+	db := NewTestDB(t, clock)
+
+	// A session created without an amount obfuscation config should use
+	// the default config.
+	s1 := createSession(t, db, "session 1")
+	require.Equal(t, AmountObfuscation{}, s1.AmountObfuscation)
+
+	// A session created with a custom config should keep it across reads.
+	cfg := AmountObfuscation{
+		Strategy:    AmountObfuscationBucket,
+		BucketSize:  10_000,
+		ClearFields: []string{"capacity"},
+	}
+	s2 := createSession(t, db, "session 2", withAmountObfuscation(cfg))
+	require.Equal(t, cfg, s2.AmountObfuscation)
+
+	s2, err := db.GetSessionByLocalPub(ctx, s2.LocalPublicKey)
+	require.NoError(t, err)
+	require.Equal(t, cfg, s2.AmountObfuscation)
+}
+
 // TestLinkedAccount tests that linking a session to an account works as
 // expected.
 func TestLinkedAccount(t *testing.T) {
@@ -375,10 +522,57 @@ func TestLinkedAccount(t *testing.T) {
 	})
 }
 
+// TestListSessionsByAccount tests that sessions can be listed by the account
+// they are linked to.
+func TestListSessionsByAccount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	clock := clock.NewTestClock(testTime)
+
+	accts := accounts.NewTestDB(t, clock)
+	db := NewTestDBWithAccounts(t, clock, accts)
+
+	// Listing the sessions of an unknown account should fail.
+	_, err := db.ListSessionsByAccount(ctx, accounts.AccountID{1, 2, 3})
+	require.ErrorIs(t, err, accounts.ErrAccNotFound)
+
+	acct1, err := accts.NewAccount(ctx, 1234, clock.Now().Add(time.Hour), "")
+	require.NoError(t, err)
+
+	acct2, err := accts.NewAccount(ctx, 4321, clock.Now().Add(time.Hour), "")
+	require.NoError(t, err)
+
+	// Without any linked sessions, the list should be empty.
+	sessions, err := db.ListSessionsByAccount(ctx, acct1.ID)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	// Link two sessions to the first account and one to the second. Also
+	// add a session that isn't linked to any account.
+	s1 := createSession(t, db, "session 1", withAccount(acct1.ID))
+	s2 := createSession(t, db, "session 2", withAccount(acct2.ID))
+	clock.SetTime(testTime.Add(time.Second))
+	s3 := createSession(t, db, "session 3", withAccount(acct1.ID))
+	_ = createSession(t, db, "session 4")
+
+	sessions, err = db.ListSessionsByAccount(ctx, acct1.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assertEqualSessions(t, s1, sessions[0])
+	assertEqualSessions(t, s3, sessions[1])
+
+	sessions, err = db.ListSessionsByAccount(ctx, acct2.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assertEqualSessions(t, s2, sessions[0])
+}
+
 type testSessionOpts struct {
-	groupID  *ID
-	sessType Type
-	account  fn.Option[accounts.AccountID]
+	groupID        *ID
+	sessType       Type
+	account        fn.Option[accounts.AccountID]
+	errorVerbosity ErrorVerbosity
+	amountCfg      AmountObfuscation
 }
 
 func defaultTestSessOpts() *testSessionOpts {
@@ -410,6 +604,18 @@ func withAccount(alias accounts.AccountID) testSessionModifier {
 	}
 }
 
+func withErrorVerbosity(verbosity ErrorVerbosity) testSessionModifier {
+	return func(s *testSessionOpts) {
+		s.errorVerbosity = verbosity
+	}
+}
+
+func withAmountObfuscation(cfg AmountObfuscation) testSessionModifier {
+	return func(s *testSessionOpts) {
+		s.amountCfg = cfg
+	}
+}
+
 func reserveSession(db Store, label string,
 	mods ...testSessionModifier) (*Session, error) {
 
@@ -422,6 +628,8 @@ func reserveSession(db Store, label string,
 		WithDevServer(),
 		WithPrivacy(PrivacyFlags{ClearPubkeys}),
 		WithLinkedGroupID(opts.groupID),
+		WithErrorVerbosity(opts.errorVerbosity),
+		WithAmountObfuscation(opts.amountCfg),
 	}
 
 	opts.account.WhenSome(func(id accounts.AccountID) {
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -31,6 +32,8 @@ const (
 	typeRevokedAt       tlv.Type = 16
 	typeGroupID         tlv.Type = 17
 	typePrivacyFlags    tlv.Type = 18
+	typeErrorVerbosity  tlv.Type = 19
+	typeAmountObfuscate tlv.Type = 20
 
 	// typeMacaroon is no longer used, but we leave it defined for backwards
 	// compatibility.
@@ -48,6 +51,14 @@ const (
 
 	typeFeatureName   tlv.Type = 1
 	typeFeatureConfig tlv.Type = 2
+
+	typeAmountStrategy    tlv.Type = 1
+	typeAmountVariation   tlv.Type = 2
+	typeAmountBucketSize  tlv.Type = 3
+	typeAmountClearFields tlv.Type = 4
+
+	typeTemplateConfig    tlv.Type = 1
+	typeTemplateCreatedAt tlv.Type = 2
 )
 
 // SerializeSession binary serializes the given session to the writer using the
@@ -180,6 +191,26 @@ func constructSessionTLVRecords(session *Session, withGroupID bool) (
 		tlv.MakePrimitiveRecord(typePrivacyFlags, &privacyFlags),
 	)
 
+	if session.ErrorVerbosity != ErrorVerbosityVerbose {
+		errorVerbosity := uint8(session.ErrorVerbosity)
+		tlvRecords = append(tlvRecords, tlv.MakePrimitiveRecord(
+			typeErrorVerbosity, &errorVerbosity,
+		))
+	}
+
+	if !session.AmountObfuscation.isZero() {
+		tlvRecords = append(tlvRecords, tlv.MakeDynamicRecord(
+			typeAmountObfuscate, &session.AmountObfuscation,
+			func() uint64 {
+				return recordSize(
+					amountObfuscationEncoder,
+					&session.AmountObfuscation,
+				)
+			},
+			amountObfuscationEncoder, amountObfuscationDecoder,
+		))
+	}
+
 	return tlvRecords, nil
 }
 
@@ -191,6 +222,7 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 		label, serverAddr                          []byte
 		pairingSecret, privateKey                  []byte
 		state, typ, devServer, privacy             uint8
+		errorVerbosity                             uint8
 		expiry, createdAt, revokedAt, privacyFlags uint64
 		macRecipe                                  MacaroonRecipe
 		featureConfig                              FeaturesConfig
@@ -224,6 +256,11 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 		tlv.MakePrimitiveRecord(typeRevokedAt, &revokedAt),
 		tlv.MakePrimitiveRecord(typeGroupID, &groupID),
 		tlv.MakePrimitiveRecord(typePrivacyFlags, &privacyFlags),
+		tlv.MakePrimitiveRecord(typeErrorVerbosity, &errorVerbosity),
+		tlv.MakeDynamicRecord(
+			typeAmountObfuscate, &session.AmountObfuscation, nil,
+			amountObfuscationEncoder, amountObfuscationDecoder,
+		),
 	)
 	if err != nil {
 		return nil, err
@@ -243,6 +280,7 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 	session.ServerAddr = string(serverAddr)
 	session.DevServer = devServer == 1
 	session.WithPrivacyMapper = privacy == 1
+	session.ErrorVerbosity = ErrorVerbosity(errorVerbosity)
 	session.PrivacyFlags, err = Deserialize(privacyFlags)
 	if err != nil {
 		return nil, err
@@ -294,6 +332,49 @@ func DeserializeSession(r io.Reader) (*Session, error) {
 	return session, nil
 }
 
+// serializeTemplate binary serializes the given session template to the writer
+// using the tlv format. The name of the template is not serialized, as it is
+// the key the template is stored under.
+func serializeTemplate(w io.Writer, tmpl *Template) error {
+	createdAt := uint64(tmpl.CreatedAt.Unix())
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeTemplateConfig, &tmpl.Config),
+		tlv.MakePrimitiveRecord(typeTemplateCreatedAt, &createdAt),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// deserializeTemplate deserializes the session template with the given name
+// from the given reader, expecting the data to be encoded in the tlv format.
+func deserializeTemplate(name string, r io.Reader) (*Template, error) {
+	var (
+		config    []byte
+		createdAt uint64
+	)
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(typeTemplateConfig, &config),
+		tlv.MakePrimitiveRecord(typeTemplateCreatedAt, &createdAt),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tlvStream.Decode(r); err != nil {
+		return nil, err
+	}
+
+	return &Template{
+		Name:      name,
+		Config:    config,
+		CreatedAt: time.Unix(int64(createdAt), 0).UTC(),
+	}, nil
+}
+
 func featureConfigEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
 	if v, ok := val.(*FeaturesConfig); ok {
 		for n, config := range *v {
@@ -483,6 +564,99 @@ func macaroonRecipeDecoder(r io.Reader, val interface{}, buf *[8]byte,
 	return tlv.NewTypeForDecodingErr(val, "MacaroonRecipe", l, l)
 }
 
+// amountObfuscationEncoder is a custom TLV encoder for an AmountObfuscation
+// record.
+func amountObfuscationEncoder(w io.Writer, val interface{},
+	_ *[8]byte) error {
+
+	if v, ok := val.(*AmountObfuscation); ok {
+		var (
+			strategy    = uint8(v.Strategy)
+			clearFields = []byte(strings.Join(v.ClearFields, ","))
+			cfgTLVBytes bytes.Buffer
+		)
+		tlvStream, err := tlv.NewStream(
+			tlv.MakePrimitiveRecord(typeAmountStrategy, &strategy),
+			tlv.MakePrimitiveRecord(
+				typeAmountVariation, &v.VariationPPM,
+			),
+			tlv.MakePrimitiveRecord(
+				typeAmountBucketSize, &v.BucketSize,
+			),
+			tlv.MakePrimitiveRecord(
+				typeAmountClearFields, &clearFields,
+			),
+		)
+		if err != nil {
+			return err
+		}
+
+		err = tlvStream.Encode(&cfgTLVBytes)
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(cfgTLVBytes.Bytes())
+
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "AmountObfuscation")
+}
+
+// amountObfuscationDecoder is a custom TLV decoder for an AmountObfuscation
+// record.
+func amountObfuscationDecoder(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*AmountObfuscation); ok {
+		innerTlvReader := io.LimitedReader{
+			R: r,
+			N: int64(l),
+		}
+
+		var (
+			strategy     uint8
+			variationPPM uint32
+			bucketSize   uint64
+			clearFields  []byte
+		)
+		tlvStream, err := tlv.NewStream(
+			tlv.MakePrimitiveRecord(typeAmountStrategy, &strategy),
+			tlv.MakePrimitiveRecord(
+				typeAmountVariation, &variationPPM,
+			),
+			tlv.MakePrimitiveRecord(
+				typeAmountBucketSize, &bucketSize,
+			),
+			tlv.MakePrimitiveRecord(
+				typeAmountClearFields, &clearFields,
+			),
+		)
+		if err != nil {
+			return err
+		}
+
+		err = tlvStream.Decode(&innerTlvReader)
+		if err != nil {
+			return err
+		}
+
+		*v = AmountObfuscation{
+			Strategy:     AmountObfuscationStrategy(strategy),
+			VariationPPM: variationPPM,
+			BucketSize:   bucketSize,
+		}
+		if len(clearFields) != 0 {
+			v.ClearFields = strings.Split(string(clearFields), ",")
+		}
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "AmountObfuscation", l, l)
+}
+
 // permsEncoder is a custom TLV encoder for macaroon Permission records.
 func permsEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
 	if v, ok := val.(*[]bakery.Op); ok {
@@ -55,13 +55,15 @@ func TestSerializeDeserializeSession(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
-		name          string
-		sessType      Type
-		revokedAt     time.Time
-		perms         []bakery.Op
-		caveats       []macaroon.Caveat
-		featureConfig map[string][]byte
-		linkedGroupID *ID
+		name           string
+		sessType       Type
+		revokedAt      time.Time
+		perms          []bakery.Op
+		caveats        []macaroon.Caveat
+		featureConfig  map[string][]byte
+		linkedGroupID  *ID
+		errorVerbosity ErrorVerbosity
+		amountCfg      AmountObfuscation
 	}{
 		{
 			name:     "revoked-at field",
@@ -102,6 +104,22 @@ func TestSerializeDeserializeSession(t *testing.T) {
 			},
 			linkedGroupID: &groupID,
 		},
+		{
+			name:           "terse error verbosity",
+			sessType:       TypeMacaroonCustom,
+			errorVerbosity: ErrorVerbosityTerse,
+		},
+		{
+			name:     "amount obfuscation",
+			sessType: TypeMacaroonCustom,
+			amountCfg: AmountObfuscation{
+				Strategy:   AmountObfuscationBucket,
+				BucketSize: 5_000,
+				ClearFields: []string{
+					"capacity", "total_balance",
+				},
+			},
+		},
 		{
 			name:     "session with no optional fields",
 			sessType: TypeMacaroonCustom,
@@ -118,6 +136,10 @@ func TestSerializeDeserializeSession(t *testing.T) {
 				"AutoFees":      {1, 2, 3, 4},
 				"AutoSomething": {4, 3, 4, 5, 6, 6},
 			},
+			errorVerbosity: ErrorVerbosityTerse,
+			amountCfg: AmountObfuscation{
+				VariationPPM: 10_000,
+			},
 		},
 	}
 
@@ -139,6 +161,8 @@ func TestSerializeDeserializeSession(t *testing.T) {
 				WithMacaroonRecipe(test.caveats, test.perms),
 				WithFeatureConfig(test.featureConfig),
 				WithLinkedGroupID(test.linkedGroupID),
+				WithErrorVerbosity(test.errorVerbosity),
+				WithAmountObfuscation(test.amountCfg),
 			)
 			require.NoError(t, err)
 
@@ -1,11 +1,14 @@
 package terminal
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +29,7 @@ import (
 	"github.com/lightningnetwork/lnd/fn"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
 	"gopkg.in/macaroon.v2"
@@ -39,6 +43,11 @@ import (
 // other special cases.
 const readOnlyAction = "***readonly***"
 
+// mailboxVerifyTimeout is the time we wait for a TLS connection to the mailbox
+// server to be established when the mailbox server of a new session should be
+// verified.
+const mailboxVerifyTimeout = 10 * time.Second
+
 // sessionRpcServer is the gRPC server for the Session RPC interface.
 type sessionRpcServer struct {
 	litrpc.UnimplementedSessionsServer
@@ -48,6 +57,11 @@ type sessionRpcServer struct {
 	cfg           *sessionRpcServerConfig
 	sessionServer *session.Server
 
+	// expiryTimers holds the timers that stop the running sessions once
+	// they expire.
+	expiryTimers    map[session.ID]*time.Timer
+	expiryTimersMtx sync.Mutex
+
 	quit     chan struct{}
 	wg       sync.WaitGroup
 	stopOnce sync.Once
@@ -62,11 +76,21 @@ type sessionRpcServerConfig struct {
 	registerGrpcServers     func(server *grpc.Server)
 	superMacBaker           litmac.Baker
 	firstConnectionDeadline time.Duration
+	reconnectCfg            *session.ReconnectConfig
 	permMgr                 *perms.Manager
 	actionsDB               *firewalldb.BoltDB
+	rulesDB                 firewalldb.RulesDB
 	autopilot               autopilotserver.Autopilot
 	ruleMgrs                rules.ManagerSet
 	privMap                 firewalldb.NewPrivacyMapDB
+
+	// allowPrivacyExport is true if the real-pseudo pairs of the privacy
+	// map may be exported.
+	allowPrivacyExport bool
+
+	// ruleEnforcer returns the firewall's rule enforcer or nil if it is
+	// not available.
+	ruleEnforcer func() *firewall.RuleEnforcer
 }
 
 // newSessionRPCServer creates a new sessionRpcServer using the passed config.
@@ -84,12 +108,13 @@ func newSessionRPCServer(cfg *sessionRpcServerConfig) (*sessionRpcServer,
 			cfg.registerGrpcServers(grpcServer)
 
 			return grpcServer
-		},
+		}, cfg.reconnectCfg,
 	)
 
 	return &sessionRpcServer{
 		cfg:           cfg,
 		sessionServer: server,
+		expiryTimers:  make(map[session.ID]*time.Timer),
 		quit:          make(chan struct{}),
 	}, nil
 }
@@ -194,6 +219,33 @@ func (s *sessionRpcServer) stop() error {
 	return returnErr
 }
 
+// mailboxServerAddr returns the mailbox server address a new session should
+// use, which is the default mailbox server if the given address is empty. If
+// verify is set, the mailbox server must be reachable over TLS.
+func mailboxServerAddr(ctx context.Context, addr string, devServer,
+	verify bool) (string, error) {
+
+	if addr == "" {
+		addr = session.DefaultMailboxServerAddr
+	}
+
+	if err := session.ValidateServerAddr(addr); err != nil {
+		return "", err
+	}
+
+	if verify {
+		ctx, cancel := context.WithTimeout(ctx, mailboxVerifyTimeout)
+		defer cancel()
+
+		err := session.VerifyServerAddr(ctx, addr, devServer)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return addr, nil
+}
+
 // AddSession adds and starts a new Terminal Connect session.
 func (s *sessionRpcServer) AddSession(ctx context.Context,
 	req *litrpc.AddSessionRequest) (*litrpc.AddSessionResponse, error) {
@@ -203,11 +255,26 @@ func (s *sessionRpcServer) AddSession(ctx context.Context,
 		return nil, fmt.Errorf("expiry must be in the future")
 	}
 
+	serverAddr, err := mailboxServerAddr(
+		ctx, req.MailboxServerAddr, req.DevServer,
+		req.VerifyMailboxServer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	typ, err := unmarshalRPCType(req.SessionType)
 	if err != nil {
 		return nil, err
 	}
 
+	errorVerbosity, err := unmarshalRPCErrorVerbosity(
+		req.ErrorVerbosity, typ,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Store the entity-action permission pairs in a map in order to
 	// de-dup any repeat perms.
 	permissions := make(map[string]map[string]struct{})
@@ -244,8 +311,7 @@ func (s *sessionRpcServer) AddSession(ctx context.Context,
 		accountID = fn.Some(*id)
 
 	// For the custom macaroon type, we use the custom permissions specified
-	// in the request. For the time being, the caveats list will be empty
-	// for this type.
+	// in the request.
 	case session.TypeMacaroonCustom:
 		if len(req.MacaroonCustomPermissions) == 0 {
 			return nil, fmt.Errorf("custom macaroon " +
@@ -307,6 +373,43 @@ func (s *sessionRpcServer) AddSession(ctx context.Context,
 			"AddAutoPilotSession method")
 	}
 
+	// If the session should be restricted to specific URIs, its macaroon
+	// only grants access to those instead of all the permissions of its
+	// type.
+	if len(req.AllowedUris) != 0 {
+		perms, err := s.allowedURIPermissions(typ, req.AllowedUris)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, op := range perms {
+			addPerm(op.Entity, op.Action)
+		}
+	}
+
+	// The session wide rules, including the spend budget, are enforced by
+	// the firewall.
+	sessionRules, err := s.unmarshalSessionRules(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessionRules) != 0 {
+		rulesCaveat, err := s.sessionRulesCaveat(sessionRules)
+		if err != nil {
+			return nil, err
+		}
+
+		caveats = append(caveats, rulesCaveat)
+	}
+
+	for _, cav := range req.MacaroonCustomCaveats {
+		if err := validateCustomCaveat(cav); err != nil {
+			return nil, err
+		}
+
+		caveats = append(caveats, macaroon.Caveat{Id: []byte(cav)})
+	}
+
 	// Collect the de-duped permissions.
 	var uniquePermissions []bakery.Op
 	for entity, actions := range permissions {
@@ -330,9 +433,26 @@ func (s *sessionRpcServer) AddSession(ctx context.Context,
 		sessOpts = append(sessOpts, session.WithAccount(id))
 	})
 
+	if errorVerbosity != session.ErrorVerbosityVerbose {
+		sessOpts = append(
+			sessOpts, session.WithErrorVerbosity(errorVerbosity),
+		)
+	}
+
+	// A linked session is added to the group of the session it is linked
+	// to. The store makes sure that no other session of the group is still
+	// active.
+	if len(req.LinkedSessionId) != 0 {
+		groupID, err := s.linkedGroupID(ctx, req.LinkedSessionId)
+		if err != nil {
+			return nil, err
+		}
+
+		sessOpts = append(sessOpts, session.WithLinkedGroupID(&groupID))
+	}
+
 	sess, err := s.cfg.db.NewSession(
-		ctx, req.Label, typ, expiry, req.MailboxServerAddr,
-		sessOpts...,
+		ctx, req.Label, typ, expiry, serverAddr, sessOpts...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating new session: %v", err)
@@ -365,26 +485,264 @@ func (s *sessionRpcServer) AddSession(ctx context.Context,
 	}, nil
 }
 
-// resumeSession tries to start the given session if it is not expired.
-func (s *sessionRpcServer) resumeSession(ctx context.Context,
-	sess *session.Session) error {
+// linkedGroupID returns the ID of the group of the session with the given ID
+// that a new session should be linked to.
+func (s *sessionRpcServer) linkedGroupID(ctx context.Context,
+	rawSessionID []byte) (session.ID, error) {
 
-	pubKey := sess.LocalPublicKey
-	pubKeyBytes := pubKey.SerializeCompressed()
+	id, err := session.IDFromBytes(rawSessionID)
+	if err != nil {
+		return session.ID{}, fmt.Errorf("invalid linked session ID: %w",
+			err)
+	}
 
-	// Don't resume an expired session.
-	if sess.Expiry.Before(time.Now()) {
-		log.Debugf("Not resuming session %x with expiry %s",
-			pubKeyBytes, sess.Expiry)
+	linkedSess, err := s.cfg.db.GetSession(ctx, id)
+	if err != nil {
+		return session.ID{}, fmt.Errorf("unable to fetch linked "+
+			"session %x: %w", id[:], err)
+	}
 
-		err := s.cfg.db.ShiftState(ctx, sess.ID, session.StateExpired)
+	// The sessions of an autopilot group are registered with the autopilot
+	// server, which needs to be told about the link.
+	if linkedSess.Type == session.TypeAutopilot {
+		return session.ID{}, fmt.Errorf("session %x is an autopilot "+
+			"session, autopilot sessions can only be linked to "+
+			"using AddAutopilotSession", id[:])
+	}
+
+	return linkedSess.GroupID, nil
+}
+
+// unmarshalSessionRules returns the session wide rules that were requested for
+// a new session, including the spend budget.
+func (s *sessionRpcServer) unmarshalSessionRules(
+	req *litrpc.AddSessionRequest) (map[string]rules.Values, error) {
+
+	sessionRules := make(map[string]rules.Values)
+	for name, value := range req.SessionRules.GetRules() {
+		if !rules.IsSessionRule(name) {
+			return nil, fmt.Errorf("rule %s can't be set for a "+
+				"session, only session wide rules are "+
+				"supported", name)
+		}
+
+		v, err := s.cfg.ruleMgrs.UnmarshalRuleValues(name, value)
 		if err != nil {
-			return fmt.Errorf("error revoking session: %v", err)
+			return nil, fmt.Errorf("invalid %s rule: %w", name, err)
 		}
 
-		return nil
+		sessionRules[name] = v
+	}
+
+	if req.SpendBudgetSat != 0 {
+		if _, ok := sessionRules[rules.SessionBudgetName]; ok {
+			return nil, fmt.Errorf("the spend budget can't be set " +
+				"both directly and as a session rule")
+		}
+
+		sessionRules[rules.SessionBudgetName] = &rules.SessionBudget{
+			AbsoluteAmtSats: req.SpendBudgetSat,
+		}
+	}
+
+	return sessionRules, nil
+}
+
+// sessionRulesCaveat returns the firewall caveat that makes the firewall
+// enforce the given rules for all requests of a session.
+func (s *sessionRpcServer) sessionRulesCaveat(
+	sessionRules map[string]rules.Values) (macaroon.Caveat, error) {
+
+	if s.cfg.ruleEnforcer() == nil {
+		return macaroon.Caveat{}, fmt.Errorf("session rules require " +
+			"the firewall, make sure the autopilot is enabled")
+	}
+
+	interceptRules := &firewall.InterceptRules{
+		SessionRules: make(map[string]string, len(sessionRules)),
+	}
+	for name, value := range sessionRules {
+		v, err := rules.Marshal(value)
+		if err != nil {
+			return macaroon.Caveat{}, err
+		}
+
+		interceptRules.SessionRules[name] = string(v)
+	}
+
+	rulesCaveat, err := firewall.RulesToCaveat(interceptRules)
+	if err != nil {
+		return macaroon.Caveat{}, err
+	}
+
+	return macaroon.Caveat{Id: []byte(rulesCaveat)}, nil
+}
+
+// spendBudget returns the spend budget of the session with the given rules
+// and the amount that is left of it, both in satoshis. Zero is returned for
+// both if the session has no spend budget.
+func (s *sessionRpcServer) spendBudget(ctx context.Context,
+	sess *session.Session, info *firewall.InterceptRules) (uint64, uint64,
+	error) {
+
+	rule, ok := info.SessionRules[rules.SessionBudgetName]
+	if !ok {
+		return 0, 0, nil
+	}
+
+	val, err := s.cfg.ruleMgrs.InitRuleValues(
+		rules.SessionBudgetName, []byte(rule),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	budget, ok := val.(*rules.SessionBudget)
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid session budget %T", val)
+	}
+
+	spentMsat, pendingMsat, err := rules.SessionBudgetSpent(
+		ctx, s.cfg.rulesDB.GetKVStores(
+			rules.SessionBudgetName, sess.GroupID, "",
+		),
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// Any fraction of a satoshi that was spent counts as a full one.
+	usedSat := (spentMsat + pendingMsat + 999) / 1000
+	if usedSat >= budget.AbsoluteAmtSats {
+		return budget.AbsoluteAmtSats, 0, nil
+	}
+
+	return budget.AbsoluteAmtSats, budget.AbsoluteAmtSats - usedSat, nil
+}
+
+// allowedURIPermissions returns the permissions that restrict the macaroon of
+// a session of the given type to the given URIs. An error is returned if any of
+// the URIs is unknown or, for readonly sessions, requires write access.
+func (s *sessionRpcServer) allowedURIPermissions(typ session.Type,
+	uris []string) ([]bakery.Op, error) {
+
+	if typ != session.TypeMacaroonAdmin &&
+		typ != session.TypeMacaroonReadonly {
+
+		return nil, fmt.Errorf("allowed URIs can only be set for " +
+			"admin and readonly sessions, custom sessions are " +
+			"scoped with their custom permissions instead")
+	}
+
+	ops := make([]bakery.Op, 0, len(uris))
+	for _, uri := range uris {
+		perms, ok := s.cfg.permMgr.URIPermissions(uri)
+		if !ok {
+			return nil, fmt.Errorf("allowed URI %s is unknown to "+
+				"LiT, URIs must be given in full, for "+
+				"example /lnrpc.Lightning/GetInfo", uri)
+		}
+
+		if typ == session.TypeMacaroonReadonly {
+			for _, perm := range perms {
+				if perm.Action == "read" {
+					continue
+				}
+
+				return nil, fmt.Errorf("allowed URI %s "+
+					"requires the %s:%s permission, which "+
+					"readonly sessions don't grant", uri,
+					perm.Entity, perm.Action)
+			}
+		}
+
+		ops = append(ops, bakery.Op{
+			Entity: macaroons.PermissionEntityCustomURI,
+			Action: uri,
+		})
+	}
+
+	return ops, nil
+}
+
+// condIPAddr is the caveat condition that lnd uses to lock a macaroon to an IP
+// address.
+const condIPAddr = "ipaddr"
+
+// customCaveatConditions are the first-party caveat conditions that lnd can
+// check and that can therefore be added to a session's macaroon.
+var customCaveatConditions = []string{
+	checkers.CondTimeBefore, condIPAddr, macaroons.CondIPRange,
+	macaroons.CondLndCustom,
+}
+
+// validateCustomCaveat makes sure that lnd can check the given custom caveat
+// condition. A macaroon with a caveat that lnd doesn't know is rejected on
+// every call, which would leave the session unusable.
+func validateCustomCaveat(cav string) error {
+	if cav == "" {
+		return fmt.Errorf("custom macaroon caveats must not be empty")
+	}
+
+	cond, arg, err := checkers.ParseCaveat(cav)
+	if err != nil {
+		return fmt.Errorf("invalid custom caveat %q: %w", cav, err)
+	}
+
+	switch cond {
+	case checkers.CondTimeBefore:
+		_, err := time.Parse(time.RFC3339Nano, arg)
+		if err != nil {
+			return fmt.Errorf("invalid custom caveat %q, the time "+
+				"must be given in the RFC3339 format: %w", cav,
+				err)
+		}
+
+	case condIPAddr:
+		if net.ParseIP(arg) == nil {
+			return fmt.Errorf("invalid custom caveat %q, %q is "+
+				"not an IP address", cav, arg)
+		}
+
+	case macaroons.CondIPRange:
+		if _, _, err := net.ParseCIDR(arg); err != nil {
+			return fmt.Errorf("invalid custom caveat %q, the IP "+
+				"range must be given in CIDR notation: %w",
+				cav, err)
+		}
+
+	// Custom caveats are only accepted by lnd if a middleware that handles
+	// them is registered, so they must belong to one of LiT's.
+	case macaroons.CondLndCustom:
+		name, _, _ := strings.Cut(arg, " ")
+		switch name {
+		case accounts.CondAccount, firewall.RuleEnforcerCaveat,
+			firewall.CondPrivacy:
+
+		default:
+			return fmt.Errorf("invalid custom caveat %q, unknown "+
+				"custom caveat name %q, only %s, %s and %s "+
+				"are handled by LiT", cav, name,
+				accounts.CondAccount,
+				firewall.RuleEnforcerCaveat,
+				firewall.CondPrivacy)
+		}
+
+	default:
+		return fmt.Errorf("unsupported custom caveat %q, the "+
+			"condition must be one of %s", cav,
+			strings.Join(customCaveatConditions, ", "))
 	}
 
+	return nil
+}
+
+// sessionMacaroonRecipe returns the permissions and caveats of the macaroon
+// that is handed to the client of the given session. False is returned if
+// sessions of the given type can't be started.
+func (s *sessionRpcServer) sessionMacaroonRecipe(sess *session.Session) (
+	[]bakery.Op, []macaroon.Caveat, bool, error) {
+
 	var (
 		caveats     []macaroon.Caveat
 		permissions []bakery.Op
@@ -396,12 +754,23 @@ func (s *sessionRpcServer) resumeSession(ctx context.Context,
 	case session.TypeMacaroonAdmin, session.TypeMacaroonReadonly:
 		permissions = s.cfg.permMgr.ActivePermissions(readOnly)
 
+		// Sessions that were restricted to specific URIs or that have
+		// custom caveats persist those in their recipe.
+		if sess.MacaroonRecipe == nil {
+			break
+		}
+
+		if len(sess.MacaroonRecipe.Permissions) != 0 {
+			permissions = sess.MacaroonRecipe.Permissions
+		}
+		caveats = append(caveats, sess.MacaroonRecipe.Caveats...)
+
 	// For account based sessions we just add the account ID caveat, the
 	// permissions are added dynamically when creating the session.
 	case session.TypeMacaroonAccount:
 		if sess.MacaroonRecipe == nil {
-			return fmt.Errorf("invalid account session, expected " +
-				"recipe to be set")
+			return nil, nil, false, fmt.Errorf("invalid account " +
+				"session, expected recipe to be set")
 		}
 
 		caveats = sess.MacaroonRecipe.Caveats
@@ -419,17 +788,45 @@ func (s *sessionRpcServer) resumeSession(ctx context.Context,
 
 	// No other types are currently supported.
 	default:
+		return nil, nil, false, nil
+	}
+
+	// The session expiry isn't added as a macaroon caveat, since it can be
+	// updated while the session is running. It is enforced against the
+	// stored session whenever the macaroon is validated instead.
+	return permissions, caveats, true, nil
+}
+
+// resumeSession tries to start the given session if it is not expired.
+func (s *sessionRpcServer) resumeSession(ctx context.Context,
+	sess *session.Session) error {
+
+	pubKey := sess.LocalPublicKey
+	pubKeyBytes := pubKey.SerializeCompressed()
+
+	// Don't resume an expired session.
+	if sess.Expiry.Before(time.Now()) {
+		log.Debugf("Not resuming session %x with expiry %s",
+			pubKeyBytes, sess.Expiry)
+
+		err := s.cfg.db.ShiftState(ctx, sess.ID, session.StateExpired)
+		if err != nil {
+			return fmt.Errorf("error revoking session: %v", err)
+		}
+
+		return nil
+	}
+
+	permissions, caveats, ok, err := s.sessionMacaroonRecipe(sess)
+	if err != nil {
+		return err
+	}
+	if !ok {
 		log.Debugf("Not resuming session %x with type %d", pubKeyBytes,
 			sess.Type)
 		return nil
 	}
 
-	// Add the session expiry as a macaroon caveat.
-	macExpiry := checkers.TimeBeforeCaveat(sess.Expiry)
-	caveats = append(caveats, macaroon.Caveat{
-		Id: []byte(macExpiry.Condition),
-	})
-
 	mac, err := s.cfg.superMacBaker(
 		ctx, sess.MacaroonRootKey, permissions, caveats,
 	)
@@ -497,12 +894,24 @@ func (s *sessionRpcServer) resumeSession(ctx context.Context,
 		return err
 	}
 
+	// The expiry timer is registered so that it can be reset if the
+	// expiry of the session is changed while it is running.
+	ticker := time.NewTimer(time.Until(sess.Expiry))
+	s.expiryTimersMtx.Lock()
+	s.expiryTimers[sess.ID] = ticker
+	s.expiryTimersMtx.Unlock()
+
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
 
-		ticker := time.NewTimer(time.Until(sess.Expiry))
-		defer ticker.Stop()
+		defer func() {
+			ticker.Stop()
+
+			s.expiryTimersMtx.Lock()
+			delete(s.expiryTimers, sess.ID)
+			s.expiryTimersMtx.Unlock()
+		}()
 
 		select {
 		case <-s.quit:
@@ -544,87 +953,674 @@ func (s *sessionRpcServer) resumeSession(ctx context.Context,
 	return nil
 }
 
-// ListSessions returns all sessions known to the session store.
+// ListSessions returns all sessions known to the session store. If an account
+// ID is set in the request, only the sessions linked to that account are
+// returned.
 func (s *sessionRpcServer) ListSessions(ctx context.Context,
-	_ *litrpc.ListSessionsRequest) (*litrpc.ListSessionsResponse, error) {
+	req *litrpc.ListSessionsRequest) (*litrpc.ListSessionsResponse, error) {
 
-	sessions, err := s.cfg.db.ListAllSessions(ctx)
+	filter, err := unmarshalSessionFilter(req)
 	if err != nil {
-		return nil, fmt.Errorf("error fetching sessions: %v", err)
+		return nil, err
 	}
 
-	response := &litrpc.ListSessionsResponse{
-		Sessions: make([]*litrpc.Session, len(sessions)),
-	}
-	for idx, sess := range sessions {
-		response.Sessions[idx], err = s.marshalRPCSession(ctx, sess)
+	if len(req.GroupId) != 0 {
+		id, err := session.IDFromBytes(req.GroupId)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling session: %v",
-				err)
+			return nil, fmt.Errorf("invalid group ID: %w", err)
 		}
-	}
-
-	return response, nil
-}
 
-// RevokeSession revokes a single session and also stops it if it is currently
-// active.
-func (s *sessionRpcServer) RevokeSession(ctx context.Context,
-	req *litrpc.RevokeSessionRequest) (*litrpc.RevokeSessionResponse, error) {
+		// The ID of any session in the group can be given, the ID of
+		// the group is the ID of the first session in it.
+		groupID, err := s.cfg.db.GetGroupID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch group of "+
+				"session %x: %w", id[:], err)
+		}
 
-	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing public key: %v", err)
+		filter.groupID = fn.Some(groupID)
 	}
 
-	sess, err := s.cfg.db.GetSessionByLocalPub(ctx, pubKey)
-	if err != nil {
-		return nil, fmt.Errorf("error fetching session: %v", err)
-	}
+	// We let the store do as much of the filtering as possible. A group
+	// only contains the few sessions of a single client, so it is the most
+	// selective filter. Otherwise, the state is the most selective filter,
+	// as most sessions of a long-running node are revoked or expired, so
+	// it takes precedence over the account.
+	var sessions []*session.Session
+	switch {
+	case filter.groupID.IsSome():
+		groupID := filter.groupID.UnwrapOr(session.ID{})
+		ids, err := s.cfg.db.GetSessionIDs(ctx, groupID)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching sessions of "+
+				"group %x: %v", groupID[:], err)
+		}
 
-	err = s.cfg.db.ShiftState(ctx, sess.ID, session.StateRevoked)
-	if err != nil {
-		return nil, fmt.Errorf("error revoking session: %v", err)
-	}
+		for _, id := range ids {
+			sess, err := s.cfg.db.GetSession(ctx, id)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching "+
+					"session %x: %v", id[:], err)
+			}
 
-	if s.cfg.autopilot != nil {
-		s.cfg.autopilot.SessionRevoked(ctx, pubKey)
-	}
+			sessions = append(sessions, sess)
+		}
 
-	// If the session expired already it might not be running anymore. So we
-	// only log possible errors here.
-	if err := s.sessionServer.StopSession(pubKey); err != nil {
-		log.Debugf("Error stopping session: %v", err)
-	}
+		sort.Slice(sessions, func(i, j int) bool {
+			return sessions[i].CreatedAt.Before(
+				sessions[j].CreatedAt,
+			)
+		})
 
-	return &litrpc.RevokeSessionResponse{}, nil
-}
+	case len(filter.states) > 0:
+		for state := range filter.states {
+			stateSessions, err := s.cfg.db.ListSessionsByState(
+				ctx, state,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error fetching "+
+					"sessions in state %d: %v", state, err)
+			}
 
-// PrivacyMapConversion can be used map real values to their pseudo counterpart
-// and vice versa.
-func (s *sessionRpcServer) PrivacyMapConversion(ctx context.Context,
-	req *litrpc.PrivacyMapConversionRequest) (
-	*litrpc.PrivacyMapConversionResponse, error) {
+			sessions = append(sessions, stateSessions...)
+		}
+
+		sort.Slice(sessions, func(i, j int) bool {
+			return sessions[i].CreatedAt.Before(
+				sessions[j].CreatedAt,
+			)
+		})
+
+	case filter.accountID.IsSome():
+		id := filter.accountID.UnwrapOr(accounts.AccountID{})
+		sessions, err = s.cfg.db.ListSessionsByAccount(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching sessions for "+
+				"account %x: %v", id[:], err)
+		}
+
+	default:
+		sessions, err = s.cfg.db.ListAllSessions(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching sessions: %v",
+				err)
+		}
+	}
+
+	response := &litrpc.ListSessionsResponse{
+		Sessions: make([]*litrpc.Session, 0, len(sessions)),
+	}
+	for _, sess := range sessions {
+		if !filter.matches(sess) {
+			continue
+		}
+
+		rpcSess, err := s.marshalRPCSession(ctx, sess)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling session: %v",
+				err)
+		}
+
+		response.Sessions = append(response.Sessions, rpcSess)
+	}
+
+	return response, nil
+}
+
+// sessionFilter selects the sessions that are returned by ListSessions. Empty
+// fields match all sessions.
+type sessionFilter struct {
+	states        map[session.State]bool
+	types         map[session.Type]bool
+	accountID     fn.Option[accounts.AccountID]
+	groupID       fn.Option[session.ID]
+	labelContains string
+}
+
+// unmarshalSessionFilter converts the filters of the given request into a
+// sessionFilter.
+func unmarshalSessionFilter(
+	req *litrpc.ListSessionsRequest) (*sessionFilter, error) {
+
+	filter := &sessionFilter{
+		states:        make(map[session.State]bool, len(req.States)),
+		types:         make(map[session.Type]bool, len(req.Types)),
+		accountID:     fn.None[accounts.AccountID](),
+		groupID:       fn.None[session.ID](),
+		labelContains: req.LabelContains,
+	}
+
+	for _, rpcState := range req.States {
+		state, err := unmarshalRPCState(rpcState)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.states[state] = true
+	}
+
+	for _, rpcType := range req.Types {
+		typ, err := unmarshalRPCType(rpcType)
+		if err != nil {
+			return nil, err
+		}
+
+		filter.types[typ] = true
+	}
+
+	if req.AccountId != "" {
+		id, err := accounts.ParseAccountID(req.AccountId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account ID: %v", err)
+		}
+
+		filter.accountID = fn.Some(*id)
+	}
+
+	return filter, nil
+}
+
+// matches returns true if the given session passes all filters.
+func (f *sessionFilter) matches(sess *session.Session) bool {
+	if len(f.states) > 0 && !f.states[sess.State] {
+		return false
+	}
+
+	if len(f.types) > 0 && !f.types[sess.Type] {
+		return false
+	}
+
+	if !strings.Contains(sess.Label, f.labelContains) {
+		return false
+	}
+
+	matchesGroup := true
+	f.groupID.WhenSome(func(id session.ID) {
+		matchesGroup = sess.GroupID == id
+	})
+	if !matchesGroup {
+		return false
+	}
+
+	matchesAccount := true
+	f.accountID.WhenSome(func(id accounts.AccountID) {
+		matchesAccount = false
+		sess.AccountID.WhenSome(func(sessAcct accounts.AccountID) {
+			matchesAccount = sessAcct == id
+		})
+	})
+
+	return matchesAccount
+}
+
+// RevokeSession revokes a single session and also stops it if it is currently
+// active.
+func (s *sessionRpcServer) RevokeSession(ctx context.Context,
+	req *litrpc.RevokeSessionRequest) (*litrpc.RevokeSessionResponse, error) {
+
+	pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing public key: %v", err)
+	}
+
+	sess, err := s.cfg.db.GetSessionByLocalPub(ctx, pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %v", err)
+	}
+
+	if err := s.revokeSession(ctx, sess); err != nil {
+		return nil, err
+	}
+
+	return &litrpc.RevokeSessionResponse{}, nil
+}
+
+// RevokeSessions revokes all active sessions that match the filters of the
+// request and also stops them if they are currently active.
+func (s *sessionRpcServer) RevokeSessions(ctx context.Context,
+	req *litrpc.RevokeSessionsRequest) (*litrpc.RevokeSessionsResponse,
+	error) {
+
+	if req.LabelPrefix == "" && req.CreatedBefore == 0 && !req.Expired &&
+		len(req.LocalPublicKeys) == 0 {
+
+		return nil, fmt.Errorf("at least one filter must be set")
+	}
+
+	// All the given keys must belong to known sessions. We check this
+	// before revoking anything, so that a typo doesn't leave us with only
+	// some of the sessions revoked.
+	localKeys := make(map[string]bool, len(req.LocalPublicKeys))
+	for _, keyBytes := range req.LocalPublicKeys {
+		pubKey, err := btcec.ParsePubKey(keyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key: %v",
+				err)
+		}
+
+		_, err = s.cfg.db.GetSessionByLocalPub(ctx, pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching session %x: %v",
+				keyBytes, err)
+		}
+
+		localKeys[string(pubKey.SerializeCompressed())] = true
+	}
+
+	createdBefore := time.Unix(int64(req.CreatedBefore), 0)
+	matches := func(sess *session.Session) bool {
+		if !strings.HasPrefix(sess.Label, req.LabelPrefix) {
+			return false
+		}
+
+		if req.CreatedBefore != 0 &&
+			!sess.CreatedAt.Before(createdBefore) {
+
+			return false
+		}
+
+		if req.Expired && !sess.Expiry.Before(time.Now()) {
+			return false
+		}
+
+		localKey := sess.LocalPublicKey.SerializeCompressed()
+		if len(localKeys) > 0 && !localKeys[string(localKey)] {
+			return false
+		}
+
+		return true
+	}
+
+	// Only sessions that can still be used need to be revoked. Sessions
+	// that were revoked by an earlier, partially failed call are skipped,
+	// which makes the call idempotent.
+	var toRevoke []*session.Session
+	for _, state := range []session.State{
+		session.StateCreated, session.StateInUse,
+	} {
+		sessions, err := s.cfg.db.ListSessionsByState(ctx, state)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching sessions: %v",
+				err)
+		}
+
+		for _, sess := range sessions {
+			if matches(sess) {
+				toRevoke = append(toRevoke, sess)
+			}
+		}
+	}
+
+	resp := &litrpc.RevokeSessionsResponse{
+		Sessions: make([]*litrpc.Session, 0, len(toRevoke)),
+	}
+	for _, sess := range toRevoke {
+		rpcSess, err := s.marshalRPCSession(ctx, sess)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling session: %v",
+				err)
+		}
+
+		resp.Sessions = append(resp.Sessions, rpcSess)
+	}
+
+	if req.DryRun {
+		resp.NumRevoked = uint32(len(toRevoke))
+
+		return resp, nil
+	}
+
+	for _, sess := range toRevoke {
+		if err := s.revokeSession(ctx, sess); err != nil {
+			return nil, fmt.Errorf("error revoking session %x "+
+				"after revoking %d of %d sessions: %w",
+				sess.ID[:], resp.NumRevoked, len(toRevoke),
+				err)
+		}
+
+		resp.NumRevoked++
+	}
+
+	log.Infof("Revoked %d sessions", resp.NumRevoked)
+
+	return resp, nil
+}
+
+// UpdateSessionExpiry sets a new expiry for a session that is neither revoked
+// nor expired. If the session is running, its expiry timer is reset and the
+// client is handed a macaroon with the new expiry on its next connection.
+func (s *sessionRpcServer) UpdateSessionExpiry(ctx context.Context,
+	req *litrpc.UpdateSessionExpiryRequest) (
+	*litrpc.UpdateSessionExpiryResponse, error) {
 
 	var (
-		groupID session.ID
-		err     error
+		sess *session.Session
+		err  error
 	)
-	if len(req.GroupId) != 0 {
-		groupID, err = session.IDFromBytes(req.GroupId)
+	switch {
+	case len(req.Id) != 0:
+		id, err := session.IDFromBytes(req.Id)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		sessionID, err := session.IDFromBytes(req.SessionId)
+
+		sess, err = s.cfg.db.GetSession(ctx, id)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("error fetching session: %v",
+				err)
 		}
 
-		groupID, err = s.cfg.db.GetGroupID(ctx, sessionID)
+	case len(req.LocalPublicKey) != 0:
+		pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing public key: %v",
+				err)
+		}
+
+		sess, err = s.cfg.db.GetSessionByLocalPub(ctx, pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching session: %v",
+				err)
+		}
+
+	default:
+		return nil, fmt.Errorf("either the session ID or the local " +
+			"public key must be set")
+	}
+
+	expiry := time.Unix(int64(req.ExpiryTimestampSeconds), 0)
+	if !expiry.After(time.Now()) {
+		return nil, fmt.Errorf("expiry must be in the future")
+	}
+
+	// A session whose expiry has passed is only marked as expired once
+	// litd tries to resume it, so we check the expiry itself as well.
+	if sess.State.Terminal() || sess.Expiry.Before(time.Now()) {
+		return nil, fmt.Errorf("session %x: %w", sess.ID[:],
+			session.ErrSessionNotActive)
+	}
+
+	err = s.cfg.db.UpdateSessionExpiry(ctx, sess.ID, expiry)
+	if err != nil {
+		return nil, fmt.Errorf("error updating session expiry: %w",
+			err)
+	}
+	sess.Expiry = expiry.UTC()
+
+	log.Infof("Updated expiry of session %x to %v", sess.ID[:], expiry)
+
+	// The macaroon of a running session doesn't carry its expiry, so the
+	// client can keep using it. We only need to move the timer that stops
+	// the session.
+	s.expiryTimersMtx.Lock()
+	if timer, running := s.expiryTimers[sess.ID]; running {
+		timer.Reset(time.Until(expiry))
+	}
+	s.expiryTimersMtx.Unlock()
+
+	rpcSess, err := s.marshalRPCSession(ctx, sess)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling session: %v", err)
+	}
+
+	return &litrpc.UpdateSessionExpiryResponse{
+		Session: rpcSess,
+	}, nil
+}
+
+// SubscribeConnectionStatus streams an event whenever the mailbox connection of
+// an active session changes, starting with the latest status of each active
+// session.
+//
+// NOTE: This is part of the litrpc.SessionsServer interface.
+func (s *sessionRpcServer) SubscribeConnectionStatus(
+	req *litrpc.SubscribeConnectionStatusRequest,
+	stream litrpc.Sessions_SubscribeConnectionStatusServer) error {
+
+	var filterKey []byte
+	if len(req.LocalPublicKey) != 0 {
+		pubKey, err := btcec.ParsePubKey(req.LocalPublicKey)
+		if err != nil {
+			return fmt.Errorf("error parsing public key: %v", err)
+		}
+
+		filterKey = pubKey.SerializeCompressed()
+	}
+
+	snapshot, events, cancel := s.sessionServer.SubscribeConnEvents()
+	defer cancel()
+
+	send := func(event *session.ConnEvent, isSnapshot bool) error {
+		pubKey := event.LocalPublicKey.SerializeCompressed()
+		if filterKey != nil && !bytes.Equal(pubKey, filterKey) {
+			return nil
+		}
+
+		return stream.Send(marshalConnEvent(event, isSnapshot))
+	}
+
+	for _, event := range snapshot {
+		if err := send(event, true); err != nil {
+			return err
+		}
+	}
+
+	if req.SnapshotOnly {
+		return nil
+	}
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return session.ErrConnEventSubscriberLagged
+			}
+
+			if err := send(event, false); err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
+		case <-s.quit:
+			return nil
+		}
+	}
+}
+
+// marshalConnEvent converts a session connection event into its RPC
+// counterpart.
+func marshalConnEvent(event *session.ConnEvent,
+	snapshot bool) *litrpc.ConnectionStatusEvent {
+
+	var state litrpc.ConnectionState
+	switch event.Type {
+	case session.ConnEventConnected:
+		state = litrpc.ConnectionState_CONNECTION_STATE_CONNECTED
+
+	case session.ConnEventDisconnected:
+		state = litrpc.ConnectionState_CONNECTION_STATE_DISCONNECTED
+
+	case session.ConnEventReconnecting:
+		state = litrpc.ConnectionState_CONNECTION_STATE_RECONNECTING
+	}
+
+	return &litrpc.ConnectionStatusEvent{
+		LocalPublicKey:  event.LocalPublicKey.SerializeCompressed(),
+		State:           state,
+		ClientConnected: event.ClientConnected,
+		Attempt:         event.Attempt,
+		BackoffMs:       uint64(event.Backoff.Milliseconds()),
+		Timestamp:       event.Timestamp.Unix(),
+		Snapshot:        snapshot,
+	}
+}
+
+// SaveSessionTemplate stores the given session template in the session
+// database, replacing an existing template with the same name if requested.
+//
+// NOTE: This is part of the litrpc.SessionsServer interface.
+func (s *sessionRpcServer) SaveSessionTemplate(ctx context.Context,
+	req *litrpc.SaveSessionTemplateRequest) (
+	*litrpc.SaveSessionTemplateResponse, error) {
+
+	tmpl := req.Template
+	if tmpl == nil || tmpl.Name == "" {
+		return nil, fmt.Errorf("template name must be set")
+	}
+
+	// Autopilot sessions are registered with the autopilot server and UI
+	// password sessions aren't created through AddSession, so only the
+	// macaroon session types can be saved as a template.
+	typ, err := unmarshalRPCType(tmpl.SessionType)
+	if err != nil {
+		return nil, err
+	}
+	switch typ {
+	case session.TypeMacaroonAdmin, session.TypeMacaroonReadonly,
+		session.TypeMacaroonAccount:
+
+	case session.TypeMacaroonCustom:
+		if len(tmpl.MacaroonCustomPermissions) == 0 {
+			return nil, fmt.Errorf("custom macaroon permissions " +
+				"must be specified for the custom macaroon " +
+				"session type")
+		}
+
+	default:
+		return nil, fmt.Errorf("invalid session type, only admin, " +
+			"readonly, custom and account macaroon types can be " +
+			"saved as a template")
+	}
+
+	_, err = unmarshalRPCErrorVerbosity(tmpl.ErrorVerbosity, typ)
+	if err != nil {
+		return nil, err
+	}
+
+	// The name and creation time are stored by the session store itself,
+	// so we only serialize the parameters of the template.
+	params := proto.Clone(tmpl).(*litrpc.SessionTemplate)
+	params.Name = ""
+	params.CreatedAt = 0
+
+	config, err := proto.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing template: %v", err)
+	}
+
+	dbTemplate, err := s.cfg.db.SaveTemplate(
+		ctx, tmpl.Name, config, req.Overwrite,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error saving template: %w", err)
+	}
+
+	log.Infof("Saved session template %s", tmpl.Name)
+
+	rpcTemplate, err := unmarshalTemplate(dbTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.SaveSessionTemplateResponse{
+		Template: rpcTemplate,
+	}, nil
+}
+
+// ListSessionTemplates returns all session templates, sorted by their name.
+//
+// NOTE: This is part of the litrpc.SessionsServer interface.
+func (s *sessionRpcServer) ListSessionTemplates(ctx context.Context,
+	_ *litrpc.ListSessionTemplatesRequest) (
+	*litrpc.ListSessionTemplatesResponse, error) {
+
+	dbTemplates, err := s.cfg.db.ListTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing templates: %v", err)
+	}
+
+	templates := make([]*litrpc.SessionTemplate, 0, len(dbTemplates))
+	for _, dbTemplate := range dbTemplates {
+		rpcTemplate, err := unmarshalTemplate(dbTemplate)
 		if err != nil {
 			return nil, err
 		}
+
+		templates = append(templates, rpcTemplate)
+	}
+
+	return &litrpc.ListSessionTemplatesResponse{
+		Templates: templates,
+	}, nil
+}
+
+// DeleteSessionTemplate removes the session template with the given name.
+//
+// NOTE: This is part of the litrpc.SessionsServer interface.
+func (s *sessionRpcServer) DeleteSessionTemplate(ctx context.Context,
+	req *litrpc.DeleteSessionTemplateRequest) (
+	*litrpc.DeleteSessionTemplateResponse, error) {
+
+	if err := s.cfg.db.DeleteTemplate(ctx, req.Name); err != nil {
+		return nil, fmt.Errorf("error deleting template: %w", err)
+	}
+
+	log.Infof("Deleted session template %s", req.Name)
+
+	return &litrpc.DeleteSessionTemplateResponse{}, nil
+}
+
+// unmarshalTemplate converts a session template stored in the session database
+// into its RPC counterpart.
+func unmarshalTemplate(
+	dbTemplate *session.Template) (*litrpc.SessionTemplate, error) {
+
+	tmpl := &litrpc.SessionTemplate{}
+	if err := proto.Unmarshal(dbTemplate.Config, tmpl); err != nil {
+		return nil, fmt.Errorf("error deserializing template %s: %v",
+			dbTemplate.Name, err)
+	}
+
+	tmpl.Name = dbTemplate.Name
+	tmpl.CreatedAt = uint64(dbTemplate.CreatedAt.Unix())
+
+	return tmpl, nil
+}
+
+// revokeSession revokes the given session and stops it if it is currently
+// active.
+func (s *sessionRpcServer) revokeSession(ctx context.Context,
+	sess *session.Session) error {
+
+	err := s.cfg.db.ShiftState(ctx, sess.ID, session.StateRevoked)
+	if err != nil {
+		return fmt.Errorf("error revoking session: %v", err)
+	}
+
+	if s.cfg.autopilot != nil {
+		s.cfg.autopilot.SessionRevoked(ctx, sess.LocalPublicKey)
+	}
+
+	// If the session expired already it might not be running anymore. So we
+	// only log possible errors here.
+	if err := s.sessionServer.StopSession(sess.LocalPublicKey); err != nil {
+		log.Debugf("Error stopping session: %v", err)
+	}
+
+	return nil
+}
+
+// PrivacyMapConversion can be used map real values to their pseudo counterpart
+// and vice versa.
+func (s *sessionRpcServer) PrivacyMapConversion(ctx context.Context,
+	req *litrpc.PrivacyMapConversionRequest) (
+	*litrpc.PrivacyMapConversionResponse, error) {
+
+	groupID, err := s.resolveGroupID(ctx, req.GroupId, req.SessionId)
+	if err != nil {
+		return nil, err
 	}
 
 	var res string
@@ -650,6 +1646,23 @@ func (s *sessionRpcServer) PrivacyMapConversion(ctx context.Context,
 	}, nil
 }
 
+// resolveGroupID returns the given group ID if it is set. Otherwise, the ID of
+// the group that the session with the given ID belongs to is returned.
+func (s *sessionRpcServer) resolveGroupID(ctx context.Context, rawGroupID,
+	rawSessionID []byte) (session.ID, error) {
+
+	if len(rawGroupID) != 0 {
+		return session.IDFromBytes(rawGroupID)
+	}
+
+	sessionID, err := session.IDFromBytes(rawSessionID)
+	if err != nil {
+		return session.ID{}, err
+	}
+
+	return s.cfg.db.GetGroupID(ctx, sessionID)
+}
+
 // ListActions will return a list of actions that have been performed on the
 // node. The actions that will be persisted depends on the value of the
 // `--firewall.request-logger.level` config option. The default value of the
@@ -728,79 +1741,316 @@ func (s *sessionRpcServer) ListActions(ctx context.Context,
 			}
 		}
 
-		return true, true
+		return true, true
+	}
+
+	query := &firewalldb.ListActionsQuery{
+		IndexOffset: req.IndexOffset,
+		MaxNum:      req.MaxNumActions,
+		Reversed:    req.Reversed,
+		CountAll:    req.CountTotal,
+	}
+
+	var (
+		db         = s.cfg.actionsDB
+		actions    []*firewalldb.Action
+		lastIndex  uint64
+		totalCount uint64
+		err        error
+	)
+	if req.SessionId != nil {
+		sessionID, err := session.IDFromBytes(req.SessionId)
+		if err != nil {
+			return nil, err
+		}
+
+		actions, lastIndex, totalCount, err = db.ListSessionActions(
+			sessionID, filterFn, query,
+		)
+		if err != nil {
+			return nil, err
+		}
+	} else if req.GroupId != nil {
+		groupID, err := session.IDFromBytes(req.GroupId)
+		if err != nil {
+			return nil, err
+		}
+
+		actions, err = db.ListGroupActions(ctx, groupID, filterFn)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		actions, lastIndex, totalCount, err = db.ListActions(
+			filterFn, query,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp := make([]*litrpc.Action, len(actions))
+	for i, a := range actions {
+		resp[i], err = marshalAction(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &litrpc.ListActionsResponse{
+		Actions:         resp,
+		LastIndexOffset: lastIndex,
+		TotalCount:      totalCount,
+	}, nil
+}
+
+// ReplayActions re-evaluates the past actions of a session against a proposed
+// set of rules in dry-run mode and reports the actions for which the new rules
+// would come to a different decision.
+func (s *sessionRpcServer) ReplayActions(ctx context.Context,
+	req *litrpc.ReplayActionsRequest) (*litrpc.ReplayActionsResponse,
+	error) {
+
+	enforcer := s.cfg.ruleEnforcer()
+	if enforcer == nil {
+		return nil, fmt.Errorf("the firewall is not available, make " +
+			"sure the autopilot is enabled")
+	}
+
+	sessionID, err := session.IDFromBytes(req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	featureRules := make(
+		map[string]map[string]rules.Values, len(req.FeatureRules),
+	)
+	for feature, rulesMap := range req.FeatureRules {
+		featureRules[feature] = make(map[string]rules.Values)
+		for ruleName, rule := range rulesMap.GetRules() {
+			v, err := s.cfg.ruleMgrs.UnmarshalRuleValues(
+				ruleName, rule,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			featureRules[feature][ruleName] = v
+		}
+	}
+
+	filterFn := func(a *firewalldb.Action, _ bool) (bool, bool) {
+		timeStamp := uint64(a.AttemptedAt.Unix())
+		if req.EndTimestamp != 0 && timeStamp > req.EndTimestamp {
+			return false, false
+		}
+
+		if req.StartTimestamp != 0 && timeStamp < req.StartTimestamp {
+			return false, true
+		}
+
+		return true, true
+	}
+
+	actions, _, _, err := s.cfg.actionsDB.ListSessionActions(
+		sessionID, filterFn, &firewalldb.ListActionsQuery{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := enforcer.Replay(ctx, sessionID, featureRules, actions)
+	if err != nil {
+		return nil, err
 	}
 
-	query := &firewalldb.ListActionsQuery{
-		IndexOffset: req.IndexOffset,
-		MaxNum:      req.MaxNumActions,
-		Reversed:    req.Reversed,
-		CountAll:    req.CountTotal,
+	resp := &litrpc.ReplayActionsResponse{
+		NumReplayed: uint64(len(results)),
+		NumSkipped:  uint64(len(actions) - len(results)),
 	}
+	for _, r := range results {
+		if !r.Changed() {
+			continue
+		}
 
-	var (
-		db         = s.cfg.actionsDB
-		actions    []*firewalldb.Action
-		lastIndex  uint64
-		totalCount uint64
-		err        error
-	)
-	if req.SessionId != nil {
-		sessionID, err := session.IDFromBytes(req.SessionId)
+		action, err := marshalAction(r.Action)
 		if err != nil {
 			return nil, err
 		}
 
-		actions, lastIndex, totalCount, err = db.ListSessionActions(
-			sessionID, filterFn, query,
+		resp.ChangedActions = append(
+			resp.ChangedActions, &litrpc.ReplayedAction{
+				Action:           action,
+				PreviouslyDenied: r.PreviouslyDenied,
+				Denied:           r.Denied,
+				Reason:           r.Reason,
+			},
 		)
+	}
+
+	return resp, nil
+}
+
+// FirewallAuditLog returns the decisions the firewall made for the requests of
+// sessions that are subject to firewall rules.
+func (s *sessionRpcServer) FirewallAuditLog(_ context.Context,
+	req *litrpc.FirewallAuditLogRequest) (*litrpc.FirewallAuditLogResponse,
+	error) {
+
+	query := &firewalldb.AuditLogQuery{
+		MaxNum: req.MaxNumEntries,
+	}
+
+	if len(req.SessionId) != 0 {
+		sessionID, err := session.IDFromBytes(req.SessionId)
 		if err != nil {
 			return nil, err
 		}
-	} else if req.GroupId != nil {
-		groupID, err := session.IDFromBytes(req.GroupId)
-		if err != nil {
-			return nil, err
-		}
+		query.SessionID = &sessionID
+	}
 
-		actions, err = db.ListGroupActions(ctx, groupID, filterFn)
+	if req.StartTimestamp != 0 {
+		query.StartTime = time.Unix(int64(req.StartTimestamp), 0)
+	}
+	if req.EndTimestamp != 0 {
+		query.EndTime = time.Unix(int64(req.EndTimestamp), 0)
+	}
+
+	if !query.StartTime.IsZero() && !query.EndTime.IsZero() &&
+		query.EndTime.Before(query.StartTime) {
+
+		return nil, fmt.Errorf("end timestamp must not be before the " +
+			"start timestamp")
+	}
+
+	entries, err := s.cfg.actionsDB.ListAuditEntries(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &litrpc.FirewallAuditLogResponse{
+		Entries: make([]*litrpc.AuditLogEntry, len(entries)),
+	}
+	for i, e := range entries {
+		decision, err := marshalAuditDecision(e.Decision)
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		actions, lastIndex, totalCount, err = db.ListActions(
-			filterFn, query,
-		)
-		if err != nil {
-			return nil, err
+
+		resp.Entries[i] = &litrpc.AuditLogEntry{
+			TimestampNs: uint64(e.Timestamp.UnixNano()),
+			SessionId:   e.SessionID[:],
+			Uri:         e.URI,
+			Decision:    decision,
+			RuleNames:   e.RuleNames,
+			Reason:      e.Reason,
 		}
 	}
-	resp := make([]*litrpc.Action, len(actions))
-	for i, a := range actions {
-		state, err := marshalActionState(a.State)
+
+	return resp, nil
+}
+
+// ExportPrivacyMapping returns all real-pseudo pairs of the privacy map of a
+// session group. It is only available if privacy exports were explicitly
+// enabled in the config.
+func (s *sessionRpcServer) ExportPrivacyMapping(ctx context.Context,
+	req *litrpc.ExportPrivacyMappingRequest) (
+	*litrpc.ExportPrivacyMappingResponse, error) {
+
+	if !s.cfg.allowPrivacyExport {
+		return nil, fmt.Errorf("privacy map exports are disabled, " +
+			"start litd with --firewall.allow-privacy-export to " +
+			"enable them")
+	}
+
+	groupID, err := s.resolveGroupID(ctx, req.GroupId, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pairs map[string]string
+	privMap := s.cfg.privMap(groupID)
+	err = privMap.View(ctx, func(ctx context.Context,
+		tx firewalldb.PrivacyMapTx) error {
+
+		allPairs, err := tx.FetchAllPairs(ctx)
 		if err != nil {
-			return nil, err
+			return err
 		}
+		pairs = allPairs.Pairs()
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The export reveals the real values that were hidden from the
+	// autopilot server, so we always log it.
+	log.Warnf("Exporting %d privacy map pairs of group %x", len(pairs),
+		groupID[:])
+
+	resp := &litrpc.ExportPrivacyMappingResponse{
+		GroupId: groupID[:],
+		Pairs:   make([]*litrpc.PrivacyMapPair, 0, len(pairs)),
+	}
+	for realStr, pseudoStr := range pairs {
+		resp.Pairs = append(resp.Pairs, &litrpc.PrivacyMapPair{
+			Real:   realStr,
+			Pseudo: pseudoStr,
+		})
+	}
+
+	// Sort the pairs so that the same privacy map always results in the
+	// same export.
+	sort.Slice(resp.Pairs, func(i, j int) bool {
+		return resp.Pairs[i].Real < resp.Pairs[j].Real
+	})
+
+	return resp, nil
+}
+
+// ImportPrivacyMapping restores the given real-pseudo pairs into the privacy
+// map of a session group.
+func (s *sessionRpcServer) ImportPrivacyMapping(ctx context.Context,
+	req *litrpc.ImportPrivacyMappingRequest) (
+	*litrpc.ImportPrivacyMappingResponse, error) {
+
+	groupID, err := s.resolveGroupID(ctx, req.GroupId, req.SessionId)
+	if err != nil {
+		return nil, err
+	}
 
-		resp[i] = &litrpc.Action{
-			SessionId:          a.SessionID[:],
-			ActorName:          a.ActorName,
-			FeatureName:        a.FeatureName,
-			Trigger:            a.Trigger,
-			Intent:             a.Intent,
-			StructuredJsonData: a.StructuredJsonData,
-			RpcMethod:          a.RPCMethod,
-			RpcParamsJson:      string(a.RPCParamsJson),
-			Timestamp:          uint64(a.AttemptedAt.Unix()),
-			State:              state,
-			ErrorReason:        a.ErrorReason,
+	pairs := make(map[string]string, len(req.Pairs))
+	for _, pair := range req.Pairs {
+		pseudoStr, ok := pairs[pair.Real]
+		if ok && pseudoStr != pair.Pseudo {
+			return nil, fmt.Errorf("real value %s is mapped to "+
+				"multiple pseudo values", pair.Real)
 		}
+		pairs[pair.Real] = pair.Pseudo
 	}
 
-	return &litrpc.ListActionsResponse{
-		Actions:         resp,
-		LastIndexOffset: lastIndex,
-		TotalCount:      totalCount,
+	var numImported int
+	privMap := s.cfg.privMap(groupID)
+	err = privMap.Update(ctx, func(ctx context.Context,
+		tx firewalldb.PrivacyMapTx) error {
+
+		var err error
+		numImported, err = firewalldb.ImportPairs(ctx, tx, pairs)
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to import privacy map pairs: %w",
+			err)
+	}
+
+	log.Infof("Imported %d privacy map pairs into group %x", numImported,
+		groupID[:])
+
+	return &litrpc.ImportPrivacyMappingResponse{
+		NumImported: uint32(numImported),
+		NumExisting: uint32(len(pairs) - numImported),
 	}, nil
 }
 
@@ -863,6 +2113,14 @@ func (s *sessionRpcServer) AddAutopilotSession(ctx context.Context,
 		return nil, fmt.Errorf("expiry must be in the future")
 	}
 
+	serverAddr, err := mailboxServerAddr(
+		ctx, req.MailboxServerAddr, req.DevServer,
+		req.VerifyMailboxServer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// If the privacy mapper is being used for this session, then we need
 	// to keep track of all our known privacy map pairs for this session
 	// along with any new pairs that we need to persist.
@@ -983,6 +2241,21 @@ func (s *sessionRpcServer) AddAutopilotSession(ctx context.Context,
 		}
 	}
 
+	var amountCfg session.AmountObfuscation
+	if req.AmountObfuscation != nil {
+		if !privacy {
+			return nil, fmt.Errorf("amount obfuscation can only " +
+				"be set when the privacy mapper is enabled")
+		}
+
+		amountCfg, err = unmarshalRPCAmountObfuscation(
+			req.AmountObfuscation,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	for f, rs := range req.Features {
 		// Check that the features is known by the autopilot server.
 		autopilotFeature, ok := autopilotFeatureMap[f]
@@ -991,6 +2264,14 @@ func (s *sessionRpcServer) AddAutopilotSession(ctx context.Context,
 				"provided by the Autopilot server", f)
 		}
 
+		// Make sure the Autopilot server won't reject the feature's
+		// configuration only once the session is registered.
+		err = autopilotFeature.ValidateConfig(rs.GetConfig())
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration for "+
+				"feature %s: %w", f, err)
+		}
+
 		// reqRules is the rules specified in the request.
 		var reqRules []rules.Values
 		if rs.Rules != nil {
@@ -1164,12 +2445,15 @@ func (s *sessionRpcServer) AddAutopilotSession(ctx context.Context,
 	}
 
 	if privacy {
-		sessOpts = append(sessOpts, session.WithPrivacy(privacyFlags))
+		sessOpts = append(
+			sessOpts, session.WithPrivacy(privacyFlags),
+			session.WithAmountObfuscation(amountCfg),
+		)
 	}
 
 	sess, err := s.cfg.db.NewSession(
-		ctx, req.Label, session.TypeAutopilot, expiry,
-		req.MailboxServerAddr, sessOpts...,
+		ctx, req.Label, session.TypeAutopilot, expiry, serverAddr,
+		sessOpts...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating new session: %v", err)
@@ -1446,6 +2730,11 @@ func (s *sessionRpcServer) marshalRPCSession(ctx context.Context,
 		return nil, err
 	}
 
+	rpcErrorVerbosity, err := marshalRPCErrorVerbosity(sess.ErrorVerbosity)
+	if err != nil {
+		return nil, err
+	}
+
 	var remotePubKey []byte
 	if sess.RemotePublicKey != nil {
 		remotePubKey = sess.RemotePublicKey.SerializeCompressed()
@@ -1464,9 +2753,10 @@ func (s *sessionRpcServer) marshalRPCSession(ctx context.Context,
 	}
 
 	var (
-		featureInfo    = make(map[string]*litrpc.RulesMap)
-		initRuleValues = s.cfg.ruleMgrs.InitRuleValues
+		sessionRules                      *litrpc.RulesMap
+		spendBudget, spendBudgetRemaining uint64
 	)
+	featureInfo := make(map[string]*litrpc.RulesMap)
 	if sess.MacaroonRecipe != nil {
 		for _, cav := range sess.MacaroonRecipe.Caveats {
 			info, err := firewall.ParseRuleCaveat(string(cav.Id))
@@ -1476,35 +2766,21 @@ func (s *sessionRpcServer) marshalRPCSession(ctx context.Context,
 				return nil, err
 			}
 
-			for feature, rules := range info.FeatureRules {
-				ruleMap := make(map[string]*litrpc.RuleValue)
-				for name, rule := range rules {
-					val, err := initRuleValues(
-						name, []byte(rule),
-					)
-					if err != nil {
-						return nil, err
-					}
-
-					if sess.WithPrivacyMapper {
-						db := s.cfg.privMap(
-							sess.GroupID,
-						)
-						val, err = val.PseudoToReal(
-							ctx, db,
-							sess.PrivacyFlags,
-						)
-						if err != nil {
-							return nil, err
-						}
-					}
-
-					ruleMap[name] = val.ToProto()
-				}
+			spendBudget, spendBudgetRemaining, err = s.spendBudget(
+				ctx, sess, info,
+			)
+			if err != nil {
+				return nil, err
+			}
 
-				featureInfo[feature] = &litrpc.RulesMap{
-					Rules: ruleMap,
-				}
+			caveatRules, err := s.marshalRules(
+				ctx, sess, info, featureInfo,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if caveatRules != nil {
+				sessionRules = caveatRules
 			}
 		}
 	}
@@ -1521,7 +2797,7 @@ func (s *sessionRpcServer) marshalRPCSession(ctx context.Context,
 		accountID = hex.EncodeToString(id[:])
 	})
 
-	return &litrpc.Session{
+	rpcSess := &litrpc.Session{
 		Id:                     sess.ID[:],
 		Label:                  sess.Label,
 		SessionState:           rpcState,
@@ -1541,7 +2817,76 @@ func (s *sessionRpcServer) marshalRPCSession(ctx context.Context,
 		FeatureConfigs:         clientConfig,
 		PrivacyFlags:           sess.PrivacyFlags.Serialize(),
 		AccountId:              accountID,
-	}, nil
+		ErrorVerbosity:         rpcErrorVerbosity,
+	}
+	rpcSess.SessionRules = sessionRules
+	rpcSess.SpendBudgetSat = spendBudget
+	rpcSess.SpendBudgetRemainingSat = spendBudgetRemaining
+
+	if sess.WithPrivacyMapper {
+		rpcSess.AmountObfuscation, err = marshalRPCAmountObfuscation(
+			sess.AmountObfuscation,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rpcSess, nil
+}
+
+// marshalRules converts the firewall rules of the given rules caveat into their
+// RPC counterparts. The feature rules are added to the given map and the
+// session wide rules are returned, or nil if there aren't any. If the given
+// session uses the privacy mapper, the feature rules are converted to their
+// real values. The session may be nil if the caveat doesn't belong to one.
+func (s *sessionRpcServer) marshalRules(ctx context.Context,
+	sess *session.Session, info *firewall.InterceptRules,
+	featureInfo map[string]*litrpc.RulesMap) (*litrpc.RulesMap, error) {
+
+	initRuleValues := s.cfg.ruleMgrs.InitRuleValues
+
+	sessionRuleMap := make(map[string]*litrpc.RuleValue)
+	for name, rule := range info.SessionRules {
+		val, err := initRuleValues(name, []byte(rule))
+		if err != nil {
+			return nil, err
+		}
+
+		sessionRuleMap[name] = val.ToProto()
+	}
+
+	for feature, rules := range info.FeatureRules {
+		ruleMap := make(map[string]*litrpc.RuleValue)
+		for name, rule := range rules {
+			val, err := initRuleValues(name, []byte(rule))
+			if err != nil {
+				return nil, err
+			}
+
+			if sess != nil && sess.WithPrivacyMapper {
+				db := s.cfg.privMap(sess.GroupID)
+				val, err = val.PseudoToReal(
+					ctx, db, sess.PrivacyFlags,
+				)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			ruleMap[name] = val.ToProto()
+		}
+
+		featureInfo[feature] = &litrpc.RulesMap{
+			Rules: ruleMap,
+		}
+	}
+
+	if len(sessionRuleMap) == 0 {
+		return nil, nil
+	}
+
+	return &litrpc.RulesMap{Rules: sessionRuleMap}, nil
 }
 
 // marshalRPCMacaroonRecipe converts a macaroon recipe (permissions and caveats)
@@ -1595,6 +2940,29 @@ func marshalRPCState(state session.State) (litrpc.SessionState, error) {
 	}
 }
 
+// unmarshalRPCState converts an RPC session state to the session state.
+func unmarshalRPCState(state litrpc.SessionState) (session.State, error) {
+	switch state {
+	case litrpc.SessionState_STATE_RESERVED:
+		return session.StateReserved, nil
+
+	case litrpc.SessionState_STATE_CREATED:
+		return session.StateCreated, nil
+
+	case litrpc.SessionState_STATE_IN_USE:
+		return session.StateInUse, nil
+
+	case litrpc.SessionState_STATE_REVOKED:
+		return session.StateRevoked, nil
+
+	case litrpc.SessionState_STATE_EXPIRED:
+		return session.StateExpired, nil
+
+	default:
+		return 0, fmt.Errorf("unknown state <%d>", state)
+	}
+}
+
 // marshalRPCType converts a session type to its RPC counterpart.
 func marshalRPCType(typ session.Type) (litrpc.SessionType, error) {
 	switch typ {
@@ -1647,7 +3015,157 @@ func unmarshalRPCType(typ litrpc.SessionType) (session.Type, error) {
 	}
 }
 
+// marshalRPCErrorVerbosity converts a session error verbosity to its RPC
+// counterpart.
+func marshalRPCErrorVerbosity(
+	verbosity session.ErrorVerbosity) (litrpc.ErrorVerbosity, error) {
+
+	switch verbosity {
+	case session.ErrorVerbosityVerbose:
+		return litrpc.ErrorVerbosity_ERROR_VERBOSITY_VERBOSE, nil
+
+	case session.ErrorVerbosityTerse:
+		return litrpc.ErrorVerbosity_ERROR_VERBOSITY_TERSE, nil
+
+	default:
+		return 0, fmt.Errorf("unknown error verbosity <%d>", verbosity)
+	}
+}
+
+// marshalRPCAmountObfuscation converts a session amount obfuscation config to
+// its RPC counterpart.
+func marshalRPCAmountObfuscation(
+	cfg session.AmountObfuscation) (*litrpc.AmountObfuscation, error) {
+
+	strategy, err := marshalRPCAmountStrategy(cfg.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.AmountObfuscation{
+		Strategy:      strategy,
+		VariationPpm:  cfg.VariationPPM,
+		BucketSizeSat: cfg.BucketSize,
+		ClearFields:   cfg.ClearFields,
+	}, nil
+}
+
+// marshalRPCAmountStrategy converts a session amount obfuscation strategy to
+// its RPC counterpart.
+func marshalRPCAmountStrategy(strategy session.AmountObfuscationStrategy) (
+	litrpc.AmountObfuscationStrategy, error) {
+
+	switch strategy {
+	case session.AmountObfuscationRelative:
+		return litrpc.
+			AmountObfuscationStrategy_AMOUNT_OBFUSCATION_RELATIVE, nil
+
+	case session.AmountObfuscationBucket:
+		return litrpc.
+			AmountObfuscationStrategy_AMOUNT_OBFUSCATION_BUCKET, nil
+
+	default:
+		return 0, fmt.Errorf("unknown amount obfuscation strategy "+
+			"<%d>", strategy)
+	}
+}
+
+// unmarshalRPCAmountObfuscation converts an RPC amount obfuscation config to
+// its session counterpart and validates it.
+func unmarshalRPCAmountObfuscation(
+	rpcCfg *litrpc.AmountObfuscation) (session.AmountObfuscation, error) {
+
+	cfg := session.AmountObfuscation{
+		VariationPPM: rpcCfg.VariationPpm,
+		BucketSize:   rpcCfg.BucketSizeSat,
+		ClearFields:  rpcCfg.ClearFields,
+	}
+
+	switch rpcCfg.Strategy {
+	case litrpc.AmountObfuscationStrategy_AMOUNT_OBFUSCATION_RELATIVE:
+		cfg.Strategy = session.AmountObfuscationRelative
+
+	case litrpc.AmountObfuscationStrategy_AMOUNT_OBFUSCATION_BUCKET:
+		cfg.Strategy = session.AmountObfuscationBucket
+
+	default:
+		return cfg, fmt.Errorf("unknown amount obfuscation strategy "+
+			"<%d>", rpcCfg.Strategy)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return cfg, fmt.Errorf("invalid amount obfuscation: %w", err)
+	}
+
+	return cfg, nil
+}
+
+// unmarshalRPCErrorVerbosity converts an RPC error verbosity to its session
+// counterpart, resolving the default verbosity for the given session type.
+func unmarshalRPCErrorVerbosity(verbosity litrpc.ErrorVerbosity,
+	typ session.Type) (session.ErrorVerbosity, error) {
+
+	switch verbosity {
+	// Only admin sessions may see the node's internals by default, all
+	// other session types need to ask for verbose errors explicitly.
+	case litrpc.ErrorVerbosity_ERROR_VERBOSITY_DEFAULT:
+		if typ == session.TypeMacaroonAdmin {
+			return session.ErrorVerbosityVerbose, nil
+		}
+
+		return session.ErrorVerbosityTerse, nil
+
+	case litrpc.ErrorVerbosity_ERROR_VERBOSITY_VERBOSE:
+		return session.ErrorVerbosityVerbose, nil
+
+	case litrpc.ErrorVerbosity_ERROR_VERBOSITY_TERSE:
+		return session.ErrorVerbosityTerse, nil
+
+	default:
+		return 0, fmt.Errorf("unknown error verbosity <%d>", verbosity)
+	}
+}
+
 // marshalActionState converts an Action state into its RPC counterpart.
+// marshalAction converts an action into its RPC counterpart.
+func marshalAction(a *firewalldb.Action) (*litrpc.Action, error) {
+	state, err := marshalActionState(a.State)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.Action{
+		SessionId:          a.SessionID[:],
+		ActorName:          a.ActorName,
+		FeatureName:        a.FeatureName,
+		Trigger:            a.Trigger,
+		Intent:             a.Intent,
+		StructuredJsonData: a.StructuredJsonData,
+		RpcMethod:          a.RPCMethod,
+		RpcParamsJson:      string(a.RPCParamsJson),
+		Timestamp:          uint64(a.AttemptedAt.Unix()),
+		State:              state,
+		ErrorReason:        a.ErrorReason,
+	}, nil
+}
+
+// marshalAuditDecision converts an audit log decision into its RPC
+// counterpart.
+func marshalAuditDecision(
+	decision firewalldb.AuditDecision) (litrpc.AuditDecision, error) {
+
+	switch decision {
+	case firewalldb.AuditDecisionAllowed:
+		return litrpc.AuditDecision_AUDIT_DECISION_ALLOWED, nil
+
+	case firewalldb.AuditDecisionDenied:
+		return litrpc.AuditDecision_AUDIT_DECISION_DENIED, nil
+
+	default:
+		return 0, fmt.Errorf("unknown audit decision: %v", decision)
+	}
+}
+
 func marshalActionState(state firewalldb.ActionState) (litrpc.ActionState,
 	error) {
 
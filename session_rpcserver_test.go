@@ -0,0 +1,767 @@
+package terminal
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightninglabs/lightning-terminal/firewall"
+	"github.com/lightninglabs/lightning-terminal/firewalldb"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	litmac "github.com/lightninglabs/lightning-terminal/macaroons"
+	"github.com/lightninglabs/lightning-terminal/perms"
+	"github.com/lightninglabs/lightning-terminal/rules"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+)
+
+// TestRevokeSessions tests that RevokeSessions revokes exactly the sessions
+// that match all given filters and that sessions in a terminal state are
+// skipped.
+func TestRevokeSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	start := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+
+	// newServer creates a session RPC server with a fresh session store
+	// that contains the following sessions:
+	//  - "old": created an hour before all other sessions.
+	//  - "prod-admin": an admin session.
+	//  - "prod-readonly": a readonly session.
+	//  - "prod-revoked": a session that was revoked already.
+	//  - "test-expired": a session whose expiry has passed.
+	//  - "test-marked": an expired session that was marked as expired.
+	newServer := func(t *testing.T) (*sessionRpcServer,
+		map[string]*session.Session) {
+
+		testClock := clock.NewTestClock(start)
+		store := session.NewTestDB(t, testClock)
+
+		sessions := make(map[string]*session.Session)
+		addSession := func(label string, typ session.Type,
+			expiry time.Time, states ...session.State) {
+
+			sess, err := store.NewSession(
+				ctx, label, typ, expiry, "foo.bar.baz:1234",
+			)
+			require.NoError(t, err)
+
+			states = append([]session.State{
+				session.StateCreated,
+			}, states...)
+			for _, state := range states {
+				err := store.ShiftState(ctx, sess.ID, state)
+				require.NoError(t, err)
+			}
+
+			sessions[label], err = store.GetSession(ctx, sess.ID)
+			require.NoError(t, err)
+		}
+
+		future := time.Now().Add(24 * time.Hour)
+		past := time.Now().Add(-time.Hour)
+
+		addSession("old", session.TypeMacaroonAdmin, future)
+
+		testClock.SetTime(start.Add(time.Hour))
+		addSession("prod-admin", session.TypeMacaroonAdmin, future)
+		addSession(
+			"prod-readonly", session.TypeMacaroonReadonly, future,
+		)
+		addSession(
+			"prod-revoked", session.TypeMacaroonAdmin, future,
+			session.StateRevoked,
+		)
+		addSession("test-expired", session.TypeMacaroonAdmin, past)
+		addSession(
+			"test-marked", session.TypeMacaroonReadonly, past,
+			session.StateExpired,
+		)
+
+		server := &sessionRpcServer{
+			cfg:           &sessionRpcServerConfig{db: store},
+			sessionServer: session.NewServer(nil, nil),
+		}
+
+		return server, sessions
+	}
+
+	tests := []struct {
+		name     string
+		req      *litrpc.RevokeSessionsRequest
+		expected []string
+	}{{
+		name: "label prefix",
+		req: &litrpc.RevokeSessionsRequest{
+			LabelPrefix: "prod-",
+		},
+		expected: []string{"prod-admin", "prod-readonly"},
+	}, {
+		name: "expired",
+		req: &litrpc.RevokeSessionsRequest{
+			Expired: true,
+		},
+		expected: []string{"test-expired"},
+	}, {
+		name: "created before",
+		req: &litrpc.RevokeSessionsRequest{
+			CreatedBefore: uint64(start.Add(time.Minute).Unix()),
+		},
+		expected: []string{"old"},
+	}, {
+		name: "label prefix and expired",
+		req: &litrpc.RevokeSessionsRequest{
+			LabelPrefix: "prod-",
+			Expired:     true,
+		},
+		expected: nil,
+	}, {
+		name: "no terminal sessions",
+		req: &litrpc.RevokeSessionsRequest{
+			CreatedBefore: uint64(time.Now().Unix()),
+		},
+		expected: []string{
+			"old", "prod-admin", "prod-readonly", "test-expired",
+		},
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			server, sessions := newServer(t)
+			store := server.cfg.db
+
+			// A dry run returns the matching sessions without
+			// revoking them.
+			dryReq := &litrpc.RevokeSessionsRequest{}
+			proto.Merge(dryReq, test.req)
+			dryReq.DryRun = true
+			resp, err := server.RevokeSessions(ctx, dryReq)
+			require.NoError(t, err)
+			require.EqualValues(
+				t, len(test.expected), resp.NumRevoked,
+			)
+			require.ElementsMatch(
+				t, test.expected, sessionLabels(resp.Sessions),
+			)
+
+			for label, sess := range sessions {
+				dbSess, err := store.GetSession(ctx, sess.ID)
+				require.NoError(t, err, label)
+				require.Equal(t, sess.State, dbSess.State, label)
+			}
+
+			// The actual call revokes each matching session and
+			// leaves all others untouched.
+			resp, err = server.RevokeSessions(ctx, test.req)
+			require.NoError(t, err)
+			require.EqualValues(
+				t, len(test.expected), resp.NumRevoked,
+			)
+			require.ElementsMatch(
+				t, test.expected, sessionLabels(resp.Sessions),
+			)
+
+			revoked := make(map[string]bool)
+			for _, label := range test.expected {
+				revoked[label] = true
+			}
+			for label, sess := range sessions {
+				dbSess, err := store.GetSession(ctx, sess.ID)
+				require.NoError(t, err, label)
+
+				expectedState := sess.State
+				if revoked[label] {
+					expectedState = session.StateRevoked
+				}
+				require.Equal(
+					t, expectedState, dbSess.State, label,
+				)
+			}
+
+			// Running the call again is a no-op since the sessions
+			// are revoked already.
+			resp, err = server.RevokeSessions(ctx, test.req)
+			require.NoError(t, err)
+			require.Zero(t, resp.NumRevoked)
+			require.Empty(t, resp.Sessions)
+		})
+	}
+
+	t.Run("local public keys", func(t *testing.T) {
+		t.Parallel()
+
+		server, sessions := newServer(t)
+		store := server.cfg.db
+
+		// The revoked session's key is known, so it doesn't fail the
+		// call, but it is skipped.
+		resp, err := server.RevokeSessions(
+			ctx, &litrpc.RevokeSessionsRequest{
+				LocalPublicKeys: [][]byte{
+					localPubKey(sessions["prod-admin"]),
+					localPubKey(sessions["prod-revoked"]),
+				},
+			},
+		)
+		require.NoError(t, err)
+		require.EqualValues(t, 1, resp.NumRevoked)
+		require.Equal(
+			t, []string{"prod-admin"}, sessionLabels(resp.Sessions),
+		)
+
+		dbSess, err := store.GetSession(ctx, sessions["prod-admin"].ID)
+		require.NoError(t, err)
+		require.Equal(t, session.StateRevoked, dbSess.State)
+
+		dbSess, err = store.GetSession(ctx, sessions["old"].ID)
+		require.NoError(t, err)
+		require.Equal(t, session.StateCreated, dbSess.State)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		t.Parallel()
+
+		server, sessions := newServer(t)
+		store := server.cfg.db
+
+		_, err := server.RevokeSessions(
+			ctx, &litrpc.RevokeSessionsRequest{},
+		)
+		require.ErrorContains(t, err, "at least one filter")
+
+		// A key of an unknown session fails the call before any of
+		// the other sessions are revoked.
+		unknownPriv, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+		unknownKey := unknownPriv.PubKey().SerializeCompressed()
+
+		_, err = server.RevokeSessions(
+			ctx, &litrpc.RevokeSessionsRequest{
+				LocalPublicKeys: [][]byte{
+					localPubKey(sessions["prod-admin"]),
+					unknownKey,
+				},
+			},
+		)
+		require.Error(t, err)
+
+		dbSess, err := store.GetSession(ctx, sessions["prod-admin"].ID)
+		require.NoError(t, err)
+		require.Equal(t, session.StateCreated, dbSess.State)
+	})
+}
+
+// sessionLabels returns the sorted labels of the given sessions.
+func sessionLabels(sessions []*litrpc.Session) []string {
+	labels := make([]string, 0, len(sessions))
+	for _, sess := range sessions {
+		labels = append(labels, sess.Label)
+	}
+	sort.Strings(labels)
+
+	return labels
+}
+
+// localPubKey returns the serialized local public key of the given session.
+func localPubKey(sess *session.Session) []byte {
+	return sess.LocalPublicKey.SerializeCompressed()
+}
+
+// TestUpdateSessionExpiryRunningSession tests that a running session whose
+// expiry was updated keeps working with the macaroon that was handed to its
+// client before the update, even past the old expiry.
+func TestUpdateSessionExpiryRunningSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := session.NewTestDB(t, clock.NewDefaultClock())
+
+	addSession := func(label string, expiry time.Time) *session.Session {
+		sess, err := store.NewSession(
+			ctx, label, session.TypeMacaroonCustom, expiry,
+			"foo.bar.baz:1234", session.WithMacaroonRecipe(
+				nil, []bakery.Op{{
+					Entity: "uri",
+					Action: "/lnrpc.Lightning/GetInfo",
+				}},
+			),
+		)
+		require.NoError(t, err)
+
+		err = store.ShiftState(ctx, sess.ID, session.StateCreated)
+		require.NoError(t, err)
+
+		return sess
+	}
+
+	oldExpiry := time.Now().Add(2 * time.Second)
+	sess := addSession("running", oldExpiry)
+
+	// We simulate the running session by registering the timer that
+	// stops it at its expiry.
+	timer := time.NewTimer(time.Until(oldExpiry))
+	t.Cleanup(func() { timer.Stop() })
+
+	server := &sessionRpcServer{
+		cfg:           &sessionRpcServerConfig{db: store},
+		sessionServer: session.NewServer(nil, nil),
+		expiryTimers: map[session.ID]*time.Timer{
+			sess.ID: timer,
+		},
+	}
+	g := &LightningTerminal{stores: &stores{sessions: store}}
+
+	// The macaroon that the client receives on its handshake must not
+	// carry the expiry of the session, otherwise it can't outlive it.
+	_, caveats, ok, err := server.sessionMacaroonRecipe(sess)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	_, expires := checkers.ExpiryTime(nil, caveats)
+	require.False(t, expires)
+
+	require.NoError(t, g.checkMacaroonSession(ctx, sess.MacaroonRootKey))
+
+	newExpiry := time.Now().Add(time.Hour)
+	resp, err := server.UpdateSessionExpiry(
+		ctx, &litrpc.UpdateSessionExpiryRequest{
+			Id:                     sess.ID[:],
+			ExpiryTimestampSeconds: uint64(newExpiry.Unix()),
+		},
+	)
+	require.NoError(t, err)
+	require.EqualValues(
+		t, newExpiry.Unix(), resp.Session.ExpiryTimestampSeconds,
+	)
+
+	// The session must not be stopped at its old expiry, and calls made
+	// after it has passed are still allowed.
+	select {
+	case <-timer.C:
+		t.Fatalf("session stopped at its old expiry")
+
+	case <-time.After(time.Until(oldExpiry) + 500*time.Millisecond):
+	}
+
+	require.NoError(t, g.checkMacaroonSession(ctx, sess.MacaroonRootKey))
+
+	// Once the session is revoked, its macaroon is rejected.
+	err = store.ShiftState(ctx, sess.ID, session.StateRevoked)
+	require.NoError(t, err)
+
+	err = g.checkMacaroonSession(ctx, sess.MacaroonRootKey)
+	require.ErrorIs(t, err, session.ErrSessionNotActive)
+
+	// The macaroon of a session whose stored expiry has passed is
+	// rejected as well, even if the session wasn't marked as expired yet.
+	expired := addSession("expired", time.Now().Add(-time.Hour))
+	err = g.checkMacaroonSession(ctx, expired.MacaroonRootKey)
+	require.ErrorIs(t, err, session.ErrSessionNotActive)
+
+	// Super macaroons that don't belong to a session aren't affected.
+	rootKeyID := litmac.NewSuperMacaroonRootKeyID([4]byte{1, 2, 3, 4})
+	require.NoError(t, g.checkMacaroonSession(ctx, rootKeyID))
+}
+
+// TestAllowedURIPermissions tests that the allowed URIs of a session are only
+// accepted for the session types and URIs that they can be applied to.
+func TestAllowedURIPermissions(t *testing.T) {
+	t.Parallel()
+
+	permsMgr, err := perms.NewManager(false)
+	require.NoError(t, err)
+
+	server := &sessionRpcServer{
+		cfg: &sessionRpcServerConfig{permMgr: permsMgr},
+	}
+
+	const (
+		getInfo = "/lnrpc.Lightning/GetInfo"
+		sendPay = "/lnrpc.Lightning/SendPaymentSync"
+	)
+	uriEntity := macaroons.PermissionEntityCustomURI
+
+	tests := []struct {
+		name        string
+		typ         session.Type
+		uris        []string
+		expectedErr string
+	}{{
+		name: "admin",
+		typ:  session.TypeMacaroonAdmin,
+		uris: []string{getInfo, sendPay},
+	}, {
+		name: "readonly",
+		typ:  session.TypeMacaroonReadonly,
+		uris: []string{getInfo},
+	}, {
+		name:        "readonly with write URI",
+		typ:         session.TypeMacaroonReadonly,
+		uris:        []string{getInfo, sendPay},
+		expectedErr: "readonly sessions don't grant",
+	}, {
+		name:        "custom session",
+		typ:         session.TypeMacaroonCustom,
+		uris:        []string{getInfo},
+		expectedErr: "only be set for admin and readonly sessions",
+	}, {
+		name:        "account session",
+		typ:         session.TypeMacaroonAccount,
+		uris:        []string{getInfo},
+		expectedErr: "only be set for admin and readonly sessions",
+	}, {
+		name:        "unknown URI",
+		typ:         session.TypeMacaroonAdmin,
+		uris:        []string{"/lnrpc.Lightning/Unknown"},
+		expectedErr: "unknown to LiT",
+	}, {
+		name:        "URI not given in full",
+		typ:         session.TypeMacaroonAdmin,
+		uris:        []string{"GetInfo"},
+		expectedErr: "must be given in full",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ops, err := server.allowedURIPermissions(
+				test.typ, test.uris,
+			)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// Each URI is granted as a URI permission, so that the
+			// macaroon doesn't grant access to any other URI that
+			// requires the same entity-action pairs.
+			require.Len(t, ops, len(test.uris))
+			for i, uri := range test.uris {
+				require.Equal(t, bakery.Op{
+					Entity: uriEntity,
+					Action: uri,
+				}, ops[i])
+			}
+		})
+	}
+}
+
+// TestValidateCustomCaveat tests that only the custom caveats that lnd can
+// check are accepted for a session.
+func TestValidateCustomCaveat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		caveat      string
+		expectedErr string
+	}{{
+		name:   "time before",
+		caveat: "time-before 2030-01-01T00:00:00Z",
+	}, {
+		name:   "IP address",
+		caveat: "ipaddr 127.0.0.1",
+	}, {
+		name:   "IP range",
+		caveat: "iprange 10.0.0.0/8",
+	}, {
+		name:   "account",
+		caveat: "lnd-custom account 0102030405060708",
+	}, {
+		name:   "firewall rules",
+		caveat: "lnd-custom lit-mac-fw meta:foo:{}",
+	}, {
+		name:        "empty",
+		caveat:      "",
+		expectedErr: "must not be empty",
+	}, {
+		name:        "invalid time",
+		caveat:      "time-before tomorrow",
+		expectedErr: "RFC3339",
+	}, {
+		name:        "invalid IP address",
+		caveat:      "ipaddr localhost",
+		expectedErr: "not an IP address",
+	}, {
+		name:        "invalid IP range",
+		caveat:      "iprange 10.0.0.0",
+		expectedErr: "CIDR notation",
+	}, {
+		name:        "unknown custom caveat",
+		caveat:      "lnd-custom unknown foo",
+		expectedErr: "unknown custom caveat name \"unknown\"",
+	}, {
+		name:        "unknown condition",
+		caveat:      "allow-ip 127.0.0.1",
+		expectedErr: "condition must be one of time-before, ipaddr",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateCustomCaveat(test.caveat)
+			if test.expectedErr != "" {
+				require.ErrorContains(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// A session with an invalid custom caveat is rejected before it is
+	// created.
+	ctx := context.Background()
+	store := session.NewTestDB(t, clock.NewDefaultClock())
+	server := &sessionRpcServer{
+		cfg: &sessionRpcServerConfig{db: store},
+	}
+
+	_, err := server.AddSession(ctx, &litrpc.AddSessionRequest{
+		Label:                  "invalid caveat",
+		SessionType:            litrpc.SessionType_TYPE_MACAROON_ADMIN,
+		ExpiryTimestampSeconds: uint64(time.Now().Add(time.Hour).Unix()),
+		MailboxServerAddr:      "foo.bar.baz:1234",
+		MacaroonCustomCaveats:  []string{"lnd-custom unknown foo"},
+	})
+	require.ErrorContains(t, err, "unknown custom caveat name")
+
+	sessions, err := store.ListAllSessions(ctx)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+// TestLinkedSessions tests that sessions can be linked to the group of an
+// existing session, that the sessions of a group can be listed and that the
+// state of their session wide rules is shared.
+func TestLinkedSessions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store := session.NewTestDB(t, clock.NewDefaultClock())
+	rulesDB := firewalldb.NewTestDBWithSessions(t, store)
+
+	server := &sessionRpcServer{
+		cfg: &sessionRpcServerConfig{
+			db:       store,
+			rulesDB:  rulesDB,
+			ruleMgrs: rules.NewRuleManagerSet(),
+		},
+		sessionServer: session.NewServer(nil, nil),
+	}
+
+	expiry := time.Now().Add(time.Hour)
+	addSession := func(label string, typ session.Type,
+		opts ...session.Option) (*session.Session, error) {
+
+		sess, err := store.NewSession(
+			ctx, label, typ, expiry, "foo.bar.baz:1234", opts...,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		err = store.ShiftState(ctx, sess.ID, session.StateCreated)
+		require.NoError(t, err)
+
+		return sess, nil
+	}
+
+	first, err := addSession("first", session.TypeMacaroonAdmin)
+	require.NoError(t, err)
+	require.Equal(t, first.ID, first.GroupID)
+
+	other, err := addSession("other", session.TypeMacaroonAdmin)
+	require.NoError(t, err)
+
+	autopilot, err := addSession("autopilot", session.TypeAutopilot)
+	require.NoError(t, err)
+
+	// Only existing sessions that aren't autopilot sessions can be linked
+	// to.
+	_, err = server.linkedGroupID(ctx, []byte{1, 2})
+	require.ErrorContains(t, err, "invalid linked session ID")
+
+	_, err = server.linkedGroupID(ctx, []byte{1, 2, 3, 4})
+	require.ErrorIs(t, err, session.ErrSessionNotFound)
+
+	_, err = server.linkedGroupID(ctx, autopilot.ID[:])
+	require.ErrorContains(t, err, "using AddAutopilotSession")
+
+	groupID, err := server.linkedGroupID(ctx, first.ID[:])
+	require.NoError(t, err)
+	require.Equal(t, first.GroupID, groupID)
+
+	// A session can only be linked once all other sessions of the group
+	// are no longer active.
+	_, err = addSession(
+		"second", session.TypeMacaroonAdmin,
+		session.WithLinkedGroupID(&groupID),
+	)
+	require.ErrorIs(t, err, session.ErrSessionsInGroupStillActive)
+
+	err = store.ShiftState(ctx, first.ID, session.StateRevoked)
+	require.NoError(t, err)
+
+	second, err := addSession(
+		"second", session.TypeMacaroonAdmin,
+		session.WithLinkedGroupID(&groupID),
+	)
+	require.NoError(t, err)
+	require.Equal(t, groupID, second.GroupID)
+
+	// Linking to the second session adds to the same group.
+	linkedGroupID, err := server.linkedGroupID(ctx, second.ID[:])
+	require.NoError(t, err)
+	require.Equal(t, groupID, linkedGroupID)
+
+	// The ID of any session in the group lists the whole group, including
+	// the revoked session, in the order the sessions were created in.
+	for _, id := range []session.ID{first.ID, second.ID} {
+		resp, err := server.ListSessions(
+			ctx, &litrpc.ListSessionsRequest{GroupId: id[:]},
+		)
+		require.NoError(t, err)
+		require.Len(t, resp.Sessions, 2)
+		require.Equal(t, "first", resp.Sessions[0].Label)
+		require.Equal(t, "second", resp.Sessions[1].Label)
+
+		for _, sess := range resp.Sessions {
+			require.Equal(t, groupID[:], sess.GroupId)
+		}
+	}
+
+	resp, err := server.ListSessions(
+		ctx, &litrpc.ListSessionsRequest{GroupId: other.ID[:]},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []string{"other"}, sessionLabels(resp.Sessions))
+
+	_, err = server.ListSessions(
+		ctx, &litrpc.ListSessionsRequest{GroupId: []byte{1, 2}},
+	)
+	require.ErrorContains(t, err, "invalid group ID")
+
+	// The state of the session wide rules is kept per group. So what the
+	// first session spent of its budget counts towards the budget of the
+	// second one too.
+	budget, err := rules.Marshal(&rules.SessionBudget{AbsoluteAmtSats: 100})
+	require.NoError(t, err)
+
+	info := &firewall.InterceptRules{
+		SessionRules: map[string]string{
+			rules.SessionBudgetName: string(budget),
+		},
+	}
+
+	enf, err := (&rules.SessionBudgetMgr{}).NewEnforcer(
+		ctx, &rules.ConfigImpl{
+			Stores: rulesDB.GetKVStores(
+				rules.SessionBudgetName, first.GroupID, "",
+			),
+			LndConnID: "test",
+		}, &rules.SessionBudget{AbsoluteAmtSats: 100},
+	)
+	require.NoError(t, err)
+
+	const sendURI = "/lnrpc.Lightning/SendPaymentSync"
+	_, err = enf.HandleRequest(ctx, sendURI, &lnrpc.SendRequest{Amt: 30})
+	require.NoError(t, err)
+	_, err = enf.HandleResponse(ctx, sendURI, &lnrpc.SendResponse{
+		PaymentRoute: &lnrpc.Route{TotalAmtMsat: 30_000},
+	})
+	require.NoError(t, err)
+
+	for _, sess := range []*session.Session{first, second} {
+		total, left, err := server.spendBudget(ctx, sess, info)
+		require.NoError(t, err)
+		require.EqualValues(t, 100, total)
+		require.EqualValues(t, 70, left)
+	}
+
+	// The budget of a session in another group is unaffected.
+	total, left, err := server.spendBudget(ctx, other, info)
+	require.NoError(t, err)
+	require.EqualValues(t, 100, total)
+	require.EqualValues(t, 100, left)
+}
+
+// TestUnmarshalRPCErrorVerbosity tests that only admin sessions get verbose
+// errors by default and that the verbosity can be set explicitly for all
+// session types.
+func TestUnmarshalRPCErrorVerbosity(t *testing.T) {
+	t.Parallel()
+
+	var (
+		defaultVerbosity = litrpc.ErrorVerbosity_ERROR_VERBOSITY_DEFAULT
+		verbose          = litrpc.ErrorVerbosity_ERROR_VERBOSITY_VERBOSE
+		terse            = litrpc.ErrorVerbosity_ERROR_VERBOSITY_TERSE
+	)
+
+	tests := []struct {
+		name      string
+		verbosity litrpc.ErrorVerbosity
+		typ       session.Type
+		expected  session.ErrorVerbosity
+	}{{
+		name:      "admin default",
+		verbosity: defaultVerbosity,
+		typ:       session.TypeMacaroonAdmin,
+		expected:  session.ErrorVerbosityVerbose,
+	}, {
+		name:      "readonly default",
+		verbosity: defaultVerbosity,
+		typ:       session.TypeMacaroonReadonly,
+		expected:  session.ErrorVerbosityTerse,
+	}, {
+		name:      "account default",
+		verbosity: defaultVerbosity,
+		typ:       session.TypeMacaroonAccount,
+		expected:  session.ErrorVerbosityTerse,
+	}, {
+		name:      "custom default",
+		verbosity: defaultVerbosity,
+		typ:       session.TypeMacaroonCustom,
+		expected:  session.ErrorVerbosityTerse,
+	}, {
+		name:      "admin terse",
+		verbosity: terse,
+		typ:       session.TypeMacaroonAdmin,
+		expected:  session.ErrorVerbosityTerse,
+	}, {
+		name:      "readonly verbose",
+		verbosity: verbose,
+		typ:       session.TypeMacaroonReadonly,
+		expected:  session.ErrorVerbosityVerbose,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			verbosity, err := unmarshalRPCErrorVerbosity(
+				test.verbosity, test.typ,
+			)
+			require.NoError(t, err)
+			require.Equal(t, test.expected, verbosity)
+		})
+	}
+
+	_, err := unmarshalRPCErrorVerbosity(
+		litrpc.ErrorVerbosity(42), session.TypeMacaroonAdmin,
+	)
+	require.ErrorContains(t, err, "unknown error verbosity")
+}
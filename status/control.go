@@ -0,0 +1,92 @@
+package status
+
+import (
+	"context"
+	"errors"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+)
+
+// SubServerController starts and stops sub-servers while LiT keeps running.
+// It is used by the StartSubServer and StopSubServer calls, which update the
+// status of the sub-server through the Manager as well.
+type SubServerController interface {
+	// StartSubServer starts the sub-server with the given name again after
+	// it was stopped or failed with an error.
+	StartSubServer(name string) error
+
+	// StopSubServer gracefully stops the sub-server with the given name.
+	StopSubServer(name string) error
+}
+
+// SetSubServerController sets the controller that starts and stops sub-servers
+// for the StartSubServer and StopSubServer calls. Until it is set, both calls
+// return an error.
+func (s *Manager) SetSubServerController(ctrl SubServerController) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.controller = ctrl
+}
+
+// subServerController returns the controller that starts and stops
+// sub-servers, or an error if it hasn't been set yet.
+func (s *Manager) subServerController() (SubServerController, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.controller == nil {
+		return nil, errors.New("sub-servers can't be started or " +
+			"stopped yet")
+	}
+
+	return s.controller, nil
+}
+
+// StartSubServer starts an integrated sub-server that was stopped with
+// StopSubServer or failed with an error, without restarting LiT.
+//
+// NOTE: this is part of the litrpc.StatusServer interface.
+func (s *Manager) StartSubServer(_ context.Context,
+	req *litrpc.StartSubServerRequest) (*litrpc.StartSubServerResponse,
+	error) {
+
+	log.Infof("[startsubserver] name=%v", req.Name)
+
+	ctrl, err := s.subServerController()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctrl.StartSubServer(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &litrpc.StartSubServerResponse{
+		Status: s.subServerStatuses()[req.Name],
+	}, nil
+}
+
+// StopSubServer gracefully stops an integrated sub-server while LiT keeps
+// running.
+//
+// NOTE: this is part of the litrpc.StatusServer interface.
+func (s *Manager) StopSubServer(_ context.Context,
+	req *litrpc.StopSubServerRequest) (*litrpc.StopSubServerResponse,
+	error) {
+
+	log.Infof("[stopsubserver] name=%v", req.Name)
+
+	ctrl, err := s.subServerController()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctrl.StopSubServer(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &litrpc.StopSubServerResponse{
+		Status: s.subServerStatuses()[req.Name],
+	}, nil
+}
@@ -0,0 +1,101 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/stretchr/testify/require"
+)
+
+// mockController is a SubServerController that updates the status of the
+// sub-servers it starts and stops the way the sub-server manager does.
+type mockController struct {
+	mgr *Manager
+
+	startErr error
+}
+
+func (c *mockController) StartSubServer(name string) error {
+	c.mgr.SetStarting(name)
+	if c.startErr != nil {
+		c.mgr.SetErrored(name, c.startErr.Error())
+		return c.startErr
+	}
+
+	c.mgr.SetRunning(name)
+
+	return nil
+}
+
+func (c *mockController) StopSubServer(name string) error {
+	c.mgr.SetStopped(name)
+
+	return nil
+}
+
+// TestStartStopSubServer tests that sub-servers can only be started and
+// stopped once a controller is set and that the new status is returned.
+func TestStartStopSubServer(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mgr := NewStatusManager()
+	require.NoError(t, mgr.RegisterAndEnableSubServer("loop"))
+	mgr.SetRunning("loop")
+
+	stopReq := &litrpc.StopSubServerRequest{Name: "loop"}
+	startReq := &litrpc.StartSubServerRequest{Name: "loop"}
+
+	_, err := mgr.StopSubServer(ctx, stopReq)
+	require.Error(t, err)
+
+	ctrl := &mockController{mgr: mgr}
+	mgr.SetSubServerController(ctrl)
+
+	stopResp, err := mgr.StopSubServer(ctx, stopReq)
+	require.NoError(t, err)
+	require.Equal(
+		t, litrpc.SubServerState_SUB_SERVER_STATE_STOPPED,
+		stopResp.Status.State,
+	)
+
+	// A failed start is returned and reported as errored.
+	ctrl.startErr = errors.New("no wallet")
+	_, err = mgr.StartSubServer(ctx, startReq)
+	require.ErrorIs(t, err, ctrl.startErr)
+
+	status := mgr.subServerStatuses()["loop"]
+	require.Equal(
+		t, litrpc.SubServerState_SUB_SERVER_STATE_ERRORED, status.State,
+	)
+	require.Equal(t, "no wallet", status.Error)
+
+	ctrl.startErr = nil
+	startResp, err := mgr.StartSubServer(ctx, startReq)
+	require.NoError(t, err)
+	require.Equal(
+		t, litrpc.SubServerState_SUB_SERVER_STATE_RUNNING,
+		startResp.Status.State,
+	)
+}
+
+// TestSetStarting tests that a stopped or errored sub-server is reported as
+// starting again.
+func TestSetStarting(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewStatusManager()
+	require.NoError(t, mgr.RegisterAndEnableSubServer("pool"))
+
+	mgr.SetErrored("pool", "failed")
+	mgr.SetStarting("pool")
+
+	status := mgr.subServerStatuses()["pool"]
+	require.Equal(
+		t, litrpc.SubServerState_SUB_SERVER_STATE_STARTING, status.State,
+	)
+	require.Empty(t, status.Error)
+	require.False(t, status.Running)
+}
@@ -0,0 +1,112 @@
+package status
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+)
+
+const (
+	// HealthPath is the path of the HTTP endpoint that reports the status
+	// of all sub-servers and always succeeds as long as LiT is able to
+	// serve requests.
+	HealthPath = "/health"
+
+	// ReadyPath is the path of the HTTP endpoint that only succeeds if all
+	// required sub-servers are running.
+	ReadyPath = "/ready"
+)
+
+// subServerHealth is the state of a single sub-server as reported by the
+// health endpoints. The endpoints are unauthenticated, so the error of a
+// sub-server isn't included, as it can leak details like file paths or
+// addresses. It is only available through the authenticated SubServerStatus
+// RPC.
+type subServerHealth struct {
+	Disabled     bool   `json:"disabled"`
+	Running      bool   `json:"running"`
+	Required     bool   `json:"required"`
+	CustomStatus string `json:"custom_status,omitempty"`
+}
+
+// healthReport is the JSON body returned by the health endpoints.
+type healthReport struct {
+	Ready      bool                        `json:"ready"`
+	SubServers map[string]*subServerHealth `json:"sub_servers"`
+}
+
+// HealthHandler returns an HTTP handler that serves the health and readiness
+// endpoints. Both endpoints return a JSON body with the state of each
+// sub-server. The health endpoint always responds with 200 while the
+// readiness endpoint responds with 503 unless all required sub-servers are
+// running. If no required sub-servers are given, all sub-servers that aren't
+// disabled are required. A required sub-server that is disabled is never
+// ready.
+func (s *Manager) HealthHandler(required []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+
+			return
+		}
+
+		report := s.healthReport(required)
+
+		code := http.StatusOK
+		if r.URL.Path == ReadyPath && !report.Ready {
+			code = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			log.Errorf("Unable to write health report: %v", err)
+		}
+	})
+}
+
+// healthReport builds the health report of all registered sub-servers given
+// the names of the sub-servers that are required to be running.
+func (s *Manager) healthReport(required []string) *healthReport {
+	// We use the same status that is reported over RPC, so that sub-servers
+	// whose status has gone stale aren't reported as running.
+	statuses := s.subServerStatuses()
+
+	isRequired := func(name string, status *litrpc.SubServerStatus) bool {
+		if len(required) == 0 {
+			return !status.Disabled
+		}
+
+		return slices.Contains(required, name)
+	}
+
+	report := &healthReport{
+		Ready:      true,
+		SubServers: make(map[string]*subServerHealth),
+	}
+	for name, status := range statuses {
+		health := &subServerHealth{
+			Disabled:     status.Disabled,
+			Running:      status.Running,
+			Required:     isRequired(name, status),
+			CustomStatus: status.CustomStatus,
+		}
+		report.SubServers[name] = health
+
+		if health.Required && !health.Running {
+			report.Ready = false
+		}
+	}
+
+	// A required sub-server that was never registered can't be running.
+	for _, name := range required {
+		if _, ok := report.SubServers[name]; !ok {
+			report.Ready = false
+		}
+	}
+
+	return report
+}
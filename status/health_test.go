@@ -0,0 +1,106 @@
+package status
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestHealthHandler tests that the health endpoint always succeeds and that
+// the readiness endpoint only succeeds once all required sub-servers are
+// running.
+func TestHealthHandler(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewStatusManager()
+	require.NoError(t, mgr.RegisterAndEnableSubServer("lnd"))
+	require.NoError(t, mgr.RegisterAndEnableSubServer("loop"))
+	require.NoError(t, mgr.RegisterSubServer("pool"))
+
+	query := func(handler http.Handler, path string) (int, *healthReport) {
+		t.Helper()
+
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		handler.ServeHTTP(rec, req)
+
+		var report healthReport
+		require.NoError(t, json.NewDecoder(rec.Body).Decode(&report))
+
+		return rec.Code, &report
+	}
+
+	// By default, all enabled sub-servers are required.
+	allHandler := mgr.HealthHandler(nil)
+
+	// Only loop is required.
+	loopHandler := mgr.HealthHandler([]string{"loop"})
+
+	// Pool is disabled, so it can never be ready.
+	poolHandler := mgr.HealthHandler([]string{"pool"})
+
+	// Before anything is running, nothing is ready but the health endpoint
+	// still succeeds.
+	code, report := query(allHandler, HealthPath)
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, report.Ready)
+	require.Len(t, report.SubServers, 3)
+	require.True(t, report.SubServers["lnd"].Required)
+	require.False(t, report.SubServers["pool"].Required)
+	require.True(t, report.SubServers["pool"].Disabled)
+
+	code, _ = query(allHandler, ReadyPath)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+
+	// Once loop is running, it is ready if only loop is required.
+	mgr.SetRunning("loop")
+
+	code, _ = query(allHandler, ReadyPath)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+
+	code, report = query(loopHandler, ReadyPath)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, report.Ready)
+	require.False(t, report.SubServers["lnd"].Required)
+
+	// Once lnd is running as well, all enabled sub-servers are running.
+	mgr.SetRunning("lnd")
+
+	code, report = query(allHandler, ReadyPath)
+	require.Equal(t, http.StatusOK, code)
+	require.True(t, report.Ready)
+
+	code, _ = query(poolHandler, ReadyPath)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+
+	// An errored sub-server isn't ready, but its error is only reported
+	// over RPC and not by the unauthenticated endpoints.
+	mgr.SetErrored("loop", "boom")
+
+	code, report = query(loopHandler, ReadyPath)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.False(t, report.SubServers["loop"].Running)
+
+	rec := httptest.NewRecorder()
+	loopHandler.ServeHTTP(rec, httptest.NewRequest(
+		http.MethodGet, HealthPath, nil,
+	))
+	require.NotContains(t, rec.Body.String(), "boom")
+
+	statuses := mgr.subServerStatuses()
+	require.Equal(t, "boom", statuses["loop"].Error)
+
+	// A required sub-server that was never registered is never ready.
+	unknownHandler := mgr.HealthHandler([]string{"unknown"})
+	code, _ = query(unknownHandler, ReadyPath)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+
+	// Only GET and HEAD requests are allowed.
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, ReadyPath, nil)
+	allHandler.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
@@ -5,10 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/clock"
 )
 
+// StatusUnknown is the custom status that is reported for a running sub-server
+// whose status has gone stale.
+const StatusUnknown = "unknown"
+
 // SubServerOption defines a functional option that can be used to modify the
 // values of a subServer's fields.
 type SubServerOption func(status *subServer)
@@ -33,6 +39,10 @@ type subServer struct {
 	// been started.
 	running bool
 
+	// stopped is true if the sub-server was stopped after it was started
+	// and hasn't been started again since.
+	stopped bool
+
 	// customStatus is a string that details a custom status of the
 	// sub-server, if the sub-server is in a custom state. This status
 	// can be set to a unique status that only exists for the specific
@@ -51,12 +61,24 @@ type subServer struct {
 	// handle the given request. If it does not, then we will fall back to
 	// our normal is-ready check.
 	isReadyOverride func(string, string) (bool, bool)
+
+	// lastUpdated is the time at which the status was last set or
+	// confirmed by a heartbeat.
+	lastUpdated time.Time
+
+	// monitored is true once a heartbeat has been received for the
+	// sub-server. Only the status of monitored sub-servers can go stale,
+	// since nothing confirms the status of the others periodically.
+	monitored bool
 }
 
 // newSubServer constructs a new subServer.
-func newSubServer(disabled bool, opts ...SubServerOption) *subServer {
+func newSubServer(disabled bool, now time.Time,
+	opts ...SubServerOption) *subServer {
+
 	s := &subServer{
-		disabled: disabled,
+		disabled:    disabled,
+		lastUpdated: now,
 	}
 
 	for _, opt := range opts {
@@ -66,6 +88,27 @@ func newSubServer(disabled bool, opts ...SubServerOption) *subServer {
 	return s
 }
 
+// state returns the lifecycle state of the sub-server, not taking into account
+// whether its status has gone stale.
+func (s *subServer) state() litrpc.SubServerState {
+	switch {
+	case s.disabled:
+		return litrpc.SubServerState_SUB_SERVER_STATE_DISABLED
+
+	case s.running:
+		return litrpc.SubServerState_SUB_SERVER_STATE_RUNNING
+
+	case s.err != "":
+		return litrpc.SubServerState_SUB_SERVER_STATE_ERRORED
+
+	case s.stopped:
+		return litrpc.SubServerState_SUB_SERVER_STATE_STOPPED
+
+	default:
+		return litrpc.SubServerState_SUB_SERVER_STATE_STARTING
+	}
+}
+
 // Manager manages the status of any sub-server registered to it. It is also an
 // implementation of the litrpc.StatusServer which can be queried for the status
 // of various LiT sub-servers.
@@ -74,15 +117,55 @@ type Manager struct {
 
 	subServers map[string]*subServer
 	mu         sync.RWMutex
+
+	clock clock.Clock
+
+	// staleThreshold is the duration after which the status of a monitored
+	// running sub-server is reported as unknown if no heartbeat was
+	// received for it. Zero disables the staleness check.
+	staleThreshold time.Duration
+
+	// subscribers are notified whenever the status of a sub-server
+	// changes.
+	subscribers      map[uint64]chan struct{}
+	nextSubscriberID uint64
+
+	// controller starts and stops sub-servers at runtime. It is nil until
+	// it is set with SetSubServerController.
+	controller SubServerController
 }
 
 // NewStatusManager constructs a new Manager.
 func NewStatusManager() *Manager {
 	return &Manager{
-		subServers: make(map[string]*subServer),
+		subServers:  make(map[string]*subServer),
+		clock:       clock.NewDefaultClock(),
+		subscribers: make(map[uint64]chan struct{}),
 	}
 }
 
+// SetStaleThreshold sets the duration after which the status of a monitored
+// running sub-server is reported as unknown if no heartbeat was received for
+// it. Zero disables the staleness check.
+func (s *Manager) SetStaleThreshold(threshold time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.staleThreshold = threshold
+}
+
+// isStaleUnsafe returns true if the running status of the given sub-server
+// hasn't been confirmed for longer than the stale threshold.
+//
+// NOTE: The mutex must be held when calling this method.
+func (s *Manager) isStaleUnsafe(ss *subServer) bool {
+	if s.staleThreshold == 0 || !ss.monitored || !ss.running {
+		return false
+	}
+
+	return s.clock.Now().Sub(ss.lastUpdated) > s.staleThreshold
+}
+
 // IsSystemReady shows if the given sub-server ready to handle the request for
 // the passed request URI. The first returned boolean is true if the system
 // is ready to handle the request. The second returned boolean is true if the
@@ -125,6 +208,13 @@ func (s *Manager) SubServerStatus(_ context.Context,
 	_ *litrpc.SubServerStatusReq) (*litrpc.SubServerStatusResp,
 	error) {
 
+	return &litrpc.SubServerStatusResp{
+		SubServers: s.subServerStatuses(),
+	}, nil
+}
+
+// subServerStatuses returns the current status of all registered sub-servers.
+func (s *Manager) subServerStatuses() map[string]*litrpc.SubServerStatus {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -135,12 +225,21 @@ func (s *Manager) SubServerStatus(_ context.Context,
 			Running:      status.running,
 			Error:        status.err,
 			CustomStatus: status.customStatus,
+			LastUpdated:  status.lastUpdated.Unix(),
+			State:        status.state(),
+		}
+
+		// We can't tell whether a sub-server with a stale status is
+		// still running, so we don't report the last known status.
+		if s.isStaleUnsafe(status) {
+			resp[server].Running = false
+			resp[server].CustomStatus = StatusUnknown
+			resp[server].State = litrpc.
+				SubServerState_SUB_SERVER_STATE_UNKNOWN
 		}
 	}
 
-	return &litrpc.SubServerStatusResp{
-		SubServers: resp,
-	}, nil
+	return resp
 }
 
 // RegisterSubServer will create a new sub-server entry for the Manager to
@@ -148,8 +247,8 @@ func (s *Manager) SubServerStatus(_ context.Context,
 func (s *Manager) RegisterSubServer(name string,
 	opts ...SubServerOption) error {
 
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	return s.registerSubServerUnsafe(name, true, opts...)
 }
@@ -159,8 +258,8 @@ func (s *Manager) RegisterSubServer(name string,
 func (s *Manager) RegisterAndEnableSubServer(name string,
 	opts ...SubServerOption) error {
 
-	s.mu.RLock()
-	defer s.mu.RUnlock()
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	return s.registerSubServerUnsafe(name, false, opts...)
 }
@@ -174,7 +273,8 @@ func (s *Manager) registerSubServerUnsafe(name string, disabled bool,
 			"been registered with the status manager", name)
 	}
 
-	s.subServers[name] = newSubServer(disabled, opts...)
+	s.subServers[name] = newSubServer(disabled, s.clock.Now(), opts...)
+	s.notifySubscribersUnsafe()
 
 	return nil
 }
@@ -191,6 +291,32 @@ func (s *Manager) SetCustomStatus(name, customStatus string) {
 	}
 
 	ss.customStatus = customStatus
+	ss.lastUpdated = s.clock.Now()
+	s.notifySubscribersUnsafe()
+}
+
+// Heartbeat confirms that the status of the given sub-server is still
+// accurate. Once a heartbeat has been received for a sub-server, its running
+// status is reported as unknown if it isn't confirmed again within the stale
+// threshold.
+//
+// NOTE: This will silently fail if the referenced sub-server has not yet been
+// registered.
+func (s *Manager) Heartbeat(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss, ok := s.subServers[name]
+	if !ok {
+		return
+	}
+
+	ss.monitored = true
+	ss.lastUpdated = s.clock.Now()
+
+	// A heartbeat can restore the status of a sub-server that had gone
+	// stale.
+	s.notifySubscribersUnsafe()
 }
 
 // SetEnabled marks the sub-server with the given name as enabled.
@@ -209,6 +335,32 @@ func (s *Manager) SetEnabled(name string) {
 	}
 
 	ss.disabled = false
+	ss.lastUpdated = s.clock.Now()
+	s.notifySubscribersUnsafe()
+}
+
+// SetStarting can be used to set the status of a sub-server as Starting again
+// after it was stopped or failed with an error.
+//
+// NOTE: This will silently fail if the referenced sub-server has not yet been
+// registered.
+func (s *Manager) SetStarting(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	log.Debugf("Setting the %s sub-server as starting", name)
+
+	ss, ok := s.subServers[name]
+	if !ok {
+		return
+	}
+
+	ss.running = false
+	ss.stopped = false
+	ss.err = ""
+	ss.customStatus = ""
+	ss.lastUpdated = s.clock.Now()
+	s.notifySubscribersUnsafe()
 }
 
 // SetRunning can be used to set the status of a sub-server as Running
@@ -228,8 +380,11 @@ func (s *Manager) SetRunning(name string) {
 	}
 
 	ss.running = true
+	ss.stopped = false
 	ss.err = ""
 	ss.customStatus = ""
+	ss.lastUpdated = s.clock.Now()
+	s.notifySubscribersUnsafe()
 }
 
 // SetStopped can be used to set the status of a sub-server as not Running and
@@ -249,8 +404,11 @@ func (s *Manager) SetStopped(name string) {
 	}
 
 	ss.running = false
+	ss.stopped = true
 	ss.err = ""
 	ss.customStatus = ""
+	ss.lastUpdated = s.clock.Now()
+	s.notifySubscribersUnsafe()
 }
 
 // SetErrored can be used to set the status of a sub-server as not Running
@@ -276,6 +434,9 @@ func (s *Manager) SetErrored(name string, errStr string,
 	log.Errorf("could not start the %s sub-server: %s", name, err)
 
 	ss.running = false
+	ss.stopped = false
 	ss.err = err
 	ss.customStatus = ""
+	ss.lastUpdated = s.clock.Now()
+	s.notifySubscribersUnsafe()
 }
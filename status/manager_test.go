@@ -0,0 +1,70 @@
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStaleStatus tests that the status of a monitored running sub-server is
+// reported as unknown once no heartbeat was received for it within the stale
+// threshold.
+func TestStaleStatus(t *testing.T) {
+	t.Parallel()
+
+	testClock := clock.NewTestClock(time.Unix(1000, 0))
+	mgr := NewStatusManager()
+	mgr.clock = testClock
+	mgr.SetStaleThreshold(time.Minute)
+
+	require.NoError(t, mgr.RegisterAndEnableSubServer("monitored"))
+	require.NoError(t, mgr.RegisterAndEnableSubServer("unmonitored"))
+	mgr.SetRunning("monitored")
+	mgr.SetRunning("unmonitored")
+	mgr.Heartbeat("monitored")
+
+	status := func(name string) *litrpc.SubServerStatus {
+		resp, err := mgr.SubServerStatus(
+			context.Background(), &litrpc.SubServerStatusReq{},
+		)
+		require.NoError(t, err)
+
+		return resp.SubServers[name]
+	}
+
+	// Within the threshold, the last known status is reported.
+	testClock.SetTime(time.Unix(1060, 0))
+	require.True(t, status("monitored").Running)
+	require.Empty(t, status("monitored").CustomStatus)
+	require.EqualValues(t, 1000, status("monitored").LastUpdated)
+
+	// Once the threshold has passed without a heartbeat, the status of the
+	// monitored sub-server is unknown. The status of a sub-server that
+	// never received a heartbeat can't go stale.
+	testClock.SetTime(time.Unix(1061, 0))
+	require.False(t, status("monitored").Running)
+	require.Equal(t, StatusUnknown, status("monitored").CustomStatus)
+	require.True(t, status("unmonitored").Running)
+
+	// A heartbeat restores the last known status.
+	mgr.Heartbeat("monitored")
+	require.True(t, status("monitored").Running)
+	require.EqualValues(t, 1061, status("monitored").LastUpdated)
+
+	// A stopped sub-server isn't reported as running anyway, so its status
+	// doesn't go stale either.
+	mgr.SetStopped("monitored")
+	testClock.SetTime(time.Unix(2000, 0))
+	require.False(t, status("monitored").Running)
+	require.Empty(t, status("monitored").CustomStatus)
+
+	// Without a threshold, the status never goes stale.
+	mgr.SetRunning("monitored")
+	mgr.SetStaleThreshold(0)
+	testClock.SetTime(time.Unix(5000, 0))
+	require.True(t, status("monitored").Running)
+}
@@ -0,0 +1,50 @@
+package status
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// collector is a prometheus.Collector that reports whether each sub-server
+// is running.
+type collector struct {
+	mgr *Manager
+
+	up *prometheus.Desc
+}
+
+// Collector returns a prometheus.Collector that reports a gauge for each
+// registered sub-server that is 1 if the sub-server is running and 0
+// otherwise. A sub-server whose status has gone stale is reported as not
+// running.
+func (s *Manager) Collector() prometheus.Collector {
+	return &collector{
+		mgr: s,
+		up: prometheus.NewDesc(
+			"litd_subserver_up", "Whether the sub-server is "+
+				"running.", []string{"subserver"}, nil,
+		),
+	}
+}
+
+// Describe sends the descriptors of all metrics of the collector.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (c *collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.up
+}
+
+// Collect sends the current status of each sub-server.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (c *collector) Collect(ch chan<- prometheus.Metric) {
+	for name, status := range c.mgr.subServerStatuses() {
+		var up float64
+		if status.Running {
+			up = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.up, prometheus.GaugeValue, up, name,
+		)
+	}
+}
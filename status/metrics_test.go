@@ -0,0 +1,35 @@
+package status
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+// TestCollector tests that the collector reports whether each sub-server is
+// running.
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewStatusManager()
+	require.NoError(t, mgr.RegisterAndEnableSubServer("lnd"))
+	require.NoError(t, mgr.RegisterAndEnableSubServer("loop"))
+	require.NoError(t, mgr.RegisterSubServer("pool"))
+	mgr.SetRunning("lnd")
+	mgr.SetRunning("loop")
+	mgr.SetErrored("loop", "boom")
+
+	expected := `
+# HELP litd_subserver_up Whether the sub-server is running.
+# TYPE litd_subserver_up gauge
+litd_subserver_up{subserver="lnd"} 1
+litd_subserver_up{subserver="loop"} 0
+litd_subserver_up{subserver="pool"} 0
+`
+	err := testutil.CollectAndCompare(
+		mgr.Collector(), strings.NewReader(expected),
+	)
+	require.NoError(t, err)
+}
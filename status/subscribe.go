@@ -0,0 +1,139 @@
+package status
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+)
+
+// subscribe registers a new subscriber that is notified whenever the status of
+// a sub-server changes. Notifications are coalesced, so a subscriber that
+// hasn't handled the previous notification yet isn't notified again. The
+// returned function must be called to remove the subscriber.
+func (s *Manager) subscribe() (<-chan struct{}, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.nextSubscriberID
+	s.nextSubscriberID++
+
+	updates := make(chan struct{}, 1)
+	s.subscribers[id] = updates
+
+	return updates, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		delete(s.subscribers, id)
+	}
+}
+
+// notifySubscribersUnsafe notifies all subscribers that the status of a
+// sub-server changed. It never blocks.
+//
+// NOTE: The mutex must be held when calling this method.
+func (s *Manager) notifySubscribersUnsafe() {
+	for _, updates := range s.subscribers {
+		select {
+		case updates <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// statusChanged returns true if the state of a sub-server differs between the
+// two given statuses. A different last updated time alone, as caused by a
+// heartbeat, isn't a change.
+func statusChanged(prev, next *litrpc.SubServerStatus) bool {
+	return prev.State != next.State || prev.Error != next.Error ||
+		prev.CustomStatus != next.CustomStatus
+}
+
+// SubscribeSubServerStatus streams an event whenever the state of a sub-server
+// changes, starting with a snapshot of the current status of all sub-servers.
+//
+// NOTE: this is part of the litrpc.StatusServer interface.
+func (s *Manager) SubscribeSubServerStatus(
+	_ *litrpc.SubscribeSubServerStatusRequest,
+	stream litrpc.Status_SubscribeSubServerStatusServer) error {
+
+	return s.WatchSubServerStatus(stream.Context(), stream.Send)
+}
+
+// WatchSubServerStatus calls the given function whenever the state of a
+// sub-server changes, starting with a snapshot of the current status of all
+// sub-servers. It blocks until the context is canceled or the function returns
+// an error.
+func (s *Manager) WatchSubServerStatus(ctx context.Context,
+	send func(*litrpc.SubServerStatusEvent) error) error {
+
+	// We subscribe before taking the snapshot, so that no change that
+	// happens in between can be missed.
+	updates, cancel := s.subscribe()
+	defer cancel()
+
+	// The status of a sub-server can go stale without any update, so we
+	// regularly check for that if a stale threshold is set.
+	s.mu.RLock()
+	staleThreshold := s.staleThreshold
+	s.mu.RUnlock()
+
+	var staleCheck <-chan time.Time
+	if staleThreshold > 0 {
+		ticker := time.NewTicker(staleThreshold / 2)
+		defer ticker.Stop()
+
+		staleCheck = ticker.C
+	}
+
+	last := make(map[string]*litrpc.SubServerStatus)
+	sendChanges := func(snapshot bool) error {
+		statuses := s.subServerStatuses()
+
+		names := make([]string, 0, len(statuses))
+		for name := range statuses {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			status := statuses[name]
+
+			prev, ok := last[name]
+			if ok && !statusChanged(prev, status) {
+				continue
+			}
+			last[name] = status
+
+			err := send(&litrpc.SubServerStatusEvent{
+				Name:     name,
+				Status:   status,
+				Snapshot: snapshot,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := sendChanges(true); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-updates:
+		case <-staleCheck:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		if err := sendChanges(false); err != nil {
+			return err
+		}
+	}
+}
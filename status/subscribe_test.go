@@ -0,0 +1,157 @@
+package status
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// mockStatusStream is a litrpc.Status_SubscribeSubServerStatusServer that
+// delivers the sent events on a channel.
+type mockStatusStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	events chan *litrpc.SubServerStatusEvent
+}
+
+// Context returns the context of the stream.
+func (m *mockStatusStream) Context() context.Context {
+	return m.ctx
+}
+
+// Send delivers the given event on the events channel.
+func (m *mockStatusStream) Send(event *litrpc.SubServerStatusEvent) error {
+	select {
+	case m.events <- event:
+		return nil
+
+	case <-m.ctx.Done():
+		return m.ctx.Err()
+	}
+}
+
+// TestSubscribeSubServerStatus tests that a subscriber first receives the
+// status of all sub-servers and then an event for every state change.
+func TestSubscribeSubServerStatus(t *testing.T) {
+	t.Parallel()
+
+	mgr := NewStatusManager()
+	require.NoError(t, mgr.RegisterAndEnableSubServer("lnd"))
+	require.NoError(t, mgr.RegisterSubServer("loop"))
+	mgr.SetRunning("lnd")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockStatusStream{
+		ctx:    ctx,
+		events: make(chan *litrpc.SubServerStatusEvent),
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- mgr.SubscribeSubServerStatus(
+			&litrpc.SubscribeSubServerStatusRequest{}, stream,
+		)
+	}()
+
+	receive := func() *litrpc.SubServerStatusEvent {
+		t.Helper()
+
+		select {
+		case event := <-stream.events:
+			return event
+
+		case <-time.After(time.Second):
+			t.Fatalf("no event received")
+			return nil
+		}
+	}
+
+	assertEvent := func(name string, state litrpc.SubServerState,
+		snapshot bool) *litrpc.SubServerStatusEvent {
+
+		t.Helper()
+
+		event := receive()
+		require.Equal(t, name, event.Name)
+		require.Equal(t, state, event.Status.State)
+		require.Equal(t, snapshot, event.Snapshot)
+
+		return event
+	}
+
+	// The snapshot of all sub-servers is sent first.
+	assertEvent("lnd", litrpc.SubServerState_SUB_SERVER_STATE_RUNNING, true)
+	assertEvent(
+		"loop", litrpc.SubServerState_SUB_SERVER_STATE_DISABLED, true,
+	)
+
+	// A heartbeat doesn't change the state, so the next event is the one
+	// of loop being enabled.
+	mgr.Heartbeat("lnd")
+	mgr.SetEnabled("loop")
+	assertEvent(
+		"loop", litrpc.SubServerState_SUB_SERVER_STATE_STARTING, false,
+	)
+
+	mgr.SetRunning("loop")
+	assertEvent(
+		"loop", litrpc.SubServerState_SUB_SERVER_STATE_RUNNING, false,
+	)
+
+	// A failure includes the error.
+	mgr.SetErrored("loop", "crashed: %v", "boom")
+	event := assertEvent(
+		"loop", litrpc.SubServerState_SUB_SERVER_STATE_ERRORED, false,
+	)
+	require.Equal(t, "crashed: boom", event.Status.Error)
+
+	mgr.SetStopped("lnd")
+	assertEvent("lnd", litrpc.SubServerState_SUB_SERVER_STATE_STOPPED, false)
+
+	// A late subscriber immediately receives the current state.
+	lateCtx, lateCancel := context.WithCancel(context.Background())
+	defer lateCancel()
+	lateStream := &mockStatusStream{
+		ctx:    lateCtx,
+		events: make(chan *litrpc.SubServerStatusEvent, 2),
+	}
+	go func() {
+		_ = mgr.SubscribeSubServerStatus(
+			&litrpc.SubscribeSubServerStatusRequest{}, lateStream,
+		)
+	}()
+
+	for _, expected := range []litrpc.SubServerState{
+		litrpc.SubServerState_SUB_SERVER_STATE_STOPPED,
+		litrpc.SubServerState_SUB_SERVER_STATE_ERRORED,
+	} {
+		select {
+		case event := <-lateStream.events:
+			require.True(t, event.Snapshot)
+			require.Equal(t, expected, event.Status.State)
+
+		case <-time.After(time.Second):
+			t.Fatalf("no snapshot received")
+		}
+	}
+
+	// Once the stream's context is canceled, the subscription ends and the
+	// subscriber is removed.
+	cancel()
+	select {
+	case err := <-errChan:
+		require.ErrorIs(t, err, context.Canceled)
+
+	case <-time.After(time.Second):
+		t.Fatalf("subscription didn't end")
+	}
+
+	mgr.mu.RLock()
+	defer mgr.mu.RUnlock()
+	require.Len(t, mgr.subscribers, 1)
+}
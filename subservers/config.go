@@ -1,6 +1,11 @@
 package subservers
 
-import "github.com/lightningnetwork/lnd/build"
+import (
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/build"
+)
 
 // RemoteConfig holds the configuration parameters that are needed when running
 // LiT in the "remote" lnd mode.
@@ -41,4 +46,28 @@ type RemoteDaemonConfig struct {
 	// TLSCertPath is the path to the tls cert of the remote daemon that
 	// should be used to verify the TLS identity of the remote RPC server.
 	TLSCertPath string `long:"tlscertpath" description:"The full path to the remote daemon's TLS cert to use for RPC connection verification."`
+
+	// Timeout is the deadline that is applied to unary calls that are
+	// forwarded to the remote daemon if the caller didn't set a deadline
+	// itself. Zero means that such calls have no deadline.
+	Timeout time.Duration `long:"timeout" description:"The deadline that is applied to unary calls that are forwarded to the remote daemon if the caller didn't set one. Calls that exceed it fail with DeadlineExceeded. Streaming calls are not affected. 0 disables the default deadline."`
+}
+
+// ValidateTimeouts checks that none of the remote daemons has a negative
+// request timeout.
+func (c *RemoteConfig) ValidateTimeouts() error {
+	for name, daemon := range map[string]*RemoteDaemonConfig{
+		LND:     c.Lnd,
+		FARADAY: c.Faraday,
+		LOOP:    c.Loop,
+		POOL:    c.Pool,
+		TAP:     c.TaprootAssets,
+	} {
+		if daemon.Timeout < 0 {
+			return fmt.Errorf("the request timeout of the remote "+
+				"%s daemon must not be negative", name)
+		}
+	}
+
+	return nil
 }
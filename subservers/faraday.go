@@ -21,6 +21,7 @@ type faradaySubServer struct {
 
 	remote    bool
 	cfg       *faraday.Config
+	rpcCfg    *frdrpcserver.Config
 	remoteCfg *RemoteDaemonConfig
 }
 
@@ -35,6 +36,7 @@ func NewFaradaySubServer(cfg *faraday.Config, rpcCfg *frdrpcserver.Config,
 	return &faradaySubServer{
 		RPCServer: frdrpcserver.NewRPCServer(rpcCfg),
 		cfg:       cfg,
+		rpcCfg:    rpcCfg,
 		remoteCfg: remoteCfg,
 		remote:    remote,
 	}
@@ -74,6 +76,22 @@ func (f *faradaySubServer) Start(_ lnrpc.LightningClient,
 	)
 }
 
+// Restartable returns an error if the sub-server can't be stopped and started
+// again in integrated mode while LiT keeps running.
+//
+// NOTE: this is part of the SubServer interface.
+func (f *faradaySubServer) Restartable() error {
+	return nil
+}
+
+// ResetIntegrated replaces the sub-server's integrated daemon with a fresh
+// instance, since a daemon can only be started once.
+//
+// NOTE: this is part of the SubServer interface.
+func (f *faradaySubServer) ResetIntegrated() {
+	f.RPCServer = frdrpcserver.NewRPCServer(f.rpcCfg)
+}
+
 // RegisterGrpcService must register the sub-server's GRPC server with the given
 // registrar.
 //
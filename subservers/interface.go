@@ -37,6 +37,16 @@ type SubServer interface {
 	// Stop stops the sub-server in integrated mode.
 	Stop() error
 
+	// Restartable returns an error if the sub-server can't be stopped and
+	// started again in integrated mode while LiT keeps running.
+	Restartable() error
+
+	// ResetIntegrated replaces the sub-server's integrated daemon with a
+	// fresh instance, since a daemon can only be started once. It is
+	// called before the sub-server is started again after it was stopped
+	// or failed to start.
+	ResetIntegrated()
+
 	// RegisterGrpcService must register the sub-server's GRPC server with
 	// the given registrar.
 	RegisterGrpcService(grpc.ServiceRegistrar)
@@ -84,6 +84,22 @@ func (l *loopSubServer) Stop() error {
 	return nil
 }
 
+// Restartable returns an error if the sub-server can't be stopped and started
+// again in integrated mode while LiT keeps running.
+//
+// NOTE: this is part of the SubServer interface.
+func (l *loopSubServer) Restartable() error {
+	return nil
+}
+
+// ResetIntegrated replaces the sub-server's integrated daemon with a fresh
+// instance, since a daemon can only be started once.
+//
+// NOTE: this is part of the SubServer interface.
+func (l *loopSubServer) ResetIntegrated() {
+	l.Daemon = loopd.New(l.cfg, nil)
+}
+
 // RegisterGrpcService must register the sub-server's GRPC server with the given
 // registrar.
 //
@@ -2,6 +2,7 @@ package subservers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"sync"
@@ -16,7 +17,9 @@ import (
 	grpcProxy "github.com/mwitkow/grpc-proxy/proxy"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	grpcstatus "google.golang.org/grpc/status"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon.v2"
 )
@@ -37,6 +40,13 @@ type Manager struct {
 	permsMgr     *perms.Manager
 	statusServer *status.Manager
 	mu           sync.RWMutex
+
+	// The lnd connections that the integrated sub-servers were started
+	// with. They are kept to start sub-servers again at runtime and are
+	// nil until StartIntegratedServers is called.
+	lndClient           lnrpc.LightningClient
+	lndGrpc             *lndclient.GrpcLndServices
+	withMacaroonService bool
 }
 
 // NewManager constructs a new Manager.
@@ -111,25 +121,179 @@ func (s *Manager) StartIntegratedServers(lndClient lnrpc.LightningClient,
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	s.lndClient = lndClient
+	s.lndGrpc = lndGrpc
+	s.withMacaroonService = withMacaroonService
+
 	for _, ss := range s.servers {
 		if ss.Remote() {
 			continue
 		}
 
-		err := ss.startIntegrated(
-			lndClient, lndGrpc, withMacaroonService,
-			func(err error) {
-				s.statusServer.SetErrored(
-					ss.Name(), err.Error(),
-				)
-			},
-		)
-		if err != nil {
+		// A sub-server that fails to start is reported as errored by
+		// the status server, but LiT keeps running without it.
+		_ = s.startIntegratedUnsafe(ss)
+	}
+}
+
+// startIntegratedUnsafe starts the given sub-server in integrated mode and
+// updates its status accordingly.
+//
+// NOTE: The mutex must be held when calling this method.
+func (s *Manager) startIntegratedUnsafe(ss *subServerWrapper) error {
+	err := ss.startIntegrated(
+		s.lndClient, s.lndGrpc, s.withMacaroonService,
+		func(err error) {
 			s.statusServer.SetErrored(ss.Name(), err.Error())
+		},
+	)
+	if err != nil {
+		s.statusServer.SetErrored(ss.Name(), err.Error())
+		return err
+	}
+
+	s.statusServer.SetRunning(ss.Name())
+
+	return nil
+}
+
+// integratedServerUnsafe returns the enabled sub-server with the given name if
+// it runs in integrated mode.
+//
+// NOTE: The mutex must be held when calling this method.
+func (s *Manager) integratedServerUnsafe(name string) (*subServerWrapper,
+	error) {
+
+	ss, ok := s.servers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSubServer, name)
+	}
+
+	if ss.Remote() {
+		return nil, fmt.Errorf("%s is running in remote mode and "+
+			"can't be started or stopped by LiT", name)
+	}
+
+	if s.lndGrpc == nil {
+		return nil, errors.New("the integrated sub-servers haven't " +
+			"been started yet")
+	}
+
+	return ss, nil
+}
+
+// StartSubServer starts the integrated sub-server with the given name again
+// after it was stopped with StopSubServer or failed with an error. The error
+// of a failed start is returned and also reported by the status server.
+func (s *Manager) StartSubServer(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss, err := s.integratedServerUnsafe(name)
+	if err != nil {
+		return err
+	}
+
+	if ss.started() {
+		return fmt.Errorf("%s is already running", name)
+	}
+
+	if err := ss.Restartable(); err != nil {
+		return err
+	}
+
+	log.Infof("Starting %s sub-server", name)
+
+	s.statusServer.SetStarting(name)
+	if err := s.startIntegratedUnsafe(ss); err != nil {
+		return fmt.Errorf("unable to start %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// StopSubServer gracefully stops the integrated sub-server with the given
+// name. New calls to the sub-server are rejected right away and calls that are
+// still in-flight are aborted with an error, before the sub-server itself is
+// stopped.
+func (s *Manager) StopSubServer(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ss, err := s.integratedServerUnsafe(name)
+	if err != nil {
+		return err
+	}
+
+	if !ss.started() {
+		return fmt.Errorf("%s is not running", name)
+	}
+
+	if err := ss.Restartable(); err != nil {
+		return err
+	}
+
+	log.Infof("Stopping %s sub-server", name)
+
+	// The status server rejects any new call to the sub-server as soon as
+	// it is marked as stopped.
+	s.statusServer.SetStopped(name)
+
+	err = ss.stop()
+	ss.setStarted(false)
+	if err != nil {
+		return fmt.Errorf("error stopping %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// TrackCall returns a context for a call to the given URI that is cancelled
+// once the integrated sub-server that owns the URI is stopped. The returned
+// function must be called with the result of the call once it completed. It
+// replaces the error of a call that was aborted because the sub-server was
+// stopped with an error that says so. Calls to any other URI keep their
+// context.
+func (s *Manager) TrackCall(ctx context.Context,
+	uri string) (context.Context, func(error) error) {
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, ss := range s.servers {
+		if !s.permsMgr.IsSubServerURI(ss.Name(), uri) {
 			continue
 		}
 
-		s.statusServer.SetRunning(ss.Name())
+		if ss.Remote() || ss.callsCtx == nil {
+			break
+		}
+
+		name := ss.Name()
+		callCtx, cancel := context.WithCancelCause(ctx)
+		stopTracking := context.AfterFunc(ss.callsCtx, func() {
+			cancel(context.Cause(ss.callsCtx))
+		})
+
+		return callCtx, func(err error) error {
+			stopTracking()
+			defer cancel(nil)
+
+			aborted := errors.Is(
+				context.Cause(callCtx), ErrSubServerStopped,
+			)
+			if err != nil && aborted {
+				return grpcstatus.Errorf(codes.Unavailable,
+					"%s was stopped while the call was in "+
+						"progress", name)
+			}
+
+			return err
+		}
+	}
+
+	return ctx, func(err error) error {
+		return err
 	}
 }
 
@@ -228,6 +392,28 @@ func (s *Manager) GetRemoteConn(uri string) (bool, *grpc.ClientConn, error) {
 	return false, nil, nil
 }
 
+// RemoteTimeout returns the default deadline of calls to the given uri if it is
+// owned by one of the manager's sub-servers running in remote mode. It is zero
+// if no default deadline is configured.
+func (s *Manager) RemoteTimeout(uri string) time.Duration {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for _, ss := range s.servers {
+		if !s.permsMgr.IsSubServerURI(ss.Name(), uri) {
+			continue
+		}
+
+		if !ss.Remote() {
+			return 0
+		}
+
+		return ss.RemoteConfig().Timeout
+	}
+
+	return 0
+}
+
 // ValidateMacaroon checks if any of the manager's sub-servers owns the given
 // uri and if so, if it is running in remote mode, then true is returned since
 // the macaroon will be validated by the remote subserver itself when the
@@ -74,6 +74,22 @@ func (p *poolSubServer) Start(lnClient lnrpc.LightningClient,
 	return p.StartAsSubserver(lnClient, lndGrpc, withMacaroonService)
 }
 
+// Restartable returns an error if the sub-server can't be stopped and started
+// again in integrated mode while LiT keeps running.
+//
+// NOTE: this is part of the SubServer interface.
+func (p *poolSubServer) Restartable() error {
+	return nil
+}
+
+// ResetIntegrated replaces the sub-server's integrated daemon with a fresh
+// instance, since a daemon can only be started once.
+//
+// NOTE: this is part of the SubServer interface.
+func (p *poolSubServer) ResetIntegrated() {
+	p.Server = pool.NewServer(p.cfg)
+}
+
 // RegisterGrpcService must register the sub-server's GRPC server with the given
 // registrar.
 //
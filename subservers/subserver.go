@@ -1,6 +1,8 @@
 package subservers
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"sync"
 
@@ -20,6 +22,14 @@ const (
 	ACCOUNTS string = "accounts"
 )
 
+// ErrUnknownSubServer is returned if a sub-server that isn't enabled is to be
+// started or stopped.
+var ErrUnknownSubServer = errors.New("unknown or disabled sub-server")
+
+// ErrSubServerStopped is the cause with which the contexts of in-flight calls
+// to an integrated sub-server are cancelled when it is stopped at runtime.
+var ErrSubServerStopped = errors.New("sub-server was stopped")
+
 // subServerWrapper is a wrapper around the SubServer interface and is used by
 // the subServerMgr to manage a SubServer.
 type subServerWrapper struct {
@@ -28,10 +38,20 @@ type subServerWrapper struct {
 	integratedStarted bool
 	startedMu         sync.RWMutex
 
+	// startAttempted is true once the sub-server was started in
+	// integrated mode, so that any later start needs a fresh instance of
+	// it.
+	startAttempted bool
+
 	stopped sync.Once
 
 	remoteConn *grpc.ClientConn
 
+	// callsCtx is cancelled once the integrated sub-server is stopped, so
+	// that calls to it that are still in-flight are aborted.
+	callsCtx    context.Context
+	cancelCalls context.CancelCauseFunc
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -64,6 +84,10 @@ func (s *subServerWrapper) stop() error {
 
 	var returnErr error
 	s.stopped.Do(func() {
+		if s.cancelCalls != nil {
+			s.cancelCalls(ErrSubServerStopped)
+		}
+
 		close(s.quit)
 		s.wg.Wait()
 
@@ -98,11 +122,23 @@ func (s *subServerWrapper) stop() error {
 	return returnErr
 }
 
-// startIntegrated starts the subServer in integrated mode.
+// startIntegrated starts the subServer in integrated mode. If it was started
+// before, a fresh instance of the sub-server is started instead.
 func (s *subServerWrapper) startIntegrated(lndClient lnrpc.LightningClient,
 	lndGrpc *lndclient.GrpcLndServices, withMacaroonService bool,
 	onError func(error)) error {
 
+	if s.startAttempted {
+		s.ResetIntegrated()
+	}
+	s.startAttempted = true
+
+	s.stopped = sync.Once{}
+	s.quit = make(chan struct{})
+	s.callsCtx, s.cancelCalls = context.WithCancelCause(
+		context.Background(),
+	)
+
 	err := s.Start(lndClient, lndGrpc, withMacaroonService)
 	if err != nil {
 		return err
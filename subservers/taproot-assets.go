@@ -2,6 +2,7 @@ package subservers
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -30,8 +31,9 @@ type taprootAssetsSubServer struct {
 	remote    bool
 	lndRemote bool
 
-	cfg       *tapcfg.Config
-	remoteCfg *RemoteDaemonConfig
+	cfg         *tapcfg.Config
+	remoteCfg   *RemoteDaemonConfig
+	chainParams *address.ChainParams
 
 	errChan chan error
 }
@@ -58,12 +60,13 @@ func NewTaprootAssetsSubServer(network string, cfg *tapcfg.Config,
 	chainCfg := address.ParamsForChain(network)
 
 	return &taprootAssetsSubServer{
-		Server:    tap.NewServer(&chainCfg, nil),
-		cfg:       cfg,
-		remoteCfg: remoteCfg,
-		remote:    remote,
-		lndRemote: lndRemote,
-		errChan:   make(chan error, 1),
+		Server:      tap.NewServer(&chainCfg, nil),
+		cfg:         cfg,
+		remoteCfg:   remoteCfg,
+		chainParams: &chainCfg,
+		remote:      remote,
+		lndRemote:   lndRemote,
+		errChan:     make(chan error, 1),
 	}
 }
 
@@ -120,6 +123,30 @@ func (t *taprootAssetsSubServer) Start(_ lnrpc.LightningClient,
 	return t.StartAsSubserver(lndGrpc)
 }
 
+// Restartable returns an error if the sub-server can't be stopped and started
+// again in integrated mode while LiT keeps running. If lnd is integrated as
+// well, tapd provides lnd's channel components, which lnd can't replace.
+//
+// NOTE: this is part of the SubServer interface.
+func (t *taprootAssetsSubServer) Restartable() error {
+	if !t.lndRemote {
+		return fmt.Errorf("%s provides the channel components of the "+
+			"integrated lnd and can only be restarted together "+
+			"with it", TAP)
+	}
+
+	return nil
+}
+
+// ResetIntegrated replaces the sub-server's integrated daemon with a fresh
+// instance, since a daemon can only be started once.
+//
+// NOTE: this is part of the SubServer interface.
+func (t *taprootAssetsSubServer) ResetIntegrated() {
+	t.Server = tap.NewServer(t.chainParams, nil)
+	t.errChan = make(chan error, 1)
+}
+
 // RegisterGrpcService must register the sub-server's GRPC server with the given
 // registrar.
 //
@@ -19,10 +19,12 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
 	restProxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jessevdk/go-flags"
 	"github.com/lightninglabs/lightning-terminal/accounts"
 	"github.com/lightninglabs/lightning-terminal/autopilotserver"
+	"github.com/lightninglabs/lightning-terminal/events"
 	"github.com/lightninglabs/lightning-terminal/firewall"
 	"github.com/lightninglabs/lightning-terminal/firewalldb"
 	"github.com/lightninglabs/lightning-terminal/litrpc"
@@ -58,6 +60,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/msgmux"
 	"github.com/lightningnetwork/lnd/rpcperms"
@@ -65,7 +68,9 @@ import (
 	"github.com/lightningnetwork/lnd/sweep"
 	grpcProxy "github.com/mwitkow/grpc-proxy/proxy"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
+	grpcstatus "google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/encoding/protojson"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -87,6 +92,16 @@ const (
 	defaultRPCTimeout     = 3 * time.Minute
 	minimumRPCTimeout     = 30 * time.Second
 	defaultStartupTimeout = 5 * time.Second
+
+	minimumStatusStaleThreshold = 10 * time.Second
+
+	// restMacaroonHeader is the header the REST proxy reads the hex
+	// encoded macaroon of a request from.
+	restMacaroonHeader = "Grpc-Metadata-Macaroon"
+
+	// restMacaroonQueryParam is the query parameter a REST request can
+	// pass its hex encoded macaroon in if that is enabled.
+	restMacaroonQueryParam = "macaroon"
 )
 
 // restRegistration is a function type that represents a REST proxy
@@ -199,12 +214,17 @@ type LightningTerminal struct {
 	subServerMgr *subservers.Manager
 	statusMgr    *status.Manager
 
+	// events multiplexes the events of the accounts, sessions,
+	// sub-servers and firewall into the stream of SubscribeEvents.
+	events *events.Broker
+
 	autopilotClient autopilotserver.Autopilot
 
 	ruleMgrs rules.ManagerSet
 
-	rpcProxy   *rpcProxy
-	httpServer *http.Server
+	rpcProxy      *rpcProxy
+	httpServer    *http.Server
+	metricsServer *http.Server
 
 	sessionRpcServer        *sessionRpcServer
 	sessionRpcServerStarted bool
@@ -213,11 +233,24 @@ type LightningTerminal struct {
 	macaroonServiceStarted bool
 	macaroonDB             kvdb.Backend
 
+	rootKeyRotator        *litmac.RootKeyRotator
+	rootKeyRotatorStarted atomic.Bool
+
 	middleware        *mid.Manager
 	middlewareStarted bool
 
+	// ruleEnforcer is set once the firewall's rule enforcer has been
+	// created. It stays nil if the autopilot is disabled.
+	ruleEnforcer atomic.Pointer[firewall.RuleEnforcer]
+
+	// auditLogger writes the decisions of the rule enforcer to the audit
+	// log. It stays nil if the autopilot is disabled.
+	auditLogger *firewall.AuditLogger
+
 	accountService        *accounts.InterceptorService
 	accountServiceStarted bool
+	spendNotifier         *accounts.WebhookSpendNotifier
+	dailySummaryNotifier  *accounts.DailySummaryNotifier
 
 	accountRpcServer *accounts.RPCServer
 
@@ -231,6 +264,7 @@ type LightningTerminal struct {
 func New() *LightningTerminal {
 	return &LightningTerminal{
 		statusMgr: status.NewStatusManager(),
+		events:    events.NewBroker(),
 	}
 }
 
@@ -296,6 +330,7 @@ func (g *LightningTerminal) Run(ctx context.Context) error {
 	}
 	g.cfg = cfg
 	g.defaultImplCfg = g.cfg.Lnd.ImplementationConfig(shutdownInterceptor)
+	g.statusMgr.SetStaleThreshold(g.cfg.StatusStaleThreshold)
 
 	// Show version at startup.
 	log.Infof("LiT version: %s", Version())
@@ -363,6 +398,10 @@ func (g *LightningTerminal) Run(ctx context.Context) error {
 	// lnd once it's fully started.
 	g.subServerMgr = subservers.NewManager(g.permsMgr, g.statusMgr)
 
+	// The sub-servers can be started and stopped at runtime through the
+	// status server.
+	g.statusMgr.SetSubServerController(g.subServerMgr)
+
 	// Register our sub-servers. This must be done before the REST proxy is
 	// set up so that the correct REST handlers are registered.
 	err = g.initSubServers()
@@ -374,7 +413,8 @@ func (g *LightningTerminal) Run(ctx context.Context) error {
 	// server is started.
 	g.rpcProxy = newRpcProxy(
 		g.cfg, g, g.validateSuperMacaroon, g.permsMgr, g.subServerMgr,
-		g.statusMgr, g.basicLNDClient,
+		g.statusMgr, g.basicLNDClient, g.getRootKeyRotator, g.whoAmI,
+		g.checkPermission, g.events,
 	)
 
 	// Register any gRPC services that should be served using LiT's
@@ -382,10 +422,26 @@ func (g *LightningTerminal) Run(ctx context.Context) error {
 	litrpc.RegisterProxyServer(g.rpcProxy.grpcServer, g.rpcProxy)
 	litrpc.RegisterStatusServer(g.rpcProxy.grpcServer, g.statusMgr)
 
+	// Publish the status changes of the sub-servers to the event stream.
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		g.publishSubServerEvents(ctx)
+	}()
+
+	// The metrics are served before lnd is started, so that the status of
+	// the sub-servers can be observed while they are starting.
+	g.registerMetrics()
+	if err := g.startMetricsServer(); err != nil {
+		return fmt.Errorf("error starting Prometheus metrics server: "+
+			"%v", err)
+	}
+
 	// Start the main web server that dispatches requests either to the
 	// static UI file server or the RPC proxy. This makes it possible to
 	// unlock lnd through the UI.
-	if err := g.startMainWebServer(); err != nil {
+	if err := g.startMainWebServer(ctx); err != nil {
 		return fmt.Errorf("error starting main proxy HTTP server: %v",
 			err)
 	}
@@ -452,13 +508,148 @@ func (g *LightningTerminal) start(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("could not create stores: %v", err)
 	}
+	g.registerStoreMetrics()
 
 	if err := g.stores.firewall.Start(ctx); err != nil {
 		return fmt.Errorf("could not start firewall DB: %v", err)
 	}
 
+	var accountServiceOpts []accounts.ServiceOption
+	if g.cfg.Accounts.LowBalanceWatermark > 0 {
+		hook := g.cfg.Accounts.LowBalanceHook
+		if hook == nil {
+			hook = accounts.LogLowBalanceHook
+		}
+
+		watermark := lnwire.NewMSatFromSatoshis(btcutil.Amount(
+			g.cfg.Accounts.LowBalanceWatermark,
+		))
+		accountServiceOpts = append(
+			accountServiceOpts,
+			accounts.WithLowBalanceHook(watermark, hook),
+		)
+	}
+
+	if g.cfg.Accounts.SpendWebhook != "" {
+		g.spendNotifier = accounts.NewWebhookSpendNotifier(
+			g.cfg.Accounts.SpendWebhook,
+			g.cfg.Accounts.SpendWebhookBatchInterval,
+		)
+		accountServiceOpts = append(
+			accountServiceOpts,
+			accounts.WithSpendNotifier(g.spendNotifier),
+		)
+	}
+
+	if g.cfg.Accounts.DailySummaryWebhook != "" {
+		timeOfDay, err := accounts.ParseDailySummaryTime(
+			g.cfg.Accounts.DailySummaryTime,
+		)
+		if err != nil {
+			return err
+		}
+
+		// The account service is only created below, but the accounts
+		// are only listed once the first summary is sent.
+		listAccounts := func(ctx context.Context) (
+			[]*accounts.OffChainBalanceAccount, error) {
+
+			return g.accountService.Accounts(ctx)
+		}
+		g.dailySummaryNotifier = accounts.NewDailySummaryNotifier(
+			g.cfg.Accounts.DailySummaryWebhook, timeOfDay,
+			g.cfg.Accounts.DailySummaryAggregate, listAccounts,
+		)
+		g.dailySummaryNotifier.Start()
+		accountServiceOpts = append(
+			accountServiceOpts,
+			accounts.WithSpendNotifier(g.dailySummaryNotifier),
+		)
+	}
+
+	if g.cfg.Accounts.AutoLabel {
+		accountServiceOpts = append(
+			accountServiceOpts,
+			accounts.WithLabelGenerator(accounts.ReadableLabel),
+		)
+	}
+
+	if g.cfg.Accounts.SatRounding != "" {
+		rounding := accounts.RoundingMode(g.cfg.Accounts.SatRounding)
+		accountServiceOpts = append(
+			accountServiceOpts, accounts.WithRoundingMode(rounding),
+		)
+	}
+
+	if g.cfg.Accounts.TopUpSource != "" {
+		source, err := accounts.ParseAccountID(
+			g.cfg.Accounts.TopUpSource,
+		)
+		if err != nil {
+			return fmt.Errorf("invalid top-up source account: %w",
+				err)
+		}
+		accountServiceOpts = append(
+			accountServiceOpts, accounts.WithTopUpSource(*source),
+		)
+	}
+
+	if g.cfg.Accounts.DepositInvoices {
+		tag := accounts.DepositTag{
+			MemoPrefix: g.cfg.Accounts.DepositMemoPrefix,
+			RecordType: g.cfg.Accounts.DepositRecordType,
+		}
+		if err := tag.Validate(); err != nil {
+			return fmt.Errorf("invalid deposit invoice tag: %w", err)
+		}
+		accountServiceOpts = append(
+			accountServiceOpts, accounts.WithDepositTag(tag),
+		)
+	}
+
+	expirationPolicy := accounts.ExpirationPolicy{
+		Default: g.cfg.Accounts.DefaultExpiration,
+		Max:     g.cfg.Accounts.MaxExpiration,
+	}
+	if err := expirationPolicy.Validate(); err != nil {
+		return fmt.Errorf("invalid account expiration policy: %w", err)
+	}
+	accountServiceOpts = append(
+		accountServiceOpts,
+		accounts.WithExpirationPolicy(expirationPolicy),
+	)
+
+	if g.cfg.Accounts.UnknownAccountPolicy != "" {
+		accountServiceOpts = append(
+			accountServiceOpts, accounts.WithUnknownAccountPolicy(
+				accounts.UnknownAccountPolicy(
+					g.cfg.Accounts.UnknownAccountPolicy,
+				),
+			),
+		)
+	}
+
+	// Accounts with a caller-given ID must not share the macaroon root key
+	// of a session, since the root keys of both are derived from the first
+	// 4 bytes of their IDs.
+	accountServiceOpts = append(
+		accountServiceOpts, accounts.WithRootKeyIDInUse(
+			func(ctx context.Context, suffix [4]byte) (bool, error) {
+				_, err := g.stores.sessions.GetSession(
+					ctx, session.ID(suffix),
+				)
+				if errors.Is(err, session.ErrSessionNotFound) {
+					return false, nil
+				}
+
+				return err == nil, err
+			},
+		),
+	)
+
 	g.accountService, err = accounts.NewService(
 		g.stores.accounts, accountServiceErrCallback,
+		accountServiceOpts...,
 	)
 	if err != nil {
 		return fmt.Errorf("error creating account service: %v", err)
@@ -472,8 +663,14 @@ func (g *LightningTerminal) start(ctx context.Context) error {
 		)
 	}
 
+	superMacDeleter := func(ctx context.Context, rootKeyID uint64) error {
+		return litmac.DeleteSuperMacaroonRootKey(
+			ctx, g.basicClient, rootKeyID,
+		)
+	}
+
 	g.accountRpcServer = accounts.NewRPCServer(
-		g.accountService, superMacBaker,
+		g.accountService, superMacBaker, superMacDeleter,
 	)
 
 	g.ruleMgrs = rules.NewRuleManagerSet()
@@ -508,7 +705,10 @@ func (g *LightningTerminal) start(ctx context.Context) error {
 	}
 
 	g.sessionRpcServer, err = newSessionRPCServer(&sessionRpcServerConfig{
-		db:        g.stores.sessions,
+		db: &sessionEventStore{
+			Store:   g.stores.sessions,
+			publish: g.events.Publish,
+		},
 		basicAuth: g.rpcProxy.basicAuth,
 		grpcOptions: []grpc.ServerOption{
 			grpc.CustomCodec(grpcProxy.Codec()), // nolint: staticcheck,
@@ -530,11 +730,15 @@ func (g *LightningTerminal) start(ctx context.Context) error {
 		},
 		superMacBaker:           superMacBaker,
 		firstConnectionDeadline: g.cfg.FirstLNCConnDeadline,
+		reconnectCfg:            g.cfg.LNCReconnect,
 		permMgr:                 g.permsMgr,
 		actionsDB:               g.stores.firewallBolt,
+		rulesDB:                 g.stores.firewall,
 		autopilot:               g.autopilotClient,
 		ruleMgrs:                g.ruleMgrs,
 		privMap:                 g.stores.firewallBolt.PrivacyDB,
+		ruleEnforcer:            g.ruleEnforcer.Load,
+		allowPrivacyExport:      g.cfg.Firewall.AllowPrivacyExport,
 	})
 	if err != nil {
 		return fmt.Errorf("could not create new session rpc "+
@@ -774,6 +978,17 @@ func (g *LightningTerminal) start(ctx context.Context) error {
 	// lnd clients.
 	g.statusMgr.SetRunning(subservers.LND)
 
+	// If a stale threshold is configured, we regularly confirm that lnd
+	// is still responsive, so a hanging lnd isn't reported as running.
+	if g.cfg.StatusStaleThreshold > 0 {
+		g.wg.Add(1)
+		go func() {
+			defer g.wg.Done()
+
+			g.monitorLndStatus(ctx)
+		}()
+	}
+
 	// Both connection types are ready now, let's start our sub-servers if
 	// they should be started locally as an integrated service.
 	createDefaultMacaroons := !g.cfg.statelessInitMode
@@ -811,6 +1026,35 @@ func (g *LightningTerminal) start(ctx context.Context) error {
 	return nil
 }
 
+// monitorLndStatus regularly checks that lnd responds to RPC calls and confirms
+// its status with the status manager if it does. Checks happen at half the
+// stale threshold, so a single slow check doesn't make the status go stale.
+func (g *LightningTerminal) monitorLndStatus(ctx context.Context) {
+	interval := g.cfg.StatusStaleThreshold / 2
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		_, err := g.basicClient.GetInfo(
+			checkCtx, &lnrpc.GetInfoRequest{},
+		)
+		cancel()
+
+		if err != nil {
+			log.Debugf("lnd status check failed: %v", err)
+		} else {
+			g.statusMgr.Heartbeat(subservers.LND)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 // basicLNDClient provides access to LiT's basicClient if it has been set.
 func (g *LightningTerminal) basicLNDClient() (lnrpc.LightningClient, error) {
 	if !g.basicClientSet.Load() {
@@ -820,6 +1064,19 @@ func (g *LightningTerminal) basicLNDClient() (lnrpc.LightningClient, error) {
 	return g.basicClient, nil
 }
 
+// getRootKeyRotator returns the rotator of the root key of LiT's own macaroons
+// if LiT's macaroon service has been started.
+func (g *LightningTerminal) getRootKeyRotator() (*litmac.RootKeyRotator,
+	error) {
+
+	if !g.rootKeyRotatorStarted.Load() {
+		return nil, fmt.Errorf("the macaroon service has not started " +
+			"yet")
+	}
+
+	return g.rootKeyRotator, nil
+}
+
 // setUpLNDClients sets up the various LND clients required by LiT.
 func (g *LightningTerminal) setUpLNDClients(ctx context.Context,
 	lndQuit chan struct{}) error {
@@ -1026,6 +1283,28 @@ func (g *LightningTerminal) startInternalSubServers(ctx context.Context,
 	}
 	g.macaroonServiceStarted = true
 
+	// The default macaroon is only written to disk if we're not in
+	// stateless init mode, which also applies to any macaroon that is
+	// baked under a new root key.
+	var macaroonPath string
+	if createDefaultMacaroons {
+		macaroonPath = g.cfg.MacaroonPath
+	}
+	g.rootKeyRotator = litmac.NewRootKeyRotator(
+		&litmac.RootKeyRotatorConfig{
+			Service:       g.macaroonService,
+			RequiredPerms: perms.RequiredPermissions,
+			MacaroonPath:  macaroonPath,
+			GracePeriod:   g.cfg.MacaroonRootKeyGracePeriod,
+			Clock:         clock.NewDefaultClock(),
+		},
+	)
+	if err := g.rootKeyRotator.Start(ctx); err != nil {
+		return fmt.Errorf("could not start macaroon root key "+
+			"rotator: %v", err)
+	}
+	g.rootKeyRotatorStarted.Store(true)
+
 	if !g.cfg.Autopilot.Disable {
 		withLndVersion := func(cfg *autopilotserver.Config) {
 			cfg.LndVersion = autopilotserver.Version{
@@ -1087,6 +1366,13 @@ func (g *LightningTerminal) startInternalSubServers(ctx context.Context,
 			g.statusMgr.SetRunning(subservers.ACCOUNTS)
 
 			g.accountServiceStarted = true
+
+			g.wg.Add(1)
+			go func() {
+				defer g.wg.Done()
+
+				g.publishAccountEvents(ctx)
+			}()
 		}
 	} else {
 		closeAccountService()
@@ -1111,6 +1397,12 @@ func (g *LightningTerminal) startInternalSubServers(ctx context.Context,
 	}
 
 	if !g.cfg.Autopilot.Disable {
+		g.auditLogger = firewall.NewAuditLogger(
+			g.stores.firewallBolt,
+			firewall.DefaultAuditLogBufferSize,
+		)
+		g.auditLogger.Start()
+
 		ruleEnforcer := firewall.NewRuleEnforcer(
 			g.stores.firewall, g.stores.firewallBolt,
 			g.stores.sessions,
@@ -1123,9 +1415,12 @@ func (g *LightningTerminal) startInternalSubServers(ctx context.Context,
 					reqID, firewalldb.ActionStateError,
 					reason,
 				)
-			}, g.stores.firewallBolt.PrivacyDB,
+			}, g.stores.firewallBolt.PrivacyDB, g.auditLogger,
+			g.publishFirewallDenial,
 		)
 
+		g.ruleEnforcer.Store(ruleEnforcer)
+
 		mw = append(mw, ruleEnforcer)
 	}
 
@@ -1133,7 +1428,8 @@ func (g *LightningTerminal) startInternalSubServers(ctx context.Context,
 	log.Infof("Starting LiT middleware manager")
 	g.middleware = mid.NewManager(
 		g.cfg.RPCMiddleware.InterceptTimeout,
-		g.lndClient.Client, g.errQueue.ChanIn(), mw...,
+		g.cfg.RPCMiddleware.HashLogIDs, g.lndClient.Client,
+		g.errQueue.ChanIn(), mw...,
 	)
 
 	if err = g.middleware.Start(ctx); err != nil {
@@ -1268,13 +1564,27 @@ func (g *LightningTerminal) ValidateMacaroon(ctx context.Context,
 	requiredPermissions []bakery.Op, fullMethod string) error {
 
 	// If the URL being queried has been whitelisted, then no macaroon
-	// validation is required for the query.
+	// validation is required for the query. Unless we're configured to
+	// require macaroons for everything, in which case only lnd's own
+	// whitelisted methods remain exempt, as they must be reachable before
+	// lnd's macaroon database is unlocked. Calls to remote sub-servers are
+	// forwarded by the proxy and never land here, so their whitelisted
+	// methods are left to the remote daemon.
 	if g.permsMgr.IsWhiteListedURL(fullMethod) {
-		return nil
+		if !g.cfg.RequireMacaroons ||
+			g.permsMgr.IsSubServerURI(subservers.LND, fullMethod) {
+
+			return nil
+		}
 	}
 
 	macHex, err := macaroons.RawMacaroonFromContext(ctx)
 	if err != nil {
+		if g.cfg.RequireMacaroons {
+			return grpcstatus.Errorf(codes.Unauthenticated, "%v",
+				err)
+		}
+
 		return err
 	}
 
@@ -1437,6 +1747,10 @@ func (g *LightningTerminal) shutdownSubServers() error {
 		}
 	}
 
+	if g.rootKeyRotatorStarted.Load() {
+		g.rootKeyRotator.Stop()
+	}
+
 	if g.macaroonServiceStarted {
 		if err := g.macaroonService.Stop(); err != nil {
 			log.Errorf("Error stopping macaroon service: %v", err)
@@ -1455,10 +1769,25 @@ func (g *LightningTerminal) shutdownSubServers() error {
 		}
 	}
 
+	// The spend notifiers are stopped after the account service so that the
+	// events of the last debits are still sent.
+	if g.spendNotifier != nil {
+		g.spendNotifier.Stop()
+	}
+	if g.dailySummaryNotifier != nil {
+		g.dailySummaryNotifier.Stop()
+	}
+
 	if g.middlewareStarted {
 		g.middleware.Stop()
 	}
 
+	// The audit logger is stopped after the middleware so that the
+	// decisions for the last requests are still written.
+	if g.auditLogger != nil {
+		g.auditLogger.Stop()
+	}
+
 	if g.ruleMgrs != nil {
 		if err := g.ruleMgrs.Stop(); err != nil {
 			log.Errorf("Error stopping rule manager set: %v", err)
@@ -1512,6 +1841,14 @@ func (g *LightningTerminal) shutdownSubServers() error {
 		}
 	}
 
+	if g.metricsServer != nil {
+		if err := g.metricsServer.Close(); err != nil {
+			log.Errorf("Error stopping Prometheus metrics server: "+
+				"%v", err)
+			returnErr = err
+		}
+	}
+
 	// Do we have any last errors to display? We use an anonymous function,
 	// so we can use return instead of breaking to a label in the default
 	// case.
@@ -1573,7 +1910,7 @@ func (g *LightningTerminal) shutdownSubServers() error {
 //	                                |  - loop             |
 //	                                |  - pool             |
 //	                                +---------------------+
-func (g *LightningTerminal) startMainWebServer() error {
+func (g *LightningTerminal) startMainWebServer(ctx context.Context) error {
 	// Initialize the in-memory file server from the content compiled by
 	// the go:embed directive. Since everything's relative to the root dir,
 	// we need to create an FS of the sub directory app/build.
@@ -1588,7 +1925,21 @@ func (g *LightningTerminal) startMainWebServer() error {
 	// Both gRPC (web) and static file requests will come into through the
 	// main UI HTTP server. We use this simple switching handler to send the
 	// requests to the correct implementation.
+	healthHandler := g.statusMgr.HealthHandler(
+		g.cfg.ReadyRequiredSubServers,
+	)
 	httpHandler := func(resp http.ResponseWriter, req *http.Request) {
+		// The health and readiness endpoints are meant for probes of
+		// orchestrators like Kubernetes, so they don't require any
+		// authentication and are served even if the UI is disabled.
+		if req.URL.Path == status.HealthPath ||
+			req.URL.Path == status.ReadyPath {
+
+			healthHandler.ServeHTTP(resp, req)
+
+			return
+		}
+
 		// If this is some kind of gRPC, gRPC Web or REST call that
 		// should go to lnd or one of the daemons, pass it to the proxy
 		// that handles all those calls.
@@ -1658,12 +2009,20 @@ func (g *LightningTerminal) startMainWebServer() error {
 		return fmt.Errorf("unable to listen on %v: %v",
 			g.cfg.HTTPSListen, err)
 	}
-	tlsConfig, err := buildTLSConfigForHttp2(g.cfg)
+	tlsConfig, reloader, err := buildTLSConfigForHttp2(g.cfg)
 	if err != nil {
 		return fmt.Errorf("unable to create TLS config: %v", err)
 	}
 	tlsListener := tls.NewListener(httpListener, tlsConfig)
 
+	// A certificate obtained through Let's Encrypt is renewed
+	// automatically, any other certificate can be reloaded from disk by
+	// sending a SIGHUP signal.
+	if reloader != nil {
+		g.wg.Add(1)
+		go g.reloadCertOnSignal(reloader, ctx.Done())
+	}
+
 	g.wg.Add(1)
 	go func() {
 		defer g.wg.Done()
@@ -1757,7 +2116,13 @@ func (g *LightningTerminal) createRESTProxy(ctx context.Context) error {
 		restMux, log, g.cfg.Lnd.WSPingInterval, g.cfg.Lnd.WSPongWait,
 		lnrpc.LndClientStreamingURIs,
 	)
-	g.restHandler = allowCORS(restHandler, g.cfg.RestCORS)
+	restHandler = restMacaroonHandler(
+		restHandler, g.cfg.RestMacaroonHeader,
+		g.cfg.RestMacaroonQueryParam,
+	)
+	g.restHandler = allowCORS(
+		restHandler, g.cfg.RestCORS, g.cfg.RestMacaroonHeader,
+	)
 
 	// First register all lnd handlers. This will make it possible to speak
 	// REST over the main RPC listener port in both remote and integrated
@@ -1820,6 +2185,37 @@ func (g *LightningTerminal) validateSuperMacaroon(ctx context.Context,
 		return fmt.Errorf("macaroon is not valid")
 	}
 
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(superMacaroon); err != nil {
+		return err
+	}
+
+	rootKeyID, err := litmac.RootKeyIDFromMacaroon(mac)
+	if err != nil {
+		return err
+	}
+
+	return g.checkMacaroonSession(ctx, rootKeyID)
+}
+
+// checkMacaroonSession makes sure that the session the super macaroon with the
+// given root key ID was baked for, if any, is still active. Session macaroons
+// don't carry the expiry of their session as a caveat, since the expiry can be
+// changed while the client is still connected with the macaroon it received on
+// its handshake. So the stored expiry is checked here instead.
+func (g *LightningTerminal) checkMacaroonSession(ctx context.Context,
+	rootKeyID uint64) error {
+
+	sess, err := g.macaroonSession(ctx, rootKeyID)
+	if err != nil || sess == nil {
+		return err
+	}
+
+	if sess.State.Terminal() || !sess.Expiry.After(time.Now()) {
+		return fmt.Errorf("session %x: %w", sess.ID[:],
+			session.ErrSessionNotActive)
+	}
+
 	return nil
 }
 
@@ -1870,9 +2266,65 @@ func (g *LightningTerminal) initSubServers() error {
 	return nil
 }
 
+// restMacaroonHandler wraps the given REST handler with a function that moves a
+// macaroon that is passed in the given custom header or, if allowQueryParam is
+// true, in the macaroon query parameter into the header that the REST proxy
+// expects. A macaroon in the standard header takes precedence. The query
+// parameter is always removed from the request before it is passed on, so that
+// it is neither logged nor interpreted as a field of the request message.
+func restMacaroonHandler(handler http.Handler, headerName string,
+	allowQueryParam bool) http.Handler {
+
+	if headerName == "" && !allowQueryParam {
+		return handler
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var macHex string
+		if headerName != "" {
+			macHex = r.Header.Get(headerName)
+		}
+
+		// The query parameter is stripped whenever it is present, even
+		// if it is empty or a header takes precedence.
+		query := r.URL.Query()
+		stripQuery := allowQueryParam &&
+			query.Has(restMacaroonQueryParam)
+		if stripQuery {
+			if macHex == "" {
+				macHex = query.Get(restMacaroonQueryParam)
+			}
+
+			query.Del(restMacaroonQueryParam)
+		}
+
+		if macHex == "" && !stripQuery {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		r = r.Clone(r.Context())
+		if stripQuery {
+			r.URL.RawQuery = query.Encode()
+			r.RequestURI = r.URL.RequestURI()
+		}
+		if headerName != "" {
+			r.Header.Del(headerName)
+		}
+		if macHex != "" && r.Header.Get(restMacaroonHeader) == "" {
+			r.Header.Set(restMacaroonHeader, macHex)
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
 // allowCORS wraps the given http.Handler with a function that adds the
-// Access-Control-Allow-Origin header to the response.
-func allowCORS(handler http.Handler, origins []string) http.Handler {
+// Access-Control-Allow-Origin header to the response. The given extra headers
+// are allowed in cross origin requests in addition to the default ones.
+func allowCORS(handler http.Handler, origins []string,
+	extraHeaders ...string) http.Handler {
+
 	allowHeaders := "Access-Control-Allow-Headers"
 	allowMethods := "Access-Control-Allow-Methods"
 	allowOrigin := "Access-Control-Allow-Origin"
@@ -1883,6 +2335,13 @@ func allowCORS(handler http.Handler, origins []string) http.Handler {
 		return handler
 	}
 
+	headers := []string{"Content-Type", "Accept", restMacaroonHeader}
+	for _, header := range extraHeaders {
+		if header != "" {
+			headers = append(headers, header)
+		}
+	}
+
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		origin := r.Header.Get("Origin")
 
@@ -1893,10 +2352,7 @@ func allowCORS(handler http.Handler, origins []string) http.Handler {
 		}
 
 		// Set the static header fields first.
-		w.Header().Set(
-			allowHeaders,
-			"Content-Type, Accept, Grpc-Metadata-Macaroon",
-		)
+		w.Header().Set(allowHeaders, strings.Join(headers, ", "))
 		w.Header().Set(allowMethods, "GET, POST, DELETE")
 
 		// Either we allow all origins or the incoming request matches
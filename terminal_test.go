@@ -0,0 +1,125 @@
+package terminal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lightninglabs/lightning-terminal/perms"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+)
+
+// TestValidateMacaroonRequireMacaroons tests that whitelisted calls need a
+// macaroon if macaroons are required, except for lnd's wallet unlocker and
+// state services.
+func TestValidateMacaroonRequireMacaroons(t *testing.T) {
+	t.Parallel()
+
+	permsMgr, err := perms.NewManager(false)
+	require.NoError(t, err)
+
+	newTerminal := func(requireMacaroons bool) *LightningTerminal {
+		return &LightningTerminal{
+			cfg:      &Config{RequireMacaroons: requireMacaroons},
+			permsMgr: permsMgr,
+		}
+	}
+
+	const statusURI = "/litrpc.Status/SubServerStatus"
+	ctx := context.Background()
+
+	// By default, the whitelisted status call doesn't need a macaroon.
+	err = newTerminal(false).ValidateMacaroon(ctx, nil, statusURI)
+	require.NoError(t, err)
+
+	// If macaroons are required, it is rejected without one.
+	g := newTerminal(true)
+	err = g.ValidateMacaroon(ctx, nil, statusURI)
+	require.Error(t, err)
+	require.Equal(t, codes.Unauthenticated, grpcstatus.Code(err))
+
+	// lnd's wallet unlocker and state services are still exempt, since
+	// they must be reachable before any macaroon can be verified.
+	exempt := []string{
+		"/lnrpc.WalletUnlocker/UnlockWallet",
+		"/lnrpc.WalletUnlocker/GenSeed",
+		"/lnrpc.State/GetState",
+		"/lnrpc.State/SubscribeState",
+	}
+	for _, uri := range exempt {
+		require.NoError(t, g.ValidateMacaroon(ctx, nil, uri), uri)
+	}
+}
+
+// TestRestMacaroonHandler tests that a macaroon in the custom header or the
+// query parameter is moved into the header of the REST proxy and that the query
+// parameter is always stripped.
+func TestRestMacaroonHandler(t *testing.T) {
+	t.Parallel()
+
+	const customHeader = "X-Lit-Macaroon"
+
+	tests := []struct {
+		name          string
+		target        string
+		header        string
+		expectedMac   string
+		expectedQuery string
+	}{{
+		name:          "header present",
+		target:        "/v1/status?macaroon=0b0b&limit=1",
+		header:        "0a0a",
+		expectedMac:   "0a0a",
+		expectedQuery: "limit=1",
+	}, {
+		name:          "query only",
+		target:        "/v1/status?macaroon=0b0b&limit=1",
+		expectedMac:   "0b0b",
+		expectedQuery: "limit=1",
+	}, {
+		name:          "empty query",
+		target:        "/v1/status?macaroon=&limit=1",
+		expectedQuery: "limit=1",
+	}, {
+		name:          "neither",
+		target:        "/v1/status?limit=1",
+		expectedQuery: "limit=1",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var forwarded *http.Request
+			handler := restMacaroonHandler(http.HandlerFunc(
+				func(_ http.ResponseWriter, r *http.Request) {
+					forwarded = r
+				},
+			), customHeader, true)
+
+			req := httptest.NewRequest(
+				http.MethodGet, test.target, nil,
+			)
+			if test.header != "" {
+				req.Header.Set(customHeader, test.header)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.NotNil(t, forwarded)
+			require.Equal(
+				t, test.expectedMac,
+				forwarded.Header.Get(restMacaroonHeader),
+			)
+			require.Empty(t, forwarded.Header.Get(customHeader))
+			require.Equal(t, test.expectedQuery,
+				forwarded.URL.RawQuery)
+			require.NotContains(
+				t, forwarded.RequestURI, restMacaroonQueryParam,
+			)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package terminal
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/lightningnetwork/lnd/cert"
+)
+
+// certReloader serves the TLS certificate of the HTTPS listener and can
+// replace it with the certificate that is currently on disk without
+// restarting the listener. Existing connections keep using the certificate
+// they were established with, only new connections use the new one.
+type certReloader struct {
+	certPath string
+	keyPath  string
+
+	cert atomic.Pointer[tls.Certificate]
+}
+
+// newCertReloader creates a new certReloader and loads the certificate and key
+// from the given paths. Like before certificates could be reloaded, an expired
+// certificate is still served on startup, only a warning is logged.
+func newCertReloader(certPath, keyPath string) (*certReloader, error) {
+	r := &certReloader{
+		certPath: certPath,
+		keyPath:  keyPath,
+	}
+
+	tlsCert, x509Cert, err := r.read()
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Now().After(x509Cert.NotAfter) {
+		log.Warnf("TLS certificate %s expired at %v, replace it and "+
+			"send SIGHUP to reload it", certPath, x509Cert.NotAfter)
+	}
+
+	r.cert.Store(&tlsCert)
+
+	return r, nil
+}
+
+// Reload reads the certificate and key from disk and uses them for all new
+// connections. The current certificate is kept if the new certificate and key
+// can't be parsed, don't belong together or if the certificate has expired.
+func (r *certReloader) Reload() error {
+	tlsCert, x509Cert, err := r.read()
+	if err != nil {
+		return err
+	}
+
+	if time.Now().After(x509Cert.NotAfter) {
+		return fmt.Errorf("TLS certificate %s expired at %v",
+			r.certPath, x509Cert.NotAfter)
+	}
+
+	r.cert.Store(&tlsCert)
+
+	return nil
+}
+
+// read reads the certificate and key from disk.
+func (r *certReloader) read() (tls.Certificate, *x509.Certificate, error) {
+	tlsCert, x509Cert, err := cert.LoadCert(r.certPath, r.keyPath)
+	if err != nil {
+		return tls.Certificate{}, nil, fmt.Errorf("failed reading "+
+			"TLS server keys: %w", err)
+	}
+
+	return tlsCert, x509Cert, nil
+}
+
+// GetCertificate returns the current certificate. It can be used as the
+// GetCertificate callback of a tls.Config.
+func (r *certReloader) GetCertificate(
+	_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+
+	return r.cert.Load(), nil
+}
+
+// reloadCertOnSignal reloads the TLS certificate of the HTTPS listener
+// whenever litd receives a SIGHUP signal, until quit is closed.
+//
+// NOTE: This MUST be run as a goroutine.
+func (g *LightningTerminal) reloadCertOnSignal(reloader *certReloader,
+	quit <-chan struct{}) {
+
+	defer g.wg.Done()
+
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-hangup:
+			log.Infof("Received SIGHUP, reloading TLS certificate "+
+				"from %s", reloader.certPath)
+
+			if err := reloader.Reload(); err != nil {
+				log.Errorf("Unable to reload TLS certificate, "+
+					"keeping the current one: %v", err)
+
+				continue
+			}
+
+			log.Infof("TLS certificate reloaded")
+
+		case <-quit:
+			return
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package terminal
+
+import (
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/cert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestCert writes a new self-signed certificate with the given validity
+// and its key to the given paths.
+func writeTestCert(t *testing.T, certPath, keyPath string,
+	validity time.Duration) {
+
+	certBytes, keyBytes, err := cert.GenCertPair(
+		"lit test", nil, nil, true, validity,
+	)
+	require.NoError(t, err)
+
+	err = cert.WriteCertPair(certPath, keyPath, certBytes, keyBytes)
+	require.NoError(t, err)
+}
+
+// TestCertReloaderExpired tests that an expired certificate is still served on
+// startup, but isn't swapped in when the certificate is reloaded.
+func TestCertReloaderExpired(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	certPath := filepath.Join(dir, "tls.cert")
+	keyPath := filepath.Join(dir, "tls.key")
+
+	// An expired certificate on disk doesn't prevent the startup.
+	writeTestCert(t, certPath, keyPath, -time.Hour)
+
+	reloader, err := newCertReloader(certPath, keyPath)
+	require.NoError(t, err)
+
+	expired, err := reloader.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	require.NotNil(t, expired)
+
+	// A valid certificate replaces it on reload.
+	writeTestCert(t, certPath, keyPath, time.Hour)
+	require.NoError(t, reloader.Reload())
+
+	valid, err := reloader.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	require.NotEqual(t, expired.Certificate, valid.Certificate)
+
+	// An expired certificate is rejected on reload and the current one
+	// is kept.
+	writeTestCert(t, certPath, keyPath, -time.Hour)
+	require.ErrorContains(t, reloader.Reload(), "expired")
+
+	current, err := reloader.GetCertificate(&tls.ClientHelloInfo{})
+	require.NoError(t, err)
+	require.Equal(t, valid.Certificate, current.Certificate)
+}
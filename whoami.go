@@ -0,0 +1,162 @@
+package terminal
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/lightninglabs/lightning-terminal/accounts"
+	"github.com/lightninglabs/lightning-terminal/firewall"
+	"github.com/lightninglabs/lightning-terminal/litrpc"
+	litmac "github.com/lightninglabs/lightning-terminal/macaroons"
+	"github.com/lightninglabs/lightning-terminal/session"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+)
+
+// whoAmI describes the privileges of the given hex encoded macaroon, which
+// must already have been validated: the URIs it may call, the session it
+// belongs to, the account it is linked to and the firewall rules that are
+// enforced for it.
+func (g *LightningTerminal) whoAmI(ctx context.Context,
+	macHex string) (*litrpc.WhoAmIResponse, error) {
+
+	mac, err := litmac.ParseMacaroon(macHex)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse macaroon: %w", err)
+	}
+
+	rootKeyID, err := litmac.RootKeyIDFromMacaroon(mac)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode root key ID: %w", err)
+	}
+
+	ops, err := litmac.OpsFromMacaroon(mac)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode permissions: %w", err)
+	}
+
+	resp := &litrpc.WhoAmIResponse{
+		AllowedUris:       g.permsMgr.PermittedURIs(ops),
+		MacaroonRootKeyId: rootKeyID,
+		SuperMacaroon:     litmac.IsSuperMacaroon(macHex),
+	}
+
+	expiry, expires := checkers.ExpiryTime(nil, mac.Caveats())
+	if expires {
+		resp.MacaroonExpiry = expiry.Unix()
+	}
+
+	// Only super macaroons are baked for sessions, and they carry the ID
+	// of the session in their root key ID.
+	var sess *session.Session
+	if resp.SuperMacaroon {
+		sess, err = g.macaroonSession(ctx, rootKeyID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if sess != nil {
+		// Session macaroons don't carry the session's expiry as a
+		// caveat, since it is checked against the stored session.
+		if !expires {
+			resp.MacaroonExpiry = sess.Expiry.Unix()
+		}
+
+		resp.Session, err = marshalCallerSession(sess)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	accountID, err := accounts.IDFromCaveats(mac.Caveats())
+	if err != nil {
+		return nil, err
+	}
+	if accountID.IsNone() && sess != nil {
+		accountID = sess.AccountID
+	}
+
+	if accountID.IsSome() && !g.cfg.Accounts.Disable {
+		id := accountID.UnwrapOr(accounts.AccountID{})
+		resp.Account, err = g.accountRpcServer.AccountInfo(
+			ctx, &litrpc.AccountInfoRequest{
+				Id: hex.EncodeToString(id[:]),
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to look up account: %w",
+				err)
+		}
+	}
+
+	resp.FeatureRules = make(map[string]*litrpc.RulesMap)
+	for _, caveat := range mac.Caveats() {
+		info, err := firewall.ParseRuleCaveat(string(caveat.Id))
+		if errors.Is(err, firewall.ErrNoRulesCaveat) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		sessionRules, err := g.sessionRpcServer.marshalRules(
+			ctx, sess, info, resp.FeatureRules,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if sessionRules != nil {
+			resp.SessionRules = sessionRules
+		}
+	}
+
+	return resp, nil
+}
+
+// macaroonSession returns the session that the super macaroon with the given
+// root key ID was baked for, or nil if it doesn't belong to a session.
+func (g *LightningTerminal) macaroonSession(ctx context.Context,
+	rootKeyID uint64) (*session.Session, error) {
+
+	sess, err := g.stores.sessions.GetSession(
+		ctx, session.IDFromMacRootKeyID(rootKeyID),
+	)
+	if errors.Is(err, session.ErrSessionNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("unable to look up session: %w", err)
+	}
+
+	// Super macaroons that weren't baked for a session can share the ID
+	// suffix of a session by chance.
+	if sess.MacaroonRootKey != rootKeyID {
+		return nil, nil
+	}
+
+	return sess, nil
+}
+
+// marshalCallerSession converts the session of a caller into its RPC
+// counterpart. Unlike a full RPC session, it doesn't contain any secrets.
+func marshalCallerSession(sess *session.Session) (*litrpc.CallerSession,
+	error) {
+
+	rpcState, err := marshalRPCState(sess.State)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcType, err := marshalRPCType(sess.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	return &litrpc.CallerSession{
+		Id:                     sess.ID[:],
+		Label:                  sess.Label,
+		SessionType:            rpcType,
+		SessionState:           rpcState,
+		ExpiryTimestampSeconds: uint64(sess.Expiry.Unix()),
+	}, nil
+}